@@ -3,6 +3,7 @@ package common
 import (
 	"errors"
 	"fmt"
+	"golangmikesamples/DateTimeTests/03_GregorianCalendar/datetime"
 	"os"
 	"regexp"
 	"runtime"
@@ -84,6 +85,7 @@ type DateTimeFormatUtility struct {
 	DateTimeOut               time.Time
 	NumOfFormatsGenerated     int
 	FormatSearchReplaceStrs   SearchStrings
+	LocaleId                  string // Optional. Locale of month and weekday names. Example: "fr"
 }
 
 // CreateAllFormatsInMemory - Currently this method generates
@@ -429,6 +431,11 @@ func (dtf *DateTimeFormatUtility) Empty() {
 // the time string to a valid time.Time value, this method will run the date time
 // string against 1.4-million possible date time string formats in an effort to
 // successfully convert the date time string into a valid time.Time value.
+//
+// If field 'LocaleId' is populated, localized month names, day of the week names
+// and AM/PM markers are translated to English before the date time string is
+// parsed. Example: With 'LocaleId' set to "fr", "15 février 2024" is parsed as
+// "15 February 2024". Locales are supplied by type datetime.DateTimeLocaleRegistry.
 func (dtf *DateTimeFormatUtility) ParseDateTimeString(dateTimeStr string, probableFormat string) (time.Time, error) {
 
 	if dateTimeStr == "" {
@@ -437,7 +444,22 @@ func (dtf *DateTimeFormatUtility) ParseDateTimeString(dateTimeStr string, probab
 
 	dtf.Empty()
 
-	xtimeStr := dtf.replaceMultipleStrSequence(dateTimeStr, dtf.FormatSearchReplaceStrs.PreTrimSearchStrs)
+	englishTimeStr := dateTimeStr
+
+	if dtf.LocaleId != "" {
+
+		dtLocale, err := datetime.DateTimeLocaleRegistry{}.Get(
+			dtf.LocaleId,
+			"DateTimeFormatUtility.ParseDateTimeString() ")
+
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		englishTimeStr = dtLocale.TranslateToEnglish(dateTimeStr, probableFormat)
+	}
+
+	xtimeStr := dtf.replaceMultipleStrSequence(englishTimeStr, dtf.FormatSearchReplaceStrs.PreTrimSearchStrs)
 	xtimeStr = dtf.replaceDateSuffixStThRd(xtimeStr)
 	xtimeStr = dtf.reformatSingleTimeString(xtimeStr, dtf.FormatSearchReplaceStrs.TimeFmtRegEx)
 	xtimeStr = dtf.replaceAMPM(xtimeStr)
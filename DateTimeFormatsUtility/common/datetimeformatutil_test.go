@@ -36,6 +36,56 @@ func TestDateTimeFormatUtility_ParseDateTimeStrings(t *testing.T) {
 
 }
 
+func TestDateTimeFormatUtility_ParseDateTimeStringLocale(t *testing.T) {
+	dtf := DateTimeFormatUtility{}
+
+	dtf.LocaleId = "fr"
+
+	ti, err := dtf.ParseDateTimeString("15 février 2024", "2 January 2006")
+
+	if err != nil {
+		t.Errorf("Error on dtf.ParseDateTimeString(\"15 février 2024\") Error: %v", err.Error())
+		return
+	}
+
+	fmtDateTimeEverything := "Monday January 2, 2006 15:04:05.000000000 -0700 MST"
+	expected := "Thursday February 15, 2024 00:00:00.000000000 +0000 UTC"
+	tiStr := ti.Format(fmtDateTimeEverything)
+
+	if tiStr != expected {
+		t.Errorf("Expected: %v - Received %v", expected, tiStr)
+	}
+
+	if dtf.OriginalDateTimeStringIn != "15 février 2024" {
+		t.Errorf("Expected OriginalDateTimeStringIn: 15 février 2024 - Received %v",
+			dtf.OriginalDateTimeStringIn)
+	}
+
+	dtf.LocaleId = "de"
+
+	ti, err = dtf.ParseDateTimeString("Donnerstag, 15. Februar 2024 14:30", "Monday, 2. January 2006 15:04")
+
+	if err != nil {
+		t.Errorf("Error on dtf.ParseDateTimeString(\"Donnerstag, 15. Februar 2024 14:30\") Error: %v", err.Error())
+		return
+	}
+
+	expected = "Thursday February 15, 2024 14:30:00.000000000 +0000 UTC"
+	tiStr = ti.Format(fmtDateTimeEverything)
+
+	if tiStr != expected {
+		t.Errorf("Expected: %v - Received %v", expected, tiStr)
+	}
+
+	dtf.LocaleId = "xx-unknown"
+
+	_, err = dtf.ParseDateTimeString("15 février 2024", "2 January 2006")
+
+	if err == nil {
+		t.Error("Expected an error from dtf.ParseDateTimeString() with an unknown LocaleId. NO ERROR WAS RETURNED!")
+	}
+}
+
 func GetXDateTimeSamples() [][][]string {
 	d := make([][][]string, 0)
 	// FmtDateTimeEverything := "Monday January 2, 2006 15:04:05.000000000 -0700 MST"
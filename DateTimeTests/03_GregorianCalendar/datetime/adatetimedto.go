@@ -485,6 +485,86 @@ func (aDateTimeDto *ADateTimeDto) CompareYears(
 	return comparisonResult
 }
 
+// ConvertTimeScale - Converts the date time encapsulated by the
+// current ADateTimeDto instance from one time scale to another and
+// returns the result as a new ADateTimeDto instance. Supported time
+// scales are UTC, TAI, GPS and TT. Reference type 'TimeScaleType'.
+//
+// Conversions to and from UTC apply the values of TAI - UTC provided
+// by the current leap second table. Reference type 'LeapSecondTable'.
+// UTC date times preceding January 1, 1972 cannot be converted.
+//
+// Time scale conversions are only supported for the Gregorian
+// Calendar.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  fromScale           TimeScaleType
+//     - The time scale of the date time encapsulated by the current
+//       ADateTimeDto instance. The date time components are
+//       interpreted in the time zone of the current instance. A
+//       second value of '60' is only valid on the UTC time scale.
+//
+//
+//  toScale             TimeScaleType
+//     - The time scale of the returned ADateTimeDto instance.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ADateTimeDto
+//     - If successful, this method returns a new ADateTimeDto instance
+//       containing the converted date time expressed with a zero UTC
+//       offset in the 'UTC' time zone. If 'toScale' is UTC, the leap
+//       second flags are set from the current leap second table and an
+//       instant falling within a leap second is expressed as 23:59:60.
+//       Otherwise, the leap second flags are set to 'false'.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (aDateTimeDto *ADateTimeDto) ConvertTimeScale(
+	fromScale TimeScaleType,
+	toScale TimeScaleType,
+	ePrefix string) (
+	ADateTimeDto,
+	error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = &sync.Mutex{}
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix += "ADateTimeDto.ConvertTimeScale() "
+
+	timeScaleMech := timeScaleMechanics{}
+
+	return timeScaleMech.convertADateTimeDto(
+		aDateTimeDto,
+		fromScale,
+		toScale,
+		ePrefix)
+}
+
 // CopyIn - Receives a pointer to an incoming ADateTimeDto and performs
 // a deep copy of all internal data fields to the current ADateTimeDto
 // instance.
@@ -706,6 +786,135 @@ func (aDateTimeDto *ADateTimeDto) ExchangeValues(
 		ePrefix)
 }
 
+// FormatDateTime - Returns a date time string formatted according to
+// the token format string, 'tokenFormatStr'.
+//
+// Unlike method ADateTimeDto.GetDateTimeStr(), this method does not
+// rely on Go 'time' package layouts. Instead, it operates directly on
+// the year, month, day and time components of the current
+// ADateTimeDto instance. Therefore, years beyond +/-9999, Before
+// Common Era (BCE) dates and dates under non-Gregorian calendars are
+// formatted correctly.
+//
+// Be advised that this method validates the current ADateTimeDto
+// instance. If this instance is judged to be invalid, an error is
+// returned.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  tokenFormatStr     string
+//     - A format string containing format tokens. Each format token
+//       consists of a percent sign ('%'), an optional '-' flag which
+//       suppresses zero padding, an optional precision ('.n') and a
+//       token character. All other characters are copied to the
+//       returned string unchanged.
+//
+//        Token   Description                                Example
+//        -----   -----------                                -------
+//         %Y     Astronomical Year, minimum four digits     "-0043"
+//         %y     Era Year (BCE/CE numbering)                "44"
+//         %E     Era suffix                                 "BCE"
+//         %C     Calendar System                            "Julian"
+//         %m     Month Number, two digits                   "03"
+//         %B     Month Name                                 "March"
+//         %b     Month Name Abbreviation                    "Mar"
+//         %d     Day of the month, two digits               "15"
+//         %j     Ordinal Day Number, three digits           "074"
+//         %A     Day of the week name                       "Wednesday"
+//         %a     Day of the week abbreviation               "Wed"
+//         %u     ISO 8601 day of the week number (1-7)      "3"
+//         %V     ISO 8601 week number, two digits           "11"
+//         %G     ISO 8601 week-based Astronomical Year      "-0043"
+//         %H     Hour (00-23)                               "13"
+//         %I     Hour (01-12)                               "01"
+//         %p     AM or PM                                   "PM"
+//         %M     Minute, two digits                         "30"
+//         %S     Second, two digits (00-60)                 "05"
+//         %f     Fractional seconds. Default nine digits.   "123456789"
+//                '%.3f' yields three digits.                "123"
+//         %z     UTC Offset                                 "-0600"
+//         %Z     Time Zone abbreviation                     "CST"
+//         %J     Julian Day Number. '%.6J' yields the       "1705426"
+//                Julian Day Number/Time with six digits
+//                to the right of the decimal.               "1705426.062564"
+//         %%     Literal percent sign                       "%"
+//
+//       Example:
+//         "%y %E %B %-d, %H:%M:%S" = "44 BCE March 15, 13:30:05"
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  dateTimeStr        string
+//     - If successful, this method returns a string containing the
+//       date time of the current ADateTimeDto instance formatted
+//       according to input parameter 'tokenFormatStr'.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (aDateTimeDto *ADateTimeDto) FormatDateTime(
+	tokenFormatStr string,
+	ePrefix string) (
+	dateTimeStr string,
+	err error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = &sync.Mutex{}
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix += "ADateTimeDto.FormatDateTime() "
+
+	aDateTimeDtoNanobot := aDateTimeDtoNanobot{}
+
+	_, err = aDateTimeDtoNanobot.testDateTransferDtoValidity(
+		aDateTimeDto,
+		ePrefix + "Testing validity of current ADateTimeDto instance. ")
+
+	if err != nil {
+		return dateTimeStr, err
+	}
+
+	dtTokenMech := dateTimeTokenFormatMechanics{}
+
+	var fields dateTimeTokenFields
+
+	fields, err = dtTokenMech.getTokenFields(
+		&aDateTimeDto.date,
+		&aDateTimeDto.time,
+		&aDateTimeDto.julianDayNumber,
+		ePrefix)
+
+	if err != nil {
+		return dateTimeStr, err
+	}
+
+	return dtTokenMech.formatDateTime(
+		&fields,
+		tokenFormatStr,
+		ePrefix)
+}
+
 // GetAbsoluteYearValue - Returns the 'year' value as an absolute
 // or positive value.
 //
@@ -794,7 +1003,7 @@ func (aDateTimeDto *ADateTimeDto) GetAbsoluteYearBigIntValue() *big.Int {
 
 	defer aDateTimeDto.lock.Unlock()
 
-	return aDateTimeDto.GetAbsoluteYearBigIntValue()
+	return aDateTimeDto.date.GetAbsoluteYearBigIntValue()
 }
 
 // GetDateHasLeapSecond - Returns a boolean value signaling whether
@@ -1492,6 +1701,102 @@ func (aDateTimeDto *ADateTimeDto) GetHour() int {
 	return aDateTimeDto.time.GetHour()
 }
 
+// GetISOWeekDate - Returns the ISO 8601 week date for the date
+// encapsulated by the current ADateTimeDto instance.
+//
+// The week date is computed under the calendar system associated
+// with the current ADateTimeDto instance. The returned ISOWeekDateDto
+// contains the week-based year, the week number (1-53) and the
+// ISO 8601 day of the week number (Monday=1, Sunday=7). The week-based
+// year is formatted as an Astronomical Year.
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/ISO_week_date
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  isoWeekDate         ISOWeekDateDto
+//     - If successful, this method returns the ISO 8601 week date
+//       equivalent to the date encapsulated by the current ADateTimeDto
+//       instance.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (aDateTimeDto *ADateTimeDto) GetISOWeekDate(
+	ePrefix string) (
+	isoWeekDate ISOWeekDateDto,
+	err error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = &sync.Mutex{}
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix += "ADateTimeDto.GetISOWeekDate() "
+
+	aDateTimeDtoNanobot := aDateTimeDtoNanobot{}
+
+	_,
+	err = aDateTimeDtoNanobot.testDateTransferDtoValidity(
+		aDateTimeDto,
+		ePrefix +
+			"Testing validity of current ADateTimeDto instance. ")
+
+	if err != nil {
+		return isoWeekDate, err
+	}
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	var weekYear int64
+	var weekNumber int
+	var weekDay ISO8601DayOfWeekNo
+
+	weekYear,
+	weekNumber,
+	weekDay,
+	err = isoWeekDateMech.getISOWeekDate(
+		aDateTimeDto.date.calendarBaseData.GetCalendarSpecification(),
+		aDateTimeDto.date.astronomicalYear,
+		aDateTimeDto.date.month,
+		aDateTimeDto.date.day,
+		ePrefix)
+
+	if err != nil {
+		return isoWeekDate, err
+	}
+
+	isoWeekDate, err = ISOWeekDateDto{}.New(
+		weekYear,
+		weekNumber,
+		weekDay,
+		ePrefix)
+
+	return isoWeekDate, err
+}
+
 // GetIsLeapYear - Returns a boolean value signaling whether the year
 // value contained in the date encapsulated within the current ADateTimeDto
 // instance is a leap year.
@@ -2689,6 +2994,95 @@ func (aDateTimeDto *ADateTimeDto) IsValidInstanceError(
 	return err
 }
 
+// MarshalBinary - Implements the encoding.BinaryMarshaler interface. The
+// current ADateTimeDto instance is encoded in the versioned binary wire
+// format. The binary wire format carries the same members as the
+// JSON wire format. Reference method ADateTimeDto.MarshalJSON().
+//
+func (aDateTimeDto ADateTimeDto) MarshalBinary() ([]byte, error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix := "ADateTimeDto.MarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.aDateTimeDtoToWire(&aDateTimeDto, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalBinary(wireTypeCodeADateTimeDto, &wire), nil
+}
+
+// MarshalJSON - Implements the json.Marshaler interface. The
+// current ADateTimeDto instance is encoded in the JSON wire format. The
+// wire format retains the calendar system, the date and time components, the
+// year numbering type, the date and time leap second flags, the
+// date, time and instance tags, the date time format, both the
+// original and the convertible time zones and, if set, the Julian
+// Day Number.
+//
+// An invalid ADateTimeDto instance cannot be marshaled and will
+// generate an error.
+//
+func (aDateTimeDto ADateTimeDto) MarshalJSON() ([]byte, error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix := "ADateTimeDto.MarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.aDateTimeDtoToWire(&aDateTimeDto, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
+// MarshalText - Implements the encoding.TextMarshaler interface. The text
+// form of a ADateTimeDto is identical to its JSON wire format. Reference
+// method ADateTimeDto.MarshalJSON().
+//
+func (aDateTimeDto ADateTimeDto) MarshalText() ([]byte, error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix := "ADateTimeDto.MarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.aDateTimeDtoToWire(&aDateTimeDto, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
 // New - Creates and returns an new instance of ADateTimeDto. This is a
 // light data transfer object designed expressly for transmission of raw 
 // date time information.
@@ -2709,13 +3103,15 @@ func (aDateTimeDto *ADateTimeDto) IsValidInstanceError(
 //       Source File: datetime\calendarspecenum.go
 //
 //       Possible Calendar System values include:
-//       Gregorian, Julian, Revised Julian, or Revised Goucher-Parker.
+//       Gregorian, Julian, Revised Julian, Revised Goucher-Parker or
+//       Julian/Gregorian.
 //
 //       Possible Enumeration Values:
 //         CalendarSpec(0).Gregorian()
 //         CalendarSpec(0).Julian()
 //         CalendarSpec(0).RevisedJulian()
 //         CalendarSpec(0).RevisedGoucherParker()
+//         CalendarSpec(0).JulianGregorian()
 //
 //
 //  year                     int64
@@ -2766,6 +3162,11 @@ func (aDateTimeDto *ADateTimeDto) IsValidInstanceError(
 //       the 'leap second', reference:
 //          https://en.wikipedia.org/wiki/Leap_second
 //
+//       For Gregorian Calendar dates, this flag is also set automatically
+//       if the current leap second table shows that a leap second was
+//       inserted during the day in the time zone specified by input
+//       parameter 'timeZoneLocation'. Reference type 'LeapSecondTable'.
+//
 //
 //  hour                     int
 //     - The hour time component for this date/time specification.
@@ -2874,11 +3275,9 @@ func (aDateTimeDto ADateTimeDto) New (
 
 	newDateTimeDto = ADateTimeDto{}
 
-	newDateTimeDto.lock = new(sync.Mutex)
-
-	err = nil
+	var date DateTransferDto
 
-	newDateTimeDto.date, err =
+	date, err =
 		DateTransferDto{}.NewFromComponents(
 			calendarSystem,
 			year,
@@ -2893,45 +3292,467 @@ func (aDateTimeDto ADateTimeDto) New (
 		return ADateTimeDto{}, err
 	}
 
-	 if second != 60 {
-		hasLeapSecond = false
-	 }
+	aDateTimeDtoUtil := aDateTimeDtoUtility{}
 
-	 newDateTimeDto.time, err =
-	 	TimeTransferDto{}.NewFromComponents(
-	 		hour,
-	 		minute,
-	 		second,
-	 		hasLeapSecond,
-	 		nanosecond,
-	 		timeZoneLocation,
-	 		ePrefix)
+	err = aDateTimeDtoUtil.setADateTimeDto(
+		&newDateTimeDto,
+		date,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		timeZoneLocation,
+		dateTimeFmt,
+		tag,
+		ePrefix)
 
 	if err != nil {
 		return ADateTimeDto{}, err
 	}
 
-	dtMech := DTimeNanobot{}
-
-	newDateTimeDto.dateTimeFmt =
-		dtMech.PreProcessDateFormatStr(dateTimeFmt)
-
-	newDateTimeDto.tag = tag
-
 	return newDateTimeDto, err
 }
 
-// SetHasLeapSecond - The standard 'day' has a duration of exactly 24-hours.
-// If this method's input parameter is set to 'true' is signals that the day
-// identified by this ADateTimeDto instance consists of 24-hours + 1-second.
+// NewFromISOWeekDate - Creates and returns a new instance of
+// ADateTimeDto from an ISO 8601 week date string and time
+// components.
 //
-// A leap second is a one-second adjustment that is occasionally applied
-// to Coordinated Universal Time (UTC) in order to accommodate the
-// difference between precise time (as measured by atomic clocks) and
-// imprecise observed solar time (known as UT1 and which varies due
-// to irregularities and long-term slowdown in the Earth's rotation).
+// The ISO 8601 week date is converted to a date under the calendar
+// system specified by input parameter 'calendarSystem'. Both the
+// extended format, "YYYY-Www-D", and the basic format, "YYYYWwwD",
+// are supported. Years outside the range 0000 through 9999 must be
+// preceded by a plus (+) or minus (-) sign. Year values are
+// interpreted as Astronomical Years.
 //
-// For more information on the 'leap second', reference:
+//  Example:
+//    Gregorian "2009-W01-1" is equivalent to 2008-12-29
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calendarSystem      CalendarSpec
+//     - Designates the calendar system under which the ISO week
+//       date will be converted.
+//
+//
+//  isoWeekDateStr      string
+//     - An ISO 8601 week date string. Example: "2009-W01-1"
+//
+//
+//  hasLeapSecond       bool
+//     - If set to 'true', signals that the day includes a leap second.
+//
+//
+//  hour                int
+//  minute              int
+//  second              int
+//  nanosecond          int
+//     - The time components of the new ADateTimeDto instance.
+//
+//
+//  timeZoneLocation    string
+//     - The time zone associated with the time components.
+//
+//
+//  dateTimeFmt         string
+//     - The date/time format used to format date/time output values.
+//
+//
+//  tag                 string
+//     - A text description associated with the new instance.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  newDateTimeDto      ADateTimeDto
+//     - If successful, this method returns a new, populated instance
+//       of ADateTimeDto. The year value is formatted as an
+//       Astronomical Year.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (aDateTimeDto ADateTimeDto) NewFromISOWeekDate(
+	calendarSystem CalendarSpec,
+	isoWeekDateStr string,
+	hasLeapSecond bool,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	tag string,
+	ePrefix string) (
+	newDateTimeDto ADateTimeDto,
+	err error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix += "ADateTimeDto.NewFromISOWeekDate() "
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	var weekYear, year int64
+	var weekNumber, month, day int
+	var weekDay ISO8601DayOfWeekNo
+
+	weekYear,
+	weekNumber,
+	weekDay,
+	err = isoWeekDateMech.parseISOWeekDateStr(
+		isoWeekDateStr,
+		ePrefix)
+
+	if err != nil {
+		return ADateTimeDto{}, err
+	}
+
+	year,
+	month,
+	day,
+	err = isoWeekDateMech.getDateFromISOWeekDate(
+		calendarSystem,
+		weekYear,
+		weekNumber,
+		weekDay,
+		ePrefix)
+
+	if err != nil {
+		return ADateTimeDto{}, err
+	}
+
+	return ADateTimeDto{}.New(
+		calendarSystem,
+		year,
+		CalendarYearNumType(0).Astronomical(),
+		month,
+		day,
+		hasLeapSecond,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		timeZoneLocation,
+		dateTimeFmt,
+		tag,
+		ePrefix)
+}
+
+// NewFromString - Creates a new ADateTimeDto instance by parsing a
+// date/time string. Parsing is driven by the same format tokens used
+// by method ADateTimeDto.FormatDateTime().
+//
+// The year may be parsed as an Astronomical Year with token '%Y' or
+// as a Common Era year with tokens '%y' and '%E'. Era values 'BCE',
+// 'BC', 'CE' and 'AD' are accepted. If token '%E' is omitted, '%y' is
+// treated as a Common Era (CE) year.
+//
+// Examples:
+//
+//  dateTimeStr                  tokenFormatStr
+//  -----------                  --------------
+//  "0044-03-15 BCE 12:00:00"    "%y-%m-%d %E %H:%M:%S"
+//  "-0043-03-15"                "%Y-%m-%d"
+//  "4713-01-01 BCE"             "%y-%m-%d %E"
+//  "March 15, 44 BC"            "%B %-d, %y %E"
+//  "2021-W53-7"                 "%G-W%V-%u"
+//
+// Month names, day of the week names and era values are matched
+// without regard to case. Missing month or day values default to one
+// (1). Missing time components default to zero (0). Day of the week
+// tokens are validated against the parsed date. Token '%z' is parsed
+// but ignored. Token '%J' is not supported for parsing.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calendarSystem      CalendarSpec
+//     - Designates the calendar system under which the date/time
+//       string is interpreted. If 'tokenFormatStr' contains token
+//       '%C', the parsed calendar system takes precedence.
+//
+//
+//  dateTimeStr         string
+//     - The date/time string to be parsed.
+//
+//
+//  tokenFormatStr      string
+//     - The token format string describing 'dateTimeStr'. For a
+//       list of format tokens, reference method
+//       ADateTimeDto.FormatDateTime().
+//
+//
+//  timeZoneLocation    string
+//     - The time zone associated with the parsed time. If this
+//       parameter is an empty string, the time zone parsed with
+//       token '%Z' is used. If neither is available, the time zone
+//       defaults to UTC.
+//
+//
+//  dateTimeFmt         string
+//     - The date/time format used to format date/time output values.
+//
+//
+//  tag                 string
+//     - A text description associated with the new instance.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  RETNewFromString
+//     - If successful, this method returns a new, populated instance
+//       of ADateTimeDto.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (aDateTimeDto ADateTimeDto) NewFromString(
+	calendarSystem CalendarSpec,
+	dateTimeStr string,
+	tokenFormatStr string,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	tag string,
+	ePrefix string) (
+	newDateTimeDto ADateTimeDto,
+	err error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix += "ADateTimeDto.NewFromString() "
+
+	dtParseMech := dateTimeTokenParseMechanics{}
+
+	var parsed dateTimeTokenParseResult
+
+	parsed, err = dtParseMech.parseDateTime(
+		dateTimeStr,
+		tokenFormatStr,
+		calendarSystem,
+		ePrefix)
+
+	if err != nil {
+		return ADateTimeDto{}, err
+	}
+
+	return dtParseMech.newADateTimeDto(
+		&parsed,
+		timeZoneLocation,
+		dateTimeFmt,
+		tag,
+		ePrefix)
+}
+
+// NewJulianGregorian - Creates and returns a new instance of
+// ADateTimeDto for a date/time in the Julian/Gregorian Calendar
+// using the cutover Julian Day Number specified by input parameter
+// 'cutoverJulianDayNo'.
+//
+// Method ADateTimeDto.New() always applies the default cutover date,
+// 15 October 1582 Gregorian, to Julian/Gregorian dates. Use this
+// method for calendars which adopted the Gregorian reform on a
+// different date. For example, Great Britain and its colonies
+// adopted the reform on 14 September 1752 Gregorian (Julian Day
+// Number 2361222).
+//
+// The date is validated against the specified cutover and the
+// cutover Julian Day Number is retained by the calendar base data
+// of the returned instance.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  cutoverJulianDayNo       int64
+//     - The first Julian Day Number governed by the Gregorian
+//       Calendar. If this value is zero, the default cutover Julian
+//       Day Number, 2299161, is applied. Otherwise, the value must be
+//       greater than or equal to 1794168 (1 March 200 Julian and
+//       Gregorian).
+//
+//
+//  year                     int64
+//     - The year number. This value is interpreted according to
+//       input parameter 'yearType'.
+//
+//
+//  yearType                 CalendarYearNumType
+//     - Classifies input parameter 'year' as an Astronomical Year,
+//       a Before Common Era (BCE) year or a Common Era (CE) year.
+//
+//
+//  month                    int
+//  day                      int
+//     - The month and day numbers of the date.
+//
+//
+//  hasLeapSecond            bool
+//     - If set to 'true', signals that the day includes a leap second.
+//
+//
+//  hour                     int
+//  minute                   int
+//  second                   int
+//  nanosecond               int
+//     - The time components of the new ADateTimeDto instance.
+//
+//
+//  timeZoneLocation         string
+//     - The time zone associated with the time components.
+//
+//
+//  dateTimeFmt              string
+//     - The date/time format used to format date/time output values.
+//
+//
+//  tag                      string
+//     - A text description associated with the new instance.
+//
+//
+//  ePrefix                  string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  newDateTimeDto     ADateTimeDto
+//     - If successful this method will return a new, fully populated instance
+//       of type ADateTimeDto.
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       in input parameter, 'ePrefix'.
+//
+func (aDateTimeDto ADateTimeDto) NewJulianGregorian(
+	cutoverJulianDayNo int64,
+	year int64,
+	yearType CalendarYearNumType,
+	month int,
+	day int,
+	hasLeapSecond bool,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	tag string,
+	ePrefix string) (
+	newDateTimeDto ADateTimeDto,
+	err error) {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix += "ADateTimeDto.NewJulianGregorian() "
+
+	newDateTimeDto = ADateTimeDto{}
+
+	var date DateTransferDto
+
+	date, err =
+		DateTransferDto{}.NewJulianGregorianFromComponents(
+			cutoverJulianDayNo,
+			year,
+			yearType,
+			month,
+			day,
+			hasLeapSecond,
+			"",
+			ePrefix)
+
+	if err != nil {
+		return ADateTimeDto{}, err
+	}
+
+	aDateTimeDtoUtil := aDateTimeDtoUtility{}
+
+	err = aDateTimeDtoUtil.setADateTimeDto(
+		&newDateTimeDto,
+		date,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		timeZoneLocation,
+		dateTimeFmt,
+		tag,
+		ePrefix)
+
+	if err != nil {
+		return ADateTimeDto{}, err
+	}
+
+	return newDateTimeDto, err
+}
+
+// SetHasLeapSecond - The standard 'day' has a duration of exactly 24-hours.
+// If this method's input parameter is set to 'true' is signals that the day
+// identified by this ADateTimeDto instance consists of 24-hours + 1-second.
+//
+// A leap second is a one-second adjustment that is occasionally applied
+// to Coordinated Universal Time (UTC) in order to accommodate the
+// difference between precise time (as measured by atomic clocks) and
+// imprecise observed solar time (known as UT1 and which varies due
+// to irregularities and long-term slowdown in the Earth's rotation).
+//
+// For more information on the 'leap second', reference:
 //          https://en.wikipedia.org/wiki/Leap_second
 //
 // This method can be used to set the value of internal member variables
@@ -3117,3 +3938,99 @@ func (aDateTimeDto *ADateTimeDto) String() string {
 	return outStr
 }
 
+
+// UnmarshalBinary - Implements the encoding.BinaryUnmarshaler interface. The
+// current ADateTimeDto instance is rebuilt from 'data', which must hold
+// the binary wire format generated by ADateTimeDto.MarshalBinary().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (aDateTimeDto *ADateTimeDto) UnmarshalBinary(data []byte) error {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix := "ADateTimeDto.UnmarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := aDateTimeDtoWire{}
+
+	err := wireMech.unmarshalBinary(data, wireTypeCodeADateTimeDto, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.aDateTimeDtoFromWire(aDateTimeDto, &wire, ePrefix)
+}
+
+// UnmarshalJSON - Implements the json.Unmarshaler interface. The
+// current ADateTimeDto instance is rebuilt from 'data', which must hold
+// the JSON wire format generated by ADateTimeDto.MarshalJSON().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (aDateTimeDto *ADateTimeDto) UnmarshalJSON(data []byte) error {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix := "ADateTimeDto.UnmarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := aDateTimeDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.aDateTimeDtoFromWire(aDateTimeDto, &wire, ePrefix)
+}
+
+// UnmarshalText - Implements the encoding.TextUnmarshaler interface. The
+// current ADateTimeDto instance is rebuilt from 'data', which must hold
+// the text form generated by ADateTimeDto.MarshalText().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (aDateTimeDto *ADateTimeDto) UnmarshalText(data []byte) error {
+
+	if aDateTimeDto.lock == nil {
+		aDateTimeDto.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDto.lock.Lock()
+
+	defer aDateTimeDto.lock.Unlock()
+
+	ePrefix := "ADateTimeDto.UnmarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := aDateTimeDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.aDateTimeDtoFromWire(aDateTimeDto, &wire, ePrefix)
+}
@@ -2,6 +2,7 @@ package datetime
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 	"sync"
 )
@@ -123,11 +124,16 @@ func (aDateTimeDtoUtil *aDateTimeDtoUtility) copyIn(
 
 	aDateTimeDto1.tag = aDateTimeDto2.tag
 
-	aDateTimeDto1.julianDayNumber, err =
-		aDateTimeDto2.julianDayNumber.CopyOut(ePrefix)
+	aDateTimeDto1.julianDayNumber = JulianDayNoDto{}
 
-	if err != nil {
-		return err
+	if aDateTimeDto2.julianDayNumber.IsValidInstance() {
+
+		aDateTimeDto1.julianDayNumber, err =
+			aDateTimeDto2.julianDayNumber.CopyOut(ePrefix)
+
+		if err != nil {
+			return err
+		}
 	}
 
 	_, err = aDateTimeDtoNanobot.testDateTransferDtoValidity(
@@ -188,11 +194,14 @@ func (aDateTimeDtoUtil *aDateTimeDtoUtility) copyOut(
 		return ADateTimeDto{}, err
 	}
 
-	newDTimeTransDto.julianDayNumber, err =
-		aDateTimeDto.julianDayNumber.CopyOut(ePrefix)
+	if aDateTimeDto.julianDayNumber.IsValidInstance() {
 
-	if err != nil {
-		return ADateTimeDto{}, err
+		newDTimeTransDto.julianDayNumber, err =
+			aDateTimeDto.julianDayNumber.CopyOut(ePrefix)
+
+		if err != nil {
+			return ADateTimeDto{}, err
+		}
 	}
 
 	newDTimeTransDto.tag = aDateTimeDto.tag
@@ -402,3 +411,165 @@ func (aDateTimeDtoUtil *aDateTimeDtoUtility) generateDateTimeStr(
 	return resultStr, err
 }
 
+
+// setADateTimeDto - Populates an ADateTimeDto instance from a
+// previously validated date and the time components passed as
+// input parameters.
+//
+// The date is copied to 'aDateTimeDto'. The leap second flag of the
+// date is set automatically if the current leap second table shows
+// that a leap second was inserted during the day in the time zone
+// specified by input parameter 'timeZoneLocation'.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  aDateTimeDto        *ADateTimeDto
+//     - A pointer to the ADateTimeDto instance which will be
+//       populated. All existing member variable data values will
+//       be overwritten.
+//
+//
+//  date                DateTransferDto
+//     - A valid date, including the calendar base data which
+//       identifies its calendar system.
+//
+//
+//  hour                int
+//  minute              int
+//  second              int
+//  nanosecond          int
+//     - The time components of the date/time. A 'second' value of
+//       60 is only accepted if the day contains a leap second.
+//
+//
+//  timeZoneLocation    string
+//     - The time zone associated with the time components.
+//
+//
+//  dateTimeFmt         string
+//     - The date/time format used to format date/time output values.
+//
+//
+//  tag                 string
+//     - A text description associated with 'aDateTimeDto'.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (aDateTimeDtoUtil *aDateTimeDtoUtility) setADateTimeDto(
+	aDateTimeDto *ADateTimeDto,
+	date DateTransferDto,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	tag string,
+	ePrefix string) error {
+
+	if aDateTimeDtoUtil.lock == nil {
+		aDateTimeDtoUtil.lock = new(sync.Mutex)
+	}
+
+	aDateTimeDtoUtil.lock.Lock()
+
+	defer aDateTimeDtoUtil.lock.Unlock()
+
+	ePrefix += "aDateTimeDtoUtility.setADateTimeDto() "
+
+	if aDateTimeDto == nil {
+		return errors.New(ePrefix + "\n" +
+			"Input parameter 'aDateTimeDto' is INVALID!\n" +
+			"aDateTimeDto == nil pointer\n")
+	}
+
+	if date.calendarBaseData == nil {
+		return errors.New(ePrefix + "\n" +
+			"Input parameter 'date' is INVALID!\n" +
+			"date.calendarBaseData == nil\n")
+	}
+
+	newDateTimeDto := ADateTimeDto{}
+
+	newDateTimeDto.lock = new(sync.Mutex)
+
+	var err error
+
+	newDateTimeDto.date, err = date.CopyOut(ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	// A 'second' value of '60' is validated below
+	// against the leap second flags.
+	isLeapSecondTime := second == 60
+
+	newDateTimeDto.time, err =
+		TimeTransferDto{}.NewFromComponents(
+			hour,
+			minute,
+			second,
+			isLeapSecondTime,
+			nanosecond,
+			timeZoneLocation,
+			ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	// Leap second flags are set automatically for
+	// days identified by the current leap second table.
+	leapSecMech := leapSecondTableMechanics{}
+
+	if leapSecMech.isLeapSecondDate(
+		newDateTimeDto.date.calendarBaseData.GetCalendarSpecification(),
+		newDateTimeDto.date.astronomicalYear,
+		newDateTimeDto.date.month,
+		newDateTimeDto.date.day,
+		newDateTimeDto.time.timeZone.GetOriginalLocationPtr()) {
+
+		newDateTimeDto.date.hasLeapSecond = true
+	}
+
+	if isLeapSecondTime &&
+		!newDateTimeDto.date.hasLeapSecond {
+
+		return fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'second' is INVALID!\n" +
+			"The 'second' value must be <= 59 and >= 0\n" +
+			"because this day does NOT contain a leap second.\n" +
+			"second='%v'\n", second)
+	}
+
+	dtMech := DTimeNanobot{}
+
+	newDateTimeDto.dateTimeFmt =
+		dtMech.PreProcessDateFormatStr(dateTimeFmt)
+
+	newDateTimeDto.tag = tag
+
+	*aDateTimeDto = newDateTimeDto
+
+	return nil
+}
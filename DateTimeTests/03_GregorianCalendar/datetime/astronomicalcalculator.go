@@ -0,0 +1,448 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// AstronomicalCalculator - Computes the position of the Sun and the
+// instants of common astronomical events: sunrise, sunset and civil
+// twilight for a geographic location, the equinoxes and solstices of
+// a year and the phases of the Moon.
+//
+// Event instants are returned as type AstronomicalEventDto which
+// expresses each instant both as a Julian Day Number/Time
+// (JulianDayNoDto) on the Universal Time (UT) time scale and as a
+// date time (DateTzDto) in the time zone requested by the caller.
+//
+// The algorithms are taken from Jean Meeus, Astronomical Algorithms,
+// 2nd Edition, Willmann-Bell, 1998. Equinoxes, solstices and Moon
+// phases are accurate to within about one minute for the years 1951
+// through 2050. Sunrise and sunset are accurate to within about one
+// minute at latitudes between 60-degrees north and 60-degrees south.
+//
+// Geographic latitudes north of the equator and longitudes east of
+// Greenwich are positive. Latitudes south of the equator and
+// longitudes west of Greenwich are negative.
+//
+// Years are astronomical years. Astronomical year zero (0) is
+// equivalent to 1 BCE. Supported years fall within the range -1000
+// through 3000.
+//
+type AstronomicalCalculator struct {
+	lock *sync.Mutex
+}
+
+// GetEquinoxesAndSolstices - Returns the March equinox, the June
+// solstice, the September equinox and the December solstice for
+// astronomical year 'year' in chronological order.
+//
+// Event date times are expressed in the time zone specified by input
+// parameter 'timeZoneDef'.
+//
+// Example:
+//  For the year 2024, the March equinox occurs on
+//  2024-03-20 03:06 UTC.
+//
+func (astroCalc AstronomicalCalculator) GetEquinoxesAndSolstices(
+	year int64,
+	timeZoneDef TimeZoneDefinition,
+	ePrefix string) (
+	[]AstronomicalEventDto,
+	error) {
+
+	if astroCalc.lock == nil {
+		astroCalc.lock = new(sync.Mutex)
+	}
+
+	astroCalc.lock.Lock()
+
+	defer astroCalc.lock.Unlock()
+
+	ePrefix += "AstronomicalCalculator.GetEquinoxesAndSolstices() "
+
+	astroMech := astronomicalMechanics{}
+
+	err := astroMech.testTimeZoneDef(&timeZoneDef, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := []AstronomicalEventType{
+		AstroEventType.MarchEquinox(),
+		AstroEventType.JuneSolstice(),
+		AstroEventType.SeptemberEquinox(),
+		AstroEventType.DecemberSolstice(),
+	}
+
+	events := make([]AstronomicalEventDto, 0, len(eventTypes))
+
+	for _, eventType := range eventTypes {
+
+		julianDayTt, err := astroMech.getEquinoxSolstice(year, eventType, ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		julianDayUt := julianDayTt - astroMech.getDeltaT(julianDayTt)/86400.0
+
+		event, err := astroMech.newAstronomicalEvent(
+			eventType,
+			julianDayUt,
+			timeZoneDef,
+			ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// GetMoonPhases - Returns all New Moons, First Quarters, Full Moons
+// and Last Quarters occurring during astronomical year 'year' in
+// chronological order. An event belongs to 'year' if its Universal
+// Time (UTC) instant falls within that year.
+//
+// Event date times are expressed in the time zone specified by input
+// parameter 'timeZoneDef'.
+//
+// Example:
+//  The first New Moon of 2024 occurs on 2024-01-11 11:57 UTC.
+//
+func (astroCalc AstronomicalCalculator) GetMoonPhases(
+	year int64,
+	timeZoneDef TimeZoneDefinition,
+	ePrefix string) (
+	[]AstronomicalEventDto,
+	error) {
+
+	if astroCalc.lock == nil {
+		astroCalc.lock = new(sync.Mutex)
+	}
+
+	astroCalc.lock.Lock()
+
+	defer astroCalc.lock.Unlock()
+
+	ePrefix += "AstronomicalCalculator.GetMoonPhases() "
+
+	if year < astroMinimumYear || year > astroMaximumYear {
+		return nil, &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "year",
+			inputParameterValue: fmt.Sprintf("%v", year),
+			errMsg: fmt.Sprintf("'year' must be within the range %v through %v.",
+				astroMinimumYear, astroMaximumYear),
+			err: nil,
+		}
+	}
+
+	astroMech := astronomicalMechanics{}
+
+	err := astroMech.testTimeZoneDef(&timeZoneDef, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	eventTypes := []AstronomicalEventType{
+		AstroEventType.NewMoon(),
+		AstroEventType.FirstQuarter(),
+		AstroEventType.FullMoon(),
+		AstroEventType.LastQuarter(),
+	}
+
+	// There are approximately 12.3685 lunations per year.
+	firstLunation := int64(math.Floor(float64(year-2000)*12.3685)) - 1
+
+	var events []AstronomicalEventDto
+
+	for lunation := firstLunation; lunation <= firstLunation+15; lunation++ {
+
+		for _, eventType := range eventTypes {
+
+			julianDayTt, err := astroMech.getMoonPhase(lunation, eventType, ePrefix)
+
+			if err != nil {
+				return nil, err
+			}
+
+			julianDayUt := julianDayTt - astroMech.getDeltaT(julianDayTt)/86400.0
+
+			if int64(astroMech.julianDayToTime(julianDayUt).Year()) != year {
+				continue
+			}
+
+			event, err := astroMech.newAstronomicalEvent(
+				eventType,
+				julianDayUt,
+				timeZoneDef,
+				ePrefix)
+
+			if err != nil {
+				return nil, err
+			}
+
+			events = append(events, event)
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].dateTime.dateTimeValue.Before(events[j].dateTime.dateTimeValue)
+	})
+
+	return events, nil
+}
+
+// GetSolarPosition - Returns the position of the Sun at the instant
+// specified by Julian Day Number/Time 'julianDayNo' as seen by an
+// observer at geographic latitude 'latitude' and longitude
+// 'longitude'. 'julianDayNo' is interpreted as Universal Time (UT).
+//
+// Reference type SolarPositionDto for a description of the returned
+// coordinates.
+//
+func (astroCalc AstronomicalCalculator) GetSolarPosition(
+	julianDayNo JulianDayNoDto,
+	latitude float64,
+	longitude float64,
+	ePrefix string) (
+	SolarPositionDto,
+	error) {
+
+	if astroCalc.lock == nil {
+		astroCalc.lock = new(sync.Mutex)
+	}
+
+	astroCalc.lock.Lock()
+
+	defer astroCalc.lock.Unlock()
+
+	ePrefix += "AstronomicalCalculator.GetSolarPosition() "
+
+	astroMech := astronomicalMechanics{}
+
+	err := astroMech.testLatitudeLongitude(latitude, longitude, ePrefix)
+
+	if err != nil {
+		return SolarPositionDto{}, err
+	}
+
+	julianDayUt, err := julianDayNo.GetDayNoTimeFloat64(ePrefix)
+
+	if err != nil {
+		return SolarPositionDto{}, err
+	}
+
+	solarPos := SolarPositionDto{
+		latitude:  latitude,
+		longitude: longitude,
+		lock:      new(sync.Mutex),
+	}
+
+	solarPos.julianDayNo, err = julianDayNo.CopyOut(ePrefix)
+
+	if err != nil {
+		return SolarPositionDto{}, err
+	}
+
+	julianDayTt := julianDayUt + astroMech.getDeltaT(julianDayUt)/86400.0
+
+	solarPos.eclipticLongitude,
+		solarPos.rightAscension,
+		solarPos.declination,
+		solarPos.equationOfTime = astroMech.getSolarCoordinates(julianDayTt)
+
+	rad := astroMech.toRadians
+	deg := astroMech.toDegrees
+
+	hourAngle := rad(astroMech.getGreenwichSiderealTime(julianDayUt) +
+		longitude - solarPos.rightAscension)
+
+	phi := rad(latitude)
+	delta := rad(solarPos.declination)
+
+	solarPos.elevation = deg(math.Asin(math.Sin(phi)*math.Sin(delta) +
+		math.Cos(phi)*math.Cos(delta)*math.Cos(hourAngle)))
+
+	solarPos.azimuth = astroMech.normalizeDegrees(deg(math.Atan2(
+		math.Sin(hourAngle),
+		math.Cos(hourAngle)*math.Sin(phi)-math.Tan(delta)*math.Cos(phi))) + 180.0)
+
+	solarPos.apparentElevation = solarPos.elevation
+
+	if solarPos.elevation > -1.0 {
+		// Atmospheric refraction in arc minutes. Reference:
+		// Meeus, Astronomical Algorithms, 2nd Ed., Formula 16.4.
+		h := solarPos.elevation
+		refraction := 1.02 / math.Tan(rad(h+10.3/(h+5.11)))
+		solarPos.apparentElevation += refraction / 60.0
+	}
+
+	return solarPos, nil
+}
+
+// GetSunEvents - Returns the civil dawn, sunrise, solar noon, sunset
+// and civil dusk occurring on a local calendar date at geographic
+// latitude 'latitude' and longitude 'longitude'. The local calendar
+// date, designated by 'year', 'month' and 'day', is interpreted in
+// the time zone specified by input parameter 'timeZoneDef'. The
+// returned events are listed in chronological order and their date
+// times are expressed in the time zone specified by 'timeZoneDef'.
+//
+// Sunrise and sunset mark the instants at which the upper limb of
+// the Sun touches the horizon, allowing for standard atmospheric
+// refraction. Civil dawn and civil dusk mark the instants at which
+// the center of the Sun is 6-degrees below the horizon.
+//
+// Events which do not occur on the local calendar date are omitted.
+// For example, during the polar day neither sunrise nor sunset is
+// returned and during the polar night only solar noon is returned.
+//
+func (astroCalc AstronomicalCalculator) GetSunEvents(
+	year int64,
+	month int,
+	day int,
+	latitude float64,
+	longitude float64,
+	timeZoneDef TimeZoneDefinition,
+	ePrefix string) (
+	[]AstronomicalEventDto,
+	error) {
+
+	if astroCalc.lock == nil {
+		astroCalc.lock = new(sync.Mutex)
+	}
+
+	astroCalc.lock.Lock()
+
+	defer astroCalc.lock.Unlock()
+
+	ePrefix += "AstronomicalCalculator.GetSunEvents() "
+
+	if year < astroMinimumYear || year > astroMaximumYear {
+		return nil, &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "year",
+			inputParameterValue: fmt.Sprintf("%v", year),
+			errMsg: fmt.Sprintf("'year' must be within the range %v through %v.",
+				astroMinimumYear, astroMaximumYear),
+			err: nil,
+		}
+	}
+
+	astroMech := astronomicalMechanics{}
+
+	err := astroMech.testLatitudeLongitude(latitude, longitude, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = astroMech.testTimeZoneDef(&timeZoneDef, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	location := timeZoneDef.GetConvertibleLocationPtr()
+
+	if location == nil {
+		return nil, errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'timeZoneDef' has a nil location pointer!\n")
+	}
+
+	localMidnight := time.Date(int(year), time.Month(month), day,
+		0, 0, 0, 0, location)
+
+	if int64(localMidnight.Year()) != year ||
+		int(localMidnight.Month()) != month ||
+		localMidnight.Day() != day {
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"Error: Input parameters 'year', 'month' and 'day' do not "+
+			"specify a valid date!\n"+
+			"year='%v' month='%v' day='%v'\n", year, month, day)
+	}
+
+	dayStart := astroMech.timeToJulianDay(localMidnight)
+	dayEnd := astroMech.timeToJulianDay(localMidnight.AddDate(0, 0, 1))
+
+	julianDayTransit := astroMech.getSunTransit(
+		astroMech.timeToJulianDay(localMidnight.Add(12*time.Hour)),
+		longitude)
+
+	type sunEvent struct {
+		eventType   AstronomicalEventType
+		julianDayUt float64
+	}
+
+	sunEvents := make([]sunEvent, 0, 5)
+
+	crossings := []struct {
+		eventType AstronomicalEventType
+		altitude  float64
+		isRising  bool
+	}{
+		{AstroEventType.CivilDawn(), -6.0, true},
+		{AstroEventType.Sunrise(), -0.833, true},
+		{AstroEventType.SolarNoon(), 0.0, false},
+		{AstroEventType.Sunset(), -0.833, false},
+		{AstroEventType.CivilDusk(), -6.0, false},
+	}
+
+	for _, crossing := range crossings {
+
+		julianDayUt := julianDayTransit
+		isFound := true
+
+		if crossing.eventType != AstroEventType.SolarNoon() {
+
+			julianDayUt, isFound = astroMech.getSunAltitudeCrossing(
+				julianDayTransit,
+				latitude,
+				longitude,
+				crossing.altitude,
+				crossing.isRising)
+		}
+
+		if !isFound ||
+			julianDayUt < dayStart ||
+			julianDayUt >= dayEnd {
+			continue
+		}
+
+		sunEvents = append(sunEvents, sunEvent{
+			eventType:   crossing.eventType,
+			julianDayUt: julianDayUt,
+		})
+	}
+
+	events := make([]AstronomicalEventDto, 0, len(sunEvents))
+
+	for _, sEvent := range sunEvents {
+
+		event, err := astroMech.newAstronomicalEvent(
+			sEvent.eventType,
+			sEvent.julianDayUt,
+			timeZoneDef,
+			ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
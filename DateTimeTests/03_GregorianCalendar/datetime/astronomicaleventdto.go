@@ -0,0 +1,99 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// AstronomicalEventDto - Contains the type and instant of an
+// astronomical event computed by type AstronomicalCalculator.
+// Examples of astronomical events include equinoxes, solstices,
+// Moon phases, sunrise and sunset.
+//
+// The instant of the event is expressed both as a Julian Day
+// Number/Time on the Universal Time (UT) time scale and as a date
+// time in the time zone requested by the caller. Event instants are
+// rounded to the nearest second.
+//
+type AstronomicalEventDto struct {
+	eventType   AstronomicalEventType // The astronomical event
+	julianDayNo JulianDayNoDto        // Julian Day Number/Time of the event (UT)
+	dateTime    DateTzDto             // Date time of the event in the requested time zone
+	lock        *sync.Mutex
+}
+
+// CopyOut - Returns a deep copy of the current AstronomicalEventDto
+// instance.
+//
+func (astroEvent *AstronomicalEventDto) CopyOut() AstronomicalEventDto {
+
+	if astroEvent.lock == nil {
+		astroEvent.lock = new(sync.Mutex)
+	}
+
+	astroEvent.lock.Lock()
+
+	defer astroEvent.lock.Unlock()
+
+	newEvent := AstronomicalEventDto{
+		eventType: astroEvent.eventType,
+		dateTime:  astroEvent.dateTime.CopyOut(),
+		lock:      new(sync.Mutex),
+	}
+
+	newEvent.julianDayNo, _ = astroEvent.julianDayNo.CopyOut("")
+
+	return newEvent
+}
+
+// GetDateTime - Returns the date time of the astronomical event
+// expressed in the time zone requested by the caller.
+//
+func (astroEvent *AstronomicalEventDto) GetDateTime() DateTzDto {
+
+	if astroEvent.lock == nil {
+		astroEvent.lock = new(sync.Mutex)
+	}
+
+	astroEvent.lock.Lock()
+
+	defer astroEvent.lock.Unlock()
+
+	return astroEvent.dateTime.CopyOut()
+}
+
+// GetEventType - Returns the type of the astronomical event.
+// Example: AstroEventType.FullMoon()
+//
+func (astroEvent *AstronomicalEventDto) GetEventType() AstronomicalEventType {
+
+	if astroEvent.lock == nil {
+		astroEvent.lock = new(sync.Mutex)
+	}
+
+	astroEvent.lock.Lock()
+
+	defer astroEvent.lock.Unlock()
+
+	return astroEvent.eventType
+}
+
+// GetJulianDayNo - Returns the Julian Day Number/Time of the
+// astronomical event on the Universal Time (UT) time scale.
+//
+func (astroEvent *AstronomicalEventDto) GetJulianDayNo(
+	ePrefix string) (
+	JulianDayNoDto,
+	error) {
+
+	if astroEvent.lock == nil {
+		astroEvent.lock = new(sync.Mutex)
+	}
+
+	astroEvent.lock.Lock()
+
+	defer astroEvent.lock.Unlock()
+
+	ePrefix += "AstronomicalEventDto.GetJulianDayNo() "
+
+	return astroEvent.julianDayNo.CopyOut(ePrefix)
+}
@@ -0,0 +1,517 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mAstronomicalEventTypeStringToCode = map[string]AstronomicalEventType{
+	"None"             : AstronomicalEventType(0),
+	"MarchEquinox"     : AstronomicalEventType(1),
+	"JuneSolstice"     : AstronomicalEventType(2),
+	"SeptemberEquinox" : AstronomicalEventType(3),
+	"DecemberSolstice" : AstronomicalEventType(4),
+	"NewMoon"          : AstronomicalEventType(5),
+	"FirstQuarter"     : AstronomicalEventType(6),
+	"FullMoon"         : AstronomicalEventType(7),
+	"LastQuarter"      : AstronomicalEventType(8),
+	"CivilDawn"        : AstronomicalEventType(9),
+	"Sunrise"          : AstronomicalEventType(10),
+	"SolarNoon"        : AstronomicalEventType(11),
+	"Sunset"           : AstronomicalEventType(12),
+	"CivilDusk"        : AstronomicalEventType(13),
+}
+
+var mAstronomicalEventTypeLwrCaseStringToCode = map[string]AstronomicalEventType{
+	"none"             : AstronomicalEventType(0),
+	"marchequinox"     : AstronomicalEventType(1),
+	"junesolstice"     : AstronomicalEventType(2),
+	"septemberequinox" : AstronomicalEventType(3),
+	"decembersolstice" : AstronomicalEventType(4),
+	"newmoon"          : AstronomicalEventType(5),
+	"firstquarter"     : AstronomicalEventType(6),
+	"fullmoon"         : AstronomicalEventType(7),
+	"lastquarter"      : AstronomicalEventType(8),
+	"civildawn"        : AstronomicalEventType(9),
+	"sunrise"          : AstronomicalEventType(10),
+	"solarnoon"        : AstronomicalEventType(11),
+	"sunset"           : AstronomicalEventType(12),
+	"civildusk"        : AstronomicalEventType(13),
+}
+
+var mAstronomicalEventTypeCodeToString = map[AstronomicalEventType]string{
+	AstronomicalEventType(0)  : "None",
+	AstronomicalEventType(1)  : "MarchEquinox",
+	AstronomicalEventType(2)  : "JuneSolstice",
+	AstronomicalEventType(3)  : "SeptemberEquinox",
+	AstronomicalEventType(4)  : "DecemberSolstice",
+	AstronomicalEventType(5)  : "NewMoon",
+	AstronomicalEventType(6)  : "FirstQuarter",
+	AstronomicalEventType(7)  : "FullMoon",
+	AstronomicalEventType(8)  : "LastQuarter",
+	AstronomicalEventType(9)  : "CivilDawn",
+	AstronomicalEventType(10) : "Sunrise",
+	AstronomicalEventType(11) : "SolarNoon",
+	AstronomicalEventType(12) : "Sunset",
+	AstronomicalEventType(13) : "CivilDusk",
+}
+
+// AstronomicalEventType - An enumeration of the astronomical events
+// computed by type AstronomicalCalculator.
+//
+// Since Go does not directly support enumerations, the 'AstronomicalEventType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'AstronomicalEventType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of astronomical
+// events. These methods are listed as follows:
+//
+//
+// None             (0) - Signals that the Astronomical Event Type is not
+//                        initialized. This is an error condition.
+//
+// MarchEquinox     (1) - The Sun crosses the celestial equator moving
+//                        north. Apparent solar longitude = 0 degrees.
+//
+// JuneSolstice     (2) - Apparent solar longitude = 90 degrees.
+//
+// SeptemberEquinox (3) - The Sun crosses the celestial equator moving
+//                        south. Apparent solar longitude = 180 degrees.
+//
+// DecemberSolstice (4) - Apparent solar longitude = 270 degrees.
+//
+// NewMoon          (5) - Moon phase. Moon - Sun longitude = 0 degrees.
+//
+// FirstQuarter     (6) - Moon phase. Moon - Sun longitude = 90 degrees.
+//
+// FullMoon         (7) - Moon phase. Moon - Sun longitude = 180 degrees.
+//
+// LastQuarter      (8) - Moon phase. Moon - Sun longitude = 270 degrees.
+//
+// CivilDawn        (9) - Beginning of morning civil twilight. The center
+//                        of the Sun is 6 degrees below the horizon.
+//
+// Sunrise         (10) - The upper limb of the Sun appears on the horizon.
+//
+// SolarNoon       (11) - The Sun crosses the local meridian.
+//
+// Sunset          (12) - The upper limb of the Sun disappears below the
+//                        horizon.
+//
+// CivilDusk       (13) - End of evening civil twilight. The center of
+//                        the Sun is 6 degrees below the horizon.
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'AstroEventType'. Example: AstroEventType.FullMoon()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: AstronomicalEventType(0).FullMoon()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the AstronomicalEventType methods in alphabetical order. Be advised that all
+// 'AstronomicalEventType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type AstronomicalEventType int
+
+var lockAstronomicalEventType sync.Mutex
+
+// None - Signals that the AstronomicalEventType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) None() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(0)
+}
+
+// MarchEquinox - Signals the March Equinox. The apparent geocentric
+// longitude of the Sun is 0 degrees.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) MarchEquinox() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(1)
+}
+
+// JuneSolstice - Signals the June Solstice. The apparent geocentric
+// longitude of the Sun is 90 degrees.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) JuneSolstice() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(2)
+}
+
+// SeptemberEquinox - Signals the September Equinox. The apparent
+// geocentric longitude of the Sun is 180 degrees.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) SeptemberEquinox() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(3)
+}
+
+// DecemberSolstice - Signals the December Solstice. The apparent
+// geocentric longitude of the Sun is 270 degrees.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) DecemberSolstice() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(4)
+}
+
+// NewMoon - Signals the New Moon phase. The geocentric longitudes
+// of the Moon and the Sun are equal.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) NewMoon() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(5)
+}
+
+// FirstQuarter - Signals the First Quarter Moon phase. The Moon is
+// 90 degrees east of the Sun.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) FirstQuarter() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(6)
+}
+
+// FullMoon - Signals the Full Moon phase. The Moon is 180 degrees
+// from the Sun.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) FullMoon() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(7)
+}
+
+// LastQuarter - Signals the Last Quarter Moon phase. The Moon is
+// 270 degrees east of the Sun.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) LastQuarter() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(8)
+}
+
+// CivilDawn - Signals the beginning of morning civil twilight.
+// The center of the Sun is 6 degrees below the horizon.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) CivilDawn() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(9)
+}
+
+// Sunrise - Signals sunrise. The upper limb of the Sun appears on
+// the horizon, allowing for atmospheric refraction.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) Sunrise() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(10)
+}
+
+// SolarNoon - Signals solar noon, the transit of the Sun across the
+// local meridian.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) SolarNoon() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(11)
+}
+
+// Sunset - Signals sunset. The upper limb of the Sun disappears
+// below the horizon, allowing for atmospheric refraction.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) Sunset() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(12)
+}
+
+// CivilDusk - Signals the end of evening civil twilight. The
+// center of the Sun is 6 degrees below the horizon.
+//
+// This method is part of the standard enumeration.
+//
+func (astroEventType AstronomicalEventType) CivilDusk() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return AstronomicalEventType(13)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'AstronomicalEventType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= AstronomicalEventType(0).FullMoon()
+// str := t.String()
+//     str is now equal to 'FullMoon'
+//
+func (astroEventType AstronomicalEventType) String() string {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	result, ok := mAstronomicalEventTypeCodeToString[astroEventType]
+
+	if !ok {
+		return "Error: Astronomical Event Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current AstronomicalEventType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  eventType := AstronomicalEventType(0).Sunrise()
+//
+//  isValid := eventType.XIsValid()
+//
+func (astroEventType AstronomicalEventType) XIsValid() bool {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	if astroEventType > 13 ||
+		astroEventType < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of AstronomicalEventType is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'fullmoon' will NOT
+//                        match the enumeration name, 'FullMoon'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'fullmoon'
+//                        will match match enumeration name 'FullMoon'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// AstronomicalEventType - Upon successful completion, this method will return
+//                         a new instance of AstronomicalEventType set to the value
+//                         of the enumeration matched by the string search performed
+//                         on input parameter, 'valueString'.
+//
+// error                 - If this method completes successfully, the returned error
+//                         Type is set equal to 'nil'. If an error condition is
+//                         encountered, this method will return an error type which
+//                         encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := AstronomicalEventType(0).XParseString("FullMoon", true)
+//
+//     t is now equal to AstronomicalEventType(0).FullMoon()
+//
+func (astroEventType AstronomicalEventType) XParseString(
+	valueString string,
+	caseSensitive bool) (AstronomicalEventType, error) {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	ePrefix := "AstronomicalEventType.XParseString() "
+
+	if len(valueString) < 4 {
+		return AstronomicalEventType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var eventType AstronomicalEventType
+
+	if caseSensitive {
+
+		eventType, ok = mAstronomicalEventTypeStringToCode[valueString]
+
+	} else {
+
+		eventType, ok =
+			mAstronomicalEventTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return AstronomicalEventType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid AstronomicalEventType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return eventType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// AstronomicalEventType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (astroEventType AstronomicalEventType) XValue() AstronomicalEventType {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return astroEventType
+}
+
+// XValueInt - This method returns the integer value of the current
+// AstronomicalEventType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (astroEventType AstronomicalEventType) XValueInt() int {
+
+	lockAstronomicalEventType.Lock()
+
+	defer lockAstronomicalEventType.Unlock()
+
+	return int(astroEventType)
+}
+
+// AstroEventType - public global variable of
+// type AstronomicalEventType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing AstronomicalEventType values.
+//
+// Usage:
+// AstroEventType.None(),
+// AstroEventType.MarchEquinox(),
+// AstroEventType.JuneSolstice(),
+// AstroEventType.SeptemberEquinox(),
+// AstroEventType.DecemberSolstice(),
+// AstroEventType.NewMoon(),
+// AstroEventType.FirstQuarter(),
+// AstroEventType.FullMoon(),
+// AstroEventType.LastQuarter(),
+// AstroEventType.CivilDawn(),
+// AstroEventType.Sunrise(),
+// AstroEventType.SolarNoon(),
+// AstroEventType.Sunset(),
+// AstroEventType.CivilDusk(),
+//
+var AstroEventType AstronomicalEventType
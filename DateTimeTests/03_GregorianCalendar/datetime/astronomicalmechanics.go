@@ -0,0 +1,762 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// Julian Day Number/Time constants used in astronomical calculations.
+const (
+	astroJ2000JulianDay     = 2451545.0 // 2000-01-01 12:00:00 TT
+	astroUnixEpochJulianDay = 2440587.5 // 1970-01-01 00:00:00 UTC
+	astroSiderealDegPerDay  = 360.98564736629
+	astroMinimumYear        = -1000
+	astroMaximumYear        = 3000
+)
+
+// astroEquinoxMeanTerms - Coefficients of the polynomials used to
+// compute the mean instants of the equinoxes and solstices. Indexed
+// by AstronomicalEventType MarchEquinox (1) through DecemberSolstice
+// (4). Reference: Meeus, Astronomical Algorithms, 2nd Ed., Table 27.A
+// (years -1000 to +1000) and Table 27.B (years +1000 to +3000).
+var astroEquinoxMeanTerms = map[AstronomicalEventType][2][5]float64{
+	AstronomicalEventType(1): {
+		{1721139.29189, 365242.13740, 0.06134, 0.00111, -0.00071},
+		{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057}},
+	AstronomicalEventType(2): {
+		{1721233.25401, 365241.72562, -0.05323, 0.00907, 0.00025},
+		{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030}},
+	AstronomicalEventType(3): {
+		{1721325.70455, 365242.49558, -0.11677, -0.00297, 0.00074},
+		{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078}},
+	AstronomicalEventType(4): {
+		{1721414.39987, 365242.88257, -0.00769, -0.00933, -0.00006},
+		{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032}},
+}
+
+// astroEquinoxPeriodicTerms - The periodic terms A, B and C applied
+// to the mean instants of the equinoxes and solstices. Reference:
+// Meeus, Astronomical Algorithms, 2nd Ed., Table 27.C.
+var astroEquinoxPeriodicTerms = [24][3]float64{
+	{485, 324.96, 1934.136},
+	{203, 337.23, 32964.467},
+	{199, 342.08, 20.186},
+	{182, 27.85, 445267.112},
+	{156, 73.14, 45036.886},
+	{136, 171.52, 22518.443},
+	{77, 222.54, 65928.934},
+	{74, 296.72, 3034.906},
+	{70, 243.58, 9037.513},
+	{58, 119.81, 33718.147},
+	{52, 297.17, 150.678},
+	{50, 21.02, 2281.226},
+	{45, 247.54, 29929.562},
+	{44, 325.15, 31555.956},
+	{29, 60.93, 4443.417},
+	{18, 155.12, 67555.328},
+	{17, 288.79, 4562.452},
+	{16, 198.04, 62894.029},
+	{14, 199.76, 31436.921},
+	{12, 95.39, 14577.848},
+	{12, 287.11, 31931.756},
+	{12, 320.81, 34777.259},
+	{9, 227.73, 1222.114},
+	{8, 15.45, 16859.074},
+}
+
+// astroMoonPlanetaryTerms - The coefficient, constant term and
+// coefficient of 'k' for the fourteen planetary arguments A1 through
+// A14 applied to the instants of the Moon's phases. The argument A1
+// includes an additional term in T squared. Reference: Meeus,
+// Astronomical Algorithms, 2nd Ed., Chapter 49.
+var astroMoonPlanetaryTerms = [14][3]float64{
+	{0.000325, 299.77, 0.107408},
+	{0.000165, 251.88, 0.016321},
+	{0.000164, 251.83, 26.651886},
+	{0.000126, 349.42, 36.412478},
+	{0.000110, 84.66, 18.206239},
+	{0.000062, 141.74, 53.303771},
+	{0.000060, 207.14, 2.453732},
+	{0.000056, 154.84, 7.306860},
+	{0.000047, 34.52, 27.261239},
+	{0.000042, 207.19, 0.121824},
+	{0.000040, 291.34, 1.844379},
+	{0.000037, 161.72, 24.198154},
+	{0.000035, 239.56, 25.513099},
+	{0.000023, 331.55, 3.592518},
+}
+
+// astronomicalMechanics - Provides helper methods used to compute
+// the positions of the Sun and the instants of solar and lunar
+// events. Unless otherwise noted, the algorithms are taken from Jean
+// Meeus, Astronomical Algorithms, 2nd Edition, Willmann-Bell, 1998.
+//
+// Angles are expressed in degrees. Julian Day Number/Time values are
+// expressed as float64 values on either the Terrestrial Time (TT) or
+// the Universal Time (UT) time scale as noted.
+//
+type astronomicalMechanics struct {
+	lock *sync.Mutex
+}
+
+// getDeltaT - Returns an estimate of Delta T, the difference in
+// seconds between Terrestrial Time (TT) and Universal Time (UT), for
+// the Julian Day Number/Time 'julianDay'.
+//
+// Delta T is computed with the polynomial expressions of Espenak and
+// Meeus. Reference:
+//   https://eclipse.gsfc.nasa.gov/SEhelp/deltatpoly2004.html
+//
+func (astroMech *astronomicalMechanics) getDeltaT(
+	julianDay float64) float64 {
+
+	y := 2000.0 + (julianDay-astroJ2000JulianDay)/365.25
+
+	var t, u float64
+
+	switch {
+
+	case y < -500 || y >= 2150:
+		u = (y - 1820) / 100
+		return -20 + 32*u*u
+
+	case y < 500:
+		u = y / 100
+		return 10583.6 + u*(-1014.41+u*(33.78311+u*(-5.952053+
+			u*(-0.1798452+u*(0.022174192+u*0.0090316521)))))
+
+	case y < 1600:
+		u = (y - 1000) / 100
+		return 1574.2 + u*(-556.01+u*(71.23472+u*(0.319781+
+			u*(-0.8503463+u*(-0.005050998+u*0.0083572073)))))
+
+	case y < 1700:
+		t = y - 1600
+		return 120 - 0.9808*t - 0.01532*t*t + t*t*t/7129
+
+	case y < 1800:
+		t = y - 1700
+		return 8.83 + 0.1603*t - 0.0059285*t*t + 0.00013336*t*t*t -
+			t*t*t*t/1174000
+
+	case y < 1860:
+		t = y - 1800
+		return 13.72 + t*(-0.332447+t*(0.0068612+t*(0.0041116+
+			t*(-0.00037436+t*(0.0000121272+t*(-0.0000001699+
+				t*0.000000000875))))))
+
+	case y < 1900:
+		t = y - 1860
+		return 7.62 + t*(0.5737+t*(-0.251754+t*(0.01680668+
+			t*(-0.0004473624+t/233174))))
+
+	case y < 1920:
+		t = y - 1900
+		return -2.79 + t*(1.494119+t*(-0.0598939+t*(0.0061966-
+			t*0.000197)))
+
+	case y < 1941:
+		t = y - 1920
+		return 21.20 + t*(0.84493+t*(-0.076100+t*0.0020936))
+
+	case y < 1961:
+		t = y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+
+	case y < 1986:
+		t = y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+
+	case y < 2005:
+		t = y - 2000
+		return 63.86 + t*(0.3345+t*(-0.060374+t*(0.0017275+
+			t*(0.000651814+t*0.00002373599))))
+
+	case y < 2050:
+		t = y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+
+	default:
+		u = (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+}
+
+// getEquinoxSolstice - Returns the Julian Day Number/Time, on the
+// Terrestrial Time (TT) time scale, of the equinox or solstice
+// designated by 'eventType' during astronomical year 'year'.
+//
+// The result is accurate to within about one minute for the years
+// 1951 through 2050. 'year' must fall within the range -1000 through
+// 3000.
+//
+// Reference: Meeus, Astronomical Algorithms, 2nd Ed., Chapter 27.
+//
+func (astroMech *astronomicalMechanics) getEquinoxSolstice(
+	year int64,
+	eventType AstronomicalEventType,
+	ePrefix string) (
+	float64,
+	error) {
+
+	ePrefix += "astronomicalMechanics.getEquinoxSolstice() "
+
+	meanTerms, ok := astroEquinoxMeanTerms[eventType]
+
+	if !ok {
+		return 0.0, fmt.Errorf(ePrefix+"\n"+
+			"Error: Input parameter 'eventType' is not an equinox or solstice!\n"+
+			"eventType='%v'\n", eventType.String())
+	}
+
+	if year < astroMinimumYear || year > astroMaximumYear {
+		return 0.0, &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "year",
+			inputParameterValue: fmt.Sprintf("%v", year),
+			errMsg: fmt.Sprintf("'year' must be within the range %v through %v.",
+				astroMinimumYear, astroMaximumYear),
+			err: nil,
+		}
+	}
+
+	coefficients := meanTerms[0]
+	y := float64(year) / 1000.0
+
+	if year >= 1000 {
+		coefficients = meanTerms[1]
+		y = (float64(year) - 2000.0) / 1000.0
+	}
+
+	jde0 := coefficients[0] + y*(coefficients[1]+y*(coefficients[2]+
+		y*(coefficients[3]+y*coefficients[4])))
+
+	t := (jde0 - astroJ2000JulianDay) / 36525.0
+
+	astroMech2 := astronomicalMechanics{}
+
+	w := astroMech2.toRadians(35999.373*t - 2.47)
+
+	deltaLambda := 1.0 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+
+	s := 0.0
+
+	for _, term := range astroEquinoxPeriodicTerms {
+		s += term[0] * math.Cos(astroMech2.toRadians(term[1]+term[2]*t))
+	}
+
+	return jde0 + 0.00001*s/deltaLambda, nil
+}
+
+// getGreenwichSiderealTime - Returns the Greenwich mean sidereal
+// time, in degrees, for the Universal Time (UT) Julian Day
+// Number/Time 'julianDayUt'.
+//
+// Reference: Meeus, Astronomical Algorithms, 2nd Ed., Formula 12.4.
+//
+func (astroMech *astronomicalMechanics) getGreenwichSiderealTime(
+	julianDayUt float64) float64 {
+
+	d := julianDayUt - astroJ2000JulianDay
+	t := d / 36525.0
+
+	astroMech2 := astronomicalMechanics{}
+
+	return astroMech2.normalizeDegrees(
+		280.46061837 + astroSiderealDegPerDay*d + t*t*(0.000387933-t/38710000.0))
+}
+
+// getMoonPhase - Returns the Julian Day Number/Time, on the
+// Terrestrial Time (TT) time scale, of the Moon phase designated by
+// 'eventType' for lunation 'lunation'. Lunation zero (0) is the New
+// Moon of January 6, 2000. Negative lunations precede that date.
+//
+// The result is accurate to within about one minute.
+//
+// Reference: Meeus, Astronomical Algorithms, 2nd Ed., Chapter 49.
+//
+func (astroMech *astronomicalMechanics) getMoonPhase(
+	lunation int64,
+	eventType AstronomicalEventType,
+	ePrefix string) (
+	float64,
+	error) {
+
+	ePrefix += "astronomicalMechanics.getMoonPhase() "
+
+	k := float64(lunation)
+
+	switch eventType {
+	case AstronomicalEventType(0).NewMoon():
+	case AstronomicalEventType(0).FirstQuarter():
+		k += 0.25
+	case AstronomicalEventType(0).FullMoon():
+		k += 0.50
+	case AstronomicalEventType(0).LastQuarter():
+		k += 0.75
+	default:
+		return 0.0, fmt.Errorf(ePrefix+"\n"+
+			"Error: Input parameter 'eventType' is not a Moon phase!\n"+
+			"eventType='%v'\n", eventType.String())
+	}
+
+	t := k / 1236.85
+	t2 := t * t
+	t3 := t2 * t
+	t4 := t3 * t
+
+	jde := 2451550.09766 + 29.530588861*k + 0.00015437*t2 -
+		0.000000150*t3 + 0.00000000073*t4
+
+	astroMech2 := astronomicalMechanics{}
+
+	rad := astroMech2.toRadians
+
+	e := 1.0 - 0.002516*t - 0.0000074*t2
+
+	// Sun's mean anomaly
+	m := rad(2.5534 + 29.10535670*k - 0.0000014*t2 - 0.00000011*t3)
+
+	// Moon's mean anomaly
+	mp := rad(201.5643 + 385.81693528*k + 0.0107582*t2 + 0.00001238*t3 -
+		0.000000058*t4)
+
+	// Moon's argument of latitude
+	f := rad(160.7108 + 390.67050284*k - 0.0016118*t2 - 0.00000227*t3 +
+		0.000000011*t4)
+
+	// Longitude of the ascending node of the lunar orbit
+	omega := rad(124.7746 - 1.56375588*k + 0.0020672*t2 + 0.00000215*t3)
+
+	sin := math.Sin
+
+	var correction float64
+
+	switch eventType {
+
+	case AstronomicalEventType(0).NewMoon(),
+		AstronomicalEventType(0).FullMoon():
+
+		coefficients := [7]float64{
+			-0.40720, 0.17241, 0.01608, 0.01039, 0.00739, -0.00514, 0.00208}
+
+		if eventType == AstronomicalEventType(0).FullMoon() {
+			coefficients = [7]float64{
+				-0.40614, 0.17302, 0.01614, 0.01043, 0.00734, -0.00515, 0.00209}
+		}
+
+		correction = coefficients[0]*sin(mp) +
+			coefficients[1]*e*sin(m) +
+			coefficients[2]*sin(2*mp) +
+			coefficients[3]*sin(2*f) +
+			coefficients[4]*e*sin(mp-m) +
+			coefficients[5]*e*sin(mp+m) +
+			coefficients[6]*e*e*sin(2*m) -
+			0.00111*sin(mp-2*f) -
+			0.00057*sin(mp+2*f) +
+			0.00056*e*sin(2*mp+m) -
+			0.00042*sin(3*mp) +
+			0.00042*e*sin(m+2*f) +
+			0.00038*e*sin(m-2*f) -
+			0.00024*e*sin(2*mp-m) -
+			0.00017*sin(omega) -
+			0.00007*sin(mp+2*m) +
+			0.00004*sin(2*mp-2*f) +
+			0.00004*sin(3*m) +
+			0.00003*sin(mp+m-2*f) +
+			0.00003*sin(2*mp+2*f) -
+			0.00003*sin(mp+m+2*f) +
+			0.00003*sin(mp-m+2*f) -
+			0.00002*sin(mp-m-2*f) -
+			0.00002*sin(3*mp+m) +
+			0.00002*sin(4*mp)
+
+	default:
+
+		correction = -0.62801*sin(mp) +
+			0.17172*e*sin(m) -
+			0.01183*e*sin(mp+m) +
+			0.00862*sin(2*mp) +
+			0.00804*sin(2*f) +
+			0.00454*e*sin(mp-m) +
+			0.00204*e*e*sin(2*m) -
+			0.00180*sin(mp-2*f) -
+			0.00070*sin(mp+2*f) -
+			0.00040*sin(3*mp) -
+			0.00034*e*sin(2*mp-m) +
+			0.00032*e*sin(m+2*f) +
+			0.00032*e*sin(m-2*f) -
+			0.00028*e*e*sin(mp+2*m) +
+			0.00027*e*sin(2*mp+m) -
+			0.00017*sin(omega) -
+			0.00005*sin(mp-m-2*f) +
+			0.00004*sin(2*mp+2*f) -
+			0.00004*sin(mp+m+2*f) +
+			0.00004*sin(mp-2*m) +
+			0.00003*sin(mp+m-2*f) +
+			0.00003*sin(3*m) +
+			0.00002*sin(2*mp-2*f) +
+			0.00002*sin(mp-m+2*f) -
+			0.00002*sin(3*mp+m)
+
+		w := 0.00306 - 0.00038*e*math.Cos(m) + 0.00026*math.Cos(mp) -
+			0.00002*math.Cos(mp-m) + 0.00002*math.Cos(mp+m) +
+			0.00002*math.Cos(2*f)
+
+		if eventType == AstronomicalEventType(0).FirstQuarter() {
+			correction += w
+		} else {
+			correction -= w
+		}
+	}
+
+	for i, term := range astroMoonPlanetaryTerms {
+
+		argument := term[1] + term[2]*k
+
+		if i == 0 {
+			argument -= 0.009173 * t2
+		}
+
+		correction += term[0] * sin(rad(argument))
+	}
+
+	return jde + correction, nil
+}
+
+// getSolarCoordinates - Returns the apparent geocentric coordinates
+// of the Sun for the Terrestrial Time (TT) Julian Day Number/Time
+// 'julianDayTt'. The equation of time is returned in minutes.
+//
+// The results are accurate to about 0.01 degrees.
+//
+// Reference: Meeus, Astronomical Algorithms, 2nd Ed., Chapters 25
+// and 28.
+//
+func (astroMech *astronomicalMechanics) getSolarCoordinates(
+	julianDayTt float64) (
+	apparentLongitude float64,
+	rightAscension float64,
+	declination float64,
+	equationOfTime float64) {
+
+	astroMech2 := astronomicalMechanics{}
+
+	rad := astroMech2.toRadians
+	deg := astroMech2.toDegrees
+
+	t := (julianDayTt - astroJ2000JulianDay) / 36525.0
+
+	// Geometric mean longitude of the Sun
+	l0 := astroMech2.normalizeDegrees(280.46646 + t*(36000.76983+t*0.0003032))
+
+	// Mean anomaly of the Sun
+	m := rad(357.52911 + t*(35999.05029-t*0.0001537))
+
+	// Eccentricity of the Earth's orbit
+	e := 0.016708634 - t*(0.000042037+t*0.0000001267)
+
+	// Equation of the center
+	c := math.Sin(m)*(1.914602-t*(0.004817+t*0.000014)) +
+		math.Sin(2*m)*(0.019993-t*0.000101) +
+		math.Sin(3*m)*0.000289
+
+	omega := rad(125.04 - 1934.136*t)
+
+	apparentLongitude = astroMech2.normalizeDegrees(
+		l0 + c - 0.00569 - 0.00478*math.Sin(omega))
+
+	meanObliquity := 23.0 + (26.0+(21.448-t*(46.815+t*(0.00059-t*0.001813)))/60.0)/60.0
+
+	obliquity := rad(meanObliquity + 0.00256*math.Cos(omega))
+
+	lambda := rad(apparentLongitude)
+
+	rightAscension = astroMech2.normalizeDegrees(
+		deg(math.Atan2(math.Cos(obliquity)*math.Sin(lambda), math.Cos(lambda))))
+
+	declination = deg(math.Asin(math.Sin(obliquity) * math.Sin(lambda)))
+
+	y := math.Tan(obliquity / 2)
+	y *= y
+
+	l0Rad := rad(l0)
+
+	equationOfTime = 4.0 * deg(y*math.Sin(2*l0Rad)-
+		2*e*math.Sin(m)+
+		4*e*y*math.Sin(m)*math.Cos(2*l0Rad)-
+		0.5*y*y*math.Sin(4*l0Rad)-
+		1.25*e*e*math.Sin(2*m))
+
+	return apparentLongitude, rightAscension, declination, equationOfTime
+}
+
+// getSolarHourAngle - Returns the local hour angle of the Sun, in
+// degrees within the range -180 through +180, together with the
+// declination of the Sun for the Universal Time (UT) Julian Day
+// Number/Time 'julianDayUt' at geographic longitude 'longitude'.
+// Longitudes east of Greenwich are positive.
+//
+func (astroMech *astronomicalMechanics) getSolarHourAngle(
+	julianDayUt float64,
+	longitude float64) (
+	hourAngle float64,
+	declination float64) {
+
+	astroMech2 := astronomicalMechanics{}
+
+	julianDayTt := julianDayUt + astroMech2.getDeltaT(julianDayUt)/86400.0
+
+	_, rightAscension, declination, _ :=
+		astroMech2.getSolarCoordinates(julianDayTt)
+
+	hourAngle = astroMech2.normalizeDegrees(
+		astroMech2.getGreenwichSiderealTime(julianDayUt) + longitude - rightAscension)
+
+	if hourAngle > 180.0 {
+		hourAngle -= 360.0
+	}
+
+	return hourAngle, declination
+}
+
+// getSunAltitudeCrossing - Returns the Universal Time (UT) Julian Day
+// Number/Time at which the center of the Sun reaches altitude
+// 'altitude' during the morning, if 'isRising' is 'true', or during
+// the evening, if 'isRising' is 'false'. 'julianDayTransit' is the
+// instant of the solar transit which the crossing accompanies.
+//
+// If the Sun does not reach altitude 'altitude' on that day, as
+// occurs during the polar day or polar night, 'isFound' is 'false'.
+//
+func (astroMech *astronomicalMechanics) getSunAltitudeCrossing(
+	julianDayTransit float64,
+	latitude float64,
+	longitude float64,
+	altitude float64,
+	isRising bool) (
+	julianDayUt float64,
+	isFound bool) {
+
+	astroMech2 := astronomicalMechanics{}
+
+	rad := astroMech2.toRadians
+
+	sign := 1.0
+
+	if isRising {
+		sign = -1.0
+	}
+
+	julianDayUt = julianDayTransit
+
+	phi := rad(latitude)
+
+	for i := 0; i < 6; i++ {
+
+		hourAngle, declination := astroMech2.getSolarHourAngle(julianDayUt, longitude)
+
+		delta := rad(declination)
+
+		cosH0 := (math.Sin(rad(altitude)) - math.Sin(phi)*math.Sin(delta)) /
+			(math.Cos(phi) * math.Cos(delta))
+
+		if cosH0 < -1.0 || cosH0 > 1.0 {
+			return julianDayTransit, false
+		}
+
+		targetHourAngle := sign * astroMech2.toDegrees(math.Acos(cosH0))
+
+		if i == 0 {
+			// Start from the transit.
+			hourAngle = 0.0
+		}
+
+		julianDayUt += (targetHourAngle - hourAngle) / astroSiderealDegPerDay
+	}
+
+	return julianDayUt, true
+}
+
+// getSunTransit - Returns the Universal Time (UT) Julian Day
+// Number/Time of the solar transit, or solar noon, nearest the
+// Universal Time Julian Day Number/Time 'julianDayUt' at geographic
+// longitude 'longitude'.
+//
+func (astroMech *astronomicalMechanics) getSunTransit(
+	julianDayUt float64,
+	longitude float64) float64 {
+
+	astroMech2 := astronomicalMechanics{}
+
+	for i := 0; i < 4; i++ {
+
+		hourAngle, _ := astroMech2.getSolarHourAngle(julianDayUt, longitude)
+
+		julianDayUt -= hourAngle / astroSiderealDegPerDay
+	}
+
+	return julianDayUt
+}
+
+// julianDayToTime - Converts a Universal Time (UT) Julian Day
+// Number/Time to a UTC time.Time value rounded to the nearest second.
+//
+func (astroMech *astronomicalMechanics) julianDayToTime(
+	julianDayUt float64) time.Time {
+
+	seconds := math.Round((julianDayUt - astroUnixEpochJulianDay) * 86400.0)
+
+	return time.Unix(int64(seconds), 0).UTC()
+}
+
+// newAstronomicalEvent - Creates a new AstronomicalEventDto for the
+// event 'eventType' occurring at Universal Time (UT) Julian Day
+// Number/Time 'julianDayUt'. The event date time is converted to the
+// time zone specified by 'timeZoneDef'.
+//
+func (astroMech *astronomicalMechanics) newAstronomicalEvent(
+	eventType AstronomicalEventType,
+	julianDayUt float64,
+	timeZoneDef TimeZoneDefinition,
+	ePrefix string) (
+	AstronomicalEventDto,
+	error) {
+
+	ePrefix += "astronomicalMechanics.newAstronomicalEvent() "
+
+	astroMech2 := astronomicalMechanics{}
+
+	eventTimeUtc := astroMech2.julianDayToTime(julianDayUt)
+
+	_, julianDayNo, err := JulianDayNoDto{}.NewFromGregorianDate(
+		eventTimeUtc,
+		ePrefix)
+
+	if err != nil {
+		return AstronomicalEventDto{}, err
+	}
+
+	eventDateTime := DateTzDto{}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err = dTzUtil.setFromTzDef(
+		&eventDateTime,
+		eventTimeUtc,
+		timeZoneDef,
+		TzConvertType.Relative(),
+		FmtDateTimeYrMDayFmtStr,
+		ePrefix)
+
+	if err != nil {
+		return AstronomicalEventDto{}, err
+	}
+
+	newEvent := AstronomicalEventDto{
+		eventType:   eventType,
+		julianDayNo: julianDayNo,
+		dateTime:    eventDateTime,
+		lock:        new(sync.Mutex),
+	}
+
+	return newEvent, nil
+}
+
+// normalizeDegrees - Reduces an angle to the range 0 through 360
+// degrees.
+//
+func (astroMech *astronomicalMechanics) normalizeDegrees(
+	degrees float64) float64 {
+
+	degrees = math.Mod(degrees, 360.0)
+
+	if degrees < 0 {
+		degrees += 360.0
+	}
+
+	return degrees
+}
+
+// testLatitudeLongitude - Returns an error if 'latitude' does not
+// fall within the range -90 through +90 degrees or 'longitude' does
+// not fall within the range -180 through +180 degrees.
+//
+func (astroMech *astronomicalMechanics) testLatitudeLongitude(
+	latitude float64,
+	longitude float64,
+	ePrefix string) error {
+
+	if math.IsNaN(latitude) || latitude < -90.0 || latitude > 90.0 {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "latitude",
+			inputParameterValue: fmt.Sprintf("%v", latitude),
+			errMsg:              "'latitude' must be within the range -90 through +90 degrees.",
+			err:                 nil,
+		}
+	}
+
+	if math.IsNaN(longitude) || longitude < -180.0 || longitude > 180.0 {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "longitude",
+			inputParameterValue: fmt.Sprintf("%v", longitude),
+			errMsg:              "'longitude' must be within the range -180 through +180 degrees.",
+			err:                 nil,
+		}
+	}
+
+	return nil
+}
+
+// testTimeZoneDef - Returns an error if 'timeZoneDef' is invalid.
+//
+func (astroMech *astronomicalMechanics) testTimeZoneDef(
+	timeZoneDef *TimeZoneDefinition,
+	ePrefix string) error {
+
+	if timeZoneDef.IsEmpty() {
+		return errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'timeZoneDef' is empty!\n")
+	}
+
+	err := timeZoneDef.IsValid()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: Input parameter 'timeZoneDef' is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	return nil
+}
+
+// timeToJulianDay - Converts a time.Time value to a Universal Time
+// (UT) Julian Day Number/Time.
+//
+func (astroMech *astronomicalMechanics) timeToJulianDay(
+	dateTime time.Time) float64 {
+
+	return astroUnixEpochJulianDay +
+		(float64(dateTime.Unix())+float64(dateTime.Nanosecond())/1e9)/86400.0
+}
+
+// toDegrees - Converts an angle expressed in radians to degrees.
+//
+func (astroMech *astronomicalMechanics) toDegrees(
+	radians float64) float64 {
+
+	return radians * 180.0 / math.Pi
+}
+
+// toRadians - Converts an angle expressed in degrees to radians.
+//
+func (astroMech *astronomicalMechanics) toRadians(
+	degrees float64) float64 {
+
+	return degrees * math.Pi / 180.0
+}
@@ -0,0 +1,283 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// BusinessDayCalendar - Defines the days which qualify as business
+// days for purposes of business day arithmetic. A business day is
+// any day which is neither a weekend day nor a holiday.
+//
+// The weekend days are configured as a weekend mask expressed in
+// either the ISO 8601 or the US Day Of The Week Numbering System.
+// Reference type 'DayOfWeekNumberingSystemType'.
+//
+//   ISO 8601 Day Of Week: Monday=1, Tuesday=2 ... Sunday=7
+//   US Day Of Week:       Sunday=0, Monday=1 ... Saturday=6
+//
+// Optionally, a holiday source implementing the IHolidaySource
+// interface may be supplied. A HolidayCalendar is one such source.
+// If the holiday source is 'nil', only weekend days are excluded.
+//
+// BusinessDayCalendar is used by the DateTzDto methods
+// AddBusinessDays(), BusinessDaysBetween(), NextBusinessDay()
+// and PreviousBusinessDay().
+//
+type BusinessDayCalendar struct {
+	weekendMask   [8]bool        // Indexed by ISO 8601 day of week number. Element zero is unused.
+	holidaySource IHolidaySource // Optional holiday source. May be 'nil'.
+	lock          *sync.Mutex
+}
+
+// GetHolidaySource - Returns the holiday source configured for
+// this BusinessDayCalendar. The returned value may be 'nil'.
+//
+func (busDayCal *BusinessDayCalendar) GetHolidaySource() IHolidaySource {
+
+	if busDayCal.lock == nil {
+		busDayCal.lock = new(sync.Mutex)
+	}
+
+	busDayCal.lock.Lock()
+
+	defer busDayCal.lock.Unlock()
+
+	return busDayCal.holidaySource
+}
+
+// GetWeekendDays - Returns the weekend days configured for this
+// BusinessDayCalendar expressed in the Day Of The Week Numbering
+// System specified by input parameter 'dayOfWeekNoSys'.
+//
+func (busDayCal *BusinessDayCalendar) GetWeekendDays(
+	dayOfWeekNoSys DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	weekendDays []int,
+	err error) {
+
+	if busDayCal.lock == nil {
+		busDayCal.lock = new(sync.Mutex)
+	}
+
+	busDayCal.lock.Lock()
+
+	defer busDayCal.lock.Unlock()
+
+	ePrefix += "BusinessDayCalendar.GetWeekendDays() "
+
+	weekendDays = make([]int, 0, 7)
+
+	switch dayOfWeekNoSys {
+
+	case DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek():
+
+		for i := 1; i < 8; i++ {
+			if busDayCal.weekendMask[i] {
+				weekendDays = append(weekendDays, i)
+			}
+		}
+
+	case DayOfWeekNumberingSystemType(0).UsDayOfWeek():
+
+		// US Sunday (0) is ISO Sunday (7)
+		if busDayCal.weekendMask[7] {
+			weekendDays = append(weekendDays, 0)
+		}
+
+		for i := 1; i < 7; i++ {
+			if busDayCal.weekendMask[i] {
+				weekendDays = append(weekendDays, i)
+			}
+		}
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'dayOfWeekNoSys' is INVALID!\n" +
+			"dayOfWeekNoSys='%v'\n",
+			dayOfWeekNoSys.XValueInt())
+	}
+
+	return weekendDays, err
+}
+
+// IsWeekendDay - Returns 'true' if the ISO 8601 day of the week
+// number passed as an input parameter is configured as a weekend
+// day.
+//
+func (busDayCal *BusinessDayCalendar) IsWeekendDay(
+	isoDayOfWeekNo ISO8601DayOfWeekNo) bool {
+
+	if busDayCal.lock == nil {
+		busDayCal.lock = new(sync.Mutex)
+	}
+
+	busDayCal.lock.Lock()
+
+	defer busDayCal.lock.Unlock()
+
+	if !isoDayOfWeekNo.XIsValid() {
+		return false
+	}
+
+	return busDayCal.weekendMask[int(isoDayOfWeekNo)]
+}
+
+// New - Creates and returns a new BusinessDayCalendar instance.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dayOfWeekNoSys      DayOfWeekNumberingSystemType
+//     - Specifies the Day Of The Week Numbering System used to
+//       interpret input parameter 'weekendDays'. Valid values are:
+//         DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek()
+//         DayOfWeekNumberingSystemType(0).UsDayOfWeek()
+//
+//
+//  weekendDays         []int
+//     - The day of the week numbers which are weekend days and
+//       therefore, not business days. Example: ISO 8601 Saturday
+//       and Sunday are specified as []int{6, 7}. The equivalent US
+//       day numbers are []int{6, 0}. At least one day of the week
+//       must remain a business day.
+//
+//
+//  holidaySource       IHolidaySource
+//     - An optional source of holiday dates. Holidays are not
+//       business days. If this parameter is 'nil', only weekend
+//       days are excluded from business days.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  BusinessDayCalendar
+//     - If successful, this method returns a new, populated instance
+//       of BusinessDayCalendar.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (busDayCal BusinessDayCalendar) New(
+	dayOfWeekNoSys DayOfWeekNumberingSystemType,
+	weekendDays []int,
+	holidaySource IHolidaySource,
+	ePrefix string) (
+	BusinessDayCalendar,
+	error) {
+
+	if busDayCal.lock == nil {
+		busDayCal.lock = new(sync.Mutex)
+	}
+
+	busDayCal.lock.Lock()
+
+	defer busDayCal.lock.Unlock()
+
+	ePrefix += "BusinessDayCalendar.New() "
+
+	newBusDayCal := BusinessDayCalendar{
+		holidaySource: holidaySource,
+		lock:          new(sync.Mutex),
+	}
+
+	for i := 0; i < len(weekendDays); i++ {
+
+		var isoDayOfWeekNo ISO8601DayOfWeekNo
+
+		switch dayOfWeekNoSys {
+
+		case DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek():
+
+			isoDayOfWeekNo = ISO8601DayOfWeekNo(weekendDays[i])
+
+			if !isoDayOfWeekNo.XIsValid() {
+				return BusinessDayCalendar{},
+					fmt.Errorf(ePrefix + "\n" +
+						"Error: 'weekendDays' contains an INVALID ISO 8601 day number!\n" +
+						"weekendDays[%v]='%v'\n",
+						i, weekendDays[i])
+			}
+
+		case DayOfWeekNumberingSystemType(0).UsDayOfWeek():
+
+			var err error
+
+			isoDayOfWeekNo, err =
+				UsDayOfWeekNo(weekendDays[i]).XISO8601DayOfWeekNumber(ePrefix)
+
+			if err != nil {
+				return BusinessDayCalendar{}, err
+			}
+
+		default:
+			return BusinessDayCalendar{},
+				fmt.Errorf(ePrefix + "\n" +
+					"Error: Input parameter 'dayOfWeekNoSys' is INVALID!\n" +
+					"dayOfWeekNoSys='%v'\n",
+					dayOfWeekNoSys.XValueInt())
+		}
+
+		newBusDayCal.weekendMask[int(isoDayOfWeekNo)] = true
+	}
+
+	hasBusinessDay := false
+
+	for i := 1; i < 8; i++ {
+		if !newBusDayCal.weekendMask[i] {
+			hasBusinessDay = true
+			break
+		}
+	}
+
+	if !hasBusinessDay {
+		return BusinessDayCalendar{},
+			errors.New(ePrefix + "\n" +
+				"Error: Input parameter 'weekendDays' includes all seven\n" +
+				"days of the week. At least one business day is required!\n")
+	}
+
+	return newBusDayCal, nil
+}
+
+// NewStandardWeekend - Creates and returns a new BusinessDayCalendar
+// instance with a standard Saturday and Sunday weekend. Input
+// parameter 'holidaySource' is optional and may be 'nil'.
+//
+func (busDayCal BusinessDayCalendar) NewStandardWeekend(
+	holidaySource IHolidaySource) BusinessDayCalendar {
+
+	if busDayCal.lock == nil {
+		busDayCal.lock = new(sync.Mutex)
+	}
+
+	busDayCal.lock.Lock()
+
+	defer busDayCal.lock.Unlock()
+
+	newBusDayCal := BusinessDayCalendar{
+		holidaySource: holidaySource,
+		lock:          new(sync.Mutex),
+	}
+
+	newBusDayCal.weekendMask[int(ISO8601DayOfWeekNo(0).Saturday())] = true
+	newBusDayCal.weekendMask[int(ISO8601DayOfWeekNo(0).Sunday())] = true
+
+	return newBusDayCal
+}
@@ -0,0 +1,408 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// businessDayMechanics - Provides helper methods used to perform
+// business day arithmetic on DateTzDto instances.
+//
+// All calculations are performed on local calendar dates in the
+// time zone of the DateTzDto instance. Candidate dates are
+// examined at 12:00:00 (noon) local time which avoids the
+// gaps and overlaps created by Daylight Saving Time transitions.
+// Results retain the original local time of day. If that time of
+// day does not exist on the resulting date because of a Daylight
+// Saving Time transition, the time value is normalized by the Go
+// 'time' package while the local date is preserved.
+//
+type businessDayMechanics struct {
+	lock *sync.Mutex
+}
+
+// addBusinessDays - Adds, or subtracts, a number of business days
+// to the date of a DateTzDto instance and returns the result as a
+// new DateTzDto instance. Negative values for 'businessDays'
+// move backward in time.
+//
+// If 'businessDays' is zero, the returned DateTzDto instance is
+// a copy of the original.
+//
+func (busDayMech *businessDayMechanics) addBusinessDays(
+	dTz *DateTzDto,
+	businessDays int,
+	busDayCal *BusinessDayCalendar,
+	dateTimeFmtStr string,
+	ePrefix string) (
+	DateTzDto,
+	error) {
+
+	if busDayMech.lock == nil {
+		busDayMech.lock = new(sync.Mutex)
+	}
+
+	busDayMech.lock.Lock()
+
+	defer busDayMech.lock.Unlock()
+
+	ePrefix += "businessDayMechanics.addBusinessDays() "
+
+	busDayMech2 := businessDayMechanics{}
+
+	err := busDayMech2.testInputValidity(dTz, busDayCal, ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	if dateTimeFmtStr == "" {
+		dateTimeFmtStr = dTz.dateTimeFmt
+	}
+
+	step := 1
+	remaining := businessDays
+
+	if businessDays < 0 {
+		step = -1
+		remaining = -businessDays
+	}
+
+	offsetDays := 0
+
+	for remaining > 0 {
+
+		offsetDays += step
+
+		var isBusinessDay bool
+
+		isBusinessDay, err = busDayMech2.isBusinessDate(
+			dTz.dateTimeValue,
+			offsetDays,
+			busDayCal,
+			ePrefix)
+
+		if err != nil {
+			return DateTzDto{}, err
+		}
+
+		if isBusinessDay {
+			remaining--
+		}
+	}
+
+	return busDayMech2.newDateTzFromOffset(
+		dTz.dateTimeValue,
+		offsetDays,
+		dateTimeFmtStr,
+		ePrefix)
+}
+
+// adjacentBusinessDay - Returns the first business day following
+// (direction = +1) or preceding (direction = -1) the date of a
+// DateTzDto instance. The date of the DateTzDto instance is
+// excluded from the search.
+//
+func (busDayMech *businessDayMechanics) adjacentBusinessDay(
+	dTz *DateTzDto,
+	direction int,
+	busDayCal *BusinessDayCalendar,
+	dateTimeFmtStr string,
+	ePrefix string) (
+	DateTzDto,
+	error) {
+
+	ePrefix += "businessDayMechanics.adjacentBusinessDay() "
+
+	if direction != 1 && direction != -1 {
+		return DateTzDto{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error: Input parameter 'direction' is INVALID!\n" +
+				"direction='%v'\n", direction)
+	}
+
+	busDayMech2 := businessDayMechanics{}
+
+	return busDayMech2.addBusinessDays(
+		dTz,
+		direction,
+		busDayCal,
+		dateTimeFmtStr,
+		ePrefix)
+}
+
+// businessDaysBetween - Returns the number of business days
+// between the dates of two DateTzDto instances. The starting date
+// is excluded from the count and the ending date is included.
+// Both dates are evaluated in the time zone of 'startDTz'.
+//
+// If the ending date precedes the starting date, the returned
+// value is negative. The starting date is then included in the
+// count and the ending date is excluded. This ensures that
+// adding the returned number of business days to a business day
+// starting date produces the ending date.
+//
+func (busDayMech *businessDayMechanics) businessDaysBetween(
+	startDTz *DateTzDto,
+	endDTz *DateTzDto,
+	busDayCal *BusinessDayCalendar,
+	ePrefix string) (
+	businessDays int64,
+	err error) {
+
+	if busDayMech.lock == nil {
+		busDayMech.lock = new(sync.Mutex)
+	}
+
+	busDayMech.lock.Lock()
+
+	defer busDayMech.lock.Unlock()
+
+	ePrefix += "businessDayMechanics.businessDaysBetween() "
+
+	busDayMech2 := businessDayMechanics{}
+
+	err = busDayMech2.testInputValidity(startDTz, busDayCal, ePrefix)
+
+	if err != nil {
+		return businessDays, err
+	}
+
+	if endDTz == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'endDTz' is a nil pointer!\n")
+
+		return businessDays, err
+	}
+
+	if endDTz.dateTimeValue.IsZero() {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'endDTz' has a zero date time value!\n")
+
+		return businessDays, err
+	}
+
+	loc := startDTz.dateTimeValue.Location()
+
+	startDate := startDTz.dateTimeValue
+
+	endDate := endDTz.dateTimeValue.In(loc)
+
+	startDay := time.Date(
+		startDate.Year(),
+		startDate.Month(),
+		startDate.Day(),
+		0, 0, 0, 0,
+		time.UTC)
+
+	endDay := time.Date(
+		endDate.Year(),
+		endDate.Month(),
+		endDate.Day(),
+		0, 0, 0, 0,
+		time.UTC)
+
+	totalDays := int((endDay.Unix() - startDay.Unix()) / 86400)
+
+	step := 1
+	firstOffset := 1
+	lastOffset := totalDays
+
+	if totalDays < 0 {
+		step = -1
+		firstOffset = 0
+		lastOffset = totalDays + 1
+	}
+
+	if totalDays == 0 {
+		return businessDays, err
+	}
+
+	for offsetDays := firstOffset; ; offsetDays += step {
+
+		var isBusinessDay bool
+
+		isBusinessDay, err = busDayMech2.isBusinessDate(
+			startDate,
+			offsetDays,
+			busDayCal,
+			ePrefix)
+
+		if err != nil {
+			return businessDays, err
+		}
+
+		if isBusinessDay {
+			businessDays += int64(step)
+		}
+
+		if offsetDays == lastOffset {
+			break
+		}
+	}
+
+	return businessDays, err
+}
+
+// isBusinessDate - Returns 'true' if the local date falling
+// 'offsetDays' calendar days from the local date of 'baseDateTime'
+// is a business day. The date is evaluated at 12:00:00 (noon) in
+// the time zone of 'baseDateTime'.
+//
+func (busDayMech *businessDayMechanics) isBusinessDate(
+	baseDateTime time.Time,
+	offsetDays int,
+	busDayCal *BusinessDayCalendar,
+	ePrefix string) (
+	isBusinessDay bool,
+	err error) {
+
+	ePrefix += "businessDayMechanics.isBusinessDate() "
+
+	candidate := time.Date(
+		baseDateTime.Year(),
+		baseDateTime.Month(),
+		baseDateTime.Day()+offsetDays,
+		12, 0, 0, 0,
+		baseDateTime.Location())
+
+	// Go's time.Weekday numbers Sunday as zero (0)
+	// in conformance with the US Day Of Week
+	// Numbering System.
+	var isoDayOfWeekNo ISO8601DayOfWeekNo
+
+	isoDayOfWeekNo, err =
+		UsDayOfWeekNo(candidate.Weekday()).XISO8601DayOfWeekNumber(ePrefix)
+
+	if err != nil {
+		return false, err
+	}
+
+	if busDayCal.IsWeekendDay(isoDayOfWeekNo) {
+		return false, err
+	}
+
+	holidaySource := busDayCal.GetHolidaySource()
+
+	if holidaySource == nil {
+		return true, err
+	}
+
+	candidateDTz := DateTzDto{}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err = dTzUtil.setFromDateTime(
+		&candidateDTz,
+		candidate,
+		FmtDateTimeYrMDayFmtStr,
+		ePrefix)
+
+	if err != nil {
+		return false, err
+	}
+
+	var isHoliday bool
+
+	isHoliday, err = holidaySource.IsHoliday(
+		candidateDTz,
+		ePrefix)
+
+	if err != nil {
+		return false, err
+	}
+
+	return !isHoliday, err
+}
+
+// newDateTzFromOffset - Returns a new DateTzDto instance set to
+// the local date falling 'offsetDays' calendar days from the local
+// date of 'baseDateTime'. The local time of day and the time zone
+// of 'baseDateTime' are retained.
+//
+func (busDayMech *businessDayMechanics) newDateTzFromOffset(
+	baseDateTime time.Time,
+	offsetDays int,
+	dateTimeFmtStr string,
+	ePrefix string) (
+	DateTzDto,
+	error) {
+
+	ePrefix += "businessDayMechanics.newDateTzFromOffset() "
+
+	newDateTime := time.Date(
+		baseDateTime.Year(),
+		baseDateTime.Month(),
+		baseDateTime.Day()+offsetDays,
+		baseDateTime.Hour(),
+		baseDateTime.Minute(),
+		baseDateTime.Second(),
+		baseDateTime.Nanosecond(),
+		baseDateTime.Location())
+
+	dtz2 := DateTzDto{}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.setFromDateTime(
+		&dtz2,
+		newDateTime,
+		dateTimeFmtStr,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	return dtz2, nil
+}
+
+// testInputValidity - Validates the DateTzDto and BusinessDayCalendar
+// input parameters passed to business day calculations.
+//
+func (busDayMech *businessDayMechanics) testInputValidity(
+	dTz *DateTzDto,
+	busDayCal *BusinessDayCalendar,
+	ePrefix string) error {
+
+	ePrefix += "businessDayMechanics.testInputValidity() "
+
+	if dTz == nil {
+		return errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'dTz' is a nil pointer!\n")
+	}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(dTz, ePrefix)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix +
+			"\nInput parameter 'dTz' is Invalid!\n" +
+			"Validation Error='%v'\n", err.Error())
+	}
+
+	if busDayCal == nil {
+		return errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'busDayCal' is a nil pointer!\n")
+	}
+
+	hasBusinessDay := false
+
+	for i := 1; i < 8; i++ {
+		if !busDayCal.IsWeekendDay(ISO8601DayOfWeekNo(i)) {
+			hasBusinessDay = true
+			break
+		}
+	}
+
+	if !hasBusinessDay {
+		return errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'busDayCal' designates all seven days\n" +
+			"of the week as weekend days. At least one business day is required!\n")
+	}
+
+	return nil
+}
@@ -256,9 +256,27 @@ type CalendarCycleConfiguration struct {
 	calendarCyclesConfig []CalendarCycleDto // Ths array contains all of the calendar cycles
 	calendarBaseData     ICalendarBaseData  // Contains methods for calculating calendar base data
 	//                                                           //   such as Leap Years and number of days in year.
+	subCycleLeapDayAdjustment calendarSubCycleLeapDayAdjustment // Optional. Counts leap days not captured by
+	//                                                           //   the sub-cycles in 'calendarCyclesConfig'.
 	lock                                      *sync.Mutex
 }
 
+// calendarSubCycleLeapDayAdjustment - Returns the number of leap days
+// in the years 'firstYear' through 'lastYear', inclusive, which are
+// NOT accounted for by the fixed day counts of the calendar sub-cycles.
+// Both year values are Astronomical Years.
+//
+// Calendar sub-cycles assume that every sub-cycle of a given length
+// contains the same number of days. A calendar whose leap year rules
+// cannot be fully expressed as nested cycles, such as the Revised
+// Goucher-Parker Calendar, supplies this function so that the calendar
+// engines can correct the sub-cycle day counts. The value of
+// CalendarCycleConfiguration.calendarCyclesConfig[0] is always the main
+// calendar cycle, which is exact, and is therefore excluded from the
+// span of years passed to this function.
+//
+type calendarSubCycleLeapDayAdjustment func(firstYear, lastYear int64) int64
+
 func( calCycCfg *CalendarCycleConfiguration) GetCalendarBaseData(ePrefix string) (ICalendarBaseData, error) {
 
 	if calCycCfg.lock == nil {
@@ -0,0 +1,342 @@
+package datetime
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// calendarEcclesiasticalMechanics - This type contains methods
+// used to compute the date of Easter Sunday (the Computus) and
+// the dates of the movable feasts which depend on it.
+//
+// Western churches compute Easter Sunday using the Gregorian
+// Calendar. Eastern Orthodox churches compute Easter Sunday using
+// the Julian Calendar.
+//
+// All year values processed by this type are formatted as
+// Astronomical Years. Floored integer division is applied
+// throughout so that the algorithms remain valid for years
+// less than or equal to zero.
+//
+// References:
+//  https://en.wikipedia.org/wiki/Computus
+//  https://en.wikipedia.org/wiki/Date_of_Easter#Anonymous_Gregorian_algorithm
+//  https://en.wikipedia.org/wiki/Date_of_Easter#Meeus's_Julian_algorithm
+//
+type calendarEcclesiasticalMechanics struct {
+	lock *sync.Mutex
+}
+
+// getEasterSunday - Returns the month and day of Easter Sunday
+// for the year and calendar system specified by the input
+// parameters.
+//
+// Only the Gregorian and Julian Calendars are supported. The
+// returned month and day are expressed in the calendar
+// designated by input parameter 'calendarSystem'.
+//
+func (calEcclesMech *calendarEcclesiasticalMechanics) getEasterSunday(
+	calendarSystem CalendarSpec,
+	astronomicalYear int64,
+	ePrefix string) (
+	month int,
+	day int,
+	err error) {
+
+	if calEcclesMech.lock == nil {
+		calEcclesMech.lock = new(sync.Mutex)
+	}
+
+	calEcclesMech.lock.Lock()
+
+	defer calEcclesMech.lock.Unlock()
+
+	ePrefix += "calendarEcclesiasticalMechanics.getEasterSunday() "
+
+	switch calendarSystem {
+
+	case CalendarSpec(0).Gregorian():
+
+		month, day = calEcclesMech.gregorianEasterSunday(astronomicalYear)
+
+	case CalendarSpec(0).Julian():
+
+		month, day = calEcclesMech.julianEasterSunday(astronomicalYear)
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'calendarSystem' is INVALID!\n" +
+			"Easter Sunday may only be computed for the Gregorian\n" +
+			"or Julian Calendars.\n" +
+			"calendarSystem='%v'\n",
+			calendarSystem.String())
+	}
+
+	return month, day, err
+}
+
+// getMovableFeast - Returns the date falling 'offsetDays' days
+// from Easter Sunday for the year and calendar system specified
+// by the input parameters. Negative values for 'offsetDays' yield
+// dates preceding Easter Sunday.
+//
+// The returned year value is formatted as an Astronomical Year
+// and the returned date is expressed in the calendar designated
+// by input parameter 'calendarSystem'.
+//
+func (calEcclesMech *calendarEcclesiasticalMechanics) getMovableFeast(
+	calendarSystem CalendarSpec,
+	astronomicalYear int64,
+	offsetDays int64,
+	ePrefix string) (
+	feastYear int64,
+	feastMonth int,
+	feastDay int,
+	err error) {
+
+	ePrefix += "calendarEcclesiasticalMechanics.getMovableFeast() "
+
+	calEcclesMech2 := calendarEcclesiasticalMechanics{}
+
+	var easterMonth, easterDay int
+
+	easterMonth,
+	easterDay,
+	err = calEcclesMech2.getEasterSunday(
+		calendarSystem,
+		astronomicalYear,
+		ePrefix)
+
+	if err != nil {
+		return feastYear, feastMonth, feastDay, err
+	}
+
+	if offsetDays == 0 {
+		return astronomicalYear, easterMonth, easterDay, err
+	}
+
+	calDtMech := calendarDateTimeMechanics{}
+
+	var julianDayNoDto JulianDayNoDto
+
+	julianDayNoDto, err = calDtMech.getJulianDayNumber(
+		calendarSystem,
+		astronomicalYear,
+		easterMonth,
+		easterDay,
+		12,
+		0,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return feastYear, feastMonth, feastDay, err
+	}
+
+	var julianDayNo int64
+
+	julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+	if err != nil {
+		return feastYear, feastMonth, feastDay, err
+	}
+
+	julianDayNoDto, err = JulianDayNoDto{}.New(
+		julianDayNo + offsetDays,
+		big.NewFloat(0.0),
+		ePrefix)
+
+	if err != nil {
+		return feastYear, feastMonth, feastDay, err
+	}
+
+	var dateTimeDto ADateTimeDto
+
+	dateTimeDto, err = calDtMech.getDateTimeFromJulianDayNumber(
+		calendarSystem,
+		julianDayNoDto,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return feastYear, feastMonth, feastDay, err
+	}
+
+	feastYear = dateTimeDto.GetYearAstronomical()
+	feastMonth = dateTimeDto.GetMonth()
+	feastDay = dateTimeDto.GetDay()
+
+	return feastYear, feastMonth, feastDay, err
+}
+
+// newMovableFeastCalDateTime - Creates and returns a new instance
+// of CalendarDateTime set to 00:00:00 (midnight) on the date falling
+// 'offsetDays' days from Easter Sunday. Input parameter 'year' is
+// converted to an Astronomical Year according to 'yearNumType'.
+//
+func (calEcclesMech *calendarEcclesiasticalMechanics) newMovableFeastCalDateTime(
+	calendarSystem CalendarSpec,
+	year int64,
+	yearNumType CalendarYearNumType,
+	offsetDays int64,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (
+	calDateTime CalendarDateTime,
+	err error) {
+
+	ePrefix += "calendarEcclesiasticalMechanics.newMovableFeastCalDateTime() "
+
+	calMech := calendarMechanics{}
+
+	var astronomicalYear int64
+
+	astronomicalYear, err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return calDateTime, err
+	}
+
+	calEcclesMech2 := calendarEcclesiasticalMechanics{}
+
+	var feastYear int64
+	var feastMonth, feastDay int
+
+	feastYear,
+	feastMonth,
+	feastDay,
+	err = calEcclesMech2.getMovableFeast(
+		calendarSystem,
+		astronomicalYear,
+		offsetDays,
+		ePrefix)
+
+	if err != nil {
+		return calDateTime, err
+	}
+
+	calDTimeUtil := calendarDateTimeUtility{}
+
+	calDateTime = CalendarDateTime{}
+
+	err = calDTimeUtil.setCalDateTime(
+		&calDateTime,
+		feastYear,
+		feastMonth,
+		feastDay,
+		0,
+		0,
+		0,
+		0,
+		false,
+		timeZoneLocation,
+		calendarSystem,
+		0,
+		CalendarYearNumType(0).Astronomical(),
+		dateTimeFmt,
+		ePrefix)
+
+	return calDateTime, err
+}
+
+// gregorianEasterSunday - Computes the month and day of Easter
+// Sunday on the Gregorian Calendar using the Anonymous Gregorian
+// algorithm (Meeus/Jones/Butcher).
+//
+func (calEcclesMech *calendarEcclesiasticalMechanics) gregorianEasterSunday(
+	astronomicalYear int64) (
+	month int,
+	day int) {
+
+	a := calEcclesMech.floorMod(astronomicalYear, 19)
+
+	b := calEcclesMech.floorDiv(astronomicalYear, 100)
+
+	c := calEcclesMech.floorMod(astronomicalYear, 100)
+
+	d := calEcclesMech.floorDiv(b, 4)
+
+	e := calEcclesMech.floorMod(b, 4)
+
+	f := calEcclesMech.floorDiv(b+8, 25)
+
+	g := calEcclesMech.floorDiv(b-f+1, 3)
+
+	h := calEcclesMech.floorMod(19*a+b-d-g+15, 30)
+
+	i := c / 4
+
+	k := c % 4
+
+	l := calEcclesMech.floorMod(32+2*e+2*i-h-k, 7)
+
+	m := (a + 11*h + 22*l) / 451
+
+	n := h + l - 7*m + 114
+
+	month = int(n / 31)
+
+	day = int(n%31) + 1
+
+	return month, day
+}
+
+// julianEasterSunday - Computes the month and day of Easter
+// Sunday on the Julian Calendar using Meeus's Julian algorithm.
+//
+func (calEcclesMech *calendarEcclesiasticalMechanics) julianEasterSunday(
+	astronomicalYear int64) (
+	month int,
+	day int) {
+
+	a := calEcclesMech.floorMod(astronomicalYear, 4)
+
+	b := calEcclesMech.floorMod(astronomicalYear, 7)
+
+	c := calEcclesMech.floorMod(astronomicalYear, 19)
+
+	d := (19*c + 15) % 30
+
+	e := (2*a + 4*b - d + 34) % 7
+
+	n := d + e + 114
+
+	month = int(n / 31)
+
+	day = int(n%31) + 1
+
+	return month, day
+}
+
+// floorDiv - Performs floored integer division. The quotient
+// is rounded toward negative infinity.
+//
+func (calEcclesMech *calendarEcclesiasticalMechanics) floorDiv(
+	dividend int64,
+	divisor int64) int64 {
+
+	quotient := dividend / divisor
+
+	if (dividend%divisor != 0) &&
+		((dividend < 0) != (divisor < 0)) {
+		quotient--
+	}
+
+	return quotient
+}
+
+// floorMod - Returns the modulus of floored integer division.
+// The result carries the sign of the divisor.
+//
+func (calEcclesMech *calendarEcclesiasticalMechanics) floorMod(
+	dividend int64,
+	divisor int64) int64 {
+
+	return dividend - calEcclesMech.floorDiv(dividend, divisor)*divisor
+}
@@ -0,0 +1,300 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// CalendarEcclesiasticalUtility - This type contains methods used
+// to compute the date of Easter Sunday (the Computus) together with
+// the movable feasts whose dates are derived from Easter Sunday.
+//
+// Western churches compute Easter Sunday on the Gregorian Calendar.
+// Eastern Orthodox churches compute Easter Sunday on the Julian
+// Calendar. Accordingly, all methods of this type accept a calendar
+// system of either CalendarSpec(0).Gregorian() or
+// CalendarSpec(0).Julian(). Dates are returned in the calendar
+// system used to compute them. To express an Orthodox Easter date
+// on the Gregorian Calendar, call CalendarDateTime.ConvertTo() on
+// the returned value.
+//
+//  Example:
+//    Orthodox Easter Sunday 2021 is April 19, 2021 on the Julian
+//    Calendar, or May 2, 2021 on the Gregorian Calendar.
+//
+// The movable feasts are computed as follows:
+//
+//    Ash Wednesday  -  46 days before Easter Sunday
+//    Ascension Day  -  39 days after Easter Sunday
+//    Pentecost      -  49 days after Easter Sunday
+//
+// All returned CalendarDateTime instances are set to 00:00:00
+// (midnight) in the time zone specified by the caller.
+//
+// References:
+//  https://en.wikipedia.org/wiki/Computus
+//  https://en.wikipedia.org/wiki/Moveable_feast
+//
+type CalendarEcclesiasticalUtility struct {
+	lock *sync.Mutex
+}
+
+// GetAscensionDay - Returns the date of Ascension Day, the
+// Thursday falling 39 days after Easter Sunday, for the year and
+// calendar system specified by the input parameters.
+//
+// For a discussion of the input parameters and return values, see
+// the documentation for method CalendarEcclesiasticalUtility.GetEasterSunday().
+//
+func (calEcclesUtil *CalendarEcclesiasticalUtility) GetAscensionDay(
+	calendarSystem CalendarSpec,
+	year int64,
+	yearNumType CalendarYearNumType,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (
+	calDateTime CalendarDateTime,
+	err error) {
+
+	if calEcclesUtil.lock == nil {
+		calEcclesUtil.lock = new(sync.Mutex)
+	}
+
+	calEcclesUtil.lock.Lock()
+
+	defer calEcclesUtil.lock.Unlock()
+
+	ePrefix += "CalendarEcclesiasticalUtility.GetAscensionDay() "
+
+	calEcclesMech := calendarEcclesiasticalMechanics{}
+
+	return calEcclesMech.newMovableFeastCalDateTime(
+		calendarSystem,
+		year,
+		yearNumType,
+		39,
+		timeZoneLocation,
+		dateTimeFmt,
+		ePrefix)
+}
+
+// GetAshWednesday - Returns the date of Ash Wednesday, the first
+// day of Lent falling 46 days before Easter Sunday, for the year
+// and calendar system specified by the input parameters.
+//
+// Be advised that Eastern Orthodox churches begin Great Lent on
+// Clean Monday and do not observe Ash Wednesday. When computed on
+// the Julian Calendar, this method simply returns the Wednesday
+// falling 46 days before the Julian Easter Sunday.
+//
+// For a discussion of the input parameters and return values, see
+// the documentation for method CalendarEcclesiasticalUtility.GetEasterSunday().
+//
+func (calEcclesUtil *CalendarEcclesiasticalUtility) GetAshWednesday(
+	calendarSystem CalendarSpec,
+	year int64,
+	yearNumType CalendarYearNumType,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (
+	calDateTime CalendarDateTime,
+	err error) {
+
+	if calEcclesUtil.lock == nil {
+		calEcclesUtil.lock = new(sync.Mutex)
+	}
+
+	calEcclesUtil.lock.Lock()
+
+	defer calEcclesUtil.lock.Unlock()
+
+	ePrefix += "CalendarEcclesiasticalUtility.GetAshWednesday() "
+
+	calEcclesMech := calendarEcclesiasticalMechanics{}
+
+	return calEcclesMech.newMovableFeastCalDateTime(
+		calendarSystem,
+		year,
+		yearNumType,
+		-46,
+		timeZoneLocation,
+		dateTimeFmt,
+		ePrefix)
+}
+
+// GetEasterSunday - Returns the date of Easter Sunday for the year
+// and calendar system specified by the input parameters.
+//
+// Western Easter is computed on the Gregorian Calendar using the
+// Anonymous Gregorian algorithm. Orthodox Easter is computed on
+// the Julian Calendar using Meeus's Julian algorithm. Both
+// algorithms are valid for all years including years less than
+// or equal to zero.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calendarSystem     CalendarSpec
+//     - Designates the calendar system used to compute Easter Sunday.
+//       Only two values are supported:
+//
+//         CalendarSpec(0).Gregorian() - Western Easter
+//         CalendarSpec(0).Julian()    - Orthodox Easter
+//
+//       Any other value will trigger an error.
+//
+//
+//  year               int64
+//     - The year for which Easter Sunday will be computed.
+//
+//
+//  yearNumType        CalendarYearNumType
+//     - Specifies the year numbering system used to express input
+//       parameter 'year'. Possible values are:
+//
+//         CalendarYearNumType(0).Astronomical()
+//         CalendarYearNumType(0).BCE()
+//         CalendarYearNumType(0).CE()
+//
+//
+//  timeZoneLocation   string
+//     - This string identifies the Time Zone associated with the
+//       returned CalendarDateTime instance. Example: "UTC"
+//
+//
+//  dateTimeFmt        string
+//     - This string contains the date/time format which will be used
+//       to format date/time output values. Example:
+//          "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  calDateTime        CalendarDateTime
+//     - If successful, this method returns a new CalendarDateTime
+//       instance set to 00:00:00 (midnight) on Easter Sunday. The
+//       date is expressed in the calendar system specified by input
+//       parameter 'calendarSystem'.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (calEcclesUtil *CalendarEcclesiasticalUtility) GetEasterSunday(
+	calendarSystem CalendarSpec,
+	year int64,
+	yearNumType CalendarYearNumType,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (
+	calDateTime CalendarDateTime,
+	err error) {
+
+	if calEcclesUtil.lock == nil {
+		calEcclesUtil.lock = new(sync.Mutex)
+	}
+
+	calEcclesUtil.lock.Lock()
+
+	defer calEcclesUtil.lock.Unlock()
+
+	ePrefix += "CalendarEcclesiasticalUtility.GetEasterSunday() "
+
+	calEcclesMech := calendarEcclesiasticalMechanics{}
+
+	return calEcclesMech.newMovableFeastCalDateTime(
+		calendarSystem,
+		year,
+		yearNumType,
+		0,
+		timeZoneLocation,
+		dateTimeFmt,
+		ePrefix)
+}
+
+// GetEasterSundayMonthDay - Returns the month and day of Easter
+// Sunday for an Astronomical Year and calendar system. This
+// low-level method does not construct a CalendarDateTime instance.
+//
+// Only CalendarSpec(0).Gregorian() (Western Easter) and
+// CalendarSpec(0).Julian() (Orthodox Easter) are supported. The
+// returned month and day are expressed in the calendar system
+// specified by input parameter 'calendarSystem'.
+//
+func (calEcclesUtil *CalendarEcclesiasticalUtility) GetEasterSundayMonthDay(
+	calendarSystem CalendarSpec,
+	astronomicalYear int64,
+	ePrefix string) (
+	month int,
+	day int,
+	err error) {
+
+	if calEcclesUtil.lock == nil {
+		calEcclesUtil.lock = new(sync.Mutex)
+	}
+
+	calEcclesUtil.lock.Lock()
+
+	defer calEcclesUtil.lock.Unlock()
+
+	ePrefix += "CalendarEcclesiasticalUtility.GetEasterSundayMonthDay() "
+
+	calEcclesMech := calendarEcclesiasticalMechanics{}
+
+	return calEcclesMech.getEasterSunday(
+		calendarSystem,
+		astronomicalYear,
+		ePrefix)
+}
+
+// GetPentecost - Returns the date of Pentecost (Whitsunday), the
+// Sunday falling 49 days after Easter Sunday, for the year and
+// calendar system specified by the input parameters.
+//
+// For a discussion of the input parameters and return values, see
+// the documentation for method CalendarEcclesiasticalUtility.GetEasterSunday().
+//
+func (calEcclesUtil *CalendarEcclesiasticalUtility) GetPentecost(
+	calendarSystem CalendarSpec,
+	year int64,
+	yearNumType CalendarYearNumType,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (
+	calDateTime CalendarDateTime,
+	err error) {
+
+	if calEcclesUtil.lock == nil {
+		calEcclesUtil.lock = new(sync.Mutex)
+	}
+
+	calEcclesUtil.lock.Lock()
+
+	defer calEcclesUtil.lock.Unlock()
+
+	ePrefix += "CalendarEcclesiasticalUtility.GetPentecost() "
+
+	calEcclesMech := calendarEcclesiasticalMechanics{}
+
+	return calEcclesMech.newMovableFeastCalDateTime(
+		calendarSystem,
+		year,
+		yearNumType,
+		49,
+		timeZoneLocation,
+		dateTimeFmt,
+		ePrefix)
+}
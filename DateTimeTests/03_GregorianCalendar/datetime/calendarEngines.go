@@ -3,7 +3,6 @@ package datetime
 import (
 	"errors"
 	"fmt"
-	"math"
 	"math/big"
 	"strings"
 	"sync"
@@ -381,6 +380,92 @@ func (calEng *CalendarEngines) DateTimeToJulianDayNumber(
 					Add(daysDuration, cycles[i].GetCycleCountTotalDays())
 		}
 
+		// Sub-cycles, cycles[1] and higher, assume a fixed number of
+		// days per cycle. Some calendars supply an adjustment for leap
+		// days which fall outside this pattern.
+		if calCycleCfg.subCycleLeapDayAdjustment != nil &&
+			len(cycles) > 1 {
+
+			mainCycleYears :=
+				cycles[0].GetCycleCountTotalYears().Int64()
+
+			subCycleYears :=
+				totalYears.Int64() - mainCycleYears
+
+			if subCycleYears > 0 {
+
+				var firstSubCycleYear int64
+
+				if julianDayNumberSign == 1 {
+					firstSubCycleYear =
+						mainCycleStartDateTime.date.astronomicalYear +
+							mainCycleYears + 1
+				} else {
+					firstSubCycleYear =
+						mainCycleStartDateTime.date.astronomicalYear -
+							mainCycleYears - subCycleYears
+				}
+
+				subCycleLeapDays :=
+					calCycleCfg.subCycleLeapDayAdjustment(
+						firstSubCycleYear,
+						firstSubCycleYear + subCycleYears - 1)
+
+				if calEng.debugCode {
+					fmt.Printf("subCycleLeapDays=%v\n",
+						subCycleLeapDays)
+				}
+
+				daysDuration =
+					big.NewInt(0).
+						Add(daysDuration, big.NewInt(subCycleLeapDays))
+			}
+		}
+
+		// Years which do not constitute a complete calendar
+		// cycle are accumulated year by year. This accommodates
+		// calendars whose leap years are not uniformly distributed
+		// within the main calendar cycle.
+		if remainderYears.Sign() == 1 {
+
+			var firstRemainderYear int64
+
+			if julianDayNumberSign == 1 {
+				firstRemainderYear =
+					mainCycleStartDateTime.date.astronomicalYear +
+						totalYears.Int64() + 1
+			} else {
+				firstRemainderYear =
+					targetDateTimeDto.date.astronomicalYear + 1
+			}
+
+			calEngMech := calendarEnginesMechanics{}
+
+			var remainderYearsDays *big.Int
+
+			remainderYearsDays,
+				err = calEngMech.getDaysInYears(
+				firstRemainderYear,
+				remainderYears.Int64(),
+				calBaseData,
+				ePrefix)
+
+			if err != nil {
+				return jDNDto, err
+			}
+
+			if calEng.debugCode {
+				fmt.Printf("remainderYears: %v\n",
+					remainderYears.Text(10))
+				fmt.Printf("remainderYearsDays: %v\n",
+					remainderYearsDays.Text(10))
+			}
+
+			daysDuration =
+				big.NewInt(0).
+					Add(daysDuration, remainderYearsDays)
+		}
+
 		if calEng.debugCode {
 			fmt.Println()
 			fmt.Printf("  totalYears: %v\n",
@@ -608,6 +693,11 @@ func (calEng *CalendarEngines) JulianDayNoToDateTime(
 
 	// Number sign is now +1 or -1
 
+	julianDayNoTimeSigned :=
+		big.NewFloat(0).
+			SetPrec(precision).
+			Set(julianDayNoTime)
+
 	julianDayNumber, _ :=
 		mathBFloatMech.Floor(julianDayNoTime, precision).Int(nil)
 
@@ -754,7 +844,7 @@ func (calEng *CalendarEngines) JulianDayNoToDateTime(
 			calCycleCfg.GetMainCycleStartDateForNegativeJDNNo()
 
 		mainCycleStartDateYear =
-			mainCycleStartDateTime.GetAbsoluteYearBigIntValue()
+			mainCycleStartDateTime.GetYearBigInt()
 
 	 mainCycleOrdinalDayNo, err = calBaseData.GetOrdinalDayNumber(
 		mainCycleStartDateTime.date.GetIsLeapYear(),
@@ -842,385 +932,151 @@ func (calEng *CalendarEngines) JulianDayNoToDateTime(
 
 	}
 
-	if !cycleRemainderDays.IsInt64() {
-		err = fmt.Errorf(ePrefix + "\n" +
-			"Error: Month Days cannot be converted to Int64!\n" +
-			"Month Days='%v'\n",
-			cycleRemainderDays.Text(10))
-		return targetDateTimeDto, err
-	}
+	// The cycle analysis above produces an estimate of the target
+	// year. Depending on the placement of leap years within the
+	// calendar cycles, this estimate may differ from the actual
+	// target year by one year. The estimate is verified and
+	// adjusted using the Julian Day Numbers for January 1st of the
+	// estimated target year and the following year.
+	var estimatedYearBigInt *big.Int
 
-	if calEng.debugCode {
-		fmt.Println("---------------------------------------")
-		fmt.Printf("Raw Total Delta Years: %s\n",
-			cycleDeltaYears.Text(10))
+	// Remainder days which do not constitute a complete calendar
+	// cycle are converted to years using the mean year length of
+	// the main calendar cycle.
+	mainCycleConfig := calCycleCfg.GetMainCycleConfiguration()
 
-		fmt.Printf("Remainder Days Raw: %s\n",
-			cycleRemainderDays.Text(10))
+	if cycleRemainderDays.Sign() == 1 &&
+		mainCycleConfig.GetDaysInCycle().Sign() == 1 {
 
-		fmt.Printf("Main Cycle Start Year Value: %v\n",
-			mainCycleStartDateYear.Text(10))
-		fmt.Println("---------------------------------------")
-		fmt.Println()
+		cycleDeltaYears =
+			big.NewInt(0).
+				Add(cycleDeltaYears,
+					big.NewInt(0).
+						Quo(
+							big.NewInt(0).
+								Mul(cycleRemainderDays,
+									mainCycleConfig.GetYearsInCycle()),
+							mainCycleConfig.GetDaysInCycle()))
 	}
 
-	var endingYearBigInt *big.Int
-
-	// For a negative JDN Number, mainCycleStartDateYear
-	// precedes the actual base start date.
-	//
-	// For a positive JDN Number, mainCycleStartDateYear
-	// is a negative year value.
-	endingYearBigInt =
-		big.NewInt(0).
-			Add(mainCycleStartDateYear, cycleDeltaYears)
+	if julianDayNumTimeNumberSign == 1 {
+		estimatedYearBigInt =
+			big.NewInt(0).
+				Add(mainCycleStartDateYear, cycleDeltaYears)
+	} else {
+		estimatedYearBigInt =
+			big.NewInt(0).
+				Sub(mainCycleStartDateYear, cycleDeltaYears)
+	}
 
-	if !endingYearBigInt.IsInt64() {
+	if !estimatedYearBigInt.IsInt64() {
 		err = fmt.Errorf(ePrefix + "\n" +
 			"Error: Ending Year Number cannot be converted to Int64!\n" +
 			"Ending Year Number='%v'\n",
-			endingYearBigInt.Text(10))
+			estimatedYearBigInt.Text(10))
 		return targetDateTimeDto, err
 	}
 
-	if calEng.debugCode {
-		fmt.Printf("Initial Ending Year: %v\n",
-			endingYearBigInt)
-	}
-
-	endingYearSign := 1
-
-	if endingYearBigInt.Sign() == -1 {
-	// Generate absolute value for ending year
-		endingYearSign = -1
+	targetYear := estimatedYearBigInt.Int64()
 
-		endingYearBigInt =
-			big.NewInt(0).
-				Neg(endingYearBigInt)
+	if calEng.debugCode {
+		fmt.Printf("Estimated Target Year: %v\n",
+			targetYear)
 	}
 
-	targetYear := endingYearBigInt.Int64()
+	// The target day number identifies the calendar day
+	// containing the Julian Day Number/Time. Calendar days
+	// begin at midnight, one-half day before the Julian
+	// Day Number at noon.
+	targetDayNo, _ :=
+		mathBFloatMech.Floor(
+			big.NewFloat(0).
+				SetPrec(precision).
+				Add(julianDayNoTimeSigned, big.NewFloat(0.5)),
+			precision).Int(nil)
 
-	maxInt := big.NewInt(math.MaxInt32)
+	calEngMech := calendarEnginesMechanics{}
 
-	minInt := big.NewInt(math.MinInt32)
+	var yearStartDayNo, nextYearStartDayNo *big.Int
 
-	if cycleRemainderDays.Cmp(maxInt) == 1 {
-		err = fmt.Errorf(ePrefix + "\n" +
-			"Error: Big Int 'cycleRemainderDays' is larger than the maximum int32 value!\n" +
-			"Maximum int32='%v'" +
-			"cycleRemainderDays='%v'\n ",
-			maxInt.Text(10),
-			cycleRemainderDays.Text(10))
-		return targetDateTimeDto, err
-	}
+	yearStartDayNo,
+		err = calEngMech.getYearStartDayNumber(
+		targetYear,
+		&calCycleCfg,
+		ePrefix)
 
-	if cycleRemainderDays.Cmp(minInt) == -1 {
-		err = fmt.Errorf(ePrefix + "\n" +
-			"Error: Big Int 'cycleRemainderDays' is less than the minimum int32 value!\n" +
-			"Minimum int32='%v'" +
-			"cycleRemainderDays='%v'\n ",
-			minInt.Text(10),
-			cycleRemainderDays.Text(10))
+	if err != nil {
 		return targetDateTimeDto, err
 	}
 
-	cycleRemainderDaysInt := int(cycleRemainderDays.Int64())
-
-	if calEng.debugCode {
-		fmt.Printf("cycleRemainderDaysInt: %v\n",
-			cycleRemainderDaysInt)
-	}
+	for yearStartDayNo.Cmp(targetDayNo) == 1 {
 
-	var isLeapYear bool
+		targetYear--
 
-	var targetYearDaysInYear int
+		yearStartDayNo,
+			err = calEngMech.getYearStartDayNumber(
+			targetYear,
+			&calCycleCfg,
+			ePrefix)
 
-	if calEng.debugCode {
-		fmt.Printf("Julian Day Number Time Adjustment: %v\n",
-			julianDayNoTimeAdjustment)
+		if err != nil {
+			return targetDateTimeDto, err
+		}
 	}
 
-	cycleRemainderDaysInt += julianDayNoTimeAdjustment
+	nextYearStartDayNo,
+		err = calEngMech.getYearStartDayNumber(
+		targetYear + 1,
+		&calCycleCfg,
+		ePrefix)
 
-	if calEng.debugCode {
-		fmt.Printf("cycleRemainderDaysInt No Adjusted For Time: %v\n",
-			cycleRemainderDaysInt)
+	if err != nil {
+		return targetDateTimeDto, err
 	}
 
-	var targetOrdinalDayNo int
-
-	// Analyze and Adjust Ordinal Day Number
-	if julianDayNumTimeNumberSign == 1 {
-
-		if cycleRemainderDaysInt > mainCycleRemainingDaysInYear {
-			// julianDayNumTimeNumberSign == 1
-			// Julian Day Number is Positive
-			// cycleRemainderDaysInt > mainCycleRemainingDaysInYear
-			// targetYear is adjusted
-
-
-			if endingYearSign == 1 {
-				// If the Ending Year Sign is '+',
-				// add 1-year to the Absolute Value of
-				// EndingYear
-				targetYear += 1
-			} else {
-				// If the Ending Year Sign is '-',
-				// subtract 1-year from the Absolute Value
-				// of Ending Year
-				targetYear += -1
-			}
-
-
-			if calEng.debugCode{
-				fmt.Println("julianDayNumTimeNumberSign == 1")
-				fmt.Println("Julian Day Number is Positive")
-				fmt.Println("cycleRemainderDaysInt > mainCycleRemainingDaysInYear")
-				fmt.Println("Ending Year Adjustment = +1")
-				fmt.Printf("New Target Ending Year: %v\n",
-					targetYear)
-				fmt.Printf("Initial cycleRemainderDaysInt: %v\n",
-					cycleRemainderDaysInt)
-				fmt.Printf(" mainCycleRemainingDaysInYear: %v\n",
-					mainCycleRemainingDaysInYear)
-			}
-
-			targetOrdinalDayNo = cycleRemainderDaysInt -  mainCycleRemainingDaysInYear
-
-			if endingYearSign == -1 ||
-				julianDayNumTimeNumberSign == -1 {
-				targetYear *= -1
-			}
-
-			isLeapYear, err =
-				calBaseData.IsLeapYear(
-					targetYear,
-					CalYearType.Astronomical(),
-					ePrefix)
-
-			if err != nil {
-				return targetDateTimeDto, err
-			}
-
-
-			if isLeapYear {
-				targetYearDaysInYear = calBaseData.GetDaysInLeapYear()
-			} else {
-				targetYearDaysInYear = calBaseData.GetDaysInStandardYear()
-			}
-
-			if calEng.debugCode {
-				fmt.Printf("                  targetYear: %v\n",
-					targetYear)
-				fmt.Printf("                        isLeapYear: %v\n",
-					isLeapYear)
-				fmt.Printf("          Ending Year Days In Year: %v\n",
-					targetYearDaysInYear)
-				fmt.Printf("          Final Ordinal Day Number: %v\n",
-					targetOrdinalDayNo)
-				fmt.Printf("      mainCycleRemainingDaysInYear: %v\n",
-					mainCycleRemainingDaysInYear)
-				fmt.Println()
-			}
-
-			// Correct Ordinal Day is set
-
-		} else {
-			// julianDayNumTimeNumberSign == 1
-			// Julian Day Number is Positive
-			// cycleRemainderDaysInt <= mainCycleRemainingDaysInYear
-			// targetYear is NOT adjusted
-
-			if calEng.debugCode{
-				fmt.Println("julianDayNumTimeNumberSign == 1")
-				fmt.Println("Julian Day Number is Positive")
-				fmt.Println("cycleRemainderDaysInt <= mainCycleRemainingDaysInYear")
-				fmt.Println("targetYear is NOT adjusted.")
-				fmt.Println("Ending Year Adjustment = 0")
-				fmt.Printf("Initial computed cycleRemainderDaysInt: %v\n",
-					cycleRemainderDaysInt)
-				fmt.Printf(" mainCycleRemainingDaysInYear: %v\n",
-					mainCycleRemainingDaysInYear)
-			}
-
-			if endingYearSign == -1 ||
-				julianDayNumTimeNumberSign == -1 {
-				targetYear *= -1
-			}
-
-			isLeapYear, err = calBaseData.IsLeapYear(
-				targetYear,
-				CalYearType.Astronomical(),
-				ePrefix)
+	for nextYearStartDayNo.Cmp(targetDayNo) < 1 {
 
-			if err != nil {
-				return targetDateTimeDto, err
-			}
+		targetYear++
 
-			if isLeapYear {
-				targetYearDaysInYear = calBaseData.GetDaysInLeapYear()
-			} else {
-				targetYearDaysInYear = calBaseData.GetDaysInStandardYear()
-			}
+		yearStartDayNo = nextYearStartDayNo
 
-			targetOrdinalDayNo =
-				targetYearDaysInYear - ( mainCycleRemainingDaysInYear - cycleRemainderDaysInt)
-
-			if calEng.debugCode {
+		nextYearStartDayNo,
+			err = calEngMech.getYearStartDayNumber(
+			targetYear + 1,
+			&calCycleCfg,
+			ePrefix)
 
-				fmt.Printf("                  targetYear: %v\n",
-					targetYear)
-				fmt.Printf("                        isLeapYear: %v\n",
-					isLeapYear)
-				fmt.Printf("          Ending Year Days In Year: %v\n",
-					targetYearDaysInYear)
-				fmt.Printf("Ordinal Day Adjusted For Base Days: %v\n",
-					targetOrdinalDayNo)
-				fmt.Printf("      mainCycleRemainingDaysInYear: %v\n",
-					mainCycleRemainingDaysInYear)
-				fmt.Println()
-			}
+		if err != nil {
+			return targetDateTimeDto, err
 		}
+	}
 
-	} else {
-		// julianDayNumTimeNumberSign == -1
-		// Julian Day Number is Negative
-
-		if cycleRemainderDaysInt >= mainCycleOrdinalDayNo {
-			// julianDayNumTimeNumberSign == -1
-			// Julian Day Number is Negative
-			// cycleRemainderDaysInt >= mainCycleOrdinalDayNo
-			// targetYear IS Adjusted
-
-			targetYear++
-
-			if calEng.debugCode{
-				fmt.Println("julianDayNumTimeNumberSign == -1")
-				fmt.Println("Julian Day Number is Negative")
-				fmt.Println("cycleRemainderDaysInt >= mainCycleOrdinalDayNo")
-				fmt.Println("Ending Year Adjustment = +1")
-				fmt.Printf("Adjusted Target Ending Year: %v\n",
-					targetYear)
-				fmt.Printf("Initial    cycleRemainderDaysInt: %v\n",
-					cycleRemainderDaysInt)
-				fmt.Printf("      mainCycleOrdinalDayNo: %v\n",
-					mainCycleOrdinalDayNo)
-			}
-
-			if endingYearSign == -1 ||
-				julianDayNumTimeNumberSign == -1 {
-				targetYear *= -1
-			}
-
-			isLeapYear,
-			err = calBaseData.IsLeapYear(
-				targetYear,
-				CalYearType.Astronomical(),
-				ePrefix)
-
-			if err != nil {
-				return targetDateTimeDto, err
-			}
-
-			if isLeapYear {
-				targetYearDaysInYear = calBaseData.GetDaysInLeapYear()
-			} else {
-				targetYearDaysInYear = calBaseData.GetDaysInStandardYear()
-			}
-
-			targetOrdinalDayNo =
-				targetYearDaysInYear - (cycleRemainderDaysInt - mainCycleOrdinalDayNo)
-
-			if calEng.debugCode {
-				fmt.Printf("                  targetYear: %v\n",
-					targetYear)
-				fmt.Printf("                        isLeapYear: %v\n",
-					isLeapYear)
-				fmt.Printf("     Target Ending Year DaysInYear: %v\n",
-					targetYearDaysInYear)
-				fmt.Printf("                 Final Ordinal Day: %v\n",
-					targetOrdinalDayNo)
-				fmt.Printf("             mainCycleOrdinalDayNo: %v\n",
-					mainCycleOrdinalDayNo)
-				fmt.Println()
-			}
-
-			// Correct Ordinal Day is set
-
-		} else {
-			// julianDayNumTimeNumberSign == -1
-			// Julian Day Number is Negative
-			// cycleRemainderDaysInt < mainCycleOrdinalDayNo
-			// There IS NO Target Ending Year Adjustment
-
-			if calEng.debugCode{
-				fmt.Println("julianDayNumTimeNumberSign == -1")
-				fmt.Println("Julian Day Number is Negative")
-				fmt.Println("cycleRemainderDaysInt < mainCycleOrdinalDayNo")
-				fmt.Println("Ending Year Adjustment = 0")
-				fmt.Printf("Initial cycleRemainderDaysInt: %v\n",
-					cycleRemainderDaysInt)
-				fmt.Printf("   mainCycleOrdinalDayNo: %v\n",
-					mainCycleOrdinalDayNo)
-			}
-
-			targetOrdinalDayNo = mainCycleOrdinalDayNo - cycleRemainderDaysInt
-
-			if endingYearSign == -1 ||
-				julianDayNumTimeNumberSign == -1 {
-				targetYear *= -1
-			}
-
-			isLeapYear,
-			err = calBaseData.IsLeapYear(
-				targetYear,
-				CalYearType.Astronomical(),
-				ePrefix)
-
-			if err != nil {
-				return targetDateTimeDto, err
-			}
-
-			if isLeapYear {
-				targetYearDaysInYear = calBaseData.GetDaysInLeapYear()
-			} else {
-				targetYearDaysInYear = calBaseData.GetDaysInStandardYear()
-			}
+	// Ordinal Day Numbers begin with day number 1
+	// (January 1st).
+	targetOrdinalDayNo :=
+		int(big.NewInt(0).
+			Sub(targetDayNo, yearStartDayNo).
+			Int64()) + 1
 
-			if calEng.debugCode {
-				fmt.Printf("          targetYear: %v\n",
-					targetYear)
-				fmt.Printf("Days In Target Ending Year: %v\n",
-					targetYearDaysInYear)
-				fmt.Printf("                isLeapYear: %v\n",
-					isLeapYear)
-				fmt.Printf("  Final Ordinal Day Number: %v\n",
-					targetOrdinalDayNo)
-				fmt.Println()
-			}
-		}
-	}
+	var isLeapYear bool
 
-	if targetOrdinalDayNo < 0 {
-		// Invalid Result!
-		err = fmt.Errorf(ePrefix + "\n" +
-			"Error: Remainder Days (a.k.a. Ordinal Day Number) is LESS THAN ZERO!\n" +
-			"Ordinal Day Number: '%v\n",
-			targetOrdinalDayNo)
+	isLeapYear, err = calBaseData.IsLeapYear(
+		targetYear,
+		CalYearType.Astronomical(),
+		ePrefix)
 
+	if err != nil {
 		return targetDateTimeDto, err
 	}
 
-	if targetOrdinalDayNo > targetYearDaysInYear {
-		err = fmt.Errorf(ePrefix + "\n" +
-			"Error: Month Days value is INVALID!\n" +
-			"Month Days='%v'\n" +
-			"Max Days In Year='%v'\n",
-			targetOrdinalDayNo,
-			targetYearDaysInYear)
-
-		return targetDateTimeDto, err
+	if calEng.debugCode {
+		fmt.Printf("          targetYear: %v\n",
+			targetYear)
+		fmt.Printf("          isLeapYear: %v\n",
+			isLeapYear)
+		fmt.Printf("  targetOrdinalDayNo: %v\n",
+			targetOrdinalDayNo)
+		fmt.Println()
 	}
 
 	var month, day int
@@ -1255,11 +1111,6 @@ func (calEng *CalendarEngines) JulianDayNoToDateTime(
 		return targetDateTimeDto, err
 	}
 
-	// JDN Time to gross nanoseconds.
-
-	if err != nil {
-	return targetDateTimeDto, err
-}
 	targetDateTimeDto,
 	err = ADateTimeDto{}.New(
 		calBaseData.GetCalendarSpecification(),
@@ -310,7 +310,7 @@ func (calGregUtil *CalendarGregorianUtility) DateTimeFromJulianDateTime(
 
 	calEng := CalendarEngines{}
 
-	calEng.SetCodeDebug(true)
+	calEng.SetCodeDebug(false)
 
 	return calEng.JulianDayNoToDateTime(
 		julianDayNumberTime,
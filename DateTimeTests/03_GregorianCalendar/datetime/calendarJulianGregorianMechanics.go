@@ -0,0 +1,365 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// calendarJulianGregorianMechanics - This type contains methods
+// used to process date arithmetic associated with the historical
+// Julian/Gregorian Calendar.
+//
+// Under this calendar, dates prior to the Gregorian reform, or
+// cutover, Julian Day Number are computed using the Julian
+// Calendar. Dates on or after the cutover Julian Day Number
+// are computed using the Gregorian Calendar. The dates skipped
+// by the reform are invalid.
+//
+// References:
+//  https://en.wikipedia.org/wiki/Adoption_of_the_Gregorian_calendar
+//  https://en.wikipedia.org/wiki/Conversion_between_Julian_and_Gregorian_calendars
+//
+type calendarJulianGregorianMechanics struct {
+	lock *sync.Mutex
+}
+
+// getCutoverJulianDayNo - Returns the effective cutover Julian Day
+// Number. If input parameter 'cutoverJulianDayNo' is zero, the
+// default cutover Julian Day Number, 2299161 (Friday, 15 October
+// 1582 Gregorian), is returned.
+//
+func (calJulianGregMech *calendarJulianGregorianMechanics) getCutoverJulianDayNo(
+	cutoverJulianDayNo int64) int64 {
+
+	if calJulianGregMech.lock == nil {
+		calJulianGregMech.lock = new(sync.Mutex)
+	}
+
+	calJulianGregMech.lock.Lock()
+
+	defer calJulianGregMech.lock.Unlock()
+
+	if cutoverJulianDayNo == 0 {
+		// Friday, 15 October 1582 Gregorian
+		return 2299161
+	}
+
+	return cutoverJulianDayNo
+}
+
+// getDateFromJulianDayNo - Receives an integer Julian Day Number
+// and returns the equivalent date under the Julian/Gregorian
+// Calendar. Julian Day Numbers less than the cutover Julian Day
+// Number are converted using the Julian Calendar. All other Julian
+// Day Numbers are converted using the Gregorian Calendar.
+//
+// The returned year value is formatted as an Astronomical Year.
+//
+func (calJulianGregMech *calendarJulianGregorianMechanics) getDateFromJulianDayNo(
+	julianDayNo int64,
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	if calJulianGregMech.lock == nil {
+		calJulianGregMech.lock = new(sync.Mutex)
+	}
+
+	calJulianGregMech.lock.Lock()
+
+	defer calJulianGregMech.lock.Unlock()
+
+	ePrefix += "calendarJulianGregorianMechanics.getDateFromJulianDayNo() "
+
+	var julianDayNoDto JulianDayNoDto
+
+	julianDayNoDto, err = JulianDayNoDto{}.New(
+		julianDayNo,
+		big.NewFloat(0.0),
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	var dateTimeDto ADateTimeDto
+
+	if julianDayNo < cutoverJulianDayNo {
+
+		calJulianUtil := CalendarJulianUtility{}
+
+		dateTimeDto, err = calJulianUtil.DateTimeFromJulianDateTime(
+			julianDayNoDto,
+			ePrefix)
+
+	} else {
+
+		calGregUtil := CalendarGregorianUtility{}
+
+		dateTimeDto, err = calGregUtil.DateTimeFromJulianDateTime(
+			julianDayNoDto,
+			ePrefix)
+	}
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	astronomicalYear = dateTimeDto.GetYearAstronomical()
+	month = dateTimeDto.GetMonth()
+	day = dateTimeDto.GetDay()
+
+	return astronomicalYear, month, day, err
+}
+
+// getDaysInYear - Returns the number of days in an astronomical
+// year under the Julian/Gregorian Calendar. For the year in which
+// the cutover occurs, the returned value excludes the dates skipped
+// by the reform.
+//
+func (calJulianGregMech *calendarJulianGregorianMechanics) getDaysInYear(
+	astronomicalYear int64,
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	daysInYear int,
+	err error) {
+
+	ePrefix += "calendarJulianGregorianMechanics.getDaysInYear() "
+
+	daysInYear = -1
+
+	var yearStartDayNo, nextYearStartDayNo int64
+
+	yearStartDayNo, err = calJulianGregMech.getYearStartJulianDayNo(
+		astronomicalYear,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return daysInYear, err
+	}
+
+	nextYearStartDayNo, err = calJulianGregMech.getYearStartJulianDayNo(
+		astronomicalYear + 1,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return daysInYear, err
+	}
+
+	daysInYear = int(nextYearStartDayNo - yearStartDayNo)
+
+	return daysInYear, err
+}
+
+// getJulianDayNoFromDate - Computes the integer Julian Day Number
+// for a date specified under the Julian/Gregorian Calendar.
+//
+// If the date precedes the cutover Julian Day Number, it is
+// processed as a Julian Calendar date. Otherwise, it is processed
+// as a Gregorian Calendar date. If the date was skipped by the
+// reform, an error is returned.
+//
+// Input parameter 'astronomicalYear' must be formatted as an
+// Astronomical Year.
+//
+func (calJulianGregMech *calendarJulianGregorianMechanics) getJulianDayNoFromDate(
+	astronomicalYear int64,
+	month int,
+	day int,
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	julianDayNo int64,
+	err error) {
+
+	if calJulianGregMech.lock == nil {
+		calJulianGregMech.lock = new(sync.Mutex)
+	}
+
+	calJulianGregMech.lock.Lock()
+
+	defer calJulianGregMech.lock.Unlock()
+
+	ePrefix += "calendarJulianGregorianMechanics.getJulianDayNoFromDate() "
+
+	julianDayNo = 0
+
+	var julianDayNoDto JulianDayNoDto
+
+	calJulianUtil := CalendarJulianUtility{}
+
+	julianDayNoDto, err = calJulianUtil.GetJulianDayNumber(
+		astronomicalYear,
+		month,
+		day,
+		12,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err == nil {
+
+		julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			return 0, err
+		}
+
+		if julianDayNo < cutoverJulianDayNo {
+			return julianDayNo, err
+		}
+	}
+
+	calGregUtil := CalendarGregorianUtility{}
+
+	julianDayNoDto, err = calGregUtil.GetJulianDayNumber(
+		astronomicalYear,
+		month,
+		day,
+		12,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return 0, err
+	}
+
+	julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if julianDayNo < cutoverJulianDayNo {
+
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The date was skipped by the Gregorian reform and does NOT exist!\n" +
+			"Date='%v-%v-%v'\n" +
+			"Cutover Julian Day Number='%v'\n",
+			astronomicalYear,
+			month,
+			day,
+			cutoverJulianDayNo)
+
+		return 0, err
+	}
+
+	return julianDayNo, err
+}
+
+// getYearStartJulianDayNo - Returns the integer Julian Day Number
+// for the first day of an astronomical year under the
+// Julian/Gregorian Calendar. Normally, this is January 1st. However,
+// if January 1st was skipped by the reform, the first day of the
+// year is the cutover date.
+//
+func (calJulianGregMech *calendarJulianGregorianMechanics) getYearStartJulianDayNo(
+	astronomicalYear int64,
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	yearStartDayNo int64,
+	err error) {
+
+	ePrefix += "calendarJulianGregorianMechanics.getYearStartJulianDayNo() "
+
+	yearStartDayNo, err = calJulianGregMech.getJulianDayNoFromDate(
+		astronomicalYear,
+		1,
+		1,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err == nil {
+		return yearStartDayNo, err
+	}
+
+	// January 1st was skipped by the reform. The
+	// year begins on the cutover date.
+	var cutoverYear int64
+
+	cutoverYear, _, _, err = calJulianGregMech.getDateFromJulianDayNo(
+		cutoverJulianDayNo,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return 0, err
+	}
+
+	if cutoverYear != astronomicalYear {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Unable to compute the first day of the year.\n" +
+			"astronomicalYear='%v'\n" +
+			"Cutover Julian Day Number='%v'\n",
+			astronomicalYear,
+			cutoverJulianDayNo)
+
+		return 0, err
+	}
+
+	yearStartDayNo = cutoverJulianDayNo
+
+	return yearStartDayNo, err
+}
+
+// isLeapYear - Returns 'true' if the astronomical year contains
+// the date February 29th under the Julian/Gregorian Calendar.
+//
+// Years prior to the reform follow the Julian leap year rule.
+// Years following the reform follow the Gregorian leap year rule.
+// The year in which the cutover occurs is a leap year only if
+// February 29th exists in that year.
+//
+func (calJulianGregMech *calendarJulianGregorianMechanics) isLeapYear(
+	astronomicalYear int64,
+	cutoverJulianDayNo int64) bool {
+
+	_, err := calJulianGregMech.getJulianDayNoFromDate(
+		astronomicalYear,
+		2,
+		29,
+		cutoverJulianDayNo,
+		"")
+
+	return err == nil
+}
+
+// testCutoverJulianDayNo - Validates a cutover Julian Day Number.
+// The cutover Julian Day Number must be greater than or equal to
+// 1794168 (1 March 200 Julian and Gregorian). Prior to this date,
+// Gregorian Calendar dates precede the equivalent Julian Calendar
+// dates and a cutover would duplicate dates instead of skipping
+// them.
+//
+func (calJulianGregMech *calendarJulianGregorianMechanics) testCutoverJulianDayNo(
+	cutoverJulianDayNo int64,
+	ePrefix string) (err error) {
+
+	if calJulianGregMech.lock == nil {
+		calJulianGregMech.lock = new(sync.Mutex)
+	}
+
+	calJulianGregMech.lock.Lock()
+
+	defer calJulianGregMech.lock.Unlock()
+
+	ePrefix += "calendarJulianGregorianMechanics.testCutoverJulianDayNo() "
+
+	if cutoverJulianDayNo < 1794168 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'cutoverJulianDayNo' is INVALID!\n" +
+			"The cutover Julian Day Number must be greater than or equal to\n" +
+			"'1794168' (1 March 200 Julian and Gregorian).\n")
+	}
+
+	return err
+}
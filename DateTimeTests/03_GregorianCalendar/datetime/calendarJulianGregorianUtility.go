@@ -0,0 +1,340 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// CalendarJulianGregorianUtility - This type contains methods
+// used to process date arithmetic associated with the historical
+// Julian/Gregorian Calendar.
+//
+// Dates prior to the cutover Julian Day Number are processed
+// using the Julian Calendar. Dates on or after the cutover Julian
+// Day Number are processed using the Gregorian Calendar. Dates
+// skipped by the reform are invalid.
+//
+// If the cutover Julian Day Number is not set, the default value,
+// 2299161 (Friday, 15 October 1582 Gregorian), is applied. Use
+// method 'SetCutoverJulianDayNo()' to configure a different
+// cutover date.
+//
+// References:
+//  https://en.wikipedia.org/wiki/Adoption_of_the_Gregorian_calendar
+//  https://en.wikipedia.org/wiki/Conversion_between_Julian_and_Gregorian_calendars
+//
+type CalendarJulianGregorianUtility struct {
+	cutoverJulianDayNo int64
+
+	lock *sync.Mutex
+}
+
+// GetCutoverJulianDayNo - Returns the cutover Julian Day Number
+// applied by this instance of CalendarJulianGregorianUtility.
+//
+func (calJulianGregUtil *CalendarJulianGregorianUtility) GetCutoverJulianDayNo() int64 {
+
+	if calJulianGregUtil.lock == nil {
+		calJulianGregUtil.lock = &sync.Mutex{}
+	}
+
+	calJulianGregUtil.lock.Lock()
+
+	defer calJulianGregUtil.lock.Unlock()
+
+	calJulianGregMech := calendarJulianGregorianMechanics{}
+
+	return calJulianGregMech.getCutoverJulianDayNo(
+		calJulianGregUtil.cutoverJulianDayNo)
+}
+
+// GetJulianDayNumber - Returns values defining the Julian Day Number
+// and Time for a date/time in the Julian/Gregorian Calendar.
+//
+// All time input parameters are assumed to be expressed in Coordinated
+// Universal Time (UTC).
+//
+// If the date specified by the input parameters precedes the cutover
+// date, the Julian Day Number is computed using the Julian Calendar.
+// Otherwise, it is computed using the Gregorian Calendar. If the date
+// was skipped by the reform, an error is returned.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  targetYear         int64
+//     - The year number associated with this date/time specification.
+//       The year value must conform to the astronomical year numbering
+//       system.
+//
+//  targetMonth        int
+//     - The month number for this date/time specification.
+//       The valid range is 1 - 12 inclusive.
+//
+//  targetDay          int
+//     - The day number for this date/time specification.
+//
+//  targetHour         int
+//     - The hour time component for this date/time specification.
+//       The valid range is 0 - 23 inclusive.
+//
+//  targetMinute       int
+//     - The minute time component for this date/time specification.
+//       The valid range is 0 - 59 inclusive.
+//
+//  targetSecond       int
+//     - The second time component for this date/time specification.
+//       The valid range is 0 - 60 inclusive. The value 60 is only
+//       used in the case of leap seconds.
+//
+//  targetNanosecond   int
+//     - The nanosecond time component for this date/time specification.
+//       The valid range is 0 - 999,999,999 inclusive.
+//
+//  ePrefix            string
+//     - A string consisting of the method chain used to call
+//       this method. In case of error, this text string is included
+//       in the error message.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  julianDayNoDto     JulianDayNoDto
+//     - If successful, this method will return a fully populated instance
+//       of JulianDayNoDto containing the Julian Day Number as well as the
+//       associated time value.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       in input parameter, 'ePrefix'.
+//
+func (calJulianGregUtil *CalendarJulianGregorianUtility) GetJulianDayNumber(
+	targetYear int64,
+	targetMonth int,
+	targetDay int,
+	targetHour int,
+	targetMinute int,
+	targetSecond int,
+	targetNanosecond int,
+	ePrefix string) (
+	julianDayNoDto JulianDayNoDto,
+	err error) {
+
+	if calJulianGregUtil.lock == nil {
+		calJulianGregUtil.lock = &sync.Mutex{}
+	}
+
+	calJulianGregUtil.lock.Lock()
+
+	defer calJulianGregUtil.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianUtility.GetJulianDayNumber() "
+
+	julianDayNoDto = JulianDayNoDto{}
+
+	calJulianGregMech := calendarJulianGregorianMechanics{}
+
+	cutoverJulianDayNo := calJulianGregMech.getCutoverJulianDayNo(
+		calJulianGregUtil.cutoverJulianDayNo)
+
+	var julianDayNo int64
+
+	julianDayNo, err = calJulianGregMech.getJulianDayNoFromDate(
+		targetYear,
+		targetMonth,
+		targetDay,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return julianDayNoDto, err
+	}
+
+	if julianDayNo < cutoverJulianDayNo {
+
+		calJulianUtil := CalendarJulianUtility{}
+
+		julianDayNoDto, err = calJulianUtil.GetJulianDayNumber(
+			targetYear,
+			targetMonth,
+			targetDay,
+			targetHour,
+			targetMinute,
+			targetSecond,
+			targetNanosecond,
+			ePrefix)
+
+		return julianDayNoDto, err
+	}
+
+	calGregUtil := CalendarGregorianUtility{}
+
+	julianDayNoDto, err = calGregUtil.GetJulianDayNumber(
+		targetYear,
+		targetMonth,
+		targetDay,
+		targetHour,
+		targetMinute,
+		targetSecond,
+		targetNanosecond,
+		ePrefix)
+
+	return julianDayNoDto, err
+}
+
+// IsLeapYear - Determines whether the input parameter 'year' is a
+// leap year under the Julian/Gregorian Calendar. Input parameter
+// 'year' must be formatted as an Astronomical Year.
+//
+// Years prior to the reform follow the Julian leap year rule. Years
+// following the reform follow the Gregorian leap year rule. The year
+// in which the cutover occurs is a leap year only if February 29th
+// exists in that year.
+//
+func (calJulianGregUtil *CalendarJulianGregorianUtility) IsLeapYear(
+	year int64) bool {
+
+	if calJulianGregUtil.lock == nil {
+		calJulianGregUtil.lock = &sync.Mutex{}
+	}
+
+	calJulianGregUtil.lock.Lock()
+
+	defer calJulianGregUtil.lock.Unlock()
+
+	calJulianGregMech := calendarJulianGregorianMechanics{}
+
+	return calJulianGregMech.isLeapYear(
+		year,
+		calJulianGregMech.getCutoverJulianDayNo(
+			calJulianGregUtil.cutoverJulianDayNo))
+}
+
+// DateTimeFromJulianDateTime - Converts a Julian Day Number/Time to its
+// corresponding Julian/Gregorian Calendar date/time.
+//
+// Dates prior to the cutover date are returned as Julian Calendar
+// dates. All other dates are returned as Gregorian Calendar dates.
+//
+func (calJulianGregUtil *CalendarJulianGregorianUtility) DateTimeFromJulianDateTime(
+	julianDayNumberTime JulianDayNoDto,
+	ePrefix string) (
+	dateTimeDto ADateTimeDto,
+	err error) {
+
+	if calJulianGregUtil.lock == nil {
+		calJulianGregUtil.lock = &sync.Mutex{}
+	}
+
+	calJulianGregUtil.lock.Lock()
+
+	defer calJulianGregUtil.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianUtility.DateTimeFromJulianDateTime() "
+
+	calJulianGregMech := calendarJulianGregorianMechanics{}
+
+	cutoverJulianDayNo := calJulianGregMech.getCutoverJulianDayNo(
+		calJulianGregUtil.cutoverJulianDayNo)
+
+	calJulianUtil := CalendarJulianUtility{}
+
+	dateTimeDto, err = calJulianUtil.DateTimeFromJulianDateTime(
+		julianDayNumberTime,
+		ePrefix)
+
+	if err != nil {
+		return dateTimeDto, err
+	}
+
+	// The Julian Day Number/Time may fall before noon. Compute
+	// the integer Julian Day Number for the civil date.
+	var civilDayNoDto JulianDayNoDto
+
+	civilDayNoDto, err = calJulianUtil.GetJulianDayNumber(
+		dateTimeDto.GetYearAstronomical(),
+		dateTimeDto.GetMonth(),
+		dateTimeDto.GetDay(),
+		12,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return dateTimeDto, err
+	}
+
+	var civilDayNo int64
+
+	civilDayNo, err = civilDayNoDto.GetJulianDayInt64(ePrefix)
+
+	if err != nil {
+		return dateTimeDto, err
+	}
+
+	if civilDayNo >= cutoverJulianDayNo {
+
+		calGregUtil := CalendarGregorianUtility{}
+
+		dateTimeDto, err = calGregUtil.DateTimeFromJulianDateTime(
+			julianDayNumberTime,
+			ePrefix)
+
+		if err != nil {
+			return dateTimeDto, err
+		}
+	}
+
+	dateTimeDto.date.calendarBaseData =
+		&CalendarJulianGregorianBaseData{
+			cutoverJulianDayNo: cutoverJulianDayNo,
+		}
+
+	return dateTimeDto, err
+}
+
+// SetCutoverJulianDayNo - Sets the cutover Julian Day Number applied
+// by this instance of CalendarJulianGregorianUtility. The cutover
+// Julian Day Number identifies the first day governed by the
+// Gregorian Calendar.
+//
+// Input parameter 'cutoverJulianDayNo' must be greater than or equal
+// to 1794168 (1 March 200 Julian and Gregorian). Otherwise, an error
+// is returned and the current instance is NOT altered.
+//
+func (calJulianGregUtil *CalendarJulianGregorianUtility) SetCutoverJulianDayNo(
+	cutoverJulianDayNo int64,
+	ePrefix string) error {
+
+	if calJulianGregUtil.lock == nil {
+		calJulianGregUtil.lock = &sync.Mutex{}
+	}
+
+	calJulianGregUtil.lock.Lock()
+
+	defer calJulianGregUtil.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianUtility.SetCutoverJulianDayNo() "
+
+	calJulianGregMech := calendarJulianGregorianMechanics{}
+
+	err := calJulianGregMech.testCutoverJulianDayNo(
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	calJulianGregUtil.cutoverJulianDayNo = cutoverJulianDayNo
+
+	return nil
+}
@@ -5,80 +5,6 @@ import (
 	"sync"
 )
 
-// CalendarGregorianBaseData - Implements the
-// Calendar Base Data Interface.
-//
-type JulianCalendarBaseData struct {
-	lock *sync.Mutex
-}
-
-// GetDaysInLeapYear - Returns the number of days in a
-// Gregorian Calendar Leap Year
-func (gregJulianBData JulianCalendarBaseData) GetDaysInLeapYear() int {
-
-	if gregJulianBData.lock == nil {
-		gregJulianBData.lock = new(sync.Mutex)
-	}
-
-	gregJulianBData.lock.Lock()
-
-	defer gregJulianBData.lock.Unlock()
-
-
-	return 366
-}
-
-// GetDaysInStandardYear - Returns the number of days in a
-// Julian Calendar Standard Year or non-leap year.
-//
-func (gregJulianBData JulianCalendarBaseData) GetDaysInStandardYear() int {
-
-	if gregJulianBData.lock == nil {
-		gregJulianBData.lock = new(sync.Mutex)
-	}
-
-	gregJulianBData.lock.Lock()
-
-	defer gregJulianBData.lock.Unlock()
-
-	return 365
-}
-
-// IsLeapYear - Determines whether the input parameter
-// 'year' is a leap year under the Julian Calendar.
-//
-// If 'year' is a Julian leap year, this method returns 'true'.
-//
-// Note: This method should NOT be used to determine leap years
-// for the Revised Julian Calendar or the Goucher-Parker
-// calendar.
-//
-// Under the Julian Calendar a year is is classified as a 'leap year'
-// if it is evenly divisible by '4'.
-//
-// Reference:
-//   https://en.wikipedia.org/wiki/Julian_calendar
-//
-func  (gregJulianBData JulianCalendarBaseData) IsLeapYear(
-	year int64) bool {
-
-	if gregJulianBData.lock == nil {
-		gregJulianBData.lock = new(sync.Mutex)
-	}
-
-	gregJulianBData.lock.Lock()
-
-	defer gregJulianBData.lock.Unlock()
-
-	remainder := year % 4
-
-	if remainder == 0 {
-		return true
-	}
-
-	return false
-}
-
 // calendarJulianMechanics - This type contains methods
 // used to process date arithmetic associated with the
 // Julian Calendar
@@ -183,14 +109,14 @@ func (calJulianMech *calendarJulianMechanics) getCalendarCyclesConfig() (
 		0,
 		"UTC",
 		"",
-		"",
+		"Julian Day Number Base Start Date/Time",
 		ePrefix)
 
 	if err != nil {
 		return CalendarCycleConfiguration{}
 	}
 
-	calCycles.jdnBaseStartYearDateTime,
+	calCycles.ordinalFixedDateStartYearDateTime,
 	err = ADateTimeDto{}.New(
 		CalendarSpec(0).Julian(),
 		int64(1),
@@ -247,7 +173,7 @@ func (calJulianMech *calendarJulianMechanics) getCalendarCyclesConfig() (
 		},
 	}
 
-	// calCycles.calendarBaseData = JulianCalendarBaseData{}
+	calCycles.calendarBaseData = &CalendarJulianBaseData{}
 
 	calCycles.lock = new(sync.Mutex)
 
@@ -18,7 +18,7 @@ type CalendarJulianUtility struct {
 
 
 // GetJulianDayNumber - Returns values defining the Julian Day Number
-// and Time for a date/time in the Gregorian Calendar.
+// and Time for a date/time in the Julian Calendar.
 //
 // All time input parameters are assumed to be expressed in Coordinated
 // Universal Time (UTC). For more information on Coordinated Universal
@@ -28,15 +28,15 @@ type CalendarJulianUtility struct {
 // Julian Day Number is used to define a standard time duration, and
 // perform date/time conversions, between differing calendar systems.
 //
-// The base date/time for Julian Day Number zero on the Gregorian
-// Calendar is November 24, -4713 12:00:00.000000000 UTC (Noon) or
-// the equivalent November 24, 4714 BCE 12:00:00.000000000 UTC (Noon).
+// The base date/time for Julian Day Number zero on the Julian
+// Calendar is January 1, -4712 12:00:00.000000000 UTC (Noon) or
+// the equivalent January 1, 4713 BCE 12:00:00.000000000 UTC (Noon).
 //
 // For more information on the Julian Day Number, reference:
 //  https://en.wikipedia.org/wiki/Julian_day
 //
-// For more information on the Gregorian Calendar, reference:
-//  https://en.wikipedia.org/wiki/Gregorian_calendar
+// For more information on the Julian Calendar, reference:
+//  https://en.wikipedia.org/wiki/Julian_calendar
 //
 //
 // ------------------------------------------------------------------------
@@ -103,12 +103,12 @@ type CalendarJulianUtility struct {
 //     - If successful, this method will return a fully populated instance
 //       of JulianDayNoDto containing the Julian Day Number as well as the
 //       associated time value.  The integer julian day number will be
-//       calculated for the Gregorian Calendar date specified by input
+//       calculated for the Julian Calendar date specified by input
 //       parameters 'targetYear', 'targetMonth' and 'targetDay'. This
 //       value equals the number of days elapsed between the base date,
-//       November 24, 4714 BCE 12:00:00 UTC (Noon), and the target date/time
+//       January 1, 4713 BCE 12:00:00 UTC (Noon), and the target date/time
 //       specified by the input parameters. Both base and target date/times
-//       represent moments on the Gregorian Calendar.
+//       represent moments on the Julian Calendar.
 //
 //
 //  err                error
@@ -194,7 +194,7 @@ func (calJulianUtil *CalendarJulianUtility) GetJulianDayNumber(
 
 	calEng := CalendarEngines{}
 
-	calEng.SetCodeDebug(true)
+	calEng.SetCodeDebug(false)
 
 	julianDayNoDto,
 		err = calEng.DateTimeToJulianDayNumber(
@@ -233,3 +233,34 @@ func (calJulianUtil *CalendarJulianUtility) IsLeapYear(
 
 	return calJulianMech.isLeapYear(year)
 }
+
+// DateTimeFromJulianDateTime - Converts a Julian Day Number/Time to its
+// corresponding Julian Calendar date/time.
+//
+func (calJulianUtil *CalendarJulianUtility) DateTimeFromJulianDateTime(
+	julianDayNumberTime JulianDayNoDto,
+	ePrefix string) (ADateTimeDto, error) {
+
+	if calJulianUtil.lock == nil {
+		calJulianUtil.lock = &sync.Mutex{}
+	}
+
+	calJulianUtil.lock.Lock()
+
+	defer calJulianUtil.lock.Unlock()
+
+	ePrefix += "CalendarJulianUtility.DateTimeFromJulianDateTime() "
+
+	calJulianMech := calendarJulianMechanics{}
+
+	calCyclesConfig := calJulianMech.getCalendarCyclesConfig()
+
+	calEng := CalendarEngines{}
+
+	calEng.SetCodeDebug(false)
+
+	return calEng.JulianDayNoToDateTime(
+		julianDayNumberTime,
+		calCyclesConfig,
+		ePrefix)
+}
@@ -424,7 +424,9 @@ func (calMech *calendarMechanics) convertAnyYearToAstronomicalYear(
 				return astronomicalYearValue, err
 
 			} else {
-				astronomicalYearValue = (year + 1) * -1
+				// 1 BCE is Astronomical Year 0, 2 BCE is
+				// Astronomical Year -1 and so on.
+				astronomicalYearValue = 1 - year
 				return astronomicalYearValue, err
 			}
 
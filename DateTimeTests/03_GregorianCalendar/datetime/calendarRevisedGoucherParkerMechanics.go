@@ -1,11 +1,274 @@
 package datetime
 
-import "sync"
+import (
+	"math/big"
+	"sync"
+)
 
 type calendarRevisedGoucherParkerMechanics struct {
 	lock *sync.Mutex
 }
 
+// getCalendarCyclesConfig - returns a CalendarCycleConfiguration instance
+// contain all the information necessary for julian day number calculations
+// using the Revised Goucher-Parker Calendar.
+//
+// Julian Day Number zero falls on January 1, -4712 12:00:00 UTC
+// (Noon) on the proleptic Revised Goucher-Parker Calendar. The main
+// calendar cycle is the least common multiple of the 128-year and
+// 454,545-year leap year rules and consists of 58,181,760-years
+// containing 21,250,433,392 days.
+//
+// The sub-cycles implement the 4-year and 128-year leap year rules.
+// Each 128-year sub-cycle contains 31 leap years. The main cycle start
+// dates are evenly divisible by 128 so that the sub-cycles never split
+// a 128-year period. Years evenly divisible by 454,545 which are not
+// leap years under the 4-year and 128-year rules are counted separately
+// by method getSubCycleLeapDayAdjustment().
+//
+func (calRevGoucherParkerMech *calendarRevisedGoucherParkerMechanics) getCalendarCyclesConfig() (
+	calCyclesCfg CalendarCycleConfiguration) {
+
+	if calRevGoucherParkerMech.lock == nil {
+		calRevGoucherParkerMech.lock = new(sync.Mutex)
+	}
+
+	calRevGoucherParkerMech.lock.Lock()
+
+	defer calRevGoucherParkerMech.lock.Unlock()
+
+	ePrefix := "calendarRevisedGoucherParkerMechanics.getCalendarCyclesConfig() "
+
+	calCycles := CalendarCycleConfiguration{}
+
+	var err error
+
+	calCycles.mainCycleStartDateForPositiveJDNNo,
+	err =
+		ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedGoucherParker(),
+		int64(-4736),
+		CalendarYearNumType(0).Astronomical(),
+		1,
+		1,
+		false,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Positive JDN No Main Cycle Start - Revised Goucher-Parker Calendar",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+
+	calCycles.mainCycleAdjustmentYearsForPositiveJDNNo =
+		big.NewInt(-24)
+
+	calCycles.mainCycleAdjustmentDaysForPositiveJDNNo =
+		big.NewInt(-8766)
+
+	calCycles.mainCycleStartDateForNegativeJDNNo,
+	err = ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedGoucherParker(),
+		int64(-4608),
+		CalendarYearNumType(0).Astronomical(),
+		1,
+		1,
+		false,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Negative JDN No Main Cycle Start - Revised Goucher-Parker Calendar",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+	calCycles.mainCycleAdjustmentYearsForNegativeJDNNo =
+		big.NewInt(-104)
+
+	calCycles.mainCycleAdjustmentDaysForNegativeJDNNo =
+		big.NewInt(-37986)
+
+	calCycles.jdnBaseStartYearDateTime,
+	err = ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedGoucherParker(),
+		int64(-4712),
+		CalendarYearNumType(0).Astronomical(),
+		1,
+		1,
+		false,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Julian Day Number Base Start Date/Time",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+	calCycles.ordinalFixedDateStartYearDateTime,
+	err = ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedGoucherParker(),
+		int64(1),
+		CalendarYearNumType(0).Astronomical(),
+		1,
+		1,
+		false,
+		0,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Revised Goucher-Parker Ordinal Day Number Start Date/Time",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+	calCycles.mainCycleConfig =
+		CalendarCycleDto{
+			yearsInCycle: big.NewInt(58181760),
+			daysInCycle:  big.NewInt(21250433392),
+			cycleCount:   big.NewInt(0),
+			cycleCountTotalDays: big.NewInt(0),
+			cycleCountTotalYears: big.NewInt(0),
+			remainderYears: big.NewInt(0),
+			remainderDays: big.NewInt(0),
+			lock:         new(sync.Mutex),
+		}
+
+	calCycles.calendarCyclesConfig = []CalendarCycleDto{
+		{// 0
+			yearsInCycle: big.NewInt(58181760),
+			daysInCycle:  big.NewInt(21250433392),
+			cycleCount:   big.NewInt(0),
+			cycleCountTotalDays: big.NewInt(0),
+			cycleCountTotalYears: big.NewInt(0),
+			remainderYears: big.NewInt(0),
+			remainderDays: big.NewInt(0),
+			lock:         new(sync.Mutex),
+		},
+		{// 1
+			yearsInCycle: big.NewInt(128),
+			daysInCycle:  big.NewInt(46751),
+			cycleCount:   big.NewInt(0),
+			cycleCountTotalDays: big.NewInt(0),
+			cycleCountTotalYears: big.NewInt(0),
+			remainderYears: big.NewInt(0),
+			remainderDays: big.NewInt(0),
+			lock:         new(sync.Mutex),
+		},
+		{// 2
+			yearsInCycle: big.NewInt(4),
+			daysInCycle:  big.NewInt(1461),
+			cycleCount:   big.NewInt(0),
+			cycleCountTotalDays: big.NewInt(0),
+			cycleCountTotalYears: big.NewInt(0),
+			remainderYears: big.NewInt(0),
+			remainderDays: big.NewInt(0),
+			lock:         new(sync.Mutex),
+		},
+		{// 3
+			yearsInCycle: big.NewInt(1),
+			daysInCycle:  big.NewInt(365),
+			cycleCount:   big.NewInt(0),
+			cycleCountTotalDays: big.NewInt(0),
+			cycleCountTotalYears: big.NewInt(0),
+			remainderYears: big.NewInt(0),
+			remainderDays: big.NewInt(0),
+			lock:         new(sync.Mutex),
+		},
+	}
+
+	leapDayMech := calendarRevisedGoucherParkerMechanics{}
+
+	calCycles.subCycleLeapDayAdjustment =
+		leapDayMech.getSubCycleLeapDayAdjustment
+
+	calCycles.calendarBaseData = &CalendarRevisedGoucherParkerBaseData{}
+
+	calCycles.lock = new(sync.Mutex)
+
+	return calCycles
+}
+
+// getSubCycleLeapDayAdjustment - Returns the number of leap years in
+// the years 'firstYear' through 'lastYear', inclusive, which qualify
+// as leap years solely under the 454,545-year rule.
+//
+// The calendar sub-cycles configured by getCalendarCyclesConfig()
+// count leap years under the 4-year and 128-year rules only. A year
+// evenly divisible by 454,545 is always a leap year. Such a year adds
+// a leap day to the sub-cycle day counts unless it is also evenly
+// divisible by 4 and NOT evenly divisible by 128.
+//
+// Since 454,545 is odd, a year 454,545 x k is evenly divisible by 4
+// or 128 only if 'k' is evenly divisible by 4 or 128.
+//
+func (calRevGoucherParkerMech *calendarRevisedGoucherParkerMechanics) getSubCycleLeapDayAdjustment(
+	firstYear int64,
+	lastYear int64) int64 {
+
+	if calRevGoucherParkerMech.lock == nil {
+		calRevGoucherParkerMech.lock = new(sync.Mutex)
+	}
+
+	calRevGoucherParkerMech.lock.Lock()
+
+	defer calRevGoucherParkerMech.lock.Unlock()
+
+	if lastYear < firstYear {
+		return 0
+	}
+
+	// Floor division; Astronomical Years may be negative.
+	floorDiv := func(dividend, divisor int64) int64 {
+
+		quotient := dividend / divisor
+
+		if dividend % divisor != 0 &&
+			dividend < 0 {
+			quotient--
+		}
+
+		return quotient
+	}
+
+	// k values for years 454,545 x k within the span
+	firstK := -floorDiv(-firstYear, 454545)
+
+	lastK := floorDiv(lastYear, 454545)
+
+	if lastK < firstK {
+		return 0
+	}
+
+	countMultiples := func(divisor int64) int64 {
+		return floorDiv(lastK, divisor) -
+			floorDiv(firstK - 1, divisor)
+	}
+
+	return countMultiples(1) -
+		countMultiples(4) +
+		countMultiples(128)
+}
+
 // isLeapYear - Returns 'true' if the year value is a leap year under
 // the Revised Goucher-Parker calendar.
 //
@@ -1,11 +1,173 @@
 package datetime
 
-import "sync"
+import (
+	"math/big"
+	"sync"
+)
 
 type calendarRevisedJulianMechanics struct {
 	lock *sync.Mutex
 }
 
+// getCalendarCyclesConfig - returns a CalendarCycleConfiguration instance
+// contain all the information necessary for julian day number calculations
+// using the Revised Julian Calendar.
+//
+// Julian Day Number zero falls on November 22, -4713 12:00:00 UTC
+// (Noon) on the proleptic Revised Julian Calendar. The main calendar
+// cycle consists of 900-years containing 328,718 days. Since leap
+// century years are not evenly distributed within the 900-year cycle,
+// no sub-cycles are configured.
+//
+func (calRevJulianMech *calendarRevisedJulianMechanics) getCalendarCyclesConfig() (
+	calCyclesCfg CalendarCycleConfiguration) {
+
+	if calRevJulianMech.lock == nil {
+		calRevJulianMech.lock = new(sync.Mutex)
+	}
+
+	calRevJulianMech.lock.Lock()
+
+	defer calRevJulianMech.lock.Unlock()
+
+	ePrefix := "calendarRevisedJulianMechanics.getCalendarCyclesConfig() "
+
+	calCycles := CalendarCycleConfiguration{}
+
+	var err error
+
+	calCycles.mainCycleStartDateForPositiveJDNNo,
+	err =
+		ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedJulian(),
+		int64(-4717),
+		CalendarYearNumType(0).Astronomical(),
+		11,
+		22,
+		false,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Positive JDN No Main Cycle Start - Revised Julian Calendar",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+
+	calCycles.mainCycleAdjustmentYearsForPositiveJDNNo =
+		big.NewInt(-4)
+
+	calCycles.mainCycleAdjustmentDaysForPositiveJDNNo =
+		big.NewInt(-1461)
+
+	calCycles.mainCycleStartDateForNegativeJDNNo,
+	err = ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedJulian(),
+		int64(-4709),
+		CalendarYearNumType(0).Astronomical(),
+		11,
+		22,
+		false,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Negative JDN No Main Cycle Start - Revised Julian Calendar",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+	calCycles.mainCycleAdjustmentYearsForNegativeJDNNo =
+		big.NewInt(-4)
+
+	calCycles.mainCycleAdjustmentDaysForNegativeJDNNo =
+		big.NewInt(-1461)
+
+	calCycles.jdnBaseStartYearDateTime,
+	err = ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedJulian(),
+		int64(-4713),
+		CalendarYearNumType(0).Astronomical(),
+		11,
+		22,
+		false,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Julian Day Number Base Start Date/Time",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+	calCycles.ordinalFixedDateStartYearDateTime,
+	err = ADateTimeDto{}.New(
+		CalendarSpec(0).RevisedJulian(),
+		int64(1),
+		CalendarYearNumType(0).Astronomical(),
+		1,
+		1,
+		false,
+		0,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"Revised Julian Ordinal Day Number Start Date/Time",
+		ePrefix)
+
+	if err != nil {
+		return CalendarCycleConfiguration{}
+	}
+
+	calCycles.mainCycleConfig =
+		CalendarCycleDto{
+			yearsInCycle: big.NewInt(900),
+			daysInCycle:  big.NewInt(328718),
+			cycleCount:   big.NewInt(0),
+			cycleCountTotalDays: big.NewInt(0),
+			cycleCountTotalYears: big.NewInt(0),
+			remainderYears: big.NewInt(0),
+			remainderDays: big.NewInt(0),
+			lock:         new(sync.Mutex),
+		}
+
+	// Years remaining after the main cycle are accumulated
+	// individually by the calendar engines.
+	calCycles.calendarCyclesConfig = []CalendarCycleDto{
+		{// 0
+			yearsInCycle: big.NewInt(900),
+			daysInCycle:  big.NewInt(328718),
+			cycleCount:   big.NewInt(0),
+			cycleCountTotalDays: big.NewInt(0),
+			cycleCountTotalYears: big.NewInt(0),
+			remainderYears: big.NewInt(0),
+			remainderDays: big.NewInt(0),
+			lock:         new(sync.Mutex),
+		},
+	}
+
+	calCycles.calendarBaseData = &CalendarRevisedJulianBaseData{}
+
+	calCycles.lock = new(sync.Mutex)
+
+	return calCycles
+}
+
 // isLeapYear - Determines whether the input parameter 'year'
 // is a leap year under the Revised Julian Calendar.
 //
@@ -48,8 +210,14 @@ func (calRevJulianMech calendarRevisedJulianMechanics) isLeapYear(
 
 		by900Remainder = year % 900
 
+		// Astronomical years may be negative. Remainders
+		// are always expressed as positive values.
+		if by900Remainder < 0 {
+			by900Remainder += 900
+		}
+
 		if by900Remainder == 200 ||
-			by900Remainder == 900 {
+			by900Remainder == 600 {
 			return true
 		}
 
@@ -0,0 +1,417 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// calendarLeapYearRule - Determines whether an astronomical year is a
+// leap year under a specific calendar system. Calendars which share
+// the month names and month lengths of the Julian Calendar differ only
+// in their leap year rules. These calendars supply their leap year
+// rule to the shared base data methods provided by types
+// calendarBaseDataMechanics and calendarBaseDataUtility.
+//
+// Examples:
+//   calendarJulianMechanics.isLeapYear()
+//   calendarRevisedJulianMechanics.isLeapYear()
+//   calendarRevisedGoucherParkerMechanics.isLeapYear()
+//
+type calendarLeapYearRule func(astronomicalYear int64) bool
+
+// calendarBaseDataMechanics - Provides the month tables and leap
+// year tests shared by the Julian, Revised Julian, Revised
+// Goucher-Parker and Julian-Gregorian Calendar Base Data types.
+//
+// All of these calendars use the twelve months of the Julian Calendar.
+// A standard year contains 365-days and a leap year contains 366-days.
+// The extra day in a leap year is added to February. Only the rules
+// which classify a year as a leap year differ between these calendars.
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/Julian_calendar
+//
+type calendarBaseDataMechanics struct {
+	lock *sync.Mutex
+}
+
+// getLeapYearDaysInYear - Returns the number of days in a Leap Year.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//       -- NONE --
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  int
+//     - This method returns the number of days in a Leap Year (366).
+//
+func (calBDataMech *calendarBaseDataMechanics) getLeapYearDaysInYear() int {
+
+	if calBDataMech.lock == nil {
+		calBDataMech.lock = new(sync.Mutex)
+	}
+
+	calBDataMech.lock.Lock()
+
+	defer calBDataMech.lock.Unlock()
+
+	return 366
+}
+
+// getLeapYearMonthDays - Returns a map containing the number of days in
+// each month for a Leap Year. The key is the integer month number and
+// the value is the number of days in that month number.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//      --- NONE ---
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  map[int]int
+//     - This method will return a map implementing an 'int' key and an
+//       'int' value. The key represents the month numbers in a calendar
+//       year, 1 through 12. The returned 'int' value represents the
+//       corresponding number of days in that month number within a
+//       Leap Year.
+//
+func (calBDataMech *calendarBaseDataMechanics) getLeapYearMonthDays(
+) map[int]int {
+
+	if calBDataMech.lock == nil {
+		calBDataMech.lock = new(sync.Mutex)
+	}
+
+	calBDataMech.lock.Lock()
+
+	defer calBDataMech.lock.Unlock()
+
+	leapYearMthDays := map[int]int {
+		1: 31,
+		2: 29,
+		3: 31,
+		4: 30,
+		5: 31,
+		6: 30,
+		7: 31,
+		8: 31,
+		9: 30,
+		10: 31,
+		11: 30,
+		12: 31,
+	}
+
+	return leapYearMthDays
+}
+
+// getLeapYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in a
+// Leap Year. The key is the integer month number and the value is the
+// number of days elapsed in the year prior to the first day of that
+// month.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//      --- NONE ---
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  map[int]int
+//     - This method will return a map implementing an 'int' key and an
+//       'int' value. The key represents the month numbers in a calendar
+//       year (months 1 through 12). The returned 'int' value represents
+//       the corresponding number of ordinal days, which have elapsed
+//       during a Leap Year, at the beginning of that month.
+//
+func (calBDataMech *calendarBaseDataMechanics) getLeapYearOrdinalDays(
+) map[int] int {
+
+	if calBDataMech.lock == nil {
+		calBDataMech.lock = new(sync.Mutex)
+	}
+
+	calBDataMech.lock.Lock()
+
+	defer calBDataMech.lock.Unlock()
+
+	leapYearOrdDays := map[int] int {
+		1: 0,
+		2: 31,
+		3: 60,
+		4: 91,
+		5: 121,
+		6: 152,
+		7: 182,
+		8: 213,
+		9: 244,
+		10: 274,
+		11: 305,
+		12: 335,
+	}
+
+	return leapYearOrdDays
+}
+
+// getStandardYearDaysInYear - Returns the number of days in a standard
+// year.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//       -- NONE --
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  int
+//     - This method returns the number of days in a standard year (365).
+//
+func (calBDataMech *calendarBaseDataMechanics) getStandardYearDaysInYear() int {
+
+	if calBDataMech.lock == nil {
+		calBDataMech.lock = new(sync.Mutex)
+	}
+
+	calBDataMech.lock.Lock()
+
+	defer calBDataMech.lock.Unlock()
+
+	return 365
+}
+
+// getStandardYearMonthDays  - Returns a map containing the number of days
+// in each month for a Standard Year. The key is the month number and the
+// value is the number of days in that month number.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//      --- NONE ---
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  map[int]int
+//     - This method will return a map implementing an 'int' key and an
+//       'int' value. The key represents the month numbers in a calendar
+//       year, 1 through 12. The returned 'int' value represents the
+//       corresponding number of days in that month number within a
+//       Standard Year.
+//
+func (calBDataMech *calendarBaseDataMechanics) getStandardYearMonthDays(
+) map[int] int {
+
+	if calBDataMech.lock == nil {
+		calBDataMech.lock = new(sync.Mutex)
+	}
+
+	calBDataMech.lock.Lock()
+
+	defer calBDataMech.lock.Unlock()
+
+	stdYrMthDays := map[int] int {
+		1: 31,
+		2: 28,
+		3: 31,
+		4: 30,
+		5: 31,
+		6: 30,
+		7: 31,
+		8: 31,
+		9: 30,
+		10: 31,
+		11: 30,
+		12: 31,
+	}
+
+	return stdYrMthDays
+}
+
+// getStandardYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in a
+// Standard Year. The key is the integer month number and the value is
+// the number of days elapsed in the year prior to the first day of
+// that month.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//      --- NONE ---
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  map[int]int
+//     - This method will return a map implementing an 'int' key and an
+//       'int' value. The key represents the month numbers in a calendar
+//       year (months 1 through 12). The returned 'int' value represents
+//       the corresponding number of ordinal days, which have elapsed
+//       during a Standard Year, at the beginning of that month.
+//
+func (calBDataMech *calendarBaseDataMechanics) getStandardYearOrdinalDays(
+) map[int] int {
+
+	if calBDataMech.lock == nil {
+		calBDataMech.lock = new(sync.Mutex)
+	}
+
+	calBDataMech.lock.Lock()
+
+	defer calBDataMech.lock.Unlock()
+
+	stdYearOrdDays := map[int] int {
+		1:0,
+		2:31,
+		3:59,
+		4:90,
+		5:120,
+		6:151,
+		7:181,
+		8:212,
+		9:243,
+		10:273,
+		11:304,
+		12: 334,
+	}
+
+	return stdYearOrdDays
+}
+
+// isLeapYear - Returns a boolean value signaling whether the year
+// value passed as an input parameter is a leap year (366-days)
+// under the leap year rule passed as input parameter 'leapYearRule'.
+//
+// The input parameter 'year' is first converted to an Astronomical
+// Year value. The leap year rule is then applied to that Astronomical
+// Year value.
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/Astronomical_year_numbering
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                   int64
+//     - A year value. This year value is classified by type as being either
+//       an Astronomical Year, Before Common Era Year or a Common Era Year
+//       based on the second input parameter 'yearNumType'.
+//
+//
+//  yearNumType            CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value. For more information on
+//       CalendarYearNumType reference the source code file:
+//            datetime/calendaryearnumbertypeenum.go
+//
+//
+//  leapYearRule           calendarLeapYearRule
+//     - The leap year rule for the calendar system in which 'year' is
+//       specified. If this parameter is 'nil', an error is returned.
+//
+//
+//  ePrefix                string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  isLeapYear             bool
+//     - This return value is set to 'true' if the input parameter, 'year',
+//       qualifies as a leap year under 'leapYearRule'.
+//
+//
+//  err                    error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataMech *calendarBaseDataMechanics) isLeapYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	leapYearRule calendarLeapYearRule,
+	ePrefix string) (
+	isLeapYear bool,
+	err error) {
+
+	if calBDataMech.lock == nil {
+		calBDataMech.lock = new(sync.Mutex)
+	}
+
+	calBDataMech.lock.Lock()
+
+	defer calBDataMech.lock.Unlock()
+
+	isLeapYear = false
+	err = nil
+
+	ePrefix += "calendarBaseDataMechanics.isLeapYear() "
+
+	if leapYearRule == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Input parameter 'leapYearRule' is nil!\n")
+
+		return isLeapYear, err
+	}
+
+	if ! yearNumType.XIsValid() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Input parameter 'yearNumType' is INVALID!\n" +
+			"yearNumType='%v'\n",
+			yearNumType.XValueInt())
+
+		return isLeapYear, err
+	}
+
+	calMech := calendarMechanics{}
+
+	var astronomicalYear int64
+
+	astronomicalYear,
+		err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return isLeapYear, err
+	}
+
+	isLeapYear = leapYearRule(astronomicalYear)
+
+	return isLeapYear, err
+}
@@ -0,0 +1,1286 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// calendarBaseDataUtility - Provides the ordinal day, month day and
+// day of the week calculations shared by the Julian, Revised Julian,
+// Revised Goucher-Parker and Julian-Gregorian Calendar Base Data
+// types. Methods which depend on the classification of leap years
+// receive the leap year rule of the calendar system as an input
+// parameter of type calendarLeapYearRule.
+//
+type calendarBaseDataUtility struct {
+	lock    *sync.Mutex
+}
+
+// getDaysInYear - Returns the number of days in the year specified by
+// input parameters 'year' and 'yearNumType' under the leap year rule,
+// 'leapYearRule'.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                int64
+//     - A year value. This year value is classified by type as being
+//       either an Astronomical Year, Before Common Era Year or a Common
+//       Era Year based on input parameter 'yearNumType'.
+//
+//
+//  yearNumType         CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value. For more information on
+//       CalendarYearNumType reference the source code file:
+//            datetime/calendaryearnumbertypeenum.go
+//
+//
+//  leapYearRule        calendarLeapYearRule
+//     - The leap year rule for the calendar system in which 'year' is
+//       specified.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  daysInYear          int
+//     - The number of days in the specified year. Leap years contain
+//       366-days. Standard years contain 365-days.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	leapYearRule calendarLeapYearRule,
+	ePrefix string) (
+	daysInYear int,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix += "calendarBaseDataUtility.getDaysInYear() "
+
+	daysInYear = -1
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	var isLeapYear bool
+
+	isLeapYear, err = calBDataMech.isLeapYear(
+		year,
+		yearNumType,
+		leapYearRule,
+		ePrefix)
+
+	if err != nil {
+		return daysInYear, err
+	}
+
+	if isLeapYear {
+		daysInYear = calBDataMech.getLeapYearDaysInYear()
+	} else {
+		daysInYear = calBDataMech.getStandardYearDaysInYear()
+	}
+
+	return daysInYear, err
+}
+
+// getDaysOfWeekNames - Returns a map containing the names of the days
+// of the week. Week day names are common to the Gregorian Calendar and
+// to all calendars which share the months of the Julian Calendar.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dayOfWeekNoSysType  DayOfWeekNumberingSystemType
+//     - Specifies the day of the week numbering system used for the
+//       keys of the returned map. Valid values are:
+//         DayOfWeekNumberingSystemType(0).UsDayOfWeek()
+//         DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek()
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  daysOfWeekNames     map[int]string
+//     - A map of week day names keyed by day of the week number.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getDaysOfWeekNames(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	daysOfWeekNames map[int]string,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix += "calendarBaseDataUtility.getDaysOfWeekNames() "
+
+	daysOfWeekNames = map[int] string {
+		0 : "Error",
+	}
+
+	gregCalBDataMech := calendarGregorianBaseDataMechanics{}
+
+	switch dayOfWeekNoSysType {
+
+	case DayOfWeekNumberingSystemType(0).UsDayOfWeek():
+
+		daysOfWeekNames = gregCalBDataMech.getDaysOfWeekNamesUS()
+
+	case DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek():
+
+		daysOfWeekNames = gregCalBDataMech.getDaysOfWeekNamesISO8601()
+
+	default:
+
+		err = fmt.Errorf(ePrefix + "\n" +
+			"ERROR: Input parameter 'dayOfWeekNoSysType' is INVALID!\n" +
+			"dayOfWeekNoSysType Value ='%v'\n",
+			dayOfWeekNoSysType.XValueInt())
+
+	}
+
+	return daysOfWeekNames, err
+}
+
+// getDaysOfWeekNameAbbreviations - Returns a map containing the
+// abbreviated names of the days of the week. Each abbreviation consists
+// of the first 'numberOfCharsInAbbreviation' characters of the week
+// day name.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dayOfWeekNoSysType           DayOfWeekNumberingSystemType
+//     - Specifies the day of the week numbering system used for the
+//       keys of the returned map. Valid values are:
+//         DayOfWeekNumberingSystemType(0).UsDayOfWeek()
+//         DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek()
+//
+//
+//  numberOfCharsInAbbreviation  int
+//     - The number of characters in each abbreviated week day name.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  weekDayNameAbbrvs   map[int]string
+//     - A map of abbreviated week day names keyed by day of the week
+//       number.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getDaysOfWeekNameAbbreviations(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	numberOfCharsInAbbreviation int,
+	ePrefix string) (
+	weekDayNameAbbrvs map[int] string,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix += "calendarBaseDataUtility.getDaysOfWeekNameAbbreviations() "
+
+	weekDayNameAbbrvs = map[int] string {
+		0 : "Error",
+	}
+
+	gregCalBDataMech := calendarGregorianBaseDataMechanics{}
+
+	switch dayOfWeekNoSysType {
+
+	case DayOfWeekNumberingSystemType(0).UsDayOfWeek():
+
+		weekDayNameAbbrvs,
+		err = gregCalBDataMech.getDaysOfWeekNameAbbrvsUS(
+			numberOfCharsInAbbreviation,
+			ePrefix)
+
+	case DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek():
+
+		weekDayNameAbbrvs,
+		err = gregCalBDataMech.getDaysOfWeekNameAbbrvsISO8601(
+			numberOfCharsInAbbreviation,
+			ePrefix)
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"ERROR: Input parameter 'dayOfWeekNoSysType' is INVALID!\n" +
+			"dayOfWeekNoSysType='%v'\n",
+			dayOfWeekNoSysType.XValueInt())
+
+	}
+
+	return weekDayNameAbbrvs, err
+}
+
+// getISODayOfWeekNumber - Receives a Julian Day Number and returns
+// the ISO 8601 Day Of The Week Number for that Julian Day Number.
+//
+// Since the seven day week cycle is continuous and independent of
+// the calendar system, the day of the week computed for a given
+// Julian Day Number is the same under every calendar system.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  julianDayNoDto      JulianDayNoDto
+//     - This input parameter contains the data elements of a Julian Day
+//       Number and Time value. The day of the week number will be
+//       computed from this Julian Day Number.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  isoDayOfWeekNo      ISO8601DayOfWeekNo
+//     - If the method completes successfully, this parameter will contain
+//       an enumeration type specifying the day of the week number formatted
+//       according to the ISO 8601 Standard Day Of The Week Numbering System.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note this error message will incorporate the method chain
+//       and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getISODayOfWeekNumber(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	isoDayOfWeekNo ISO8601DayOfWeekNo,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix +=
+		"calendarBaseDataUtility.getISODayOfWeekNumber() "
+
+	isoDayOfWeekNo = ISO8601DayOfWeekNo(0).None()
+
+	err = julianDayNoDto.IsValidInstanceError(ePrefix + "Testing validity of 'julianDayNoDto' ")
+
+	if err != nil {
+		return isoDayOfWeekNo, err
+	}
+
+	calMech := calendarMechanics{}
+
+	var usDayOfWeekNo UsDayOfWeekNo
+
+	usDayOfWeekNo,
+	err = calMech.usDayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	if err != nil {
+		return isoDayOfWeekNo, err
+	}
+
+	isoDayOfWeekNo, err =
+		usDayOfWeekNo.XISO8601DayOfWeekNumber(ePrefix)
+
+	return isoDayOfWeekNo, err
+}
+
+// getUsDayOfWeekNumber - Receives a Julian Day Number and returns
+// the US Day Of The Week Number for that Julian Day Number.
+//
+// Since the seven day week cycle is continuous and independent of
+// the calendar system, the day of the week computed for a given
+// Julian Day Number is the same under every calendar system.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  julianDayNoDto      JulianDayNoDto
+//     - This input parameter contains the data elements of a Julian Day
+//       Number and Time value. The day of the week number will be
+//       computed from this Julian Day Number.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  usDayOfWeekNo       UsDayOfWeekNo
+//     - If the method completes successfully, this parameter will contain
+//       an enumeration type specifying the day of the week number formatted
+//       according to the US Day Of The Week Numbering System.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note this error message will incorporate the method chain
+//       and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getUsDayOfWeekNumber(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	usDayOfWeekNo UsDayOfWeekNo,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix +=
+		"calendarBaseDataUtility.getUsDayOfWeekNumber() "
+
+	usDayOfWeekNo = UsDayOfWeekNo(0).None()
+
+	err = julianDayNoDto.IsValidInstanceError(ePrefix + "Testing validity of 'julianDayNoDto' ")
+
+	if err != nil {
+		return usDayOfWeekNo, err
+	}
+
+	calMech := calendarMechanics{}
+
+	usDayOfWeekNo,
+	err = calMech.usDayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return usDayOfWeekNo, err
+}
+
+// getMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and returns
+// the associated month and day number. The
+// input parameter 'isLeapYear' specifies whether the Ordinal Day Number
+// is included in a standard year (365-Days) or a Leap Year (366-Days).
+//
+// The return value 'yearAdjustment' will be populated with one of two
+// values:
+//
+//       1.  Zero (0)       -
+//             A value of Zero signals that no year adjustment is
+//             required. The ordinal day number was converted to
+//             month and day number in the current year.
+//
+//       2.  Minus One (-1) -
+//             A value of Minus One indicates that the ordinal date
+//             of zero represents December 31st of the prior year.
+//             'year' is therefore equal to year - 1, month = 12 and
+//             day = 31.
+//
+// For more information on Ordinal Date, reference:
+//    https://en.wikipedia.org/wiki/Ordinal_date
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  ordinalDate        int
+//     - A value with a valid range of 0-366 inclusive which specifies
+//       the day of the year expressed as an ordinal day number or ordinal
+//       date.
+//
+//
+//  isLeapYear         bool
+//     - If 'true' it signals that the input parameter 'ordinalDate'
+//       represents an ordinal date within a leap year.
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  yearAdjustment     int
+//     - Either zero (0) or minus one (-1) as described above.
+//
+//
+//  month              int
+//     - If the method completes successfully, this value will contain
+//       the month number associated with the input parameter
+//       'ordinalDate'.
+//
+//
+//  day                int
+//     - If successful this value will contain the day number
+//       associated with the input parameter, 'ordinalDate'.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       in input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	isLeapYear bool,
+	ePrefix string)(
+	yearAdjustment int,
+	month int,
+	day int,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix +=
+		"calendarBaseDataUtility.getMonthDayFromOrdinalDayNo() "
+
+	yearAdjustment = math.MinInt32
+	month = -1
+	day = -1
+	err = nil
+	var ordDays, mthDays map[int]int
+	var daysInYear int
+
+	calBDataMech :=
+		calendarBaseDataMechanics{}
+
+	if isLeapYear {
+
+		daysInYear = calBDataMech.getLeapYearDaysInYear()
+
+		ordDays =
+			calBDataMech.getLeapYearOrdinalDays()
+
+		mthDays =
+			calBDataMech.getLeapYearMonthDays()
+
+	} else {
+
+		daysInYear =
+			calBDataMech.getStandardYearDaysInYear()
+
+		ordDays =
+			calBDataMech.getStandardYearOrdinalDays()
+
+		mthDays =
+			calBDataMech.getStandardYearMonthDays()
+	}
+
+	if ordinalDate < 0 ||
+		ordinalDate > daysInYear {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Input Parameter 'ordinalDate' is INVALID!\n" +
+			"ordinalDate='%v'\n" +
+			"Days In Year='%v'\n",
+			ordinalDate,
+			daysInYear)
+		return yearAdjustment, month, day, err
+	}
+
+	if ordinalDate == 0 {
+		yearAdjustment = -1
+		month = len(mthDays)
+		day = mthDays[month]
+		return yearAdjustment, month, day, err
+	}
+
+	yearAdjustment = 0
+
+	for i := len(ordDays); i > 0; i-- {
+
+		if ordinalDate > ordDays[i] {
+
+			day = ordinalDate - ordDays[i]
+
+			month = i
+
+			if day > mthDays[month] {
+				err = fmt.Errorf(ePrefix + "\n" +
+					"Invalid Ordinal Day Number Result!\n" +
+					"month='%v'  day='%v'\n" +
+					"Original Ordinal Date='%v'\n",
+					month, day, ordinalDate)
+			}
+
+			return yearAdjustment, month, day, err
+		}
+	}
+
+	err = fmt.Errorf(ePrefix + "\n" +
+		"Error: After searching the 'ordinalDays' map,\n" +
+		"no 'month' or 'day' value was returned!\n" +
+		"ordinalDate='%v'\n",
+		ordinalDate)
+
+	return yearAdjustment, month, day, err
+}
+
+// getOrdinalDayNumber - Computes the ordinal day number within a
+// year for any given month and day.
+// Input parameter 'isLeapYear' indicates whether the year encompassing
+// the specified month and day is a 'leap year' containing 366-days
+// instead of the standard year containing 365-days.
+//
+// Consistent with the Gregorian implementation, a 'month' value of
+// one (1) and a 'day' value of zero (0) will return an ordinal day
+// number of zero (0).
+//
+// Reference
+//    https://en.wikipedia.org/wiki/Ordinal_date
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  isLeapYear          bool
+//     - If set to 'true' this boolean value signals that the year
+//       encompassing the 'month' and 'day' input parameters is a
+//       leap year containing 366-days.
+//
+//
+//  month               int
+//     - The month number of the month encompassing the Ordinal Day
+//       Number.
+//
+//
+//  day                 int
+//     - The day number within parameter 'month' which designates
+//       Ordinal Day to be calculated.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ordinalDayNo        int
+//     - The Ordinal Day Number of the month and day specified as
+//       input parameters.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getOrdinalDayNumber(
+	isLeapYear bool,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix += "calendarBaseDataUtility.getOrdinalDayNumber() "
+
+	ordinalDayNo = -1
+	err = nil
+
+	var monthDays, ordinalDays map[int] int
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	if isLeapYear {
+		monthDays = calBDataMech.getLeapYearMonthDays()
+		ordinalDays = calBDataMech.getLeapYearOrdinalDays()
+	} else {
+		monthDays = calBDataMech.getStandardYearMonthDays()
+		ordinalDays = calBDataMech.getStandardYearOrdinalDays()
+	}
+
+	maxMonthNo := len(monthDays)
+
+	if month < 1 || month > maxMonthNo {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Input Parameter 'month' is INVALID!\n" +
+			"The valid range for 'month' number is 1 through %v inclusive.\n" +
+			"month='%v'\n",
+			maxMonthNo,
+			month)
+
+		return ordinalDayNo, err
+	}
+
+	if month == 1 &&
+		day == 0 {
+		ordinalDayNo = 0
+		return ordinalDayNo, err
+	}
+
+	daysInMonth := monthDays[month]
+
+	if day < 1 || day > daysInMonth {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Input parameter 'day' is INVALID!\n" +
+			"The month number is '%v'\n" +
+			"The valid range for 'day' is 1 through %v inclusive.\n" +
+			"day='%v'\n",
+			month,
+			daysInMonth,
+			day)
+
+		return ordinalDayNo, err
+	}
+
+	ordinalDayNo = ordinalDays[month] + day
+
+	return ordinalDayNo, err
+}
+
+// getOrdinalDayNoFromDate - Computes the ordinal day number within
+// a year for the date specified by input parameters 'year', 'month'
+// and 'day'. The year is classified as a leap year or a standard year
+// under the leap year rule, 'leapYearRule'.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                int64
+//     - A year value. This year value is classified by type as being
+//       either an Astronomical Year, Before Common Era Year or a Common
+//       Era Year based on input parameter 'yearNumType'.
+//
+//
+//  yearNumType         CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value. For more information on
+//       CalendarYearNumType reference the source code file:
+//            datetime/calendaryearnumbertypeenum.go
+//
+//
+//  month               int
+//     - The month number of the date.
+//
+//
+//  day                 int
+//     - The day number of the date.
+//
+//
+//  leapYearRule        calendarLeapYearRule
+//     - The leap year rule for the calendar system in which 'year' is
+//       specified.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ordinalDayNo        int
+//     - The Ordinal Day Number of the specified date.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getOrdinalDayNoFromDate(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	leapYearRule calendarLeapYearRule,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix += "calendarBaseDataUtility.getOrdinalDayNoFromDate() "
+
+	ordinalDayNo = math.MinInt32
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	var isLeapYear bool
+
+	isLeapYear,
+	err = calBDataMech.isLeapYear(
+		year,
+		yearNumType,
+		leapYearRule,
+		ePrefix)
+
+	if err != nil {
+		return ordinalDayNo, err
+	}
+
+	calBDataUtil2 := calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil2.getOrdinalDayNumber(
+		isLeapYear,
+		month,
+		day,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// getRemainingDaysInYear - Returns the number of days remaining in
+// the year following the date specified by input parameters 'year',
+// 'month' and 'day'. The year is classified as a leap year or a
+// standard year under the leap year rule, 'leapYearRule'.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                int64
+//     - A year value. This year value is classified by type as being
+//       either an Astronomical Year, Before Common Era Year or a Common
+//       Era Year based on input parameter 'yearNumType'.
+//
+//
+//  yearNumType         CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value. For more information on
+//       CalendarYearNumType reference the source code file:
+//            datetime/calendaryearnumbertypeenum.go
+//
+//
+//  month               int
+//     - The month number of the date.
+//
+//
+//  day                 int
+//     - The day number of the date.
+//
+//
+//  leapYearRule        calendarLeapYearRule
+//     - The leap year rule for the calendar system in which 'year' is
+//       specified.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  remainingDaysOfYear int
+//     - The number of days remaining in the year after the specified
+//       date.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getRemainingDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	leapYearRule calendarLeapYearRule,
+	ePrefix string) (
+	remainingDaysOfYear int,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix += "calendarBaseDataUtility.getRemainingDaysInYear() "
+
+	remainingDaysOfYear = -1
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	var isLeapYear bool
+
+	isLeapYear,
+	err = calBDataMech.isLeapYear(
+		year,
+		yearNumType,
+		leapYearRule,
+		ePrefix)
+
+	if err != nil {
+		return remainingDaysOfYear, err
+	}
+
+	var daysInYear int
+
+	if isLeapYear {
+		daysInYear =
+			calBDataMech.getLeapYearDaysInYear()
+	} else {
+		daysInYear =
+			calBDataMech.getStandardYearDaysInYear()
+	}
+
+	var ordinalDayNo int
+
+	calBDataUtil2 := calendarBaseDataUtility{}
+
+	ordinalDayNo, err = calBDataUtil2.getOrdinalDayNumber(
+		isLeapYear,
+		month,
+		day,
+		ePrefix)
+
+	if err != nil {
+		return remainingDaysOfYear, err
+	}
+
+	remainingDaysOfYear = daysInYear - ordinalDayNo
+
+	return remainingDaysOfYear, err
+}
+
+// getYearMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and a
+// year value. The method then returns the associated astronomical year,
+// month and day under the leap year rule, 'leapYearRule'.
+//
+// For more information on Ordinal Date, reference:
+//    https://en.wikipedia.org/wiki/Ordinal_date
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  ordinalDate        int
+//     - A value with a valid range of 1-366 inclusive which specifies
+//       the day of the year expressed as an ordinal day number or ordinal
+//       date.
+//
+//
+//  year               int64
+//     - The year value encompassing 'ordinalDate'. This year value is
+//       classified by type as being either an Astronomical Year, Before
+//       Common Era Year or a Common Era Year based on the next input
+//       parameter 'yearNumType'.
+//
+//
+//  yearNumType        CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value. For more information on
+//       CalendarYearNumType reference the source code file:
+//            datetime/calendaryearnumbertypeenum.go
+//
+//
+//  leapYearRule       calendarLeapYearRule
+//     - The leap year rule for the calendar system in which 'year' is
+//       specified.
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  astronomicalYear   int64
+//     - The input parameter 'year' expressed as an astronomical year.
+//
+//
+//  month              int
+//     - If the method completes successfully, this value will contain
+//       the month number associated with the input parameter
+//       'ordinalDate'.
+//
+//
+//  day                int
+//     - If successful this value will contain the day number
+//       associated with the input parameter, 'ordinalDate'.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       in input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) getYearMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	year int64,
+	yearNumType CalendarYearNumType,
+	leapYearRule calendarLeapYearRule,
+	ePrefix string)(
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	ePrefix +=
+		"calendarBaseDataUtility.getYearMonthDayFromOrdinalDayNo() "
+
+	astronomicalYear = math.MinInt64
+	month = -1
+	day = -1
+	err = nil
+
+	calMech := calendarMechanics{}
+
+	astronomicalYear,
+	err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	calBDataMech :=
+		calendarBaseDataMechanics{}
+
+	var isLeapYear bool
+
+	isLeapYear,
+	err = calBDataMech.isLeapYear(
+		astronomicalYear,
+		CalendarYearNumType(0).Astronomical(),
+		leapYearRule,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	var ordinalDays map[int]int
+	var daysInYear int
+
+	if isLeapYear {
+		daysInYear = calBDataMech.getLeapYearDaysInYear()
+		ordinalDays = calBDataMech.getLeapYearOrdinalDays()
+	} else {
+		daysInYear = calBDataMech.getStandardYearDaysInYear()
+		ordinalDays = calBDataMech.getStandardYearOrdinalDays()
+	}
+
+	if ordinalDate < 1 ||
+		ordinalDate > daysInYear {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Input Parameter 'ordinalDate' is INVALID!\n" +
+			"ordinalDate='%v'\n" +
+			"Days In Year='%v'\n",
+			ordinalDate,
+			daysInYear)
+
+		return astronomicalYear, month, day, err
+	}
+
+	for i := len(ordinalDays); i > 0 ; i-- {
+
+		testOrdDays, ok := ordinalDays[i]
+
+		if !ok {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Map Ordinal Days Look-Up Failed!\n" +
+				"ordinalDays[i] Failed to return a value!\n" +
+				"i (a.k.a month number) ='%v'\n",
+				i)
+
+			return astronomicalYear, month, day, err
+		}
+
+		if ordinalDate > testOrdDays {
+			day = ordinalDate - testOrdDays
+			month = i
+			return astronomicalYear, month, day, err
+		}
+	}
+
+	err = errors.New(ePrefix + "\n" +
+		"Error: After searching the 'ordinalDays' map,\n" +
+		"no 'month' or 'day' value was returned!\n")
+
+	return astronomicalYear, month, day, err
+}
+
+// isValidDate - Validates year, month and day values under the
+// leap year rule, 'leapYearRule'. If the date is valid, this method returns 'true'
+// and a 'nil' error. Otherwise, 'isValid' is returned as 'false'
+// and the returned error explains why the date is invalid.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                int64
+//     - The year value of the date to be validated. This year value is
+//       classified by type as being either an Astronomical Year, Before
+//       Common Era Year or a Common Era Year based on input parameter
+//       'yearNumType'.
+//
+//
+//  yearNumType         CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value.
+//
+//
+//  month               int
+//     - The month number of the date to be validated.
+//
+//
+//  day                 int
+//     - The day number of the date to be validated.
+//
+//
+//  leapYearRule        calendarLeapYearRule
+//     - The leap year rule for the calendar system in which 'year' is
+//       specified.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  isValid             bool
+//     - Set to 'true' if the date is valid.
+//
+//
+//  err                 error
+//     - If the date is valid, the returned error Type is set equal to
+//       'nil'. Otherwise, the returned error Type will encapsulate an
+//       error message. Note that this error message will incorporate
+//       the method chain and text passed by input parameter, 'ePrefix'.
+//
+func (calBDataUtil *calendarBaseDataUtility) isValidDate(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	leapYearRule calendarLeapYearRule,
+	ePrefix string) (
+	isValid bool,
+	err error) {
+
+	if calBDataUtil.lock == nil {
+		calBDataUtil.lock = new(sync.Mutex)
+	}
+
+	calBDataUtil.lock.Lock()
+
+	defer calBDataUtil.lock.Unlock()
+
+	isValid = false
+	err = nil
+
+	ePrefix += "calendarBaseDataUtility.isValidDate() "
+
+	calBDataMech :=
+		calendarBaseDataMechanics{}
+
+	var isLeapYear bool
+
+	isLeapYear,
+	err = calBDataMech.isLeapYear(
+		year,
+		yearNumType,
+		leapYearRule,
+		ePrefix)
+
+	if err != nil {
+		return isValid, err
+	}
+
+	var mthDays map [int] int
+
+	if isLeapYear {
+		mthDays =
+			calBDataMech.getLeapYearMonthDays()
+	} else {
+		mthDays =
+			calBDataMech.getStandardYearMonthDays()
+	}
+
+	maxMonthValue := len(mthDays)
+
+	if month < 1 ||
+		month > maxMonthValue {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"The Date INVALID!\n" +
+			"'month' parameter is outside the valid range.\n" +
+			"The minimum value for 'month' is '1'.\n" +
+			"The maximum value for 'month' is '%v'.\n" +
+			"The actual value of month is '%v'.\n",
+			maxMonthValue,
+			month)
+		return isValid, err
+	}
+
+	maxDaysInMonth := mthDays[month]
+
+	if day < 1 ||
+		day > maxDaysInMonth {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"The Date INVALID!\n" +
+			"'day' parameter is outside the valid range.\n" +
+			"The minimum value for 'day' is '1'.\n" +
+			"The maximum value for 'day' is '%v'.\n" +
+			"The actual value of 'day' is '%v'.\n",
+			maxDaysInMonth,
+			day)
+		return isValid, err
+	}
+
+	isValid = true
+
+	return isValid, err
+}
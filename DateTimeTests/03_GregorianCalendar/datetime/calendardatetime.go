@@ -14,6 +14,180 @@ type CalendarDateTime struct {
 	lock              *sync.Mutex      // Used for coordinating thread safe operations.
 }
 
+// ConvertTo - Converts the date/time encapsulated by the current
+// CalendarDateTime instance to its equivalent date/time under the
+// calendar system specified by input parameter 'targetCalendar'.
+//
+// The conversion is performed through the Julian Day Number/Time
+// associated with the current CalendarDateTime instance. Therefore,
+// the original and converted date/times identify the same moment
+// in time.
+//
+// The returned CalendarDateTime instance retains the time of day,
+// time zone, leap second status, date/time format and tag description
+// of the current instance. The year numbering mode (Astronomical or
+// Common Era) is also retained. However, the year type (BCE or CE)
+// is recomputed because the converted date may fall in a different
+// era. For example, Julian Calendar date January 1, 0001 CE converts
+// to Gregorian Calendar date December 30, 0001 BCE.
+//
+// Conversions to the Julian/Gregorian Calendar apply the default
+// cutover date, 15 October 1582 Gregorian. To apply a different
+// cutover date, use method ConvertToJulianGregorian().
+//
+// The current CalendarDateTime instance is NOT altered by this method.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  targetCalendar      CalendarSpec
+//     - An enumeration type designating the Calendar System to which
+//       the current CalendarDateTime instance will be converted.
+//       Reference:
+//       Source File: datetime\calendarspecenum.go
+//
+//       Possible Calendar System values include:
+//       Gregorian, Julian, Revised Julian, or
+//       Revised Goucher-Parker.
+//
+//       Possible Enumeration Values:
+//         CalendarSpec(0).Gregorian()
+//         CalendarSpec(0).Julian()
+//         CalendarSpec(0).RevisedJulian()
+//         CalendarSpec(0).RevisedGoucherParker()
+//         CalendarSpec(0).JulianGregorian()
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  CalendarDateTime
+//     - If successful, this method returns a new CalendarDateTime
+//       instance expressing the date/time of the current instance
+//       under the calendar system specified by 'targetCalendar'.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (calDTime *CalendarDateTime) ConvertTo(
+	targetCalendar CalendarSpec,
+	ePrefix string) (
+	CalendarDateTime,
+	error) {
+
+	if calDTime.lock == nil {
+		calDTime.lock = new(sync.Mutex)
+	}
+
+	calDTime.lock.Lock()
+
+	defer calDTime.lock.Unlock()
+
+	ePrefix += "CalendarDateTime.ConvertTo() "
+
+	calDTimeUtil := calendarDateTimeUtility{}
+
+	return calDTimeUtil.convertToCalendar(
+		calDTime,
+		targetCalendar,
+		0,
+		ePrefix)
+}
+
+// ConvertToJulianGregorian - Converts the date/time encapsulated by
+// the current CalendarDateTime instance to its equivalent date/time
+// under the Julian/Gregorian Calendar using the cutover Julian Day
+// Number specified by input parameter 'cutoverJulianDayNo'.
+//
+// Method ConvertTo() always applies the default cutover date, 15
+// October 1582 Gregorian, when converting to the Julian/Gregorian
+// Calendar. Use this method for calendars which adopted the Gregorian
+// reform on a different date. For example, Great Britain and its
+// colonies adopted the reform on 14 September 1752 Gregorian (Julian
+// Day Number 2361222).
+//
+// Dates prior to the cutover Julian Day Number are returned as
+// Julian Calendar dates. All other dates are returned as Gregorian
+// Calendar dates. The returned instance retains the cutover Julian
+// Day Number.
+//
+// The current CalendarDateTime instance is NOT altered by this method.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  cutoverJulianDayNo  int64
+//     - The first Julian Day Number governed by the Gregorian
+//       Calendar. If this value is zero, the default cutover Julian
+//       Day Number, 2299161, is applied. Otherwise, the value must be
+//       greater than or equal to 1794168 (1 March 200 Julian and
+//       Gregorian).
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  CalendarDateTime
+//     - If successful, this method returns a new CalendarDateTime
+//       instance expressing the date/time of the current instance
+//       under the Julian/Gregorian Calendar.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (calDTime *CalendarDateTime) ConvertToJulianGregorian(
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	CalendarDateTime,
+	error) {
+
+	if calDTime.lock == nil {
+		calDTime.lock = new(sync.Mutex)
+	}
+
+	calDTime.lock.Lock()
+
+	defer calDTime.lock.Unlock()
+
+	ePrefix += "CalendarDateTime.ConvertToJulianGregorian() "
+
+	calDTimeUtil := calendarDateTimeUtility{}
+
+	return calDTimeUtil.convertToCalendar(
+		calDTime,
+		CalendarSpec(0).JulianGregorian(),
+		cutoverJulianDayNo,
+		ePrefix)
+}
+
 // CopyIn - Populates the current CalendarDateTime instance with a deep copy
 // of member data elements extracted from the the incoming CalendarDateTime
 // instance, 'incomingCalDTime'.
@@ -107,10 +281,137 @@ func (calDTime *CalendarDateTime) CopyOut(
 
 	calDTimeUtil := calendarDateTimeUtility{}
 
-	newCalDTime := CalendarDateTime{}
-
 	return calDTimeUtil.copyOut(
-		&newCalDTime,
+		calDTime,
+		ePrefix)
+}
+
+// FormatDateTime - Returns a date time string formatted according to
+// the token format string, 'tokenFormatStr'.
+//
+// Unlike method CalendarDateTime.GetDateTimeStr(), this method does not
+// rely on Go 'time' package layouts. Instead, it operates directly on
+// the year, month, day and time components of the current
+// CalendarDateTime instance. Therefore, years beyond +/-9999, Before
+// Common Era (BCE) dates and dates under non-Gregorian calendars are
+// formatted correctly.
+//
+// Be advised that this method validates the current CalendarDateTime
+// instance. If this instance is judged to be invalid, an error is
+// returned.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  tokenFormatStr     string
+//     - A format string containing format tokens. Each format token
+//       consists of a percent sign ('%'), an optional '-' flag which
+//       suppresses zero padding, an optional precision ('.n') and a
+//       token character. All other characters are copied to the
+//       returned string unchanged.
+//
+//        Token   Description                                Example
+//        -----   -----------                                -------
+//         %Y     Astronomical Year, minimum four digits     "-0043"
+//         %y     Era Year (BCE/CE numbering)                "44"
+//         %E     Era suffix                                 "BCE"
+//         %C     Calendar System                            "Julian"
+//         %m     Month Number, two digits                   "03"
+//         %B     Month Name                                 "March"
+//         %b     Month Name Abbreviation                    "Mar"
+//         %d     Day of the month, two digits               "15"
+//         %j     Ordinal Day Number, three digits           "074"
+//         %A     Day of the week name                       "Wednesday"
+//         %a     Day of the week abbreviation               "Wed"
+//         %u     ISO 8601 day of the week number (1-7)      "3"
+//         %V     ISO 8601 week number, two digits           "11"
+//         %G     ISO 8601 week-based Astronomical Year      "-0043"
+//         %H     Hour (00-23)                               "13"
+//         %I     Hour (01-12)                               "01"
+//         %p     AM or PM                                   "PM"
+//         %M     Minute, two digits                         "30"
+//         %S     Second, two digits (00-60)                 "05"
+//         %f     Fractional seconds. Default nine digits.   "123456789"
+//                '%.3f' yields three digits.                "123"
+//         %z     UTC Offset                                 "-0600"
+//         %Z     Time Zone abbreviation                     "CST"
+//         %J     Julian Day Number. '%.6J' yields the       "1705426"
+//                Julian Day Number/Time with six digits
+//                to the right of the decimal.               "1705426.062564"
+//         %%     Literal percent sign                       "%"
+//
+//       Example:
+//         "%y %E %B %-d, %H:%M:%S" = "44 BCE March 15, 13:30:05"
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  dateTimeStr        string
+//     - If successful, this method returns a string containing the
+//       date time of the current CalendarDateTime instance formatted
+//       according to input parameter 'tokenFormatStr'.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (calDTime *CalendarDateTime) FormatDateTime(
+	tokenFormatStr string,
+	ePrefix string) (
+	dateTimeStr string,
+	err error) {
+
+	if calDTime.lock == nil {
+		calDTime.lock = new(sync.Mutex)
+	}
+
+	calDTime.lock.Lock()
+
+	defer calDTime.lock.Unlock()
+
+	ePrefix += "CalendarDateTime.FormatDateTime() "
+
+	calDtMech := calendarDateTimeMechanics{}
+
+	_, err = calDtMech.testCalendarDateTimeValidity(
+		calDTime,
+		ePrefix)
+
+	if err != nil {
+		return dateTimeStr, err
+	}
+
+	dtTokenMech := dateTimeTokenFormatMechanics{}
+
+	var fields dateTimeTokenFields
+
+	fields, err = dtTokenMech.getTokenFields(
+		&calDTime.dateTimeDto.date,
+		&calDTime.dateTimeDto.time,
+		&calDTime.julianDayNumber,
+		ePrefix)
+
+	if err != nil {
+		return dateTimeStr, err
+	}
+
+	return dtTokenMech.formatDateTime(
+		&fields,
+		tokenFormatStr,
 		ePrefix)
 }
 
@@ -200,13 +501,15 @@ func (calDTime *CalendarDateTime) GetCalendarSpecification(
 //       Source File: datetime\calendarspecenum.go
 //
 //       Possible Calendar System values include:
-//       Gregorian, Julian, Revised Julian, or Revised Goucher-Parker.
+//       Gregorian, Julian, Revised Julian, Revised Goucher-Parker or
+//       Julian/Gregorian.
 //
 //       Possible Enumeration Values:
 //         CalendarSpec(0).Gregorian()
 //         CalendarSpec(0).Julian()
 //         CalendarSpec(0).RevisedJulian()
 //         CalendarSpec(0).RevisedGoucherParker()
+//         CalendarSpec(0).JulianGregorian()
 //
 //
 //  month           int
@@ -418,6 +721,70 @@ func (calDTime *CalendarDateTime) GetDaysInYear(
 	return calDTime.dateTimeDto.date.calendarBaseData.GetDaysInLeapYear(), nil
 }
 
+// GetISOWeekDate - Returns the ISO 8601 week date for this Calendar
+// Date Time instance. The week date is computed under the calendar
+// system associated with the current CalendarDateTime instance.
+//
+// The returned ISOWeekDateDto contains the week-based year, the week
+// number (1-53) and the ISO 8601 day of the week number (Monday=1,
+// Sunday=7). The week-based year is formatted as an Astronomical
+// Year. Therefore, the year 1 BCE is returned as year zero (0).
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/ISO_week_date
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  ePrefix       string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ISOWeekDateDto
+//     - If successful, this method returns the ISO 8601 week date
+//       equivalent to the date of this CalendarDateTime instance.
+//
+//
+//  err      error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (calDTime *CalendarDateTime) GetISOWeekDate(
+	ePrefix string) (ISOWeekDateDto, error) {
+
+	if calDTime.lock == nil {
+		calDTime.lock = new(sync.Mutex)
+	}
+
+	calDTime.lock.Lock()
+
+	defer calDTime.lock.Unlock()
+
+	ePrefix += "CalendarDateTime.GetISOWeekDate() "
+
+	calDtMech := calendarDateTimeMechanics{}
+
+	_, err := calDtMech.testCalendarDateTimeValidity(
+		calDTime,
+		ePrefix)
+
+	if err != nil {
+		return ISOWeekDateDto{}, err
+	}
+
+	return calDTime.dateTimeDto.GetISOWeekDate(ePrefix)
+}
+
 // GetOrdinalDayNo - Returns the Ordinal Day Number for this Calendar
 // Date Time instance. This Ordinal Day Number is the day number within
 // the year specified by the current CalendarDateTime instance.
@@ -1031,6 +1398,7 @@ func (calDTime CalendarDateTime) NewGregorianDate(
 		applyLeapSecond,
 		timeZoneLocation,
 		CalSpec.Gregorian(),
+		0,
 		yearNumberType,
 		dateTimeFmt,
 		ePrefix)
@@ -1124,6 +1492,115 @@ func (calDTime CalendarDateTime) NewJulianDate(
 		nanoseconds,
 		false,
 		timeZoneLocation, CalendarSpec(0).Julian(),
+		0,
+		yearNumberType,
+		dateTimeFmt,
+		ePrefix)
+
+	return calDateTime, err
+}
+
+// NewJulianGregorianDate - Creates a new instance of 'CalendarDateTime'
+// formatted for a Julian/Gregorian Date Time using the cutover Julian
+// Day Number specified by input parameter 'cutoverJulianDayNo'.
+//
+// Dates prior to the cutover are Julian Calendar dates. All other dates
+// are Gregorian Calendar dates. Dates skipped by the reform are invalid.
+// For example, with the British cutover, Julian Day Number 2361222,
+// September 3 through September 13, 1752 are invalid.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  cutoverJulianDayNo int64
+//    - The first Julian Day Number governed by the Gregorian Calendar.
+//      If this value is zero, the default cutover Julian Day Number,
+//      2299161 (15 October 1582 Gregorian), is applied. Otherwise, the
+//      value must be greater than or equal to 1794168.
+//
+//  year               int64
+//    - The year number. This value is interpreted according to input
+//      parameter 'yearNumberType'.
+//
+//  yearNumberType     CalendarYearNumType
+//    - Classifies input parameter 'year' as an Astronomical Year, a
+//      Before Common Era (BCE) year or a Common Era (CE) year.
+//
+//  month              int
+//    - The month number.
+//
+//  day                int
+//    - The day number
+//
+//  hours              int
+//    - The hour number expressed on a 24-hour time scale.
+//      Example: 3:00PM is passed as the hour 15
+//
+//  minutes            int
+//    - The minutes number
+//
+//  seconds            int
+//    - The number of seconds
+//
+//  nanoseconds        int
+//    - The number of nanoseconds
+//
+//  timeZoneLocation   string
+//    - This string identifies the Time Zone associated with parameters
+//      hours, minutes, seconds and nanoseconds.
+//
+//  dateTimeFmt        string
+//    - This string contains the date/time format which will be used to
+//      to format date/time output values. Example:
+//          "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//  ePrefix            string
+//    This is an error prefix which is included in all returned
+//    error messages. Usually, it contains the names of the calling
+//    method or methods.
+//
+func (calDTime CalendarDateTime) NewJulianGregorianDate(
+	cutoverJulianDayNo int64,
+	year int64,
+	yearNumberType CalendarYearNumType,
+	month,
+	day,
+	hours,
+	minutes,
+	seconds,
+	nanoseconds int,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (calDateTime CalendarDateTime, err error) {
+
+	if calDTime.lock == nil {
+		calDTime.lock = new(sync.Mutex)
+	}
+
+	calDTime.lock.Lock()
+
+	defer calDTime.lock.Unlock()
+
+	ePrefix += "CalendarDateTime.NewJulianGregorianDate() "
+
+	calDTimeUtil := calendarDateTimeUtility{}
+
+	calDateTime = CalendarDateTime{}
+
+	err = calDTimeUtil.setCalDateTime(
+		&calDateTime,
+		year,
+		month,
+		day,
+		hours,
+		minutes,
+		seconds,
+		nanoseconds,
+		false,
+		timeZoneLocation,
+		CalendarSpec(0).JulianGregorian(),
+		cutoverJulianDayNo,
 		yearNumberType,
 		dateTimeFmt,
 		ePrefix)
@@ -1267,6 +1744,7 @@ func (calDTime CalendarDateTime) NewCalDateTime(
 		applyLeapSecond,
 		timeZoneLocation,
 		calendarSystem,
+		0,
 		yearNumberType,
 		dateTimeFmt,
 		ePrefix)
@@ -1286,13 +1764,15 @@ func (calDTime CalendarDateTime) NewCalDateTime(
 //       Source File: datetime\calendarspecenum.go
 //
 //       Possible Calendar System values include:
-//       Gregorian, Julian, Revised Julian, or Revised Goucher-Parker.
+//       Gregorian, Julian, Revised Julian, Revised Goucher-Parker or
+//       Julian/Gregorian.
 //
 //       Possible Enumeration Values:
 //         CalendarSpec(0).Gregorian()
 //         CalendarSpec(0).Julian()
 //         CalendarSpec(0).RevisedJulian()
 //         CalendarSpec(0).RevisedGoucherParker()
+//         CalendarSpec(0).JulianGregorian()
 //
 //
 //  year                     int64
@@ -1473,6 +1953,307 @@ func (calDTime CalendarDateTime) NewCalDateTimeFromComponents(
 		ePrefix)
 	}
 
+// NewCalDateTimeFromISOWeekDate - Creates and returns a new instance
+// of CalendarDateTime from an ISO 8601 week date string and time
+// components.
+//
+// The ISO 8601 week date is converted to a date under the calendar
+// system specified by input parameter 'calendarSystem'. Both the
+// extended format, "YYYY-Www-D", and the basic format, "YYYYWwwD",
+// are supported. Years outside the range 0000 through 9999 must be
+// preceded by a plus (+) or minus (-) sign. Year values are
+// interpreted as Astronomical Years. The returned CalendarDateTime
+// instance is configured for Astronomical Year numbering.
+//
+//  Examples:
+//    Gregorian "2009-W01-1"  = 2008-12-29
+//    Gregorian "-0001-W01-1" = Astronomical Year -1 (2 BCE)
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calendarSystem     CalendarSpec
+//     - An enumeration type designating the Calendar System under
+//       which the ISO week date will be converted. Reference:
+//       Source File: datetime\calendarspecenum.go
+//
+//
+//  isoWeekDateStr     string
+//     - An ISO 8601 week date string. Example: "2009-W01-1"
+//
+//
+//  hours              int
+//    - The hour number expressed on a 24-hour time scale.
+//
+//
+//  minutes            int
+//    - The minutes number
+//
+//
+//  seconds            int
+//    - The number of seconds
+//
+//
+//  nanoseconds        int
+//    - The number of nanoseconds
+//
+//
+//  applyLeapSecond    bool
+//     - If this parameter is set to 'true', it signals that the time value
+//       includes a "Leap Second". This parameter is rarely used and is almost
+//       always set to 'false'.
+//
+//
+//  timeZoneLocation   string
+//    - This string identifies the Time Zone associated with parameters
+//      hours, minutes, seconds and nanoseconds.
+//
+//
+//  dateTimeFmt        string
+//    - This string contains the date/time format which will be used to
+//      to format date/time output values. Example:
+//          "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//
+//  ePrefix            string
+//    This is an error prefix which is included in all returned
+//    error messages. Usually, it contains the names of the calling
+//    method or methods.
+//
+func (calDTime CalendarDateTime) NewCalDateTimeFromISOWeekDate(
+	calendarSystem CalendarSpec,
+	isoWeekDateStr string,
+	hours,
+	minutes,
+	seconds,
+	nanoseconds int,
+	applyLeapSecond bool,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (
+	calDateTime CalendarDateTime,
+	err error) {
+
+	if calDTime.lock == nil {
+		calDTime.lock = new(sync.Mutex)
+	}
+
+	calDTime.lock.Lock()
+
+	defer calDTime.lock.Unlock()
+
+	ePrefix += "CalendarDateTime.NewCalDateTimeFromISOWeekDate() "
+
+	calDateTime = CalendarDateTime{}
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	var weekYear, year int64
+	var weekNumber, month, day int
+	var weekDay ISO8601DayOfWeekNo
+
+	weekYear,
+	weekNumber,
+	weekDay,
+	err = isoWeekDateMech.parseISOWeekDateStr(
+		isoWeekDateStr,
+		ePrefix)
+
+	if err != nil {
+		return calDateTime, err
+	}
+
+	year,
+	month,
+	day,
+	err = isoWeekDateMech.getDateFromISOWeekDate(
+		calendarSystem,
+		weekYear,
+		weekNumber,
+		weekDay,
+		ePrefix)
+
+	if err != nil {
+		return calDateTime, err
+	}
+
+	calDTimeUtil := calendarDateTimeUtility{}
+
+	err = calDTimeUtil.setCalDateTime(
+		&calDateTime,
+		year,
+		month,
+		day,
+		hours,
+		minutes,
+		seconds,
+		nanoseconds,
+		applyLeapSecond,
+		timeZoneLocation,
+		calendarSystem,
+		0,
+		CalendarYearNumType(0).Astronomical(),
+		dateTimeFmt,
+		ePrefix)
+
+	return calDateTime, err
+}
+
+// NewCalDateTimeFromString - Creates a new CalendarDateTime instance by parsing a
+// date/time string. Parsing is driven by the same format tokens used
+// by method CalendarDateTime.FormatDateTime().
+//
+// The year may be parsed as an Astronomical Year with token '%Y' or
+// as a Common Era year with tokens '%y' and '%E'. Era values 'BCE',
+// 'BC', 'CE' and 'AD' are accepted. If token '%E' is omitted, '%y' is
+// treated as a Common Era (CE) year.
+//
+// Examples:
+//
+//  dateTimeStr                  tokenFormatStr
+//  -----------                  --------------
+//  "0044-03-15 BCE 12:00:00"    "%y-%m-%d %E %H:%M:%S"
+//  "-0043-03-15"                "%Y-%m-%d"
+//  "4713-01-01 BCE"             "%y-%m-%d %E"
+//  "March 15, 44 BC"            "%B %-d, %y %E"
+//  "2021-W53-7"                 "%G-W%V-%u"
+//
+// Month names, day of the week names and era values are matched
+// without regard to case. Missing month or day values default to one
+// (1). Missing time components default to zero (0). Day of the week
+// tokens are validated against the parsed date. Token '%z' is parsed
+// but ignored. Token '%J' is not supported for parsing.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calendarSystem      CalendarSpec
+//     - Designates the calendar system under which the date/time
+//       string is interpreted. If 'tokenFormatStr' contains token
+//       '%C', the parsed calendar system takes precedence.
+//
+//
+//  dateTimeStr         string
+//     - The date/time string to be parsed.
+//
+//
+//  tokenFormatStr      string
+//     - The token format string describing 'dateTimeStr'. For a
+//       list of format tokens, reference method
+//       CalendarDateTime.FormatDateTime().
+//
+//
+//  timeZoneLocation    string
+//     - The time zone associated with the parsed time. If this
+//       parameter is an empty string, the time zone parsed with
+//       token '%Z' is used. If neither is available, the time zone
+//       defaults to UTC.
+//
+//
+//  dateTimeFmt         string
+//     - The date/time format used to format date/time output values.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  RETNewCalDateTimeFromString
+//     - If successful, this method returns a new, populated instance
+//       of CalendarDateTime.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calDTime CalendarDateTime) NewCalDateTimeFromString(
+	calendarSystem CalendarSpec,
+	dateTimeStr string,
+	tokenFormatStr string,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	ePrefix string) (
+	calDateTime CalendarDateTime,
+	err error) {
+
+	if calDTime.lock == nil {
+		calDTime.lock = new(sync.Mutex)
+	}
+
+	calDTime.lock.Lock()
+
+	defer calDTime.lock.Unlock()
+
+	ePrefix += "CalendarDateTime.NewCalDateTimeFromString() "
+
+	calDateTime = CalendarDateTime{}
+
+	dtParseMech := dateTimeTokenParseMechanics{}
+
+	var parsed dateTimeTokenParseResult
+
+	parsed, err = dtParseMech.parseDateTime(
+		dateTimeStr,
+		tokenFormatStr,
+		calendarSystem,
+		ePrefix)
+
+	if err != nil {
+		return calDateTime, err
+	}
+
+	if len(timeZoneLocation) == 0 {
+		timeZoneLocation = parsed.timeZoneName
+	}
+
+	if len(timeZoneLocation) == 0 {
+		timeZoneLocation = TZones.UTC()
+	}
+
+	calDTimeUtil := calendarDateTimeUtility{}
+
+	err = calDTimeUtil.setCalDateTime(
+		&calDateTime,
+		parsed.astronomicalYear,
+		parsed.month,
+		parsed.day,
+		parsed.hour,
+		parsed.minute,
+		parsed.second,
+		parsed.nanosecond,
+		parsed.second == 60,
+		timeZoneLocation,
+		parsed.calendarSystem,
+		0,
+		CalendarYearNumType(0).Astronomical(),
+		dateTimeFmt,
+		ePrefix)
+
+	if err != nil {
+		return CalendarDateTime{}, err
+	}
+
+	dtParseMech.setYearNumberingMode(
+		&calDateTime.dateTimeDto.date,
+		parsed.yearNumType)
+
+	return calDateTime, err
+}
+
 // SetDateTimeFormat - Sets the Date Time Format for the current
 // CalendarDateTime instance. The format string is stored in
 // internal member variable, 'calDTime.dateTimeFmt'.
@@ -180,6 +180,320 @@ func (calDtMech *calendarDateTimeMechanics) getCalendarYear(
 	return year, yearNumType, isLeapYear, calendarSystem, err
 }
 
+// getDateTimeFromJulianDayNumber - Converts a Julian Day Number/Time
+// to a date/time value under the calendar system specified by input
+// parameter 'calendarSystem'.
+//
+// The returned date/time is expressed in Universal Coordinated Time
+// (UTC) and the year value is formatted as an Astronomical Year.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calendarSystem     CalendarSpec
+//     - An enumeration type designating the Calendar System in which
+//       the returned date/time will be expressed. Reference:
+//       Source File: datetime\calendarspecenum.go
+//
+//       Possible Calendar System values include:
+//       Gregorian, Julian, Revised Julian, or
+//       Revised Goucher-Parker.
+//
+//
+//  julianDayNoDto     JulianDayNoDto
+//     - The Julian Day Number/Time which will be converted to a
+//       date/time value.
+//
+//
+//  cutoverJulianDayNo int64
+//     - Applies only to the Julian/Gregorian Calendar. This is the
+//       first Julian Day Number governed by the Gregorian Calendar.
+//       If this value is zero, the default cutover Julian Day
+//       Number, 2299161 (15 October 1582 Gregorian), is applied.
+//       For all other calendar systems, this parameter is ignored.
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  dateTimeDto        ADateTimeDto
+//     - If successful, this method returns the date/time equivalent
+//       of 'julianDayNoDto' under the designated calendar system.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (calDtMech *calendarDateTimeMechanics) getDateTimeFromJulianDayNumber(
+	calendarSystem CalendarSpec,
+	julianDayNoDto JulianDayNoDto,
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	dateTimeDto ADateTimeDto,
+	err error) {
+
+	if calDtMech.lock == nil {
+		calDtMech.lock = new(sync.Mutex)
+	}
+
+	calDtMech.lock.Lock()
+
+	defer calDtMech.lock.Unlock()
+
+	ePrefix += "calendarDateTimeMechanics.getDateTimeFromJulianDayNumber() "
+
+	switch calendarSystem {
+
+	case CalendarSpec(0).Gregorian():
+
+		calGregUtil := CalendarGregorianUtility{}
+
+		dateTimeDto, err = calGregUtil.DateTimeFromJulianDateTime(
+			julianDayNoDto,
+			ePrefix)
+
+	case CalendarSpec(0).Julian():
+
+		calJulianUtil := CalendarJulianUtility{}
+
+		dateTimeDto, err = calJulianUtil.DateTimeFromJulianDateTime(
+			julianDayNoDto,
+			ePrefix)
+
+	case CalendarSpec(0).RevisedJulian():
+
+		calRevJulianUtil := CalendarRevisedJulianUtility{}
+
+		dateTimeDto, err = calRevJulianUtil.DateTimeFromJulianDateTime(
+			julianDayNoDto,
+			ePrefix)
+
+	case CalendarSpec(0).RevisedGoucherParker():
+
+		calRevGoucherParkerUtil := CalendarRevisedGoucherParkerUtility{}
+
+		dateTimeDto, err = calRevGoucherParkerUtil.DateTimeFromJulianDateTime(
+			julianDayNoDto,
+			ePrefix)
+
+	case CalendarSpec(0).JulianGregorian():
+
+		calJulianGregUtil := CalendarJulianGregorianUtility{
+			cutoverJulianDayNo: cutoverJulianDayNo,
+		}
+
+		dateTimeDto, err = calJulianGregUtil.DateTimeFromJulianDateTime(
+			julianDayNoDto,
+			ePrefix)
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Invalid Calendar Specification.\n" +
+			"Calendar Specification='%v'\n",
+			calendarSystem.String())
+	}
+
+	return dateTimeDto, err
+}
+
+// getJulianDayNumber - Computes the Julian Day Number/Time for a
+// date/time specified under the calendar system designated by input
+// parameter 'calendarSystem'.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calendarSystem     CalendarSpec
+//     - An enumeration type designating the Calendar System in which
+//       the date/time input parameters are expressed. Reference:
+//       Source File: datetime\calendarspecenum.go
+//
+//       Possible Calendar System values include:
+//       Gregorian, Julian, Revised Julian, or
+//       Revised Goucher-Parker.
+//
+//
+//  astronomicalYear   int64
+//     - The year number formatted as an Astronomical Year.
+//
+//
+//  month              int
+//     - The month number
+//
+//
+//  day                int
+//     - The day number
+//
+//
+//  hour               int
+//     - The hour number expressed on a 24-hour time scale.
+//
+//
+//  minute             int
+//     - The minute number
+//
+//
+//  second             int
+//     - The second number
+//
+//
+//  nanosecond         int
+//     - The nanosecond number
+//
+//
+//  cutoverJulianDayNo int64
+//     - Applies only to the Julian/Gregorian Calendar. This is the
+//       first Julian Day Number governed by the Gregorian Calendar.
+//       If this value is zero, the default cutover Julian Day
+//       Number, 2299161 (15 October 1582 Gregorian), is applied.
+//       For all other calendar systems, this parameter is ignored.
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  julianDayNoDto     JulianDayNoDto
+//     - If successful, this method returns the Julian Day Number/Time
+//       equivalent of the date/time input parameters.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (calDtMech *calendarDateTimeMechanics) getJulianDayNumber(
+	calendarSystem CalendarSpec,
+	astronomicalYear int64,
+	month,
+	day,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	julianDayNoDto JulianDayNoDto,
+	err error) {
+
+	if calDtMech.lock == nil {
+		calDtMech.lock = new(sync.Mutex)
+	}
+
+	calDtMech.lock.Lock()
+
+	defer calDtMech.lock.Unlock()
+
+	ePrefix += "calendarDateTimeMechanics.getJulianDayNumber() "
+
+	switch calendarSystem {
+
+	case CalendarSpec(0).Gregorian():
+
+		calGregUtil := CalendarGregorianUtility{}
+
+		julianDayNoDto, err = calGregUtil.GetJulianDayNumber(
+			astronomicalYear,
+			month,
+			day,
+			hour,
+			minute,
+			second,
+			nanosecond,
+			ePrefix)
+
+	case CalendarSpec(0).Julian():
+
+		calJulianUtil := CalendarJulianUtility{}
+
+		julianDayNoDto, err = calJulianUtil.GetJulianDayNumber(
+			astronomicalYear,
+			month,
+			day,
+			hour,
+			minute,
+			second,
+			nanosecond,
+			ePrefix)
+
+	case CalendarSpec(0).RevisedJulian():
+
+		calRevJulianUtil := CalendarRevisedJulianUtility{}
+
+		julianDayNoDto, err = calRevJulianUtil.GetJulianDayNumber(
+			astronomicalYear,
+			month,
+			day,
+			hour,
+			minute,
+			second,
+			nanosecond,
+			ePrefix)
+
+	case CalendarSpec(0).RevisedGoucherParker():
+
+		calRevGoucherParkerUtil := CalendarRevisedGoucherParkerUtility{}
+
+		julianDayNoDto, err = calRevGoucherParkerUtil.GetJulianDayNumber(
+			astronomicalYear,
+			month,
+			day,
+			hour,
+			minute,
+			second,
+			nanosecond,
+			ePrefix)
+
+	case CalendarSpec(0).JulianGregorian():
+
+		calJulianGregUtil := CalendarJulianGregorianUtility{
+			cutoverJulianDayNo: cutoverJulianDayNo,
+		}
+
+		julianDayNoDto, err = calJulianGregUtil.GetJulianDayNumber(
+			astronomicalYear,
+			month,
+			day,
+			hour,
+			minute,
+			second,
+			nanosecond,
+			ePrefix)
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Invalid Calendar Specification.\n" +
+			"Calendar Specification='%v'\n",
+			calendarSystem.String())
+	}
+
+	return julianDayNoDto, err
+}
+
 // isGregorianLeapYear - Returns true if the year number is
 // a leap year under the Gregorian Calendar.
 //
@@ -237,9 +551,14 @@ func (calDtMech *calendarDateTimeMechanics) isGregorianLeapYear(
 // isLeapYear - Determines whether input parameter 'year'
 // is a leap year under the specified calendar.
 //
+// Input parameter 'cutoverJulianDayNo' applies only to the
+// Julian/Gregorian Calendar. A zero value applies the default
+// cutover Julian Day Number, 2299161.
+//
 func (calDtMech *calendarDateTimeMechanics) isLeapYear(
 	year int64,
 	calendar CalendarSpec,
+	cutoverJulianDayNo int64,
 	ePrefix string) (bool, error) {
 
 	if calDtMech.lock == nil {
@@ -280,6 +599,14 @@ func (calDtMech *calendarDateTimeMechanics) isLeapYear(
 
 		isALeapYear = calRevGoucherParkerMech.isLeapYear(year)
 
+	case CalSpec.JulianGregorian():
+
+		calJulianGregUtil := CalendarJulianGregorianUtility{
+			cutoverJulianDayNo: cutoverJulianDayNo,
+		}
+
+		isALeapYear = calJulianGregUtil.IsLeapYear(year)
+
 	default:
 
 		isALeapYear = false
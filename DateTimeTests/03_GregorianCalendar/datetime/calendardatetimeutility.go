@@ -2,12 +2,10 @@ package datetime
 
 import (
 	"errors"
-	"fmt"
 	"math/big"
 	"strconv"
 	"strings"
 	"sync"
-	"time"
 )
 
 type calendarDateTimeUtility struct {
@@ -15,6 +13,209 @@ type calendarDateTimeUtility struct {
 }
 
 
+// convertToCalendar - Converts the date/time encapsulated by input
+// parameter 'calDTime' to its equivalent date/time under the calendar
+// system specified by input parameter 'targetCalendar'.
+//
+// The conversion is performed through the Julian Day Number/Time
+// associated with 'calDTime'. The returned CalendarDateTime instance
+// retains the time of day, time zone, leap second status, year
+// numbering mode, date/time format and tag description of 'calDTime'.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  calDTime            *CalendarDateTime
+//     - A pointer to an instance of CalendarDateTime. The date/time
+//       value encapsulated by this instance will be converted to the
+//       target calendar system. 'calDTime' is NOT altered by this method.
+//
+//
+//  targetCalendar      CalendarSpec
+//     - An enumeration type designating the Calendar System to which
+//       'calDTime' will be converted. Reference:
+//       Source File: datetime\calendarspecenum.go
+//
+//       Possible Calendar System values include:
+//       Gregorian, Julian, Revised Julian, or
+//       Revised Goucher-Parker.
+//
+//
+//  cutoverJulianDayNo  int64
+//     - Applies only when 'targetCalendar' is the Julian/Gregorian
+//       Calendar. This is the first Julian Day Number governed by the
+//       Gregorian Calendar. If this value is zero, the default cutover
+//       Julian Day Number, 2299161 (15 October 1582 Gregorian), is
+//       applied. For all other calendar systems, this parameter is
+//       ignored.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  convertedCalDTime   CalendarDateTime
+//     - If successful, this method returns a new CalendarDateTime
+//       instance expressing the date/time of 'calDTime' under the
+//       calendar system specified by 'targetCalendar'.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+//
+func (calDTimeUtil *calendarDateTimeUtility) convertToCalendar(
+	calDTime *CalendarDateTime,
+	targetCalendar CalendarSpec,
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	convertedCalDTime CalendarDateTime,
+	err error) {
+
+	if calDTimeUtil.lock == nil {
+		calDTimeUtil.lock = new(sync.Mutex)
+	}
+
+	calDTimeUtil.lock.Lock()
+
+	defer calDTimeUtil.lock.Unlock()
+
+	ePrefix += "calendarDateTimeUtility.convertToCalendar() "
+
+	convertedCalDTime = CalendarDateTime{}
+
+	if calDTime == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Input parameter 'calDTime' is INVALID!\n" +
+			"calDTime == nil\n")
+		return convertedCalDTime, err
+	}
+
+	if !targetCalendar.XIsValid()  {
+		err = &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "targetCalendar",
+			inputParameterValue: targetCalendar.String() ,
+			errMsg:              "'targetCalendar' is INVALID!",
+			err:                 nil,
+		}
+
+		return convertedCalDTime, err
+	}
+
+	calDtMech := calendarDateTimeMechanics{}
+
+	_, err = calDtMech.testCalendarDateTimeValidity(
+		calDTime,
+		ePrefix + "- Input parameter 'calDTime'. ")
+
+	if err != nil {
+		return convertedCalDTime, err
+	}
+
+	var targetDateTime ADateTimeDto
+
+	targetDateTime, err = calDtMech.getDateTimeFromJulianDayNumber(
+		targetCalendar,
+		calDTime.julianDayNumber,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return convertedCalDTime, err
+	}
+
+	// The time of day, time zone and leap second
+	// status are carried over from 'calDTime'.
+	convertedCalDTime.dateTimeDto, err =
+		calDTime.dateTimeDto.CopyOut(ePrefix + "- calDTime.dateTimeDto ")
+
+	if err != nil {
+		return CalendarDateTime{}, err
+	}
+
+	sourceDate := &calDTime.dateTimeDto.date
+
+	astronomicalYear := targetDateTime.GetYearAstronomical()
+
+	if targetCalendar == CalendarSpec(0).JulianGregorian() {
+
+		convertedCalDTime.dateTimeDto.date, err =
+			DateTransferDto{}.NewJulianGregorianFromComponents(
+				cutoverJulianDayNo,
+				astronomicalYear,
+				CalendarYearNumType(0).Astronomical(),
+				targetDateTime.GetMonth(),
+				targetDateTime.GetDay(),
+				sourceDate.hasLeapSecond,
+				sourceDate.tag,
+				ePrefix)
+
+	} else {
+
+		convertedCalDTime.dateTimeDto.date, err =
+			DateTransferDto{}.NewFromComponents(
+				targetCalendar,
+				astronomicalYear,
+				CalendarYearNumType(0).Astronomical(),
+				targetDateTime.GetMonth(),
+				targetDateTime.GetDay(),
+				sourceDate.hasLeapSecond,
+				sourceDate.tag,
+				ePrefix)
+	}
+
+	if err != nil {
+		return CalendarDateTime{}, err
+	}
+
+	// Apply the year numbering mode of 'calDTime'. The year
+	// type is recomputed because the converted year may fall
+	// in a different era than the original year.
+	calMech := calendarMechanics{}
+
+	convertedCalDTime.dateTimeDto.date.yearNumberingMode =
+		sourceDate.yearNumberingMode
+
+	_,
+	convertedCalDTime.dateTimeDto.date.yearNumType,
+	err = calMech.getCalendarYearByType(
+		astronomicalYear,
+		sourceDate.yearNumberingMode,
+		ePrefix)
+
+	if err != nil {
+		return CalendarDateTime{}, err
+	}
+
+	convertedCalDTime.julianDayNumber, err =
+		calDTime.julianDayNumber.CopyOut(ePrefix)
+
+	if err != nil {
+		return CalendarDateTime{}, err
+	}
+
+	convertedCalDTime.dateTimeFmt = calDTime.dateTimeFmt
+
+	convertedCalDTime.tag = calDTime.tag
+
+	convertedCalDTime.lock = new(sync.Mutex)
+
+	return convertedCalDTime, err
+}
+
 // copyIn - Makes a deep copy of incoming CalendarDateTime instance
 // 'incomingCalDTime' and stores the data in the internal member variables
 // of 'oldCalDTime', the original CalendarDateTime instance. All member
@@ -677,13 +878,15 @@ func (calDTimeUtil *calendarDateTimeUtility) generateDateTimeStr(
 //       Source File: datetime\calendarspecenum.go
 //
 //       Possible Calendar System values include:
-//       Gregorian, Julian, Revised Julian, or Revised Goucher-Parker.
+//       Gregorian, Julian, Revised Julian, Revised Goucher-Parker or
+//       Julian/Gregorian.
 //
 //       Possible Enumeration Values:
 //         CalendarSpec(0).Gregorian()
 //         CalendarSpec(0).Julian()
 //         CalendarSpec(0).RevisedJulian()
 //         CalendarSpec(0).RevisedGoucherParker()
+//         CalendarSpec(0).JulianGregorian()
 //
 //
 //  year                     int64
@@ -972,6 +1175,14 @@ func (calDTimeUtil *calendarDateTimeUtility) newCalDateTimeFromComponents(
 //      which will be used to create a new instance of CalendarDateTime.
 //
 //
+//  cutoverJulianDayNo int64
+//    - Applies only to the Julian/Gregorian Calendar. This is the first
+//      Julian Day Number governed by the Gregorian Calendar. If this
+//      value is zero, the default cutover Julian Day Number, 2299161
+//      (15 October 1582 Gregorian), is applied. For all other calendar
+//      systems, this parameter is ignored.
+//
+//
 //  yearNumberMode     CalendarYearNumMode
 //    - This is Year Numbering Mode designating the type of year number
 //      will be used when displaying date time value. The choices are:
@@ -1002,6 +1213,7 @@ func (calDTimeUtil *calendarDateTimeUtility) setCalDateTime(
 	applyLeapSecond bool,
 	timeZoneLocation string,
 	calendar CalendarSpec,
+	cutoverJulianDayNo int64,
 	yearNumberType CalendarYearNumType,
 	dateTimeFmt,
 	ePrefix string) (err error) {
@@ -1041,27 +1253,6 @@ func (calDTimeUtil *calendarDateTimeUtility) setCalDateTime(
 		}
 	}
 
-	timeZone := TimeZoneDefinition{}
-
-	tzDefUtil := timeZoneDefUtility{}
-
-	baseDateTime := time.Now().UTC()
-
-	err = tzDefUtil.setFromTimeZoneName(
-		&timeZone,
-		baseDateTime,
-		TimeZoneConversionType(0).Relative(),
-		timeZoneLocation,
-		ePrefix)
-
-	if err != nil {
-		return err
-	}
-
-	var jDayNoDto JulianDayNoDto
-
-	var calBaseData ICalendarBaseData
-
 	if !yearNumberType.XIsValid() {
 		return &InputParameterError{
 			ePrefix:             ePrefix,
@@ -1072,119 +1263,74 @@ func (calDTimeUtil *calendarDateTimeUtility) setCalDateTime(
 		}
 	}
 
-	calMech := calendarMechanics{}
+	var dateTimeDto ADateTimeDto
 
-	if calendar == CalendarSpec(0).Julian() {
+	if calendar == CalendarSpec(0).JulianGregorian() {
 
-		jDayNoDto, err = calMech.julianCalendarDateJulianDayNo(
-			year,
-			month,
-			day,
-			hour,
-			minute,
-			second,
-			nanosecond,
-			ePrefix)
-
-		if err != nil {
-			return err
-		}
-
-		// TODO - Build Julian Calendar Base Data
-		calBaseData = &CalendarGregorianBaseData{}
-
-	} else if calendar == CalendarSpec(0).Gregorian() {
-
-		calGregUtil := CalendarGregorianUtility{}
-
-		jDayNoDto,
-		err = calGregUtil.GetJulianDayNumber(
+		dateTimeDto, err = ADateTimeDto{}.NewJulianGregorian(
+			cutoverJulianDayNo,
 			year,
+			yearNumberType,
 			month,
 			day,
+			applyLeapSecond,
 			hour,
 			minute,
 			second,
 			nanosecond,
-			ePrefix)
-
-		calBaseData = &CalendarGregorianBaseData{}
+			timeZoneLocation,
+			dateTimeFmt,
+			"",
+			ePrefix + " - dateTimeDto elements - ")
 
-	} else if calendar == CalendarSpec(0).RevisedGoucherParker() {
+	} else {
 
-		jDayNoDto, err = calMech.revisedGoucherParkerToJulianDayNo(
+		dateTimeDto, err = ADateTimeDto{}.New(
+			calendar,
 			year,
+			yearNumberType,
 			month,
 			day,
+			applyLeapSecond,
 			hour,
 			minute,
 			second,
 			nanosecond,
-			ePrefix)
-
-		if err != nil {
-			return err
-		}
-
-		// TODO - Build Revised Goucher-Parker Calendar Base Data
-		calBaseData = &CalendarGregorianBaseData{}
-
-	} else if calendar == CalendarSpec(0).RevisedJulian() {
-	// TODO - Fix Revised Julian Calendar initialization
-
-		// TODO - Build Revised Julian Calendar Base Data
-		calBaseData = &CalendarGregorianBaseData{}
-
-	} else {
-		err = fmt.Errorf(ePrefix + "\n" +
-			"Error: Invalid Calendar Specification.\n" +
-			"Calendar Specification='%v'\n",
-			calendar.String())
-
-		return err
+			timeZoneLocation,
+			dateTimeFmt,
+			"",
+			ePrefix + " - dateTimeDto elements - ")
 	}
 
-	var isLeapYear bool
-
-	isLeapYear, err =
-		calBaseData.IsLeapYear(
-			year,
-			yearNumberType,
-			ePrefix)
-
 	if err != nil {
 		return err
 	}
 
-	calUtil := CalendarUtility{}
+	var jDayNoDto JulianDayNoDto
 
-	err = calUtil.IsValidDateTimeComponents(
-		isLeapYear,
+	jDayNoDto, err = calDtMech.getJulianDayNumber(
+		calendar,
+		dateTimeDto.GetYearAstronomical(),
 		month,
 		day,
 		hour,
 		minute,
 		second,
 		nanosecond,
-		ePrefix + " - dateTimeDto elements - ")
+		cutoverJulianDayNo,
+		ePrefix)
 
 	if err != nil {
 		return err
 	}
 
-	calDTime.dateTimeDto.date.calendarBaseData = calBaseData.New()
-	calDTime.dateTimeDto.date.astronomicalYear = year
-	calDTime.dateTimeDto.date.month = month
-	calDTime.dateTimeDto.date.day = day
-	calDTime.dateTimeDto.time.hour = hour
-	calDTime.dateTimeDto.time.minute = minute
-	calDTime.dateTimeDto.time.second = second
-	calDTime.dateTimeDto.time.nanosecond = nanosecond
-	calDTime.dateTimeDto.date.hasLeapSecond = applyLeapSecond
+	jDayNoDto.SetApplyLeapSecond(applyLeapSecond)
+
+	calDTime.dateTimeDto = dateTimeDto
+
 	calDTime.julianDayNumber, err = jDayNoDto.CopyOut(ePrefix)
 
 	if err != nil {
-		calDtMech := calendarDateTimeMechanics{}
 		_ = calDtMech.empty(calDTime, "")
 		return err
 	}
@@ -1194,7 +1340,6 @@ func (calDTimeUtil *calendarDateTimeUtility) setCalDateTime(
 	calDTime.dateTimeFmt =
 		dtMech.PreProcessDateFormatStr(dateTimeFmt)
 
-
 	return nil
 }
 
@@ -0,0 +1,241 @@
+package datetime
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+)
+
+type calendarEnginesMechanics struct {
+	lock *sync.Mutex
+}
+
+// getYearStartDayNumber - Returns the integer Julian Day Number for
+// noon on the first day of the year (January 1st) of the specified
+// astronomical year. The Julian Day Number is computed under the
+// calendar system specified by input parameter 'calCycleCfg'.
+//
+// This method is used by the Julian Day Number to date/time engine
+// to resolve the target year and ordinal day number for a Julian
+// Day Number without relying on the position of leap years within
+// the calendar cycles.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  astronomicalYear           int64
+//     - The year for which the Julian Day Number of January 1st
+//       will be computed. This value must be formatted as an
+//       Astronomical Year.
+//
+//
+//  calCycleCfg                *CalendarCycleConfiguration
+//     - The calendar cycle configuration for the calendar system
+//       in which 'astronomicalYear' is specified.
+//
+//
+//  ePrefix                    string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  yearStartDayNo             *big.Int
+//     - The integer Julian Day Number for January 1st of year
+//       'astronomicalYear' at noon.
+//
+//
+//  err                        error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calEngMech *calendarEnginesMechanics) getYearStartDayNumber(
+	astronomicalYear int64,
+	calCycleCfg *CalendarCycleConfiguration,
+	ePrefix string) (
+	yearStartDayNo *big.Int,
+	err error) {
+
+	if calEngMech.lock == nil {
+		calEngMech.lock = new(sync.Mutex)
+	}
+
+	calEngMech.lock.Lock()
+
+	defer calEngMech.lock.Unlock()
+
+	ePrefix += "calendarEnginesMechanics.getYearStartDayNumber() "
+
+	yearStartDayNo = big.NewInt(0)
+
+	if calCycleCfg == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'calCycleCfg' is a nil pointer!\n")
+
+		return yearStartDayNo, err
+	}
+
+	var calBaseData ICalendarBaseData
+
+	calBaseData, err = calCycleCfg.GetCalendarBaseData(ePrefix)
+
+	if err != nil {
+		return yearStartDayNo, err
+	}
+
+	var yearStartDateTime ADateTimeDto
+
+	yearStartDateTime, err = ADateTimeDto{}.New(
+		calBaseData.GetCalendarSpecification(),
+		astronomicalYear,
+		CalendarYearNumType(0).Astronomical(),
+		1,
+		1,
+		false,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		"",
+		ePrefix)
+
+	if err != nil {
+		return yearStartDayNo, err
+	}
+
+	calEng := CalendarEngines{}
+
+	var jDNDto JulianDayNoDto
+
+	jDNDto, err = calEng.DateTimeToJulianDayNumber(
+		yearStartDateTime,
+		*calCycleCfg,
+		1024,
+		ePrefix)
+
+	if err != nil {
+		return yearStartDayNo, err
+	}
+
+	yearStartDayNo, err = jDNDto.GetJulianDayBigInt(ePrefix)
+
+	return yearStartDayNo, err
+}
+
+// getDaysInYears - Returns the total number of days contained in a
+// series of consecutive years beginning with 'startYear'.
+//
+// This method is used by the date/time to Julian Day Number engine
+// to accumulate the days in those years which do not constitute a
+// complete calendar cycle.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  startYear                  int64
+//     - The first year in the series of consecutive years. This value
+//       must be formatted as an Astronomical Year.
+//
+//
+//  numberOfYears              int64
+//     - The number of consecutive years, beginning with 'startYear',
+//       for which the total number of days will be computed. If this
+//       value is less than one, zero days are returned.
+//
+//
+//  calBaseData                ICalendarBaseData
+//     - The calendar base data for the calendar system in which the
+//       years are specified.
+//
+//
+//  ePrefix                    string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  totalDays                  *big.Int
+//     - The total number of days in the series of consecutive years.
+//
+//
+//  err                        error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (calEngMech *calendarEnginesMechanics) getDaysInYears(
+	startYear int64,
+	numberOfYears int64,
+	calBaseData ICalendarBaseData,
+	ePrefix string) (
+	totalDays *big.Int,
+	err error) {
+
+	if calEngMech.lock == nil {
+		calEngMech.lock = new(sync.Mutex)
+	}
+
+	calEngMech.lock.Lock()
+
+	defer calEngMech.lock.Unlock()
+
+	ePrefix += "calendarEnginesMechanics.getDaysInYears() "
+
+	totalDays = big.NewInt(0)
+
+	if calBaseData == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'calBaseData' is nil!\n")
+
+		return totalDays, err
+	}
+
+	var isLeapYear bool
+
+	var days int64
+
+	daysInLeapYear := int64(calBaseData.GetDaysInLeapYear())
+
+	daysInStandardYear := int64(calBaseData.GetDaysInStandardYear())
+
+	for i := int64(0); i < numberOfYears; i++ {
+
+		isLeapYear, err = calBaseData.IsLeapYear(
+			startYear + i,
+			CalendarYearNumType(0).Astronomical(),
+			ePrefix)
+
+		if err != nil {
+			return totalDays, err
+		}
+
+		if isLeapYear {
+			days += daysInLeapYear
+		} else {
+			days += daysInStandardYear
+		}
+	}
+
+	totalDays.SetInt64(days)
+
+	return totalDays, err
+}
@@ -925,7 +925,7 @@ func (gregCalBData *CalendarGregorianBaseData) GetLeapYearMonthDays(
 
 	gregCalBDataMech := calendarGregorianBaseDataMechanics{}
 
-	return gregCalBDataMech.getLeapYearOrdinalDays()
+	return gregCalBDataMech.getLeapYearMonthDays()
 }
 
 // GetMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and returns
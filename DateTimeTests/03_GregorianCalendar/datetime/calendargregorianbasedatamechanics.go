@@ -850,15 +850,15 @@ func (gregCalBDataMech *calendarGregorianBaseDataMechanics) isLeapYear(
 		astronomicalYear *= -1
 	}
 
-	if year % int64(4) == 0 {
+	if astronomicalYear % int64(4) == 0 {
 
 		isLeapYear = true
 
-		if year % 100 == 0 {
+		if astronomicalYear % 100 == 0 {
 
 			isLeapYear = false
 
-			if  year % int64(400) == 0 {
+			if  astronomicalYear % int64(400) == 0 {
 				isLeapYear = true
 			}
 		}
@@ -483,20 +483,20 @@ func (gregCalBDataUtil *calendarGregorianBaseDataUtility) getMonthDayFromOrdinal
 
 	yearAdjustment = 0
 
-	for i:=11; i > -1; i-- {
+	for i:=len(ordDays); i > 0; i-- {
 
 		if ordDays[i] < ordinalDate {
 
 			day = ordinalDate - ordDays[i]
 
-			month = i + 1
+			month = i
 
-			if month > len(mthDays) - 1 {
+			if month > len(mthDays) {
 				err = fmt.Errorf(ePrefix + "\n" +
 					"Error: Calculated Month Number exceeds maximum months!\n" +
 					"   Maximum Month Number: %v\n" +
 					"Calculated Month Number: %v\n",
-					len(mthDays)-1,
+					len(mthDays),
 					month)
 				return yearAdjustment, month, day, err
 			}
@@ -881,7 +881,7 @@ func (gregCalBDataUtil *calendarGregorianBaseDataUtility) getYearMonthDayFromOrd
 			return astronomicalYear, month, day, err
 		}
 
-		if ordinalDate >= testOrdDays {
+		if ordinalDate > testOrdDays {
 			day = ordinalDate - testOrdDays
 			month = i
 			return astronomicalYear, month, day, err
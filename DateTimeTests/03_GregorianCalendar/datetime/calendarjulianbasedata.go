@@ -0,0 +1,613 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// CalendarJulianBaseData - Implements the Calendar Base Data
+// Interface (ICalendarBaseData) for the Julian Calendar.
+//
+// The Julian Calendar, proposed by Julius Caesar in 46 BC, shares
+// month names and month lengths with the Gregorian Calendar. The
+// two calendars differ only in their leap year rules. Under the
+// Julian Calendar, every year evenly divisible by four is a leap
+// year. Dates prior to the inception of the Julian Calendar are
+// computed using the proleptic Julian Calendar.
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/Julian_calendar
+//   https://en.wikipedia.org/wiki/Proleptic_Julian_calendar
+//
+type CalendarJulianBaseData struct {
+	lock *sync.Mutex
+}
+
+// JulianCalendarBaseData - Former name of type CalendarJulianBaseData,
+// retained so that existing code continues to compile.
+//
+// Deprecated: Use CalendarJulianBaseData. Note that the methods of
+// CalendarJulianBaseData use pointer receivers and that
+// IsLeapYear() now accepts a year number type and an error prefix:
+//
+//   julianBData := JulianCalendarBaseData{}
+//   isLeapYear, err := julianBData.IsLeapYear(
+//     year,
+//     CalendarYearNumType(0).Astronomical(),
+//     ePrefix)
+//
+type JulianCalendarBaseData = CalendarJulianBaseData
+
+// GetCalendarSpecification - Returns the Calendar Specification ID for the
+// Julian Calendar.
+//
+func (julianCalBData *CalendarJulianBaseData) GetCalendarSpecification() CalendarSpec {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	return CalendarSpec(0).Julian()
+}
+
+// GetISODayOfWeekNo - Receives a Julian Day Number and returns the ISO
+// 8601 Day Of The Week Number for that date. The seven day week cycle
+// is common to all calendars. Therefore, a given Julian Day Number
+// always falls on the same day of the week.
+//
+// For more information on the ISO 8601 Standard Day Of The Week Numbering
+// System, reference:
+//   https://en.wikipedia.org/wiki/ISO_8601#Week_dates
+//   Type: ISO8601DayOfWeekNo Source Code File: datetime/dayofweeknumberiso8601enum.go
+//
+func (julianCalBData *CalendarJulianBaseData) GetISODayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	isoDayOfWeekNo ISO8601DayOfWeekNo,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetISODayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	isoDayOfWeekNo,
+	err = calBDataUtil.getISODayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return isoDayOfWeekNo, err
+}
+
+// GetUsDayOfWeekNo - Receives a Julian Day Number and returns the US Day
+// Of The Week Number for that date.
+//
+// For more information on the US Day Of The Week Numbering System, reference:
+//   https://www.timeanddate.com/date/week-numbers.html
+//   Type: UsDayOfWeekNo Source Code File: datetime/dayofweeknumberusenum.go
+//
+func (julianCalBData *CalendarJulianBaseData) GetUsDayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	usDayOfWeekNo UsDayOfWeekNo,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetUsDayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	usDayOfWeekNo,
+	err = calBDataUtil.getUsDayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return usDayOfWeekNo, err
+}
+
+// GetDaysInLeapYear - Returns the number of days in a leap year
+// (366-days).
+//
+func (julianCalBData *CalendarJulianBaseData) GetDaysInLeapYear() int {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearDaysInYear()
+}
+
+// GetDaysInStandardYear - Returns the number of days in a standard
+// (non-leap) year (365-days).
+//
+func (julianCalBData *CalendarJulianBaseData) GetDaysInStandardYear() int {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearDaysInYear()
+}
+
+// GetDaysInYear - Returns the number of days in the specified year
+// under the Julian Calendar. The year is classified as an Astronomical,
+// Before Common Era or Common Era year by input parameter
+// 'yearNumType'.
+//
+func (julianCalBData *CalendarJulianBaseData) GetDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	daysInYear int,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetDaysInYear() "
+
+	calJulianMech := calendarJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	daysInYear,
+	err = calBDataUtil.getDaysInYear(
+		year,
+		yearNumType,
+		calJulianMech.isLeapYear,
+		ePrefix)
+
+	return daysInYear, err
+}
+
+// GetDaysOfWeekNames - Returns a map containing the names of the days
+// of the week keyed by the day of the week numbering system specified
+// by input parameter 'dayOfWeekNoSysType'.
+//
+func (julianCalBData *CalendarJulianBaseData) GetDaysOfWeekNames(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	daysOfWeekNames map[int]string,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetDaysOfWeekNames() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	daysOfWeekNames,
+	err = calBDataUtil.getDaysOfWeekNames(
+		dayOfWeekNoSysType,
+		ePrefix)
+
+	return daysOfWeekNames, err
+}
+
+// GetDaysOfWeekNameAbbreviations - Returns a map containing the
+// abbreviated names of the days of the week. Each abbreviation
+// consists of the first 'numberOfCharsInAbbreviation' characters
+// of the week day name.
+//
+func (julianCalBData *CalendarJulianBaseData) GetDaysOfWeekNameAbbreviations(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	numberOfCharsInAbbreviation int,
+	ePrefix string) (
+	weekDayNameAbbrvs map[int] string,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetDaysOfWeekNameAbbreviations() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	weekDayNameAbbrvs,
+	err = calBDataUtil.getDaysOfWeekNameAbbreviations(
+		dayOfWeekNoSysType,
+		numberOfCharsInAbbreviation,
+		ePrefix)
+
+	return weekDayNameAbbrvs, err
+}
+
+// GetLeapYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in
+// a leap year.
+//
+func (julianCalBData *CalendarJulianBaseData) GetLeapYearOrdinalDays(
+	) map[int] int {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearOrdinalDays()
+}
+
+// GetLeapYearMonthDays - Returns a map containing the number of days
+// in each month of a leap year.
+//
+func (julianCalBData *CalendarJulianBaseData) GetLeapYearMonthDays(
+	) map[int] int {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearMonthDays()
+}
+
+// GetMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// returns the associated month and day number. Ordinal day number
+// zero is interpreted as December 31st of the prior year and is
+// signaled by a 'yearAdjustment' value of minus one (-1).
+//
+func (julianCalBData *CalendarJulianBaseData) GetMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	isLeapYear bool,
+	ePrefix string)(
+	yearAdjustment int,
+	month int,
+	day int,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetMonthDayFromOrdinalDayNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	yearAdjustment,
+	month,
+	day,
+	err = calBDataUtil.getMonthDayFromOrdinalDayNo(
+		ordinalDate,
+		isLeapYear,
+		ePrefix)
+
+	return yearAdjustment, month, day, err
+}
+
+// GetOrdinalDayNumber - Computes the ordinal day number for a month
+// and day in a leap year or a standard year.
+//
+func (julianCalBData *CalendarJulianBaseData) GetOrdinalDayNumber(
+	isLeapYear bool,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetOrdinalDayNumber() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil.getOrdinalDayNumber(
+		isLeapYear,
+		month,
+		day,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// GetOrdinalDayNoFromDate - Computes the ordinal day number for the
+// specified Julian Calendar date.
+//
+func (julianCalBData *CalendarJulianBaseData) GetOrdinalDayNoFromDate(
+	year int64,
+	yearType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetOrdinalDayNoFromDate() "
+
+	calJulianMech := calendarJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil.getOrdinalDayNoFromDate(
+		year,
+		yearType,
+		month,
+		day,
+		calJulianMech.isLeapYear,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// GetRemainingDaysInYear - Returns the number of days remaining in
+// the year following the specified Julian Calendar date.
+//
+func (julianCalBData *CalendarJulianBaseData) GetRemainingDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	remainingDaysOfYear int,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetRemainingDaysInYear() "
+
+	calJulianMech := calendarJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	remainingDaysOfYear,
+	err = calBDataUtil.getRemainingDaysInYear(
+		year,
+		yearNumType,
+		month,
+		day,
+		calJulianMech.isLeapYear,
+		ePrefix)
+
+	return remainingDaysOfYear, err
+}
+
+// GetStandardYearMonthDays - Returns a map containing the number of
+// days in each month of a standard (non-leap) year.
+//
+func (julianCalBData *CalendarJulianBaseData) GetStandardYearMonthDays(
+	) map[int] int {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearMonthDays()
+}
+
+// GetStandardYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in a
+// standard (non-leap) year.
+//
+func (julianCalBData *CalendarJulianBaseData) GetStandardYearOrdinalDays(
+	) map[int] int {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearOrdinalDays()
+}
+
+// GetYearMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// a year and returns the astronomical year, month and day under the
+// Julian Calendar.
+//
+func (julianCalBData *CalendarJulianBaseData) GetYearMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string)(
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.GetYearMonthDayFromOrdinalDayNo() "
+
+	calJulianMech := calendarJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	astronomicalYear,
+	month,
+	day,
+	err = calBDataUtil.getYearMonthDayFromOrdinalDayNo(
+		ordinalDate,
+		year,
+		yearNumType,
+		calJulianMech.isLeapYear,
+		ePrefix)
+
+	return astronomicalYear, month, day, err
+}
+
+// IsLeapYear - Returns 'true' if the specified year is a leap year
+// under the Julian Calendar.
+//
+func (julianCalBData *CalendarJulianBaseData) IsLeapYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	isLeapYear bool,
+	err error ) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.IsLeapYear() "
+
+	calJulianMech := calendarJulianMechanics{}
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	isLeapYear,
+	err = calBDataMech.isLeapYear(
+		year,
+		yearNumType,
+		calJulianMech.isLeapYear,
+		ePrefix)
+
+	return isLeapYear, err
+}
+
+// IsValidDate - Returns 'true' if the specified year, month and day
+// form a valid date under the Julian Calendar.
+//
+func (julianCalBData *CalendarJulianBaseData) IsValidDate(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	isValid bool,
+	err error) {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianBaseData.IsValidDate() "
+
+	calJulianMech := calendarJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	isValid,
+	err = calBDataUtil.isValidDate(
+		year,
+		yearNumType,
+		month,
+		day,
+		calJulianMech.isLeapYear,
+		ePrefix)
+
+	return isValid, err
+}
+
+// New - Returns a new instance of CalendarJulianBaseData as an
+// ICalendarBaseData interface.
+//
+func (julianCalBData *CalendarJulianBaseData) New() ICalendarBaseData {
+
+	if julianCalBData.lock == nil {
+		julianCalBData.lock = new(sync.Mutex)
+	}
+
+	julianCalBData.lock.Lock()
+
+	defer julianCalBData.lock.Unlock()
+
+	newBaseData := &CalendarJulianBaseData{}
+
+	return newBaseData
+}
@@ -0,0 +1,1199 @@
+package datetime
+
+import (
+	"fmt"
+	"math"
+	"sync"
+)
+
+// CalendarJulianGregorianBaseData - Implements the Calendar Base
+// Data Interface (ICalendarBaseData) for the historical
+// Julian/Gregorian Calendar.
+//
+// Real archival dates follow the Julian Calendar until a country's
+// reform date and the Gregorian Calendar thereafter. Under this
+// calendar, dates prior to the cutover Julian Day Number are
+// computed using the Julian Calendar. Dates on or after the cutover
+// Julian Day Number are computed using the Gregorian Calendar. The
+// dates skipped by the reform do not exist and are rejected as
+// invalid dates.
+//
+// The cutover Julian Day Number is configurable. Use method
+// 'NewFromCutoverJulianDayNo()' to create an instance with a
+// specific cutover date. A zero value CalendarJulianGregorianBaseData
+// instance applies the default cutover Julian Day Number, 2299161,
+// Friday, 15 October 1582 (Gregorian), which immediately followed
+// Thursday, 4 October 1582 (Julian).
+//
+// Examples:
+//   Cutover Julian Day Number   Cutover Date        Last Julian Date
+//   -------------------------   ------------        ----------------
+//          2299161              1582-10-15          1582-10-04
+//          2361222              1752-09-14          1752-09-02 (Great Britain)
+//
+// The year in which the cutover occurs is shorter than a standard
+// year. For example, with the default cutover date, the year 1582
+// contains 355 days.
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/Adoption_of_the_Gregorian_calendar
+//   https://en.wikipedia.org/wiki/Conversion_between_Julian_and_Gregorian_calendars
+//
+type CalendarJulianGregorianBaseData struct {
+	cutoverJulianDayNo int64 // First Julian Day Number governed by the Gregorian Calendar.
+	//                       //  Zero signals the default value, 2299161.
+	lock *sync.Mutex
+}
+
+// GetCalendarSpecification - Returns the Calendar Specification ID for the
+// Julian/Gregorian Calendar
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetCalendarSpecification() CalendarSpec {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	return CalendarSpec(0).JulianGregorian()
+}
+
+// GetCutoverJulianDayNo - Returns the cutover Julian Day Number
+// for this Julian/Gregorian Calendar instance. The cutover Julian
+// Day Number identifies the first day governed by the Gregorian
+// Calendar.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetCutoverJulianDayNo() int64 {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	return julianGregMech.getCutoverJulianDayNo(
+		julianGregCalBData.cutoverJulianDayNo)
+}
+
+// GetISODayOfWeekNo - Returns the ISO Day Of The Week Number. This
+// method receives a Julian Day Number and proceeds to calculate the day
+// of the week number associated with that Julian Day Number Date.
+//
+// The seven day week cycle was not interrupted by the transition from
+// the Julian to the Gregorian Calendar. Therefore, a given Julian Day
+// Number always falls on the same day of the week regardless of the
+// calendar used to express the date.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetISODayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	isoDayOfWeekNo ISO8601DayOfWeekNo,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetISODayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	isoDayOfWeekNo,
+		err = calBDataUtil.getISODayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return isoDayOfWeekNo, err
+}
+
+// GetUsDayOfWeekNo - Returns the US Day Of The Week Number. This
+// method receives a Julian Day Number and proceeds to calculate the
+// day of the week number associated with that Julian Day Number Date.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetUsDayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	usDayOfWeekNo UsDayOfWeekNo,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetUsDayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	usDayOfWeekNo,
+		err = calBDataUtil.getUsDayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return usDayOfWeekNo, err
+}
+
+// GetDaysInLeapYear - Returns the number of days in a leap year
+// which does NOT contain the cutover date.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetDaysInLeapYear() int {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearDaysInYear()
+}
+
+// GetDaysInStandardYear - Returns the number of days in a standard
+// year which does NOT contain the cutover date.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetDaysInStandardYear() int {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearDaysInYear()
+}
+
+// GetDaysInYear - Returns the number of days in the specified year
+// under the Julian/Gregorian Calendar.
+//
+// For years which do not contain the cutover date, the returned
+// value is either 365 or 366. For the year in which the cutover
+// occurs, the dates skipped by the reform are excluded. For example,
+// with the default cutover date, the year 1582 contains 355 days.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                   int64
+//     - A year value. This year value is classified by type as being either
+//       an Astronomical Year, Before Common Era Year or a Common Era Year
+//       based on the second input parameter 'yearNumType'.
+//
+//
+//  yearNumType            CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value. For more information on
+//       CalendarYearNumType reference the source code file:
+//            datetime/calendaryearnumbertypeenum.go
+//
+//
+//  ePrefix                string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  daysInYear             int
+//     - If this method completes successfully, the number of days in the
+//       the year value supplied by input parameter, 'year', will be
+//       returned.
+//
+//
+//  err                    error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	daysInYear int,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetDaysInYear() "
+
+	daysInYear = -1
+
+	calMech := calendarMechanics{}
+
+	var astronomicalYear int64
+
+	astronomicalYear, err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return daysInYear, err
+	}
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	daysInYear, err = julianGregMech.getDaysInYear(
+		astronomicalYear,
+		julianGregMech.getCutoverJulianDayNo(
+			julianGregCalBData.cutoverJulianDayNo),
+		ePrefix)
+
+	return daysInYear, err
+}
+
+// GetDaysOfWeekNames - This method returns a map consisting of the
+// days of the week names. The Julian/Gregorian Calendar uses the same
+// day of the week names as the Gregorian Calendar.
+//
+// The returned map will be indexed according to either the US Day Of
+// The Week Numbering System or the ISO 8601 Standard Day Of The Week
+// Numbering System depending on input parameter 'dayOfWeekNoSysType'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetDaysOfWeekNames(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	daysOfWeekNames map[int]string,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetDaysOfWeekNames() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	return calBDataUtil.getDaysOfWeekNames(
+		dayOfWeekNoSysType,
+		ePrefix)
+}
+
+// GetDaysOfWeekNameAbbreviations - Returns a map consisting of the
+// days of the week name abbreviations. The Julian/Gregorian Calendar
+// uses the same day of the week names as the Gregorian Calendar.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetDaysOfWeekNameAbbreviations(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	numberOfCharsInAbbreviation int,
+	ePrefix string) (
+	weekDayNameAbbrvs map[int] string,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetDaysOfWeekNameAbbreviations() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	return calBDataUtil.getDaysOfWeekNameAbbreviations(
+		dayOfWeekNoSysType,
+		numberOfCharsInAbbreviation,
+		ePrefix)
+}
+
+// GetLeapYearOrdinalDays - Returns a map containing the number of
+// ordinal days elapsed at the beginning of each month within a
+// leap year which does NOT contain the cutover date. The key is
+// the month number.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetLeapYearOrdinalDays(
+	) map[int] int {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearOrdinalDays()
+}
+
+// GetLeapYearMonthDays - Returns a map containing the number of
+// days in each month of a leap year which does NOT contain the
+// cutover date. The key is the month number.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetLeapYearMonthDays(
+	) map[int] int {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearMonthDays()
+}
+
+// GetMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// returns the associated month and day number for a year which does
+// NOT contain the cutover date.
+//
+// Since this method does not receive a year value, it cannot account
+// for the dates skipped by the reform. For the year in which the
+// cutover occurs, use method 'GetYearMonthDayFromOrdinalDayNo()'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	isLeapYear bool,
+	ePrefix string)(
+	yearAdjustment int,
+	month int,
+	day int,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetMonthDayFromOrdinalDayNo() "
+
+	calBDataUtil :=
+		calendarBaseDataUtility{}
+
+	yearAdjustment,
+	month,
+	day,
+	err = calBDataUtil.getMonthDayFromOrdinalDayNo(
+		ordinalDate,
+		isLeapYear,
+		ePrefix)
+
+	return yearAdjustment, month, day, err
+}
+
+// GetOrdinalDayNumber - Computes the ordinal day number within a
+// year for any given month and day. This method assumes the year
+// does NOT contain the cutover date.
+//
+// Since this method does not receive a year value, it cannot account
+// for the dates skipped by the reform. For the year in which the
+// cutover occurs, use method 'GetOrdinalDayNoFromDate()'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetOrdinalDayNumber(
+	isLeapYear bool,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetOrdinalDayNumber() "
+
+	calBDataUtil :=
+		calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil.getOrdinalDayNumber(
+		isLeapYear,
+		month,
+		day,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// GetOrdinalDayNoFromDate - Computes the ordinal day number for a
+// Julian/Gregorian Calendar date specified by year, month and day.
+//
+// For the year in which the cutover occurs, the dates skipped by the
+// reform are NOT counted. For example, with the default cutover date,
+// 1582-10-04 is ordinal day 277 and 1582-10-15 is ordinal day 278.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                int64
+//     - The year value of the target date. This year value is classified
+//       by type as being either an Astronomical Year, Before Common Era
+//       Year or a Common Era Year based on input parameter 'yearType'.
+//
+//
+//  yearType            CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value.
+//
+//
+//  month               int
+//     - The month number of the target date.
+//
+//
+//  day                 int
+//     - The day number of the target date.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ordinalDayNo        int
+//     - The Ordinal Day Number of the date specified by the input
+//       parameters.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetOrdinalDayNoFromDate(
+	year int64,
+	yearType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetOrdinalDayNoFromDate() "
+
+	ordinalDayNo = math.MinInt32
+
+	calMech := calendarMechanics{}
+
+	var astronomicalYear int64
+
+	astronomicalYear, err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearType,
+		ePrefix)
+
+	if err != nil {
+		return ordinalDayNo, err
+	}
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	cutoverJulianDayNo := julianGregMech.getCutoverJulianDayNo(
+		julianGregCalBData.cutoverJulianDayNo)
+
+	var julianDayNo, yearStartDayNo int64
+
+	julianDayNo, err = julianGregMech.getJulianDayNoFromDate(
+		astronomicalYear,
+		month,
+		day,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return ordinalDayNo, err
+	}
+
+	yearStartDayNo, err = julianGregMech.getYearStartJulianDayNo(
+		astronomicalYear,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return ordinalDayNo, err
+	}
+
+	ordinalDayNo = int(julianDayNo - yearStartDayNo) + 1
+
+	return ordinalDayNo, err
+}
+
+// GetRemainingDaysInYear - Returns the number of days remaining in
+// the year following the Julian/Gregorian Calendar date specified by
+// input parameters 'year', 'month' and 'day'. The specified date
+// itself is NOT included in the count. Therefore, the remaining days
+// in the year for December 31st is zero (0).
+//
+// For the year in which the cutover occurs, the dates skipped by the
+// reform are NOT counted.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                int64
+//     - The year value of the target date. This year value is classified
+//       by type as being either an Astronomical Year, Before Common Era
+//       Year or a Common Era Year based on input parameter 'yearNumType'.
+//
+//
+//  yearNumType         CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value.
+//
+//
+//  month               int
+//     - The month number of the target date.
+//
+//
+//  day                 int
+//     - The day number of the target date.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  remainingDaysOfYear int
+//     - The number of days remaining in the year after the target date.
+//
+//
+//  err                 error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetRemainingDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	remainingDaysOfYear int,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetRemainingDaysInYear() "
+
+	remainingDaysOfYear = -1
+
+	calMech := calendarMechanics{}
+
+	var astronomicalYear int64
+
+	astronomicalYear, err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return remainingDaysOfYear, err
+	}
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	cutoverJulianDayNo := julianGregMech.getCutoverJulianDayNo(
+		julianGregCalBData.cutoverJulianDayNo)
+
+	var julianDayNo, nextYearStartDayNo int64
+
+	julianDayNo, err = julianGregMech.getJulianDayNoFromDate(
+		astronomicalYear,
+		month,
+		day,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return remainingDaysOfYear, err
+	}
+
+	nextYearStartDayNo, err = julianGregMech.getYearStartJulianDayNo(
+		astronomicalYear + 1,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return remainingDaysOfYear, err
+	}
+
+	remainingDaysOfYear = int(nextYearStartDayNo - julianDayNo) - 1
+
+	return remainingDaysOfYear, err
+}
+
+// GetStandardYearMonthDays - Returns a map containing the number of
+// days in each month of a standard year which does NOT contain the
+// cutover date. The key is the month number.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetStandardYearMonthDays(
+	) map[int] int {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearMonthDays()
+}
+
+// GetStandardYearOrdinalDays - Returns a map containing the number of
+// ordinal days elapsed at the beginning of each month within a
+// standard year which does NOT contain the cutover date. The key is
+// the month number.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetStandardYearOrdinalDays(
+	) map[int] int {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearOrdinalDays()
+}
+
+// GetYearMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// a year value. The method then returns the associated astronomical
+// year, month and day under the Julian/Gregorian Calendar.
+//
+// For the year in which the cutover occurs, the dates skipped by the
+// reform are NOT counted.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  ordinalDate        int
+//     - A value with a valid range of 1 to the number of days in the
+//       year, inclusive, which specifies the day of the year expressed
+//       as an ordinal day number.
+//
+//
+//  year               int64
+//     - The year value encompassing 'ordinalDate'. This year value is
+//       classified by type as being either an Astronomical Year, Before
+//       Common Era Year or a Common Era Year based on input parameter
+//       'yearNumType'.
+//
+//
+//  yearNumType        CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value.
+//
+//
+//  ePrefix            string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  astronomicalYear   int64
+//     - The input parameter 'year' expressed as an astronomical year.
+//
+//
+//  month              int
+//     - The month number associated with 'ordinalDate'.
+//
+//
+//  day                int
+//     - The day number associated with 'ordinalDate'.
+//
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       in input parameter, 'ePrefix'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) GetYearMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string)(
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.GetYearMonthDayFromOrdinalDayNo() "
+
+	calMech := calendarMechanics{}
+
+	astronomicalYear, err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	cutoverJulianDayNo := julianGregMech.getCutoverJulianDayNo(
+		julianGregCalBData.cutoverJulianDayNo)
+
+	var daysInYear int
+
+	daysInYear, err = julianGregMech.getDaysInYear(
+		astronomicalYear,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	if ordinalDate < 1 || ordinalDate > daysInYear {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'ordinalDate' is INVALID!\n" +
+			"The valid range for 'ordinalDate' is '1' through '%v'.\n" +
+			"ordinalDate='%v'\n",
+			daysInYear,
+			ordinalDate)
+
+		return astronomicalYear, month, day, err
+	}
+
+	var yearStartDayNo int64
+
+	yearStartDayNo, err = julianGregMech.getYearStartJulianDayNo(
+		astronomicalYear,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	astronomicalYear,
+	month,
+	day,
+	err = julianGregMech.getDateFromJulianDayNo(
+		yearStartDayNo + int64(ordinalDate) - 1,
+		cutoverJulianDayNo,
+		ePrefix)
+
+	return astronomicalYear, month, day, err
+}
+
+// IsLeapYear - Returns a boolean value signaling whether the year
+// value passed as an input parameter is a leap year under the
+// Julian/Gregorian Calendar.
+//
+// Years prior to the reform follow the Julian leap year rule. Years
+// following the reform follow the Gregorian leap year rule. The year
+// in which the cutover occurs is classified as a leap year only if
+// the date February 29th exists in that year.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                   int64
+//     - A year value. This year value is classified by type as being either
+//       an Astronomical Year, Before Common Era Year or a Common Era Year
+//       based on the second input parameter 'yearNumType'.
+//
+//
+//  yearNumType            CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value.
+//
+//
+//  ePrefix                string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  isLeapYear             bool
+//     - This return value is set to 'true' if the input parameter, 'year',
+//       qualifies as a leap year under the Julian/Gregorian Calendar.
+//
+//
+//  err                    error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) IsLeapYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	isLeapYear bool,
+	err error ) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.IsLeapYear() "
+
+	calMech := calendarMechanics{}
+
+	var astronomicalYear int64
+
+	astronomicalYear, err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return isLeapYear, err
+	}
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	isLeapYear = julianGregMech.isLeapYear(
+		astronomicalYear,
+		julianGregMech.getCutoverJulianDayNo(
+			julianGregCalBData.cutoverJulianDayNo))
+
+	return isLeapYear, err
+}
+
+// IsValidDate - Returns 'true' if the year, month and day input
+// parameters constitute a valid date under the Julian/Gregorian
+// Calendar. Dates skipped by the reform are invalid. For example,
+// with the default cutover date, 1582-10-05 through 1582-10-14 are
+// invalid dates. If the date is invalid, the returned error will
+// describe the reason.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  year                int64
+//     - The year value of the date to be validated. This year value is
+//       classified by type as being either an Astronomical Year, Before
+//       Common Era Year or a Common Era Year based on input parameter
+//       'yearNumType'.
+//
+//
+//  yearNumType         CalendarYearNumType
+//     - Year number type is an enumeration which determines the type of
+//       conversion algorithm which will be applied to convert input parameter
+//       'year' to an Astronomical Year Value.
+//
+//
+//  month               int
+//     - The month number of the date to be validated.
+//
+//
+//  day                 int
+//     - The day number of the date to be validated.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  isValid             bool
+//     - Set to 'true' if the date is a valid Julian/Gregorian Calendar
+//       date.
+//
+//
+//  err                 error
+//     - If the date is valid, the returned error Type is set equal to
+//       'nil'. Otherwise, the returned error Type will encapsulate an
+//       error message. Note that this error message will incorporate
+//       the method chain and text passed by input parameter, 'ePrefix'.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) IsValidDate(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	isValid bool,
+	err error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.IsValidDate() "
+
+	calMech := calendarMechanics{}
+
+	var astronomicalYear int64
+
+	astronomicalYear, err = calMech.convertAnyYearToAstronomicalYear(
+		year,
+		yearNumType,
+		ePrefix)
+
+	if err != nil {
+		return isValid, err
+	}
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	_, err = julianGregMech.getJulianDayNoFromDate(
+		astronomicalYear,
+		month,
+		day,
+		julianGregMech.getCutoverJulianDayNo(
+			julianGregCalBData.cutoverJulianDayNo),
+		ePrefix)
+
+	if err != nil {
+		return isValid, err
+	}
+
+	isValid = true
+
+	return isValid, err
+}
+
+// New - Returns a new instance of CalendarJulianGregorianBaseData
+// typed as the ICalendarBaseData interface. The new instance
+// retains the cutover Julian Day Number of the current instance.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) New() ICalendarBaseData {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	newBaseData := &CalendarJulianGregorianBaseData{
+		cutoverJulianDayNo: julianGregCalBData.cutoverJulianDayNo,
+	}
+
+	return newBaseData
+}
+
+// NewFromCutoverJulianDayNo - Creates and returns a new instance of
+// CalendarJulianGregorianBaseData configured with the cutover Julian
+// Day Number specified by input parameter 'cutoverJulianDayNo'.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  cutoverJulianDayNo  int64
+//     - The integer Julian Day Number of the first day governed by the
+//       Gregorian Calendar. All prior days are governed by the Julian
+//       Calendar. This value must be greater than or equal to 1794168
+//       (1 March 200 Julian and Gregorian). Examples:
+//         2299161 - 1582-10-15 Gregorian (Papal States, Spain, Portugal)
+//         2361222 - 1752-09-14 Gregorian (Great Britain and colonies)
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  CalendarJulianGregorianBaseData
+//     - If successful, this method returns a new instance of
+//       CalendarJulianGregorianBaseData configured with the
+//       specified cutover Julian Day Number.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (julianGregCalBData CalendarJulianGregorianBaseData) NewFromCutoverJulianDayNo(
+	cutoverJulianDayNo int64,
+	ePrefix string) (
+	CalendarJulianGregorianBaseData,
+	error) {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.NewFromCutoverJulianDayNo() "
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	err := julianGregMech.testCutoverJulianDayNo(
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return CalendarJulianGregorianBaseData{}, err
+	}
+
+	newBaseData := CalendarJulianGregorianBaseData{
+		cutoverJulianDayNo: cutoverJulianDayNo,
+		lock:               new(sync.Mutex),
+	}
+
+	return newBaseData, nil
+}
+
+// SetCutoverJulianDayNo - Sets the cutover Julian Day Number for
+// the current CalendarJulianGregorianBaseData instance. The cutover
+// Julian Day Number identifies the first day governed by the
+// Gregorian Calendar.
+//
+// Input parameter 'cutoverJulianDayNo' must be greater than or equal
+// to 1794168 (1 March 200 Julian and Gregorian). Otherwise, an error
+// is returned and the current instance is NOT altered.
+//
+func (julianGregCalBData *CalendarJulianGregorianBaseData) SetCutoverJulianDayNo(
+	cutoverJulianDayNo int64,
+	ePrefix string) error {
+
+	if julianGregCalBData.lock == nil {
+		julianGregCalBData.lock = new(sync.Mutex)
+	}
+
+	julianGregCalBData.lock.Lock()
+
+	defer julianGregCalBData.lock.Unlock()
+
+	ePrefix += "CalendarJulianGregorianBaseData.SetCutoverJulianDayNo() "
+
+	julianGregMech := calendarJulianGregorianMechanics{}
+
+	err := julianGregMech.testCutoverJulianDayNo(
+		cutoverJulianDayNo,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	julianGregCalBData.cutoverJulianDayNo = cutoverJulianDayNo
+
+	return nil
+}
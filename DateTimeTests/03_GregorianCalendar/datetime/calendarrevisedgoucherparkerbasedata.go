@@ -0,0 +1,602 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// CalendarRevisedGoucherParkerBaseData - Implements the Calendar Base Data
+// Interface (ICalendarBaseData) for the Revised Goucher-Parker Calendar.
+//
+// The Revised Goucher-Parker Calendar shares month names and month
+// lengths with the Julian Calendar. The two calendars differ only in
+// their leap year rules. Under the Revised Goucher-Parker Calendar,
+// years evenly divisible by 4 are leap years, years evenly divisible
+// by 128 are NOT leap years and years evenly divisible by 454,545 ARE
+// leap years.
+//
+// Like the Julian Calendar, Julian Day Number zero begins at noon on
+// January 1, -4712 (4713 BCE) on the proleptic Revised Goucher-Parker
+// Calendar.
+//
+// Reference:
+//   Documentation for Type CalendarSpec: datetime\calendarspecenum.go
+//   https://www.theguardian.com/science/2011/feb/28/leap-year-alex-bellos
+//
+type CalendarRevisedGoucherParkerBaseData struct {
+	lock *sync.Mutex
+}
+
+// GetCalendarSpecification - Returns the Calendar Specification ID for the
+// Revised Goucher-Parker Calendar.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetCalendarSpecification() CalendarSpec {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	return CalendarSpec(0).RevisedGoucherParker()
+}
+
+// GetISODayOfWeekNo - Receives a Julian Day Number and returns the ISO
+// 8601 Day Of The Week Number for that date. The seven day week cycle
+// is common to all calendars. Therefore, a given Julian Day Number
+// always falls on the same day of the week.
+//
+// For more information on the ISO 8601 Standard Day Of The Week Numbering
+// System, reference:
+//   https://en.wikipedia.org/wiki/ISO_8601#Week_dates
+//   Type: ISO8601DayOfWeekNo Source Code File: datetime/dayofweeknumberiso8601enum.go
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetISODayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	isoDayOfWeekNo ISO8601DayOfWeekNo,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetISODayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	isoDayOfWeekNo,
+	err = calBDataUtil.getISODayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return isoDayOfWeekNo, err
+}
+
+// GetUsDayOfWeekNo - Receives a Julian Day Number and returns the US Day
+// Of The Week Number for that date.
+//
+// For more information on the US Day Of The Week Numbering System, reference:
+//   https://www.timeanddate.com/date/week-numbers.html
+//   Type: UsDayOfWeekNo Source Code File: datetime/dayofweeknumberusenum.go
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetUsDayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	usDayOfWeekNo UsDayOfWeekNo,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetUsDayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	usDayOfWeekNo,
+	err = calBDataUtil.getUsDayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return usDayOfWeekNo, err
+}
+
+// GetDaysInLeapYear - Returns the number of days in a leap year
+// (366-days).
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetDaysInLeapYear() int {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearDaysInYear()
+}
+
+// GetDaysInStandardYear - Returns the number of days in a standard
+// (non-leap) year (365-days).
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetDaysInStandardYear() int {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearDaysInYear()
+}
+
+// GetDaysInYear - Returns the number of days in the specified year
+// under the Revised Goucher-Parker Calendar. The year is classified as an Astronomical,
+// Before Common Era or Common Era year by input parameter
+// 'yearNumType'.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	daysInYear int,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetDaysInYear() "
+
+	calRevGoucherParkerMech := calendarRevisedGoucherParkerMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	daysInYear,
+	err = calBDataUtil.getDaysInYear(
+		year,
+		yearNumType,
+		calRevGoucherParkerMech.isLeapYear,
+		ePrefix)
+
+	return daysInYear, err
+}
+
+// GetDaysOfWeekNames - Returns a map containing the names of the days
+// of the week keyed by the day of the week numbering system specified
+// by input parameter 'dayOfWeekNoSysType'.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetDaysOfWeekNames(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	daysOfWeekNames map[int]string,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetDaysOfWeekNames() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	daysOfWeekNames,
+	err = calBDataUtil.getDaysOfWeekNames(
+		dayOfWeekNoSysType,
+		ePrefix)
+
+	return daysOfWeekNames, err
+}
+
+// GetDaysOfWeekNameAbbreviations - Returns a map containing the
+// abbreviated names of the days of the week. Each abbreviation
+// consists of the first 'numberOfCharsInAbbreviation' characters
+// of the week day name.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetDaysOfWeekNameAbbreviations(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	numberOfCharsInAbbreviation int,
+	ePrefix string) (
+	weekDayNameAbbrvs map[int] string,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetDaysOfWeekNameAbbreviations() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	weekDayNameAbbrvs,
+	err = calBDataUtil.getDaysOfWeekNameAbbreviations(
+		dayOfWeekNoSysType,
+		numberOfCharsInAbbreviation,
+		ePrefix)
+
+	return weekDayNameAbbrvs, err
+}
+
+// GetLeapYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in
+// a leap year.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetLeapYearOrdinalDays(
+	) map[int] int {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearOrdinalDays()
+}
+
+// GetLeapYearMonthDays - Returns a map containing the number of days
+// in each month of a leap year.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetLeapYearMonthDays(
+	) map[int] int {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearMonthDays()
+}
+
+// GetMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// returns the associated month and day number. Ordinal day number
+// zero is interpreted as December 31st of the prior year and is
+// signaled by a 'yearAdjustment' value of minus one (-1).
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	isLeapYear bool,
+	ePrefix string)(
+	yearAdjustment int,
+	month int,
+	day int,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetMonthDayFromOrdinalDayNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	yearAdjustment,
+	month,
+	day,
+	err = calBDataUtil.getMonthDayFromOrdinalDayNo(
+		ordinalDate,
+		isLeapYear,
+		ePrefix)
+
+	return yearAdjustment, month, day, err
+}
+
+// GetOrdinalDayNumber - Computes the ordinal day number for a month
+// and day in a leap year or a standard year.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetOrdinalDayNumber(
+	isLeapYear bool,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetOrdinalDayNumber() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil.getOrdinalDayNumber(
+		isLeapYear,
+		month,
+		day,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// GetOrdinalDayNoFromDate - Computes the ordinal day number for the
+// specified Revised Goucher-Parker Calendar date.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetOrdinalDayNoFromDate(
+	year int64,
+	yearType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetOrdinalDayNoFromDate() "
+
+	calRevGoucherParkerMech := calendarRevisedGoucherParkerMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil.getOrdinalDayNoFromDate(
+		year,
+		yearType,
+		month,
+		day,
+		calRevGoucherParkerMech.isLeapYear,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// GetRemainingDaysInYear - Returns the number of days remaining in
+// the year following the specified Revised Goucher-Parker Calendar date.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetRemainingDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	remainingDaysOfYear int,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetRemainingDaysInYear() "
+
+	calRevGoucherParkerMech := calendarRevisedGoucherParkerMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	remainingDaysOfYear,
+	err = calBDataUtil.getRemainingDaysInYear(
+		year,
+		yearNumType,
+		month,
+		day,
+		calRevGoucherParkerMech.isLeapYear,
+		ePrefix)
+
+	return remainingDaysOfYear, err
+}
+
+// GetStandardYearMonthDays - Returns a map containing the number of
+// days in each month of a standard (non-leap) year.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetStandardYearMonthDays(
+	) map[int] int {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearMonthDays()
+}
+
+// GetStandardYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in a
+// standard (non-leap) year.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetStandardYearOrdinalDays(
+	) map[int] int {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearOrdinalDays()
+}
+
+// GetYearMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// a year and returns the astronomical year, month and day under the
+// Revised Goucher-Parker Calendar.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) GetYearMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string)(
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.GetYearMonthDayFromOrdinalDayNo() "
+
+	calRevGoucherParkerMech := calendarRevisedGoucherParkerMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	astronomicalYear,
+	month,
+	day,
+	err = calBDataUtil.getYearMonthDayFromOrdinalDayNo(
+		ordinalDate,
+		year,
+		yearNumType,
+		calRevGoucherParkerMech.isLeapYear,
+		ePrefix)
+
+	return astronomicalYear, month, day, err
+}
+
+// IsLeapYear - Returns 'true' if the specified year is a leap year
+// under the Revised Goucher-Parker Calendar.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) IsLeapYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	isLeapYear bool,
+	err error ) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.IsLeapYear() "
+
+	calRevGoucherParkerMech := calendarRevisedGoucherParkerMechanics{}
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	isLeapYear,
+	err = calBDataMech.isLeapYear(
+		year,
+		yearNumType,
+		calRevGoucherParkerMech.isLeapYear,
+		ePrefix)
+
+	return isLeapYear, err
+}
+
+// IsValidDate - Returns 'true' if the specified year, month and day
+// form a valid date under the Revised Goucher-Parker Calendar.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) IsValidDate(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	isValid bool,
+	err error) {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedGoucherParkerBaseData.IsValidDate() "
+
+	calRevGoucherParkerMech := calendarRevisedGoucherParkerMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	isValid,
+	err = calBDataUtil.isValidDate(
+		year,
+		yearNumType,
+		month,
+		day,
+		calRevGoucherParkerMech.isLeapYear,
+		ePrefix)
+
+	return isValid, err
+}
+
+// New - Returns a new instance of CalendarRevisedGoucherParkerBaseData as an
+// ICalendarBaseData interface.
+//
+func (revGoucherParkerCalBData *CalendarRevisedGoucherParkerBaseData) New() ICalendarBaseData {
+
+	if revGoucherParkerCalBData.lock == nil {
+		revGoucherParkerCalBData.lock = new(sync.Mutex)
+	}
+
+	revGoucherParkerCalBData.lock.Lock()
+
+	defer revGoucherParkerCalBData.lock.Unlock()
+
+	newBaseData := &CalendarRevisedGoucherParkerBaseData{}
+
+	return newBaseData
+}
@@ -0,0 +1,601 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// CalendarRevisedJulianBaseData - Implements the Calendar Base Data
+// Interface (ICalendarBaseData) for the Revised Julian Calendar.
+//
+// The Revised Julian Calendar, proposed by Milutin Milanković in 1923,
+// shares month names and month lengths with the Julian Calendar. Under
+// the Revised Julian Calendar, years evenly divisible by 4 are leap years
+// unless they are century years. Century years are leap years only if
+// they have a remainder of 200 or 600 when divided by 900.
+//
+// Revised Julian Calendar dates coincide with Gregorian Calendar dates
+// from March 1, 1600 through February 28, 2800. Dates prior to the
+// inception of the Revised Julian Calendar are computed using the
+// proleptic Revised Julian Calendar.
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/Revised_Julian_calendar
+//
+type CalendarRevisedJulianBaseData struct {
+	lock *sync.Mutex
+}
+
+// GetCalendarSpecification - Returns the Calendar Specification ID for the
+// Revised Julian Calendar.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetCalendarSpecification() CalendarSpec {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	return CalendarSpec(0).RevisedJulian()
+}
+
+// GetISODayOfWeekNo - Receives a Julian Day Number and returns the ISO
+// 8601 Day Of The Week Number for that date. The seven day week cycle
+// is common to all calendars. Therefore, a given Julian Day Number
+// always falls on the same day of the week.
+//
+// For more information on the ISO 8601 Standard Day Of The Week Numbering
+// System, reference:
+//   https://en.wikipedia.org/wiki/ISO_8601#Week_dates
+//   Type: ISO8601DayOfWeekNo Source Code File: datetime/dayofweeknumberiso8601enum.go
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetISODayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	isoDayOfWeekNo ISO8601DayOfWeekNo,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetISODayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	isoDayOfWeekNo,
+	err = calBDataUtil.getISODayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return isoDayOfWeekNo, err
+}
+
+// GetUsDayOfWeekNo - Receives a Julian Day Number and returns the US Day
+// Of The Week Number for that date.
+//
+// For more information on the US Day Of The Week Numbering System, reference:
+//   https://www.timeanddate.com/date/week-numbers.html
+//   Type: UsDayOfWeekNo Source Code File: datetime/dayofweeknumberusenum.go
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetUsDayOfWeekNo(
+	julianDayNoDto JulianDayNoDto,
+	ePrefix string) (
+	usDayOfWeekNo UsDayOfWeekNo,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetUsDayOfWeekNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	usDayOfWeekNo,
+	err = calBDataUtil.getUsDayOfWeekNumber(
+		julianDayNoDto,
+		ePrefix)
+
+	return usDayOfWeekNo, err
+}
+
+// GetDaysInLeapYear - Returns the number of days in a leap year
+// (366-days).
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetDaysInLeapYear() int {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearDaysInYear()
+}
+
+// GetDaysInStandardYear - Returns the number of days in a standard
+// (non-leap) year (365-days).
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetDaysInStandardYear() int {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearDaysInYear()
+}
+
+// GetDaysInYear - Returns the number of days in the specified year
+// under the Revised Julian Calendar. The year is classified as an Astronomical,
+// Before Common Era or Common Era year by input parameter
+// 'yearNumType'.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	daysInYear int,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetDaysInYear() "
+
+	calRevJulianMech := calendarRevisedJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	daysInYear,
+	err = calBDataUtil.getDaysInYear(
+		year,
+		yearNumType,
+		calRevJulianMech.isLeapYear,
+		ePrefix)
+
+	return daysInYear, err
+}
+
+// GetDaysOfWeekNames - Returns a map containing the names of the days
+// of the week keyed by the day of the week numbering system specified
+// by input parameter 'dayOfWeekNoSysType'.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetDaysOfWeekNames(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	daysOfWeekNames map[int]string,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetDaysOfWeekNames() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	daysOfWeekNames,
+	err = calBDataUtil.getDaysOfWeekNames(
+		dayOfWeekNoSysType,
+		ePrefix)
+
+	return daysOfWeekNames, err
+}
+
+// GetDaysOfWeekNameAbbreviations - Returns a map containing the
+// abbreviated names of the days of the week. Each abbreviation
+// consists of the first 'numberOfCharsInAbbreviation' characters
+// of the week day name.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetDaysOfWeekNameAbbreviations(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	numberOfCharsInAbbreviation int,
+	ePrefix string) (
+	weekDayNameAbbrvs map[int] string,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetDaysOfWeekNameAbbreviations() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	weekDayNameAbbrvs,
+	err = calBDataUtil.getDaysOfWeekNameAbbreviations(
+		dayOfWeekNoSysType,
+		numberOfCharsInAbbreviation,
+		ePrefix)
+
+	return weekDayNameAbbrvs, err
+}
+
+// GetLeapYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in
+// a leap year.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetLeapYearOrdinalDays(
+	) map[int] int {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearOrdinalDays()
+}
+
+// GetLeapYearMonthDays - Returns a map containing the number of days
+// in each month of a leap year.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetLeapYearMonthDays(
+	) map[int] int {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getLeapYearMonthDays()
+}
+
+// GetMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// returns the associated month and day number. Ordinal day number
+// zero is interpreted as December 31st of the prior year and is
+// signaled by a 'yearAdjustment' value of minus one (-1).
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	isLeapYear bool,
+	ePrefix string)(
+	yearAdjustment int,
+	month int,
+	day int,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetMonthDayFromOrdinalDayNo() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	yearAdjustment,
+	month,
+	day,
+	err = calBDataUtil.getMonthDayFromOrdinalDayNo(
+		ordinalDate,
+		isLeapYear,
+		ePrefix)
+
+	return yearAdjustment, month, day, err
+}
+
+// GetOrdinalDayNumber - Computes the ordinal day number for a month
+// and day in a leap year or a standard year.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetOrdinalDayNumber(
+	isLeapYear bool,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetOrdinalDayNumber() "
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil.getOrdinalDayNumber(
+		isLeapYear,
+		month,
+		day,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// GetOrdinalDayNoFromDate - Computes the ordinal day number for the
+// specified Revised Julian Calendar date.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetOrdinalDayNoFromDate(
+	year int64,
+	yearType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	ordinalDayNo int,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetOrdinalDayNoFromDate() "
+
+	calRevJulianMech := calendarRevisedJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	ordinalDayNo,
+	err = calBDataUtil.getOrdinalDayNoFromDate(
+		year,
+		yearType,
+		month,
+		day,
+		calRevJulianMech.isLeapYear,
+		ePrefix)
+
+	return ordinalDayNo, err
+}
+
+// GetRemainingDaysInYear - Returns the number of days remaining in
+// the year following the specified Revised Julian Calendar date.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetRemainingDaysInYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	remainingDaysOfYear int,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetRemainingDaysInYear() "
+
+	calRevJulianMech := calendarRevisedJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	remainingDaysOfYear,
+	err = calBDataUtil.getRemainingDaysInYear(
+		year,
+		yearNumType,
+		month,
+		day,
+		calRevJulianMech.isLeapYear,
+		ePrefix)
+
+	return remainingDaysOfYear, err
+}
+
+// GetStandardYearMonthDays - Returns a map containing the number of
+// days in each month of a standard (non-leap) year.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetStandardYearMonthDays(
+	) map[int] int {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearMonthDays()
+}
+
+// GetStandardYearOrdinalDays - Returns a map containing the number of
+// ordinal days which have elapsed at the beginning of each month in a
+// standard (non-leap) year.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetStandardYearOrdinalDays(
+	) map[int] int {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	return calBDataMech.getStandardYearOrdinalDays()
+}
+
+// GetYearMonthDayFromOrdinalDayNo - Receives an Ordinal Day Number and
+// a year and returns the astronomical year, month and day under the
+// Revised Julian Calendar.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) GetYearMonthDayFromOrdinalDayNo(
+	ordinalDate int,
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string)(
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.GetYearMonthDayFromOrdinalDayNo() "
+
+	calRevJulianMech := calendarRevisedJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	astronomicalYear,
+	month,
+	day,
+	err = calBDataUtil.getYearMonthDayFromOrdinalDayNo(
+		ordinalDate,
+		year,
+		yearNumType,
+		calRevJulianMech.isLeapYear,
+		ePrefix)
+
+	return astronomicalYear, month, day, err
+}
+
+// IsLeapYear - Returns 'true' if the specified year is a leap year
+// under the Revised Julian Calendar.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) IsLeapYear(
+	year int64,
+	yearNumType CalendarYearNumType,
+	ePrefix string) (
+	isLeapYear bool,
+	err error ) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.IsLeapYear() "
+
+	calRevJulianMech := calendarRevisedJulianMechanics{}
+
+	calBDataMech := calendarBaseDataMechanics{}
+
+	isLeapYear,
+	err = calBDataMech.isLeapYear(
+		year,
+		yearNumType,
+		calRevJulianMech.isLeapYear,
+		ePrefix)
+
+	return isLeapYear, err
+}
+
+// IsValidDate - Returns 'true' if the specified year, month and day
+// form a valid date under the Revised Julian Calendar.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) IsValidDate(
+	year int64,
+	yearNumType CalendarYearNumType,
+	month int,
+	day int,
+	ePrefix string) (
+	isValid bool,
+	err error) {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	ePrefix += "CalendarRevisedJulianBaseData.IsValidDate() "
+
+	calRevJulianMech := calendarRevisedJulianMechanics{}
+
+	calBDataUtil := calendarBaseDataUtility{}
+
+	isValid,
+	err = calBDataUtil.isValidDate(
+		year,
+		yearNumType,
+		month,
+		day,
+		calRevJulianMech.isLeapYear,
+		ePrefix)
+
+	return isValid, err
+}
+
+// New - Returns a new instance of CalendarRevisedJulianBaseData as an
+// ICalendarBaseData interface.
+//
+func (revJulianCalBData *CalendarRevisedJulianBaseData) New() ICalendarBaseData {
+
+	if revJulianCalBData.lock == nil {
+		revJulianCalBData.lock = new(sync.Mutex)
+	}
+
+	revJulianCalBData.lock.Lock()
+
+	defer revJulianCalBData.lock.Unlock()
+
+	newBaseData := &CalendarRevisedJulianBaseData{}
+
+	return newBaseData
+}
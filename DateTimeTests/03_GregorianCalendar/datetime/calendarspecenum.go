@@ -12,6 +12,7 @@ var mCalendarSpecStringToCode = map[string]CalendarSpec{
 	"Julian"         : CalendarSpec(2),
 	"RevisedJulian"  : CalendarSpec(3),
 	"RevisedGoucherParker"  : CalendarSpec(4),
+	"JulianGregorian"  : CalendarSpec(5),
 }
 
 var mCalendarSpecLwrCaseStringToCode = map[string]CalendarSpec{
@@ -20,6 +21,7 @@ var mCalendarSpecLwrCaseStringToCode = map[string]CalendarSpec{
 	"julian"         : CalendarSpec(2),
 	"revisedjulian"  : CalendarSpec(3),
 	"revisedgoucherparker"  : CalendarSpec(4),
+	"juliangregorian"  : CalendarSpec(5),
 }
 
 var mCalendarSpecCodeToString = map[CalendarSpec]string{
@@ -28,6 +30,7 @@ var mCalendarSpecCodeToString = map[CalendarSpec]string{
 	CalendarSpec(2)  : "Julian",
 	CalendarSpec(3)  : "RevisedJulian",
 	CalendarSpec(4)  : "RevisedGoucherParker",
+	CalendarSpec(5)  : "JulianGregorian",
 }
 
 // CalendarSpec - An enumeration of calendar designations or types.
@@ -98,6 +101,15 @@ var mCalendarSpecCodeToString = map[CalendarSpec]string{
 //                        solar year of 365.2421897 days and experiences a 1-day calendar
 //                        drift approximately every + or - 500-billion years.
 //
+// JulianGregorian  (5) - Signals that the historical Julian/Gregorian Calendar is
+//                        specified and in effect. Dates prior to the Gregorian
+//                        reform, or cutover, date are computed using the Julian
+//                        Calendar. Dates on or after the cutover date are computed
+//                        using the Gregorian Calendar. The dates skipped by the
+//                        reform are invalid. Unless otherwise specified, the cutover
+//                        date is Friday, 15 October 1582 (Gregorian) which
+//                        immediately followed Thursday, 4 October 1582 (Julian).
+//
 //
 // For easy access to these enumeration values, use the global variable 'CalSpec'.
 // Example: CalSpec.Julian()
@@ -324,6 +336,42 @@ func (calSpec CalendarSpec) RevisedGoucherParker() CalendarSpec {
 	return CalendarSpec(4)
 }
 
+// JulianGregorian - Signals that the historical Julian/Gregorian
+// Calendar is specified and in effect.
+//
+// Real archival dates follow the Julian Calendar until a country's
+// reform date and the Gregorian Calendar thereafter. Under this
+// calendar, dates prior to the cutover date are computed using the
+// Julian Calendar. Dates on or after the cutover date are computed
+// using the Gregorian Calendar. The dates skipped by the reform do
+// not exist and are treated as invalid dates.
+//
+// The cutover date is configured as a Julian Day Number. Unless
+// otherwise specified, the cutover Julian Day Number is 2299161,
+// Friday, 15 October 1582 (Gregorian), which immediately followed
+// Thursday, 4 October 1582 (Julian). Great Britain and its colonies
+// adopted the Gregorian Calendar with a cutover Julian Day Number
+// of 2361222, Thursday, 14 September 1752 (Gregorian), which
+// immediately followed Wednesday, 2 September 1752 (Julian).
+//
+// For more information on configuring the cutover date, reference:
+//    Source File: datetime\calendarjuliangregorianbasedata.go
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/Adoption_of_the_Gregorian_calendar
+//   https://en.wikipedia.org/wiki/Conversion_between_Julian_and_Gregorian_calendars
+//
+// This method is part of the standard enumeration.
+//
+func (calSpec CalendarSpec) JulianGregorian() CalendarSpec {
+
+	lockCalendarSpec.Lock()
+
+	defer lockCalendarSpec.Unlock()
+
+	return CalendarSpec(5)
+}
+
 // String - Returns a string with the name of the enumeration associated
 // with this instance of 'CalendarSpec'.
 //
@@ -374,7 +422,7 @@ func (calSpec CalendarSpec) XIsValid() bool {
 
 	defer lockCalendarSpec.Unlock()
 
-	if calSpec > 4 ||
+	if calSpec > 5 ||
 		calSpec < 1 {
 		return false
 	}
@@ -522,5 +570,6 @@ func (calSpec CalendarSpec) XValueInt() int {
 // CalSpec.Julian(),
 // CalSpec.RevisedJulian(),
 // CalSpec.RevisedGoucherParker(),
+// CalSpec.JulianGregorian(),
 //
 var CalSpec CalendarSpec
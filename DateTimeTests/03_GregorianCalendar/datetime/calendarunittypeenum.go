@@ -0,0 +1,336 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mCalendarUnitTypeStringToCode = map[string]CalendarUnitType{
+	"None"  : CalendarUnitType(0),
+	"Day"   : CalendarUnitType(1),
+	"Week"  : CalendarUnitType(2),
+	"Month" : CalendarUnitType(3),
+	"Year"  : CalendarUnitType(4),
+}
+
+var mCalendarUnitTypeLwrCaseStringToCode = map[string]CalendarUnitType{
+	"none"  : CalendarUnitType(0),
+	"day"   : CalendarUnitType(1),
+	"week"  : CalendarUnitType(2),
+	"month" : CalendarUnitType(3),
+	"year"  : CalendarUnitType(4),
+}
+
+var mCalendarUnitTypeCodeToString = map[CalendarUnitType]string{
+	CalendarUnitType(0) : "None",
+	CalendarUnitType(1) : "Day",
+	CalendarUnitType(2) : "Week",
+	CalendarUnitType(3) : "Month",
+	CalendarUnitType(4) : "Year",
+}
+
+// CalendarUnitType - An enumeration of calendar units. Calendar
+// units are measured in local time. Their boundaries fall at local
+// midnight, and their length in hours varies on days with Daylight
+// Saving Time transitions.
+//
+// Since Go does not directly support enumerations, the 'CalendarUnitType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'CalendarUnitType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of calendar units.
+// These methods are listed as follows:
+//
+//
+// None         (0) - Signals that the Calendar Unit Type is not
+//                    initialized. This is an error condition.
+//
+// Day          (1) - A calendar day beginning at local midnight.
+//
+// Week         (2) - A calendar week beginning at local midnight on
+//                    Monday, in accordance with ISO 8601.
+//
+// Month        (3) - A calendar month beginning at local midnight on
+//                    the first day of the month.
+//
+// Year         (4) - A calendar year beginning at local midnight on
+//                    January 1st.
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'CalUnit'. Example: CalUnit.Month()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: CalendarUnitType(0).Month()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the CalendarUnitType methods in alphabetical order. Be advised that all
+// 'CalendarUnitType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type CalendarUnitType int
+
+var lockCalendarUnitType sync.Mutex
+
+// None - Signals that the CalendarUnitType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (calUnit CalendarUnitType) None() CalendarUnitType {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	return CalendarUnitType(0)
+}
+
+// Day - Signals a calendar day beginning at local midnight.
+//
+// This method is part of the standard enumeration.
+//
+func (calUnit CalendarUnitType) Day() CalendarUnitType {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	return CalendarUnitType(1)
+}
+
+// Week - Signals a calendar week beginning at local midnight on
+// Monday.
+//
+// This method is part of the standard enumeration.
+//
+func (calUnit CalendarUnitType) Week() CalendarUnitType {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	return CalendarUnitType(2)
+}
+
+// Month - Signals a calendar month beginning at local midnight on
+// the first day of the month.
+//
+// This method is part of the standard enumeration.
+//
+func (calUnit CalendarUnitType) Month() CalendarUnitType {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	return CalendarUnitType(3)
+}
+
+// Year - Signals a calendar year beginning at local midnight on
+// January 1st.
+//
+// This method is part of the standard enumeration.
+//
+func (calUnit CalendarUnitType) Year() CalendarUnitType {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	return CalendarUnitType(4)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'CalendarUnitType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= CalendarUnitType(0).Month()
+// str := t.String()
+//     str is now equal to 'Month'
+//
+func (calUnit CalendarUnitType) String() string {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	result, ok := mCalendarUnitTypeCodeToString[calUnit]
+
+	if !ok {
+		return "Error: Calendar Unit Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current CalendarUnitType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  calUnit := CalendarUnitType(0).Month()
+//
+//  isValid := calUnit.XIsValid()
+//
+func (calUnit CalendarUnitType) XIsValid() bool {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	if calUnit > 4 ||
+		calUnit < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of CalendarUnitType is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'month' will NOT
+//                        match the enumeration name, 'Month'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'MONTH'
+//                        will match match enumeration name 'Month'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// CalendarUnitType - Upon successful completion, this method will return
+//                    a new instance of CalendarUnitType set to the value
+//                    of the enumeration matched by the string search
+//                    performed on input parameter, 'valueString'.
+//
+// error            - If this method completes successfully, the returned error
+//                    Type is set equal to 'nil'. If an error condition is
+//                    encountered, this method will return an error type which
+//                    encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := CalendarUnitType(0).XParseString("MONTH", false)
+//
+//     t is now equal to CalendarUnitType(0).Month()
+//
+func (calUnit CalendarUnitType) XParseString(
+	valueString string,
+	caseSensitive bool) (CalendarUnitType, error) {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	ePrefix := "CalendarUnitType.XParseString() "
+
+	if len(valueString) < 3 {
+		return CalendarUnitType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '3'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var calendarUnitType CalendarUnitType
+
+	if caseSensitive {
+
+		calendarUnitType, ok = mCalendarUnitTypeStringToCode[valueString]
+
+	} else {
+
+		calendarUnitType, ok =
+			mCalendarUnitTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return CalendarUnitType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid CalendarUnitType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return calendarUnitType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// CalendarUnitType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (calUnit CalendarUnitType) XValue() CalendarUnitType {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	return calUnit
+}
+
+// XValueInt - This method returns the integer value of the current
+// CalendarUnitType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (calUnit CalendarUnitType) XValueInt() int {
+
+	lockCalendarUnitType.Lock()
+
+	defer lockCalendarUnitType.Unlock()
+
+	return int(calUnit)
+}
+
+// CalUnit - public global variable of
+// type CalendarUnitType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing CalendarUnitType values.
+//
+// Usage:
+// CalUnit.None(),
+// CalUnit.Day(),
+// CalUnit.Week(),
+// CalUnit.Month(),
+// CalUnit.Year(),
+//
+var CalUnit CalendarUnitType
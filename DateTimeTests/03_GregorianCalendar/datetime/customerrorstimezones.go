@@ -1,6 +1,9 @@
 package datetime
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type TimeZoneMapLookupError struct {
 	ePrefix  string // Contains a chain of called methods leading to error
@@ -100,3 +103,138 @@ func (e *TimeZoneError) Unwrap() error {
 	return e.err
 }
 
+
+// TimeZoneNonexistentTimeError - Signals that a local date time
+// does not exist in a time zone location. Nonexistent local times
+// fall within the gap created when clocks are set forward, as at
+// the start of Daylight Saving Time.
+//
+// TimeZoneNonexistentTimeError is a member of the TimeZoneError
+// family. errors.Is() will match this error against a target of
+// type *TimeZoneError.
+//
+type TimeZoneNonexistentTimeError struct {
+	ePrefix       string    // Contains a chain of called methods leading to error
+	timeZoneName  string    // Time Zone Location Name
+	localDateTime string    // The nonexistent local date time
+	gapStart      time.Time // Last instant preceding the gap
+	gapEnd        time.Time // Instant at which the gap ends
+	errMsg        string    // Error Message
+	err           error     // Next error in error chain
+}
+
+func (e *TimeZoneNonexistentTimeError) Error() string{
+
+	if len(e.errMsg) > 0 {
+
+		return fmt.Sprintf(e.ePrefix +
+			"\n%v\n" +
+			"Time Zone='%v'\nLocal Date Time='%v'\n",
+			e.errMsg, e.timeZoneName, e.localDateTime)
+	}
+
+	return fmt.Sprintf(e.ePrefix +
+		"\nLocal date time does NOT EXIST in this time zone!\n" +
+		"Time Zone='%v'\nLocal Date Time='%v'\n" +
+		"Gap Start='%v'\nGap End='%v'\n",
+		e.timeZoneName,
+		e.localDateTime,
+		e.gapStart.Format(FmtDateTimeYrMDayFmtStr),
+		e.gapEnd.Format(FmtDateTimeYrMDayFmtStr))
+}
+
+// GetGapEnd - Returns the instant at which the gap containing the
+// nonexistent local time ends.
+//
+func (e *TimeZoneNonexistentTimeError) GetGapEnd() time.Time {
+	return e.gapEnd
+}
+
+// GetGapStart - Returns the last instant preceding the gap
+// containing the nonexistent local time.
+//
+func (e *TimeZoneNonexistentTimeError) GetGapStart() time.Time {
+	return e.gapStart
+}
+
+func (e *TimeZoneNonexistentTimeError) Is(target error) bool {
+
+	switch target.(type) {
+	case *TimeZoneNonexistentTimeError, *TimeZoneError:
+		return true
+	}
+
+	return false
+}
+
+func (e *TimeZoneNonexistentTimeError) Unwrap() error {
+	return e.err
+}
+
+
+// TimeZoneAmbiguousTimeError - Signals that a local date time
+// occurs twice in a time zone location. Ambiguous local times fall
+// within the overlap created when clocks are set back, as at the
+// end of Daylight Saving Time.
+//
+// TimeZoneAmbiguousTimeError is a member of the TimeZoneError
+// family. errors.Is() will match this error against a target of
+// type *TimeZoneError.
+//
+type TimeZoneAmbiguousTimeError struct {
+	ePrefix       string    // Contains a chain of called methods leading to error
+	timeZoneName  string    // Time Zone Location Name
+	localDateTime string    // The ambiguous local date time
+	earlier       time.Time // First occurrence of the local date time
+	later         time.Time // Second occurrence of the local date time
+	errMsg        string    // Error Message
+	err           error     // Next error in error chain
+}
+
+func (e *TimeZoneAmbiguousTimeError) Error() string{
+
+	if len(e.errMsg) > 0 {
+
+		return fmt.Sprintf(e.ePrefix +
+			"\n%v\n" +
+			"Time Zone='%v'\nLocal Date Time='%v'\n",
+			e.errMsg, e.timeZoneName, e.localDateTime)
+	}
+
+	return fmt.Sprintf(e.ePrefix +
+		"\nLocal date time is AMBIGUOUS in this time zone!\n" +
+		"Time Zone='%v'\nLocal Date Time='%v'\n" +
+		"Earlier='%v'\nLater='%v'\n",
+		e.timeZoneName,
+		e.localDateTime,
+		e.earlier.Format(FmtDateTimeYrMDayFmtStr),
+		e.later.Format(FmtDateTimeYrMDayFmtStr))
+}
+
+// GetEarlier - Returns the first occurrence of the ambiguous
+// local time.
+//
+func (e *TimeZoneAmbiguousTimeError) GetEarlier() time.Time {
+	return e.earlier
+}
+
+// GetLater - Returns the second occurrence of the ambiguous
+// local time.
+//
+func (e *TimeZoneAmbiguousTimeError) GetLater() time.Time {
+	return e.later
+}
+
+func (e *TimeZoneAmbiguousTimeError) Is(target error) bool {
+
+	switch target.(type) {
+	case *TimeZoneAmbiguousTimeError, *TimeZoneError:
+		return true
+	}
+
+	return false
+}
+
+func (e *TimeZoneAmbiguousTimeError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,642 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DateTimeLocale - Contains the localized month names, day of the
+// week names, abbreviations and AM/PM markers for a single language
+// or locale. DateTimeLocale instances are used to format and parse
+// date time strings in languages other than English.
+//
+// Locale packs for more than a dozen languages are embedded in this
+// package and may be retrieved by locale identifier using type
+// DateTimeLocaleRegistry. Additional locale packs may be registered
+// at run time.
+//
+//  Example:
+//    locale, err := DateTimeLocaleRegistry{}.Get("fr", ePrefix)
+//
+//    dateTimeStr := locale.FormatDateTime(dateTime, "2 January 2006")
+//       dateTimeStr is now equal to "15 février 2024"
+//
+//    dateTime, err = locale.ParseDateTime(
+//       "2 January 2006",
+//       "15 février 2024",
+//       time.UTC,
+//       ePrefix)
+//
+// Month names are indexed from January (index 0) through December
+// (index 11). Day of the week names are indexed from Sunday (index 0)
+// through Saturday (index 6), consistent with type time.Weekday.
+//
+type DateTimeLocale struct {
+	localeId      string   // Normalized locale identifier. Example: "fr" or "pt-br"
+	languageName  string   // Name of the language in that language. Example: "Français"
+	monthNames    []string // Month names, January through December
+	monthAbbrvs   []string // Month name abbreviations, January through December
+	weekDayNames  []string // Day of the week names, Sunday through Saturday
+	weekDayAbbrvs []string // Day of the week abbreviations, Sunday through Saturday
+	amPmMarkers   []string // Ante Meridiem and Post Meridiem markers
+	lock          *sync.Mutex
+}
+
+// CopyOut - Returns a deep copy of the current DateTimeLocale
+// instance.
+//
+func (dtLocale *DateTimeLocale) CopyOut() DateTimeLocale {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	newLocale := DateTimeLocale{
+		localeId:      dtLocale.localeId,
+		languageName:  dtLocale.languageName,
+		monthNames:    append([]string(nil), dtLocale.monthNames...),
+		monthAbbrvs:   append([]string(nil), dtLocale.monthAbbrvs...),
+		weekDayNames:  append([]string(nil), dtLocale.weekDayNames...),
+		weekDayAbbrvs: append([]string(nil), dtLocale.weekDayAbbrvs...),
+		amPmMarkers:   append([]string(nil), dtLocale.amPmMarkers...),
+		lock:          new(sync.Mutex),
+	}
+
+	return newLocale
+}
+
+// FormatDateTime - Formats 'dateTime' using the Go 'time' package
+// layout, 'layout', and returns the result with month names, day of
+// the week names and AM/PM markers expressed in the language of the
+// current DateTimeLocale instance.
+//
+// The layout tokens "January", "Jan", "Monday", "Mon", "PM" and "pm"
+// are replaced with the localized equivalents. All other layout
+// tokens are formatted by the Go 'time' package.
+//
+//  Example: Locale "fr"
+//    dateTime = 2024-02-15 14:30:00 UTC
+//    layout   = "Monday 2 January 2006 15:04"
+//    result   = "jeudi 15 février 2024 14:30"
+//
+// If the current DateTimeLocale instance is invalid, the date time
+// is formatted in English.
+//
+func (dtLocale *DateTimeLocale) FormatDateTime(
+	dateTime time.Time,
+	layout string) string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	if dtLocaleMech.testLocaleValidity(dtLocale, "") != nil {
+		return dateTime.Format(layout)
+	}
+
+	return dtLocaleMech.formatDateTime(dtLocale, dateTime, layout)
+}
+
+// GetAmPmMarkers - Returns the localized Ante Meridiem (AM) and Post
+// Meridiem (PM) markers.
+//
+func (dtLocale *DateTimeLocale) GetAmPmMarkers() (
+	amMarker string,
+	pmMarker string) {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	if len(dtLocale.amPmMarkers) != 2 {
+		return amMarker, pmMarker
+	}
+
+	amMarker = dtLocale.amPmMarkers[0]
+	pmMarker = dtLocale.amPmMarkers[1]
+
+	return amMarker, pmMarker
+}
+
+// GetDaysOfWeekNameAbbreviations - Returns a map containing the
+// localized day of the week name abbreviations. This method is the
+// localized counterpart of method
+// ICalendarBaseData.GetDaysOfWeekNameAbbreviations().
+//
+// The returned map is indexed according to the Day Of The Week
+// Numbering System specified by input parameter,
+// 'dayOfWeekNoSysType':
+//
+//  DayOfWeekNumberingSystemType(0).UsDayOfWeek()
+//     0 = Sunday through 6 = Saturday
+//
+//  DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek()
+//     1 = Monday through 7 = Sunday
+//
+// If 'dayOfWeekNoSysType' is set to any other value, or if the
+// current DateTimeLocale instance is invalid, an error is returned.
+//
+func (dtLocale *DateTimeLocale) GetDaysOfWeekNameAbbreviations(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	weekDayNameAbbrvs map[int]string,
+	err error) {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	ePrefix += "DateTimeLocale.GetDaysOfWeekNameAbbreviations() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	err = dtLocaleMech.testLocaleValidity(dtLocale, ePrefix)
+
+	if err != nil {
+		return weekDayNameAbbrvs, err
+	}
+
+	return dtLocaleMech.getDaysOfWeekMap(
+		dtLocale.weekDayAbbrvs,
+		dayOfWeekNoSysType,
+		ePrefix)
+}
+
+// GetDaysOfWeekNames - Returns a map containing the localized day of
+// the week names. This method is the localized counterpart of method
+// ICalendarBaseData.GetDaysOfWeekNames().
+//
+// The returned map is indexed according to the Day Of The Week
+// Numbering System specified by input parameter,
+// 'dayOfWeekNoSysType':
+//
+//  DayOfWeekNumberingSystemType(0).UsDayOfWeek()
+//     0 = Sunday through 6 = Saturday
+//
+//  DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek()
+//     1 = Monday through 7 = Sunday
+//
+// If 'dayOfWeekNoSysType' is set to any other value, or if the
+// current DateTimeLocale instance is invalid, an error is returned.
+//
+func (dtLocale *DateTimeLocale) GetDaysOfWeekNames(
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	daysOfWeekNames map[int]string,
+	err error) {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	ePrefix += "DateTimeLocale.GetDaysOfWeekNames() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	err = dtLocaleMech.testLocaleValidity(dtLocale, ePrefix)
+
+	if err != nil {
+		return daysOfWeekNames, err
+	}
+
+	return dtLocaleMech.getDaysOfWeekMap(
+		dtLocale.weekDayNames,
+		dayOfWeekNoSysType,
+		ePrefix)
+}
+
+// GetLanguageName - Returns the name of the language associated with
+// the current DateTimeLocale instance. The language name is expressed
+// in that language. Example: "Français".
+//
+func (dtLocale *DateTimeLocale) GetLanguageName() string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	return dtLocale.languageName
+}
+
+// GetLocaleId - Returns the normalized locale identifier associated
+// with the current DateTimeLocale instance. Example: "fr" or "pt-br".
+//
+func (dtLocale *DateTimeLocale) GetLocaleId() string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	return dtLocale.localeId
+}
+
+// GetMonthName - Returns the localized name of the month designated
+// by input parameter 'month'. If 'month' is invalid, an empty string
+// is returned.
+//
+func (dtLocale *DateTimeLocale) GetMonthName(month time.Month) string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	if month < time.January ||
+		int(month) > len(dtLocale.monthNames) {
+		return ""
+	}
+
+	return dtLocale.monthNames[month-1]
+}
+
+// GetMonthNameAbbreviation - Returns the localized abbreviation of
+// the month designated by input parameter 'month'. If 'month' is
+// invalid, an empty string is returned.
+//
+func (dtLocale *DateTimeLocale) GetMonthNameAbbreviation(month time.Month) string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	if month < time.January ||
+		int(month) > len(dtLocale.monthAbbrvs) {
+		return ""
+	}
+
+	return dtLocale.monthAbbrvs[month-1]
+}
+
+// GetWeekDayName - Returns the localized name of the day of the week
+// designated by input parameter 'weekDay'. If 'weekDay' is invalid,
+// an empty string is returned.
+//
+func (dtLocale *DateTimeLocale) GetWeekDayName(weekDay time.Weekday) string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	if weekDay < time.Sunday ||
+		int(weekDay) >= len(dtLocale.weekDayNames) {
+		return ""
+	}
+
+	return dtLocale.weekDayNames[weekDay]
+}
+
+// GetWeekDayNameAbbreviation - Returns the localized abbreviation of
+// the day of the week designated by input parameter 'weekDay'. If
+// 'weekDay' is invalid, an empty string is returned.
+//
+func (dtLocale *DateTimeLocale) GetWeekDayNameAbbreviation(weekDay time.Weekday) string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	if weekDay < time.Sunday ||
+		int(weekDay) >= len(dtLocale.weekDayAbbrvs) {
+		return ""
+	}
+
+	return dtLocale.weekDayAbbrvs[weekDay]
+}
+
+// IsValidInstance - Returns 'true' if the current DateTimeLocale
+// instance is populated with a valid locale identifier, twelve month
+// names, twelve month abbreviations, seven day of the week names,
+// seven day of the week abbreviations and two AM/PM markers.
+//
+func (dtLocale *DateTimeLocale) IsValidInstance() bool {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	return dtLocaleMech.testLocaleValidity(dtLocale, "") == nil
+}
+
+// New - Creates and returns a new DateTimeLocale instance. The new
+// instance may be added to the locale registry by calling method
+// DateTimeLocaleRegistry.Register().
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  localeId            string
+//     - The locale identifier. Identifiers are not case sensitive
+//       and underscores are converted to hyphens. Examples: "fr",
+//       "pt-BR", "pt_BR".
+//
+//
+//  languageName        string
+//     - The name of the language. Example: "Français".
+//
+//
+//  monthNames          []string
+//     - Twelve localized month names, January through December.
+//
+//
+//  monthAbbreviations  []string
+//     - Twelve localized month name abbreviations, January through
+//       December.
+//
+//
+//  weekDayNames        []string
+//     - Seven localized day of the week names, Sunday through
+//       Saturday.
+//
+//
+//  weekDayAbbreviations []string
+//     - Seven localized day of the week abbreviations, Sunday
+//       through Saturday.
+//
+//
+//  amMarker            string
+//     - The localized Ante Meridiem marker. Example: "AM".
+//
+//
+//  pmMarker            string
+//     - The localized Post Meridiem marker. Example: "PM".
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  DateTimeLocale
+//     - If successful, this method returns a new, populated instance
+//       of DateTimeLocale.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If any of the names or markers is missing or empty, the
+//       returned error Type will encapsulate an appropriate error
+//       message. Note this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (dtLocale DateTimeLocale) New(
+	localeId string,
+	languageName string,
+	monthNames []string,
+	monthAbbreviations []string,
+	weekDayNames []string,
+	weekDayAbbreviations []string,
+	amMarker string,
+	pmMarker string,
+	ePrefix string) (
+	DateTimeLocale,
+	error) {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	ePrefix += "DateTimeLocale.New() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	newLocale := DateTimeLocale{
+		localeId:      dtLocaleMech.normalizeLocaleId(localeId),
+		languageName:  strings.TrimSpace(languageName),
+		monthNames:    append([]string(nil), monthNames...),
+		monthAbbrvs:   append([]string(nil), monthAbbreviations...),
+		weekDayNames:  append([]string(nil), weekDayNames...),
+		weekDayAbbrvs: append([]string(nil), weekDayAbbreviations...),
+		amPmMarkers:   []string{amMarker, pmMarker},
+		lock:          new(sync.Mutex),
+	}
+
+	err := dtLocaleMech.testLocaleValidity(&newLocale, ePrefix)
+
+	if err != nil {
+		return DateTimeLocale{}, err
+	}
+
+	return newLocale, nil
+}
+
+// ParseDateTime - Parses a date time string containing month names,
+// day of the week names or AM/PM markers expressed in the language
+// of the current DateTimeLocale instance. Input parameter 'layout'
+// is a Go 'time' package layout describing 'dateTimeStr'.
+//
+// Localized names are matched without regard to case and translated
+// to English before the date time string is parsed by the Go 'time'
+// package.
+//
+//  Example: Locale "fr"
+//    layout      = "2 January 2006"
+//    dateTimeStr = "15 février 2024"
+//    result      = 2024-02-15 00:00:00 +0000 UTC
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  layout              string
+//     - A Go 'time' package layout describing 'dateTimeStr'. Layout
+//       tokens "January", "Jan", "Monday", "Mon", "PM" and "pm"
+//       designate localized names and markers.
+//
+//
+//  dateTimeStr         string
+//     - The localized date time string to be parsed.
+//
+//
+//  location            *time.Location
+//     - The time zone location used when 'dateTimeStr' does not
+//       specify a UTC offset. If this parameter is 'nil', it
+//       defaults to UTC.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  time.Time
+//     - If successful, this method returns the parsed date time.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (dtLocale *DateTimeLocale) ParseDateTime(
+	layout string,
+	dateTimeStr string,
+	location *time.Location,
+	ePrefix string) (
+	time.Time,
+	error) {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	ePrefix += "DateTimeLocale.ParseDateTime() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	err := dtLocaleMech.testLocaleValidity(dtLocale, ePrefix)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(layout) == 0 {
+		return time.Time{}, errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'layout' is an empty string!\n")
+	}
+
+	if len(strings.TrimSpace(dateTimeStr)) == 0 {
+		return time.Time{}, errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'dateTimeStr' is an empty string!\n")
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+
+	englishStr := dtLocaleMech.translateToEnglish(
+		dtLocale,
+		dateTimeStr,
+		layout)
+
+	dateTime, err := time.ParseInLocation(layout, englishStr, location)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf(ePrefix+"\n"+
+			"Error: Unable to parse date time string using locale '%v'.\n"+
+			"dateTimeStr='%v'\n"+
+			"layout='%v'\n"+
+			"Error='%v'\n",
+			dtLocale.localeId, dateTimeStr, layout, err.Error())
+	}
+
+	return dateTime, nil
+}
+
+// TranslateToEnglish - Returns a copy of 'dateTimeStr' in which the
+// localized month names, day of the week names and AM/PM markers of
+// the current DateTimeLocale instance have been replaced with their
+// English equivalents. The returned string may then be parsed by the
+// Go 'time' package.
+//
+// If input parameter 'layout' is populated with a Go 'time' package
+// layout, the layout tokens "January", "Jan", "Monday", "Mon", "PM"
+// and "pm" are used to resolve ambiguous names. For example, the
+// Spanish abbreviation "mar" may designate either March or Tuesday.
+// If 'layout' is an empty string, or if the layout tokens cannot be
+// matched, the longest localized name found at each word boundary is
+// translated.
+//
+//  Example: Locale "fr"
+//    dateTimeStr = "jeudi 15 février 2024"
+//    result      = "Thursday 15 February 2024"
+//
+// If the current DateTimeLocale instance is invalid, 'dateTimeStr' is
+// returned unchanged.
+//
+func (dtLocale *DateTimeLocale) TranslateToEnglish(
+	dateTimeStr string,
+	layout string) string {
+
+	if dtLocale.lock == nil {
+		dtLocale.lock = new(sync.Mutex)
+	}
+
+	dtLocale.lock.Lock()
+
+	defer dtLocale.lock.Unlock()
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	if dtLocaleMech.testLocaleValidity(dtLocale, "") != nil {
+		return dateTimeStr
+	}
+
+	return dtLocaleMech.translateToEnglish(dtLocale, dateTimeStr, layout)
+}
@@ -0,0 +1,828 @@
+package datetime
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// embeddedDateTimeLocales - The locale packs compiled into this
+// package. Each locale pack supplies the month names, day of the
+// week names, abbreviations and AM/PM markers for a single language.
+// Reference source file 'datetime-locales.json'.
+//
+//go:embed datetime-locales.json
+var embeddedDateTimeLocales []byte
+
+// dateTimeLocaleRegistry - The registry of locale packs used to
+// format and parse localized date time strings. Map keys are
+// normalized locale identifiers. The registry is initialized from
+// 'embeddedDateTimeLocales' on first use and may be extended by
+// calling DateTimeLocaleRegistry.Register().
+//
+var dateTimeLocaleRegistry map[string]DateTimeLocale
+
+var lockDateTimeLocaleRegistry sync.Mutex
+
+// Localized name classes. Each class corresponds to one of the Go
+// 'time' package layout tokens "January", "Jan", "Monday", "Mon",
+// "PM" and "pm".
+const (
+	dtLocaleMonthName = iota
+	dtLocaleMonthAbbrv
+	dtLocaleWeekDayName
+	dtLocaleWeekDayAbbrv
+	dtLocaleAmPm
+	dtLocaleAmPmLower
+)
+
+// dtLocaleLayoutTokens - Go 'time' package layout tokens which
+// designate localized names. Longer tokens precede the shorter
+// tokens they contain.
+var dtLocaleLayoutTokens = []struct {
+	token     string
+	nameClass int
+}{
+	{"January", dtLocaleMonthName},
+	{"Jan", dtLocaleMonthAbbrv},
+	{"Monday", dtLocaleWeekDayName},
+	{"Mon", dtLocaleWeekDayAbbrv},
+	{"PM", dtLocaleAmPm},
+	{"pm", dtLocaleAmPmLower},
+}
+
+// dateTimeLocaleJsonDto - Defines the JSON format of a single locale
+// pack.
+//
+type dateTimeLocaleJsonDto struct {
+	LocaleId             string   `json:"localeId"`
+	LanguageName         string   `json:"languageName"`
+	MonthNames           []string `json:"monthNames"`
+	MonthAbbreviations   []string `json:"monthAbbreviations"`
+	WeekDayNames         []string `json:"weekDayNames"`
+	WeekDayAbbreviations []string `json:"weekDayAbbreviations"`
+	AmPmMarkers          []string `json:"amPmMarkers"`
+}
+
+// dateTimeLocalesJsonDto - Defines the JSON format of a collection
+// of locale packs.
+//
+type dateTimeLocalesJsonDto struct {
+	Locales []dateTimeLocaleJsonDto `json:"locales"`
+}
+
+// dateTimeLocaleLayoutToken - Identifies a localized name token
+// found in a Go 'time' package layout.
+//
+type dateTimeLocaleLayoutToken struct {
+	startIdx  int // Index of the first byte of the token
+	endIdx    int // Index following the last byte of the token
+	nameClass int // Localized name class
+}
+
+// dateTimeLocaleMechanics - Provides helper methods used to format
+// and parse localized date time strings and to maintain the locale
+// registry.
+//
+type dateTimeLocaleMechanics struct {
+	lock *sync.Mutex
+}
+
+// findName - Searches 'dateTimeStr' for the localized names of class
+// 'nameClass'. The search begins at byte index 'startIdx'. Names are
+// matched without regard to case and only at word boundaries. If
+// more than one name matches at the same index, the longest name is
+// selected.
+//
+// If a name is found, this method returns the byte index at which
+// the name was found, the byte length of the matched text and the
+// English equivalent of the name. Otherwise, 'isFound' is 'false'.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) findName(
+	dtLocale *DateTimeLocale,
+	dateTimeStr string,
+	startIdx int,
+	nameClass int) (
+	foundIdx int,
+	matchLen int,
+	englishName string,
+	isFound bool) {
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	localNames, englishNames := dtLocaleMech2.getNameClass(dtLocale, nameClass)
+
+	for idx := startIdx; idx < len(dateTimeStr); {
+
+		for i := 0; i < len(localNames); i++ {
+
+			length := dtLocaleMech2.matchName(dateTimeStr, idx, localNames[i])
+
+			if length > matchLen {
+				matchLen = length
+				englishName = englishNames[i]
+			}
+		}
+
+		if matchLen > 0 {
+			return idx, matchLen, englishName, true
+		}
+
+		_, runeLen := utf8.DecodeRuneInString(dateTimeStr[idx:])
+
+		idx += runeLen
+	}
+
+	return foundIdx, matchLen, englishName, false
+}
+
+// formatDateTime - Formats 'dateTime' according to the Go 'time'
+// package layout, 'layout'. Month names, day of the week names and
+// AM/PM markers are expressed in the language of 'dtLocale'.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) formatDateTime(
+	dtLocale *DateTimeLocale,
+	dateTime time.Time,
+	layout string) string {
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	layoutTokens := dtLocaleMech2.scanLayout(layout)
+
+	var b strings.Builder
+
+	lastIdx := 0
+
+	for _, layoutToken := range layoutTokens {
+
+		if layoutToken.startIdx > lastIdx {
+			b.WriteString(dateTime.Format(layout[lastIdx:layoutToken.startIdx]))
+		}
+
+		switch layoutToken.nameClass {
+
+		case dtLocaleMonthName:
+			b.WriteString(dtLocale.monthNames[dateTime.Month()-1])
+
+		case dtLocaleMonthAbbrv:
+			b.WriteString(dtLocale.monthAbbrvs[dateTime.Month()-1])
+
+		case dtLocaleWeekDayName:
+			b.WriteString(dtLocale.weekDayNames[dateTime.Weekday()])
+
+		case dtLocaleWeekDayAbbrv:
+			b.WriteString(dtLocale.weekDayAbbrvs[dateTime.Weekday()])
+
+		case dtLocaleAmPm, dtLocaleAmPmLower:
+
+			marker := dtLocale.amPmMarkers[0]
+
+			if dateTime.Hour() >= 12 {
+				marker = dtLocale.amPmMarkers[1]
+			}
+
+			if layoutToken.nameClass == dtLocaleAmPmLower {
+				marker = strings.ToLower(marker)
+			}
+
+			b.WriteString(marker)
+		}
+
+		lastIdx = layoutToken.endIdx
+	}
+
+	if lastIdx < len(layout) {
+		b.WriteString(dateTime.Format(layout[lastIdx:]))
+	}
+
+	return b.String()
+}
+
+// getDaysOfWeekMap - Converts day of the week names, ordered Sunday
+// through Saturday, to a map indexed according to the Day Of The
+// Week Numbering System, 'dayOfWeekNoSysType'.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) getDaysOfWeekMap(
+	weekDayNames []string,
+	dayOfWeekNoSysType DayOfWeekNumberingSystemType,
+	ePrefix string) (
+	map[int]string,
+	error) {
+
+	daysOfWeek := make(map[int]string, len(weekDayNames))
+
+	switch dayOfWeekNoSysType {
+
+	case DayOfWeekNumberingSystemType(0).UsDayOfWeek():
+
+		for i := 0; i < len(weekDayNames); i++ {
+			daysOfWeek[i] = weekDayNames[i]
+		}
+
+	case DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek():
+
+		// ISO 8601 numbers Monday as one (1) and Sunday as seven (7).
+		for i := 0; i < len(weekDayNames); i++ {
+
+			isoDayNo := i
+
+			if isoDayNo == 0 {
+				isoDayNo = 7
+			}
+
+			daysOfWeek[isoDayNo] = weekDayNames[i]
+		}
+
+	default:
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"ERROR: Input parameter 'dayOfWeekNoSysType' is INVALID!\n"+
+			"dayOfWeekNoSysType Value ='%v'\n",
+			dayOfWeekNoSysType.XValueInt())
+	}
+
+	return daysOfWeek, nil
+}
+
+// getLocale - Returns a deep copy of the registered locale pack
+// identified by 'localeId'. If no locale pack is registered for a
+// regional identifier such as "fr-CA", the locale pack for the base
+// language, "fr", is returned.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) getLocale(
+	localeId string,
+	ePrefix string) (
+	DateTimeLocale,
+	error) {
+
+	ePrefix += "dateTimeLocaleMechanics.getLocale() "
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	normalizedId := dtLocaleMech2.normalizeLocaleId(localeId)
+
+	if len(normalizedId) == 0 {
+		return DateTimeLocale{}, &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "localeId",
+			inputParameterValue: localeId,
+			errMsg:              "'localeId' is an empty string!",
+			err:                 nil,
+		}
+	}
+
+	lockDateTimeLocaleRegistry.Lock()
+
+	defer lockDateTimeLocaleRegistry.Unlock()
+
+	err := dtLocaleMech2.initializeRegistry(ePrefix)
+
+	if err != nil {
+		return DateTimeLocale{}, err
+	}
+
+	for searchId := normalizedId; len(searchId) > 0; {
+
+		dtLocale, ok := dateTimeLocaleRegistry[searchId]
+
+		if ok {
+			return dtLocale.CopyOut(), nil
+		}
+
+		hyphenIdx := strings.LastIndex(searchId, "-")
+
+		if hyphenIdx < 0 {
+			break
+		}
+
+		searchId = searchId[:hyphenIdx]
+	}
+
+	return DateTimeLocale{}, &InputParameterError{
+		ePrefix:             ePrefix,
+		inputParameterName:  "localeId",
+		inputParameterValue: localeId,
+		errMsg:              "No locale pack is registered for 'localeId'.",
+		err:                 nil,
+	}
+}
+
+// getLocaleIds - Returns the normalized identifiers of all
+// registered locale packs in ascending order.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) getLocaleIds(
+	ePrefix string) (
+	[]string,
+	error) {
+
+	ePrefix += "dateTimeLocaleMechanics.getLocaleIds() "
+
+	lockDateTimeLocaleRegistry.Lock()
+
+	defer lockDateTimeLocaleRegistry.Unlock()
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	err := dtLocaleMech2.initializeRegistry(ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	localeIds := make([]string, 0, len(dateTimeLocaleRegistry))
+
+	for localeId := range dateTimeLocaleRegistry {
+		localeIds = append(localeIds, localeId)
+	}
+
+	sort.Strings(localeIds)
+
+	return localeIds, nil
+}
+
+// getNameClass - Returns the localized names of class 'nameClass'
+// together with the corresponding English names.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) getNameClass(
+	dtLocale *DateTimeLocale,
+	nameClass int) (
+	localNames []string,
+	englishNames []string) {
+
+	switch nameClass {
+
+	case dtLocaleMonthName, dtLocaleMonthAbbrv:
+
+		localNames = dtLocale.monthNames
+
+		if nameClass == dtLocaleMonthAbbrv {
+			localNames = dtLocale.monthAbbrvs
+		}
+
+		for month := time.January; month <= time.December; month++ {
+
+			if nameClass == dtLocaleMonthName {
+				englishNames = append(englishNames, month.String())
+			} else {
+				englishNames = append(englishNames, month.String()[:3])
+			}
+		}
+
+	case dtLocaleWeekDayName, dtLocaleWeekDayAbbrv:
+
+		localNames = dtLocale.weekDayNames
+
+		if nameClass == dtLocaleWeekDayAbbrv {
+			localNames = dtLocale.weekDayAbbrvs
+		}
+
+		for weekDay := time.Sunday; weekDay <= time.Saturday; weekDay++ {
+
+			if nameClass == dtLocaleWeekDayName {
+				englishNames = append(englishNames, weekDay.String())
+			} else {
+				englishNames = append(englishNames, weekDay.String()[:3])
+			}
+		}
+
+	case dtLocaleAmPm:
+		localNames = dtLocale.amPmMarkers
+		englishNames = []string{"AM", "PM"}
+
+	case dtLocaleAmPmLower:
+		localNames = dtLocale.amPmMarkers
+		englishNames = []string{"am", "pm"}
+	}
+
+	return localNames, englishNames
+}
+
+// initializeRegistry - Loads the embedded locale packs into the
+// locale registry if the registry has not yet been initialized.
+//
+// The caller must hold 'lockDateTimeLocaleRegistry'.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) initializeRegistry(
+	ePrefix string) error {
+
+	if dateTimeLocaleRegistry != nil {
+		return nil
+	}
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	locales, err := dtLocaleMech2.parseLocalesJSON(
+		embeddedDateTimeLocales,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	dateTimeLocaleRegistry = make(map[string]DateTimeLocale, len(locales))
+
+	for i := 0; i < len(locales); i++ {
+		dateTimeLocaleRegistry[locales[i].localeId] = locales[i]
+	}
+
+	return nil
+}
+
+// matchName - Compares localized name 'name' to the text of
+// 'dateTimeStr' beginning at byte index 'startIdx'. The comparison
+// is not case sensitive. A name beginning or ending with a letter
+// must not be adjacent to another letter.
+//
+// If the name matches, this method returns the byte length of the
+// matched text. Otherwise, the returned value is zero.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) matchName(
+	dateTimeStr string,
+	startIdx int,
+	name string) int {
+
+	if len(name) == 0 {
+		return 0
+	}
+
+	firstRune, _ := utf8.DecodeRuneInString(name)
+
+	if unicode.IsLetter(firstRune) && startIdx > 0 {
+
+		prevRune, _ := utf8.DecodeLastRuneInString(dateTimeStr[:startIdx])
+
+		if unicode.IsLetter(prevRune) {
+			return 0
+		}
+	}
+
+	idx := startIdx
+
+	var lastRune rune
+
+	for _, nameRune := range name {
+
+		if idx >= len(dateTimeStr) {
+			return 0
+		}
+
+		strRune, runeLen := utf8.DecodeRuneInString(dateTimeStr[idx:])
+
+		if strRune != nameRune &&
+			unicode.ToLower(strRune) != unicode.ToLower(nameRune) &&
+			unicode.ToUpper(strRune) != unicode.ToUpper(nameRune) {
+			return 0
+		}
+
+		idx += runeLen
+
+		lastRune = nameRune
+	}
+
+	if unicode.IsLetter(lastRune) && idx < len(dateTimeStr) {
+
+		nextRune, _ := utf8.DecodeRuneInString(dateTimeStr[idx:])
+
+		if unicode.IsLetter(nextRune) {
+			return 0
+		}
+	}
+
+	return idx - startIdx
+}
+
+// normalizeLocaleId - Converts a locale identifier to lower case,
+// replaces underscores with hyphens and removes leading and
+// trailing white space. Example: "pt_BR" is converted to "pt-br".
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) normalizeLocaleId(
+	localeId string) string {
+
+	localeId = strings.TrimSpace(localeId)
+
+	localeId = strings.Replace(localeId, "_", "-", -1)
+
+	return strings.ToLower(localeId)
+}
+
+// parseLocalesJSON - Parses a collection of locale packs formatted
+// in JSON.
+//
+//  Example:
+//   {
+//     "locales": [
+//       {
+//         "localeId": "fr",
+//         "languageName": "Français",
+//         "monthNames": ["janvier", "février", ... "décembre"],
+//         "monthAbbreviations": ["janv.", "févr.", ... "déc."],
+//         "weekDayNames": ["dimanche", "lundi", ... "samedi"],
+//         "weekDayAbbreviations": ["dim.", "lun.", ... "sam."],
+//         "amPmMarkers": ["AM", "PM"]
+//       }
+//     ]
+//   }
+//
+// Month names begin with January. Day of the week names begin with
+// Sunday.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) parseLocalesJSON(
+	jsonLocales []byte,
+	ePrefix string) (
+	[]DateTimeLocale,
+	error) {
+
+	ePrefix += "dateTimeLocaleMechanics.parseLocalesJSON() "
+
+	if len(jsonLocales) == 0 {
+		return nil, errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'jsonLocales' is empty!\n")
+	}
+
+	localesJsonDto := dateTimeLocalesJsonDto{}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonLocales))
+
+	decoder.DisallowUnknownFields()
+
+	err := decoder.Decode(&localesJsonDto)
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"Error: Failed to decode locale pack JSON.\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	if len(localesJsonDto.Locales) == 0 {
+		return nil, errors.New(ePrefix + "\n" +
+			"Error: The locale pack JSON does not contain any locales!\n")
+	}
+
+	locales := make([]DateTimeLocale, 0, len(localesJsonDto.Locales))
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	for i := 0; i < len(localesJsonDto.Locales); i++ {
+
+		localeJson := localesJsonDto.Locales[i]
+
+		newLocale := DateTimeLocale{
+			localeId:      dtLocaleMech2.normalizeLocaleId(localeJson.LocaleId),
+			languageName:  strings.TrimSpace(localeJson.LanguageName),
+			monthNames:    localeJson.MonthNames,
+			monthAbbrvs:   localeJson.MonthAbbreviations,
+			weekDayNames:  localeJson.WeekDayNames,
+			weekDayAbbrvs: localeJson.WeekDayAbbreviations,
+			amPmMarkers:   localeJson.AmPmMarkers,
+			lock:          new(sync.Mutex),
+		}
+
+		err = dtLocaleMech2.testLocaleValidity(
+			&newLocale,
+			fmt.Sprintf(ePrefix+"locales[%v] ", i))
+
+		if err != nil {
+			return nil, err
+		}
+
+		locales = append(locales, newLocale)
+	}
+
+	return locales, nil
+}
+
+// registerLocale - Adds a deep copy of 'dtLocale' to the locale
+// registry. If a locale pack with the same identifier is already
+// registered, it is replaced.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) registerLocale(
+	dtLocale *DateTimeLocale,
+	ePrefix string) error {
+
+	ePrefix += "dateTimeLocaleMechanics.registerLocale() "
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	err := dtLocaleMech2.testLocaleValidity(dtLocale, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	newLocale := dtLocale.CopyOut()
+
+	lockDateTimeLocaleRegistry.Lock()
+
+	defer lockDateTimeLocaleRegistry.Unlock()
+
+	err = dtLocaleMech2.initializeRegistry(ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	dateTimeLocaleRegistry[newLocale.localeId] = newLocale
+
+	return nil
+}
+
+// scanLayout - Returns the localized name tokens found in the Go
+// 'time' package layout, 'layout', in order of occurrence.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) scanLayout(
+	layout string) []dateTimeLocaleLayoutToken {
+
+	layoutTokens := make([]dateTimeLocaleLayoutToken, 0)
+
+	for idx := 0; idx < len(layout); {
+
+		isToken := false
+
+		for _, layoutToken := range dtLocaleLayoutTokens {
+
+			if strings.HasPrefix(layout[idx:], layoutToken.token) {
+
+				layoutTokens = append(layoutTokens,
+					dateTimeLocaleLayoutToken{
+						startIdx:  idx,
+						endIdx:    idx + len(layoutToken.token),
+						nameClass: layoutToken.nameClass,
+					})
+
+				idx += len(layoutToken.token)
+
+				isToken = true
+
+				break
+			}
+		}
+
+		if !isToken {
+			idx++
+		}
+	}
+
+	return layoutTokens
+}
+
+// testLocaleValidity - Returns an error if 'dtLocale' does not
+// contain a locale identifier, twelve month names, twelve month
+// abbreviations, seven day of the week names, seven day of the week
+// abbreviations and two AM/PM markers. Names and markers may not be
+// empty.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) testLocaleValidity(
+	dtLocale *DateTimeLocale,
+	ePrefix string) error {
+
+	if dtLocale == nil {
+		return errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'dtLocale' is a nil pointer!\n")
+	}
+
+	if len(dtLocale.localeId) == 0 {
+		return errors.New(ePrefix + "\n" +
+			"Error: The locale identifier is an empty string!\n")
+	}
+
+	nameSets := []struct {
+		names        []string
+		expectedLen  int
+		nameSetLabel string
+	}{
+		{dtLocale.monthNames, 12, "month names"},
+		{dtLocale.monthAbbrvs, 12, "month abbreviations"},
+		{dtLocale.weekDayNames, 7, "day of the week names"},
+		{dtLocale.weekDayAbbrvs, 7, "day of the week abbreviations"},
+		{dtLocale.amPmMarkers, 2, "AM/PM markers"},
+	}
+
+	for _, nameSet := range nameSets {
+
+		if len(nameSet.names) != nameSet.expectedLen {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: Locale '%v' must contain %v %v.\n"+
+				"Number of %v='%v'\n",
+				dtLocale.localeId, nameSet.expectedLen, nameSet.nameSetLabel,
+				nameSet.nameSetLabel, len(nameSet.names))
+		}
+
+		for i := 0; i < len(nameSet.names); i++ {
+
+			if len(strings.TrimSpace(nameSet.names[i])) == 0 {
+				return fmt.Errorf(ePrefix+"\n"+
+					"Error: Locale '%v' %v[%v] is an empty string!\n",
+					dtLocale.localeId, nameSet.nameSetLabel, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// translateToEnglish - Replaces the localized month names, day of
+// the week names and AM/PM markers in 'dateTimeStr' with their
+// English equivalents.
+//
+// If 'layout' contains localized name tokens, each token is matched,
+// in order, to the next localized name of the corresponding class.
+// If 'layout' is empty, or if any layout token cannot be matched,
+// the longest localized name of any class found at each word
+// boundary is translated.
+//
+func (dtLocaleMech *dateTimeLocaleMechanics) translateToEnglish(
+	dtLocale *DateTimeLocale,
+	dateTimeStr string,
+	layout string) string {
+
+	dtLocaleMech2 := dateTimeLocaleMechanics{}
+
+	layoutTokens := dtLocaleMech2.scanLayout(layout)
+
+	if len(layoutTokens) > 0 {
+
+		var b strings.Builder
+
+		lastIdx := 0
+
+		isTranslated := true
+
+		for _, layoutToken := range layoutTokens {
+
+			foundIdx, matchLen, englishName, isFound :=
+				dtLocaleMech2.findName(
+					dtLocale,
+					dateTimeStr,
+					lastIdx,
+					layoutToken.nameClass)
+
+			if !isFound {
+				isTranslated = false
+				break
+			}
+
+			b.WriteString(dateTimeStr[lastIdx:foundIdx])
+			b.WriteString(englishName)
+
+			lastIdx = foundIdx + matchLen
+		}
+
+		if isTranslated {
+			b.WriteString(dateTimeStr[lastIdx:])
+			return b.String()
+		}
+	}
+
+	// Ties are resolved in favor of the name class listed first.
+	nameClasses := []int{
+		dtLocaleMonthName,
+		dtLocaleWeekDayName,
+		dtLocaleMonthAbbrv,
+		dtLocaleWeekDayAbbrv,
+		dtLocaleAmPm,
+	}
+
+	var b strings.Builder
+
+	for idx := 0; idx < len(dateTimeStr); {
+
+		bestLen := 0
+		bestName := ""
+
+		for _, nameClass := range nameClasses {
+
+			localNames, englishNames := dtLocaleMech2.getNameClass(dtLocale, nameClass)
+
+			for i := 0; i < len(localNames); i++ {
+
+				length := dtLocaleMech2.matchName(dateTimeStr, idx, localNames[i])
+
+				if length > bestLen {
+					bestLen = length
+					bestName = englishNames[i]
+				}
+			}
+		}
+
+		if bestLen > 0 {
+			b.WriteString(bestName)
+			idx += bestLen
+			continue
+		}
+
+		_, runeLen := utf8.DecodeRuneInString(dateTimeStr[idx:])
+
+		b.WriteString(dateTimeStr[idx : idx+runeLen])
+
+		idx += runeLen
+	}
+
+	return b.String()
+}
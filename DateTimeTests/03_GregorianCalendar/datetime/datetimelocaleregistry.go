@@ -0,0 +1,165 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// DateTimeLocaleRegistry - Provides access to the registry of locale
+// packs used to format and parse localized date time strings. Each
+// locale pack is represented by an instance of type DateTimeLocale.
+//
+// The registry is initialized with the locale packs embedded in this
+// package. Reference source file 'datetime-locales.json'. The
+// embedded locale identifiers are:
+//
+//  "da" Danish        "fi" Finnish       "nl" Dutch       "ru" Russian
+//  "de" German        "fr" French        "pl" Polish      "sv" Swedish
+//  "en" English       "it" Italian       "pt" Portuguese  "tr" Turkish
+//  "es" Spanish       "nb" Norwegian Bokmål
+//
+// Where a language inflects month names, the embedded locale pack
+// uses the form found in dates. For example, the Polish and Russian
+// locale packs use the genitive case: "15 lutego 2024".
+//
+// Locale identifiers are not case sensitive and underscores are
+// treated as hyphens. If no locale pack is registered for a regional
+// identifier such as "fr-CA", the locale pack for the base language,
+// "fr", is used.
+//
+type DateTimeLocaleRegistry struct {
+	lock *sync.Mutex
+}
+
+// Get - Returns a copy of the locale pack registered for input
+// parameter 'localeId'. If no locale pack is registered for a
+// regional identifier such as "fr-CA", the locale pack for the base
+// language, "fr", is returned. If no matching locale pack is found,
+// an error is returned.
+//
+func (dtLocaleReg DateTimeLocaleRegistry) Get(
+	localeId string,
+	ePrefix string) (
+	DateTimeLocale,
+	error) {
+
+	if dtLocaleReg.lock == nil {
+		dtLocaleReg.lock = new(sync.Mutex)
+	}
+
+	dtLocaleReg.lock.Lock()
+
+	defer dtLocaleReg.lock.Unlock()
+
+	ePrefix += "DateTimeLocaleRegistry.Get() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	return dtLocaleMech.getLocale(localeId, ePrefix)
+}
+
+// GetLocaleIds - Returns the identifiers of all registered locale
+// packs in ascending order.
+//
+func (dtLocaleReg DateTimeLocaleRegistry) GetLocaleIds(
+	ePrefix string) (
+	[]string,
+	error) {
+
+	if dtLocaleReg.lock == nil {
+		dtLocaleReg.lock = new(sync.Mutex)
+	}
+
+	dtLocaleReg.lock.Lock()
+
+	defer dtLocaleReg.lock.Unlock()
+
+	ePrefix += "DateTimeLocaleRegistry.GetLocaleIds() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	return dtLocaleMech.getLocaleIds(ePrefix)
+}
+
+// Register - Adds a locale pack to the registry. If a locale pack
+// with the same identifier is already registered, including one of
+// the embedded locale packs, it is replaced. Locale packs may be
+// created with method DateTimeLocale{}.New().
+//
+func (dtLocaleReg DateTimeLocaleRegistry) Register(
+	dtLocale DateTimeLocale,
+	ePrefix string) error {
+
+	if dtLocaleReg.lock == nil {
+		dtLocaleReg.lock = new(sync.Mutex)
+	}
+
+	dtLocaleReg.lock.Lock()
+
+	defer dtLocaleReg.lock.Unlock()
+
+	ePrefix += "DateTimeLocaleRegistry.Register() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	return dtLocaleMech.registerLocale(&dtLocale, ePrefix)
+}
+
+// RegisterFromJSON - Adds one or more locale packs formatted in JSON
+// to the registry. If a locale pack with the same identifier is
+// already registered, it is replaced.
+//
+// If the JSON is malformed, contains unknown elements or defines an
+// incomplete locale pack, an error is returned and the registry is
+// not changed.
+//
+//  Example:
+//   {
+//     "locales": [
+//       {
+//         "localeId": "fr",
+//         "languageName": "Français",
+//         "monthNames": ["janvier", "février", ... "décembre"],
+//         "monthAbbreviations": ["janv.", "févr.", ... "déc."],
+//         "weekDayNames": ["dimanche", "lundi", ... "samedi"],
+//         "weekDayAbbreviations": ["dim.", "lun.", ... "sam."],
+//         "amPmMarkers": ["AM", "PM"]
+//       }
+//     ]
+//   }
+//
+// Month names begin with January. Day of the week names begin with
+// Sunday.
+//
+func (dtLocaleReg DateTimeLocaleRegistry) RegisterFromJSON(
+	jsonLocales []byte,
+	ePrefix string) error {
+
+	if dtLocaleReg.lock == nil {
+		dtLocaleReg.lock = new(sync.Mutex)
+	}
+
+	dtLocaleReg.lock.Lock()
+
+	defer dtLocaleReg.lock.Unlock()
+
+	ePrefix += "DateTimeLocaleRegistry.RegisterFromJSON() "
+
+	dtLocaleMech := dateTimeLocaleMechanics{}
+
+	locales, err := dtLocaleMech.parseLocalesJSON(jsonLocales, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(locales); i++ {
+
+		err = dtLocaleMech.registerLocale(&locales[i], ePrefix)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
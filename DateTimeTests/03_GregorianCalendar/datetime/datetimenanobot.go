@@ -1040,11 +1040,12 @@ func (dTimeNanobot *DTimeNanobot) GetUtcOffsetTzAbbrvFromDateTime(
 	return utcOffset, tzAbbrv, err
 }
 
-// LoadTzLocation - Provides a single method for calling
-// time.LoadLocation(). This method may be altered in the future
-// to load time zones from an internal file thus affording
-// consistency in time zone definitions without relying on
-// zoneinfo.zip databases residing on host computers.
+// LoadTzLocation - Provides a single method for loading time
+// zone locations. Time zones are loaded from the current time zone
+// database which, by default, is the IANA time zone database
+// embedded in this package. This affords consistency in time zone
+// definitions without relying on zoneinfo databases residing on
+// host computers. Reference type 'TimeZoneDatabase'.
 //
 // If successful, this method returns a *time.Location or
 // location pointer to a valid time zone.
@@ -1072,19 +1073,15 @@ func (dTimeNanobot *DTimeNanobot) LoadTzLocation(
 			}
 	}
 
-	locPtr, err := time.LoadLocation(timeZoneName)
+	tzDbMech := timeZoneDatabaseMechanics{}
+
+	tzDatabase, err := tzDbMech.getCurrentDatabase(ePrefix)
 
 	if err != nil {
-		return nil,
-			&TimeZoneError{
-				ePrefix: ePrefix,
-				errMsg:  fmt.Sprintf("Error returned by time.LoadLocation(timeZoneName)!\n" +
-					"timeZoneName='%v'\nError='%v'\n", timeZoneName, err.Error()),
-				err:     nil,
-			}
+		return nil, err
 	}
 
-	return locPtr, nil
+	return tzDatabase.LoadLocation(timeZoneName, ePrefix)
 }
 
 // ConvertTimeToNewTimeZoneName - Receives a valid time (time.Time)
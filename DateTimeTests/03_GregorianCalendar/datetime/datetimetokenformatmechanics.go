@@ -0,0 +1,592 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+)
+
+// dateTimeTokenFields - Holds the date/time components consumed by
+// the token based date/time formatter. The year is always stored as
+// an Astronomical Year.
+//
+type dateTimeTokenFields struct {
+	calendarSystem   CalendarSpec       // Calendar System associated with the date
+	astronomicalYear *big.Int           // Astronomical Year Number
+	month            int                // Month Number
+	day              int                // Day Number day of the month
+	ordinalDayNo     int                // Day number within the year
+	isoWeekYear      *big.Int           // ISO 8601 week-based year (Astronomical)
+	isoWeekNumber    int                // ISO 8601 week number
+	isoWeekDay       ISO8601DayOfWeekNo // ISO 8601 day of the week number
+	hour             int                // Hour component of time value
+	minute           int                // Minute component of time value
+	second           int                // Second component of time value
+	nanosecond       int                // Nanosecond component of time value
+	zoneName         string             // Time Zone abbreviation. Example: "CST"
+	zoneOffset       string             // UTC offset. Example: "-0600"
+	julianDayNo      JulianDayNoDto     // Julian Day Number/Time
+
+	cutoverJulianDayNo int64 // Julian/Gregorian cutover. Zero applies the default.
+}
+
+// dateTimeTokenFormatMechanics - Provides methods used to format
+// date/time components with a token based format string.
+//
+// Unlike Go 'time' package layouts, the token formatter operates
+// directly on year, month, day and time components. Therefore, it
+// correctly formats years beyond +/-9999, Before Common Era (BCE)
+// dates and dates under non-Gregorian calendars.
+//
+// Format tokens consist of a percent sign ('%') followed by an
+// optional '-' flag, an optional precision ('.n') and a single
+// token character. The '-' flag suppresses zero padding for numeric
+// tokens. All other characters are copied to the output unchanged.
+//
+//  Token   Description                                 Example
+//  -----   -----------                                 -------
+//   %Y     Astronomical Year, minimum four digits     "-0043"
+//   %y     Era Year (BCE/CE numbering)                "44"
+//   %E     Era suffix                                 "BCE"
+//   %C     Calendar System                            "Julian"
+//   %m     Month Number, two digits                   "03"
+//   %B     Month Name                                 "March"
+//   %b     Month Name Abbreviation                    "Mar"
+//   %d     Day of the month, two digits               "15"
+//   %j     Ordinal Day Number, three digits           "074"
+//   %A     Day of the week name                       "Wednesday"
+//   %a     Day of the week abbreviation               "Wed"
+//   %u     ISO 8601 day of the week number (1-7)      "3"
+//   %V     ISO 8601 week number, two digits           "11"
+//   %G     ISO 8601 week-based Astronomical Year      "-0043"
+//   %H     Hour (00-23)                               "13"
+//   %I     Hour (01-12)                               "01"
+//   %p     AM or PM                                   "PM"
+//   %M     Minute, two digits                         "30"
+//   %S     Second, two digits (00-60)                 "05"
+//   %f     Fractional seconds. Default nine digits.   "123456789"
+//          '%.3f' yields three digits.                "123"
+//   %z     UTC Offset                                 "-0600"
+//   %Z     Time Zone abbreviation                     "CST"
+//   %J     Julian Day Number. '%.6J' yields the       "1705426"
+//          Julian Day Number/Time with six digits
+//          to the right of the decimal.               "1705426.062564"
+//   %%     Literal percent sign                       "%"
+//
+type dateTimeTokenFormatMechanics struct {
+	lock *sync.Mutex
+}
+
+// dateTimeTokenMonthNames - Month names used by the token formatter.
+// All calendars supported by this package employ the same month
+// names.
+var dateTimeTokenMonthNames = map[int]string{
+	1:  "January",
+	2:  "February",
+	3:  "March",
+	4:  "April",
+	5:  "May",
+	6:  "June",
+	7:  "July",
+	8:  "August",
+	9:  "September",
+	10: "October",
+	11: "November",
+	12: "December",
+}
+
+// dateTimeTokenWeekDayNames - Day of the week names keyed by
+// ISO 8601 day of the week number.
+var dateTimeTokenWeekDayNames = map[ISO8601DayOfWeekNo]string{
+	1: "Monday",
+	2: "Tuesday",
+	3: "Wednesday",
+	4: "Thursday",
+	5: "Friday",
+	6: "Saturday",
+	7: "Sunday",
+}
+
+// formatDateTime - Formats the date/time components in 'fields'
+// according to the token format string, 'tokenFormatStr'. Reference
+// the token table in the type description above.
+//
+func (dtTokenMech *dateTimeTokenFormatMechanics) formatDateTime(
+	fields *dateTimeTokenFields,
+	tokenFormatStr string,
+	ePrefix string) (
+	dateTimeStr string,
+	err error) {
+
+	if dtTokenMech.lock == nil {
+		dtTokenMech.lock = new(sync.Mutex)
+	}
+
+	dtTokenMech.lock.Lock()
+
+	defer dtTokenMech.lock.Unlock()
+
+	ePrefix += "dateTimeTokenFormatMechanics.formatDateTime() "
+
+	if fields == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'fields' is a nil pointer!\n")
+		return dateTimeStr, err
+	}
+
+	if len(tokenFormatStr) == 0 {
+		err = &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "tokenFormatStr",
+			inputParameterValue: "",
+			errMsg:              "Input parameter 'tokenFormatStr' is an empty string!",
+			err:                 nil,
+		}
+		return dateTimeStr, err
+	}
+
+	fmtRunes := []rune(tokenFormatStr)
+	lenFmtRunes := len(fmtRunes)
+
+	var b strings.Builder
+
+	for i := 0; i < lenFmtRunes; i++ {
+
+		if fmtRunes[i] != '%' {
+			b.WriteRune(fmtRunes[i])
+			continue
+		}
+
+		tokenStart := i
+
+		i++
+
+		noPadding := false
+
+		if i < lenFmtRunes && fmtRunes[i] == '-' {
+			noPadding = true
+			i++
+		}
+
+		precision := -1
+
+		if i < lenFmtRunes && fmtRunes[i] == '.' {
+
+			i++
+
+			precision = 0
+			digitCount := 0
+
+			for i < lenFmtRunes &&
+				fmtRunes[i] >= '0' &&
+				fmtRunes[i] <= '9' &&
+				digitCount < 2 {
+
+				precision = precision*10 + int(fmtRunes[i]-'0')
+				digitCount++
+				i++
+			}
+
+			if digitCount == 0 {
+				precision = -2
+			}
+		}
+
+		if i >= lenFmtRunes {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "tokenFormatStr",
+				inputParameterValue: tokenFormatStr,
+				errMsg: fmt.Sprintf("Format token beginning at index %v "+
+					"is incomplete.", tokenStart),
+				err: nil,
+			}
+			return dateTimeStr, err
+		}
+
+		token := fmtRunes[i]
+
+		if precision != -1 && token != 'f' && token != 'J' ||
+			precision == -2 {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "tokenFormatStr",
+				inputParameterValue: tokenFormatStr,
+				errMsg: fmt.Sprintf("Format token '%v' has an invalid precision.",
+					string(fmtRunes[tokenStart:i+1])),
+				err: nil,
+			}
+			return dateTimeStr, err
+		}
+
+		var tokenStr string
+
+		tokenStr, err = dtTokenMech.formatToken(
+			fields,
+			token,
+			noPadding,
+			precision,
+			ePrefix)
+
+		if err != nil {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "tokenFormatStr",
+				inputParameterValue: tokenFormatStr,
+				errMsg: fmt.Sprintf("Format token '%v' could not be formatted.",
+					string(fmtRunes[tokenStart:i+1])),
+				err: err,
+			}
+			return dateTimeStr, err
+		}
+
+		b.WriteString(tokenStr)
+	}
+
+	dateTimeStr = b.String()
+
+	return dateTimeStr, err
+}
+
+// formatToken - Returns the string value for a single format token.
+//
+func (dtTokenMech *dateTimeTokenFormatMechanics) formatToken(
+	fields *dateTimeTokenFields,
+	token rune,
+	noPadding bool,
+	precision int,
+	ePrefix string) (
+	tokenStr string,
+	err error) {
+
+	ePrefix += "dateTimeTokenFormatMechanics.formatToken() "
+
+	padInt := func(value, width int) string {
+		if noPadding {
+			return fmt.Sprintf("%d", value)
+		}
+		return fmt.Sprintf("%0*d", width, value)
+	}
+
+	padYear := func(year *big.Int) string {
+		if noPadding {
+			return year.String()
+		}
+		return dtTokenMech.formatYear(year, 4)
+	}
+
+	eraYear, eraYearType := dtTokenMech.getEraYear(fields.astronomicalYear)
+
+	switch token {
+
+	case 'Y':
+		tokenStr = padYear(fields.astronomicalYear)
+
+	case 'y':
+		tokenStr = eraYear.String()
+
+	case 'E':
+		tokenStr = eraYearType.XYearSuffix()
+
+	case 'C':
+		tokenStr = fields.calendarSystem.String()
+
+	case 'm':
+		tokenStr = padInt(fields.month, 2)
+
+	case 'B', 'b':
+
+		monthName, ok := dateTimeTokenMonthNames[fields.month]
+
+		if !ok {
+			err = fmt.Errorf(ePrefix+"\n"+
+				"Error: Month number is INVALID!\n"+
+				"month='%v'\n", fields.month)
+			return tokenStr, err
+		}
+
+		if token == 'b' {
+			monthName = monthName[0:3]
+		}
+
+		tokenStr = monthName
+
+	case 'd':
+		tokenStr = padInt(fields.day, 2)
+
+	case 'j':
+		tokenStr = padInt(fields.ordinalDayNo, 3)
+
+	case 'A', 'a':
+
+		weekDayName, ok := dateTimeTokenWeekDayNames[fields.isoWeekDay]
+
+		if !ok {
+			err = fmt.Errorf(ePrefix+"\n"+
+				"Error: ISO day of the week number is INVALID!\n"+
+				"isoWeekDay='%v'\n", int(fields.isoWeekDay))
+			return tokenStr, err
+		}
+
+		if token == 'a' {
+			weekDayName = weekDayName[0:3]
+		}
+
+		tokenStr = weekDayName
+
+	case 'u':
+		tokenStr = fmt.Sprintf("%d", int(fields.isoWeekDay))
+
+	case 'V':
+		tokenStr = padInt(fields.isoWeekNumber, 2)
+
+	case 'G':
+		tokenStr = padYear(fields.isoWeekYear)
+
+	case 'H':
+		tokenStr = padInt(fields.hour, 2)
+
+	case 'I':
+
+		hour12 := fields.hour % 12
+
+		if hour12 == 0 {
+			hour12 = 12
+		}
+
+		tokenStr = padInt(hour12, 2)
+
+	case 'p':
+
+		if fields.hour < 12 {
+			tokenStr = "AM"
+		} else {
+			tokenStr = "PM"
+		}
+
+	case 'M':
+		tokenStr = padInt(fields.minute, 2)
+
+	case 'S':
+		tokenStr = padInt(fields.second, 2)
+
+	case 'f':
+
+		if precision == -1 {
+			precision = 9
+		}
+
+		if precision < 1 || precision > 9 {
+			err = fmt.Errorf(ePrefix+"\n"+
+				"Error: Fractional second precision must be 1-9.\n"+
+				"precision='%v'\n", precision)
+			return tokenStr, err
+		}
+
+		tokenStr = fmt.Sprintf("%09d", fields.nanosecond)[0:precision]
+
+	case 'z':
+		tokenStr = fields.zoneOffset
+
+	case 'Z':
+		tokenStr = fields.zoneName
+
+	case 'J':
+
+		if !fields.julianDayNo.isThisInstanceValid {
+
+			calDtMech := calendarDateTimeMechanics{}
+
+			fields.julianDayNo, err = calDtMech.getJulianDayNumber(
+				fields.calendarSystem,
+				fields.astronomicalYear.Int64(),
+				fields.month,
+				fields.day,
+				fields.hour,
+				fields.minute,
+				fields.second,
+				fields.nanosecond,
+				fields.cutoverJulianDayNo,
+				ePrefix)
+
+			if err != nil {
+				return tokenStr, err
+			}
+		}
+
+		if precision == -1 {
+
+			var julianDayNo *big.Int
+
+			julianDayNo, err = fields.julianDayNo.GetJulianDayBigInt(ePrefix)
+
+			if err != nil {
+				return tokenStr, err
+			}
+
+			tokenStr = julianDayNo.String()
+
+			break
+		}
+
+		if precision < 1 || precision > 18 {
+			err = fmt.Errorf(ePrefix+"\n"+
+				"Error: Julian Day Number/Time precision must be 1-18.\n"+
+				"precision='%v'\n", precision)
+			return tokenStr, err
+		}
+
+		tokenStr, _, _, err = fields.julianDayNo.GetJulianDayNoTimeStr(precision)
+
+	case '%':
+		tokenStr = "%"
+
+	default:
+		err = fmt.Errorf(ePrefix+"\n"+
+			"Error: Format token character '%v' is INVALID!\n",
+			string(token))
+	}
+
+	return tokenStr, err
+}
+
+// formatYear - Formats a year value with a minimum number of digits.
+// Negative years are prefixed with a minus sign ('-'). The minus sign
+// is not included in the digit count.
+//
+func (dtTokenMech *dateTimeTokenFormatMechanics) formatYear(
+	year *big.Int,
+	minDigits int) string {
+
+	absYearStr := big.NewInt(0).Abs(year).String()
+
+	if len(absYearStr) < minDigits {
+		absYearStr = strings.Repeat("0", minDigits-len(absYearStr)) +
+			absYearStr
+	}
+
+	if year.Sign() < 0 {
+		return "-" + absYearStr
+	}
+
+	return absYearStr
+}
+
+// getEraYear - Converts an Astronomical Year to a Common Era year
+// value and year type. Astronomical Year zero (0) is 1 BCE.
+//
+func (dtTokenMech *dateTimeTokenFormatMechanics) getEraYear(
+	astronomicalYear *big.Int) (
+	eraYear *big.Int,
+	eraYearType CalendarYearNumType) {
+
+	if astronomicalYear.Sign() > 0 {
+		return big.NewInt(0).Set(astronomicalYear), CalendarYearNumType(0).CE()
+	}
+
+	eraYear = big.NewInt(0).Sub(big.NewInt(1), astronomicalYear)
+
+	return eraYear, CalendarYearNumType(0).BCE()
+}
+
+// getTokenFields - Assembles the date/time components required by the
+// token formatter from date and time transfer objects.
+//
+// If 'julianDayNo' is a valid instance, it is used for Julian Day
+// Number tokens. Otherwise, the Julian Day Number/Time is computed
+// from the date and time components when a Julian Day Number token
+// is formatted.
+//
+func (dtTokenMech *dateTimeTokenFormatMechanics) getTokenFields(
+	dateTransDto *DateTransferDto,
+	timeTransDto *TimeTransferDto,
+	julianDayNo *JulianDayNoDto,
+	ePrefix string) (
+	fields dateTimeTokenFields,
+	err error) {
+
+	if dtTokenMech.lock == nil {
+		dtTokenMech.lock = new(sync.Mutex)
+	}
+
+	dtTokenMech.lock.Lock()
+
+	defer dtTokenMech.lock.Unlock()
+
+	ePrefix += "dateTimeTokenFormatMechanics.getTokenFields() "
+
+	if dateTransDto == nil ||
+		timeTransDto == nil ||
+		julianDayNo == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: One or more input parameters is a nil pointer!\n")
+		return fields, err
+	}
+
+	if dateTransDto.calendarBaseData == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: The date transfer object has no calendar base data!\n")
+		return fields, err
+	}
+
+	fields.calendarSystem =
+		dateTransDto.calendarBaseData.GetCalendarSpecification()
+
+	if julianGregBData, ok :=
+		dateTransDto.calendarBaseData.(*CalendarJulianGregorianBaseData); ok {
+
+		fields.cutoverJulianDayNo = julianGregBData.GetCutoverJulianDayNo()
+	}
+
+	fields.astronomicalYear = big.NewInt(dateTransDto.astronomicalYear)
+	fields.month = dateTransDto.month
+	fields.day = dateTransDto.day
+
+	fields.ordinalDayNo,
+		err = dateTransDto.calendarBaseData.GetOrdinalDayNoFromDate(
+		dateTransDto.astronomicalYear,
+		CalendarYearNumType(0).Astronomical(),
+		dateTransDto.month,
+		dateTransDto.day,
+		ePrefix)
+
+	if err != nil {
+		return fields, err
+	}
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	var isoWeekYear int64
+
+	isoWeekYear,
+		fields.isoWeekNumber,
+		fields.isoWeekDay,
+		err = isoWeekDateMech.getISOWeekDate(
+		fields.calendarSystem,
+		dateTransDto.astronomicalYear,
+		dateTransDto.month,
+		dateTransDto.day,
+		ePrefix)
+
+	if err != nil {
+		return fields, err
+	}
+
+	fields.isoWeekYear = big.NewInt(isoWeekYear)
+
+	fields.hour = timeTransDto.hour
+	fields.minute = timeTransDto.minute
+	fields.second = timeTransDto.second
+	fields.nanosecond = timeTransDto.nanosecond
+
+	fields.zoneName = timeTransDto.timeZone.GetConvertibleZoneName()
+	fields.zoneOffset = timeTransDto.timeZone.GetConvertibleUtcOffset()
+
+	if julianDayNo.isThisInstanceValid {
+		fields.julianDayNo, err = julianDayNo.CopyOut(ePrefix)
+	}
+
+	return fields, err
+}
@@ -0,0 +1,825 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// dateTimeTokenParseResult - Holds the date/time components extracted
+// from a date/time string by the token based date/time parser.
+//
+type dateTimeTokenParseResult struct {
+	calendarSystem   CalendarSpec        // Calendar System associated with the date
+	astronomicalYear int64               // Year Number expressed as an Astronomical Year
+	yearNumType      CalendarYearNumType // Astronomical, BCE or CE
+	month            int                 // Month Number
+	day              int                 // Day Number day of the month
+	hour             int                 // Hour component of time value
+	minute           int                 // Minute component of time value
+	second           int                 // Second component of time value
+	nanosecond       int                 // Nanosecond component of time value
+	timeZoneName     string              // Time zone parsed with token '%Z'. May be empty.
+}
+
+// dateTimeTokenParseMechanics - Provides methods used to parse
+// date/time strings with the same format tokens used by the token
+// based date/time formatter. Reference type
+// dateTimeTokenFormatMechanics for the token table.
+//
+type dateTimeTokenParseMechanics struct {
+	lock *sync.Mutex
+}
+
+// newADateTimeDto - Creates a new ADateTimeDto instance from a parse
+// result. If the year was parsed as a Common Era year, the year
+// numbering mode of the new instance is set to Common Era.
+//
+// If 'timeZoneLocation' is an empty string, the time zone parsed with
+// token '%Z' is used. If neither is available, the time zone defaults
+// to UTC.
+//
+func (dtParseMech *dateTimeTokenParseMechanics) newADateTimeDto(
+	parsed *dateTimeTokenParseResult,
+	timeZoneLocation string,
+	dateTimeFmt string,
+	tag string,
+	ePrefix string) (
+	newDateTimeDto ADateTimeDto,
+	err error) {
+
+	if dtParseMech.lock == nil {
+		dtParseMech.lock = new(sync.Mutex)
+	}
+
+	dtParseMech.lock.Lock()
+
+	defer dtParseMech.lock.Unlock()
+
+	ePrefix += "dateTimeTokenParseMechanics.newADateTimeDto() "
+
+	if parsed == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'parsed' is a nil pointer!\n")
+		return newDateTimeDto, err
+	}
+
+	if len(timeZoneLocation) == 0 {
+		timeZoneLocation = parsed.timeZoneName
+	}
+
+	if len(timeZoneLocation) == 0 {
+		timeZoneLocation = TZones.UTC()
+	}
+
+	newDateTimeDto, err = ADateTimeDto{}.New(
+		parsed.calendarSystem,
+		parsed.astronomicalYear,
+		CalendarYearNumType(0).Astronomical(),
+		parsed.month,
+		parsed.day,
+		parsed.second == 60,
+		parsed.hour,
+		parsed.minute,
+		parsed.second,
+		parsed.nanosecond,
+		timeZoneLocation,
+		dateTimeFmt,
+		tag,
+		ePrefix)
+
+	if err != nil {
+		return newDateTimeDto, err
+	}
+
+	dtParseMech.setYearNumberingMode(
+		&newDateTimeDto.date,
+		parsed.yearNumType)
+
+	return newDateTimeDto, err
+}
+
+// parseDateTime - Parses a date/time string according to the token
+// format string, 'tokenFormatStr'.
+//
+// The year is taken from token '%Y' (Astronomical Year) or tokens
+// '%y' and '%E' (Era Year and Era). If the era is omitted, an Era Year
+// is treated as a Common Era (CE) year. If neither '%Y' nor '%y' is
+// present, tokens '%G', '%V' and '%u' (ISO 8601 week date) may supply
+// the date.
+//
+// The date is taken from tokens '%m'/'%B'/'%b' and '%d', from the
+// ordinal day token '%j' or from the ISO 8601 week date tokens. Missing
+// month or day values default to one (1). Missing time components
+// default to zero (0).
+//
+// Day of the week tokens ('%A', '%a' and '%u') are validated against
+// the parsed date. Token '%C' overrides input parameter
+// 'calendarSystem'. Token '%z' is parsed but ignored. Token '%J' is not
+// supported.
+//
+func (dtParseMech *dateTimeTokenParseMechanics) parseDateTime(
+	dateTimeStr string,
+	tokenFormatStr string,
+	calendarSystem CalendarSpec,
+	ePrefix string) (
+	parsed dateTimeTokenParseResult,
+	err error) {
+
+	if dtParseMech.lock == nil {
+		dtParseMech.lock = new(sync.Mutex)
+	}
+
+	dtParseMech.lock.Lock()
+
+	defer dtParseMech.lock.Unlock()
+
+	ePrefix += "dateTimeTokenParseMechanics.parseDateTime() "
+
+	if len(dateTimeStr) == 0 {
+		err = &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dateTimeStr",
+			inputParameterValue: "",
+			errMsg:              "Input parameter 'dateTimeStr' is an empty string!",
+			err:                 nil,
+		}
+		return parsed, err
+	}
+
+	if len(tokenFormatStr) == 0 {
+		err = &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "tokenFormatStr",
+			inputParameterValue: "",
+			errMsg:              "Input parameter 'tokenFormatStr' is an empty string!",
+			err:                 nil,
+		}
+		return parsed, err
+	}
+
+	parsed.calendarSystem = calendarSystem
+
+	var astronomicalYear, eraYear, isoWeekYear *big.Int
+
+	eraYearType := CalendarYearNumType(0).None()
+
+	month, day, ordinalDayNo, isoWeekNumber := -1, -1, -1, -1
+
+	hour, hour12, minute, second, nanosecond := -1, -1, 0, 0, 0
+
+	isoWeekDay := ISO8601DayOfWeekNo(0).None()
+
+	isPM, hasAmPm := false, false
+
+	fmtRunes := []rune(tokenFormatStr)
+	lenFmtRunes := len(fmtRunes)
+
+	inRunes := []rune(dateTimeStr)
+	pos := 0
+
+	mismatchErr := func(index int) error {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dateTimeStr",
+			inputParameterValue: dateTimeStr,
+			errMsg: fmt.Sprintf("'dateTimeStr' does not match format '%v' "+
+				"at character index %v.", tokenFormatStr, index),
+			err: nil,
+		}
+	}
+
+	for i := 0; i < lenFmtRunes; i++ {
+
+		if fmtRunes[i] != '%' {
+
+			if pos >= len(inRunes) || inRunes[pos] != fmtRunes[i] {
+				err = mismatchErr(pos)
+				return parsed, err
+			}
+
+			pos++
+
+			continue
+		}
+
+		tokenStart := i
+
+		i++
+
+		if i < lenFmtRunes && fmtRunes[i] == '-' {
+			i++
+		}
+
+		precision := -1
+
+		if i < lenFmtRunes && fmtRunes[i] == '.' {
+
+			i++
+
+			precision = 0
+
+			for i < lenFmtRunes &&
+				fmtRunes[i] >= '0' &&
+				fmtRunes[i] <= '9' {
+				precision = precision*10 + int(fmtRunes[i]-'0')
+				i++
+			}
+		}
+
+		if i >= lenFmtRunes {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "tokenFormatStr",
+				inputParameterValue: tokenFormatStr,
+				errMsg: fmt.Sprintf("Format token beginning at index %v "+
+					"is incomplete.", tokenStart),
+				err: nil,
+			}
+			return parsed, err
+		}
+
+		token := fmtRunes[i]
+
+		// A numeric token immediately followed by another token
+		// has a fixed width.
+		isAdjacent := i+1 < lenFmtRunes && fmtRunes[i+1] == '%'
+
+		tokenPos := pos
+
+		var ok bool
+		var bigValue *big.Int
+		var intValue int
+		var text string
+
+		switch token {
+
+		case 'Y', 'G':
+
+			bigValue, ok = dtParseMech.readYear(inRunes, &pos, true, isAdjacent)
+
+			if token == 'Y' {
+				astronomicalYear = bigValue
+			} else {
+				isoWeekYear = bigValue
+			}
+
+		case 'y':
+			eraYear, ok = dtParseMech.readYear(inRunes, &pos, false, isAdjacent)
+
+		case 'E':
+
+			text, ok = dtParseMech.readChoice(
+				inRunes, &pos, []string{"BCE", "BC", "CE", "AD"})
+
+			if text == "BCE" || text == "BC" {
+				eraYearType = CalendarYearNumType(0).BCE()
+			} else {
+				eraYearType = CalendarYearNumType(0).CE()
+			}
+
+		case 'C':
+
+			calendarNames := []string{
+				CalendarSpec(0).RevisedGoucherParker().String(),
+				CalendarSpec(0).JulianGregorian().String(),
+				CalendarSpec(0).RevisedJulian().String(),
+				CalendarSpec(0).Gregorian().String(),
+				CalendarSpec(0).Julian().String(),
+			}
+
+			text, ok = dtParseMech.readChoice(inRunes, &pos, calendarNames)
+
+			if ok {
+				parsed.calendarSystem, err =
+					CalendarSpec(0).XParseString(text, true)
+
+				if err != nil {
+					return parsed, err
+				}
+			}
+
+		case 'm':
+			month, ok = dtParseMech.readInt(inRunes, &pos, 2, isAdjacent)
+
+		case 'B', 'b':
+
+			var monthNames []string
+
+			for monthNo := 1; monthNo <= 12; monthNo++ {
+
+				monthName := dateTimeTokenMonthNames[monthNo]
+
+				if token == 'b' {
+					monthName = monthName[0:3]
+				}
+
+				monthNames = append(monthNames, monthName)
+			}
+
+			text, ok = dtParseMech.readChoice(inRunes, &pos, monthNames)
+
+			for idx, monthName := range monthNames {
+				if monthName == text {
+					month = idx + 1
+				}
+			}
+
+		case 'd':
+			day, ok = dtParseMech.readInt(inRunes, &pos, 2, isAdjacent)
+
+		case 'j':
+			ordinalDayNo, ok = dtParseMech.readInt(inRunes, &pos, 3, isAdjacent)
+
+		case 'A', 'a':
+
+			var weekDayNames []string
+
+			for weekDayNo := ISO8601DayOfWeekNo(1); weekDayNo <= 7; weekDayNo++ {
+
+				weekDayName := dateTimeTokenWeekDayNames[weekDayNo]
+
+				if token == 'a' {
+					weekDayName = weekDayName[0:3]
+				}
+
+				weekDayNames = append(weekDayNames, weekDayName)
+			}
+
+			text, ok = dtParseMech.readChoice(inRunes, &pos, weekDayNames)
+
+			for idx, weekDayName := range weekDayNames {
+				if weekDayName == text {
+					isoWeekDay = ISO8601DayOfWeekNo(idx + 1)
+				}
+			}
+
+		case 'u':
+
+			intValue, ok = dtParseMech.readInt(inRunes, &pos, 1, true)
+
+			isoWeekDay = ISO8601DayOfWeekNo(intValue)
+
+		case 'V':
+			isoWeekNumber, ok = dtParseMech.readInt(inRunes, &pos, 2, isAdjacent)
+
+		case 'H':
+			hour, ok = dtParseMech.readInt(inRunes, &pos, 2, isAdjacent)
+
+		case 'I':
+			hour12, ok = dtParseMech.readInt(inRunes, &pos, 2, isAdjacent)
+
+		case 'p':
+
+			text, ok = dtParseMech.readChoice(inRunes, &pos, []string{"AM", "PM"})
+
+			hasAmPm = true
+			isPM = text == "PM"
+
+		case 'M':
+			minute, ok = dtParseMech.readInt(inRunes, &pos, 2, isAdjacent)
+
+		case 'S':
+			second, ok = dtParseMech.readInt(inRunes, &pos, 2, isAdjacent)
+
+		case 'f':
+
+			maxDigits := 9
+
+			if precision > 0 && precision < 9 {
+				maxDigits = precision
+			}
+
+			startPos := pos
+
+			intValue, ok = dtParseMech.readInt(inRunes, &pos, maxDigits, false)
+
+			for digits := pos - startPos; ok && digits < 9; digits++ {
+				intValue *= 10
+			}
+
+			nanosecond = intValue
+
+		case 'z':
+
+			if pos < len(inRunes) && inRunes[pos] == 'Z' {
+				pos++
+				ok = true
+				break
+			}
+
+			if pos < len(inRunes) &&
+				(inRunes[pos] == '+' || inRunes[pos] == '-') {
+
+				pos++
+
+				_, ok = dtParseMech.readInt(inRunes, &pos, 2, true)
+
+				if ok && pos < len(inRunes) && inRunes[pos] == ':' {
+					pos++
+				}
+
+				if ok {
+					_, ok = dtParseMech.readInt(inRunes, &pos, 2, true)
+				}
+			}
+
+		case 'Z':
+
+			for pos < len(inRunes) && !unicode.IsSpace(inRunes[pos]) {
+				pos++
+			}
+
+			parsed.timeZoneName = string(inRunes[tokenPos:pos])
+
+			ok = len(parsed.timeZoneName) > 0
+
+		case '%':
+
+			if pos < len(inRunes) && inRunes[pos] == '%' {
+				pos++
+				ok = true
+			}
+
+		default:
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "tokenFormatStr",
+				inputParameterValue: tokenFormatStr,
+				errMsg: fmt.Sprintf("Format token '%v' is not supported "+
+					"for parsing.", string(fmtRunes[tokenStart:i+1])),
+				err: nil,
+			}
+			return parsed, err
+		}
+
+		if !ok {
+			err = mismatchErr(tokenPos)
+			return parsed, err
+		}
+	}
+
+	if pos != len(inRunes) {
+		err = &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dateTimeStr",
+			inputParameterValue: dateTimeStr,
+			errMsg: fmt.Sprintf("'dateTimeStr' contains unparsed characters "+
+				"beginning at index %v.", pos),
+			err: nil,
+		}
+		return parsed, err
+	}
+
+	if !parsed.calendarSystem.XIsValid() {
+		err = &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "calendarSystem",
+			inputParameterValue: parsed.calendarSystem.String(),
+			errMsg:              "'calendarSystem' is INVALID!",
+			err:                 nil,
+		}
+		return parsed, err
+	}
+
+	// Resolve the year
+	parsed.yearNumType = CalendarYearNumType(0).Astronomical()
+
+	if astronomicalYear == nil && eraYear != nil {
+
+		if eraYear.Sign() < 1 {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "dateTimeStr",
+				inputParameterValue: dateTimeStr,
+				errMsg:              "Era Year values must be greater than zero.",
+				err:                 nil,
+			}
+			return parsed, err
+		}
+
+		if eraYearType == CalendarYearNumType(0).BCE() {
+			astronomicalYear = big.NewInt(0).Sub(big.NewInt(1), eraYear)
+		} else {
+			astronomicalYear = eraYear
+			eraYearType = CalendarYearNumType(0).CE()
+		}
+
+		parsed.yearNumType = eraYearType
+	}
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	if astronomicalYear == nil {
+
+		if isoWeekYear == nil || isoWeekNumber < 0 {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "tokenFormatStr",
+				inputParameterValue: tokenFormatStr,
+				errMsg: "'tokenFormatStr' does not contain a year token " +
+					"('%Y' or '%y') or an ISO week date ('%G' and '%V').",
+				err: nil,
+			}
+			return parsed, err
+		}
+
+		if !isoWeekYear.IsInt64() {
+			err = mismatchErr(0)
+			return parsed, err
+		}
+
+		if isoWeekDay == ISO8601DayOfWeekNo(0).None() {
+			isoWeekDay = ISO8601DayOfWeekNo(0).Monday()
+		}
+
+		parsed.astronomicalYear,
+			month,
+			day,
+			err = isoWeekDateMech.getDateFromISOWeekDate(
+			parsed.calendarSystem,
+			isoWeekYear.Int64(),
+			isoWeekNumber,
+			isoWeekDay,
+			ePrefix)
+
+		if err != nil {
+			return parsed, err
+		}
+
+	} else {
+
+		if !astronomicalYear.IsInt64() {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "dateTimeStr",
+				inputParameterValue: dateTimeStr,
+				errMsg:              "The year value exceeds the range of an int64.",
+				err:                 nil,
+			}
+			return parsed, err
+		}
+
+		parsed.astronomicalYear = astronomicalYear.Int64()
+
+		if month < 0 && day < 0 && ordinalDayNo > 0 {
+
+			var jan1DayNo *big.Int
+
+			jan1DayNo, err = isoWeekDateMech.getJulianDayNo(
+				parsed.calendarSystem,
+				parsed.astronomicalYear,
+				1,
+				1,
+				ePrefix)
+
+			if err != nil {
+				return parsed, err
+			}
+
+			var ordinalYear int64
+
+			ordinalYear,
+				month,
+				day,
+				err = isoWeekDateMech.getDateFromJulianDayNo(
+				parsed.calendarSystem,
+				big.NewInt(0).Add(jan1DayNo, big.NewInt(int64(ordinalDayNo-1))),
+				ePrefix)
+
+			if err != nil {
+				return parsed, err
+			}
+
+			if ordinalYear != parsed.astronomicalYear {
+				err = &InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "dateTimeStr",
+					inputParameterValue: dateTimeStr,
+					errMsg: fmt.Sprintf("Ordinal day number '%v' exceeds the "+
+						"number of days in the year.", ordinalDayNo),
+					err: nil,
+				}
+				return parsed, err
+			}
+		}
+
+		if month < 0 {
+			month = 1
+		}
+
+		if day < 0 {
+			day = 1
+		}
+	}
+
+	parsed.month = month
+	parsed.day = day
+
+	// Resolve the hour
+	if hour < 0 && hour12 >= 0 {
+
+		if hour12 < 1 || hour12 > 12 {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "dateTimeStr",
+				inputParameterValue: dateTimeStr,
+				errMsg: fmt.Sprintf("12-hour clock value '%v' is INVALID!",
+					hour12),
+				err: nil,
+			}
+			return parsed, err
+		}
+
+		hour = hour12 % 12
+
+		if hasAmPm && isPM {
+			hour += 12
+		}
+	}
+
+	if hour < 0 {
+		hour = 0
+	}
+
+	parsed.hour = hour
+	parsed.minute = minute
+	parsed.second = second
+	parsed.nanosecond = nanosecond
+
+	if isoWeekDay != ISO8601DayOfWeekNo(0).None() &&
+		isoWeekNumber < 0 {
+
+		var actualWeekDay ISO8601DayOfWeekNo
+
+		_, _, actualWeekDay, err = isoWeekDateMech.getISOWeekDate(
+			parsed.calendarSystem,
+			parsed.astronomicalYear,
+			parsed.month,
+			parsed.day,
+			ePrefix)
+
+		if err != nil {
+			return parsed, err
+		}
+
+		if actualWeekDay != isoWeekDay {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "dateTimeStr",
+				inputParameterValue: dateTimeStr,
+				errMsg: fmt.Sprintf("The parsed day of the week '%v' does not "+
+					"match the date. The date falls on a '%v'.",
+					dateTimeTokenWeekDayNames[isoWeekDay],
+					dateTimeTokenWeekDayNames[actualWeekDay]),
+				err: nil,
+			}
+			return parsed, err
+		}
+	}
+
+	return parsed, err
+}
+
+// setYearNumberingMode - Parsed years are always submitted to date
+// constructors as Astronomical Years so that BCE years are converted
+// under the rule: 1 BCE = year 0. If the year was parsed as a Common
+// Era year, this method restores the Common Era year numbering mode
+// on the resulting date.
+//
+func (dtParseMech *dateTimeTokenParseMechanics) setYearNumberingMode(
+	dateTransDto *DateTransferDto,
+	yearNumType CalendarYearNumType) {
+
+	if yearNumType == CalendarYearNumType(0).Astronomical() {
+		return
+	}
+
+	dateTransDto.yearNumberingMode = CalendarYearNumMode(0).CommonEra()
+	dateTransDto.yearNumType = yearNumType
+}
+
+// readChoice - Reads the longest of the 'choices' strings found at
+// position '*pos' in 'inRunes'. The comparison is case insensitive.
+// The matching element of 'choices' is returned.
+//
+func (dtParseMech *dateTimeTokenParseMechanics) readChoice(
+	inRunes []rune,
+	pos *int,
+	choices []string) (
+	choice string,
+	ok bool) {
+
+	matchLen := 0
+
+	for _, candidate := range choices {
+
+		candidateRunes := []rune(candidate)
+
+		if len(candidateRunes) <= matchLen ||
+			*pos+len(candidateRunes) > len(inRunes) {
+			continue
+		}
+
+		if strings.EqualFold(
+			string(inRunes[*pos:*pos+len(candidateRunes)]),
+			candidate) {
+
+			choice = candidate
+			matchLen = len(candidateRunes)
+		}
+	}
+
+	if matchLen == 0 {
+		return choice, false
+	}
+
+	*pos += matchLen
+
+	return choice, true
+}
+
+// readInt - Reads an unsigned integer of up to 'maxDigits' digits
+// beginning at position '*pos' in 'inRunes'. If 'fixedWidth' is
+// 'true', exactly 'maxDigits' digits are required.
+//
+func (dtParseMech *dateTimeTokenParseMechanics) readInt(
+	inRunes []rune,
+	pos *int,
+	maxDigits int,
+	fixedWidth bool) (
+	value int,
+	ok bool) {
+
+	digits := 0
+
+	for *pos < len(inRunes) &&
+		digits < maxDigits &&
+		inRunes[*pos] >= '0' &&
+		inRunes[*pos] <= '9' {
+
+		value = value*10 + int(inRunes[*pos]-'0')
+		digits++
+		*pos++
+	}
+
+	if digits == 0 ||
+		fixedWidth && digits != maxDigits {
+		return value, false
+	}
+
+	return value, true
+}
+
+// readYear - Reads a year value beginning at position '*pos' in
+// 'inRunes'. If 'signed' is 'true', the year may be preceded by a
+// plus ('+') or minus ('-') sign. If 'fixedWidth' is 'true', exactly
+// four digits are read. Otherwise, all consecutive digits are read.
+//
+func (dtParseMech *dateTimeTokenParseMechanics) readYear(
+	inRunes []rune,
+	pos *int,
+	signed bool,
+	fixedWidth bool) (
+	year *big.Int,
+	ok bool) {
+
+	isNegative := false
+
+	if signed &&
+		*pos < len(inRunes) &&
+		(inRunes[*pos] == '-' || inRunes[*pos] == '+') {
+
+		isNegative = inRunes[*pos] == '-'
+		*pos++
+	}
+
+	startPos := *pos
+
+	for *pos < len(inRunes) &&
+		inRunes[*pos] >= '0' &&
+		inRunes[*pos] <= '9' {
+
+		if fixedWidth && *pos-startPos == 4 {
+			break
+		}
+
+		*pos++
+	}
+
+	if *pos == startPos ||
+		fixedWidth && *pos-startPos != 4 {
+		return year, false
+	}
+
+	year, ok = big.NewInt(0).SetString(string(inRunes[startPos:*pos]), 10)
+
+	if ok && isNegative {
+		year.Neg(year)
+	}
+
+	return year, ok
+}
@@ -441,10 +441,8 @@ func (dateTransDto *DateTransferDto) CopyOut(
 
 	dateTransUtil := dateTransferDtoUtility{}
 
-	newDateTransDto := DateTransferDto{}
-
 	return dateTransUtil.copyOut(
-		&newDateTransDto,
+		dateTransDto,
 		ePrefix)
 }
 
@@ -1431,7 +1429,7 @@ func (dateTransDto *DateTransferDto) GetMonth() int{
 
 	defer dateTransDto.lock.Unlock()
 
-	return dateTransDto.day
+	return dateTransDto.month
 }
 
 // GetOrdinalDayNoInYear - Returns the Ordinal Day Number in the year
@@ -2228,13 +2226,15 @@ func (dateTransDto *DateTransferDto) IsValidInstanceError(
 //       Source File: datetime\calendarspecenum.go
 //
 //       Possible Calendar System values include:
-//       Gregorian, Julian, Revised Julian, or Revised Goucher-Parker.
+//       Gregorian, Julian, Revised Julian, Revised Goucher-Parker or
+//       Julian/Gregorian.
 //
 //       Possible Enumeration Values:
 //         CalendarSpec(0).Gregorian()
 //         CalendarSpec(0).Julian()
 //         CalendarSpec(0).RevisedJulian()
 //         CalendarSpec(0).RevisedGoucherParker()
+//         CalendarSpec(0).JulianGregorian()
 //
 //
 //  year                     int64
@@ -2348,6 +2348,123 @@ func (dateTransDto DateTransferDto) NewFromComponents(
 		day,
 		hasLeapSecond,
 		calendarSystem,
+		0,
+		tag,
+		ePrefix)
+
+	return newDateTransDto, err
+}
+
+// NewJulianGregorianFromComponents - Returns a new DateTransferDto
+// instance for a date in the Julian/Gregorian Calendar using the
+// cutover Julian Day Number specified by input parameter
+// 'cutoverJulianDayNo'.
+//
+// Method NewFromComponents() always applies the default cutover
+// date, 15 October 1582 Gregorian. Use this method for calendars
+// which adopted the Gregorian reform on a different date. For
+// example, Great Britain and its colonies adopted the reform on
+// 14 September 1752 Gregorian (Julian Day Number 2361222).
+//
+// The cutover Julian Day Number is retained by the calendar base
+// data of the returned instance.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  cutoverJulianDayNo  int64
+//     - The first Julian Day Number governed by the Gregorian
+//       Calendar. If this value is zero, the default cutover Julian
+//       Day Number, 2299161, is applied. Otherwise, the value must be
+//       greater than or equal to 1794168 (1 March 200 Julian and
+//       Gregorian).
+//
+//
+//  year                int64
+//     - The year number. This value is interpreted according to
+//       input parameter 'yearType'.
+//
+//
+//  yearType            CalendarYearNumType
+//     - Classifies input parameter 'year' as an Astronomical Year,
+//       a Before Common Era (BCE) year or a Common Era (CE) year.
+//
+//
+//  month               int
+//     - The month number
+//
+//
+//  day                 int
+//    - The day number within the month identified by input
+//      parameter 'month'.
+//
+//
+//  hasLeapSecond       bool
+//     - If this parameter is set to 'true', it signals that the day
+//       contains a leap second.
+//
+//
+//  tag                 string
+//     - A string description to be associated with the newly created
+//       DateTransferDto instance generated by this method.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  DateTransferDto
+//     - If successful, this method will return a newly created instance of
+//       DateTransferDto based on the input parameters identified above.
+//
+//
+//  error
+//     - If this method is successful, the returned error Type is set
+//       equal to 'nil'. If errors are encountered during processing,
+//       the returned error Type will encapsulate an error message.
+//       Note that this error message will incorporate the method
+//       chain and text passed by input parameter, 'ePrefix'.
+//
+func (dateTransDto DateTransferDto) NewJulianGregorianFromComponents(
+	cutoverJulianDayNo int64,
+	year               int64,
+	yearType           CalendarYearNumType,
+	month              int,
+	day                int,
+	hasLeapSecond      bool,
+	tag                string,
+	ePrefix            string) (DateTransferDto, error) {
+
+	if dateTransDto.lock == nil {
+		dateTransDto.lock = new(sync.Mutex)
+	}
+
+	dateTransDto.lock.Lock()
+
+	defer dateTransDto.lock.Unlock()
+
+	ePrefix += "DateTransferDto.NewJulianGregorianFromComponents() "
+
+	newDateTransDto := DateTransferDto{}
+
+	dateTransUtil := dateTransferDtoUtility{}
+
+	err := dateTransUtil.setDateTransferDto(
+		&newDateTransDto,
+		year,
+		yearType,
+		month,
+		day,
+		hasLeapSecond,
+		CalendarSpec(0).JulianGregorian(),
+		cutoverJulianDayNo,
 		tag,
 		ePrefix)
 
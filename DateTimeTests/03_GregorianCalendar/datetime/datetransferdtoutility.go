@@ -386,14 +386,24 @@ func(dateTransUtil *dateTransferDtoUtility) compare(
 	var ordinalDayNoDtoOne, ordinalDayNoDtoTwo int
 
 	ordinalDayNoDtoOne,
-	err = dateTransDtoOne.GetOrdinalDayNoInYear(ePrefix)
+	err = dateTransDtoOne.calendarBaseData.GetOrdinalDayNoFromDate(
+		dateTransDtoOne.astronomicalYear,
+		CalendarYearNumType(0).Astronomical(),
+		dateTransDtoOne.month,
+		dateTransDtoOne.day,
+		ePrefix)
 
 	if err != nil {
 		return compareResult, err
 	}
 
 	ordinalDayNoDtoTwo,
-	err = dateTransDtoTwo.GetOrdinalDayNoInYear(ePrefix)
+	err = dateTransDtoTwo.calendarBaseData.GetOrdinalDayNoFromDate(
+		dateTransDtoTwo.astronomicalYear,
+		CalendarYearNumType(0).Astronomical(),
+		dateTransDtoTwo.month,
+		dateTransDtoTwo.day,
+		ePrefix)
 
 	if err != nil {
 		return compareResult, err
@@ -1023,13 +1033,24 @@ func(dateTransUtil *dateTransferDtoUtility) getDaysInYear(
 //       Source File: datetime\calendarspecenum.go
 //
 //       Possible Calendar System values include:
-//       Gregorian, Julian, Revised Julian, or Revised Goucher-Parker.
+//       Gregorian, Julian, Revised Julian, Revised Goucher-Parker or
+//       Julian/Gregorian.
 //
 //       Possible Enumeration Values:
 //         CalendarSpec(0).Gregorian()
 //         CalendarSpec(0).Julian()
 //         CalendarSpec(0).RevisedJulian()
 //         CalendarSpec(0).RevisedGoucherParker()
+//         CalendarSpec(0).JulianGregorian()
+//
+//
+//  cutoverJulianDayNo  int64
+//     - Applies only when 'calendarSystem' is set to
+//       CalendarSpec(0).JulianGregorian(). This is the first Julian
+//       Day Number governed by the Gregorian Calendar. If this value
+//       is zero, the default cutover Julian Day Number, 2299161
+//       (15 October 1582 Gregorian), is applied. For all other
+//       calendar systems, this parameter is ignored.
 //
 //
 //  tag                 string
@@ -1062,6 +1083,7 @@ func(dateTransUtil *dateTransferDtoUtility) setDateTransferDto(
 	day int,
 	hasLeapSecond bool,
 	calendarSystem CalendarSpec,
+	cutoverJulianDayNo int64,
 	tag string,
 	ePrefix string) (err error) {
 
@@ -1135,10 +1157,37 @@ func(dateTransUtil *dateTransferDtoUtility) setDateTransferDto(
 	case CalendarSpec(0).Gregorian():
 		calendarBaseData = &CalendarGregorianBaseData{}
 
+	case CalendarSpec(0).Julian():
+		calendarBaseData = &CalendarJulianBaseData{}
+
+	case CalendarSpec(0).RevisedJulian():
+		calendarBaseData = &CalendarRevisedJulianBaseData{}
+
+	case CalendarSpec(0).RevisedGoucherParker():
+		calendarBaseData = &CalendarRevisedGoucherParkerBaseData{}
+
+	case CalendarSpec(0).JulianGregorian():
+
+		if cutoverJulianDayNo != 0 {
+
+			calJulianGregMech := calendarJulianGregorianMechanics{}
+
+			err = calJulianGregMech.testCutoverJulianDayNo(
+				cutoverJulianDayNo,
+				ePrefix)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		calendarBaseData = &CalendarJulianGregorianBaseData{
+			cutoverJulianDayNo: cutoverJulianDayNo,
+		}
+
 	default:
 		err = fmt.Errorf(ePrefix + "\n" +
 			"Error: Input parameter 'calendarSystem' is INVALID!\n" +
-			"Currently, the Gregorian Calendar System is the only supported calendar.\n" +
 			"calendarSystem='%v'\n",
 			calendarSystem.XValueInt())
 		return err
@@ -1180,7 +1229,7 @@ func(dateTransUtil *dateTransferDtoUtility) setDateTransferDto(
 
 	maxDaysInMonth := yearMonthDays[month]
 
-	if day < 0 || day > maxDaysInMonth {
+	if day < 1 || day > maxDaysInMonth {
 
 		err = fmt.Errorf(ePrefix + "\n" +
 			"Error: Input parameter 'day' is INVALID!\n" +
@@ -1188,7 +1237,7 @@ func(dateTransUtil *dateTransferDtoUtility) setDateTransferDto(
 			"maximum number of days in this month is '%v'\n" +
 			"month='%v'\n" +
 			"day='%v'",
-			len(yearMonthDays),
+			maxDaysInMonth,
 			month,
 			day)
 
@@ -128,6 +128,91 @@ type DateTzDto struct {
 	lock       *sync.Mutex      // Mutex used to ensure thread-safe operations.
 }
 
+// AddBusinessDays - Adds, or subtracts, a number of business days to
+// the date of the current DateTzDto instance and returns the result
+// in a new DateTzDto instance. The current DateTzDto instance is NOT
+// modified.
+//
+// Business days are days which are neither weekend days nor holidays
+// as defined by input parameter 'busDayCal'. Calendar dates are
+// evaluated in the time zone of the current DateTzDto instance.
+// The local time of day is retained in the result. If that time of
+// day does not exist on the resulting date because of a Daylight
+// Saving Time transition, the time value is normalized while the
+// local date is preserved.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  businessDays      int
+//       - The number of business days to add to the current date.
+//         Negative values will subtract business days from the
+//         current date. If this value is zero, a copy of the
+//         current DateTzDto instance is returned.
+//
+//  busDayCal         BusinessDayCalendar
+//       - Defines the weekend days and the optional holiday source
+//         used to identify business days. Reference:
+//               datetime\businessdaycalendar.go
+//
+//   dateTimeFmtStr string
+//       - A date time format string which will be used
+//         to format and display 'dateTime'. Example:
+//         "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//         If 'dateTimeFmtStr' is submitted as an
+//         'empty string', the date time format string
+//         of the current DateTzDto instance will be
+//         applied.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  DateTzDto - If successful the method returns a new, valid, fully populated
+//              DateTzDto type set to the resulting business day.
+//
+//
+//  error     - If successful the returned error Type is set equal to 'nil'. If errors are
+//              encountered this error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  busDayCal := BusinessDayCalendar{}.NewStandardWeekend(&holidayCalendar)
+//
+//  dtz2, err := dtz.AddBusinessDays(
+//                  10,
+//                  busDayCal,
+//                  FmtDateTimeYrMDayFmtStr)
+//
+func (dtz *DateTzDto) AddBusinessDays(
+	businessDays int,
+	busDayCal BusinessDayCalendar,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.AddBusinessDays() "
+
+	busDayMech := businessDayMechanics{}
+
+	return busDayMech.addBusinessDays(
+		dtz,
+		businessDays,
+		&busDayCal,
+		dateTimeFmtStr,
+		ePrefix)
+}
+
 // AddDate - Adds input parameters 'years, 'months' and 'days' to date time value of the
 // current DateTzDto and returns the updated value in a new DateTzDto instance.
 //
@@ -1371,6 +1456,51 @@ func (dtz *DateTzDto) AddTimeToThis(
 	return nil
 }
 
+// BusinessDaysBetween - Returns the number of business days between
+// the date of the current DateTzDto instance and the date of input
+// parameter 'endDateTz'. The current date is excluded from the count
+// and the ending date is included. Both dates are evaluated in the
+// time zone of the current DateTzDto instance.
+//
+// If the ending date precedes the current date, the returned value
+// is negative. In that case, the current date is included in the
+// count and the ending date is excluded. Accordingly, when the
+// current date is a business day, calling AddBusinessDays() with the
+// returned value yields the ending date whenever the ending date is
+// also a business day.
+//
+// Business days are days which are neither weekend days nor holidays
+// as defined by input parameter 'busDayCal'.
+//
+//  Example:
+//    Current Date: Friday    2021-06-04
+//    End Date:     Tuesday   2021-06-08
+//    Weekend:      Saturday and Sunday
+//    Result:       2  (Monday 06-07 and Tuesday 06-08)
+//
+func (dtz *DateTzDto) BusinessDaysBetween(
+	endDateTz DateTzDto,
+	busDayCal BusinessDayCalendar) (int64, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.BusinessDaysBetween() "
+
+	busDayMech := businessDayMechanics{}
+
+	return busDayMech.businessDaysBetween(
+		dtz,
+		&endDateTz,
+		&busDayCal,
+		ePrefix)
+}
+
 // Compare - Compares the date time values of the current
 // DateTzDto object ('dtz') and the 'dtz2' DateTzDto object
 // passed as an input parameter. 
@@ -2346,6 +2476,107 @@ func (dtz *DateTzDto) GetOriginalTzUtcOffset() string {
 	return dtz.timeZone.GetOriginalUtcOffset()
 }
 
+// GetISOWeekDate - Returns the ISO 8601 week date for the date
+// encapsulated by the current DateTzDto instance. The week date is
+// computed from the date in the time zone of the current instance.
+//
+// The returned ISOWeekDateDto contains the week-based year, the week
+// number (1-53) and the ISO 8601 day of the week number (Monday=1,
+// Sunday=7). The week-based year is formatted as an Astronomical
+// Year.
+//
+// Example:
+//   DateTzDto Date 2008-12-29 is ISO Week Date 2009-W01-1
+//
+// Reference:
+//   https://en.wikipedia.org/wiki/ISO_week_date
+//
+func (dtz *DateTzDto) GetISOWeekDate() (ISOWeekDateDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.GetISOWeekDate() "
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	weekYear,
+	weekNumber,
+	weekDay,
+	err := isoWeekDateMech.getISOWeekDate(
+		CalendarSpec(0).Gregorian(),
+		int64(dtz.dateTimeValue.Year()),
+		int(dtz.dateTimeValue.Month()),
+		dtz.dateTimeValue.Day(),
+		ePrefix)
+
+	if err != nil {
+		return ISOWeekDateDto{}, err
+	}
+
+	return ISOWeekDateDto{}.New(
+		weekYear,
+		weekNumber,
+		weekDay,
+		ePrefix)
+}
+
+// GetLocaleDateTimeStr - Returns the date time of the current
+// DateTzDto instance formatted with the date time format string
+// stored in this instance. Month names, day of the week names and
+// AM/PM markers are expressed in the language designated by input
+// parameter 'localeId'.
+//
+// If the current DateTzDto date time format string is empty, the
+// default format, FmtDateTimeYrMDayFmtStr, is used.
+//
+// 'localeId' identifies a locale pack registered with type
+// DateTimeLocaleRegistry. Examples: "fr", "de", "es". If no locale
+// pack is registered for 'localeId', an error is returned.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//      dtzDto.SetDateTimeFmt("Monday 2 January 2006")
+//
+//      dateTimeStr, err := dtzDto.GetLocaleDateTimeStr("fr")
+//
+//      dateTimeStr is now equal to "jeudi 15 février 2024"
+//
+func (dtz *DateTzDto) GetLocaleDateTimeStr(
+	localeId string) (string, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.GetLocaleDateTimeStr() "
+
+	dtLocale, err := DateTimeLocaleRegistry{}.Get(localeId, ePrefix)
+
+	if err != nil {
+		return "", err
+	}
+
+	dateTimeFmt := dtz.dateTimeFmt
+
+	if len(dateTimeFmt) == 0 {
+		dateTimeFmt = FmtDateTimeYrMDayFmtStr
+	}
+
+	return dtLocale.FormatDateTime(dtz.dateTimeValue, dateTimeFmt), nil
+}
+
 // GetMilitaryCompactDateTimeGroup - Outputs date time string formatted for
 // standard U.S.A. Military date time also referred to as the Military
 // Date Time Group (DTG). This form of the Date Time Group is configured
@@ -2766,6 +2997,126 @@ func (dtz *DateTzDto) IsValid() error {
 	return dTzUtil.isValidDateTzDto(dtz, ePrefix)
 }
 
+// IsValidInstance - Returns 'true' if the data fields of the current
+// DateTzDto instance are valid. This method is identical in operation
+// to method DateTzDto.IsValid() except that it returns a boolean value.
+//
+func (dtz *DateTzDto) IsValidInstance() bool {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(dtz, "")
+
+	return err == nil
+}
+
+// MarshalBinary - Implements the encoding.BinaryMarshaler interface. The
+// current DateTzDto instance is encoded in the versioned binary wire
+// format. The binary wire format carries the same members as the
+// JSON wire format. Reference method DateTzDto.MarshalJSON().
+//
+func (dtz DateTzDto) MarshalBinary() ([]byte, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.MarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.dateTzDtoToWire(&dtz, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalBinary(wireTypeCodeDateTzDto, &wire), nil
+}
+
+// MarshalJSON - Implements the json.Marshaler interface. The
+// current DateTzDto instance is encoded in the JSON wire format. The
+// wire format retains the date time, the date time format, the tag description
+// and both the original and the convertible time zones. Time
+// components are recomputed when the instance is unmarshaled.
+//
+// An invalid DateTzDto instance cannot be marshaled and will
+// generate an error.
+//
+// Example:
+//
+//  {
+//    "dateTime": "2021-03-13T12:00:00.123456789-06:00",
+//    "dateTimeFormat": "2006-01-02 15:04:05.000000000 -0700 MST",
+//    "tagDescription": "",
+//    "timeZone": {
+//      "originalTimeZone": { "locationName": "America/Chicago", ... },
+//      "convertibleTimeZone": { "locationName": "America/Chicago", ... }
+//    }
+//  }
+//
+func (dtz DateTzDto) MarshalJSON() ([]byte, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.MarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.dateTzDtoToWire(&dtz, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
+// MarshalText - Implements the encoding.TextMarshaler interface. The text
+// form of a DateTzDto is identical to its JSON wire format. Reference
+// method DateTzDto.MarshalJSON().
+//
+func (dtz DateTzDto) MarshalText() ([]byte, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.MarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.dateTzDtoToWire(&dtz, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
 // New - Returns a new DateTzDto instance initialized
 // to zero values.
 //
@@ -2877,6 +3228,10 @@ func (dtz DateTzDto) NewDateTime(
 // NewDateTimeComponents - creates a new DateTzDto object and populates the
 // data fields based on input parameters.
 //
+// Local times which are ambiguous or nonexistent in the designated
+// time zone are resolved by the Go time package, time.Date(). To
+// control this resolution, use method NewDateTimeComponentsWithDstPolicy().
+//
 // ------------------------------------------------------------------------
 //
 // Input Parameter
@@ -3034,6 +3389,7 @@ func (dtz DateTzDto) NewDateTimeComponents(
 		microsecond,
 		nanosecond,
 		timeZoneLocationName,
+		TzDstPolicy.None(),
 		dateTimeFmtStr,
 		ePrefix)
 
@@ -3044,26 +3400,25 @@ func (dtz DateTzDto) NewDateTimeComponents(
 	return dtz2, nil
 }
 
-// NewDateTimeElements - creates a new DateTzDto object and populates
-// the data fields based on date time elements.
-//
-// Date Time elements include year, month, day, hour, minute, second and
-// nanosecond.
+// NewDateTimeComponentsWithDstPolicy - creates a new DateTzDto object and populates the
+// data fields based on input parameters.
 //
 // ------------------------------------------------------------------------
 //
 // Input Parameter
 //
-//  year                 int - year number
-//  month                int - month number       1 - 12
-//  day                  int - day number         1 - 31
-//  hour                 int - hour number        0 - 24
-//  minute               int - minute number      0 - 59
-//  second               int - second number      0 - 59
-//  nanosecond           int - nanosecond number  0 - 999,999,999
+//   year               int  - year number
+//   month              int  - month number       1 - 12
+//   day                int  - day number         1 - 31
+//   hour               int  - hour number        0 - 24
+//   minute             int  - minute number      0 - 59
+//   second             int  - second number      0 - 59
+//   millisecond        int  - millisecond number 0 - 999
+//   microsecond        int  - microsecond number 0 - 999
+//   nanosecond         int  - nanosecond number  0 - 999
 //
 //
-//  timeZoneLocationName string
+//   timeZoneLocationName  string
 //     - Designates the standard Time Zone location by which
 //       time duration will be compared. This ensures that
 //       'oranges are compared to oranges and apples are compared
@@ -3111,7 +3466,30 @@ func (dtz DateTzDto) NewDateTimeComponents(
 //           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
 //           time zone constants begin with the prefix 'TZones'.
 //
-//   dateTimeFmtStr string
+//  dstPolicy  TimeZoneDstPolicy
+//     - Determines how local date times which are ambiguous or
+//       nonexistent in the designated time zone are resolved.
+//       Ambiguous local times occur twice, as when clocks are
+//       set back at the end of Daylight Saving Time. Nonexistent
+//       local times are skipped, as when clocks are set forward
+//       at the start of Daylight Saving Time. Valid values are:
+//
+//         TzDstPolicy.Earlier()
+//         TzDstPolicy.Later()
+//         TzDstPolicy.Reject()
+//         TzDstPolicy.ShiftForward()
+//
+//       If TzDstPolicy.Reject() is specified, ambiguous local
+//       times generate an error of type *TimeZoneAmbiguousTimeError
+//       and nonexistent local times generate an error of type
+//       *TimeZoneNonexistentTimeError.
+//
+//       See the documentation for type TimeZoneDstPolicy in
+//       source file 'timezonedstpolicyenum.go'.
+//
+//       TzDstPolicy.None() is INVALID and generates an error.
+//
+//    dateTimeFmtStr string
 //       - A date time format string which will be used
 //         to format and display 'dateTime'. Example:
 //         "2006-01-02 15:04:05.000000000 -0700 MST"
@@ -3133,49 +3511,825 @@ func (dtz DateTzDto) NewDateTimeComponents(
 //
 // Return Values
 //
-//   DateTzDto - If successful, this method returns a new, populated 'DateTzDto'
-//               instance.
+//   DateTzDto - If successful this method returns a new DateTzDto instance.
 //
 //
-//   error     - If successful the returned error Type is set equal to 'nil'. If errors are
-//               encountered this error Type will encapsulate an error message.
+//   error - If successful the returned error Type is set equal to 'nil'. If errors are
+//           encountered this error Type will encapsulate an error message.
 //
 // ------------------------------------------------------------------------
 //
-// Usage
+// Example Usage:
 //
-//      dtzDto, err := DateTzDto{}.NewDateTimeElements(
-//         year,
-//         month,
-//         day,
-//         hour,
-//         minute,
-//         second,
-//         nanosecond ,
-//         TZones.US.Central(),
-//         FmtDateTimeYrMDayFmtStr)
+//      dtzDto, err := DateTzDto{}.NewStartEndTimes(
+//                        year,
+//                        month,
+//                        day,
+//                        hour,
+//                        min,
+//                        sec,
+//                        nanosecond,
+//                        TZones.US.Central(),
+//                        FmtDateTimeYrMDayFmtStr)
 //
-// Note:
-//        'TZones.US.Central()' is a constant available int source file,
+//
+//   Note:
+//        'TZones.US.Central()' is a constant available int source file,
+//         'timezonedata.go'
+//
+//         TZones.US.Central() is equivalent to "America/Chicago"
+//
+//        'FmtDateTimeYrMDayFmtStr' is a constant available in source file,
+//        'constantsdatetime.go'
+//
+//         FmtDateTimeYrMDayFmtStr = "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+func (dtz DateTzDto) NewDateTimeComponentsWithDstPolicy(
+	year,
+	month,
+	day,
+	hour,
+	minute,
+	second,
+	millisecond,
+	microsecond,
+	nanosecond int,
+	timeZoneLocationName string,
+	dstPolicy TimeZoneDstPolicy,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.NewDateTimeComponentsWithDstPolicy() "
+
+	if !dstPolicy.XIsValid() {
+		return DateTzDto{}, &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dstPolicy",
+			inputParameterValue: dstPolicy.String(),
+			errMsg:              "Input parameter 'dstPolicy' is INVALID!",
+			err:                 nil,
+		}
+	}
+
+	dtz2 := DateTzDto{}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.setFromDateTimeComponents(
+		&dtz2,
+		year,
+		month,
+		day,
+		hour,
+		minute,
+		second,
+		millisecond,
+		microsecond,
+		nanosecond,
+		timeZoneLocationName,
+		dstPolicy,
+		dateTimeFmtStr,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	return dtz2, nil
+}
+
+// NewDateTimeElements - creates a new DateTzDto object and populates
+// the data fields based on date time elements.
+//
+// Local times which are ambiguous or nonexistent in the designated
+// time zone are resolved by the Go time package, time.Date(). To
+// control this resolution, use method NewDateTimeElementsWithDstPolicy().
+//
+// Date Time elements include year, month, day, hour, minute, second and
+// nanosecond.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameter
+//
+//  year                 int - year number
+//  month                int - month number       1 - 12
+//  day                  int - day number         1 - 31
+//  hour                 int - hour number        0 - 24
+//  minute               int - minute number      0 - 59
+//  second               int - second number      0 - 59
+//  nanosecond           int - nanosecond number  0 - 999,999,999
+//
+//
+//  timeZoneLocationName string
+//     - Designates the standard Time Zone location by which
+//       time duration will be compared. This ensures that
+//       'oranges are compared to oranges and apples are compared
+//       to apples' with respect to start time and end time duration
+//       calculations.
+//
+//       If 'timeZoneLocation' is passed as an empty string, it
+//       will be automatically defaulted to the 'UTC' time zone.
+//       Reference Universal Coordinated Time:
+//          https://en.wikipedia.org/wiki/Coordinated_Universal_Time
+//
+//       Time zone location, or time zone name,
+//       must be designated as one of three types
+//       of values:
+//
+//       (1) The string 'Local' - signals the designation of the local time zone
+//           configured for the host computer executing this code.
+//
+//       (2) IANA Time Zone Location -
+//           See https://golang.org/pkg/time/#LoadLocation
+//           and https://www.iana.org/time-zones to ensure that
+//           the IANA Time Zone Database is properly configured
+//           on your system. Note: IANA Time Zone Data base is
+//           equivalent to 'tz database'.
+//
+//              Examples:
+//                "America/New_York"
+//                "America/Chicago"
+//                "America/Denver"
+//                "America/Los_Angeles"
+//                "Pacific/Honolulu"
+//
+//       (3) A valid Military Time Zone
+//           Military time zones are commonly used in
+//           aviation as well as at sea. They are also
+//           known as nautical or maritime time zones.
+//           Reference:
+//               https://en.wikipedia.org/wiki/List_of_military_time_zones
+//               http://www.thefightschool.demon.co.uk/UNMC_Military_Time.htm
+//               https://www.timeanddate.com/time/zones/military
+//
+//       Note:
+//           The source file 'timezonedata.go' contains over 600 constant
+//           time zone declarations covering all IANA and Military Time
+//           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
+//           time zone constants begin with the prefix 'TZones'.
+//
+//   dateTimeFmtStr string
+//       - A date time format string which will be used
+//         to format and display 'dateTime'. Example:
+//         "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//         Date time format constants are found in the source
+//         file 'constantsdatetime.go'. These constants represent
+//         the more commonly used date time string formats. All
+//         Date Time format constants begin with the prefix
+//         'FmtDateTime'.
+//
+//         If 'dateTimeFmtStr' is submitted as an
+//         'empty string', a default date time format
+//         string will be applied. The default date time
+//         format string is:
+//           FmtDateTimeYrMDayFmtStr =
+//               "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//   DateTzDto - If successful, this method returns a new, populated 'DateTzDto'
+//               instance.
+//
+//
+//   error     - If successful the returned error Type is set equal to 'nil'. If errors are
+//               encountered this error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//      dtzDto, err := DateTzDto{}.NewDateTimeElements(
+//         year,
+//         month,
+//         day,
+//         hour,
+//         minute,
+//         second,
+//         nanosecond ,
+//         TZones.US.Central(),
+//         FmtDateTimeYrMDayFmtStr)
+//
+// Note:
+//        'TZones.US.Central()' is a constant available int source file,
+//         'timezonedata.go'
+//
+//         TZones.US.Central() is equivalent to "America/Chicago"
+//
+//        'FmtDateTimeYrMDayFmtStr' is a constant available in source file,
+//        'constantsdatetime.go'
+//
+//         FmtDateTimeYrMDayFmtStr = "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+func (dtz DateTzDto) NewDateTimeElements(
+		year,
+		month,
+		day,
+		hour,
+		minute,
+		second,
+		nanosecond int,
+		timeZoneLocationName,
+		dateTimeFmtStr string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.NewDateTimeElements() "
+
+	dtz2 := DateTzDto{}
+
+	dtUtil := dateTzDtoUtility{}
+
+	err := dtUtil.setFromDateTimeElements(
+		&dtz2,
+		year,
+		month,
+		day,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		timeZoneLocationName,
+		TzDstPolicy.None(),
+		dateTimeFmtStr,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	return dtz2, nil
+}
+
+// NewDateTimeElementsWithDstPolicy - creates a new DateTzDto object and populates
+// the data fields based on date time elements.
+//
+// Date Time elements include year, month, day, hour, minute, second and
+// nanosecond.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameter
+//
+//  year                 int - year number
+//  month                int - month number       1 - 12
+//  day                  int - day number         1 - 31
+//  hour                 int - hour number        0 - 24
+//  minute               int - minute number      0 - 59
+//  second               int - second number      0 - 59
+//  nanosecond           int - nanosecond number  0 - 999,999,999
+//
+//
+//  timeZoneLocationName string
+//     - Designates the standard Time Zone location by which
+//       time duration will be compared. This ensures that
+//       'oranges are compared to oranges and apples are compared
+//       to apples' with respect to start time and end time duration
+//       calculations.
+//
+//       If 'timeZoneLocation' is passed as an empty string, it
+//       will be automatically defaulted to the 'UTC' time zone.
+//       Reference Universal Coordinated Time:
+//          https://en.wikipedia.org/wiki/Coordinated_Universal_Time
+//
+//       Time zone location, or time zone name,
+//       must be designated as one of three types
+//       of values:
+//
+//       (1) The string 'Local' - signals the designation of the local time zone
+//           configured for the host computer executing this code.
+//
+//       (2) IANA Time Zone Location -
+//           See https://golang.org/pkg/time/#LoadLocation
+//           and https://www.iana.org/time-zones to ensure that
+//           the IANA Time Zone Database is properly configured
+//           on your system. Note: IANA Time Zone Data base is
+//           equivalent to 'tz database'.
+//
+//              Examples:
+//                "America/New_York"
+//                "America/Chicago"
+//                "America/Denver"
+//                "America/Los_Angeles"
+//                "Pacific/Honolulu"
+//
+//       (3) A valid Military Time Zone
+//           Military time zones are commonly used in
+//           aviation as well as at sea. They are also
+//           known as nautical or maritime time zones.
+//           Reference:
+//               https://en.wikipedia.org/wiki/List_of_military_time_zones
+//               http://www.thefightschool.demon.co.uk/UNMC_Military_Time.htm
+//               https://www.timeanddate.com/time/zones/military
+//
+//       Note:
+//           The source file 'timezonedata.go' contains over 600 constant
+//           time zone declarations covering all IANA and Military Time
+//           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
+//           time zone constants begin with the prefix 'TZones'.
+//
+//  dstPolicy  TimeZoneDstPolicy
+//     - Determines how local date times which are ambiguous or
+//       nonexistent in the designated time zone are resolved.
+//       Ambiguous local times occur twice, as when clocks are
+//       set back at the end of Daylight Saving Time. Nonexistent
+//       local times are skipped, as when clocks are set forward
+//       at the start of Daylight Saving Time. Valid values are:
+//
+//         TzDstPolicy.Earlier()
+//         TzDstPolicy.Later()
+//         TzDstPolicy.Reject()
+//         TzDstPolicy.ShiftForward()
+//
+//       If TzDstPolicy.Reject() is specified, ambiguous local
+//       times generate an error of type *TimeZoneAmbiguousTimeError
+//       and nonexistent local times generate an error of type
+//       *TimeZoneNonexistentTimeError.
+//
+//       See the documentation for type TimeZoneDstPolicy in
+//       source file 'timezonedstpolicyenum.go'.
+//
+//       TzDstPolicy.None() is INVALID and generates an error.
+//
+//   dateTimeFmtStr string
+//       - A date time format string which will be used
+//         to format and display 'dateTime'. Example:
+//         "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//         Date time format constants are found in the source
+//         file 'constantsdatetime.go'. These constants represent
+//         the more commonly used date time string formats. All
+//         Date Time format constants begin with the prefix
+//         'FmtDateTime'.
+//
+//         If 'dateTimeFmtStr' is submitted as an
+//         'empty string', a default date time format
+//         string will be applied. The default date time
+//         format string is:
+//           FmtDateTimeYrMDayFmtStr =
+//               "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//   DateTzDto - If successful, this method returns a new, populated 'DateTzDto'
+//               instance.
+//
+//
+//   error     - If successful the returned error Type is set equal to 'nil'. If errors are
+//               encountered this error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//      dtzDto, err := DateTzDto{}.NewDateTimeElements(
+//         year,
+//         month,
+//         day,
+//         hour,
+//         minute,
+//         second,
+//         nanosecond ,
+//         TZones.US.Central(),
+//         FmtDateTimeYrMDayFmtStr)
+//
+// Note:
+//        'TZones.US.Central()' is a constant available int source file,
 //         'timezonedata.go'
 //
 //         TZones.US.Central() is equivalent to "America/Chicago"
 //
-//        'FmtDateTimeYrMDayFmtStr' is a constant available in source file,
-//        'constantsdatetime.go'
+//        'FmtDateTimeYrMDayFmtStr' is a constant available in source file,
+//        'constantsdatetime.go'
+//
+//         FmtDateTimeYrMDayFmtStr = "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+func (dtz DateTzDto) NewDateTimeElementsWithDstPolicy(
+		year,
+		month,
+		day,
+		hour,
+		minute,
+		second,
+		nanosecond int,
+		timeZoneLocationName string,
+		dstPolicy TimeZoneDstPolicy,
+		dateTimeFmtStr string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.NewDateTimeElementsWithDstPolicy() "
+
+	if !dstPolicy.XIsValid() {
+		return DateTzDto{}, &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dstPolicy",
+			inputParameterValue: dstPolicy.String(),
+			errMsg:              "Input parameter 'dstPolicy' is INVALID!",
+			err:                 nil,
+		}
+	}
+
+	dtz2 := DateTzDto{}
+
+	dtUtil := dateTzDtoUtility{}
+
+	err := dtUtil.setFromDateTimeElements(
+		&dtz2,
+		year,
+		month,
+		day,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		timeZoneLocationName,
+		dstPolicy,
+		dateTimeFmtStr,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	return dtz2, nil
+}
+
+// NewFromISOWeekDate - Creates and returns a new DateTzDto instance
+// from an ISO 8601 week date string and time elements.
+//
+// Both the extended format, "YYYY-Www-D", and the basic format,
+// "YYYYWwwD", are supported. Years outside the range 0000 through
+// 9999 must be preceded by a plus (+) or minus (-) sign. Year values
+// are interpreted as Astronomical Years.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameter
+//
+//  isoWeekDateStr       string - ISO 8601 week date. Example: "2009-W01-1"
+//  hour                 int    - hour number        0 - 23
+//  minute               int    - minute number      0 - 59
+//  second               int    - second number      0 - 59
+//  nanosecond           int    - nanosecond number  0 - 999,999,999
+//
+//
+//  timeZoneLocationName string
+//     - Designates the time zone location associated with the
+//       new DateTzDto instance. If 'timeZoneLocationName' is passed
+//       as an empty string, it will be automatically defaulted to
+//       the 'UTC' time zone.
+//
+//
+//   dateTimeFmtStr string
+//       - A date time format string which will be used
+//         to format and display 'dateTime'. If 'dateTimeFmtStr'
+//         is submitted as an 'empty string', a default date time
+//         format string will be applied. The default date time
+//         format string is:
+//           FmtDateTimeYrMDayFmtStr =
+//               "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//   DateTzDto - If successful, this method returns a new, populated 'DateTzDto'
+//               instance.
+//
+//
+//   error     - If successful the returned error Type is set equal to 'nil'. If errors are
+//               encountered this error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//      dtzDto, err := DateTzDto{}.NewFromISOWeekDate(
+//         "2009-W01-1",
+//         14,
+//         30,
+//         0,
+//         0,
+//         TZones.US.Central(),
+//         FmtDateTimeYrMDayFmtStr)
+//
+//      dtzDto is now equal to 2008-12-29 14:30:00.000000000 -0600 CST
+//
+func (dtz DateTzDto) NewFromISOWeekDate(
+	isoWeekDateStr string,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	timeZoneLocationName,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.NewFromISOWeekDate() "
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	weekYear,
+	weekNumber,
+	weekDay,
+	err := isoWeekDateMech.parseISOWeekDateStr(
+		isoWeekDateStr,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	var year int64
+	var month, day int
+
+	year,
+	month,
+	day,
+	err = isoWeekDateMech.getDateFromISOWeekDate(
+		CalendarSpec(0).Gregorian(),
+		weekYear,
+		weekNumber,
+		weekDay,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	if hour < 0 || hour > 23 ||
+		minute < 0 || minute > 59 ||
+		second < 0 || second > 59 ||
+		nanosecond < 0 || nanosecond > 999999999 {
+
+		return DateTzDto{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error: One or more time components are INVALID!\n" +
+				"hour='%v' minute='%v' second='%v' nanosecond='%v'\n",
+				hour, minute, second, nanosecond)
+	}
+
+	// The date/time components are applied to the target time
+	// zone as an 'Absolute' value.
+	dateTime := time.Date(
+		int(year),
+		time.Month(month),
+		day,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		time.UTC)
+
+	dtz2 := DateTzDto{}
+
+	dtUtil := dateTzDtoUtility{}
+
+	err = dtUtil.setFromTimeTzName(
+		&dtz2,
+		dateTime,
+		TzConvertType.Absolute(),
+		timeZoneLocationName,
+		dateTimeFmtStr,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	return dtz2, nil
+}
+
+// NewFromLocaleString - Creates and returns a new DateTzDto instance
+// by parsing a date time string containing month names, day of the
+// week names or AM/PM markers expressed in the language designated
+// by input parameter 'localeId'.
+//
+// Localized names are matched without regard to case.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dateTimeStr          string
+//     - The localized date time string to be parsed.
+//       Example: "15 février 2024"
+//
+//
+//  dateTimeFmtStr       string
+//     - A Go 'time' package layout describing 'dateTimeStr'. The
+//       layout tokens "January", "Jan", "Monday", "Mon", "PM" and
+//       "pm" designate localized names and markers. This format
+//       string is also stored in the returned DateTzDto instance.
+//       If 'dateTimeFmtStr' is an empty string, an error is returned.
+//
+//
+//  localeId             string
+//     - Identifies a locale pack registered with type
+//       DateTimeLocaleRegistry. Examples: "fr", "de", "es".
+//
+//
+//  timeZoneLocation     string
+//     - The time zone in which the parsed date time components are
+//       interpreted. If 'timeZoneLocation' is an empty string, the
+//       UTC offset parsed from 'dateTimeStr' is applied. If
+//       'dateTimeStr' does not specify a UTC offset, the time zone
+//       defaults to UTC.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//   DateTzDto - If successful, this method returns a new, populated 'DateTzDto'
+//               instance.
+//
+//
+//   error     - If successful the returned error Type is set equal to 'nil'. If errors are
+//               encountered this error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//      dtzDto, err := DateTzDto{}.NewFromLocaleString(
+//         "15 février 2024",
+//         "2 January 2006",
+//         "fr",
+//         TZones.Europe.Paris())
+//
+//      dtzDto is now equal to 2024-02-15 00:00:00.000000000 +0100 CET
+//
+func (dtz DateTzDto) NewFromLocaleString(
+	dateTimeStr,
+	dateTimeFmtStr,
+	localeId,
+	timeZoneLocation string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.NewFromLocaleString() "
+
+	if len(dateTimeFmtStr) == 0 {
+		return DateTzDto{}, errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'dateTimeFmtStr' is an empty string!\n")
+	}
+
+	dtLocale, err := DateTimeLocaleRegistry{}.Get(localeId, ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	dateTime, err := dtLocale.ParseDateTime(
+		dateTimeFmtStr,
+		dateTimeStr,
+		time.UTC,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	dtz2 := DateTzDto{}
+
+	dtUtil := dateTzDtoUtility{}
+
+	if len(timeZoneLocation) == 0 {
+
+		err = dtUtil.setFromDateTime(
+			&dtz2,
+			dateTime,
+			dateTimeFmtStr,
+			ePrefix)
+
+	} else {
+
+		// The parsed date time components are applied to the
+		// target time zone as an 'Absolute' value.
+		err = dtUtil.setFromTimeTzName(
+			&dtz2,
+			dateTime,
+			TzConvertType.Absolute(),
+			timeZoneLocation,
+			dateTimeFmtStr,
+			ePrefix)
+	}
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	return dtz2, nil
+}
+
+// NewFromMilitaryDateTimeGroup - Creates and returns a new DateTzDto
+// instance by parsing a Military Date Time Group (DTG) string. This
+// method reverses the formatting performed by methods
+// DateTzDto.GetMilitaryCompactDateTimeGroup() and
+// DateTzDto.GetMilitaryOpenDateTimeGroup().
+//
+// The Military Date Time Group is traditionally formatted as
+// DDHHMM(Z)MONYY, where 'Z' is the Military Time Zone letter. Both
+// the 'Compact' and 'Open' variants are accepted:
+//
+//    Compact: "061830RJAN12"
+//    Open:    "06 1830R JAN 12"
+//
+// Four digit years, as in "061830RJAN2012", are also accepted.
+//
+// The returned DateTzDto is configured with the Military Time Zone
+// identified by the Military Time Zone letter. The Military Time
+// Zone letter 'J' (Juliet), which designates local time, is NOT
+// supported.
+//
+// Reference:
+//    http://www.thefightschool.demon.co.uk/UNMC_Military_Time.htm
+//    https://www.timeanddate.com/time/zones/military
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dtg                  string
+//     - A Military Date Time Group in either the 'Compact' or
+//       'Open' format.
+//
+//
+//  centuryPivot         int
+//     - The first year of the 100-year window used to interpret
+//       two digit years. A two digit year is mapped to the year
+//       within this window ending in the same two digits.
+//
+//       Example: If 'centuryPivot' is 1950, two digit years '50'
+//       through '99' are mapped to 1950 through 1999 and two digit
+//       years '00' through '49' are mapped to 2000 through 2049.
+//
+//       'centuryPivot' is ignored for four digit years.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//   DateTzDto - If successful, this method returns a new, populated 'DateTzDto'
+//               instance. The date time format string is set to the default
+//               format, FmtDateTimeYrMDayFmtStr.
+//
+//
+//   error     - If successful the returned error Type is set equal to 'nil'. If errors are
+//               encountered this error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//      dtzDto, err := DateTzDto{}.NewFromMilitaryDateTimeGroup(
+//         "061830RJAN12",
+//         1950)
 //
-//         FmtDateTimeYrMDayFmtStr = "2006-01-02 15:04:05.000000000 -0700 MST"
+//      dtzDto is now equal to 2012-01-06 18:30:00.000000000 -0500 -05
+//      in Military Time Zone "Romeo".
 //
-func (dtz DateTzDto) NewDateTimeElements(
-		year,
-		month,
-		day,
-		hour,
-		minute,
-		second,
-		nanosecond int,
-		timeZoneLocationName,
-		dateTimeFmtStr string) (DateTzDto, error) {
+func (dtz DateTzDto) NewFromMilitaryDateTimeGroup(
+	dtg string,
+	centuryPivot int) (DateTzDto, error) {
 
 	if dtz.lock == nil {
 		dtz.lock = new(sync.Mutex)
@@ -3185,23 +4339,48 @@ func (dtz DateTzDto) NewDateTimeElements(
 
 	defer dtz.lock.Unlock()
 
-	ePrefix := "DateTzDto.NewDateTimeElements() "
+	ePrefix := "DateTzDto.NewFromMilitaryDateTimeGroup() "
 
-	dtz2 := DateTzDto{}
+	milDtgMech := militaryDtgMechanics{}
 
-	dtUtil := dateTzDtoUtility{}
+	year,
+	month,
+	day,
+	hour,
+	minute,
+	milTzName,
+	err := milDtgMech.parseDateTimeGroup(
+		dtg,
+		centuryPivot,
+		ePrefix)
 
-	err := dtUtil.setFromDateTimeElements(
-		&dtz2,
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	// Military Time Zones have fixed UTC offsets. The
+	// date/time components are applied to the target
+	// time zone as an 'Absolute' value.
+	dateTime := time.Date(
 		year,
-		month,
+		time.Month(month),
 		day,
 		hour,
 		minute,
-		second,
-		nanosecond,
-		timeZoneLocationName,
-		dateTimeFmtStr,
+		0,
+		0,
+		time.UTC)
+
+	dtz2 := DateTzDto{}
+
+	dtUtil := dateTzDtoUtility{}
+
+	err = dtUtil.setFromTimeTzName(
+		&dtz2,
+		dateTime,
+		TzConvertType.Absolute(),
+		milTzName,
+		"",
 		ePrefix)
 
 	if err != nil {
@@ -4062,6 +5241,207 @@ func (dtz DateTzDto) NewTzSpec(
 	return dTzOut, err
 }
 
+// NextBusinessDay - Returns a new DateTzDto instance set to the
+// first business day following the date of the current DateTzDto
+// instance. The local time of day is retained. The current DateTzDto
+// instance is NOT modified.
+//
+// Business days are days which are neither weekend days nor holidays
+// as defined by input parameter 'busDayCal'. Calendar dates are
+// evaluated in the time zone of the current DateTzDto instance.
+//
+// If input parameter 'dateTimeFmtStr' is an empty string, the date
+// time format string of the current DateTzDto instance is applied.
+//
+func (dtz *DateTzDto) NextBusinessDay(
+	busDayCal BusinessDayCalendar,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.NextBusinessDay() "
+
+	busDayMech := businessDayMechanics{}
+
+	return busDayMech.adjacentBusinessDay(
+		dtz,
+		1,
+		&busDayCal,
+		dateTimeFmtStr,
+		ePrefix)
+}
+
+// NextTransition - Returns a TimeZoneSpecification describing the
+// first time zone transition which occurs after the date time of the
+// current DateTzDto instance. A time zone transition is an instant
+// at which the UTC offset or the time zone abbreviation of the time
+// zone location changes. Daylight Saving Time changes are the most
+// common example.
+//
+// The reference date time of the returned TimeZoneSpecification is
+// set to the instant of the transition. The UTC offset and
+// abbreviation of the returned instance are those which take effect
+// at the transition.
+//
+// If no transition occurs after the current date time, as is the
+// case for 'UTC' or fixed offset time zones, the returned boolean
+// value, 'hasTransition', is set to 'false'.
+//
+// Military Time Zones are ignored. Transitions are identified for
+// the standard (IANA) Time Zone Location.
+//
+func (dtz *DateTzDto) NextTransition() (
+	transition TimeZoneSpecification,
+	hasTransition bool,
+	err error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.NextTransition() "
+
+	transition = TimeZoneSpecification{}
+
+	locPtr := dtz.timeZone.GetBestConvertibleTimeZone().locationPtr
+
+	if locPtr == nil {
+		locPtr = dtz.dateTimeValue.Location()
+	}
+
+	tzTransMech := timeZoneTransitionMechanics{}
+
+	var transitionTime time.Time
+
+	transitionTime, hasTransition =
+		tzTransMech.nextTransition(dtz.dateTimeValue, locPtr)
+
+	if !hasTransition {
+		return transition, hasTransition, err
+	}
+
+	transition, err = tzTransMech.newTransitionTzSpec(
+		transitionTime,
+		locPtr,
+		ePrefix)
+
+	if err != nil {
+		hasTransition = false
+	}
+
+	return transition, hasTransition, err
+}
+
+// PreviousBusinessDay - Returns a new DateTzDto instance set to the
+// last business day preceding the date of the current DateTzDto
+// instance. The local time of day is retained. The current DateTzDto
+// instance is NOT modified.
+//
+// Business days are days which are neither weekend days nor holidays
+// as defined by input parameter 'busDayCal'. Calendar dates are
+// evaluated in the time zone of the current DateTzDto instance.
+//
+// If input parameter 'dateTimeFmtStr' is an empty string, the date
+// time format string of the current DateTzDto instance is applied.
+//
+func (dtz *DateTzDto) PreviousBusinessDay(
+	busDayCal BusinessDayCalendar,
+	dateTimeFmtStr string) (DateTzDto, error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.PreviousBusinessDay() "
+
+	busDayMech := businessDayMechanics{}
+
+	return busDayMech.adjacentBusinessDay(
+		dtz,
+		-1,
+		&busDayCal,
+		dateTimeFmtStr,
+		ePrefix)
+}
+
+// PreviousTransition - Returns a TimeZoneSpecification describing
+// the last time zone transition which occurs on or before the date
+// time of the current DateTzDto instance. A time zone transition is
+// an instant at which the UTC offset or the time zone abbreviation
+// of the time zone location changes. Daylight Saving Time changes
+// are the most common example.
+//
+// The reference date time of the returned TimeZoneSpecification is
+// set to the instant of the transition. The UTC offset and
+// abbreviation of the returned instance are those which take effect
+// at the transition and therefore apply to the current date time.
+//
+// If no transition occurs on or before the current date time, the
+// returned boolean value, 'hasTransition', is set to 'false'.
+//
+// Military Time Zones are ignored. Transitions are identified for
+// the standard (IANA) Time Zone Location.
+//
+func (dtz *DateTzDto) PreviousTransition() (
+	transition TimeZoneSpecification,
+	hasTransition bool,
+	err error) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.PreviousTransition() "
+
+	transition = TimeZoneSpecification{}
+
+	locPtr := dtz.timeZone.GetBestConvertibleTimeZone().locationPtr
+
+	if locPtr == nil {
+		locPtr = dtz.dateTimeValue.Location()
+	}
+
+	tzTransMech := timeZoneTransitionMechanics{}
+
+	var transitionTime time.Time
+
+	transitionTime, hasTransition =
+		tzTransMech.previousTransition(dtz.dateTimeValue, locPtr)
+
+	if !hasTransition {
+		return transition, hasTransition, err
+	}
+
+	transition, err = tzTransMech.newTransitionTzSpec(
+		transitionTime,
+		locPtr,
+		ePrefix)
+
+	if err != nil {
+		hasTransition = false
+	}
+
+	return transition, hasTransition, err
+}
+
 // SetDateTimeFmt - Sets the DateTzDto data field 'DateTimeFmt'.
 // This string is used to format the DateTzDto DateTimeFmt field
 // when DateTzDto.String() is called.
@@ -4092,9 +5472,141 @@ func (dtz DateTzDto) NewTzSpec(
 //
 // Return Values
 //
-//   None - This method sets the internal data field DateTzDto.dateTimeFmt
+//   None - This method sets the internal data field DateTzDto.dateTimeFmt
+//
+func (dtz *DateTzDto) SetDateTimeFmt(dateTimeFmtStr string) {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	dTzUtil.setDateTimeFormat(
+		dtz,
+		dateTimeFmtStr,
+		"DateTzDto.SetDateTimeFmt() ")
+
+}
+
+// SetFromDateTimeComponents - Sets the values of the Date Time fields
+// for the current DateTzDto instance based on time components
+// and a Time Zone Location.
+//
+// Local times which are ambiguous or nonexistent in the designated
+// time zone are resolved by the Go time package, time.Date(). To
+// control this resolution, use method SetFromDateTimeComponentsWithDstPolicy().
+//
+// Note that this variation of time elements breaks time down by
+// hour, minute, second, millisecond, microsecond and nanosecond.
+//
+// See method SetFromDateTimeElements(), above, which uses a slightly
+// different set of time components.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//   year                int - year number
+//   month               int - month number        1 -  12
+//   day                 int - day number          1 -  31
+//   hour                int - hour number         0 -  24
+//   min                 int - minute number       0 -  59
+//   sec                 int - second number       0 -  59
+//   millisecond         int - millisecond number  0 - 999
+//   microsecond         int - microsecond number  0 - 999
+//   nanosecond          int - nanosecond number   0 - 999
+//
+//
+//   timeZoneLocationName  string
+//     - Designates the standard Time Zone location by which
+//       time duration will be compared. This ensures that
+//       'oranges are compared to oranges and apples are compared
+//       to apples' with respect to start time and end time duration
+//       calculations.
+//
+//       If 'timeZoneLocation' is passed as an empty string, it
+//       will be automatically defaulted to the 'UTC' time zone.
+//       Reference Universal Coordinated Time:
+//          https://en.wikipedia.org/wiki/Coordinated_Universal_Time
+//
+//       Time zone location, or time zone name,
+//       must be designated as one of three types
+//       of values:
+//
+//       (1) The string 'Local' - signals the designation of the local time zone
+//           configured for the host computer executing this code.
+//
+//       (2) IANA Time Zone Location -
+//           See https://golang.org/pkg/time/#LoadLocation
+//           and https://www.iana.org/time-zones to ensure that
+//           the IANA Time Zone Database is properly configured
+//           on your system. Note: IANA Time Zone Data base is
+//           equivalent to 'tz database'.
+//
+//              Examples:
+//                "America/New_York"
+//                "America/Chicago"
+//                "America/Denver"
+//                "America/Los_Angeles"
+//                "Pacific/Honolulu"
+//
+//       (3) A valid Military Time Zone
+//           Military time zones are commonly used in
+//           aviation as well as at sea. They are also
+//           known as nautical or maritime time zones.
+//           Reference:
+//               https://en.wikipedia.org/wiki/List_of_military_time_zones
+//               http://www.thefightschool.demon.co.uk/UNMC_Military_Time.htm
+//               https://www.timeanddate.com/time/zones/military
+//
+//       Note:
+//           The source file 'timezonedata.go' contains over 600 constant
+//           time zone declarations covering all IANA and Military Time
+//           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
+//           time zone constants begin with the prefix 'TZones'.
+//
+//   dateTimeFmtStr string
+//       - A date time format string which will be used
+//         to format and display 'dateTime'. Example:
+//         "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//         Date time format constants are found in the source
+//         file 'constantsdatetime.go'. These constants represent
+//         the more commonly used date time string formats. All
+//         Date Time format constants begin with the prefix
+//         'FmtDateTime'.
+//
+//         If 'dateTimeFmtStr' is submitted as an
+//         'empty string', a default date time format
+//         string will be applied. The default date time
+//         format string is:
+//           FmtDateTimeYrMDayFmtStr =
+//               "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//   error - If successful the returned error Type is set equal to 'nil'. If errors are
+//           encountered this error Type will encapsulate an error message.
 //
-func (dtz *DateTzDto) SetDateTimeFmt(dateTimeFmtStr string) {
+func (dtz *DateTzDto) SetFromDateTimeComponents(
+			year,
+			month,
+			day,
+			hour,
+			minute,
+			second,
+			millisecond,
+			microsecond,
+			nanosecond int,
+			timeZoneLocationName,
+			dateTimeFmtStr string) error {
 
 	if dtz.lock == nil {
 		dtz.lock = new(sync.Mutex)
@@ -4104,16 +5616,28 @@ func (dtz *DateTzDto) SetDateTimeFmt(dateTimeFmtStr string) {
 
 	defer dtz.lock.Unlock()
 
-	dTzUtil := dateTzDtoUtility{}
+	ePrefix := "DateTzDto.SetFromDateTimeComponents() "
 
-	dTzUtil.setDateTimeFormat(
-		dtz,
-		dateTimeFmtStr,
-		"DateTzDto.SetDateTimeFmt() ")
+	dTzUtil := dateTzDtoUtility{}
 
+	return dTzUtil.setFromDateTimeComponents(
+						dtz,
+						year,
+						month,
+						day,
+						hour,
+						minute,
+						second,
+						millisecond,
+						microsecond,
+						nanosecond,
+						timeZoneLocationName,
+						TzDstPolicy.None(),
+						dateTimeFmtStr,
+						ePrefix)
 }
 
-// SetFromDateTimeComponents - Sets the values of the Date Time fields
+// SetFromDateTimeComponentsWithDstPolicy - Sets the values of the Date Time fields
 // for the current DateTzDto instance based on time components
 // and a Time Zone Location.
 //
@@ -4186,6 +5710,29 @@ func (dtz *DateTzDto) SetDateTimeFmt(dateTimeFmtStr string) {
 //           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
 //           time zone constants begin with the prefix 'TZones'.
 //
+//  dstPolicy  TimeZoneDstPolicy
+//     - Determines how local date times which are ambiguous or
+//       nonexistent in the designated time zone are resolved.
+//       Ambiguous local times occur twice, as when clocks are
+//       set back at the end of Daylight Saving Time. Nonexistent
+//       local times are skipped, as when clocks are set forward
+//       at the start of Daylight Saving Time. Valid values are:
+//
+//         TzDstPolicy.Earlier()
+//         TzDstPolicy.Later()
+//         TzDstPolicy.Reject()
+//         TzDstPolicy.ShiftForward()
+//
+//       If TzDstPolicy.Reject() is specified, ambiguous local
+//       times generate an error of type *TimeZoneAmbiguousTimeError
+//       and nonexistent local times generate an error of type
+//       *TimeZoneNonexistentTimeError.
+//
+//       See the documentation for type TimeZoneDstPolicy in
+//       source file 'timezonedstpolicyenum.go'.
+//
+//       TzDstPolicy.None() is INVALID and generates an error.
+//
 //   dateTimeFmtStr string
 //       - A date time format string which will be used
 //         to format and display 'dateTime'. Example:
@@ -4211,7 +5758,7 @@ func (dtz *DateTzDto) SetDateTimeFmt(dateTimeFmtStr string) {
 //   error - If successful the returned error Type is set equal to 'nil'. If errors are
 //           encountered this error Type will encapsulate an error message.
 //
-func (dtz *DateTzDto) SetFromDateTimeComponents(
+func (dtz *DateTzDto) SetFromDateTimeComponentsWithDstPolicy(
 			year,
 			month,
 			day,
@@ -4221,7 +5768,8 @@ func (dtz *DateTzDto) SetFromDateTimeComponents(
 			millisecond,
 			microsecond,
 			nanosecond int,
-			timeZoneLocationName,
+			timeZoneLocationName string,
+			dstPolicy TimeZoneDstPolicy,
 			dateTimeFmtStr string) error {
 
 	if dtz.lock == nil {
@@ -4232,7 +5780,17 @@ func (dtz *DateTzDto) SetFromDateTimeComponents(
 
 	defer dtz.lock.Unlock()
 
-	ePrefix := "DateTzDto.SetFromDateTimeComponents() "
+	ePrefix := "DateTzDto.SetFromDateTimeComponentsWithDstPolicy() "
+
+	if !dstPolicy.XIsValid() {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dstPolicy",
+			inputParameterValue: dstPolicy.String(),
+			errMsg:              "Input parameter 'dstPolicy' is INVALID!",
+			err:                 nil,
+		}
+	}
 
 	dTzUtil := dateTzDtoUtility{}
 
@@ -4248,6 +5806,7 @@ func (dtz *DateTzDto) SetFromDateTimeComponents(
 						microsecond,
 						nanosecond,
 						timeZoneLocationName,
+						dstPolicy,
 						dateTimeFmtStr,
 						ePrefix)
 }
@@ -4256,6 +5815,10 @@ func (dtz *DateTzDto) SetFromDateTimeComponents(
 // data fields based on input parameters consisting of date time
 // elements, a time zone location and a date time format string.
 //
+// Local times which are ambiguous or nonexistent in the designated
+// time zone are resolved by the Go time package, time.Date(). To
+// control this resolution, use method SetFromDateTimeElementsWithDstPolicy().
+//
 // Date Time elements include year, month, day, hour, minute,
 // second and nanosecond.
 //
@@ -4380,6 +5943,174 @@ func (dtz *DateTzDto) SetFromDateTimeElements(
 			second,
 			nanosecond,
 			timeZoneLocationName,
+			TzDstPolicy.None(),
+			dateTimeFmtStr,
+			ePrefix)
+}
+
+// SetFromDateTimeElementsWithDstPolicy - Sets the values of the current DateTzDto
+// data fields based on input parameters consisting of date time
+// elements, a time zone location and a date time format string.
+//
+// Date Time elements include year, month, day, hour, minute,
+// second and nanosecond.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//   year                int - year number
+//   month               int - month number        1 -  12
+//   day                 int - day number          1 -  31
+//   hour                int - hour number         0 -  24
+//   min                 int - minute number       0 -  59
+//   sec                 int - second number       0 -  59
+//   millisecond         int - millisecond number  0 - 999
+//   microsecond         int - microsecond number  0 - 999
+//   nanosecond          int - nanosecond number   0 - 999,999,999
+//
+//
+//   timeZoneLocationName  string
+//     - Designates the standard Time Zone location by which
+//       time duration will be compared. This ensures that
+//       'oranges are compared to oranges and apples are compared
+//       to apples' with respect to start time and end time duration
+//       calculations.
+//
+//       If 'timeZoneLocation' is passed as an empty string, it
+//       will be automatically defaulted to the 'UTC' time zone.
+//       Reference Universal Coordinated Time:
+//          https://en.wikipedia.org/wiki/Coordinated_Universal_Time
+//
+//       Time zone location, or time zone name,
+//       must be designated as one of three types
+//       of values:
+//
+//       (1) The string 'Local' - signals the designation of the local time zone
+//           configured for the host computer executing this code.
+//
+//       (2) IANA Time Zone Location -
+//           See https://golang.org/pkg/time/#LoadLocation
+//           and https://www.iana.org/time-zones to ensure that
+//           the IANA Time Zone Database is properly configured
+//           on your system. Note: IANA Time Zone Data base is
+//           equivalent to 'tz database'.
+//
+//              Examples:
+//                "America/New_York"
+//                "America/Chicago"
+//                "America/Denver"
+//                "America/Los_Angeles"
+//                "Pacific/Honolulu"
+//
+//       (3) A valid Military Time Zone
+//           Military time zones are commonly used in
+//           aviation as well as at sea. They are also
+//           known as nautical or maritime time zones.
+//           Reference:
+//               https://en.wikipedia.org/wiki/List_of_military_time_zones
+//               http://www.thefightschool.demon.co.uk/UNMC_Military_Time.htm
+//               https://www.timeanddate.com/time/zones/military
+//
+//       Note:
+//           The source file 'timezonedata.go' contains over 600 constant
+//           time zone declarations covering all IANA and Military Time
+//           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
+//           time zone constants begin with the prefix 'TZones'.
+//
+//  dstPolicy  TimeZoneDstPolicy
+//     - Determines how local date times which are ambiguous or
+//       nonexistent in the designated time zone are resolved.
+//       Ambiguous local times occur twice, as when clocks are
+//       set back at the end of Daylight Saving Time. Nonexistent
+//       local times are skipped, as when clocks are set forward
+//       at the start of Daylight Saving Time. Valid values are:
+//
+//         TzDstPolicy.Earlier()
+//         TzDstPolicy.Later()
+//         TzDstPolicy.Reject()
+//         TzDstPolicy.ShiftForward()
+//
+//       If TzDstPolicy.Reject() is specified, ambiguous local
+//       times generate an error of type *TimeZoneAmbiguousTimeError
+//       and nonexistent local times generate an error of type
+//       *TimeZoneNonexistentTimeError.
+//
+//       See the documentation for type TimeZoneDstPolicy in
+//       source file 'timezonedstpolicyenum.go'.
+//
+//       TzDstPolicy.None() is INVALID and generates an error.
+//
+//   dateTimeFmtStr string
+//       - A date time format string which will be used
+//         to format and display 'dateTime'. Example:
+//         "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+//         Date time format constants are found in the source
+//         file 'constantsdatetime.go'. These constants represent
+//         the more commonly used date time string formats. All
+//         Date Time format constants begin with the prefix
+//         'FmtDateTime'.
+//
+//         If 'dateTimeFmtStr' is submitted as an
+//         'empty string', a default date time format
+//         string will be applied. The default date time
+//         format string is:
+//           FmtDateTimeYrMDayFmtStr =
+//               "2006-01-02 15:04:05.000000000 -0700 MST"
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//   error - If successful the returned error Type is set equal to 'nil'. If errors are
+//           encountered this error Type will encapsulate an error message.
+//
+func (dtz *DateTzDto) SetFromDateTimeElementsWithDstPolicy(
+	year,
+	month,
+	day,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	timeZoneLocationName string,
+	dstPolicy TimeZoneDstPolicy,
+	dateTimeFmtStr string) error {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.SetFromDateTimeElementsWithDstPolicy() "
+
+	if !dstPolicy.XIsValid() {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dstPolicy",
+			inputParameterValue: dstPolicy.String(),
+			errMsg:              "Input parameter 'dstPolicy' is INVALID!",
+			err:                 nil,
+		}
+	}
+
+	dtUtil := dateTzDtoUtility{}
+
+	return dtUtil.setFromDateTimeElements(
+			dtz,
+			year,
+			month,
+			day,
+			hour,
+			minute,
+			second,
+			nanosecond,
+			timeZoneLocationName,
+			dstPolicy,
 			dateTimeFmtStr,
 			ePrefix)
 }
@@ -4967,3 +6698,99 @@ func (dtz *DateTzDto) SubDateTime(t2 time.Time) time.Duration {
 
 	return dtz.dateTimeValue.Sub(t2)
 }
+
+// UnmarshalBinary - Implements the encoding.BinaryUnmarshaler interface. The
+// current DateTzDto instance is rebuilt from 'data', which must hold
+// the binary wire format generated by DateTzDto.MarshalBinary().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (dtz *DateTzDto) UnmarshalBinary(data []byte) error {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.UnmarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := dateTzDtoWire{}
+
+	err := wireMech.unmarshalBinary(data, wireTypeCodeDateTzDto, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.dateTzDtoFromWire(dtz, &wire, ePrefix)
+}
+
+// UnmarshalJSON - Implements the json.Unmarshaler interface. The
+// current DateTzDto instance is rebuilt from 'data', which must hold
+// the JSON wire format generated by DateTzDto.MarshalJSON().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (dtz *DateTzDto) UnmarshalJSON(data []byte) error {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.UnmarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := dateTzDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.dateTzDtoFromWire(dtz, &wire, ePrefix)
+}
+
+// UnmarshalText - Implements the encoding.TextUnmarshaler interface. The
+// current DateTzDto instance is rebuilt from 'data', which must hold
+// the text form generated by DateTzDto.MarshalText().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (dtz *DateTzDto) UnmarshalText(data []byte) error {
+
+	if dtz.lock == nil {
+		dtz.lock = new(sync.Mutex)
+	}
+
+	dtz.lock.Lock()
+
+	defer dtz.lock.Unlock()
+
+	ePrefix := "DateTzDto.UnmarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := dateTzDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.dateTzDtoFromWire(dtz, &wire, ePrefix)
+}
@@ -1360,6 +1360,30 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTime(
 //           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
 //           time zone constants begin with the prefix 'TZones'.
 //
+//  dstPolicy  TimeZoneDstPolicy
+//     - Determines how local date times which are ambiguous or
+//       nonexistent in the designated time zone are resolved.
+//       Ambiguous local times occur twice, as when clocks are
+//       set back at the end of Daylight Saving Time. Nonexistent
+//       local times are skipped, as when clocks are set forward
+//       at the start of Daylight Saving Time. Valid values are:
+//
+//         TzDstPolicy.Earlier()
+//         TzDstPolicy.Later()
+//         TzDstPolicy.Reject()
+//         TzDstPolicy.ShiftForward()
+//
+//       If TzDstPolicy.Reject() is specified, ambiguous local
+//       times generate an error of type *TimeZoneAmbiguousTimeError
+//       and nonexistent local times generate an error of type
+//       *TimeZoneNonexistentTimeError.
+//
+//       See the documentation for type TimeZoneDstPolicy in
+//       source file 'timezonedstpolicyenum.go'.
+//
+//       If TzDstPolicy.None() is specified, the local date time
+//       is resolved by the Go time package, time.Date().
+//
 //  dateTimeFmtStr  string
 //     - A date time format string which will be used
 //       to format and display 'dateTime'. Example:
@@ -1400,7 +1424,8 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTimeComponents(
 	millisecond,
 	microsecond,
 	nanosecond int,
-	timeZoneLocationName,
+	timeZoneLocationName string,
+	dstPolicy TimeZoneDstPolicy,
 	dateTimeFmtStr,
 	ePrefix string) error {
 
@@ -1452,13 +1477,60 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTimeComponents(
 
 	var dt time.Time
 
-	dt, err = tDto.GetDateTime(timeZoneLocationName)
+	convertType := TzConvertType.Relative()
 
-	if err != nil {
-		return fmt.Errorf(ePrefix+
-			"\nError returned by tDto.GetDateTime(timeZoneLocationName).\n"+
-			"\ntimeZoneLocationName='%v'\nError='%v'\n",
-			timeZoneLocationName, err.Error())
+	if dstPolicy == TzDstPolicy.None() {
+
+		dt, err = tDto.GetDateTime(timeZoneLocationName)
+
+		if err != nil {
+			return fmt.Errorf(ePrefix+
+				"\nError returned by tDto.GetDateTime(timeZoneLocationName).\n"+
+				"\ntimeZoneLocationName='%v'\nError='%v'\n",
+				timeZoneLocationName, err.Error())
+		}
+
+		convertType = TzConvertType.Absolute()
+
+	} else {
+
+		tzDstMech := timeZoneDstMechanics{}
+
+		dt, err = tzDstMech.resolveLocalDateTime(
+			tDto.Years,
+			tDto.Months,
+			tDto.DateDays,
+			tDto.Hours,
+			tDto.Minutes,
+			tDto.Seconds,
+			tDto.TotSubSecNanoseconds,
+			timeZoneLocationName,
+			dstPolicy,
+			ePrefix)
+
+		if err != nil {
+			return err
+		}
+
+		// Nonexistent local times resolve to a date time
+		// which differs from the original time components.
+		if dt.Year() != tDto.Years ||
+			int(dt.Month()) != tDto.Months ||
+			dt.Day() != tDto.DateDays ||
+			dt.Hour() != tDto.Hours ||
+			dt.Minute() != tDto.Minutes ||
+			dt.Second() != tDto.Seconds ||
+			dt.Nanosecond() != tDto.TotSubSecNanoseconds {
+
+			tDto, err = TimeDto{}.NewFromDateTime(dt)
+
+			if err != nil {
+				return fmt.Errorf(ePrefix+
+					"\nError returned by TimeDto{}.NewFromDateTime(dt).\n"+
+					"dt='%v'\nError='%v'\n",
+					dt.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+			}
+		}
 	}
 
 	timeZone := TimeZoneDefinition{}
@@ -1468,7 +1540,7 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTimeComponents(
 	err = tzDefUtil.setFromTimeZoneName(
 		&timeZone,
 		dt,
-		TzConvertType.Absolute(),
+		convertType,
 		timeZoneLocationName,
 		ePrefix)
 
@@ -1565,6 +1637,30 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTimeComponents(
 //           Zones. Example: 'TZones.US.Central()' = "America/Chicago". All
 //           time zone constants begin with the prefix 'TZones'.
 //
+//  dstPolicy  TimeZoneDstPolicy
+//     - Determines how local date times which are ambiguous or
+//       nonexistent in the designated time zone are resolved.
+//       Ambiguous local times occur twice, as when clocks are
+//       set back at the end of Daylight Saving Time. Nonexistent
+//       local times are skipped, as when clocks are set forward
+//       at the start of Daylight Saving Time. Valid values are:
+//
+//         TzDstPolicy.Earlier()
+//         TzDstPolicy.Later()
+//         TzDstPolicy.Reject()
+//         TzDstPolicy.ShiftForward()
+//
+//       If TzDstPolicy.Reject() is specified, ambiguous local
+//       times generate an error of type *TimeZoneAmbiguousTimeError
+//       and nonexistent local times generate an error of type
+//       *TimeZoneNonexistentTimeError.
+//
+//       See the documentation for type TimeZoneDstPolicy in
+//       source file 'timezonedstpolicyenum.go'.
+//
+//       If TzDstPolicy.None() is specified, the local date time
+//       is resolved by the Go time package, time.Date().
+//
 //  dateTimeFmtStr  string
 //     - A date time format string which will be used
 //       to format and display 'dateTime'. Example:
@@ -1603,7 +1699,8 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTimeElements(
 	minute,
 	second,
 	nanosecond int,
-	timeZoneLocationName,
+	timeZoneLocationName string,
+	dstPolicy TimeZoneDstPolicy,
 	dateTimeFmtStr,
 	ePrefix string) error {
 
@@ -1667,14 +1764,61 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTimeElements(
 
 	var dt time.Time
 
-	dt, err = tDto.GetDateTime(timeZoneLocationName)
+	convertType := TzConvertType.Relative()
 
-	if err != nil {
-		return fmt.Errorf(ePrefix+
-			"\nError returned by tDto.GetDateTime(tzl).\n"+
-			"\ntimeZoneLocationName='%v'\n"+
-			"Error='%v'\n",
-			timeZoneLocationName, err.Error())
+	if dstPolicy == TzDstPolicy.None() {
+
+		dt, err = tDto.GetDateTime(timeZoneLocationName)
+
+		if err != nil {
+			return fmt.Errorf(ePrefix+
+				"\nError returned by tDto.GetDateTime(tzl).\n"+
+				"\ntimeZoneLocationName='%v'\n"+
+				"Error='%v'\n",
+				timeZoneLocationName, err.Error())
+		}
+
+		convertType = TzConvertType.Absolute()
+
+	} else {
+
+		tzDstMech := timeZoneDstMechanics{}
+
+		dt, err = tzDstMech.resolveLocalDateTime(
+			tDto.Years,
+			tDto.Months,
+			tDto.DateDays,
+			tDto.Hours,
+			tDto.Minutes,
+			tDto.Seconds,
+			tDto.TotSubSecNanoseconds,
+			timeZoneLocationName,
+			dstPolicy,
+			ePrefix)
+
+		if err != nil {
+			return err
+		}
+
+		// Nonexistent local times resolve to a date time
+		// which differs from the original time components.
+		if dt.Year() != tDto.Years ||
+			int(dt.Month()) != tDto.Months ||
+			dt.Day() != tDto.DateDays ||
+			dt.Hour() != tDto.Hours ||
+			dt.Minute() != tDto.Minutes ||
+			dt.Second() != tDto.Seconds ||
+			dt.Nanosecond() != tDto.TotSubSecNanoseconds {
+
+			tDto, err = TimeDto{}.NewFromDateTime(dt)
+
+			if err != nil {
+				return fmt.Errorf(ePrefix+
+					"\nError returned by TimeDto{}.NewFromDateTime(dt).\n"+
+					"dt='%v'\nError='%v'\n",
+					dt.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+			}
+		}
 	}
 
 	timeZone := TimeZoneDefinition{}
@@ -1684,7 +1828,7 @@ func (dTzUtil *dateTzDtoUtility) setFromDateTimeElements(
 	err = tzDefUtil.setFromTimeZoneName(
 		&timeZone,
 		dt,
-		TzConvertType.Absolute(),
+		convertType,
 		timeZoneLocationName,
 		ePrefix)
 
@@ -0,0 +1,507 @@
+package datetime
+
+import (
+	"sync"
+	"time"
+)
+
+// DateTzInterval - Defines a time span bounded by two DateTzDto
+// instances. The start date time is always included in the interval.
+// The bound type determines whether the end date time is included.
+//
+//  IntervalBound.HalfOpen()  [start, end)
+//  IntervalBound.Closed()    [start, end]
+//
+// Reference type 'IntervalBoundType'.
+//
+// Interval operations compare instants, not local date times.
+// Consequently, intervals whose date times are expressed in
+// different time zones may be combined. Results are expressed in
+// the time zone of the current DateTzInterval instance, with the
+// exception of Union() which uses the time zone of the earlier
+// interval.
+//
+// Method Split() divides an interval at calendar unit boundaries in
+// the local time of the interval's start date time. A calendar day
+// containing a Daylight Saving Time transition is returned as a
+// single interval of 23 or 25 hours.
+//
+type DateTzInterval struct {
+	start  DateTzDto         // Always included in the interval
+	end    DateTzDto         // Included only if 'bounds' is Closed
+	bounds IntervalBoundType // HalfOpen or Closed
+	lock   *sync.Mutex
+}
+
+// Contains - Returns 'true' if the instant of 'dTz' lies within the
+// current DateTzInterval.
+//
+func (dTzInterval *DateTzInterval) Contains(dTz DateTzDto) bool {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	return dTzIntervalMech.contains(dTzInterval, dTz.dateTimeValue)
+}
+
+// CopyOut - Returns a deep copy of the current DateTzInterval
+// instance.
+//
+func (dTzInterval *DateTzInterval) CopyOut() DateTzInterval {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	return dTzIntervalMech.copyOut(dTzInterval)
+}
+
+// Gap - Returns the interval separating the current DateTzInterval
+// from 'interval2'. If the two intervals overlap or abut, return
+// value 'hasGap' is set to 'false'.
+//
+// The gap is returned as a half-open interval which begins at the
+// end date time of the earlier interval and ends at the start date
+// time of the later interval.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  [09:00, 10:00) gap [11:00, 12:00) = [10:00, 11:00)
+//
+func (dTzInterval *DateTzInterval) Gap(
+	interval2 DateTzInterval,
+	ePrefix string) (
+	gap DateTzInterval,
+	hasGap bool,
+	err error) {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	ePrefix += "DateTzInterval.Gap() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	err = dTzIntervalMech.testIntervalValidity(&interval2, ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, false, err
+	}
+
+	return dTzIntervalMech.gap(dTzInterval, &interval2, ePrefix)
+}
+
+// GetBounds - Returns the bound type of the interval. Reference
+// type 'IntervalBoundType'.
+//
+func (dTzInterval *DateTzInterval) GetBounds() IntervalBoundType {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	return dTzInterval.bounds
+}
+
+// GetDuration - Returns the elapsed time between the start and end
+// date times of the interval. On days with Daylight Saving Time
+// transitions, a calendar day may span 23 or 25 hours.
+//
+func (dTzInterval *DateTzInterval) GetDuration() time.Duration {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	return dTzInterval.end.dateTimeValue.Sub(dTzInterval.start.dateTimeValue)
+}
+
+// GetEnd - Returns the end date time of the interval.
+//
+func (dTzInterval *DateTzInterval) GetEnd() DateTzDto {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	return dTzUtil.copyOut(&dTzInterval.end)
+}
+
+// GetStart - Returns the start date time of the interval.
+//
+func (dTzInterval *DateTzInterval) GetStart() DateTzDto {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	return dTzUtil.copyOut(&dTzInterval.start)
+}
+
+// Intersect - Returns the interval shared by the current
+// DateTzInterval and 'interval2'. If the two intervals do not
+// overlap, return value 'found' is set to 'false'.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  [09:00, 11:00) intersect [10:00, 12:00] = [10:00, 11:00)
+//
+func (dTzInterval *DateTzInterval) Intersect(
+	interval2 DateTzInterval,
+	ePrefix string) (
+	intersection DateTzInterval,
+	found bool,
+	err error) {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	ePrefix += "DateTzInterval.Intersect() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	err = dTzIntervalMech.testIntervalValidity(&interval2, ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, false, err
+	}
+
+	return dTzIntervalMech.intersect(dTzInterval, &interval2, ePrefix)
+}
+
+// IsEmpty - Returns 'true' if the interval contains no instants.
+// Only a half-open interval whose start and end date times are equal
+// is empty.
+//
+func (dTzInterval *DateTzInterval) IsEmpty() bool {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	return dTzIntervalMech.isEmpty(dTzInterval)
+}
+
+// IsValid - Returns an error if the current DateTzInterval instance
+// is invalid.
+//
+func (dTzInterval *DateTzInterval) IsValid(
+	ePrefix string) error {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	ePrefix += "DateTzInterval.IsValid() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	return dTzIntervalMech.testIntervalValidity(dTzInterval, ePrefix)
+}
+
+// New - Creates and returns a new DateTzInterval.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  start               DateTzDto
+//     - The start date time of the interval. The start date time is
+//       always included in the interval.
+//
+//
+//  end                 DateTzDto
+//     - The end date time of the interval. 'end' may not occur before
+//       'start'. 'end' may be expressed in a different time zone than
+//       'start'.
+//
+//
+//  bounds              IntervalBoundType
+//     - Determines whether 'end' is included in the interval.
+//
+//        IntervalBound.HalfOpen() - [start, end)
+//        IntervalBound.Closed()   - [start, end]
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  DateTzInterval
+//     - If successful, this method returns a new, populated instance
+//       of DateTzInterval.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  interval, err := DateTzInterval{}.New(
+//                     startDTz,
+//                     endDTz,
+//                     IntervalBound.HalfOpen(),
+//                     ePrefix)
+//
+//  days, err := interval.Split(CalUnit.Day(), ePrefix)
+//
+func (dTzInterval DateTzInterval) New(
+	start DateTzDto,
+	end DateTzDto,
+	bounds IntervalBoundType,
+	ePrefix string) (
+	DateTzInterval,
+	error) {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	ePrefix += "DateTzInterval.New() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	newInterval := DateTzInterval{
+		start:  dTzUtil.copyOut(&start),
+		end:    dTzUtil.copyOut(&end),
+		bounds: bounds,
+		lock:   new(sync.Mutex),
+	}
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	err := dTzIntervalMech.testIntervalValidity(&newInterval, ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, err
+	}
+
+	return newInterval, nil
+}
+
+// Overlaps - Returns 'true' if the current DateTzInterval and
+// 'interval2' share at least one instant. Half-open intervals which
+// abut do not overlap.
+//
+func (dTzInterval *DateTzInterval) Overlaps(interval2 DateTzInterval) bool {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	_, found, err := dTzIntervalMech.intersect(dTzInterval, &interval2, "")
+
+	return err == nil && found
+}
+
+// Split - Divides the interval at the boundaries of calendar unit
+// 'unit'. Calendar units are evaluated in the local time of the
+// interval's start date time. Reference type 'CalendarUnitType'.
+//
+// The first resulting interval begins at the start date time of the
+// current interval. Each following interval begins at local
+// midnight on the first day of a calendar unit. Every resulting
+// interval except the last is half-open. The last interval retains
+// the bounds of the current interval.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  Interval: [2021-03-13 12:00 CST, 2021-03-15 12:00 CDT)
+//
+//  Split(CalUnit.Day()) returns:
+//    [2021-03-13 12:00 CST, 2021-03-14 00:00 CST)  12 hours
+//    [2021-03-14 00:00 CST, 2021-03-15 00:00 CDT)  23 hours
+//    [2021-03-15 00:00 CDT, 2021-03-15 12:00 CDT)  12 hours
+//
+func (dTzInterval *DateTzInterval) Split(
+	unit CalendarUnitType,
+	ePrefix string) (
+	[]DateTzInterval,
+	error) {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	ePrefix += "DateTzInterval.Split() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	err := dTzIntervalMech.testIntervalValidity(dTzInterval, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return dTzIntervalMech.split(dTzInterval, unit, ePrefix)
+}
+
+// String - Returns the interval formatted with the date time format
+// of its start and end date times. Square brackets signal included
+// date times. A parenthesis signals an excluded end date time.
+//
+//  Example:
+//   "[2021-03-14 00:00:00 -0600 CST, 2021-03-15 00:00:00 -0500 CDT)"
+//
+func (dTzInterval *DateTzInterval) String() string {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	closing := ")"
+
+	if dTzInterval.bounds == IntervalBound.Closed() {
+		closing = "]"
+	}
+
+	return "[" +
+		dTzInterval.start.dateTimeValue.Format(dTzInterval.start.dateTimeFmt) +
+		", " +
+		dTzInterval.end.dateTimeValue.Format(dTzInterval.end.dateTimeFmt) +
+		closing
+}
+
+// Union - Returns the interval covering both the current
+// DateTzInterval and 'interval2'. The two intervals must overlap or
+// abut. Otherwise, their union is not a single interval and an error
+// is returned. Use type IntervalSet to combine disjoint intervals.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  [09:00, 10:00) union [10:00, 11:00] = [09:00, 11:00]
+//
+func (dTzInterval *DateTzInterval) Union(
+	interval2 DateTzInterval,
+	ePrefix string) (
+	DateTzInterval,
+	error) {
+
+	if dTzInterval.lock == nil {
+		dTzInterval.lock = new(sync.Mutex)
+	}
+
+	dTzInterval.lock.Lock()
+
+	defer dTzInterval.lock.Unlock()
+
+	ePrefix += "DateTzInterval.Union() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	err := dTzIntervalMech.testIntervalValidity(&interval2, ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, err
+	}
+
+	union, isJoined, err := dTzIntervalMech.union(dTzInterval, &interval2, ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, err
+	}
+
+	if !isJoined {
+		return DateTzInterval{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "interval2",
+				inputParameterValue: interval2.String(),
+				errMsg:              "Input parameter 'interval2' neither overlaps nor abuts the current interval!",
+				err:                 nil,
+			}
+	}
+
+	return union, nil
+}
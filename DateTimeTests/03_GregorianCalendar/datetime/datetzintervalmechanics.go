@@ -0,0 +1,527 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// dateTzIntervalMechanics - Provides helper methods used to compute
+// DateTzInterval and IntervalSet operations.
+//
+// All comparisons are performed on instants. Time zones affect only
+// the presentation of results and the calendar boundaries applied
+// by split().
+//
+type dateTzIntervalMechanics struct {
+	lock *sync.Mutex
+}
+
+// contains - Returns 'true' if instant 't' lies within 'interval'.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) contains(
+	interval *DateTzInterval,
+	t time.Time) bool {
+
+	start := interval.start.dateTimeValue
+	end := interval.end.dateTimeValue
+
+	if t.Before(start) {
+		return false
+	}
+
+	if t.Before(end) {
+		return true
+	}
+
+	return interval.bounds == IntervalBound.Closed() &&
+		t.Equal(end)
+}
+
+// copyOut - Returns a deep copy of 'interval'.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) copyOut(
+	interval *DateTzInterval) DateTzInterval {
+
+	dTzUtil := dateTzDtoUtility{}
+
+	return DateTzInterval{
+		start:  dTzUtil.copyOut(&interval.start),
+		end:    dTzUtil.copyOut(&interval.end),
+		bounds: interval.bounds,
+		lock:   new(sync.Mutex),
+	}
+}
+
+// gap - Returns the interval separating 'interval1' and 'interval2'.
+// If the intervals overlap or abut, 'hasGap' is set to 'false'.
+//
+// The gap is returned as a half-open interval. It begins at the end
+// date time of the earlier interval and ends at the start date time
+// of the later interval. Date times are expressed in the time zone
+// of 'interval1'.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) gap(
+	interval1 *DateTzInterval,
+	interval2 *DateTzInterval,
+	ePrefix string) (
+	gap DateTzInterval,
+	hasGap bool,
+	err error) {
+
+	ePrefix += "dateTzIntervalMechanics.gap() "
+
+	earlier := interval1
+	later := interval2
+
+	if later.start.dateTimeValue.Before(earlier.start.dateTimeValue) {
+		earlier, later = later, earlier
+	}
+
+	gapStart := earlier.end.dateTimeValue
+	gapEnd := later.start.dateTimeValue
+
+	if !gapStart.Before(gapEnd) {
+		return DateTzInterval{}, false, nil
+	}
+
+	gap, err = dTzIntervalMech.newInterval(
+		gapStart,
+		gapEnd,
+		IntervalBound.HalfOpen(),
+		interval1,
+		ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, false, err
+	}
+
+	return gap, true, nil
+}
+
+// intersect - Returns the intersection of 'interval1' and
+// 'interval2'. If the intervals do not overlap, 'found' is set to
+// 'false'. Date times are expressed in the time zone of 'interval1'.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) intersect(
+	interval1 *DateTzInterval,
+	interval2 *DateTzInterval,
+	ePrefix string) (
+	intersection DateTzInterval,
+	found bool,
+	err error) {
+
+	ePrefix += "dateTzIntervalMechanics.intersect() "
+
+	start := interval1.start.dateTimeValue
+
+	if interval2.start.dateTimeValue.After(start) {
+		start = interval2.start.dateTimeValue
+	}
+
+	end, endIncluded := dTzIntervalMech.minEnd(interval1, interval2)
+
+	if start.After(end) ||
+		(start.Equal(end) && !endIncluded) {
+		return DateTzInterval{}, false, nil
+	}
+
+	bounds := IntervalBound.HalfOpen()
+
+	if endIncluded {
+		bounds = IntervalBound.Closed()
+	}
+
+	intersection, err = dTzIntervalMech.newInterval(
+		start,
+		end,
+		bounds,
+		interval1,
+		ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, false, err
+	}
+
+	return intersection, true, nil
+}
+
+// isEmpty - Returns 'true' if 'interval' contains no instants. Only
+// half-open intervals whose start and end date times are equal are
+// empty.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) isEmpty(
+	interval *DateTzInterval) bool {
+
+	return interval.bounds == IntervalBound.HalfOpen() &&
+		interval.start.dateTimeValue.Equal(interval.end.dateTimeValue)
+}
+
+// minEnd - Returns the earlier of the two end date times and
+// whether that end date time is included in both intervals.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) minEnd(
+	interval1 *DateTzInterval,
+	interval2 *DateTzInterval) (
+	end time.Time,
+	endIncluded bool) {
+
+	end1 := interval1.end.dateTimeValue
+	end2 := interval2.end.dateTimeValue
+
+	included1 := interval1.bounds == IntervalBound.Closed()
+	included2 := interval2.bounds == IntervalBound.Closed()
+
+	switch {
+
+	case end1.Before(end2):
+		return end1, included1
+
+	case end2.Before(end1):
+		return end2, included2
+	}
+
+	return end1, included1 && included2
+}
+
+// maxEnd - Returns the later of the two end date times and whether
+// that end date time is included in either interval.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) maxEnd(
+	interval1 *DateTzInterval,
+	interval2 *DateTzInterval) (
+	end time.Time,
+	endIncluded bool) {
+
+	end1 := interval1.end.dateTimeValue
+	end2 := interval2.end.dateTimeValue
+
+	included1 := interval1.bounds == IntervalBound.Closed()
+	included2 := interval2.bounds == IntervalBound.Closed()
+
+	switch {
+
+	case end1.After(end2):
+		return end1, included1
+
+	case end2.After(end1):
+		return end2, included2
+	}
+
+	return end1, included1 || included2
+}
+
+// newInterval - Returns a new DateTzInterval spanning instants
+// 'start' and 'end'. The date times are expressed in the time zone,
+// and formatted with the format string, of the start date time of
+// 'tzTemplate'.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) newInterval(
+	start time.Time,
+	end time.Time,
+	bounds IntervalBoundType,
+	tzTemplate *DateTzInterval,
+	ePrefix string) (
+	DateTzInterval,
+	error) {
+
+	ePrefix += "dateTzIntervalMechanics.newInterval() "
+
+	locPtr := tzTemplate.start.dateTimeValue.Location()
+
+	fmtStr := tzTemplate.start.dateTimeFmt
+
+	dTzUtil := dateTzDtoUtility{}
+
+	newInterval := DateTzInterval{
+		bounds: bounds,
+		lock:   new(sync.Mutex),
+	}
+
+	err := dTzUtil.setFromDateTime(&newInterval.start, start.In(locPtr), fmtStr, ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, err
+	}
+
+	err = dTzUtil.setFromDateTime(&newInterval.end, end.In(locPtr), fmtStr, ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, err
+	}
+
+	return newInterval, nil
+}
+
+// nextUnitBoundary - Returns the first instant of the calendar unit
+// following the calendar unit which contains 't'. Calendar units
+// are evaluated in the local time of time zone location 'locPtr'.
+//
+// Calendar units begin at local midnight. If local midnight does
+// not exist because clocks were set forward, the calendar unit
+// begins at the first instant following the gap. If local midnight
+// occurs twice, the calendar unit begins at the first occurrence.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) nextUnitBoundary(
+	t time.Time,
+	locPtr *time.Location,
+	unit CalendarUnitType) time.Time {
+
+	local := t.In(locPtr)
+
+	year, month, day := local.Date()
+
+	switch unit {
+
+	case CalUnit.Day():
+		day++
+
+	case CalUnit.Week():
+		// ISO 8601 weeks begin on Monday.
+		isoWeekDay := int(local.Weekday())
+
+		if isoWeekDay == 0 {
+			isoWeekDay = 7
+		}
+
+		day += 8 - isoWeekDay
+
+	case CalUnit.Month():
+		month++
+		day = 1
+
+	default:
+		year++
+		month = time.January
+		day = 1
+	}
+
+	naiveMidnight := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	earlier, later, _, isNonexistent :=
+		tzDstMech.classifyLocalDateTime(naiveMidnight, locPtr)
+
+	if isNonexistent {
+		return later
+	}
+
+	return earlier
+}
+
+// normalize - Sorts 'intervals' by start date time, discards empty
+// intervals and merges intervals which overlap or abut. The returned
+// intervals are disjoint and in ascending order.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) normalize(
+	intervals []DateTzInterval,
+	ePrefix string) (
+	[]DateTzInterval,
+	error) {
+
+	ePrefix += "dateTzIntervalMechanics.normalize() "
+
+	sorted := make([]DateTzInterval, 0, len(intervals))
+
+	for i := 0; i < len(intervals); i++ {
+
+		if dTzIntervalMech.isEmpty(&intervals[i]) {
+			continue
+		}
+
+		sorted = append(sorted, dTzIntervalMech.copyOut(&intervals[i]))
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].start.dateTimeValue.Before(sorted[j].start.dateTimeValue)
+	})
+
+	merged := make([]DateTzInterval, 0, len(sorted))
+
+	for i := 0; i < len(sorted); i++ {
+
+		if len(merged) == 0 {
+			merged = append(merged, sorted[i])
+			continue
+		}
+
+		last := &merged[len(merged)-1]
+
+		union, isJoined, err := dTzIntervalMech.union(last, &sorted[i], ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if isJoined {
+			*last = union
+			continue
+		}
+
+		merged = append(merged, sorted[i])
+	}
+
+	return merged, nil
+}
+
+// split - Divides 'interval' at the calendar unit boundaries which
+// fall within it. Calendar units are evaluated in the local time of
+// the interval's start date time.
+//
+// Each resulting interval except the last is half-open. The last
+// interval retains the bounds of 'interval'.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) split(
+	interval *DateTzInterval,
+	unit CalendarUnitType,
+	ePrefix string) (
+	[]DateTzInterval,
+	error) {
+
+	ePrefix += "dateTzIntervalMechanics.split() "
+
+	if !unit.XIsValid() {
+		return nil,
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "unit",
+				inputParameterValue: unit.String(),
+				errMsg:              "Input parameter 'unit' is INVALID!",
+				err:                 nil,
+			}
+	}
+
+	locPtr := interval.start.dateTimeValue.Location()
+
+	end := interval.end.dateTimeValue
+
+	cursor := interval.start.dateTimeValue
+
+	pieces := make([]DateTzInterval, 0, 8)
+
+	for {
+
+		boundary := dTzIntervalMech.nextUnitBoundary(cursor, locPtr, unit)
+
+		if !boundary.Before(end) {
+			break
+		}
+
+		piece, err := dTzIntervalMech.newInterval(
+			cursor,
+			boundary,
+			IntervalBound.HalfOpen(),
+			interval,
+			ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		pieces = append(pieces, piece)
+
+		cursor = boundary
+	}
+
+	piece, err := dTzIntervalMech.newInterval(
+		cursor,
+		end,
+		interval.bounds,
+		interval,
+		ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pieces = append(pieces, piece)
+
+	return pieces, nil
+}
+
+// testIntervalValidity - Returns an error if 'interval' is invalid.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) testIntervalValidity(
+	interval *DateTzInterval,
+	ePrefix string) error {
+
+	ePrefix += "dateTzIntervalMechanics.testIntervalValidity() "
+
+	if !interval.bounds.XIsValid() {
+		return fmt.Errorf(ePrefix+
+			"\nError: The Interval Bound Type is INVALID!\n"+
+			"bounds='%v'\n", interval.bounds.String())
+	}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&interval.start, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	err = dTzUtil.isValidDateTzDto(&interval.end, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	if interval.end.dateTimeValue.Before(interval.start.dateTimeValue) {
+		return errors.New(ePrefix + "\n" +
+			"Error: The interval end date time occurs before the start date time!\n")
+	}
+
+	return nil
+}
+
+// union - Returns the union of 'interval1' and 'interval2'. If the
+// intervals neither overlap nor abut, their union is not an interval
+// and 'isJoined' is set to 'false'. Date times are expressed in the
+// time zone of the earlier interval.
+//
+func (dTzIntervalMech *dateTzIntervalMechanics) union(
+	interval1 *DateTzInterval,
+	interval2 *DateTzInterval,
+	ePrefix string) (
+	union DateTzInterval,
+	isJoined bool,
+	err error) {
+
+	ePrefix += "dateTzIntervalMechanics.union() "
+
+	earlier := interval1
+	later := interval2
+
+	if later.start.dateTimeValue.Before(earlier.start.dateTimeValue) {
+		earlier, later = later, earlier
+	}
+
+	if later.start.dateTimeValue.After(earlier.end.dateTimeValue) {
+		return DateTzInterval{}, false, nil
+	}
+
+	end, endIncluded := dTzIntervalMech.maxEnd(interval1, interval2)
+
+	bounds := IntervalBound.HalfOpen()
+
+	if endIncluded {
+		bounds = IntervalBound.Closed()
+	}
+
+	union, err = dTzIntervalMech.newInterval(
+		earlier.start.dateTimeValue,
+		end,
+		bounds,
+		earlier,
+		ePrefix)
+
+	if err != nil {
+		return DateTzInterval{}, false, err
+	}
+
+	return union, true, nil
+}
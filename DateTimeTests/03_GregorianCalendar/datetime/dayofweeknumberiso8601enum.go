@@ -65,6 +65,7 @@ var mIso8601DayOfWeekNoCodeToString = map[ISO8601DayOfWeekNo]string{
 	ISO8601DayOfWeekNo(3)   : "Wednesday",
 	ISO8601DayOfWeekNo(4)   : "Thursday",
 	ISO8601DayOfWeekNo(5)   : "Friday",
+	ISO8601DayOfWeekNo(6)   : "Saturday",
 	ISO8601DayOfWeekNo(7)   : "Sunday",
 }
 
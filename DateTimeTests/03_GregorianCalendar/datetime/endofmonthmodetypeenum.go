@@ -0,0 +1,300 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mEndOfMonthModeTypeStringToCode = map[string]EndOfMonthModeType{
+	"None"     : EndOfMonthModeType(0),
+	"Clamp"    : EndOfMonthModeType(1),
+	"Overflow" : EndOfMonthModeType(2),
+}
+
+var mEndOfMonthModeTypeLwrCaseStringToCode = map[string]EndOfMonthModeType{
+	"none"     : EndOfMonthModeType(0),
+	"clamp"    : EndOfMonthModeType(1),
+	"overflow" : EndOfMonthModeType(2),
+}
+
+var mEndOfMonthModeTypeCodeToString = map[EndOfMonthModeType]string{
+	EndOfMonthModeType(0) : "None",
+	EndOfMonthModeType(1) : "Clamp",
+	EndOfMonthModeType(2) : "Overflow",
+}
+
+// EndOfMonthModeType - An enumeration of the methods used to resolve
+// a day number which does not exist in the target month after years
+// or months are added to a date. Example: January 31st plus one
+// month.
+//
+// Since Go does not directly support enumerations, the 'EndOfMonthModeType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'EndOfMonthModeType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of end of month
+// modes. These methods are listed as follows:
+//
+//
+// None         (0) - Signals that the End Of Month Mode Type is not
+//                    initialized. This is an error condition.
+//
+// Clamp        (1) - The day number is reduced to the last day of the
+//                    target month.
+//                    Example: 2021-01-31 + 1 month = 2021-02-28
+//                             2020-01-31 + 1 month = 2020-02-29
+//
+// Overflow     (2) - Days in excess of the length of the target month
+//                    roll over into the following month.
+//                    Example: 2021-01-31 + 1 month = 2021-03-03
+//                             2020-01-31 + 1 month = 2020-03-02
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'EndOfMonthMode'. Example: EndOfMonthMode.Clamp()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: EndOfMonthModeType(0).Clamp()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the EndOfMonthModeType methods in alphabetical order. Be advised that all
+// 'EndOfMonthModeType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type EndOfMonthModeType int
+
+var lockEndOfMonthModeType sync.Mutex
+
+// None - Signals that the EndOfMonthModeType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (eomMode EndOfMonthModeType) None() EndOfMonthModeType {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	return EndOfMonthModeType(0)
+}
+
+// Clamp - Signals that a day number which does not exist in the
+// target month is reduced to the last day of that month.
+//
+// This method is part of the standard enumeration.
+//
+func (eomMode EndOfMonthModeType) Clamp() EndOfMonthModeType {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	return EndOfMonthModeType(1)
+}
+
+// Overflow - Signals that days in excess of the length of the
+// target month roll over into the following month.
+//
+// This method is part of the standard enumeration.
+//
+func (eomMode EndOfMonthModeType) Overflow() EndOfMonthModeType {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	return EndOfMonthModeType(2)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'EndOfMonthModeType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= EndOfMonthModeType(0).Clamp()
+// str := t.String()
+//     str is now equal to 'Clamp'
+//
+func (eomMode EndOfMonthModeType) String() string {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	result, ok := mEndOfMonthModeTypeCodeToString[eomMode]
+
+	if !ok {
+		return "Error: End Of Month Mode Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current EndOfMonthModeType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  eomMode := EndOfMonthModeType(0).Clamp()
+//
+//  isValid := eomMode.XIsValid()
+//
+func (eomMode EndOfMonthModeType) XIsValid() bool {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	if eomMode > 2 ||
+		eomMode < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of EndOfMonthModeType is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'clamp' will NOT
+//                        match the enumeration name, 'Clamp'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'CLAMP'
+//                        will match match enumeration name 'Clamp'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// EndOfMonthModeType - Upon successful completion, this method will return
+//                      a new instance of EndOfMonthModeType set to the value
+//                      of the enumeration matched by the string search
+//                      performed on input parameter, 'valueString'.
+//
+// error              - If this method completes successfully, the returned error
+//                      Type is set equal to 'nil'. If an error condition is
+//                      encountered, this method will return an error type which
+//                      encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := EndOfMonthModeType(0).XParseString("CLAMP", false)
+//
+//     t is now equal to EndOfMonthModeType(0).Clamp()
+//
+func (eomMode EndOfMonthModeType) XParseString(
+	valueString string,
+	caseSensitive bool) (EndOfMonthModeType, error) {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	ePrefix := "EndOfMonthModeType.XParseString() "
+
+	if len(valueString) < 4 {
+		return EndOfMonthModeType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var endOfMonthModeType EndOfMonthModeType
+
+	if caseSensitive {
+
+		endOfMonthModeType, ok = mEndOfMonthModeTypeStringToCode[valueString]
+
+	} else {
+
+		endOfMonthModeType, ok =
+			mEndOfMonthModeTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return EndOfMonthModeType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid EndOfMonthModeType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return endOfMonthModeType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// EndOfMonthModeType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (eomMode EndOfMonthModeType) XValue() EndOfMonthModeType {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	return eomMode
+}
+
+// XValueInt - This method returns the integer value of the current
+// EndOfMonthModeType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (eomMode EndOfMonthModeType) XValueInt() int {
+
+	lockEndOfMonthModeType.Lock()
+
+	defer lockEndOfMonthModeType.Unlock()
+
+	return int(eomMode)
+}
+
+// EndOfMonthMode - public global variable of
+// type EndOfMonthModeType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing EndOfMonthModeType values.
+//
+// Usage:
+// EndOfMonthMode.None(),
+// EndOfMonthMode.Clamp(),
+// EndOfMonthMode.Overflow(),
+//
+var EndOfMonthMode EndOfMonthModeType
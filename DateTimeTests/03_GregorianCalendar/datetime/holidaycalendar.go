@@ -0,0 +1,336 @@
+package datetime
+
+import (
+	"errors"
+	"sync"
+)
+
+// HolidayCalendar - Encapsulates a named set of holiday rules.
+// The rules are evaluated on the Gregorian Calendar in order to
+// determine whether a given date is a holiday and to list the
+// holidays occurring in a given year.
+//
+// Holiday rules may be added individually using method AddRule()
+// or loaded from a JSON rule set using method NewFromJSON().
+//
+//  Example JSON Rule Set:
+//   {
+//     "name": "US Federal Holidays",
+//     "rules": [
+//       {"name": "New Year's Day", "type": "FixedDate",
+//        "month": 1, "day": 1, "observed": "NearestWeekday"},
+//       {"name": "Memorial Day", "type": "LastWeekdayOfMonth",
+//        "month": 5, "weekDay": "Monday"},
+//       {"name": "Thanksgiving Day", "type": "NthWeekdayOfMonth",
+//        "month": 11, "weekDay": "Thursday", "nth": 4}
+//     ]
+//   }
+//
+// For a description of supported rule types and weekend observance
+// policies, reference:
+//    Source File: datetime\holidayrule.go
+//    Source File: datetime\holidayobservedtypeenum.go
+//
+type HolidayCalendar struct {
+	name  string
+	rules []HolidayRule
+	lock  *sync.Mutex
+}
+
+// AddRule - Adds a holiday rule to the current HolidayCalendar
+// instance. The rule is validated before it is added.
+//
+func (holCal *HolidayCalendar) AddRule(
+	rule HolidayRule,
+	ePrefix string) error {
+
+	if holCal.lock == nil {
+		holCal.lock = new(sync.Mutex)
+	}
+
+	holCal.lock.Lock()
+
+	defer holCal.lock.Unlock()
+
+	ePrefix += "HolidayCalendar.AddRule() "
+
+	holCalMech := holidayCalendarMechanics{}
+
+	err := holCalMech.testHolidayRuleValidity(
+		&rule,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	holCal.rules = append(holCal.rules, rule.CopyOut())
+
+	return nil
+}
+
+// GetHolidaysOnDate - Returns the holidays which either fall on,
+// or are observed on, the date of the DateTzDto input parameter.
+// The date is evaluated in the time zone of 'dTz'. If the date is
+// not a holiday, an empty array is returned.
+//
+func (holCal *HolidayCalendar) GetHolidaysOnDate(
+	dTz DateTzDto,
+	ePrefix string) (
+	holidays []HolidayDto,
+	err error) {
+
+	if holCal.lock == nil {
+		holCal.lock = new(sync.Mutex)
+	}
+
+	holCal.lock.Lock()
+
+	defer holCal.lock.Unlock()
+
+	ePrefix += "HolidayCalendar.GetHolidaysOnDate() "
+
+	holidays = make([]HolidayDto, 0)
+
+	dateTime := dTz.GetDateTimeValue()
+
+	if dateTime.IsZero() {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'dTz' has a zero date time value!\n")
+
+		return holidays, err
+	}
+
+	year := int64(dateTime.Year())
+	month := int(dateTime.Month())
+	day := dateTime.Day()
+
+	// Observed dates may fall in an adjacent year.
+	// Example: New Year's Day on Saturday, January 1st,
+	// is observed on Friday, December 31st.
+	targetYears := []int64{year}
+
+	if month == 1 {
+		targetYears = append(targetYears, year-1)
+	} else if month == 12 {
+		targetYears = append(targetYears, year+1)
+	}
+
+	holCalMech := holidayCalendarMechanics{}
+
+	for i := 0; i < len(targetYears); i++ {
+
+		var yearHolidays []HolidayDto
+
+		yearHolidays, err = holCalMech.getHolidaysInYear(
+			holCal.rules,
+			targetYears[i],
+			ePrefix)
+
+		if err != nil {
+			return holidays, err
+		}
+
+		for j := 0; j < len(yearHolidays); j++ {
+
+			if (yearHolidays[j].year == year &&
+				yearHolidays[j].month == month &&
+				yearHolidays[j].day == day) ||
+				(yearHolidays[j].observedYear == year &&
+					yearHolidays[j].observedMonth == month &&
+					yearHolidays[j].observedDay == day) {
+
+				holidays = append(holidays, yearHolidays[j])
+			}
+		}
+	}
+
+	return holidays, err
+}
+
+// GetName - Returns the name of this HolidayCalendar.
+//
+func (holCal *HolidayCalendar) GetName() string {
+
+	if holCal.lock == nil {
+		holCal.lock = new(sync.Mutex)
+	}
+
+	holCal.lock.Lock()
+
+	defer holCal.lock.Unlock()
+
+	return holCal.name
+}
+
+// GetRules - Returns a deep copy of the holiday rules
+// configured for this HolidayCalendar.
+//
+func (holCal *HolidayCalendar) GetRules() []HolidayRule {
+
+	if holCal.lock == nil {
+		holCal.lock = new(sync.Mutex)
+	}
+
+	holCal.lock.Lock()
+
+	defer holCal.lock.Unlock()
+
+	rules := make([]HolidayRule, len(holCal.rules))
+
+	for i := 0; i < len(holCal.rules); i++ {
+		rules[i] = holCal.rules[i].CopyOut()
+	}
+
+	return rules
+}
+
+// HolidaysInYear - Returns all holidays falling within the
+// specified Gregorian Calendar year, sorted by date. Input
+// parameter 'year' is formatted as an Astronomical Year.
+//
+// Holidays are selected by their actual date. Be advised that
+// the observed date of a holiday may fall in an adjacent year.
+// Example: New Year's Day 2022 fell on Saturday, January 1st,
+// and was observed on Friday, December 31st, 2021. That holiday
+// is included in the holidays for year 2022.
+//
+func (holCal *HolidayCalendar) HolidaysInYear(
+	year int64,
+	ePrefix string) (
+	holidays []HolidayDto,
+	err error) {
+
+	if holCal.lock == nil {
+		holCal.lock = new(sync.Mutex)
+	}
+
+	holCal.lock.Lock()
+
+	defer holCal.lock.Unlock()
+
+	ePrefix += "HolidayCalendar.HolidaysInYear() "
+
+	holCalMech := holidayCalendarMechanics{}
+
+	return holCalMech.getHolidaysInYear(
+		holCal.rules,
+		year,
+		ePrefix)
+}
+
+// IsHoliday - Returns 'true' if the date of the DateTzDto input
+// parameter is a holiday. A date qualifies as a holiday if it is
+// either the actual date or the observed date of a holiday. The
+// date is evaluated in the time zone of 'dTz'.
+//
+func (holCal *HolidayCalendar) IsHoliday(
+	dTz DateTzDto,
+	ePrefix string) (
+	isHoliday bool,
+	err error) {
+
+	ePrefix += "HolidayCalendar.IsHoliday() "
+
+	var holidays []HolidayDto
+
+	holidays, err = holCal.GetHolidaysOnDate(dTz, ePrefix)
+
+	if err != nil {
+		return false, err
+	}
+
+	return len(holidays) > 0, err
+}
+
+// New - Creates and returns a new, empty HolidayCalendar. Holiday
+// rules may be added using method AddRule().
+//
+func (holCal HolidayCalendar) New(
+	calendarName string) HolidayCalendar {
+
+	if holCal.lock == nil {
+		holCal.lock = new(sync.Mutex)
+	}
+
+	holCal.lock.Lock()
+
+	defer holCal.lock.Unlock()
+
+	newHolCal := HolidayCalendar{
+		name:  calendarName,
+		rules: make([]HolidayRule, 0),
+		lock:  new(sync.Mutex),
+	}
+
+	return newHolCal
+}
+
+// NewFromJSON - Creates and returns a new HolidayCalendar populated
+// with the holiday rule set contained in input parameter
+// 'jsonRuleSet'.
+//
+// Each rule in the rule set specifies the holiday name, the rule
+// type and the elements required by that rule type. Rule types and
+// observance policies are not case sensitive. If the "observed"
+// element is omitted, the holiday is observed on its actual date.
+//
+//  Rule Type            Required Elements
+//  ---------            -----------------
+//  FixedDate            "month", "day"
+//  NthWeekdayOfMonth    "month", "weekDay", "nth"
+//  LastWeekdayOfMonth   "month", "weekDay"
+//  EasterOffset         "offsetDays"
+//
+//  Observance Policies: "Actual", "NearestWeekday", "FollowingMonday"
+//
+//  Example:
+//   {
+//     "name": "US Federal Holidays",
+//     "rules": [
+//       {"name": "Independence Day", "type": "FixedDate",
+//        "month": 7, "day": 4, "observed": "NearestWeekday"},
+//       {"name": "Labor Day", "type": "NthWeekdayOfMonth",
+//        "month": 9, "weekDay": "Monday", "nth": 1}
+//     ]
+//   }
+//
+// If the JSON is malformed, contains unknown elements or defines
+// an invalid rule, an error is returned.
+//
+func (holCal HolidayCalendar) NewFromJSON(
+	jsonRuleSet []byte,
+	ePrefix string) (
+	HolidayCalendar,
+	error) {
+
+	if holCal.lock == nil {
+		holCal.lock = new(sync.Mutex)
+	}
+
+	holCal.lock.Lock()
+
+	defer holCal.lock.Unlock()
+
+	ePrefix += "HolidayCalendar.NewFromJSON() "
+
+	holCalMech := holidayCalendarMechanics{}
+
+	calendarName,
+	rules,
+	err := holCalMech.parseHolidayCalendarJSON(
+		jsonRuleSet,
+		ePrefix)
+
+	if err != nil {
+		return HolidayCalendar{}, err
+	}
+
+	newHolCal := HolidayCalendar{
+		name:  calendarName,
+		rules: rules,
+		lock:  new(sync.Mutex),
+	}
+
+	return newHolCal, nil
+}
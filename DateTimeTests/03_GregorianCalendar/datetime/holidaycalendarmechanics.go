@@ -0,0 +1,742 @@
+package datetime
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// holidayCalendarMechanics - Provides helper methods used to
+// evaluate holiday rules and load holiday rule sets. All dates
+// are computed on the Gregorian Calendar and all year values
+// are formatted as Astronomical Years.
+//
+type holidayCalendarMechanics struct {
+	lock *sync.Mutex
+}
+
+// holidayRuleJsonDto - Defines the JSON format of a single
+// holiday rule.
+//
+//  Example:
+//   {
+//     "name": "Thanksgiving Day",
+//     "type": "NthWeekdayOfMonth",
+//     "month": 11,
+//     "weekDay": "Thursday",
+//     "nth": 4,
+//     "observed": "Actual"
+//   }
+//
+type holidayRuleJsonDto struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Month      int    `json:"month,omitempty"`
+	Day        int    `json:"day,omitempty"`
+	WeekDay    string `json:"weekDay,omitempty"`
+	Nth        int    `json:"nth,omitempty"`
+	OffsetDays int    `json:"offsetDays,omitempty"`
+	Observed   string `json:"observed,omitempty"`
+}
+
+// holidayCalendarJsonDto - Defines the JSON format of a holiday
+// rule set.
+//
+type holidayCalendarJsonDto struct {
+	Name  string               `json:"name"`
+	Rules []holidayRuleJsonDto `json:"rules"`
+}
+
+// getHolidaysInYear - Evaluates a series of holiday rules for
+// a given year and returns the resulting holidays sorted by
+// date. Holidays which do not occur during the target year,
+// such as a fifth Monday which does not exist, are omitted.
+//
+func (holCalMech *holidayCalendarMechanics) getHolidaysInYear(
+	rules []HolidayRule,
+	year int64,
+	ePrefix string) (
+	holidays []HolidayDto,
+	err error) {
+
+	if holCalMech.lock == nil {
+		holCalMech.lock = new(sync.Mutex)
+	}
+
+	holCalMech.lock.Lock()
+
+	defer holCalMech.lock.Unlock()
+
+	ePrefix += "holidayCalendarMechanics.getHolidaysInYear() "
+
+	holidays = make([]HolidayDto, 0, len(rules))
+
+	holCalMech2 := holidayCalendarMechanics{}
+
+	for i := 0; i < len(rules); i++ {
+
+		var hYear int64
+		var hMonth, hDay int
+		var exists bool
+
+		hYear,
+		hMonth,
+		hDay,
+		exists,
+		err = holCalMech2.getHolidayDate(
+			&rules[i],
+			year,
+			ePrefix)
+
+		if err != nil {
+			return holidays, err
+		}
+
+		if !exists {
+			continue
+		}
+
+		newHoliday := HolidayDto{
+			name:  rules[i].name,
+			year:  hYear,
+			month: hMonth,
+			day:   hDay,
+			lock:  new(sync.Mutex),
+		}
+
+		newHoliday.observedYear,
+		newHoliday.observedMonth,
+		newHoliday.observedDay,
+		err = holCalMech2.getObservedDate(
+			rules[i].observedType,
+			hYear,
+			hMonth,
+			hDay,
+			ePrefix)
+
+		if err != nil {
+			return holidays, err
+		}
+
+		holidays = append(holidays, newHoliday)
+	}
+
+	sort.SliceStable(holidays, func(i, j int) bool {
+
+		if holidays[i].year != holidays[j].year {
+			return holidays[i].year < holidays[j].year
+		}
+
+		if holidays[i].month != holidays[j].month {
+			return holidays[i].month < holidays[j].month
+		}
+
+		return holidays[i].day < holidays[j].day
+	})
+
+	return holidays, err
+}
+
+// getHolidayDate - Computes the date of a holiday for a given
+// year. If the holiday does not occur during the year, the
+// returned 'exists' flag is set to 'false'.
+//
+func (holCalMech *holidayCalendarMechanics) getHolidayDate(
+	rule *HolidayRule,
+	year int64,
+	ePrefix string) (
+	holidayYear int64,
+	holidayMonth int,
+	holidayDay int,
+	exists bool,
+	err error) {
+
+	ePrefix += "holidayCalendarMechanics.getHolidayDate() "
+
+	holidayYear = year
+
+	holCalMech2 := holidayCalendarMechanics{}
+
+	var daysInMonth int
+
+	if rule.ruleType != HolidayRuleType(0).EasterOffset() {
+
+		daysInMonth, err = holCalMech2.getDaysInMonth(
+			year,
+			rule.month,
+			ePrefix)
+
+		if err != nil {
+			return holidayYear, holidayMonth, holidayDay, exists, err
+		}
+	}
+
+	switch rule.ruleType {
+
+	case HolidayRuleType(0).FixedDate():
+
+		if rule.day > daysInMonth {
+			// February 29th in a standard year
+			return holidayYear, holidayMonth, holidayDay, false, err
+		}
+
+		holidayMonth = rule.month
+		holidayDay = rule.day
+
+	case HolidayRuleType(0).NthWeekdayOfMonth():
+
+		var firstDayOfWeek ISO8601DayOfWeekNo
+
+		firstDayOfWeek, err = holCalMech2.getISODayOfWeekNo(
+			year,
+			rule.month,
+			1,
+			ePrefix)
+
+		if err != nil {
+			return holidayYear, holidayMonth, holidayDay, exists, err
+		}
+
+		holidayDay = 1 +
+			(int(rule.weekDay) - int(firstDayOfWeek) + 7) % 7 +
+			(rule.nthWeek - 1) * 7
+
+		if holidayDay > daysInMonth {
+			return holidayYear, 0, 0, false, err
+		}
+
+		holidayMonth = rule.month
+
+	case HolidayRuleType(0).LastWeekdayOfMonth():
+
+		var lastDayOfWeek ISO8601DayOfWeekNo
+
+		lastDayOfWeek, err = holCalMech2.getISODayOfWeekNo(
+			year,
+			rule.month,
+			daysInMonth,
+			ePrefix)
+
+		if err != nil {
+			return holidayYear, holidayMonth, holidayDay, exists, err
+		}
+
+		holidayMonth = rule.month
+
+		holidayDay = daysInMonth -
+			(int(lastDayOfWeek) - int(rule.weekDay) + 7) % 7
+
+	case HolidayRuleType(0).EasterOffset():
+
+		calEcclesMech := calendarEcclesiasticalMechanics{}
+
+		holidayYear,
+		holidayMonth,
+		holidayDay,
+		err = calEcclesMech.getMovableFeast(
+			CalendarSpec(0).Gregorian(),
+			year,
+			int64(rule.easterOffsetDays),
+			ePrefix)
+
+		if err != nil {
+			return holidayYear, holidayMonth, holidayDay, exists, err
+		}
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Holiday Rule Type is INVALID!\n" +
+			"Holiday Name='%v'\n" +
+			"Rule Type='%v'\n",
+			rule.name,
+			rule.ruleType.XValueInt())
+
+		return holidayYear, holidayMonth, holidayDay, exists, err
+	}
+
+	return holidayYear, holidayMonth, holidayDay, true, err
+}
+
+// getObservedDate - Computes the date on which a holiday is
+// observed according to the weekend observance policy specified
+// by input parameter 'observedType'.
+//
+func (holCalMech *holidayCalendarMechanics) getObservedDate(
+	observedType HolidayObservedType,
+	year int64,
+	month int,
+	day int,
+	ePrefix string) (
+	observedYear int64,
+	observedMonth int,
+	observedDay int,
+	err error) {
+
+	ePrefix += "holidayCalendarMechanics.getObservedDate() "
+
+	observedYear = year
+	observedMonth = month
+	observedDay = day
+
+	if observedType == HolidayObservedType(0).Actual() {
+		return observedYear, observedMonth, observedDay, err
+	}
+
+	holCalMech2 := holidayCalendarMechanics{}
+
+	var dayOfWeek ISO8601DayOfWeekNo
+
+	dayOfWeek, err = holCalMech2.getISODayOfWeekNo(
+		year,
+		month,
+		day,
+		ePrefix)
+
+	if err != nil {
+		return observedYear, observedMonth, observedDay, err
+	}
+
+	var offsetDays int64
+
+	switch observedType {
+
+	case HolidayObservedType(0).NearestWeekday():
+
+		if dayOfWeek == ISO8601DayOfWeekNo(0).Saturday() {
+			offsetDays = -1
+		} else if dayOfWeek == ISO8601DayOfWeekNo(0).Sunday() {
+			offsetDays = 1
+		}
+
+	case HolidayObservedType(0).FollowingMonday():
+
+		if dayOfWeek == ISO8601DayOfWeekNo(0).Saturday() {
+			offsetDays = 2
+		} else if dayOfWeek == ISO8601DayOfWeekNo(0).Sunday() {
+			offsetDays = 1
+		}
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'observedType' is INVALID!\n" +
+			"observedType='%v'\n",
+			observedType.XValueInt())
+
+		return observedYear, observedMonth, observedDay, err
+	}
+
+	if offsetDays == 0 {
+		return observedYear, observedMonth, observedDay, err
+	}
+
+	return holCalMech2.addDays(
+		year,
+		month,
+		day,
+		offsetDays,
+		ePrefix)
+}
+
+// addDays - Adds a number of days to a Gregorian Calendar date
+// and returns the resulting date. Negative values for input
+// parameter 'days' are subtracted from the date.
+//
+func (holCalMech *holidayCalendarMechanics) addDays(
+	year int64,
+	month int,
+	day int,
+	days int64,
+	ePrefix string) (
+	newYear int64,
+	newMonth int,
+	newDay int,
+	err error) {
+
+	ePrefix += "holidayCalendarMechanics.addDays() "
+
+	calGregUtil := CalendarGregorianUtility{}
+
+	var julianDayNoDto JulianDayNoDto
+
+	julianDayNoDto, err = calGregUtil.GetJulianDayNumber(
+		year,
+		month,
+		day,
+		12,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return newYear, newMonth, newDay, err
+	}
+
+	var julianDayNo int64
+
+	julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+	if err != nil {
+		return newYear, newMonth, newDay, err
+	}
+
+	julianDayNoDto, err = JulianDayNoDto{}.New(
+		julianDayNo + days,
+		big.NewFloat(0.0),
+		ePrefix)
+
+	if err != nil {
+		return newYear, newMonth, newDay, err
+	}
+
+	var dateTimeDto ADateTimeDto
+
+	dateTimeDto, err = calGregUtil.DateTimeFromJulianDateTime(
+		julianDayNoDto,
+		ePrefix)
+
+	if err != nil {
+		return newYear, newMonth, newDay, err
+	}
+
+	newYear = dateTimeDto.GetYearAstronomical()
+	newMonth = dateTimeDto.GetMonth()
+	newDay = dateTimeDto.GetDay()
+
+	return newYear, newMonth, newDay, err
+}
+
+// getDaysInMonth - Returns the number of days in a month on the
+// Gregorian Calendar.
+//
+func (holCalMech *holidayCalendarMechanics) getDaysInMonth(
+	year int64,
+	month int,
+	ePrefix string) (
+	daysInMonth int,
+	err error) {
+
+	ePrefix += "holidayCalendarMechanics.getDaysInMonth() "
+
+	if month < 1 || month > 12 {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'month' is INVALID!\n" +
+			"month='%v'\n", month)
+
+		return daysInMonth, err
+	}
+
+	gregCalBData := CalendarGregorianBaseData{}
+
+	var isLeapYear bool
+
+	isLeapYear, err = gregCalBData.IsLeapYear(
+		year,
+		CalendarYearNumType(0).Astronomical(),
+		ePrefix)
+
+	if err != nil {
+		return daysInMonth, err
+	}
+
+	if isLeapYear {
+		daysInMonth = gregCalBData.GetLeapYearMonthDays()[month]
+	} else {
+		daysInMonth = gregCalBData.GetStandardYearMonthDays()[month]
+	}
+
+	return daysInMonth, err
+}
+
+// getISODayOfWeekNo - Returns the ISO 8601 day of the week
+// number for a Gregorian Calendar date.
+//
+func (holCalMech *holidayCalendarMechanics) getISODayOfWeekNo(
+	year int64,
+	month int,
+	day int,
+	ePrefix string) (
+	isoDayOfWeekNo ISO8601DayOfWeekNo,
+	err error) {
+
+	ePrefix += "holidayCalendarMechanics.getISODayOfWeekNo() "
+
+	calGregUtil := CalendarGregorianUtility{}
+
+	var julianDayNoDto JulianDayNoDto
+
+	julianDayNoDto, err = calGregUtil.GetJulianDayNumber(
+		year,
+		month,
+		day,
+		12,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return isoDayOfWeekNo, err
+	}
+
+	gregCalBData := CalendarGregorianBaseData{}
+
+	return gregCalBData.GetISODayOfWeekNo(
+		julianDayNoDto,
+		ePrefix)
+}
+
+// parseHolidayCalendarJSON - Parses a holiday rule set formatted
+// in JSON and returns the rule set name and the component holiday
+// rules.
+//
+//  Example:
+//   {
+//     "name": "US Federal Holidays",
+//     "rules": [
+//       {"name": "New Year's Day", "type": "FixedDate",
+//        "month": 1, "day": 1, "observed": "NearestWeekday"},
+//       {"name": "Memorial Day", "type": "LastWeekdayOfMonth",
+//        "month": 5, "weekDay": "Monday"},
+//       {"name": "Thanksgiving Day", "type": "NthWeekdayOfMonth",
+//        "month": 11, "weekDay": "Thursday", "nth": 4},
+//       {"name": "Good Friday", "type": "EasterOffset",
+//        "offsetDays": -2}
+//     ]
+//   }
+//
+// Rule type names and observance policy names are not case
+// sensitive. If the "observed" element is omitted, the holiday
+// is observed on its actual date.
+//
+func (holCalMech *holidayCalendarMechanics) parseHolidayCalendarJSON(
+	jsonRuleSet []byte,
+	ePrefix string) (
+	calendarName string,
+	rules []HolidayRule,
+	err error) {
+
+	if holCalMech.lock == nil {
+		holCalMech.lock = new(sync.Mutex)
+	}
+
+	holCalMech.lock.Lock()
+
+	defer holCalMech.lock.Unlock()
+
+	ePrefix += "holidayCalendarMechanics.parseHolidayCalendarJSON() "
+
+	if len(jsonRuleSet) == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'jsonRuleSet' is empty!\n")
+
+		return calendarName, rules, err
+	}
+
+	calJsonDto := holidayCalendarJsonDto{}
+
+	decoder := json.NewDecoder(bytes.NewReader(jsonRuleSet))
+
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&calJsonDto)
+
+	if err != nil {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Failed to decode holiday rule set JSON.\n" +
+			"Error='%v'\n", err.Error())
+
+		return calendarName, rules, err
+	}
+
+	calendarName = calJsonDto.Name
+
+	rules = make([]HolidayRule, 0, len(calJsonDto.Rules))
+
+	holCalMech2 := holidayCalendarMechanics{}
+
+	for i := 0; i < len(calJsonDto.Rules); i++ {
+
+		ruleJson := calJsonDto.Rules[i]
+
+		rulePrefix := fmt.Sprintf(ePrefix + "rules[%v] ", i)
+
+		newRule := HolidayRule{
+			name:             ruleJson.Name,
+			month:            ruleJson.Month,
+			day:              ruleJson.Day,
+			nthWeek:          ruleJson.Nth,
+			easterOffsetDays: ruleJson.OffsetDays,
+			observedType:     HolidayObservedType(0).Actual(),
+			lock:             new(sync.Mutex),
+		}
+
+		newRule.ruleType, err =
+			HolidayRuleType(0).XParseString(ruleJson.Type, false)
+
+		if err != nil {
+			err = fmt.Errorf(rulePrefix + "\n" +
+				"Error: Holiday rule 'type' is INVALID!\n" +
+				"Holiday Name='%v'\n" +
+				"Error='%v'\n",
+				ruleJson.Name,
+				err.Error())
+
+			return calendarName, rules, err
+		}
+
+		if len(ruleJson.WeekDay) > 0 {
+
+			newRule.weekDay, err =
+				ISO8601DayOfWeekNo(0).XParseString(ruleJson.WeekDay)
+
+			if err != nil {
+				err = fmt.Errorf(rulePrefix + "\n" +
+					"Error: Holiday rule 'weekDay' is INVALID!\n" +
+					"Holiday Name='%v'\n" +
+					"Error='%v'\n",
+					ruleJson.Name,
+					err.Error())
+
+				return calendarName, rules, err
+			}
+		}
+
+		if len(ruleJson.Observed) > 0 {
+
+			newRule.observedType, err =
+				HolidayObservedType(0).XParseString(ruleJson.Observed, false)
+
+			if err != nil {
+				err = fmt.Errorf(rulePrefix + "\n" +
+					"Error: Holiday rule 'observed' is INVALID!\n" +
+					"Holiday Name='%v'\n" +
+					"Error='%v'\n",
+					ruleJson.Name,
+					err.Error())
+
+				return calendarName, rules, err
+			}
+		}
+
+		err = holCalMech2.testHolidayRuleValidity(
+			&newRule,
+			rulePrefix)
+
+		if err != nil {
+			return calendarName, rules, err
+		}
+
+		rules = append(rules, newRule)
+	}
+
+	return calendarName, rules, err
+}
+
+// testHolidayRuleValidity - Tests a HolidayRule instance for
+// validity. If the rule is invalid, an error is returned.
+//
+func (holCalMech *holidayCalendarMechanics) testHolidayRuleValidity(
+	rule *HolidayRule,
+	ePrefix string) error {
+
+	if holCalMech.lock == nil {
+		holCalMech.lock = new(sync.Mutex)
+	}
+
+	holCalMech.lock.Lock()
+
+	defer holCalMech.lock.Unlock()
+
+	ePrefix += "holidayCalendarMechanics.testHolidayRuleValidity() "
+
+	if rule == nil {
+		return errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'rule' is a nil pointer!\n")
+	}
+
+	if len(rule.name) == 0 {
+		return errors.New(ePrefix + "\n" +
+			"Error: The holiday name is an empty string!\n")
+	}
+
+	if !rule.observedType.XIsValid() {
+		return fmt.Errorf(ePrefix + "\n" +
+			"Error: The holiday observed type is INVALID!\n" +
+			"Holiday Name='%v'\n" +
+			"observedType='%v'\n",
+			rule.name,
+			rule.observedType.XValueInt())
+	}
+
+	if rule.ruleType != HolidayRuleType(0).EasterOffset() &&
+		(rule.month < 1 || rule.month > 12) {
+		return fmt.Errorf(ePrefix + "\n" +
+			"Error: The holiday month is INVALID!\n" +
+			"Holiday Name='%v'\n" +
+			"month='%v'\n",
+			rule.name,
+			rule.month)
+	}
+
+	switch rule.ruleType {
+
+	case HolidayRuleType(0).FixedDate():
+
+		// Leap year month days permit February 29th
+		gregCalBData := CalendarGregorianBaseData{}
+
+		maxDays := gregCalBData.GetLeapYearMonthDays()[rule.month]
+
+		if rule.day < 1 || rule.day > maxDays {
+			return fmt.Errorf(ePrefix + "\n" +
+				"Error: The holiday day is INVALID!\n" +
+				"Holiday Name='%v'\n" +
+				"month='%v' day='%v'\n",
+				rule.name,
+				rule.month,
+				rule.day)
+		}
+
+	case HolidayRuleType(0).NthWeekdayOfMonth(),
+		HolidayRuleType(0).LastWeekdayOfMonth():
+
+		if !rule.weekDay.XIsValid() {
+			return fmt.Errorf(ePrefix + "\n" +
+				"Error: The holiday day of the week is INVALID!\n" +
+				"Holiday Name='%v'\n" +
+				"weekDay='%v'\n",
+				rule.name,
+				rule.weekDay.XValueInt())
+		}
+
+		if rule.ruleType == HolidayRuleType(0).NthWeekdayOfMonth() &&
+			(rule.nthWeek < 1 || rule.nthWeek > 5) {
+			return fmt.Errorf(ePrefix + "\n" +
+				"Error: The holiday week number is INVALID!\n" +
+				"The valid range is '1' through '5'.\n" +
+				"Holiday Name='%v'\n" +
+				"nthWeek='%v'\n",
+				rule.name,
+				rule.nthWeek)
+		}
+
+	case HolidayRuleType(0).EasterOffset():
+
+		// Any offset is valid
+
+	default:
+		return fmt.Errorf(ePrefix + "\n" +
+			"Error: The holiday rule type is INVALID!\n" +
+			"Holiday Name='%v'\n" +
+			"ruleType='%v'\n",
+			rule.name,
+			rule.ruleType.XValueInt())
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package datetime
+
+import (
+	"fmt"
+	"sync"
+)
+
+// HolidayDto - Describes a single occurrence of a holiday computed
+// from a HolidayRule. The holiday date and the date on which the
+// holiday is observed are both expressed on the Gregorian Calendar.
+// Year values are formatted as Astronomical Years.
+//
+// The observed date differs from the holiday date only when the
+// holiday falls on a weekend and the governing HolidayRule specifies
+// a weekend observance policy.
+//
+type HolidayDto struct {
+	name          string
+	year          int64
+	month         int
+	day           int
+	observedYear  int64
+	observedMonth int
+	observedDay   int
+	lock          *sync.Mutex
+}
+
+// GetDate - Returns the actual date of the holiday.
+//
+func (holDto *HolidayDto) GetDate() (
+	year int64,
+	month int,
+	day int) {
+
+	if holDto.lock == nil {
+		holDto.lock = new(sync.Mutex)
+	}
+
+	holDto.lock.Lock()
+
+	defer holDto.lock.Unlock()
+
+	return holDto.year, holDto.month, holDto.day
+}
+
+// GetName - Returns the name of the holiday.
+//
+func (holDto *HolidayDto) GetName() string {
+
+	if holDto.lock == nil {
+		holDto.lock = new(sync.Mutex)
+	}
+
+	holDto.lock.Lock()
+
+	defer holDto.lock.Unlock()
+
+	return holDto.name
+}
+
+// GetObservedDate - Returns the date on which the holiday is
+// observed.
+//
+func (holDto *HolidayDto) GetObservedDate() (
+	year int64,
+	month int,
+	day int) {
+
+	if holDto.lock == nil {
+		holDto.lock = new(sync.Mutex)
+	}
+
+	holDto.lock.Lock()
+
+	defer holDto.lock.Unlock()
+
+	return holDto.observedYear, holDto.observedMonth, holDto.observedDay
+}
+
+// String - Returns a text description of the holiday.
+//
+//  Example:
+//    "2021-07-04 Independence Day (Observed 2021-07-05)"
+//
+func (holDto *HolidayDto) String() string {
+
+	if holDto.lock == nil {
+		holDto.lock = new(sync.Mutex)
+	}
+
+	holDto.lock.Lock()
+
+	defer holDto.lock.Unlock()
+
+	str := fmt.Sprintf("%04d-%02d-%02d %v",
+		holDto.year,
+		holDto.month,
+		holDto.day,
+		holDto.name)
+
+	if holDto.year != holDto.observedYear ||
+		holDto.month != holDto.observedMonth ||
+		holDto.day != holDto.observedDay {
+
+		str += fmt.Sprintf(" (Observed %04d-%02d-%02d)",
+			holDto.observedYear,
+			holDto.observedMonth,
+			holDto.observedDay)
+	}
+
+	return str
+}
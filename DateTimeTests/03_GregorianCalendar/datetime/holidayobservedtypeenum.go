@@ -0,0 +1,319 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mHolidayObservedTypeStringToCode = map[string]HolidayObservedType{
+	"None"            : HolidayObservedType(0),
+	"Actual"          : HolidayObservedType(1),
+	"NearestWeekday"  : HolidayObservedType(2),
+	"FollowingMonday" : HolidayObservedType(3),
+}
+
+var mHolidayObservedTypeLwrCaseStringToCode = map[string]HolidayObservedType{
+	"none"            : HolidayObservedType(0),
+	"actual"          : HolidayObservedType(1),
+	"nearestweekday"  : HolidayObservedType(2),
+	"followingmonday" : HolidayObservedType(3),
+}
+
+var mHolidayObservedTypeCodeToString = map[HolidayObservedType]string{
+	HolidayObservedType(0) : "None",
+	HolidayObservedType(1) : "Actual",
+	HolidayObservedType(2) : "NearestWeekday",
+	HolidayObservedType(3) : "FollowingMonday",
+}
+
+// HolidayObservedType - An enumeration of the policies used to
+// compute the observed date of a holiday which falls on a weekend.
+//
+// Since Go does not directly support enumerations, the 'HolidayObservedType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'HolidayObservedType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of observance
+// policies. These methods are listed as follows:
+//
+//
+// None            (0) - Signals that the Holiday Observed Type is not
+//                       initialized. This is an error condition.
+//
+// Actual          (1) - The holiday is always observed on its actual
+//                       date, even if that date falls on a weekend.
+//
+// NearestWeekday  (2) - A holiday falling on a Saturday is observed on
+//                       the preceding Friday. A holiday falling on a
+//                       Sunday is observed on the following Monday.
+//                       This is the policy applied to United States
+//                       Federal Holidays.
+//
+// FollowingMonday (3) - A holiday falling on a Saturday or Sunday is
+//                       observed on the following Monday.
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'HolObservedType'. Example: HolObservedType.NearestWeekday()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: HolidayObservedType(0).NearestWeekday()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the HolidayObservedType methods in alphabetical order. Be advised that all
+// 'HolidayObservedType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type HolidayObservedType int
+
+var lockHolidayObservedType sync.Mutex
+
+// None - Signals that the HolidayObservedType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (holObservedType HolidayObservedType) None() HolidayObservedType {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	return HolidayObservedType(0)
+}
+
+// Actual - Signals that the holiday is always observed on its
+// actual date, even if that date falls on a weekend.
+//
+// This method is part of the standard enumeration.
+//
+func (holObservedType HolidayObservedType) Actual() HolidayObservedType {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	return HolidayObservedType(1)
+}
+
+// NearestWeekday - Signals that a holiday falling on a Saturday
+// is observed on the preceding Friday and a holiday falling on a
+// Sunday is observed on the following Monday.
+//
+// This method is part of the standard enumeration.
+//
+func (holObservedType HolidayObservedType) NearestWeekday() HolidayObservedType {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	return HolidayObservedType(2)
+}
+
+// FollowingMonday - Signals that a holiday falling on a Saturday
+// or Sunday is observed on the following Monday.
+//
+// This method is part of the standard enumeration.
+//
+func (holObservedType HolidayObservedType) FollowingMonday() HolidayObservedType {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	return HolidayObservedType(3)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'HolidayObservedType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= HolidayObservedType(0).NearestWeekday()
+// str := t.String()
+//     str is now equal to 'NearestWeekday'
+//
+func (holObservedType HolidayObservedType) String() string {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	result, ok := mHolidayObservedTypeCodeToString[holObservedType]
+
+	if !ok {
+		return "Error: Holiday Observed Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current HolidayObservedType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  observedType := HolidayObservedType(0).NearestWeekday()
+//
+//  isValid := observedType.XIsValid()
+//
+func (holObservedType HolidayObservedType) XIsValid() bool {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	if holObservedType > 3 ||
+		holObservedType < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of HolidayObservedType is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'actual' will NOT
+//                        match the enumeration name, 'Actual'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'actual'
+//                        will match match enumeration name 'Actual'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// HolidayObservedType - Upon successful completion, this method will return
+//                       a new instance of HolidayObservedType set to the value
+//                       of the enumeration matched by the string search performed
+//                       on input parameter, 'valueString'.
+//
+// error               - If this method completes successfully, the returned error
+//                       Type is set equal to 'nil'. If an error condition is
+//                       encountered, this method will return an error type which
+//                       encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := HolidayObservedType(0).XParseString("NearestWeekday", true)
+//
+//     t is now equal to HolidayObservedType(0).NearestWeekday()
+//
+func (holObservedType HolidayObservedType) XParseString(
+	valueString string,
+	caseSensitive bool) (HolidayObservedType, error) {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	ePrefix := "HolidayObservedType.XParseString() "
+
+	if len(valueString) < 4 {
+		return HolidayObservedType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var observedType HolidayObservedType
+
+	if caseSensitive {
+
+		observedType, ok = mHolidayObservedTypeStringToCode[valueString]
+
+	} else {
+
+		observedType, ok =
+			mHolidayObservedTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return HolidayObservedType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid HolidayObservedType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return observedType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// HolidayObservedType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (holObservedType HolidayObservedType) XValue() HolidayObservedType {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	return holObservedType
+}
+
+// XValueInt - This method returns the integer value of the current
+// HolidayObservedType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (holObservedType HolidayObservedType) XValueInt() int {
+
+	lockHolidayObservedType.Lock()
+
+	defer lockHolidayObservedType.Unlock()
+
+	return int(holObservedType)
+}
+
+// HolObservedType - public global variable of
+// type HolidayObservedType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing HolidayObservedType values.
+//
+// Usage:
+// HolObservedType.None(),
+// HolObservedType.Actual(),
+// HolObservedType.NearestWeekday(),
+// HolObservedType.FollowingMonday(),
+//
+var HolObservedType HolidayObservedType
@@ -0,0 +1,400 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// HolidayRule - Defines the rule used to compute the date of a
+// single holiday within a given year. Holiday rules are evaluated
+// on the Gregorian Calendar.
+//
+// Four rule types are supported:
+//
+//  FixedDate          - The holiday falls on the same month and day
+//                       every year. Example: July 4th.
+//
+//  NthWeekdayOfMonth  - The holiday falls on the nth occurrence of a
+//                       day of the week within a month. Example: The
+//                       4th Thursday of November.
+//
+//  LastWeekdayOfMonth - The holiday falls on the last occurrence of a
+//                       day of the week within a month. Example: The
+//                       last Monday of May.
+//
+//  EasterOffset       - The holiday falls a fixed number of days before
+//                       or after Western (Gregorian) Easter Sunday.
+//                       Example: Good Friday, offset -2 days.
+//
+// In addition, each rule specifies how the holiday is observed when
+// it falls on a weekend. Reference type 'HolidayObservedType'.
+//
+// HolidayRule instances are grouped together in a HolidayCalendar.
+//
+type HolidayRule struct {
+	name             string              // The name of the holiday
+	ruleType         HolidayRuleType     // The type of rule used to compute the holiday date
+	month            int                 // Month number. Not used for EasterOffset rules.
+	day              int                 // Day number. Used only for FixedDate rules.
+	weekDay          ISO8601DayOfWeekNo  // Day of week. Used for NthWeekdayOfMonth and LastWeekdayOfMonth rules.
+	nthWeek          int                 // Occurrence 1-5. Used only for NthWeekdayOfMonth rules.
+	easterOffsetDays int                 // Days from Easter Sunday. Used only for EasterOffset rules.
+	observedType     HolidayObservedType // Weekend observance policy
+	lock             *sync.Mutex
+}
+
+// CopyOut - Returns a deep copy of the current HolidayRule
+// instance.
+//
+func (holRule *HolidayRule) CopyOut() HolidayRule {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	newHolRule := HolidayRule{
+		name:             holRule.name,
+		ruleType:         holRule.ruleType,
+		month:            holRule.month,
+		day:              holRule.day,
+		weekDay:          holRule.weekDay,
+		nthWeek:          holRule.nthWeek,
+		easterOffsetDays: holRule.easterOffsetDays,
+		observedType:     holRule.observedType,
+		lock:             new(sync.Mutex),
+	}
+
+	return newHolRule
+}
+
+// GetDay - Returns the day number. This value is only
+// used by FixedDate rules.
+//
+func (holRule *HolidayRule) GetDay() int {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.day
+}
+
+// GetEasterOffsetDays - Returns the number of days from
+// Easter Sunday. This value is only used by EasterOffset
+// rules.
+//
+func (holRule *HolidayRule) GetEasterOffsetDays() int {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.easterOffsetDays
+}
+
+// GetMonth - Returns the month number. This value is not
+// used by EasterOffset rules.
+//
+func (holRule *HolidayRule) GetMonth() int {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.month
+}
+
+// GetName - Returns the name of the holiday.
+//
+func (holRule *HolidayRule) GetName() string {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.name
+}
+
+// GetNthWeek - Returns the occurrence number of the day of the
+// week within the month. This value is only used by
+// NthWeekdayOfMonth rules.
+//
+func (holRule *HolidayRule) GetNthWeek() int {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.nthWeek
+}
+
+// GetObservedType - Returns the weekend observance policy
+// applied to this holiday.
+//
+func (holRule *HolidayRule) GetObservedType() HolidayObservedType {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.observedType
+}
+
+// GetRuleType - Returns the type of rule used to compute
+// the holiday date.
+//
+func (holRule *HolidayRule) GetRuleType() HolidayRuleType {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.ruleType
+}
+
+// GetWeekDay - Returns the ISO 8601 day of the week number.
+// This value is only used by NthWeekdayOfMonth and
+// LastWeekdayOfMonth rules.
+//
+func (holRule *HolidayRule) GetWeekDay() ISO8601DayOfWeekNo {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	return holRule.weekDay
+}
+
+// NewEasterOffset - Creates and returns a new HolidayRule for a
+// holiday falling a fixed number of days before or after Western
+// (Gregorian) Easter Sunday.
+//
+//  Example:
+//    Good Friday      easterOffsetDays = -2
+//    Easter Monday    easterOffsetDays =  1
+//
+func (holRule HolidayRule) NewEasterOffset(
+	name string,
+	easterOffsetDays int,
+	observedType HolidayObservedType,
+	ePrefix string) (
+	HolidayRule,
+	error) {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	ePrefix += "HolidayRule.NewEasterOffset() "
+
+	newHolRule := HolidayRule{
+		name:             name,
+		ruleType:         HolidayRuleType(0).EasterOffset(),
+		easterOffsetDays: easterOffsetDays,
+		observedType:     observedType,
+		lock:             new(sync.Mutex),
+	}
+
+	holCalMech := holidayCalendarMechanics{}
+
+	err := holCalMech.testHolidayRuleValidity(
+		&newHolRule,
+		ePrefix)
+
+	if err != nil {
+		return HolidayRule{}, err
+	}
+
+	return newHolRule, nil
+}
+
+// NewFixedDate - Creates and returns a new HolidayRule for a
+// holiday falling on the same month and day every year.
+//
+// If the month and day are February 29th, the holiday only occurs
+// in leap years.
+//
+//  Example:
+//    Independence Day  month = 7  day = 4
+//
+func (holRule HolidayRule) NewFixedDate(
+	name string,
+	month int,
+	day int,
+	observedType HolidayObservedType,
+	ePrefix string) (
+	HolidayRule,
+	error) {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	ePrefix += "HolidayRule.NewFixedDate() "
+
+	newHolRule := HolidayRule{
+		name:         name,
+		ruleType:     HolidayRuleType(0).FixedDate(),
+		month:        month,
+		day:          day,
+		observedType: observedType,
+		lock:         new(sync.Mutex),
+	}
+
+	holCalMech := holidayCalendarMechanics{}
+
+	err := holCalMech.testHolidayRuleValidity(
+		&newHolRule,
+		ePrefix)
+
+	if err != nil {
+		return HolidayRule{}, err
+	}
+
+	return newHolRule, nil
+}
+
+// NewLastWeekdayOfMonth - Creates and returns a new HolidayRule for
+// a holiday falling on the last occurrence of a day of the week
+// within a month.
+//
+//  Example:
+//    Memorial Day  month = 5  weekDay = ISO8601DayOfWeekNo(0).Monday()
+//
+func (holRule HolidayRule) NewLastWeekdayOfMonth(
+	name string,
+	month int,
+	weekDay ISO8601DayOfWeekNo,
+	observedType HolidayObservedType,
+	ePrefix string) (
+	HolidayRule,
+	error) {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	ePrefix += "HolidayRule.NewLastWeekdayOfMonth() "
+
+	newHolRule := HolidayRule{
+		name:         name,
+		ruleType:     HolidayRuleType(0).LastWeekdayOfMonth(),
+		month:        month,
+		weekDay:      weekDay,
+		observedType: observedType,
+		lock:         new(sync.Mutex),
+	}
+
+	holCalMech := holidayCalendarMechanics{}
+
+	err := holCalMech.testHolidayRuleValidity(
+		&newHolRule,
+		ePrefix)
+
+	if err != nil {
+		return HolidayRule{}, err
+	}
+
+	return newHolRule, nil
+}
+
+// NewNthWeekdayOfMonth - Creates and returns a new HolidayRule for
+// a holiday falling on the nth occurrence of a day of the week
+// within a month. The valid range for 'nthWeek' is 1 through 5.
+// If the fifth occurrence does not exist in a given month, the
+// holiday does not occur in that year.
+//
+//  Example:
+//    Thanksgiving Day  month = 11
+//                      weekDay = ISO8601DayOfWeekNo(0).Thursday()
+//                      nthWeek = 4
+//
+func (holRule HolidayRule) NewNthWeekdayOfMonth(
+	name string,
+	month int,
+	weekDay ISO8601DayOfWeekNo,
+	nthWeek int,
+	observedType HolidayObservedType,
+	ePrefix string) (
+	HolidayRule,
+	error) {
+
+	if holRule.lock == nil {
+		holRule.lock = new(sync.Mutex)
+	}
+
+	holRule.lock.Lock()
+
+	defer holRule.lock.Unlock()
+
+	ePrefix += "HolidayRule.NewNthWeekdayOfMonth() "
+
+	newHolRule := HolidayRule{
+		name:         name,
+		ruleType:     HolidayRuleType(0).NthWeekdayOfMonth(),
+		month:        month,
+		weekDay:      weekDay,
+		nthWeek:      nthWeek,
+		observedType: observedType,
+		lock:         new(sync.Mutex),
+	}
+
+	holCalMech := holidayCalendarMechanics{}
+
+	err := holCalMech.testHolidayRuleValidity(
+		&newHolRule,
+		ePrefix)
+
+	if err != nil {
+		return HolidayRule{}, err
+	}
+
+	return newHolRule, nil
+}
@@ -0,0 +1,343 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mHolidayRuleTypeStringToCode = map[string]HolidayRuleType{
+	"None"               : HolidayRuleType(0),
+	"FixedDate"          : HolidayRuleType(1),
+	"NthWeekdayOfMonth"  : HolidayRuleType(2),
+	"LastWeekdayOfMonth" : HolidayRuleType(3),
+	"EasterOffset"       : HolidayRuleType(4),
+}
+
+var mHolidayRuleTypeLwrCaseStringToCode = map[string]HolidayRuleType{
+	"none"               : HolidayRuleType(0),
+	"fixeddate"          : HolidayRuleType(1),
+	"nthweekdayofmonth"  : HolidayRuleType(2),
+	"lastweekdayofmonth" : HolidayRuleType(3),
+	"easteroffset"       : HolidayRuleType(4),
+}
+
+var mHolidayRuleTypeCodeToString = map[HolidayRuleType]string{
+	HolidayRuleType(0) : "None",
+	HolidayRuleType(1) : "FixedDate",
+	HolidayRuleType(2) : "NthWeekdayOfMonth",
+	HolidayRuleType(3) : "LastWeekdayOfMonth",
+	HolidayRuleType(4) : "EasterOffset",
+}
+
+// HolidayRuleType - An enumeration of the rule types used to
+// compute the date of a holiday within a given year. Holiday
+// rules are evaluated on the Gregorian Calendar.
+//
+// Since Go does not directly support enumerations, the 'HolidayRuleType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'HolidayRuleType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of holiday rule
+// types. These methods are listed as follows:
+//
+//
+// None               (0) - Signals that the Holiday Rule Type is not
+//                          initialized. This is an error condition.
+//
+// FixedDate          (1) - The holiday falls on the same month and day
+//                          every year. Example: July 4th.
+//
+// NthWeekdayOfMonth  (2) - The holiday falls on the nth occurrence of a
+//                          day of the week within a month. Example: The
+//                          4th Thursday of November.
+//
+// LastWeekdayOfMonth (3) - The holiday falls on the last occurrence of a
+//                          day of the week within a month. Example: The
+//                          last Monday of May.
+//
+// EasterOffset       (4) - The holiday falls a fixed number of days
+//                          before or after Western (Gregorian) Easter
+//                          Sunday. Example: Good Friday falls two days
+//                          before Easter Sunday.
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'HolRuleType'. Example: HolRuleType.FixedDate()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: HolidayRuleType(0).FixedDate()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the HolidayRuleType methods in alphabetical order. Be advised that all
+// 'HolidayRuleType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type HolidayRuleType int
+
+var lockHolidayRuleType sync.Mutex
+
+// None - Signals that the HolidayRuleType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (holRuleType HolidayRuleType) None() HolidayRuleType {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	return HolidayRuleType(0)
+}
+
+// FixedDate - Signals that the holiday falls on the same
+// month and day every year. Example: July 4th.
+//
+// This method is part of the standard enumeration.
+//
+func (holRuleType HolidayRuleType) FixedDate() HolidayRuleType {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	return HolidayRuleType(1)
+}
+
+// NthWeekdayOfMonth - Signals that the holiday falls on the
+// nth occurrence of a day of the week within a month.
+// Example: The 4th Thursday of November.
+//
+// This method is part of the standard enumeration.
+//
+func (holRuleType HolidayRuleType) NthWeekdayOfMonth() HolidayRuleType {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	return HolidayRuleType(2)
+}
+
+// LastWeekdayOfMonth - Signals that the holiday falls on the
+// last occurrence of a day of the week within a month.
+// Example: The last Monday of May.
+//
+// This method is part of the standard enumeration.
+//
+func (holRuleType HolidayRuleType) LastWeekdayOfMonth() HolidayRuleType {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	return HolidayRuleType(3)
+}
+
+// EasterOffset - Signals that the holiday falls a fixed number
+// of days before or after Western (Gregorian) Easter Sunday.
+// Example: Good Friday falls two days before Easter Sunday.
+//
+// This method is part of the standard enumeration.
+//
+func (holRuleType HolidayRuleType) EasterOffset() HolidayRuleType {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	return HolidayRuleType(4)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'HolidayRuleType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= HolidayRuleType(0).FixedDate()
+// str := t.String()
+//     str is now equal to 'FixedDate'
+//
+func (holRuleType HolidayRuleType) String() string {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	result, ok := mHolidayRuleTypeCodeToString[holRuleType]
+
+	if !ok {
+		return "Error: Holiday Rule Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current HolidayRuleType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  ruleType := HolidayRuleType(0).FixedDate()
+//
+//  isValid := ruleType.XIsValid()
+//
+func (holRuleType HolidayRuleType) XIsValid() bool {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	if holRuleType > 4 ||
+		holRuleType < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of HolidayRuleType is returned set to the value of
+// the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'fixeddate' will NOT
+//                        match the enumeration name, 'FixedDate'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'fixeddate'
+//                        will match match enumeration name 'FixedDate'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// HolidayRuleType - Upon successful completion, this method will return a new
+//                   instance of HolidayRuleType set to the value of the enumeration
+//                   matched by the string search performed on input parameter,
+//                   'valueString'.
+//
+// error           - If this method completes successfully, the returned error
+//                   Type is set equal to 'nil'. If an error condition is encountered,
+//                   this method will return an error type which encapsulates an
+//                   appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := HolidayRuleType(0).XParseString("FixedDate", true)
+//
+//     t is now equal to HolidayRuleType(0).FixedDate()
+//
+func (holRuleType HolidayRuleType) XParseString(
+	valueString string,
+	caseSensitive bool) (HolidayRuleType, error) {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	ePrefix := "HolidayRuleType.XParseString() "
+
+	if len(valueString) < 4 {
+		return HolidayRuleType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var ruleType HolidayRuleType
+
+	if caseSensitive {
+
+		ruleType, ok = mHolidayRuleTypeStringToCode[valueString]
+
+	} else {
+
+		ruleType, ok = mHolidayRuleTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return HolidayRuleType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid HolidayRuleType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return ruleType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// HolidayRuleType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (holRuleType HolidayRuleType) XValue() HolidayRuleType {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	return holRuleType
+}
+
+// XValueInt - This method returns the integer value of the current
+// HolidayRuleType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (holRuleType HolidayRuleType) XValueInt() int {
+
+	lockHolidayRuleType.Lock()
+
+	defer lockHolidayRuleType.Unlock()
+
+	return int(holRuleType)
+}
+
+// HolRuleType - public global variable of
+// type HolidayRuleType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing HolidayRuleType values.
+//
+// Usage:
+// HolRuleType.None(),
+// HolRuleType.FixedDate(),
+// HolRuleType.NthWeekdayOfMonth(),
+// HolRuleType.LastWeekdayOfMonth(),
+// HolRuleType.EasterOffset(),
+//
+var HolRuleType HolidayRuleType
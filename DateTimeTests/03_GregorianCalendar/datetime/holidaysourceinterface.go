@@ -0,0 +1,17 @@
+package datetime
+
+// IHolidaySource - Defines a source of holiday dates used by
+// business day calculations. Implementations report whether the
+// date of a DateTzDto instance, evaluated in that instance's time
+// zone, is a holiday.
+//
+// Type *HolidayCalendar implements this interface.
+//
+type IHolidaySource interface {
+
+	IsHoliday(
+		dTz DateTzDto,
+		ePrefix string) (
+		isHoliday bool,
+		err error)
+}
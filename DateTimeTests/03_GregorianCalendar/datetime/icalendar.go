@@ -0,0 +1,362 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// ICalendar - Contains an iCalendar object ('VCALENDAR') as defined
+// by RFC 5545. ICalendar reads and writes iCalendar files, allowing
+// events to be exchanged with standard calendar applications.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545
+//
+// Reading an iCalendar file:
+//
+//  Each 'VEVENT' component is parsed as an ICalendarEvent. Date time
+//  properties are converted to DateTzDto values while preserving
+//  their iCalendar form (date, floating, UTC or zoned). Reference
+//  type 'ICalendarDateTime'.
+//
+//  'TZID' property parameters are resolved to IANA time zones. The
+//  'VTIMEZONE' components of the file are used only to locate the
+//  IANA time zone when the 'TZID' value is not itself a valid time
+//  zone name. Local times are always converted with the time zone
+//  database. Resolution proceeds as follows:
+//
+//   1. The 'TZID' value is a valid time zone name.
+//      Example: "America/New_York"
+//
+//   2. The trailing components of the 'TZID' value form a valid
+//      time zone name.
+//      Example: "/mozilla.org/20050126_1/America/New_York"
+//
+//   3. The 'X-LIC-LOCATION' property of the matching 'VTIMEZONE'
+//      component is a valid time zone name.
+//
+//   4. The 'STANDARD' and 'DAYLIGHT' observances of the matching
+//      'VTIMEZONE' component agree with the UTC offsets and
+//      transitions of an IANA time zone during recent years.
+//      Example: "Eastern Standard Time" exported by Microsoft
+//               Outlook resolves to "America/New_York".
+//
+//  Zoned local times which do not exist because clocks were set
+//  forward are shifted forward by the length of the gap. Zoned local
+//  times which occur twice resolve to the first occurrence. These
+//  are the rules specified by RFC 5545, section 3.3.5.
+//
+//  Calendar properties, components and event properties which are
+//  not parsed individually are retained and written back unchanged.
+//
+// Writing an iCalendar file:
+//
+//  A 'VTIMEZONE' component is generated for every 'TZID' referenced
+//  by an event. Its observances are computed from the transitions
+//  of the IANA time zone, covering the date times of all events. If
+//  an event recurs without end, the final Standard and Daylight
+//  observances are written with yearly recurrence rules. Lines are
+//  terminated with CRLF and folded at 75 octets.
+//
+// No network access is required to read or write iCalendar files.
+//
+type ICalendar struct {
+	prodId          string           // PRODID
+	version         string           // VERSION. Always "2.0" when written.
+	events          []ICalendarEvent // VEVENT components
+	otherLines      []string         // Unfolded content lines of other calendar properties
+	otherComponents []string         // Unfolded content lines of other calendar components
+	lock            *sync.Mutex
+}
+
+// AddEvent - Adds an event to the calendar.
+//
+func (iCal *ICalendar) AddEvent(
+	event ICalendarEvent,
+	ePrefix string) error {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	ePrefix += "ICalendar.AddEvent() "
+
+	iCalMech := iCalendarMechanics{}
+
+	err := iCalMech.testEventValidity(&event, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	iCal.events = append(iCal.events, iCalMech.copyOutEvent(&event))
+
+	return nil
+}
+
+// CopyOut - Returns a deep copy of the current ICalendar instance.
+//
+func (iCal *ICalendar) CopyOut() ICalendar {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutCalendar(iCal)
+}
+
+// GetEvents - Returns a deep copy of the events ('VEVENT') contained
+// in the calendar.
+//
+func (iCal *ICalendar) GetEvents() []ICalendarEvent {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	events := make([]ICalendarEvent, len(iCal.events))
+
+	for i := 0; i < len(iCal.events); i++ {
+		events[i] = iCalMech.copyOutEvent(&iCal.events[i])
+	}
+
+	return events
+}
+
+// GetICalendarText - Formats the calendar as an iCalendar object in
+// accordance with RFC 5545. A 'VTIMEZONE' component is generated for
+// each 'TZID' referenced by the calendar's events.
+//
+// Lines are terminated with CRLF ("\r\n") and folded so that no line
+// exceeds 75 octets.
+//
+func (iCal *ICalendar) GetICalendarText(
+	ePrefix string) (
+	string,
+	error) {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	ePrefix += "ICalendar.GetICalendarText() "
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.formatCalendar(iCal, ePrefix)
+}
+
+// GetOtherProperties - Returns the unfolded content lines of all
+// calendar properties other than 'PRODID' and 'VERSION'. These lines
+// are written back unchanged.
+//
+//  Example: "CALSCALE:GREGORIAN"
+//
+func (iCal *ICalendar) GetOtherProperties() []string {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	otherLines := make([]string, len(iCal.otherLines))
+
+	copy(otherLines, iCal.otherLines)
+
+	return otherLines
+}
+
+// GetProdId - Returns the value of the 'PRODID' property which
+// identifies the product that created the calendar.
+//
+func (iCal *ICalendar) GetProdId() string {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	return iCal.prodId
+}
+
+// New - Creates and returns a new, empty ICalendar instance.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  prodId              string
+//     - Identifies the product which created the calendar. This value
+//       is written as the 'PRODID' property and may not be an empty
+//       string.
+//
+//         Example: "-//ABC Corporation//NONSGML My Product//EN"
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ICalendar
+//     - If successful, this method returns a new instance of
+//       ICalendar containing no events.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If 'prodId' is an empty string, the returned error Type will
+//       encapsulate an error message. Note this error message will
+//       incorporate the method chain and text passed by input
+//       parameter, 'ePrefix'.
+//
+func (iCal ICalendar) New(
+	prodId string,
+	ePrefix string) (
+	ICalendar,
+	error) {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	ePrefix += "ICalendar.New() "
+
+	if len(prodId) == 0 {
+		return ICalendar{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "prodId",
+				inputParameterValue: "",
+				errMsg:              "Input parameter 'prodId' is an EMPTY string!",
+				err:                 nil,
+			}
+	}
+
+	newICal := ICalendar{
+		prodId:  prodId,
+		version: "2.0",
+		lock:    new(sync.Mutex),
+	}
+
+	return newICal, nil
+}
+
+// NewFromString - Parses the text of an iCalendar object and returns
+// a new ICalendar instance.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  iCalendarText       string
+//     - The text of an iCalendar object formatted in accordance with
+//       RFC 5545. The text must contain exactly one 'VCALENDAR'
+//       component. Lines may be terminated with CRLF or LF. Folded
+//       lines are unfolded.
+//
+//
+//  floatingTimeZoneName string
+//     - The name of the time zone in which floating date times and
+//       'DATE' values are placed. Floating date times are not bound
+//       to any time zone. Their form is preserved, and they are
+//       written back without a time zone. If this parameter is an
+//       empty string, it defaults to "UTC".
+//
+//         Example: "America/Chicago"
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ICalendar
+//     - If successful, this method returns a new, populated instance
+//       of ICalendar.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If 'iCalendarText' is malformed, or if a 'TZID' cannot be
+//       resolved to an IANA time zone, the returned error Type will
+//       encapsulate an error message. Note this error message will
+//       incorporate the method chain and text passed by input
+//       parameter, 'ePrefix'.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  iCal, err := ICalendar{}.NewFromString(
+//                 icsText,
+//                 "America/Chicago",
+//                 ePrefix)
+//
+//  events := iCal.GetEvents()
+//
+//  recurSet, err := events[0].GetRecurrenceSet(ePrefix)
+//
+func (iCal ICalendar) NewFromString(
+	iCalendarText string,
+	floatingTimeZoneName string,
+	ePrefix string) (
+	ICalendar,
+	error) {
+
+	if iCal.lock == nil {
+		iCal.lock = new(sync.Mutex)
+	}
+
+	iCal.lock.Lock()
+
+	defer iCal.lock.Unlock()
+
+	ePrefix += "ICalendar.NewFromString() "
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.parseCalendar(
+		iCalendarText,
+		floatingTimeZoneName,
+		ePrefix)
+}
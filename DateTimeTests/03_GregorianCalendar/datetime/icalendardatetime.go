@@ -0,0 +1,294 @@
+package datetime
+
+import (
+	"sync"
+	"time"
+)
+
+// ICalendarDateTime - Contains a date or date time value read from,
+// or written to, an iCalendar file as defined by RFC 5545. In
+// addition to the date time value, the iCalendar form of the value
+// is preserved so that floating, UTC and zoned date times survive a
+// round trip without modification.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.3.5
+//
+// The date time value is stored as a DateTzDto:
+//
+//  ICalTimeForm.Date()
+//     - The date time is set to midnight. Like floating date times,
+//       DATE values are placed in the floating time zone when read
+//       from an iCalendar file.
+//
+//  ICalTimeForm.Floating()
+//     - The date time is placed in the floating time zone supplied
+//       by the caller when the iCalendar file is read. The wall clock
+//       time is written back without a 'TZID' parameter or a 'Z'
+//       suffix.
+//
+//  ICalTimeForm.Utc()
+//     - The date time is placed in the UTC time zone.
+//
+//  ICalTimeForm.Zoned()
+//     - The date time is placed in the IANA time zone identified by
+//       the 'TZID' property parameter. The original 'TZID' text is
+//       retained and written back unchanged.
+//
+type ICalendarDateTime struct {
+	dateTime DateTzDto         // The date time value
+	form     ICalendarTimeForm // Date, Floating, Utc or Zoned
+	tzId     string            // 'TZID' parameter value. Zoned form only.
+	lock     *sync.Mutex
+}
+
+// CopyOut - Returns a deep copy of the current ICalendarDateTime
+// instance.
+//
+func (iCalDt *ICalendarDateTime) CopyOut() ICalendarDateTime {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutDateTime(iCalDt)
+}
+
+// GetDateTime - Returns the date time value as a DateTzDto.
+//
+func (iCalDt *ICalendarDateTime) GetDateTime() DateTzDto {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	return iCalDt.dateTime.CopyOut()
+}
+
+// GetForm - Returns the iCalendar form of the date time value.
+// Reference type 'ICalendarTimeForm'.
+//
+func (iCalDt *ICalendarDateTime) GetForm() ICalendarTimeForm {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	return iCalDt.form
+}
+
+// GetTimeZoneSpec - Returns the time zone specification of the date
+// time value. For zoned date times, this is the IANA time zone
+// identified by the 'TZID' property parameter.
+//
+func (iCalDt *ICalendarDateTime) GetTimeZoneSpec() TimeZoneSpecification {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	return iCalDt.dateTime.GetOriginalTimeZone()
+}
+
+// GetTzId - Returns the value of the 'TZID' property parameter. For
+// date times which are not zoned, this method returns an empty
+// string.
+//
+func (iCalDt *ICalendarDateTime) GetTzId() string {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	return iCalDt.tzId
+}
+
+// IsValid - Returns an error if the current ICalendarDateTime
+// instance is invalid.
+//
+func (iCalDt *ICalendarDateTime) IsValid(
+	ePrefix string) error {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	ePrefix += "ICalendarDateTime.IsValid() "
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.testDateTimeValidity(iCalDt, ePrefix)
+}
+
+// New - Creates and returns a new ICalendarDateTime instance.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dateTime            DateTzDto
+//     - The date time value. The treatment of 'dateTime' depends on
+//       input parameter 'form'.
+//
+//
+//  form                ICalendarTimeForm
+//     - The iCalendar form in which the date time will be written.
+//
+//       ICalTimeForm.Date()
+//          The date of 'dateTime' is retained. The time of day is set
+//          to midnight.
+//
+//       ICalTimeForm.Floating()
+//          The wall clock time of 'dateTime' is retained. The time
+//          zone of 'dateTime' is not written.
+//
+//       ICalTimeForm.Utc()
+//          'dateTime' is converted to UTC.
+//
+//       ICalTimeForm.Zoned()
+//          'dateTime' is retained. The 'TZID' parameter is set to the
+//          time zone location name of 'dateTime'. Example:
+//          "America/Chicago".
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ICalendarDateTime
+//     - If successful, this method returns a new, populated instance
+//       of ICalendarDateTime.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  iCalDt, err := ICalendarDateTime{}.New(
+//                   dTz,
+//                   ICalTimeForm.Zoned(),
+//                   ePrefix)
+//
+func (iCalDt ICalendarDateTime) New(
+	dateTime DateTzDto,
+	form ICalendarTimeForm,
+	ePrefix string) (
+	ICalendarDateTime,
+	error) {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	ePrefix += "ICalendarDateTime.New() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&dateTime, ePrefix)
+
+	if err != nil {
+		return ICalendarDateTime{}, err
+	}
+
+	t := dateTime.dateTimeValue
+
+	tzId := ""
+
+	switch form {
+
+	case ICalTimeForm.Date():
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+
+	case ICalTimeForm.Floating():
+
+	case ICalTimeForm.Utc():
+		t = t.UTC()
+
+	case ICalTimeForm.Zoned():
+		tzId = dateTime.timeZone.GetBestConvertibleTimeZone().locationName
+
+		if len(tzId) == 0 {
+			tzId = t.Location().String()
+		}
+
+	default:
+		return ICalendarDateTime{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "form",
+				inputParameterValue: form.String(),
+				errMsg:              "Input parameter 'form' is INVALID!",
+				err:                 nil,
+			}
+	}
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.newDateTime(t, form, tzId, ePrefix)
+}
+
+// String - Returns the date time formatted as an iCalendar 'DATE' or
+// 'DATE-TIME' value. Property parameters such as 'TZID' are NOT
+// included.
+//
+//  Examples: "19970714"
+//            "19970714T133000"
+//            "19970714T173000Z"
+//
+func (iCalDt *ICalendarDateTime) String() string {
+
+	if iCalDt.lock == nil {
+		iCalDt.lock = new(sync.Mutex)
+	}
+
+	iCalDt.lock.Lock()
+
+	defer iCalDt.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.formatDateTimeValue(iCalDt)
+}
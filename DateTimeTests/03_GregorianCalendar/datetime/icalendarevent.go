@@ -0,0 +1,629 @@
+package datetime
+
+import (
+	"errors"
+	"sync"
+)
+
+// ICalendarEvent - Contains a 'VEVENT' calendar component as defined
+// by the iCalendar specification, RFC 5545.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.6.1
+//
+// The following properties are parsed and maintained individually:
+//
+//  UID, DTSTAMP, DTSTART, DTEND, SUMMARY, DESCRIPTION, LOCATION,
+//  RRULE, EXRULE, RDATE and EXDATE.
+//
+// All other properties, as well as nested components such as
+// 'VALARM', are retained as unfolded content lines and are written
+// back unchanged. This includes the 'DURATION' property.
+//
+// The start date time, recurrence rules, recurrence dates and
+// exclusion dates may be converted to a RecurrenceSet by calling
+// method GetRecurrenceSet().
+//
+type ICalendarEvent struct {
+	uid         string              // UID
+	dtStamp     ICalendarDateTime   // DTSTAMP. Form is None if absent.
+	dtStart     ICalendarDateTime   // DTSTART
+	dtEnd       ICalendarDateTime   // DTEND. Form is None if absent.
+	summary     string              // SUMMARY
+	description string              // DESCRIPTION
+	location    string              // LOCATION
+	rRules      []RecurrenceRule    // RRULE
+	exRules     []RecurrenceRule    // EXRULE
+	rDates      []ICalendarDateTime // RDATE
+	exDates     []ICalendarDateTime // EXDATE
+	otherLines  []string            // Unfolded content lines of all other properties
+	lock        *sync.Mutex
+}
+
+// AddExDate - Adds an exclusion date time to the event.
+//
+func (iCalEvent *ICalendarEvent) AddExDate(
+	exDate ICalendarDateTime,
+	ePrefix string) error {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	ePrefix += "ICalendarEvent.AddExDate() "
+
+	iCalMech := iCalendarMechanics{}
+
+	err := iCalMech.testDateTimeValidity(&exDate, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	iCalEvent.exDates = append(iCalEvent.exDates, iCalMech.copyOutDateTime(&exDate))
+
+	return nil
+}
+
+// AddExRule - Adds an exclusion rule to the event.
+//
+// Note that the 'EXRULE' property was deprecated by RFC 5545. It is
+// supported here for compatibility with RFC 2445.
+//
+func (iCalEvent *ICalendarEvent) AddExRule(
+	exRule RecurrenceRule,
+	ePrefix string) error {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	ePrefix += "ICalendarEvent.AddExRule() "
+
+	err := exRule.IsValid(ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	iCalEvent.exRules = append(iCalEvent.exRules, exRule.CopyOut())
+
+	return nil
+}
+
+// AddRDate - Adds an individual recurrence date time to the event.
+//
+func (iCalEvent *ICalendarEvent) AddRDate(
+	rDate ICalendarDateTime,
+	ePrefix string) error {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	ePrefix += "ICalendarEvent.AddRDate() "
+
+	iCalMech := iCalendarMechanics{}
+
+	err := iCalMech.testDateTimeValidity(&rDate, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	iCalEvent.rDates = append(iCalEvent.rDates, iCalMech.copyOutDateTime(&rDate))
+
+	return nil
+}
+
+// AddRRule - Adds a recurrence rule to the event.
+//
+func (iCalEvent *ICalendarEvent) AddRRule(
+	rRule RecurrenceRule,
+	ePrefix string) error {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	ePrefix += "ICalendarEvent.AddRRule() "
+
+	err := rRule.IsValid(ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	iCalEvent.rRules = append(iCalEvent.rRules, rRule.CopyOut())
+
+	return nil
+}
+
+// CopyOut - Returns a deep copy of the current ICalendarEvent
+// instance.
+//
+func (iCalEvent *ICalendarEvent) CopyOut() ICalendarEvent {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutEvent(iCalEvent)
+}
+
+// GetDescription - Returns the value of the 'DESCRIPTION' property.
+//
+func (iCalEvent *ICalendarEvent) GetDescription() string {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	return iCalEvent.description
+}
+
+// GetDtEnd - Returns the value of the 'DTEND' property. If the event
+// does not specify 'DTEND', return value 'hasDtEnd' is set to
+// 'false'.
+//
+func (iCalEvent *ICalendarEvent) GetDtEnd() (
+	dtEnd ICalendarDateTime,
+	hasDtEnd bool) {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	if iCalEvent.dtEnd.form == ICalTimeForm.None() {
+		return ICalendarDateTime{}, false
+	}
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutDateTime(&iCalEvent.dtEnd), true
+}
+
+// GetDtStamp - Returns the value of the 'DTSTAMP' property. If the
+// event does not specify 'DTSTAMP', return value 'hasDtStamp' is set
+// to 'false'.
+//
+func (iCalEvent *ICalendarEvent) GetDtStamp() (
+	dtStamp ICalendarDateTime,
+	hasDtStamp bool) {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	if iCalEvent.dtStamp.form == ICalTimeForm.None() {
+		return ICalendarDateTime{}, false
+	}
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutDateTime(&iCalEvent.dtStamp), true
+}
+
+// GetDtStart - Returns the value of the 'DTSTART' property.
+//
+func (iCalEvent *ICalendarEvent) GetDtStart() ICalendarDateTime {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutDateTime(&iCalEvent.dtStart)
+}
+
+// GetExDates - Returns a deep copy of the exclusion date times
+// ('EXDATE').
+//
+func (iCalEvent *ICalendarEvent) GetExDates() []ICalendarDateTime {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutDateTimes(iCalEvent.exDates)
+}
+
+// GetExRules - Returns a deep copy of the exclusion rules
+// ('EXRULE').
+//
+func (iCalEvent *ICalendarEvent) GetExRules() []RecurrenceRule {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	exRules := make([]RecurrenceRule, len(iCalEvent.exRules))
+
+	for i := 0; i < len(iCalEvent.exRules); i++ {
+		exRules[i] = iCalEvent.exRules[i].CopyOut()
+	}
+
+	return exRules
+}
+
+// GetLocation - Returns the value of the 'LOCATION' property.
+//
+func (iCalEvent *ICalendarEvent) GetLocation() string {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	return iCalEvent.location
+}
+
+// GetOtherProperties - Returns the unfolded content lines of all
+// properties and nested components which are not parsed individually
+// by ICalendarEvent. These lines are written back unchanged.
+//
+//  Example: "DURATION:PT1H"
+//
+func (iCalEvent *ICalendarEvent) GetOtherProperties() []string {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	otherLines := make([]string, len(iCalEvent.otherLines))
+
+	copy(otherLines, iCalEvent.otherLines)
+
+	return otherLines
+}
+
+// GetRDates - Returns a deep copy of the recurrence date times
+// ('RDATE').
+//
+func (iCalEvent *ICalendarEvent) GetRDates() []ICalendarDateTime {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.copyOutDateTimes(iCalEvent.rDates)
+}
+
+// GetRecurrenceSet - Returns a RecurrenceSet composed of the event's
+// start date time, recurrence rules, recurrence dates, exclusion
+// rules and exclusion dates. Recurrence rules are expanded in the
+// local time of the start date time, as required by RFC 5545.
+//
+// Reference type 'RecurrenceSet'.
+//
+func (iCalEvent *ICalendarEvent) GetRecurrenceSet(
+	ePrefix string) (
+	RecurrenceSet,
+	error) {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	ePrefix += "ICalendarEvent.GetRecurrenceSet() "
+
+	iCalMech := iCalendarMechanics{}
+
+	return iCalMech.newRecurrenceSet(iCalEvent, ePrefix)
+}
+
+// GetRRules - Returns a deep copy of the recurrence rules
+// ('RRULE').
+//
+func (iCalEvent *ICalendarEvent) GetRRules() []RecurrenceRule {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	rRules := make([]RecurrenceRule, len(iCalEvent.rRules))
+
+	for i := 0; i < len(iCalEvent.rRules); i++ {
+		rRules[i] = iCalEvent.rRules[i].CopyOut()
+	}
+
+	return rRules
+}
+
+// GetSummary - Returns the value of the 'SUMMARY' property.
+//
+func (iCalEvent *ICalendarEvent) GetSummary() string {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	return iCalEvent.summary
+}
+
+// GetUid - Returns the value of the 'UID' property.
+//
+func (iCalEvent *ICalendarEvent) GetUid() string {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	return iCalEvent.uid
+}
+
+// New - Creates and returns a new ICalendarEvent.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  uid                 string
+//     - The globally unique identifier of the event. This value is
+//       written as the 'UID' property. 'uid' may not be an empty
+//       string.
+//
+//
+//  dtStamp             ICalendarDateTime
+//     - The date time at which the event was created. This value is
+//       written as the 'DTSTAMP' property and must be expressed in
+//       the UTC form, ICalTimeForm.Utc().
+//
+//
+//  dtStart             ICalendarDateTime
+//     - The start date time of the event. This value is written as
+//       the 'DTSTART' property.
+//
+//
+//  dtEnd               ICalendarDateTime
+//     - The end date time of the event. This value is written as the
+//       'DTEND' property. If 'dtEnd' is an empty ICalendarDateTime
+//       instance, no 'DTEND' property is written. Otherwise, 'dtEnd'
+//       must be of the same form as 'dtStart' and may not occur
+//       before 'dtStart'.
+//
+//
+//  summary             string
+//     - A short summary of the event written as the 'SUMMARY'
+//       property. If 'summary' is an empty string, no 'SUMMARY'
+//       property is written.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ICalendarEvent
+//     - If successful, this method returns a new, populated instance
+//       of ICalendarEvent.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (iCalEvent ICalendarEvent) New(
+	uid string,
+	dtStamp ICalendarDateTime,
+	dtStart ICalendarDateTime,
+	dtEnd ICalendarDateTime,
+	summary string,
+	ePrefix string) (
+	ICalendarEvent,
+	error) {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	ePrefix += "ICalendarEvent.New() "
+
+	if len(uid) == 0 {
+		return ICalendarEvent{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "uid",
+				inputParameterValue: "",
+				errMsg:              "Input parameter 'uid' is an EMPTY string!",
+				err:                 nil,
+			}
+	}
+
+	if dtStamp.form != ICalTimeForm.Utc() {
+		return ICalendarEvent{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "dtStamp",
+				inputParameterValue: dtStamp.form.String(),
+				errMsg:              "Input parameter 'dtStamp' must be expressed in the UTC form!",
+				err:                 nil,
+			}
+	}
+
+	iCalMech := iCalendarMechanics{}
+
+	newEvent := ICalendarEvent{
+		uid:     uid,
+		dtStamp: iCalMech.copyOutDateTime(&dtStamp),
+		dtStart: iCalMech.copyOutDateTime(&dtStart),
+		dtEnd:   iCalMech.copyOutDateTime(&dtEnd),
+		summary: summary,
+		lock:    new(sync.Mutex),
+	}
+
+	err := iCalMech.testEventValidity(&newEvent, ePrefix)
+
+	if err != nil {
+		return ICalendarEvent{}, err
+	}
+
+	return newEvent, nil
+}
+
+// SetDescription - Sets the value of the 'DESCRIPTION' property. If
+// 'description' is an empty string, no 'DESCRIPTION' property is
+// written.
+//
+func (iCalEvent *ICalendarEvent) SetDescription(description string) {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	iCalEvent.description = description
+}
+
+// SetLocation - Sets the value of the 'LOCATION' property. If
+// 'location' is an empty string, no 'LOCATION' property is written.
+//
+func (iCalEvent *ICalendarEvent) SetLocation(location string) {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	iCalEvent.location = location
+}
+
+// SetOtherProperties - Replaces the unfolded content lines of all
+// properties which are not parsed individually by ICalendarEvent.
+// Each line must be a valid iCalendar content line.
+//
+//  Example: "DURATION:PT1H"
+//
+func (iCalEvent *ICalendarEvent) SetOtherProperties(
+	otherLines []string,
+	ePrefix string) error {
+
+	if iCalEvent.lock == nil {
+		iCalEvent.lock = new(sync.Mutex)
+	}
+
+	iCalEvent.lock.Lock()
+
+	defer iCalEvent.lock.Unlock()
+
+	ePrefix += "ICalendarEvent.SetOtherProperties() "
+
+	iCalMech := iCalendarMechanics{}
+
+	for i := 0; i < len(otherLines); i++ {
+
+		contentLine, err := iCalMech.parseContentLine(otherLines[i], ePrefix)
+
+		if err != nil {
+			return err
+		}
+
+		if iCalMech.isEventProperty(contentLine.name) {
+			return errors.New(ePrefix + "\n" +
+				"Error: 'otherLines' contains a property which is maintained by ICalendarEvent!\n" +
+				"otherLine='" + otherLines[i] + "'\n")
+		}
+	}
+
+	iCalEvent.otherLines = make([]string, len(otherLines))
+
+	copy(iCalEvent.otherLines, otherLines)
+
+	return nil
+}
@@ -0,0 +1,2368 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// iCalendarMaxLineOctets - The maximum length of a content line, in
+// octets, excluding the line break. Longer lines are folded.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.1
+//
+const iCalendarMaxLineOctets = 75
+
+// mICalendarEventProperties - Event properties which are parsed and
+// maintained individually by type ICalendarEvent.
+//
+var mICalendarEventProperties = map[string]bool{
+	"UID"           : true,
+	"DTSTAMP"       : true,
+	"DTSTART"       : true,
+	"DTEND"         : true,
+	"SUMMARY"       : true,
+	"DESCRIPTION"   : true,
+	"LOCATION"      : true,
+	"RRULE"         : true,
+	"EXRULE"        : true,
+	"RDATE"         : true,
+	"EXDATE"        : true,
+}
+
+// iCalendarContentLine - Contains the components of a single,
+// unfolded iCalendar content line.
+//
+//  Example: "DTSTART;TZID=America/New_York:19970714T133000"
+//
+//   name   = "DTSTART"
+//   params = {"TZID": "America/New_York"}
+//   value  = "19970714T133000"
+//
+// Property and parameter names are converted to upper case.
+// Quotation marks surrounding parameter values are removed.
+//
+type iCalendarContentLine struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// iCalendarParseContext - Contains the time zone information used
+// to convert date time properties while parsing an iCalendar object.
+//
+type iCalendarParseContext struct {
+	floatingLocPtr     *time.Location                    // Floating date times and DATE values
+	tzIdLocPtrs        map[string]*time.Location         // Resolved 'TZID' values
+	vTimeZoneLocations map[string]string                 // 'TZID' to 'X-LIC-LOCATION'
+	vTimeZoneLines     map[string][]iCalendarContentLine // 'TZID' to 'VTIMEZONE' content lines
+}
+
+// iCalendarObservance - Describes a single 'STANDARD' or 'DAYLIGHT'
+// sub-component of a generated or parsed 'VTIMEZONE' component.
+//
+type iCalendarObservance struct {
+	isDaylight   bool      // 'true' for DAYLIGHT. 'false' for STANDARD.
+	hasStart     bool      // 'false' if no prior transition exists
+	start        time.Time // The transition instant
+	offsetFrom   int       // UTC offset in seconds before the transition
+	offsetTo     int       // UTC offset in seconds after the transition
+	tzName       string    // Time zone abbreviation after the transition
+	rRule        string    // Yearly recurrence rule. May be empty.
+}
+
+// iCalendarTzRange - Identifies the time span for which 'VTIMEZONE'
+// observances must be generated.
+//
+type iCalendarTzRange struct {
+	locPtr   *time.Location
+	earliest time.Time
+	latest   time.Time
+	extend   bool // An event recurs without end in this time zone
+}
+
+// iCalendarMechanics - Provides helper methods used to read and
+// write iCalendar objects as defined by RFC 5545.
+//
+type iCalendarMechanics struct {
+	lock *sync.Mutex
+}
+
+// copyOutCalendar - Returns a deep copy of 'iCal'.
+//
+func (iCalMech *iCalendarMechanics) copyOutCalendar(
+	iCal *ICalendar) ICalendar {
+
+	newICal := ICalendar{
+		prodId:  iCal.prodId,
+		version: iCal.version,
+		lock:    new(sync.Mutex),
+	}
+
+	newICal.events = make([]ICalendarEvent, len(iCal.events))
+
+	for i := 0; i < len(iCal.events); i++ {
+		newICal.events[i] = iCalMech.copyOutEvent(&iCal.events[i])
+	}
+
+	newICal.otherLines = make([]string, len(iCal.otherLines))
+
+	copy(newICal.otherLines, iCal.otherLines)
+
+	newICal.otherComponents = make([]string, len(iCal.otherComponents))
+
+	copy(newICal.otherComponents, iCal.otherComponents)
+
+	return newICal
+}
+
+// copyOutDateTime - Returns a deep copy of 'iCalDt'.
+//
+func (iCalMech *iCalendarMechanics) copyOutDateTime(
+	iCalDt *ICalendarDateTime) ICalendarDateTime {
+
+	newICalDt := ICalendarDateTime{
+		form: iCalDt.form,
+		tzId: iCalDt.tzId,
+		lock: new(sync.Mutex),
+	}
+
+	if iCalDt.form != ICalTimeForm.None() {
+		newICalDt.dateTime = iCalDt.dateTime.CopyOut()
+	}
+
+	return newICalDt
+}
+
+// copyOutDateTimes - Returns a deep copy of an array of
+// ICalendarDateTime instances.
+//
+func (iCalMech *iCalendarMechanics) copyOutDateTimes(
+	iCalDts []ICalendarDateTime) []ICalendarDateTime {
+
+	newICalDts := make([]ICalendarDateTime, len(iCalDts))
+
+	for i := 0; i < len(iCalDts); i++ {
+		newICalDts[i] = iCalMech.copyOutDateTime(&iCalDts[i])
+	}
+
+	return newICalDts
+}
+
+// copyOutEvent - Returns a deep copy of 'iCalEvent'.
+//
+func (iCalMech *iCalendarMechanics) copyOutEvent(
+	iCalEvent *ICalendarEvent) ICalendarEvent {
+
+	newEvent := ICalendarEvent{
+		uid:         iCalEvent.uid,
+		dtStamp:     iCalMech.copyOutDateTime(&iCalEvent.dtStamp),
+		dtStart:     iCalMech.copyOutDateTime(&iCalEvent.dtStart),
+		dtEnd:       iCalMech.copyOutDateTime(&iCalEvent.dtEnd),
+		summary:     iCalEvent.summary,
+		description: iCalEvent.description,
+		location:    iCalEvent.location,
+		rDates:      iCalMech.copyOutDateTimes(iCalEvent.rDates),
+		exDates:     iCalMech.copyOutDateTimes(iCalEvent.exDates),
+		lock:        new(sync.Mutex),
+	}
+
+	newEvent.rRules = make([]RecurrenceRule, len(iCalEvent.rRules))
+
+	for i := 0; i < len(iCalEvent.rRules); i++ {
+		newEvent.rRules[i] = iCalEvent.rRules[i].CopyOut()
+	}
+
+	newEvent.exRules = make([]RecurrenceRule, len(iCalEvent.exRules))
+
+	for i := 0; i < len(iCalEvent.exRules); i++ {
+		newEvent.exRules[i] = iCalEvent.exRules[i].CopyOut()
+	}
+
+	newEvent.otherLines = make([]string, len(iCalEvent.otherLines))
+
+	copy(newEvent.otherLines, iCalEvent.otherLines)
+
+	return newEvent
+}
+
+// escapeText - Escapes a string for use as an iCalendar 'TEXT'
+// property value.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.3.11
+//
+func (iCalMech *iCalendarMechanics) escapeText(text string) string {
+
+	replacer := strings.NewReplacer(
+		"\\", "\\\\",
+		";", "\\;",
+		",", "\\,",
+		"\r\n", "\\n",
+		"\n", "\\n")
+
+	return replacer.Replace(text)
+}
+
+// foldLine - Writes 'line' to 'sb' terminated by CRLF. Lines longer
+// than 75 octets are folded by inserting CRLF followed by a single
+// space. Multi-octet UTF-8 characters are never split.
+//
+func (iCalMech *iCalendarMechanics) foldLine(
+	sb *strings.Builder,
+	line string) {
+
+	limit := iCalendarMaxLineOctets
+
+	for len(line) > limit {
+
+		cut := limit
+
+		for cut > 1 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+
+		sb.WriteString(line[:cut])
+		sb.WriteString("\r\n ")
+
+		line = line[cut:]
+
+		// The leading space of a continuation line counts
+		// toward the line length.
+		limit = iCalendarMaxLineOctets - 1
+	}
+
+	sb.WriteString(line)
+	sb.WriteString("\r\n")
+}
+
+// formatCalendar - Formats 'iCal' as the text of an iCalendar
+// object.
+//
+func (iCalMech *iCalendarMechanics) formatCalendar(
+	iCal *ICalendar,
+	ePrefix string) (
+	string,
+	error) {
+
+	ePrefix += "iCalendarMechanics.formatCalendar() "
+
+	if len(iCal.prodId) == 0 {
+		return "",
+			errors.New(ePrefix + "\n" +
+				"Error: The ICalendar instance is INVALID! 'PRODID' is an EMPTY string!\n")
+	}
+
+	lines := make([]string, 0, 64)
+
+	lines = append(lines,
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:"+iCal.prodId)
+
+	lines = append(lines, iCal.otherLines...)
+
+	tzRanges, err := iCalMech.getTimeZoneRanges(iCal, ePrefix)
+
+	if err != nil {
+		return "", err
+	}
+
+	tzIds := make([]string, 0, len(tzRanges))
+
+	for tzId := range tzRanges {
+		tzIds = append(tzIds, tzId)
+	}
+
+	sort.Strings(tzIds)
+
+	for _, tzId := range tzIds {
+		lines = append(lines,
+			iCalMech.formatVTimeZone(tzId, tzRanges[tzId])...)
+	}
+
+	for i := 0; i < len(iCal.events); i++ {
+		lines = append(lines,
+			iCalMech.formatEvent(&iCal.events[i])...)
+	}
+
+	lines = append(lines, iCal.otherComponents...)
+
+	lines = append(lines, "END:VCALENDAR")
+
+	sb := strings.Builder{}
+
+	for _, line := range lines {
+		iCalMech.foldLine(&sb, line)
+	}
+
+	return sb.String(), nil
+}
+
+// formatDateTimeLines - Formats a date time property. Consecutive
+// values sharing the same form and 'TZID' are written to a single
+// content line as a comma separated list.
+//
+//  Example: "EXDATE;TZID=America/New_York:19970714T133000,19970715T133000"
+//
+func (iCalMech *iCalendarMechanics) formatDateTimeLines(
+	propertyName string,
+	iCalDts []ICalendarDateTime) []string {
+
+	lines := make([]string, 0, 1)
+
+	for i := 0; i < len(iCalDts); {
+
+		j := i + 1
+
+		for j < len(iCalDts) &&
+			iCalDts[j].form == iCalDts[i].form &&
+			iCalDts[j].tzId == iCalDts[i].tzId {
+			j++
+		}
+
+		values := make([]string, 0, j-i)
+
+		for k := i; k < j; k++ {
+			values = append(values, iCalMech.formatDateTimeValue(&iCalDts[k]))
+		}
+
+		lines = append(lines,
+			iCalMech.formatDateTimeParams(propertyName, &iCalDts[i])+
+				":"+strings.Join(values, ","))
+
+		i = j
+	}
+
+	return lines
+}
+
+// formatDateTimeParams - Returns the property name followed by the
+// property parameters required by the form of 'iCalDt'.
+//
+//  Examples: "DTSTART;VALUE=DATE"
+//            "DTSTART;TZID=America/New_York"
+//
+func (iCalMech *iCalendarMechanics) formatDateTimeParams(
+	propertyName string,
+	iCalDt *ICalendarDateTime) string {
+
+	switch iCalDt.form {
+
+	case ICalTimeForm.Date():
+		return propertyName + ";VALUE=DATE"
+
+	case ICalTimeForm.Zoned():
+		return propertyName + ";TZID=" + iCalMech.formatParamValue(iCalDt.tzId)
+	}
+
+	return propertyName
+}
+
+// formatDateTimeValue - Formats the date time of 'iCalDt' as an
+// iCalendar 'DATE' or 'DATE-TIME' value.
+//
+func (iCalMech *iCalendarMechanics) formatDateTimeValue(
+	iCalDt *ICalendarDateTime) string {
+
+	t := iCalDt.dateTime.dateTimeValue
+
+	switch iCalDt.form {
+
+	case ICalTimeForm.Date():
+		return t.Format("20060102")
+
+	case ICalTimeForm.Utc():
+		return t.UTC().Format("20060102T150405Z")
+	}
+
+	return t.Format("20060102T150405")
+}
+
+// formatEvent - Returns the unfolded content lines of a 'VEVENT'
+// component.
+//
+func (iCalMech *iCalendarMechanics) formatEvent(
+	iCalEvent *ICalendarEvent) []string {
+
+	lines := make([]string, 0, 16)
+
+	lines = append(lines,
+		"BEGIN:VEVENT",
+		"UID:"+iCalMech.escapeText(iCalEvent.uid))
+
+	if iCalEvent.dtStamp.form != ICalTimeForm.None() {
+		lines = append(lines,
+			iCalMech.formatDateTimeLines("DTSTAMP",
+				[]ICalendarDateTime{iCalEvent.dtStamp})...)
+	}
+
+	lines = append(lines,
+		iCalMech.formatDateTimeLines("DTSTART",
+			[]ICalendarDateTime{iCalEvent.dtStart})...)
+
+	if iCalEvent.dtEnd.form != ICalTimeForm.None() {
+		lines = append(lines,
+			iCalMech.formatDateTimeLines("DTEND",
+				[]ICalendarDateTime{iCalEvent.dtEnd})...)
+	}
+
+	if len(iCalEvent.summary) > 0 {
+		lines = append(lines, "SUMMARY:"+iCalMech.escapeText(iCalEvent.summary))
+	}
+
+	if len(iCalEvent.description) > 0 {
+		lines = append(lines, "DESCRIPTION:"+iCalMech.escapeText(iCalEvent.description))
+	}
+
+	if len(iCalEvent.location) > 0 {
+		lines = append(lines, "LOCATION:"+iCalMech.escapeText(iCalEvent.location))
+	}
+
+	for i := 0; i < len(iCalEvent.rRules); i++ {
+		lines = append(lines, "RRULE:"+iCalEvent.rRules[i].String())
+	}
+
+	for i := 0; i < len(iCalEvent.exRules); i++ {
+		lines = append(lines, "EXRULE:"+iCalEvent.exRules[i].String())
+	}
+
+	lines = append(lines, iCalMech.formatDateTimeLines("RDATE", iCalEvent.rDates)...)
+
+	lines = append(lines, iCalMech.formatDateTimeLines("EXDATE", iCalEvent.exDates)...)
+
+	lines = append(lines, iCalEvent.otherLines...)
+
+	lines = append(lines, "END:VEVENT")
+
+	return lines
+}
+
+// formatParamValue - Returns a property parameter value. Values
+// containing a colon, semicolon or comma are quoted.
+//
+func (iCalMech *iCalendarMechanics) formatParamValue(value string) string {
+
+	if strings.ContainsAny(value, ":;,") {
+		return "\"" + value + "\""
+	}
+
+	return value
+}
+
+// formatUtcOffset - Formats a UTC offset in seconds as an iCalendar
+// 'UTC-OFFSET' value.
+//
+//  Examples: "-0500"  "+0530"  "+003045"
+//
+func (iCalMech *iCalendarMechanics) formatUtcOffset(offsetSeconds int) string {
+
+	sign := "+"
+
+	if offsetSeconds < 0 {
+		sign = "-"
+		offsetSeconds = -offsetSeconds
+	}
+
+	hours := offsetSeconds / 3600
+	minutes := (offsetSeconds % 3600) / 60
+	seconds := offsetSeconds % 60
+
+	if seconds != 0 {
+		return fmt.Sprintf("%v%02d%02d%02d", sign, hours, minutes, seconds)
+	}
+
+	return fmt.Sprintf("%v%02d%02d", sign, hours, minutes)
+}
+
+// formatVTimeZone - Returns the unfolded content lines of a
+// 'VTIMEZONE' component describing time zone 'tzRange.locPtr'
+// between 'tzRange.earliest' and 'tzRange.latest'.
+//
+// If 'tzId' differs from the IANA time zone name, the name is
+// written as the 'X-LIC-LOCATION' property.
+//
+// The first observance is the one in effect at 'tzRange.earliest'.
+// Each transition within the range adds a further observance. If
+// 'tzRange.extend' is 'true', the range covers at least one year and
+// the final Standard and Daylight observances are given a yearly
+// recurrence rule, provided the rule correctly predicts the
+// following transition.
+//
+func (iCalMech *iCalendarMechanics) formatVTimeZone(
+	tzId string,
+	tzRange iCalendarTzRange) []string {
+
+	locPtr := tzRange.locPtr
+
+	latest := tzRange.latest
+
+	if tzRange.extend &&
+		latest.Before(tzRange.earliest.AddDate(1, 0, 0)) {
+		latest = tzRange.earliest.AddDate(1, 0, 0)
+	}
+
+	tzTransMech := timeZoneTransitionMechanics{}
+
+	observances := make([]iCalendarObservance, 0, 8)
+
+	transition, found := tzTransMech.previousTransition(tzRange.earliest, locPtr)
+
+	if found {
+
+		observances = append(observances,
+			iCalMech.newObservance(transition, locPtr))
+
+	} else {
+
+		tzName, offset := tzRange.earliest.In(locPtr).Zone()
+
+		observances = append(observances,
+			iCalendarObservance{
+				isDaylight: tzRange.earliest.In(locPtr).IsDST(),
+				hasStart:   false,
+				offsetFrom: offset,
+				offsetTo:   offset,
+				tzName:     tzName,
+			})
+	}
+
+	cursor := tzRange.earliest
+
+	for {
+
+		transition, found = tzTransMech.nextTransition(cursor, locPtr)
+
+		if !found || transition.After(latest) {
+			break
+		}
+
+		observances = append(observances,
+			iCalMech.newObservance(transition, locPtr))
+
+		cursor = transition
+	}
+
+	if tzRange.extend {
+
+		lastIdx := map[bool]int{true: -1, false: -1}
+
+		for i := 0; i < len(observances); i++ {
+			if observances[i].hasStart {
+				lastIdx[observances[i].isDaylight] = i
+			}
+		}
+
+		for _, idx := range lastIdx {
+
+			if idx < 0 {
+				continue
+			}
+
+			observances[idx].rRule =
+				iCalMech.getObservanceRule(observances[idx], locPtr)
+		}
+	}
+
+	lines := make([]string, 0, 4+len(observances)*7)
+
+	lines = append(lines,
+		"BEGIN:VTIMEZONE",
+		"TZID:"+tzId)
+
+	if tzId != locPtr.String() {
+		// Allows the 'TZID' to be resolved when the file is read.
+		lines = append(lines, "X-LIC-LOCATION:"+locPtr.String())
+	}
+
+	for _, observance := range observances {
+
+		componentName := "STANDARD"
+
+		if observance.isDaylight {
+			componentName = "DAYLIGHT"
+		}
+
+		dtStart := "19700101T000000"
+
+		if observance.hasStart {
+			dtStart = observance.start.In(
+				time.FixedZone("", observance.offsetFrom)).Format("20060102T150405")
+		}
+
+		lines = append(lines,
+			"BEGIN:"+componentName,
+			"DTSTART:"+dtStart)
+
+		if len(observance.rRule) > 0 {
+			lines = append(lines, "RRULE:"+observance.rRule)
+		}
+
+		lines = append(lines,
+			"TZOFFSETFROM:"+iCalMech.formatUtcOffset(observance.offsetFrom),
+			"TZOFFSETTO:"+iCalMech.formatUtcOffset(observance.offsetTo),
+			"TZNAME:"+observance.tzName,
+			"END:"+componentName)
+	}
+
+	lines = append(lines, "END:VTIMEZONE")
+
+	return lines
+}
+
+// getObservanceRule - Returns a yearly recurrence rule describing
+// the transition of 'observance'. The rule identifies the nth, or
+// last, weekday of the month on which the transition occurs.
+//
+//  Example: "FREQ=YEARLY;BYMONTH=3;BYDAY=2SU"
+//
+// If the rule does not correctly predict the next transition of the
+// same kind, this method returns an empty string.
+//
+func (iCalMech *iCalendarMechanics) getObservanceRule(
+	observance iCalendarObservance,
+	locPtr *time.Location) string {
+
+	fromZone := time.FixedZone("", observance.offsetFrom)
+
+	local := observance.start.In(fromZone)
+
+	daysInMonth := time.Date(local.Year(), local.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+
+	nth := (local.Day()-1)/7 + 1
+
+	if local.Day()+7 > daysInMonth {
+		nth = -1
+	}
+
+	// Locate the next transition with the same UTC offsets.
+	tzTransMech := timeZoneTransitionMechanics{}
+
+	cursor := observance.start
+
+	limit := observance.start.AddDate(1, 1, 0)
+
+	var next iCalendarObservance
+
+	found := false
+
+	for {
+
+		transition, ok := tzTransMech.nextTransition(cursor, locPtr)
+
+		if !ok || transition.After(limit) {
+			break
+		}
+
+		candidate := iCalMech.newObservance(transition, locPtr)
+
+		if candidate.offsetFrom == observance.offsetFrom &&
+			candidate.offsetTo == observance.offsetTo &&
+			candidate.isDaylight == observance.isDaylight {
+			next = candidate
+			found = true
+			break
+		}
+
+		cursor = transition
+	}
+
+	if !found {
+		return ""
+	}
+
+	nextLocal := next.start.In(fromZone)
+
+	// Compute the date predicted by the rule for the following year.
+	year := local.Year() + 1
+
+	var predicted time.Time
+
+	if nth > 0 {
+
+		first := time.Date(year, local.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+		dayOffset := (int(local.Weekday()) - int(first.Weekday()) + 7) % 7
+
+		predicted = first.AddDate(0, 0, dayOffset+(nth-1)*7)
+
+	} else {
+
+		last := time.Date(year, local.Month()+1, 0, 0, 0, 0, 0, time.UTC)
+
+		dayOffset := (int(last.Weekday()) - int(local.Weekday()) + 7) % 7
+
+		predicted = last.AddDate(0, 0, -dayOffset)
+	}
+
+	if nextLocal.Year() != predicted.Year() ||
+		nextLocal.Month() != predicted.Month() ||
+		nextLocal.Day() != predicted.Day() ||
+		nextLocal.Hour() != local.Hour() ||
+		nextLocal.Minute() != local.Minute() ||
+		nextLocal.Second() != local.Second() {
+		return ""
+	}
+
+	weekDayCode := []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}[local.Weekday()]
+
+	return fmt.Sprintf("FREQ=YEARLY;BYMONTH=%v;BYDAY=%v%v",
+		int(local.Month()), nth, weekDayCode)
+}
+
+// getTimeZoneRanges - Returns the time span covered by each 'TZID'
+// referenced by the events of 'iCal'. The time span includes the
+// start, end, recurrence and exclusion date times of each event.
+// For events recurring without end, the 'extend' flag is set.
+//
+func (iCalMech *iCalendarMechanics) getTimeZoneRanges(
+	iCal *ICalendar,
+	ePrefix string) (
+	map[string]iCalendarTzRange,
+	error) {
+
+	ePrefix += "iCalendarMechanics.getTimeZoneRanges() "
+
+	tzRanges := make(map[string]iCalendarTzRange)
+
+	addDateTime := func(iCalDt *ICalendarDateTime, t time.Time, extend bool) {
+
+		if iCalDt.form != ICalTimeForm.Zoned() {
+			return
+		}
+
+		tzRange, ok := tzRanges[iCalDt.tzId]
+
+		if !ok {
+			tzRange = iCalendarTzRange{
+				locPtr:   iCalDt.dateTime.dateTimeValue.Location(),
+				earliest: t,
+				latest:   t,
+			}
+		}
+
+		if t.Before(tzRange.earliest) {
+			tzRange.earliest = t
+		}
+
+		if t.After(tzRange.latest) {
+			tzRange.latest = t
+		}
+
+		tzRange.extend = tzRange.extend || extend
+
+		tzRanges[iCalDt.tzId] = tzRange
+	}
+
+	for i := 0; i < len(iCal.events); i++ {
+
+		iCalEvent := &iCal.events[i]
+
+		dateTimes := make([]ICalendarDateTime, 0, 2)
+
+		dateTimes = append(dateTimes, iCalEvent.dtStart)
+
+		if iCalEvent.dtEnd.form != ICalTimeForm.None() {
+			dateTimes = append(dateTimes, iCalEvent.dtEnd)
+		}
+
+		dateTimes = append(dateTimes, iCalEvent.rDates...)
+
+		dateTimes = append(dateTimes, iCalEvent.exDates...)
+
+		for j := 0; j < len(dateTimes); j++ {
+			addDateTime(&dateTimes[j], dateTimes[j].dateTime.dateTimeValue, false)
+		}
+
+		if len(iCalEvent.rRules) == 0 {
+			continue
+		}
+
+		isBounded := true
+
+		for j := 0; j < len(iCalEvent.rRules); j++ {
+			if !iCalEvent.rRules[j].IsBounded() {
+				isBounded = false
+			}
+		}
+
+		if !isBounded {
+			addDateTime(&iCalEvent.dtStart, iCalEvent.dtStart.dateTime.dateTimeValue, true)
+			continue
+		}
+
+		recurSet, err := iCalMech.newRecurrenceSet(iCalEvent, ePrefix)
+
+		if err != nil {
+			return tzRanges, err
+		}
+
+		occurrences, err := recurSet.GetOccurrences(0, ePrefix)
+
+		if err != nil {
+			return tzRanges, err
+		}
+
+		if len(occurrences) == 0 {
+			continue
+		}
+
+		lastOccurrence := occurrences[len(occurrences)-1].dateTimeValue
+
+		if iCalEvent.dtEnd.form != ICalTimeForm.None() {
+			lastOccurrence = lastOccurrence.Add(
+				iCalEvent.dtEnd.dateTime.dateTimeValue.Sub(
+					iCalEvent.dtStart.dateTime.dateTimeValue))
+		}
+
+		addDateTime(&iCalEvent.dtStart, lastOccurrence, false)
+	}
+
+	return tzRanges, nil
+}
+
+// getVTimeZoneTransitions - Expands the observances of a parsed
+// 'VTIMEZONE' component into individual transitions occurring before
+// 'horizon'. Recurrence rules are evaluated in the local time in
+// effect before each transition. The transitions are returned in
+// ascending order. Return value 'isOpenEnded' is set to 'true' if
+// an observance recurs without end.
+//
+func (iCalMech *iCalendarMechanics) getVTimeZoneTransitions(
+	observances []iCalendarObservance,
+	horizon time.Time,
+	ePrefix string) (
+	transitions []iCalendarObservance,
+	isOpenEnded bool,
+	err error) {
+
+	ePrefix += "iCalendarMechanics.getVTimeZoneTransitions() "
+
+	transitions = make([]iCalendarObservance, 0, 16)
+
+	for _, observance := range observances {
+
+		if len(observance.rRule) == 0 {
+			transitions = append(transitions, observance)
+			continue
+		}
+
+		if !observance.start.Before(horizon) {
+			continue
+		}
+
+		var rule RecurrenceRule
+
+		rule, err = RecurrenceRule{}.NewFromString(observance.rRule, ePrefix)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !rule.IsBounded() {
+			isOpenEnded = true
+		}
+
+		// Local times are expressed in UTC. A UTC 'UNTIL' value is
+		// converted to the same local time.
+		offsetFrom := time.Duration(observance.offsetFrom) * time.Second
+
+		if rule.hasUntil && rule.untilIsUtc {
+			rule.untilDateTime = rule.untilDateTime.Add(offsetFrom)
+		}
+
+		var dtStart, dtEnd DateTzDto
+
+		dtStart, err = DateTzDto{}.NewDateTime(
+			observance.start.Add(offsetFrom),
+			FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		dtEnd, err = DateTzDto{}.NewDateTime(
+			horizon.Add(offsetFrom),
+			FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		var recurSet RecurrenceSet
+
+		recurSet, err = RecurrenceSet{}.New(dtStart, TCalcMode.LocalTimeZone(), ePrefix)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		err = recurSet.AddRRule(rule, ePrefix)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		var occurrences []DateTzDto
+
+		occurrences, err = recurSet.Between(dtStart, dtEnd, ePrefix)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		for _, occurrence := range occurrences {
+
+			transition := observance
+
+			transition.start = occurrence.dateTimeValue.Add(-offsetFrom)
+
+			transition.rRule = ""
+
+			transitions = append(transitions, transition)
+		}
+	}
+
+	sort.Slice(transitions, func(i, j int) bool {
+		return transitions[i].start.Before(transitions[j].start)
+	})
+
+	return transitions, isOpenEnded, nil
+}
+
+// isEventProperty - Returns 'true' if 'propertyName' identifies a
+// property which is parsed and maintained individually by type
+// ICalendarEvent.
+//
+func (iCalMech *iCalendarMechanics) isEventProperty(propertyName string) bool {
+
+	return mICalendarEventProperties[strings.ToUpper(propertyName)]
+}
+
+// matchVTimeZone - Returns the name of the IANA time zone whose UTC
+// offsets match the observances of a 'VTIMEZONE' component. Each
+// time zone in the current time zone database is compared at every
+// transition, and at the start and middle of every year, within a
+// window of up to five years.
+//
+// If the observances recur without end, or specify a single UTC
+// offset, the window ends with the year following the current year.
+// Otherwise, the window ends with the year of the final transition.
+//
+// When several time zones match, those whose abbreviations agree
+// with the 'TZNAME' properties are preferred. Remaining ties are
+// resolved with the priority list used for time zone abbreviations.
+// If no time zone matches, return value 'found' is set to 'false'.
+//
+func (iCalMech *iCalendarMechanics) matchVTimeZone(
+	contentLines []iCalendarContentLine,
+	ePrefix string) (
+	timeZoneName string,
+	found bool,
+	err error) {
+
+	ePrefix += "iCalendarMechanics.matchVTimeZone() "
+
+	observances, err := iCalMech.parseObservances(contentLines, ePrefix)
+
+	if err != nil || len(observances) == 0 {
+		return "", false, err
+	}
+
+	latestStartYear := observances[0].start.Year()
+
+	isSingleOffset := true
+
+	for _, observance := range observances {
+
+		if observance.start.Year() > latestStartYear {
+			latestStartYear = observance.start.Year()
+		}
+
+		if observance.offsetFrom != observances[0].offsetFrom ||
+			observance.offsetTo != observances[0].offsetFrom {
+			isSingleOffset = false
+		}
+	}
+
+	lastYear := time.Now().UTC().Year()
+
+	if latestStartYear > lastYear {
+		lastYear = latestStartYear
+	}
+
+	lastYear++
+
+	transitions, isOpenEnded, err := iCalMech.getVTimeZoneTransitions(
+		observances,
+		time.Date(lastYear+1, 1, 1, 0, 0, 0, 0, time.UTC),
+		ePrefix)
+
+	if err != nil || len(transitions) == 0 {
+		return "", false, err
+	}
+
+	firstYear := latestStartYear
+
+	if !isOpenEnded && !isSingleOffset {
+		firstYear = transitions[0].start.Year()
+		lastYear = transitions[len(transitions)-1].start.Year()
+	}
+
+	if firstYear < lastYear-4 {
+		firstYear = lastYear - 4
+	}
+
+	windowStart := time.Date(firstYear, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	windowEnd := time.Date(lastYear+1, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	samples := make([]time.Time, 0, 32)
+
+	for year := firstYear; year <= lastYear; year++ {
+		samples = append(samples,
+			time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(year, 7, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	for _, transition := range transitions {
+
+		if transition.start.Before(windowStart) ||
+			!transition.start.Before(windowEnd) {
+			continue
+		}
+
+		samples = append(samples,
+			transition.start.Add(-1*time.Second),
+			transition.start)
+	}
+
+	expectedOffsets := make([]int, len(samples))
+
+	expectedTzNames := make([]string, len(samples))
+
+	for i, sample := range samples {
+
+		expectedOffsets[i] = transitions[0].offsetFrom
+
+		for _, transition := range transitions {
+
+			if transition.start.After(sample) {
+				break
+			}
+
+			expectedOffsets[i] = transition.offsetTo
+			expectedTzNames[i] = transition.tzName
+		}
+	}
+
+	tzDbMech := timeZoneDatabaseMechanics{}
+
+	tzDatabase, err := tzDbMech.getCurrentDatabase(ePrefix)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	tzAbbrvResMech := tzAbbrvResolverMechanics{}
+
+	bestMismatches := 0
+
+	bestPriority := 0
+
+	for _, candidate := range tzDatabase.GetTimeZoneNames() {
+
+		locPtr, loadErr := tzDatabase.LoadLocation(candidate, ePrefix)
+
+		if loadErr != nil {
+			continue
+		}
+
+		isMatch := true
+
+		mismatches := 0
+
+		for i, sample := range samples {
+
+			tzName, offset := sample.In(locPtr).Zone()
+
+			if offset != expectedOffsets[i] {
+				isMatch = false
+				break
+			}
+
+			if len(expectedTzNames[i]) > 0 &&
+				tzName != expectedTzNames[i] {
+				mismatches++
+			}
+		}
+
+		if !isMatch {
+			continue
+		}
+
+		priority := tzAbbrvResMech.getPriority(candidate)
+
+		if found &&
+			(mismatches > bestMismatches ||
+				(mismatches == bestMismatches && priority >= bestPriority)) {
+			continue
+		}
+
+		timeZoneName = candidate
+		bestMismatches = mismatches
+		bestPriority = priority
+		found = true
+	}
+
+	return timeZoneName, found, nil
+}
+
+// newDateTime - Returns a new ICalendarDateTime instance
+// containing date time 't'.
+//
+func (iCalMech *iCalendarMechanics) newDateTime(
+	t time.Time,
+	form ICalendarTimeForm,
+	tzId string,
+	ePrefix string) (
+	ICalendarDateTime,
+	error) {
+
+	dTz, err := DateTzDto{}.NewDateTime(t, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		return ICalendarDateTime{},
+			fmt.Errorf(ePrefix+
+				"\nError returned by DateTzDto{}.NewDateTime(t).\n"+
+				"t='%v'\nError='%v'\n",
+				t.Format(FmtDateTimeYrMDayFmtStr), err.Error())
+	}
+
+	return ICalendarDateTime{
+		dateTime: dTz,
+		form:     form,
+		tzId:     tzId,
+		lock:     new(sync.Mutex),
+	}, nil
+}
+
+// newObservance - Returns a 'VTIMEZONE' observance describing the
+// transition which occurs at 'transition' in time zone location
+// 'locPtr'.
+//
+func (iCalMech *iCalendarMechanics) newObservance(
+	transition time.Time,
+	locPtr *time.Location) iCalendarObservance {
+
+	after := transition.In(locPtr)
+
+	_, offsetFrom := transition.Add(-1 * time.Nanosecond).In(locPtr).Zone()
+
+	tzName, offsetTo := after.Zone()
+
+	return iCalendarObservance{
+		isDaylight: after.IsDST(),
+		hasStart:   true,
+		start:      transition,
+		offsetFrom: offsetFrom,
+		offsetTo:   offsetTo,
+		tzName:     tzName,
+	}
+}
+
+// newRecurrenceSet - Returns a RecurrenceSet composed of the start
+// date time, recurrence rules, recurrence dates, exclusion rules and
+// exclusion dates of 'iCalEvent'.
+//
+func (iCalMech *iCalendarMechanics) newRecurrenceSet(
+	iCalEvent *ICalendarEvent,
+	ePrefix string) (
+	RecurrenceSet,
+	error) {
+
+	ePrefix += "iCalendarMechanics.newRecurrenceSet() "
+
+	recurSet, err := RecurrenceSet{}.New(
+		iCalEvent.dtStart.dateTime,
+		TCalcMode.LocalTimeZone(),
+		ePrefix)
+
+	if err != nil {
+		return RecurrenceSet{}, err
+	}
+
+	for i := 0; i < len(iCalEvent.rRules); i++ {
+
+		err = recurSet.AddRRule(iCalEvent.rRules[i], ePrefix)
+
+		if err != nil {
+			return RecurrenceSet{}, err
+		}
+	}
+
+	for i := 0; i < len(iCalEvent.exRules); i++ {
+
+		err = recurSet.AddExRule(iCalEvent.exRules[i], ePrefix)
+
+		if err != nil {
+			return RecurrenceSet{}, err
+		}
+	}
+
+	for i := 0; i < len(iCalEvent.rDates); i++ {
+
+		err = recurSet.AddRDate(iCalEvent.rDates[i].dateTime, ePrefix)
+
+		if err != nil {
+			return RecurrenceSet{}, err
+		}
+	}
+
+	for i := 0; i < len(iCalEvent.exDates); i++ {
+
+		err = recurSet.AddExDate(iCalEvent.exDates[i].dateTime, ePrefix)
+
+		if err != nil {
+			return RecurrenceSet{}, err
+		}
+	}
+
+	return recurSet, nil
+}
+
+// parseCalendar - Parses the text of an iCalendar object and returns
+// a new ICalendar instance.
+//
+func (iCalMech *iCalendarMechanics) parseCalendar(
+	iCalendarText string,
+	floatingTimeZoneName string,
+	ePrefix string) (
+	ICalendar,
+	error) {
+
+	ePrefix += "iCalendarMechanics.parseCalendar() "
+
+	if len(floatingTimeZoneName) == 0 {
+		floatingTimeZoneName = TZones.UTC()
+	}
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	floatingLocPtr, err := tzDstMech.getLocationPtr(
+		time.Now().UTC(),
+		floatingTimeZoneName,
+		ePrefix)
+
+	if err != nil {
+		return ICalendar{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "floatingTimeZoneName",
+				inputParameterValue: floatingTimeZoneName,
+				errMsg:              "Input parameter 'floatingTimeZoneName' is INVALID!",
+				err:                 err,
+			}
+	}
+
+	lines := iCalMech.unfoldLines(iCalendarText)
+
+	if len(lines) == 0 {
+		return ICalendar{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "iCalendarText",
+				inputParameterValue: "",
+				errMsg:              "Input parameter 'iCalendarText' is EMPTY!",
+				err:                 nil,
+			}
+	}
+
+	contentLines := make([]iCalendarContentLine, len(lines))
+
+	for i := 0; i < len(lines); i++ {
+
+		contentLines[i], err = iCalMech.parseContentLine(lines[i], ePrefix)
+
+		if err != nil {
+			return ICalendar{}, err
+		}
+	}
+
+	if contentLines[0].name != "BEGIN" ||
+		!strings.EqualFold(contentLines[0].value, "VCALENDAR") {
+		return ICalendar{},
+			fmt.Errorf(ePrefix+
+				"\nError: The iCalendar object does NOT begin with 'BEGIN:VCALENDAR'!\n"+
+				"First Line='%v'\n", lines[0])
+	}
+
+	last := len(contentLines) - 1
+
+	if contentLines[last].name != "END" ||
+		!strings.EqualFold(contentLines[last].value, "VCALENDAR") {
+		return ICalendar{},
+			fmt.Errorf(ePrefix+
+				"\nError: The iCalendar object does NOT end with 'END:VCALENDAR'!\n"+
+				"Last Line='%v'\n", lines[last])
+	}
+
+	newICal := ICalendar{
+		lock: new(sync.Mutex),
+	}
+
+	parseCtx := iCalendarParseContext{
+		floatingLocPtr:     floatingLocPtr,
+		tzIdLocPtrs:        make(map[string]*time.Location),
+		vTimeZoneLocations: make(map[string]string),
+		vTimeZoneLines:     make(map[string][]iCalendarContentLine),
+	}
+
+	// Each element holds the start and end index of a 'VEVENT'.
+	eventBounds := make([][2]int, 0, 8)
+
+	for i := 1; i < last; i++ {
+
+		contentLine := contentLines[i]
+
+		if contentLine.name == "END" {
+			return ICalendar{},
+				fmt.Errorf(ePrefix+
+					"\nError: Unexpected 'END' content line!\n"+
+					"Line='%v'\n", lines[i])
+		}
+
+		if contentLine.name != "BEGIN" {
+
+			switch contentLine.name {
+
+			case "PRODID":
+				newICal.prodId = contentLine.value
+
+			case "VERSION":
+				newICal.version = contentLine.value
+
+			default:
+				newICal.otherLines = append(newICal.otherLines, lines[i])
+			}
+
+			continue
+		}
+
+		componentName := strings.ToUpper(contentLine.value)
+
+		end, err := iCalMech.findComponentEnd(contentLines, i, last, ePrefix)
+
+		if err != nil {
+			return ICalendar{}, err
+		}
+
+		switch componentName {
+
+		case "VEVENT":
+			eventBounds = append(eventBounds, [2]int{i, end})
+
+		case "VTIMEZONE":
+			iCalMech.parseVTimeZone(contentLines[i+1:end], &parseCtx)
+
+		default:
+			newICal.otherComponents = append(newICal.otherComponents, lines[i:end+1]...)
+		}
+
+		i = end
+	}
+
+	if len(newICal.version) > 0 && newICal.version != "2.0" {
+		return ICalendar{},
+			fmt.Errorf(ePrefix+
+				"\nError: The iCalendar 'VERSION' is NOT supported!\n"+
+				"VERSION='%v'\n", newICal.version)
+	}
+
+	newICal.version = "2.0"
+
+	for _, bounds := range eventBounds {
+
+		iCalEvent, err := iCalMech.parseEvent(
+			lines[bounds[0]+1:bounds[1]],
+			contentLines[bounds[0]+1:bounds[1]],
+			&parseCtx,
+			ePrefix)
+
+		if err != nil {
+			return ICalendar{}, err
+		}
+
+		newICal.events = append(newICal.events, iCalEvent)
+	}
+
+	return newICal, nil
+}
+
+// findComponentEnd - Returns the index of the 'END' content line
+// matching the 'BEGIN' content line at index 'begin'. Nested
+// components are skipped.
+//
+func (iCalMech *iCalendarMechanics) findComponentEnd(
+	contentLines []iCalendarContentLine,
+	begin int,
+	limit int,
+	ePrefix string) (
+	int,
+	error) {
+
+	names := []string{strings.ToUpper(contentLines[begin].value)}
+
+	for i := begin + 1; i < limit; i++ {
+
+		switch contentLines[i].name {
+
+		case "BEGIN":
+			names = append(names, strings.ToUpper(contentLines[i].value))
+
+		case "END":
+			top := names[len(names)-1]
+
+			if !strings.EqualFold(contentLines[i].value, top) {
+				return -1,
+					fmt.Errorf(ePrefix+
+						"\nError: Mismatched component end!\n"+
+						"Expected 'END:%v'. Found 'END:%v'.\n",
+						top, contentLines[i].value)
+			}
+
+			names = names[:len(names)-1]
+
+			if len(names) == 0 {
+				return i, nil
+			}
+		}
+	}
+
+	return -1,
+		fmt.Errorf(ePrefix+
+			"\nError: Component 'BEGIN:%v' has no matching 'END'!\n",
+			contentLines[begin].value)
+}
+
+// parseContentLine - Parses a single, unfolded iCalendar content
+// line.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.1
+//
+func (iCalMech *iCalendarMechanics) parseContentLine(
+	line string,
+	ePrefix string) (
+	iCalendarContentLine,
+	error) {
+
+	contentLine := iCalendarContentLine{
+		params: make(map[string]string),
+	}
+
+	isNameChar := func(c byte) bool {
+		return (c >= 'A' && c <= 'Z') ||
+			(c >= 'a' && c <= 'z') ||
+			(c >= '0' && c <= '9') ||
+			c == '-'
+	}
+
+	badLine := func(reason string) error {
+		return fmt.Errorf(ePrefix+
+			"\nError: Invalid iCalendar content line! %v\n"+
+			"Line='%v'\n", reason, line)
+	}
+
+	idx := 0
+
+	for idx < len(line) && isNameChar(line[idx]) {
+		idx++
+	}
+
+	if idx == 0 {
+		return contentLine, badLine("The property name is missing.")
+	}
+
+	contentLine.name = strings.ToUpper(line[:idx])
+
+	for idx < len(line) && line[idx] == ';' {
+
+		idx++
+
+		nameStart := idx
+
+		for idx < len(line) && isNameChar(line[idx]) {
+			idx++
+		}
+
+		if idx == nameStart || idx >= len(line) || line[idx] != '=' {
+			return contentLine, badLine("A parameter name is invalid.")
+		}
+
+		paramName := strings.ToUpper(line[nameStart:idx])
+
+		idx++
+
+		values := make([]string, 0, 1)
+
+		for {
+
+			if idx < len(line) && line[idx] == '"' {
+
+				closeIdx := strings.IndexByte(line[idx+1:], '"')
+
+				if closeIdx < 0 {
+					return contentLine, badLine("A quoted parameter value is not terminated.")
+				}
+
+				values = append(values, line[idx+1:idx+1+closeIdx])
+
+				idx += closeIdx + 2
+
+			} else {
+
+				valueStart := idx
+
+				for idx < len(line) &&
+					line[idx] != ',' &&
+					line[idx] != ';' &&
+					line[idx] != ':' {
+					idx++
+				}
+
+				values = append(values, line[valueStart:idx])
+			}
+
+			if idx < len(line) && line[idx] == ',' {
+				idx++
+				continue
+			}
+
+			break
+		}
+
+		contentLine.params[paramName] = strings.Join(values, ",")
+	}
+
+	if idx >= len(line) || line[idx] != ':' {
+		return contentLine, badLine("The ':' value delimiter is missing.")
+	}
+
+	contentLine.value = line[idx+1:]
+
+	return contentLine, nil
+}
+
+// parseDateTimeProperty - Parses the value of a date time property
+// such as 'DTSTART' or 'EXDATE'. The value may contain a comma
+// separated list of date times.
+//
+func (iCalMech *iCalendarMechanics) parseDateTimeProperty(
+	contentLine iCalendarContentLine,
+	parseCtx *iCalendarParseContext,
+	ePrefix string) (
+	[]ICalendarDateTime,
+	error) {
+
+	ePrefix += "iCalendarMechanics.parseDateTimeProperty() "
+
+	valueType := strings.ToUpper(contentLine.params["VALUE"])
+
+	if len(valueType) > 0 &&
+		valueType != "DATE" &&
+		valueType != "DATE-TIME" {
+		return nil,
+			fmt.Errorf(ePrefix+
+				"\nError: Property '%v' value type is NOT supported!\n"+
+				"VALUE='%v'\n", contentLine.name, valueType)
+	}
+
+	tzId := contentLine.params["TZID"]
+
+	var locPtr *time.Location
+
+	if len(tzId) > 0 {
+
+		var err error
+
+		locPtr, err = iCalMech.resolveTzId(tzId, parseCtx, ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	values := strings.Split(contentLine.value, ",")
+
+	iCalDts := make([]ICalendarDateTime, 0, len(values))
+
+	for _, value := range values {
+
+		isDate := valueType == "DATE" || len(value) == 8
+
+		naive, isUtc, ok := iCalMech.parseDateTimeValue(value, isDate)
+
+		if !ok {
+			return nil,
+				fmt.Errorf(ePrefix+
+					"\nError: Property '%v' contains an invalid date time value!\n"+
+					"Value='%v'\n", contentLine.name, value)
+		}
+
+		var form ICalendarTimeForm
+		var dateTime time.Time
+		dtTzId := ""
+
+		switch {
+
+		case isDate:
+			form = ICalTimeForm.Date()
+			dateTime = iCalMech.resolveLocalDateTime(naive, parseCtx.floatingLocPtr)
+
+		case isUtc:
+			form = ICalTimeForm.Utc()
+			dateTime = naive
+
+		case locPtr != nil:
+			form = ICalTimeForm.Zoned()
+			dateTime = iCalMech.resolveLocalDateTime(naive, locPtr)
+			dtTzId = tzId
+
+		default:
+			form = ICalTimeForm.Floating()
+			dateTime = iCalMech.resolveLocalDateTime(naive, parseCtx.floatingLocPtr)
+		}
+
+		iCalDt, err := iCalMech.newDateTime(dateTime, form, dtTzId, ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		iCalDts = append(iCalDts, iCalDt)
+	}
+
+	return iCalDts, nil
+}
+
+// parseDateTimeValue - Parses a single 'DATE' or 'DATE-TIME' value.
+// The date time components are returned in the UTC time zone. If the
+// value carries the UTC designator 'Z', return value 'isUtc' is set
+// to 'true'. If the value is invalid, return value 'ok' is set to
+// 'false'.
+//
+//  Examples: "19970714"  "19970714T133000"  "19970714T173000Z"
+//
+func (iCalMech *iCalendarMechanics) parseDateTimeValue(
+	value string,
+	isDate bool) (
+	naive time.Time,
+	isUtc bool,
+	ok bool) {
+
+	if isDate {
+
+		if len(value) != 8 {
+			return time.Time{}, false, false
+		}
+
+	} else {
+
+		if strings.HasSuffix(value, "Z") {
+			isUtc = true
+			value = value[:len(value)-1]
+		}
+
+		if len(value) != 15 || value[8] != 'T' {
+			return time.Time{}, false, false
+		}
+	}
+
+	components := make([]int, 6)
+
+	fields := [][2]int{{0, 4}, {4, 6}, {6, 8}, {9, 11}, {11, 13}, {13, 15}}
+
+	if isDate {
+		fields = fields[:3]
+	}
+
+	for k, field := range fields {
+
+		number, err := strconv.Atoi(value[field[0]:field[1]])
+
+		if err != nil || number < 0 {
+			return time.Time{}, false, false
+		}
+
+		components[k] = number
+	}
+
+	naive = time.Date(
+		components[0],
+		time.Month(components[1]),
+		components[2],
+		components[3],
+		components[4],
+		components[5],
+		0,
+		time.UTC)
+
+	if naive.Year() != components[0] ||
+		int(naive.Month()) != components[1] ||
+		naive.Day() != components[2] ||
+		naive.Hour() != components[3] ||
+		naive.Minute() != components[4] ||
+		naive.Second() != components[5] {
+		return time.Time{}, false, false
+	}
+
+	return naive, isUtc, true
+}
+
+// parseEvent - Parses the content lines of a 'VEVENT' component.
+// The 'BEGIN:VEVENT' and 'END:VEVENT' content lines are excluded.
+//
+func (iCalMech *iCalendarMechanics) parseEvent(
+	lines []string,
+	contentLines []iCalendarContentLine,
+	parseCtx *iCalendarParseContext,
+	ePrefix string) (
+	ICalendarEvent,
+	error) {
+
+	ePrefix += "iCalendarMechanics.parseEvent() "
+
+	iCalEvent := ICalendarEvent{
+		lock: new(sync.Mutex),
+	}
+
+	found := make(map[string]bool)
+
+	for i := 0; i < len(contentLines); i++ {
+
+		contentLine := contentLines[i]
+
+		if contentLine.name == "BEGIN" {
+
+			end, err := iCalMech.findComponentEnd(contentLines, i, len(contentLines), ePrefix)
+
+			if err != nil {
+				return ICalendarEvent{}, err
+			}
+
+			iCalEvent.otherLines = append(iCalEvent.otherLines, lines[i:end+1]...)
+
+			i = end
+
+			continue
+		}
+
+		switch contentLine.name {
+
+		case "UID", "DTSTAMP", "DTSTART", "DTEND", "SUMMARY", "DESCRIPTION", "LOCATION":
+
+			if found[contentLine.name] {
+				return ICalendarEvent{},
+					fmt.Errorf(ePrefix+
+						"\nError: Property '%v' occurs more than once!\n",
+						contentLine.name)
+			}
+
+			found[contentLine.name] = true
+		}
+
+		var err error
+		var iCalDts []ICalendarDateTime
+		var rule RecurrenceRule
+
+		switch contentLine.name {
+
+		case "UID":
+			iCalEvent.uid = iCalMech.unescapeText(contentLine.value)
+
+		case "SUMMARY":
+			iCalEvent.summary = iCalMech.unescapeText(contentLine.value)
+
+		case "DESCRIPTION":
+			iCalEvent.description = iCalMech.unescapeText(contentLine.value)
+
+		case "LOCATION":
+			iCalEvent.location = iCalMech.unescapeText(contentLine.value)
+
+		case "DTSTAMP", "DTSTART", "DTEND":
+
+			iCalDts, err = iCalMech.parseDateTimeProperty(contentLine, parseCtx, ePrefix)
+
+			if err != nil {
+				return ICalendarEvent{}, err
+			}
+
+			if len(iCalDts) != 1 {
+				return ICalendarEvent{},
+					fmt.Errorf(ePrefix+
+						"\nError: Property '%v' must contain a single value!\n"+
+						"Line='%v'\n", contentLine.name, lines[i])
+			}
+
+			switch contentLine.name {
+
+			case "DTSTAMP":
+				iCalEvent.dtStamp = iCalDts[0]
+
+			case "DTSTART":
+				iCalEvent.dtStart = iCalDts[0]
+
+			default:
+				iCalEvent.dtEnd = iCalDts[0]
+			}
+
+		case "RRULE", "EXRULE":
+
+			rule, err = RecurrenceRule{}.NewFromString(contentLine.value, ePrefix)
+
+			if err != nil {
+				return ICalendarEvent{}, err
+			}
+
+			if contentLine.name == "RRULE" {
+				iCalEvent.rRules = append(iCalEvent.rRules, rule)
+			} else {
+				iCalEvent.exRules = append(iCalEvent.exRules, rule)
+			}
+
+		case "RDATE", "EXDATE":
+
+			if strings.EqualFold(contentLine.params["VALUE"], "PERIOD") {
+				// Recurrence periods are retained, but are not
+				// evaluated.
+				iCalEvent.otherLines = append(iCalEvent.otherLines, lines[i])
+				continue
+			}
+
+			iCalDts, err = iCalMech.parseDateTimeProperty(contentLine, parseCtx, ePrefix)
+
+			if err != nil {
+				return ICalendarEvent{}, err
+			}
+
+			if contentLine.name == "RDATE" {
+				iCalEvent.rDates = append(iCalEvent.rDates, iCalDts...)
+			} else {
+				iCalEvent.exDates = append(iCalEvent.exDates, iCalDts...)
+			}
+
+		default:
+			iCalEvent.otherLines = append(iCalEvent.otherLines, lines[i])
+		}
+	}
+
+	err := iCalMech.testEventValidity(&iCalEvent, ePrefix)
+
+	if err != nil {
+		return ICalendarEvent{}, err
+	}
+
+	return iCalEvent, nil
+}
+
+// parseObservances - Parses the 'STANDARD' and 'DAYLIGHT'
+// sub-components of a 'VTIMEZONE' component. The onset of each
+// observance is converted to an instant using the UTC offset in
+// effect before the onset ('TZOFFSETFROM'). Each 'RDATE' value adds
+// a further observance without a recurrence rule.
+//
+func (iCalMech *iCalendarMechanics) parseObservances(
+	contentLines []iCalendarContentLine,
+	ePrefix string) (
+	[]iCalendarObservance,
+	error) {
+
+	ePrefix += "iCalendarMechanics.parseObservances() "
+
+	observances := make([]iCalendarObservance, 0, 4)
+
+	for i := 0; i < len(contentLines); i++ {
+
+		if contentLines[i].name != "BEGIN" {
+			continue
+		}
+
+		componentName := strings.ToUpper(contentLines[i].value)
+
+		end, err := iCalMech.findComponentEnd(contentLines, i, len(contentLines), ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		observanceLines := contentLines[i+1 : end]
+
+		i = end
+
+		if componentName != "STANDARD" &&
+			componentName != "DAYLIGHT" {
+			continue
+		}
+
+		badProperty := func(propertyName, value string) error {
+			return fmt.Errorf(ePrefix+
+				"\nError: Observance '%v' contains an invalid '%v' property!\n"+
+				"Value='%v'\n", componentName, propertyName, value)
+		}
+
+		observance := iCalendarObservance{
+			isDaylight: componentName == "DAYLIGHT",
+			hasStart:   true,
+		}
+
+		dtStart := ""
+
+		rDates := make([]string, 0)
+
+		found := make(map[string]bool)
+
+		for _, contentLine := range observanceLines {
+
+			ok := true
+
+			switch contentLine.name {
+
+			case "DTSTART":
+				dtStart = contentLine.value
+
+			case "RDATE":
+				rDates = append(rDates, strings.Split(contentLine.value, ",")...)
+
+			case "TZOFFSETFROM":
+				observance.offsetFrom, ok = iCalMech.parseUtcOffset(contentLine.value)
+
+			case "TZOFFSETTO":
+				observance.offsetTo, ok = iCalMech.parseUtcOffset(contentLine.value)
+
+			case "TZNAME":
+				observance.tzName = contentLine.value
+
+			case "RRULE":
+				observance.rRule = contentLine.value
+			}
+
+			if !ok {
+				return nil, badProperty(contentLine.name, contentLine.value)
+			}
+
+			found[contentLine.name] = true
+		}
+
+		for _, propertyName := range []string{"DTSTART", "TZOFFSETFROM", "TZOFFSETTO"} {
+
+			if !found[propertyName] {
+				return nil,
+					fmt.Errorf(ePrefix+
+						"\nError: Observance '%v' has no '%v' property!\n",
+						componentName, propertyName)
+			}
+		}
+
+		onsets := append([]string{dtStart}, rDates...)
+
+		for k, onset := range onsets {
+
+			naive, isUtc, ok := iCalMech.parseDateTimeValue(onset, len(onset) == 8)
+
+			if !ok {
+
+				propertyName := "RDATE"
+
+				if k == 0 {
+					propertyName = "DTSTART"
+				}
+
+				return nil, badProperty(propertyName, onset)
+			}
+
+			onsetObservance := observance
+
+			onsetObservance.start = naive
+
+			if !isUtc {
+				onsetObservance.start = naive.Add(
+					time.Duration(-observance.offsetFrom) * time.Second)
+			}
+
+			if k > 0 {
+				onsetObservance.rRule = ""
+			}
+
+			observances = append(observances, onsetObservance)
+		}
+	}
+
+	return observances, nil
+}
+
+// parseUtcOffset - Parses an iCalendar 'UTC-OFFSET' value and returns
+// the offset in seconds. If the value is invalid, return value 'ok'
+// is set to 'false'.
+//
+//  Examples: "-0500"  "+0530"  "+003045"
+//
+func (iCalMech *iCalendarMechanics) parseUtcOffset(
+	value string) (
+	offsetSeconds int,
+	ok bool) {
+
+	if len(value) != 5 && len(value) != 7 {
+		return 0, false
+	}
+
+	sign := 1
+
+	switch value[0] {
+
+	case '+':
+
+	case '-':
+		sign = -1
+
+	default:
+		return 0, false
+	}
+
+	components := []int{0, 0, 0}
+
+	for k := 0; 1+k*2 < len(value); k++ {
+
+		digits := value[1+k*2 : 3+k*2]
+
+		if digits[0] < '0' || digits[0] > '9' ||
+			digits[1] < '0' || digits[1] > '9' {
+			return 0, false
+		}
+
+		components[k], _ = strconv.Atoi(digits)
+	}
+
+	if components[0] > 23 ||
+		components[1] > 59 ||
+		components[2] > 59 {
+		return 0, false
+	}
+
+	return sign * (components[0]*3600 + components[1]*60 + components[2]), true
+}
+
+// parseVTimeZone - Records the 'X-LIC-LOCATION' property and the
+// content lines of a 'VTIMEZONE' component. The observances of the
+// component are evaluated only if its 'TZID' value cannot otherwise
+// be resolved to a time zone. Reference method resolveTzId().
+//
+func (iCalMech *iCalendarMechanics) parseVTimeZone(
+	contentLines []iCalendarContentLine,
+	parseCtx *iCalendarParseContext) {
+
+	tzId := ""
+	location := ""
+	depth := 0
+
+	for _, contentLine := range contentLines {
+
+		switch contentLine.name {
+
+		case "BEGIN":
+			depth++
+
+		case "END":
+			depth--
+
+		case "TZID":
+			if depth == 0 {
+				tzId = contentLine.value
+			}
+
+		case "X-LIC-LOCATION":
+			if depth == 0 {
+				location = contentLine.value
+			}
+		}
+	}
+
+	if len(tzId) == 0 {
+		return
+	}
+
+	parseCtx.vTimeZoneLines[tzId] = contentLines
+
+	if len(location) > 0 {
+		parseCtx.vTimeZoneLocations[tzId] = location
+	}
+}
+
+// resolveLocalDateTime - Converts the date time components of
+// 'naive' to an instant in time zone location 'locPtr' applying the
+// rules of RFC 5545, section 3.3.5. Nonexistent local times are
+// shifted forward by the length of the gap. Ambiguous local times
+// resolve to the first occurrence.
+//
+func (iCalMech *iCalendarMechanics) resolveLocalDateTime(
+	naive time.Time,
+	locPtr *time.Location) time.Time {
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	earlier, later, _, isNonexistent :=
+		tzDstMech.classifyLocalDateTime(naive, locPtr)
+
+	if isNonexistent {
+		return later
+	}
+
+	return earlier
+}
+
+// resolveTzId - Returns the IANA time zone location identified by
+// 'tzId'. Reference type 'ICalendar' for the resolution rules.
+//
+func (iCalMech *iCalendarMechanics) resolveTzId(
+	tzId string,
+	parseCtx *iCalendarParseContext,
+	ePrefix string) (
+	*time.Location,
+	error) {
+
+	ePrefix += "iCalendarMechanics.resolveTzId() "
+
+	locPtr, ok := parseCtx.tzIdLocPtrs[tzId]
+
+	if ok {
+		return locPtr, nil
+	}
+
+	candidates := []string{tzId}
+
+	parts := strings.Split(strings.Trim(tzId, "/"), "/")
+
+	for i := 1; i < len(parts); i++ {
+		candidates = append(candidates, strings.Join(parts[i:], "/"))
+	}
+
+	location, ok := parseCtx.vTimeZoneLocations[tzId]
+
+	if ok {
+		candidates = append(candidates, location)
+	}
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	referenceDateTime := time.Now().UTC()
+
+	for _, candidate := range candidates {
+
+		if !strings.Contains(candidate, "/") &&
+			candidate != tzId &&
+			candidate != location {
+			// Single trailing components such as 'New_York' are
+			// not time zone names.
+			continue
+		}
+
+		var err error
+
+		locPtr, err = tzDstMech.getLocationPtr(referenceDateTime, candidate, ePrefix)
+
+		if err == nil && locPtr != nil {
+			parseCtx.tzIdLocPtrs[tzId] = locPtr
+			return locPtr, nil
+		}
+	}
+
+	vTimeZoneLines, ok := parseCtx.vTimeZoneLines[tzId]
+
+	if ok {
+
+		timeZoneName, found, err := iCalMech.matchVTimeZone(vTimeZoneLines, ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+
+			locPtr, err = tzDstMech.getLocationPtr(referenceDateTime, timeZoneName, ePrefix)
+
+			if err != nil {
+				return nil, err
+			}
+
+			parseCtx.tzIdLocPtrs[tzId] = locPtr
+
+			return locPtr, nil
+		}
+	}
+
+	return nil,
+		fmt.Errorf(ePrefix+
+			"\nError: 'TZID' could NOT be resolved to a time zone!\n"+
+			"TZID='%v'\n", tzId)
+}
+
+// testDateTimeValidity - Returns an error if 'iCalDt' is invalid.
+//
+func (iCalMech *iCalendarMechanics) testDateTimeValidity(
+	iCalDt *ICalendarDateTime,
+	ePrefix string) error {
+
+	ePrefix += "iCalendarMechanics.testDateTimeValidity() "
+
+	if !iCalDt.form.XIsValid() {
+		return fmt.Errorf(ePrefix+
+			"\nError: The iCalendar Time Form is INVALID!\n"+
+			"form='%v'\n", iCalDt.form.String())
+	}
+
+	if iCalDt.form == ICalTimeForm.Zoned() &&
+		len(iCalDt.tzId) == 0 {
+		return errors.New(ePrefix + "\n" +
+			"Error: The 'TZID' of a Zoned date time is an EMPTY string!\n")
+	}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	return dTzUtil.isValidDateTzDto(&iCalDt.dateTime, ePrefix)
+}
+
+// testEventValidity - Returns an error if 'iCalEvent' is invalid.
+//
+func (iCalMech *iCalendarMechanics) testEventValidity(
+	iCalEvent *ICalendarEvent,
+	ePrefix string) error {
+
+	ePrefix += "iCalendarMechanics.testEventValidity() "
+
+	if len(iCalEvent.uid) == 0 {
+		return errors.New(ePrefix + "\n" +
+			"Error: The event 'UID' property is missing!\n")
+	}
+
+	if iCalEvent.dtStart.form == ICalTimeForm.None() {
+		return fmt.Errorf(ePrefix+
+			"\nError: The event 'DTSTART' property is missing!\n"+
+			"UID='%v'\n", iCalEvent.uid)
+	}
+
+	err := iCalMech.testDateTimeValidity(&iCalEvent.dtStart, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	if iCalEvent.dtStamp.form != ICalTimeForm.None() {
+
+		err = iCalMech.testDateTimeValidity(&iCalEvent.dtStamp, ePrefix)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if iCalEvent.dtEnd.form == ICalTimeForm.None() {
+		return nil
+	}
+
+	err = iCalMech.testDateTimeValidity(&iCalEvent.dtEnd, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	if (iCalEvent.dtStart.form == ICalTimeForm.Date()) !=
+		(iCalEvent.dtEnd.form == ICalTimeForm.Date()) {
+		return fmt.Errorf(ePrefix+
+			"\nError: 'DTSTART' and 'DTEND' must have the same value type!\n"+
+			"UID='%v'\n", iCalEvent.uid)
+	}
+
+	if iCalEvent.dtEnd.dateTime.dateTimeValue.Before(
+		iCalEvent.dtStart.dateTime.dateTimeValue) {
+		return fmt.Errorf(ePrefix+
+			"\nError: 'DTEND' occurs before 'DTSTART'!\n"+
+			"UID='%v'\n", iCalEvent.uid)
+	}
+
+	return nil
+}
+
+// unescapeText - Removes the escape sequences from an iCalendar
+// 'TEXT' property value.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.3.11
+//
+func (iCalMech *iCalendarMechanics) unescapeText(text string) string {
+
+	if !strings.Contains(text, "\\") {
+		return text
+	}
+
+	sb := strings.Builder{}
+
+	for i := 0; i < len(text); i++ {
+
+		if text[i] != '\\' || i+1 == len(text) {
+			sb.WriteByte(text[i])
+			continue
+		}
+
+		i++
+
+		switch text[i] {
+
+		case 'n', 'N':
+			sb.WriteByte('\n')
+
+		default:
+			sb.WriteByte(text[i])
+		}
+	}
+
+	return sb.String()
+}
+
+// unfoldLines - Splits iCalendar text into unfolded content lines.
+// Lines may be terminated with CRLF or LF. A line beginning with a
+// space or horizontal tab is a continuation of the preceding line.
+// Empty lines are discarded.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.1
+//
+func (iCalMech *iCalendarMechanics) unfoldLines(iCalendarText string) []string {
+
+	rawLines := strings.Split(iCalendarText, "\n")
+
+	lines := make([]string, 0, len(rawLines))
+
+	for _, rawLine := range rawLines {
+
+		rawLine = strings.TrimSuffix(rawLine, "\r")
+
+		if len(rawLine) == 0 {
+			continue
+		}
+
+		if (rawLine[0] == ' ' || rawLine[0] == '\t') &&
+			len(lines) > 0 {
+			lines[len(lines)-1] += rawLine[1:]
+			continue
+		}
+
+		lines = append(lines, rawLine)
+	}
+
+	return lines
+}
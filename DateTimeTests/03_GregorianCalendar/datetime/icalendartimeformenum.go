@@ -0,0 +1,345 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mICalendarTimeFormStringToCode = map[string]ICalendarTimeForm{
+	"None"      : ICalendarTimeForm(0),
+	"Date"      : ICalendarTimeForm(1),
+	"Floating"  : ICalendarTimeForm(2),
+	"Utc"       : ICalendarTimeForm(3),
+	"Zoned"     : ICalendarTimeForm(4),
+}
+
+var mICalendarTimeFormLwrCaseStringToCode = map[string]ICalendarTimeForm{
+	"none"      : ICalendarTimeForm(0),
+	"date"      : ICalendarTimeForm(1),
+	"floating"  : ICalendarTimeForm(2),
+	"utc"       : ICalendarTimeForm(3),
+	"zoned"     : ICalendarTimeForm(4),
+}
+
+var mICalendarTimeFormCodeToString = map[ICalendarTimeForm]string{
+	ICalendarTimeForm(0) : "None",
+	ICalendarTimeForm(1) : "Date",
+	ICalendarTimeForm(2) : "Floating",
+	ICalendarTimeForm(3) : "Utc",
+	ICalendarTimeForm(4) : "Zoned",
+}
+
+// ICalendarTimeForm - An enumeration of the forms in which date and
+// date time values are expressed in the iCalendar specification,
+// RFC 5545.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.3.4
+//   https://tools.ietf.org/html/rfc5545#section-3.3.5
+//
+// Since Go does not directly support enumerations, the 'ICalendarTimeForm'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'ICalendarTimeForm' is declared as a type 'int'. The
+// method names effectively represent an enumeration of iCalendar time
+// forms. These methods are listed as follows:
+//
+//
+// None         (0) - Signals that the iCalendar Time Form is not
+//                    initialized. This is an error condition.
+//
+// Date         (1) - A 'DATE' value with no time of day.
+//                    Example: "DTSTART;VALUE=DATE:19970714"
+//
+// Floating     (2) - A local 'DATE-TIME' value which is not bound to
+//                    any time zone. The value identifies the same
+//                    wall clock time in every time zone.
+//                    Example: "DTSTART:19970714T133000"
+//
+// Utc          (3) - A 'DATE-TIME' value expressed in Coordinated
+//                    Universal Time (UTC).
+//                    Example: "DTSTART:19970714T173000Z"
+//
+// Zoned        (4) - A local 'DATE-TIME' value bound to the time zone
+//                    identified by the 'TZID' property parameter.
+//                    Example:
+//                      "DTSTART;TZID=America/New_York:19970714T133000"
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'ICalTimeForm'. Example: ICalTimeForm.Zoned()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: ICalendarTimeForm(0).Zoned()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the ICalendarTimeForm methods in alphabetical order. Be advised that all
+// 'ICalendarTimeForm' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type ICalendarTimeForm int
+
+var lockICalendarTimeForm sync.Mutex
+
+// None - Signals that the ICalendarTimeForm is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (iCalForm ICalendarTimeForm) None() ICalendarTimeForm {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	return ICalendarTimeForm(0)
+}
+
+// Date - Signals an iCalendar 'DATE' value with no time of day.
+//
+// This method is part of the standard enumeration.
+//
+func (iCalForm ICalendarTimeForm) Date() ICalendarTimeForm {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	return ICalendarTimeForm(1)
+}
+
+// Floating - Signals an iCalendar local 'DATE-TIME' value which is
+// not bound to any time zone.
+//
+// This method is part of the standard enumeration.
+//
+func (iCalForm ICalendarTimeForm) Floating() ICalendarTimeForm {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	return ICalendarTimeForm(2)
+}
+
+// Utc - Signals an iCalendar 'DATE-TIME' value expressed in
+// Coordinated Universal Time (UTC).
+//
+// This method is part of the standard enumeration.
+//
+func (iCalForm ICalendarTimeForm) Utc() ICalendarTimeForm {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	return ICalendarTimeForm(3)
+}
+
+// Zoned - Signals an iCalendar local 'DATE-TIME' value bound to the
+// time zone identified by a 'TZID' property parameter.
+//
+// This method is part of the standard enumeration.
+//
+func (iCalForm ICalendarTimeForm) Zoned() ICalendarTimeForm {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	return ICalendarTimeForm(4)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'ICalendarTimeForm'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= ICalendarTimeForm(0).Zoned()
+// str := t.String()
+//     str is now equal to 'Zoned'
+//
+func (iCalForm ICalendarTimeForm) String() string {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	result, ok := mICalendarTimeFormCodeToString[iCalForm]
+
+	if !ok {
+		return "Error: iCalendar Time Form UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current ICalendarTimeForm value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  iCalForm := ICalendarTimeForm(0).Zoned()
+//
+//  isValid := iCalForm.XIsValid()
+//
+func (iCalForm ICalendarTimeForm) XIsValid() bool {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	if iCalForm > 4 ||
+		iCalForm < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of ICalendarTimeForm is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'zoned' will NOT
+//                        match the enumeration name, 'Zoned'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'ZONED'
+//                        will match match enumeration name 'Zoned'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// ICalendarTimeForm - Upon successful completion, this method will return
+//                     a new instance of ICalendarTimeForm set to the value
+//                     of the enumeration matched by the string search
+//                     performed on input parameter, 'valueString'.
+//
+// error             - If this method completes successfully, the returned error
+//                     Type is set equal to 'nil'. If an error condition is
+//                     encountered, this method will return an error type which
+//                     encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := ICalendarTimeForm(0).XParseString("ZONED", false)
+//
+//     t is now equal to ICalendarTimeForm(0).Zoned()
+//
+func (iCalForm ICalendarTimeForm) XParseString(
+	valueString string,
+	caseSensitive bool) (ICalendarTimeForm, error) {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	ePrefix := "ICalendarTimeForm.XParseString() "
+
+	if len(valueString) < 3 {
+		return ICalendarTimeForm(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '3'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var iCalendarTimeForm ICalendarTimeForm
+
+	if caseSensitive {
+
+		iCalendarTimeForm, ok = mICalendarTimeFormStringToCode[valueString]
+
+	} else {
+
+		iCalendarTimeForm, ok =
+			mICalendarTimeFormLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return ICalendarTimeForm(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid ICalendarTimeForm Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return iCalendarTimeForm, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// ICalendarTimeForm instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (iCalForm ICalendarTimeForm) XValue() ICalendarTimeForm {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	return iCalForm
+}
+
+// XValueInt - This method returns the integer value of the current
+// ICalendarTimeForm instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (iCalForm ICalendarTimeForm) XValueInt() int {
+
+	lockICalendarTimeForm.Lock()
+
+	defer lockICalendarTimeForm.Unlock()
+
+	return int(iCalForm)
+}
+
+// ICalTimeForm - public global variable of
+// type ICalendarTimeForm.
+//
+// This variable serves as an easier, short hand
+// technique for accessing ICalendarTimeForm values.
+//
+// Usage:
+// ICalTimeForm.None(),
+// ICalTimeForm.Date(),
+// ICalTimeForm.Floating(),
+// ICalTimeForm.Utc(),
+// ICalTimeForm.Zoned(),
+//
+var ICalTimeForm ICalendarTimeForm
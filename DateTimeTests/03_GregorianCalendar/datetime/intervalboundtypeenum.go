@@ -0,0 +1,299 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mIntervalBoundTypeStringToCode = map[string]IntervalBoundType{
+	"None"     : IntervalBoundType(0),
+	"HalfOpen" : IntervalBoundType(1),
+	"Closed"   : IntervalBoundType(2),
+}
+
+var mIntervalBoundTypeLwrCaseStringToCode = map[string]IntervalBoundType{
+	"none"     : IntervalBoundType(0),
+	"halfopen" : IntervalBoundType(1),
+	"closed"   : IntervalBoundType(2),
+}
+
+var mIntervalBoundTypeCodeToString = map[IntervalBoundType]string{
+	IntervalBoundType(0) : "None",
+	IntervalBoundType(1) : "HalfOpen",
+	IntervalBoundType(2) : "Closed",
+}
+
+// IntervalBoundType - An enumeration of the bounds applied to a
+// DateTzInterval. The start date time of an interval is always
+// included in the interval. The bound type determines whether the
+// end date time is also included.
+//
+// Since Go does not directly support enumerations, the 'IntervalBoundType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'IntervalBoundType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of interval bound
+// types. These methods are listed as follows:
+//
+//
+// None         (0) - Signals that the Interval Bound Type is not
+//                    initialized. This is an error condition.
+//
+// HalfOpen     (1) - The interval includes its start date time and
+//                    excludes its end date time: [start, end).
+//                    Consecutive half-open intervals abut without
+//                    overlapping. An interval whose start and end
+//                    date times are equal is empty.
+//
+// Closed       (2) - The interval includes both its start date time
+//                    and its end date time: [start, end].
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'IntervalBound'. Example: IntervalBound.HalfOpen()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: IntervalBoundType(0).HalfOpen()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the IntervalBoundType methods in alphabetical order. Be advised that all
+// 'IntervalBoundType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type IntervalBoundType int
+
+var lockIntervalBoundType sync.Mutex
+
+// None - Signals that the IntervalBoundType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (intervalBound IntervalBoundType) None() IntervalBoundType {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	return IntervalBoundType(0)
+}
+
+// HalfOpen - Signals that an interval includes its start date time
+// and excludes its end date time: [start, end).
+//
+// This method is part of the standard enumeration.
+//
+func (intervalBound IntervalBoundType) HalfOpen() IntervalBoundType {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	return IntervalBoundType(1)
+}
+
+// Closed - Signals that an interval includes both its start date
+// time and its end date time: [start, end].
+//
+// This method is part of the standard enumeration.
+//
+func (intervalBound IntervalBoundType) Closed() IntervalBoundType {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	return IntervalBoundType(2)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'IntervalBoundType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= IntervalBoundType(0).HalfOpen()
+// str := t.String()
+//     str is now equal to 'HalfOpen'
+//
+func (intervalBound IntervalBoundType) String() string {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	result, ok := mIntervalBoundTypeCodeToString[intervalBound]
+
+	if !ok {
+		return "Error: Interval Bound Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current IntervalBoundType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  intervalBound := IntervalBoundType(0).HalfOpen()
+//
+//  isValid := intervalBound.XIsValid()
+//
+func (intervalBound IntervalBoundType) XIsValid() bool {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	if intervalBound > 2 ||
+		intervalBound < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of IntervalBoundType is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'halfopen' will NOT
+//                        match the enumeration name, 'HalfOpen'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'HALFOPEN'
+//                        will match match enumeration name 'HalfOpen'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// IntervalBoundType - Upon successful completion, this method will return
+//                     a new instance of IntervalBoundType set to the value
+//                     of the enumeration matched by the string search
+//                     performed on input parameter, 'valueString'.
+//
+// error             - If this method completes successfully, the returned error
+//                     Type is set equal to 'nil'. If an error condition is
+//                     encountered, this method will return an error type which
+//                     encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := IntervalBoundType(0).XParseString("HALFOPEN", false)
+//
+//     t is now equal to IntervalBoundType(0).HalfOpen()
+//
+func (intervalBound IntervalBoundType) XParseString(
+	valueString string,
+	caseSensitive bool) (IntervalBoundType, error) {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	ePrefix := "IntervalBoundType.XParseString() "
+
+	if len(valueString) < 4 {
+		return IntervalBoundType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var intervalBoundType IntervalBoundType
+
+	if caseSensitive {
+
+		intervalBoundType, ok = mIntervalBoundTypeStringToCode[valueString]
+
+	} else {
+
+		intervalBoundType, ok =
+			mIntervalBoundTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return IntervalBoundType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid IntervalBoundType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return intervalBoundType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// IntervalBoundType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (intervalBound IntervalBoundType) XValue() IntervalBoundType {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	return intervalBound
+}
+
+// XValueInt - This method returns the integer value of the current
+// IntervalBoundType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (intervalBound IntervalBoundType) XValueInt() int {
+
+	lockIntervalBoundType.Lock()
+
+	defer lockIntervalBoundType.Unlock()
+
+	return int(intervalBound)
+}
+
+// IntervalBound - public global variable of
+// type IntervalBoundType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing IntervalBoundType values.
+//
+// Usage:
+// IntervalBound.None(),
+// IntervalBound.HalfOpen(),
+// IntervalBound.Closed(),
+//
+var IntervalBound IntervalBoundType
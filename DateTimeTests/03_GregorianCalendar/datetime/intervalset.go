@@ -0,0 +1,384 @@
+package datetime
+
+import (
+	"sync"
+	"time"
+)
+
+// IntervalSet - Contains a normalized collection of DateTzInterval
+// instances. Intervals added to the set are sorted by start date
+// time, and intervals which overlap or abut are merged. Empty
+// intervals are discarded. As a result, the intervals of an
+// IntervalSet are always disjoint and in ascending order.
+//
+// When two intervals are merged, the result is expressed in the
+// time zone of the earlier interval.
+//
+// The zero value of IntervalSet is an empty set ready for use.
+//
+type IntervalSet struct {
+	intervals []DateTzInterval // Disjoint and in ascending order
+	lock      *sync.Mutex
+}
+
+// Add - Adds an interval to the set. If 'interval' overlaps or
+// abuts intervals already in the set, those intervals are merged.
+//
+func (intervalSet *IntervalSet) Add(
+	interval DateTzInterval,
+	ePrefix string) error {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	ePrefix += "IntervalSet.Add() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	err := dTzIntervalMech.testIntervalValidity(&interval, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	intervals, err := dTzIntervalMech.normalize(
+		append(intervalSet.intervals, interval),
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	intervalSet.intervals = intervals
+
+	return nil
+}
+
+// Contains - Returns 'true' if the instant of 'dTz' lies within one
+// of the intervals in the set.
+//
+func (intervalSet *IntervalSet) Contains(dTz DateTzDto) bool {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	for i := 0; i < len(intervalSet.intervals); i++ {
+
+		if dTzIntervalMech.contains(&intervalSet.intervals[i], dTz.dateTimeValue) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CopyOut - Returns a deep copy of the current IntervalSet
+// instance.
+//
+func (intervalSet *IntervalSet) CopyOut() IntervalSet {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	newSet := IntervalSet{
+		intervals: make([]DateTzInterval, len(intervalSet.intervals)),
+		lock:      new(sync.Mutex),
+	}
+
+	for i := 0; i < len(intervalSet.intervals); i++ {
+		newSet.intervals[i] = dTzIntervalMech.copyOut(&intervalSet.intervals[i])
+	}
+
+	return newSet
+}
+
+// GetCount - Returns the number of disjoint intervals in the set.
+//
+func (intervalSet *IntervalSet) GetCount() int {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	return len(intervalSet.intervals)
+}
+
+// GetGaps - Returns the intervals separating consecutive intervals
+// of the set. Each gap is a half-open interval. A set containing
+// fewer than two intervals has no gaps.
+//
+func (intervalSet *IntervalSet) GetGaps(
+	ePrefix string) (
+	[]DateTzInterval,
+	error) {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	ePrefix += "IntervalSet.GetGaps() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	gaps := make([]DateTzInterval, 0, len(intervalSet.intervals))
+
+	for i := 1; i < len(intervalSet.intervals); i++ {
+
+		gap, hasGap, err := dTzIntervalMech.gap(
+			&intervalSet.intervals[i-1],
+			&intervalSet.intervals[i],
+			ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if hasGap {
+			gaps = append(gaps, gap)
+		}
+	}
+
+	return gaps, nil
+}
+
+// GetIntervals - Returns a deep copy of the disjoint intervals of
+// the set in ascending order.
+//
+func (intervalSet *IntervalSet) GetIntervals() []DateTzInterval {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	intervals := make([]DateTzInterval, len(intervalSet.intervals))
+
+	for i := 0; i < len(intervalSet.intervals); i++ {
+		intervals[i] = dTzIntervalMech.copyOut(&intervalSet.intervals[i])
+	}
+
+	return intervals
+}
+
+// GetTotalDuration - Returns the sum of the elapsed time of all
+// intervals in the set.
+//
+func (intervalSet *IntervalSet) GetTotalDuration() time.Duration {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	var total time.Duration
+
+	for i := 0; i < len(intervalSet.intervals); i++ {
+		total += intervalSet.intervals[i].end.dateTimeValue.Sub(
+			intervalSet.intervals[i].start.dateTimeValue)
+	}
+
+	return total
+}
+
+// Intersect - Returns a new IntervalSet containing the instants
+// shared by the current IntervalSet and 'intervalSet2'.
+//
+func (intervalSet *IntervalSet) Intersect(
+	intervalSet2 IntervalSet,
+	ePrefix string) (
+	IntervalSet,
+	error) {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	ePrefix += "IntervalSet.Intersect() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	intersections := make([]DateTzInterval, 0, len(intervalSet.intervals))
+
+	for i := 0; i < len(intervalSet.intervals); i++ {
+
+		for j := 0; j < len(intervalSet2.intervals); j++ {
+
+			intersection, found, err := dTzIntervalMech.intersect(
+				&intervalSet.intervals[i],
+				&intervalSet2.intervals[j],
+				ePrefix)
+
+			if err != nil {
+				return IntervalSet{}, err
+			}
+
+			if found {
+				intersections = append(intersections, intersection)
+			}
+		}
+	}
+
+	intervals, err := dTzIntervalMech.normalize(intersections, ePrefix)
+
+	if err != nil {
+		return IntervalSet{}, err
+	}
+
+	return IntervalSet{
+		intervals: intervals,
+		lock:      new(sync.Mutex),
+	}, nil
+}
+
+// New - Creates and returns a new IntervalSet containing the
+// normalized form of 'intervals'. Overlapping and abutting intervals
+// are merged and empty intervals are discarded.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  intervals           []DateTzInterval
+//     - The intervals to be added to the set. 'intervals' may be
+//       empty and need not be sorted.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  IntervalSet
+//     - If successful, this method returns a new, populated instance
+//       of IntervalSet.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If any member of 'intervals' is invalid, the returned error
+//       Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (intervalSet IntervalSet) New(
+	intervals []DateTzInterval,
+	ePrefix string) (
+	IntervalSet,
+	error) {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	ePrefix += "IntervalSet.New() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	for i := 0; i < len(intervals); i++ {
+
+		err := dTzIntervalMech.testIntervalValidity(&intervals[i], ePrefix)
+
+		if err != nil {
+			return IntervalSet{}, err
+		}
+	}
+
+	normalized, err := dTzIntervalMech.normalize(intervals, ePrefix)
+
+	if err != nil {
+		return IntervalSet{}, err
+	}
+
+	return IntervalSet{
+		intervals: normalized,
+		lock:      new(sync.Mutex),
+	}, nil
+}
+
+// Union - Returns a new IntervalSet containing the instants of both
+// the current IntervalSet and 'intervalSet2'.
+//
+func (intervalSet *IntervalSet) Union(
+	intervalSet2 IntervalSet,
+	ePrefix string) (
+	IntervalSet,
+	error) {
+
+	if intervalSet.lock == nil {
+		intervalSet.lock = new(sync.Mutex)
+	}
+
+	intervalSet.lock.Lock()
+
+	defer intervalSet.lock.Unlock()
+
+	ePrefix += "IntervalSet.Union() "
+
+	dTzIntervalMech := dateTzIntervalMechanics{}
+
+	combined := make([]DateTzInterval, 0,
+		len(intervalSet.intervals)+len(intervalSet2.intervals))
+
+	combined = append(combined, intervalSet.intervals...)
+
+	combined = append(combined, intervalSet2.intervals...)
+
+	intervals, err := dTzIntervalMech.normalize(combined, ePrefix)
+
+	if err != nil {
+		return IntervalSet{}, err
+	}
+
+	return IntervalSet{
+		intervals: intervals,
+		lock:      new(sync.Mutex),
+	}, nil
+}
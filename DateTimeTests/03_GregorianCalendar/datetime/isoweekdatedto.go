@@ -0,0 +1,289 @@
+package datetime
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// ISOWeekDateDto - Encapsulates an ISO 8601 week date consisting of
+// a week-based year, a week number and a day of the week number.
+//
+// Under the ISO 8601 standard, weeks begin on Monday. Week number
+// one (1) of a week-based year is the week containing the first
+// Thursday of that calendar year. A week-based year contains either
+// 52 or 53 weeks. The week-based year may differ from the calendar
+// year for dates falling in the first or last days of the calendar
+// year.
+//
+//  Example:
+//    Gregorian Date 2008-12-29 is ISO Week Date 2009-W01-1
+//
+// The week-based year is formatted as an Astronomical Year; that is,
+// a year numbering system which includes year zero. The year 1 BCE
+// is therefore expressed as year zero (0) and the year 2 BCE is
+// expressed as year -1.
+//
+// Reference:
+//  https://en.wikipedia.org/wiki/ISO_week_date
+//  https://en.wikipedia.org/wiki/Astronomical_year_numbering
+//
+type ISOWeekDateDto struct {
+	weekYear   int64              // Week-based year expressed as an Astronomical Year
+	weekNumber int                // Week number 1 - 53
+	weekDay    ISO8601DayOfWeekNo // ISO 8601 day of the week number. Monday=1, Sunday=7
+	lock       *sync.Mutex
+}
+
+// GetWeekDay - Returns the ISO 8601 day of the week number.
+// Monday is day number one (1) and Sunday is day number
+// seven (7).
+//
+func (isoWeekDate *ISOWeekDateDto) GetWeekDay() ISO8601DayOfWeekNo {
+
+	if isoWeekDate.lock == nil {
+		isoWeekDate.lock = new(sync.Mutex)
+	}
+
+	isoWeekDate.lock.Lock()
+
+	defer isoWeekDate.lock.Unlock()
+
+	return isoWeekDate.weekDay
+}
+
+// GetWeekNumber - Returns the week number within the week-based
+// year. The valid range is 1 through 53.
+//
+func (isoWeekDate *ISOWeekDateDto) GetWeekNumber() int {
+
+	if isoWeekDate.lock == nil {
+		isoWeekDate.lock = new(sync.Mutex)
+	}
+
+	isoWeekDate.lock.Lock()
+
+	defer isoWeekDate.lock.Unlock()
+
+	return isoWeekDate.weekNumber
+}
+
+// GetWeekYear - Returns the week-based year formatted as an
+// Astronomical Year.
+//
+func (isoWeekDate *ISOWeekDateDto) GetWeekYear() int64 {
+
+	if isoWeekDate.lock == nil {
+		isoWeekDate.lock = new(sync.Mutex)
+	}
+
+	isoWeekDate.lock.Lock()
+
+	defer isoWeekDate.lock.Unlock()
+
+	return isoWeekDate.weekYear
+}
+
+// GetWeekYearBigInt - Returns the week-based year formatted as an
+// Astronomical Year and expressed as a type *big.Int.
+//
+func (isoWeekDate *ISOWeekDateDto) GetWeekYearBigInt() *big.Int {
+
+	if isoWeekDate.lock == nil {
+		isoWeekDate.lock = new(sync.Mutex)
+	}
+
+	isoWeekDate.lock.Lock()
+
+	defer isoWeekDate.lock.Unlock()
+
+	return big.NewInt(isoWeekDate.weekYear)
+}
+
+// New - Creates and returns a new instance of ISOWeekDateDto.
+//
+// Be advised that this method only validates the ranges of the
+// week number (1-53) and the week day (1-7). Whether week number
+// 53 exists in a given week-based year depends on the calendar
+// system and is verified when the ISO week date is converted to
+// a calendar date.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  weekYear            int64
+//     - The week-based year formatted as an Astronomical Year.
+//
+//
+//  weekNumber          int
+//     - The week number. The valid range is 1 through 53.
+//
+//
+//  weekDay             ISO8601DayOfWeekNo
+//     - The ISO 8601 day of the week number. Monday is day number
+//       one (1) and Sunday is day number seven (7).
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  ISOWeekDateDto
+//     - If successful, this method returns a new, populated instance
+//       of ISOWeekDateDto.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (isoWeekDate ISOWeekDateDto) New(
+	weekYear int64,
+	weekNumber int,
+	weekDay ISO8601DayOfWeekNo,
+	ePrefix string) (
+	ISOWeekDateDto,
+	error) {
+
+	if isoWeekDate.lock == nil {
+		isoWeekDate.lock = new(sync.Mutex)
+	}
+
+	isoWeekDate.lock.Lock()
+
+	defer isoWeekDate.lock.Unlock()
+
+	ePrefix += "ISOWeekDateDto.New() "
+
+	if weekNumber < 1 || weekNumber > 53 {
+		return ISOWeekDateDto{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error: Input parameter 'weekNumber' is INVALID!\n" +
+				"The valid range is '1' through '53'.\n" +
+				"weekNumber='%v'\n",
+				weekNumber)
+	}
+
+	if !weekDay.XIsValid() {
+		return ISOWeekDateDto{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error: Input parameter 'weekDay' is INVALID!\n" +
+				"weekDay='%v'\n",
+				weekDay.XValueInt())
+	}
+
+	newISOWeekDate := ISOWeekDateDto{
+		weekYear:   weekYear,
+		weekNumber: weekNumber,
+		weekDay:    weekDay,
+		lock:       new(sync.Mutex),
+	}
+
+	return newISOWeekDate, nil
+}
+
+// NewFromString - Creates and returns a new instance of ISOWeekDateDto
+// by parsing an ISO 8601 week date string.
+//
+// Both the extended format, "YYYY-Www-D", and the basic format,
+// "YYYYWwwD", are supported. Years outside the range 0000 through
+// 9999 must be preceded by a plus (+) or minus (-) sign. Year values
+// are interpreted as Astronomical Years.
+//
+//  Examples:
+//    "2009-W01-1"
+//    "2004W537"
+//    "-0001-W52-7"
+//    "+12345-W10-3"
+//
+func (isoWeekDate ISOWeekDateDto) NewFromString(
+	isoWeekDateStr string,
+	ePrefix string) (
+	ISOWeekDateDto,
+	error) {
+
+	if isoWeekDate.lock == nil {
+		isoWeekDate.lock = new(sync.Mutex)
+	}
+
+	isoWeekDate.lock.Lock()
+
+	defer isoWeekDate.lock.Unlock()
+
+	ePrefix += "ISOWeekDateDto.NewFromString() "
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	weekYear,
+	weekNumber,
+	weekDay,
+	err := isoWeekDateMech.parseISOWeekDateStr(
+		isoWeekDateStr,
+		ePrefix)
+
+	if err != nil {
+		return ISOWeekDateDto{}, err
+	}
+
+	newISOWeekDate := ISOWeekDateDto{
+		weekYear:   weekYear,
+		weekNumber: weekNumber,
+		weekDay:    weekDay,
+		lock:       new(sync.Mutex),
+	}
+
+	return newISOWeekDate, nil
+}
+
+// String - Returns the ISO 8601 week date formatted in the extended
+// format, "YYYY-Www-D". Week-based years outside the range 0000
+// through 9999 are preceded by a plus (+) or minus (-) sign.
+//
+//  Examples:
+//    "2009-W01-1"
+//    "-0001-W52-7"
+//    "+12345-W10-3"
+//
+func (isoWeekDate *ISOWeekDateDto) String() string {
+
+	if isoWeekDate.lock == nil {
+		isoWeekDate.lock = new(sync.Mutex)
+	}
+
+	isoWeekDate.lock.Lock()
+
+	defer isoWeekDate.lock.Unlock()
+
+	var yearStr string
+
+	if isoWeekDate.weekYear >= 0 &&
+		isoWeekDate.weekYear <= 9999 {
+
+		yearStr = fmt.Sprintf("%04d", isoWeekDate.weekYear)
+
+	} else if isoWeekDate.weekYear < 0 {
+
+		yearStr = fmt.Sprintf("-%04d",
+			big.NewInt(0).Abs(big.NewInt(isoWeekDate.weekYear)))
+
+	} else {
+
+		yearStr = fmt.Sprintf("+%d", isoWeekDate.weekYear)
+	}
+
+	return fmt.Sprintf("%v-W%02d-%d",
+		yearStr,
+		isoWeekDate.weekNumber,
+		int(isoWeekDate.weekDay))
+}
@@ -0,0 +1,473 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// isoWeekDateMechanics - Provides helper methods used to compute
+// ISO 8601 week dates.
+//
+// Under the ISO 8601 standard, weeks begin on Monday. Week number
+// one (1) of a week-based year is the week containing the first
+// Thursday of that calendar year. Equivalently, it is the week
+// containing January 4th. As a result, a week-based year contains
+// either 52 or 53 weeks and the first and last days of a calendar
+// year may belong to the week-based year of an adjacent calendar
+// year.
+//
+// Since the seven day week cycle is independent of the calendar,
+// these methods operate on Julian Day Numbers and may be applied
+// to any of the calendar systems supported by this package.
+//
+// Reference:
+//  https://en.wikipedia.org/wiki/ISO_week_date
+//  https://en.wikipedia.org/wiki/ISO_8601#Week_dates
+//
+type isoWeekDateMechanics struct {
+	lock *sync.Mutex
+}
+
+// getDateFromISOWeekDate - Receives an ISO week date and returns the
+// equivalent date under the calendar system specified by input
+// parameter 'calendarSystem'.
+//
+// The returned 'astronomicalYear' is formatted as an Astronomical
+// Year. If the week number does not exist in the week-based year,
+// an error is returned.
+//
+func (isoWeekDateMech *isoWeekDateMechanics) getDateFromISOWeekDate(
+	calendarSystem CalendarSpec,
+	weekYear int64,
+	weekNumber int,
+	weekDay ISO8601DayOfWeekNo,
+	ePrefix string) (
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	if isoWeekDateMech.lock == nil {
+		isoWeekDateMech.lock = new(sync.Mutex)
+	}
+
+	isoWeekDateMech.lock.Lock()
+
+	defer isoWeekDateMech.lock.Unlock()
+
+	ePrefix += "isoWeekDateMechanics.getDateFromISOWeekDate() "
+
+	if weekNumber < 1 || weekNumber > 53 {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'weekNumber' is INVALID!\n" +
+			"The valid range is '1' through '53'.\n" +
+			"weekNumber='%v'\n",
+			weekNumber)
+
+		return astronomicalYear, month, day, err
+	}
+
+	if !weekDay.XIsValid() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'weekDay' is INVALID!\n" +
+			"weekDay='%v'\n",
+			weekDay.XValueInt())
+
+		return astronomicalYear, month, day, err
+	}
+
+	var jan4DayNo *big.Int
+
+	jan4DayNo, err = isoWeekDateMech.getJulianDayNo(
+		calendarSystem,
+		weekYear,
+		1,
+		4,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	// Monday of week number one
+	targetDayNo := big.NewInt(0).Sub(
+		jan4DayNo,
+		big.NewInt(int64(isoWeekDateMech.getISODayOfWeekNo(jan4DayNo) - 1)))
+
+	targetDayNo.Add(
+		targetDayNo,
+		big.NewInt(int64(weekNumber - 1) * 7 + int64(weekDay - 1)))
+
+	var actualWeekYear int64
+
+	actualWeekYear, _, _, err = isoWeekDateMech.getISOWeekDateFromDayNo(
+		calendarSystem,
+		targetDayNo,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	if actualWeekYear != weekYear {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Week number '%v' does NOT exist in week-based year '%v'.\n",
+			weekNumber,
+			weekYear)
+
+		return astronomicalYear, month, day, err
+	}
+
+	astronomicalYear,
+		month,
+		day,
+		err = isoWeekDateMech.getDateFromJulianDayNo(
+		calendarSystem,
+		targetDayNo,
+		ePrefix)
+
+	return astronomicalYear, month, day, err
+}
+
+// getISOWeekDate - Computes the ISO 8601 week date for a date
+// specified under the calendar system designated by input parameter
+// 'calendarSystem'.
+//
+// Input parameter 'astronomicalYear' must be formatted as an
+// Astronomical Year. The returned 'weekYear' is also formatted as
+// an Astronomical Year.
+//
+func (isoWeekDateMech *isoWeekDateMechanics) getISOWeekDate(
+	calendarSystem CalendarSpec,
+	astronomicalYear int64,
+	month int,
+	day int,
+	ePrefix string) (
+	weekYear int64,
+	weekNumber int,
+	weekDay ISO8601DayOfWeekNo,
+	err error) {
+
+	if isoWeekDateMech.lock == nil {
+		isoWeekDateMech.lock = new(sync.Mutex)
+	}
+
+	isoWeekDateMech.lock.Lock()
+
+	defer isoWeekDateMech.lock.Unlock()
+
+	ePrefix += "isoWeekDateMechanics.getISOWeekDate() "
+
+	var julianDayNo *big.Int
+
+	julianDayNo, err = isoWeekDateMech.getJulianDayNo(
+		calendarSystem,
+		astronomicalYear,
+		month,
+		day,
+		ePrefix)
+
+	if err != nil {
+		return weekYear, weekNumber, weekDay, err
+	}
+
+	return isoWeekDateMech.getISOWeekDateFromDayNo(
+		calendarSystem,
+		julianDayNo,
+		ePrefix)
+}
+
+// getISOWeekDateFromDayNo - Computes the ISO 8601 week date for an
+// integer Julian Day Number. The returned 'weekYear' is formatted as
+// an Astronomical Year under the calendar system designated by input
+// parameter 'calendarSystem'.
+//
+func (isoWeekDateMech *isoWeekDateMechanics) getISOWeekDateFromDayNo(
+	calendarSystem CalendarSpec,
+	julianDayNo *big.Int,
+	ePrefix string) (
+	weekYear int64,
+	weekNumber int,
+	weekDay ISO8601DayOfWeekNo,
+	err error) {
+
+	ePrefix += "isoWeekDateMechanics.getISOWeekDateFromDayNo() "
+
+	weekDay = isoWeekDateMech.getISODayOfWeekNo(julianDayNo)
+
+	// The week-based year is the year containing
+	// the Thursday of the target week.
+	thursdayDayNo := big.NewInt(0).Add(
+		julianDayNo,
+		big.NewInt(int64(ISO8601DayOfWeekNo(0).Thursday() - weekDay)))
+
+	weekYear, _, _, err = isoWeekDateMech.getDateFromJulianDayNo(
+		calendarSystem,
+		thursdayDayNo,
+		ePrefix)
+
+	if err != nil {
+		return weekYear, weekNumber, weekDay, err
+	}
+
+	var jan1DayNo *big.Int
+
+	jan1DayNo, err = isoWeekDateMech.getJulianDayNo(
+		calendarSystem,
+		weekYear,
+		1,
+		1,
+		ePrefix)
+
+	if err != nil {
+		return weekYear, weekNumber, weekDay, err
+	}
+
+	elapsedDays := big.NewInt(0).Sub(thursdayDayNo, jan1DayNo)
+
+	weekNumber = int(big.NewInt(0).Quo(elapsedDays, big.NewInt(7)).Int64()) + 1
+
+	return weekYear, weekNumber, weekDay, err
+}
+
+// getISODayOfWeekNo - Returns the ISO 8601 day of the week number
+// for an integer Julian Day Number. Julian Day Number zero (0)
+// fell on a Monday.
+//
+func (isoWeekDateMech *isoWeekDateMechanics) getISODayOfWeekNo(
+	julianDayNo *big.Int) ISO8601DayOfWeekNo {
+
+	// big.Int.Mod implements Euclidean modulus. The
+	// result is never negative.
+	dayOfWeek := big.NewInt(0).Mod(julianDayNo, big.NewInt(7))
+
+	return ISO8601DayOfWeekNo(dayOfWeek.Int64() + 1)
+}
+
+// getJulianDayNo - Returns the integer Julian Day Number for a date
+// specified under the calendar system designated by input parameter
+// 'calendarSystem'.
+//
+func (isoWeekDateMech *isoWeekDateMechanics) getJulianDayNo(
+	calendarSystem CalendarSpec,
+	astronomicalYear int64,
+	month int,
+	day int,
+	ePrefix string) (
+	julianDayNo *big.Int,
+	err error) {
+
+	ePrefix += "isoWeekDateMechanics.getJulianDayNo() "
+
+	julianDayNo = big.NewInt(0)
+
+	calDtMech := calendarDateTimeMechanics{}
+
+	var julianDayNoDto JulianDayNoDto
+
+	julianDayNoDto, err = calDtMech.getJulianDayNumber(
+		calendarSystem,
+		astronomicalYear,
+		month,
+		day,
+		12,
+		0,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return julianDayNo, err
+	}
+
+	julianDayNo, err = julianDayNoDto.GetJulianDayBigInt(ePrefix)
+
+	return julianDayNo, err
+}
+
+// getDateFromJulianDayNo - Returns the date equivalent to an integer
+// Julian Day Number under the calendar system designated by input
+// parameter 'calendarSystem'. The returned year value is formatted as
+// an Astronomical Year.
+//
+func (isoWeekDateMech *isoWeekDateMechanics) getDateFromJulianDayNo(
+	calendarSystem CalendarSpec,
+	julianDayNo *big.Int,
+	ePrefix string) (
+	astronomicalYear int64,
+	month int,
+	day int,
+	err error) {
+
+	ePrefix += "isoWeekDateMechanics.getDateFromJulianDayNo() "
+
+	if !julianDayNo.IsInt64() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The Julian Day Number exceeds the range of an int64.\n" +
+			"julianDayNo='%v'\n",
+			julianDayNo.Text(10))
+
+		return astronomicalYear, month, day, err
+	}
+
+	var julianDayNoDto JulianDayNoDto
+
+	julianDayNoDto, err = JulianDayNoDto{}.New(
+		julianDayNo.Int64(),
+		big.NewFloat(0.0),
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	calDtMech := calendarDateTimeMechanics{}
+
+	var dateTimeDto ADateTimeDto
+
+	dateTimeDto, err = calDtMech.getDateTimeFromJulianDayNumber(
+		calendarSystem,
+		julianDayNoDto,
+		0,
+		ePrefix)
+
+	if err != nil {
+		return astronomicalYear, month, day, err
+	}
+
+	astronomicalYear = dateTimeDto.GetYearAstronomical()
+	month = dateTimeDto.GetMonth()
+	day = dateTimeDto.GetDay()
+
+	return astronomicalYear, month, day, err
+}
+
+// parseISOWeekDateStr - Parses an ISO 8601 week date string and
+// returns the component week-based year, week number and week day.
+//
+// Both the extended format, "YYYY-Www-D", and the basic format,
+// "YYYYWwwD", are supported. Years outside the range 0000 through
+// 9999 must be preceded by a plus (+) or minus (-) sign. Signed
+// years consist of four or more digits. Year values are interpreted
+// as Astronomical Years.
+//
+//  Examples:
+//    "2009-W01-1"     Monday, 29 December 2008 (Gregorian)
+//    "2004W537"       Sunday, 2 January 2005 (Gregorian)
+//    "-0001-W52-7"    Astronomical year -1 (2 BCE)
+//    "+12345-W10-3"   Astronomical year 12345
+//
+func (isoWeekDateMech *isoWeekDateMechanics) parseISOWeekDateStr(
+	isoWeekDateStr string,
+	ePrefix string) (
+	weekYear int64,
+	weekNumber int,
+	weekDay ISO8601DayOfWeekNo,
+	err error) {
+
+	if isoWeekDateMech.lock == nil {
+		isoWeekDateMech.lock = new(sync.Mutex)
+	}
+
+	isoWeekDateMech.lock.Lock()
+
+	defer isoWeekDateMech.lock.Unlock()
+
+	ePrefix += "isoWeekDateMechanics.parseISOWeekDateStr() "
+
+	str := strings.TrimSpace(isoWeekDateStr)
+
+	if len(str) == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'isoWeekDateStr' is an empty string!\n")
+
+		return weekYear, weekNumber, weekDay, err
+	}
+
+	invalidFormatErr := fmt.Errorf(ePrefix + "\n" +
+		"Error: Input parameter 'isoWeekDateStr' is INVALID!\n" +
+		"Expected format 'YYYY-Www-D' or 'YYYYWwwD'.\n" +
+		"isoWeekDateStr='%v'\n",
+		isoWeekDateStr)
+
+	idx := strings.Index(str, "W")
+
+	if idx < 1 {
+		return weekYear, weekNumber, weekDay, invalidFormatErr
+	}
+
+	yearStr := str[:idx]
+	weekStr := str[idx+1:]
+
+	isExtendedFormat := strings.HasSuffix(yearStr, "-")
+
+	if isExtendedFormat {
+
+		yearStr = yearStr[:len(yearStr)-1]
+
+		if len(weekStr) != 4 ||
+			weekStr[2] != '-' {
+			return weekYear, weekNumber, weekDay, invalidFormatErr
+		}
+
+		weekStr = weekStr[:2] + weekStr[3:]
+
+	} else if len(weekStr) != 3 {
+		return weekYear, weekNumber, weekDay, invalidFormatErr
+	}
+
+	yearDigits := yearStr
+
+	isSigned := strings.HasPrefix(yearStr, "+") ||
+		strings.HasPrefix(yearStr, "-")
+
+	if isSigned {
+		yearDigits = yearStr[1:]
+	}
+
+	if len(yearDigits) < 4 ||
+		(!isSigned && len(yearDigits) != 4) {
+		return weekYear, weekNumber, weekDay, invalidFormatErr
+	}
+
+	for i := 0; i < len(yearDigits); i++ {
+		if yearDigits[i] < '0' || yearDigits[i] > '9' {
+			return weekYear, weekNumber, weekDay, invalidFormatErr
+		}
+	}
+
+	for i := 0; i < len(weekStr); i++ {
+		if weekStr[i] < '0' || weekStr[i] > '9' {
+			return weekYear, weekNumber, weekDay, invalidFormatErr
+		}
+	}
+
+	weekYear, err = strconv.ParseInt(yearStr, 10, 64)
+
+	if err != nil {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The year value in 'isoWeekDateStr' is INVALID!\n" +
+			"isoWeekDateStr='%v'\n" +
+			"Error='%v'\n",
+			isoWeekDateStr,
+			err.Error())
+
+		return weekYear, weekNumber, weekDay, err
+	}
+
+	weekNumber = int(weekStr[0]-'0')*10 + int(weekStr[1]-'0')
+
+	weekDay = ISO8601DayOfWeekNo(weekStr[2] - '0')
+
+	if weekNumber < 1 || weekNumber > 53 ||
+		!weekDay.XIsValid() {
+		return weekYear, weekNumber, weekDay, invalidFormatErr
+	}
+
+	return weekYear, weekNumber, weekDay, err
+}
@@ -106,6 +106,53 @@ type JulianDayNoDto struct {
 	isThisInstanceValid bool        // Is this object valid flag
 	lock                *sync.Mutex // Used for coordinating thread safe operations.
 }
+
+// ConvertTimeScale - Converts the Julian Day Number/Time encapsulated
+// by the current JulianDayNoDto instance from one time scale to
+// another and returns the result as a new JulianDayNoDto instance.
+// Supported time scales are UTC, TAI, GPS and TT. Reference type
+// 'TimeScaleType'.
+//
+// Conversions to and from UTC apply the values of TAI - UTC provided
+// by the current leap second table. Reference type 'LeapSecondTable'.
+// UTC instants preceding January 1, 1972 cannot be converted.
+//
+// Julian Dates on the UTC time scale are computed with days of
+// exactly 86,400 seconds. An instant falling within an inserted leap
+// second is expressed as the corresponding instant during the final
+// second, 23:59:59, of the UTC day.
+//
+// If 'toScale' is UTC, the leap second flag of the returned instance
+// is set to 'true' when a leap second was inserted during the Julian
+// Day. Reference JulianDayNoDto.GetHasLeapSecond(). For all other
+// time scales, the leap second flag is set to 'false'.
+//
+func (jDNDto *JulianDayNoDto) ConvertTimeScale(
+	fromScale TimeScaleType,
+	toScale TimeScaleType,
+	ePrefix string) (
+	JulianDayNoDto,
+	error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.ConvertTimeScale() "
+
+	timeScaleMech := timeScaleMechanics{}
+
+	return timeScaleMech.convertJulianDayNoDto(
+		jDNDto,
+		fromScale,
+		toScale,
+		ePrefix)
+}
+
 // CopyIn - Receives a pointer to an incoming JulianDayNoDto instance
 // (JulianDayNoDto2) and performs a deep copy of all internal data
 // field values to the current JulianDayNoDto instance (jDNDto).
@@ -1094,6 +1141,208 @@ func (jDNDto *JulianDayNoDto) GetNanoseconds() int {
 	return nanosecondsInt
 }
 
+// GetLilianDayNo - Returns the Lilian Day Number as a type
+// *big.Int. The Lilian Day Number is a count of days beginning
+// with day one (1) on October 15, 1582, the first day of the
+// Gregorian Calendar. Lilian days begin at midnight Universal
+// Coordinated Time (UTC).
+//
+//   Lilian Day Number = floor(JD - 2299159.5)
+//
+func (jDNDto *JulianDayNoDto) GetLilianDayNo(
+	ePrefix string) (*big.Int, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.GetLilianDayNo() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.getVariantDayNo(
+		jDNDto,
+		JDNVariant.LilianDayNumber(),
+		ePrefix)
+}
+
+// GetModifiedJulianDate - Returns the Modified Julian Date (MJD)
+// as a type *big.Float. The returned value includes the time
+// fraction. Modified Julian Dates begin at midnight Universal
+// Coordinated Time (UTC). MJD zero (0.0) is 00:00:00 UTC on
+// November 17, 1858.
+//
+//   MJD = JD - 2400000.5
+//
+func (jDNDto *JulianDayNoDto) GetModifiedJulianDate(
+	ePrefix string) (*big.Float, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.GetModifiedJulianDate() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.getVariantDayNoTime(
+		jDNDto,
+		JDNVariant.ModifiedJulianDate(),
+		ePrefix)
+}
+
+// GetRataDie - Returns the Rata Die (R.D.) day number as a type
+// *big.Int. Rata Die is a count of days beginning with day one
+// (1) on January 1, 0001 on the proleptic Gregorian Calendar.
+// Rata Die days begin at midnight Universal Coordinated Time
+// (UTC).
+//
+//   R.D. = floor(JD - 1721424.5)
+//
+func (jDNDto *JulianDayNoDto) GetRataDie(
+	ePrefix string) (*big.Int, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.GetRataDie() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.getVariantDayNo(
+		jDNDto,
+		JDNVariant.RataDie(),
+		ePrefix)
+}
+
+// GetReducedJulianDate - Returns the Reduced Julian Date (RJD)
+// as a type *big.Float. The returned value includes the time
+// fraction. Like Julian Day Numbers, Reduced Julian Dates begin
+// at noon.
+//
+//   RJD = JD - 2400000
+//
+func (jDNDto *JulianDayNoDto) GetReducedJulianDate(
+	ePrefix string) (*big.Float, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.GetReducedJulianDate() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.getVariantDayNoTime(
+		jDNDto,
+		JDNVariant.ReducedJulianDate(),
+		ePrefix)
+}
+
+// GetTruncatedJulianDate - Returns the Truncated Julian Date (TJD)
+// as defined by NASA. The returned *big.Float value includes the
+// time fraction. Truncated Julian Dates begin at midnight Universal
+// Coordinated Time (UTC). TJD zero (0.0) is 00:00:00 UTC on May 24,
+// 1968.
+//
+//   TJD = JD - 2440000.5
+//
+func (jDNDto *JulianDayNoDto) GetTruncatedJulianDate(
+	ePrefix string) (*big.Float, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.GetTruncatedJulianDate() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.getVariantDayNoTime(
+		jDNDto,
+		JDNVariant.TruncatedJulianDate(),
+		ePrefix)
+}
+
+// GetVariantDayNo - Returns the integer day number for the Julian
+// Day Number variant specified by input parameter 'variant'. The
+// returned value is the floor of the variant day number/time.
+//
+// Reference type 'JulianDayNoVariantType' for a list of supported
+// variants.
+//
+func (jDNDto *JulianDayNoDto) GetVariantDayNo(
+	variant JulianDayNoVariantType,
+	ePrefix string) (*big.Int, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.GetVariantDayNo() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.getVariantDayNo(
+		jDNDto,
+		variant,
+		ePrefix)
+}
+
+// GetVariantDayNoTime - Returns the day number/time, including the
+// time fraction, for the Julian Day Number variant specified by
+// input parameter 'variant'.
+//
+// Reference type 'JulianDayNoVariantType' for a list of supported
+// variants.
+//
+func (jDNDto *JulianDayNoDto) GetVariantDayNoTime(
+	variant JulianDayNoVariantType,
+	ePrefix string) (*big.Float, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.GetVariantDayNoTime() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.getVariantDayNoTime(
+		jDNDto,
+		variant,
+		ePrefix)
+}
+
 // IsValidInstance - Returns a boolean value signaling whether the
 // current JulianDayNoDto instance is valid.
 //
@@ -1195,6 +1444,92 @@ func (jDNDto *JulianDayNoDto) IsValidInstanceError(
 	return err
 }
 
+// MarshalBinary - Implements the encoding.BinaryMarshaler interface. The
+// current JulianDayNoDto instance is encoded in the versioned binary wire
+// format. The binary wire format carries the same members as the
+// JSON wire format. Reference method JulianDayNoDto.MarshalJSON().
+//
+func (jDNDto JulianDayNoDto) MarshalBinary() ([]byte, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix := "JulianDayNoDto.MarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.julianDayNoDtoToWire(&jDNDto, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalBinary(wireTypeCodeJulianDayNoDto, &wire), nil
+}
+
+// MarshalJSON - Implements the json.Marshaler interface. The
+// current JulianDayNoDto instance is encoded in the JSON wire format. The
+// wire format retains the signed Julian Day Number/Time at its original
+// precision and the leap second flag.
+//
+// An invalid JulianDayNoDto instance cannot be marshaled and will
+// generate an error.
+//
+func (jDNDto JulianDayNoDto) MarshalJSON() ([]byte, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix := "JulianDayNoDto.MarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.julianDayNoDtoToWire(&jDNDto, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
+// MarshalText - Implements the encoding.TextMarshaler interface. The text
+// form of a JulianDayNoDto is identical to its JSON wire format. Reference
+// method JulianDayNoDto.MarshalJSON().
+//
+func (jDNDto JulianDayNoDto) MarshalText() ([]byte, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix := "JulianDayNoDto.MarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.julianDayNoDtoToWire(&jDNDto, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
 // New - Returns a new, populated instance of type 'JulianDayNoDto' containing
 // Julian Day Number information calculated from the input parameters listed
 // below.
@@ -1571,6 +1906,240 @@ func (jDNDto JulianDayNoDto) NewFromJulianDayNoTime(
 }
 
 
+// NewFromLilianDayNo - Returns a new instance of JulianDayNoDto
+// computed from a Lilian Day Number. The returned Julian Day
+// Number/Time identifies 00:00:00 (midnight) Universal Coordinated
+// Time (UTC) on the Lilian day.
+//
+// The Lilian Day Number is a count of days beginning with day one
+// (1) on October 15, 1582, the first day of the Gregorian Calendar.
+//
+//   JD = Lilian Day Number + 2299159.5
+//
+func (jDNDto JulianDayNoDto) NewFromLilianDayNo(
+	lilianDayNo int64,
+	ePrefix string) (JulianDayNoDto, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.NewFromLilianDayNo() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.newFromVariantDayNoTime(
+		JDNVariant.LilianDayNumber(),
+		big.NewFloat(0.0).
+			SetPrec(1024).
+			SetInt64(lilianDayNo),
+		false,
+		ePrefix)
+}
+
+// NewFromModifiedJulianDate - Returns a new instance of
+// JulianDayNoDto computed from a Modified Julian Date (MJD).
+// Input parameter 'modifiedJulianDate' includes the time
+// fraction. Modified Julian Dates begin at midnight Universal
+// Coordinated Time (UTC).
+//
+//   JD = MJD + 2400000.5
+//
+func (jDNDto JulianDayNoDto) NewFromModifiedJulianDate(
+	modifiedJulianDate *big.Float,
+	ePrefix string) (JulianDayNoDto, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.NewFromModifiedJulianDate() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.newFromVariantDayNoTime(
+		JDNVariant.ModifiedJulianDate(),
+		modifiedJulianDate,
+		false,
+		ePrefix)
+}
+
+// NewFromRataDie - Returns a new instance of JulianDayNoDto
+// computed from a Rata Die (R.D.) day number. The returned Julian
+// Day Number/Time identifies 00:00:00 (midnight) Universal
+// Coordinated Time (UTC) on the Rata Die day.
+//
+// Rata Die is a count of days beginning with day one (1) on
+// January 1, 0001 on the proleptic Gregorian Calendar.
+//
+//   JD = R.D. + 1721424.5
+//
+func (jDNDto JulianDayNoDto) NewFromRataDie(
+	rataDie int64,
+	ePrefix string) (JulianDayNoDto, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.NewFromRataDie() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.newFromVariantDayNoTime(
+		JDNVariant.RataDie(),
+		big.NewFloat(0.0).
+			SetPrec(1024).
+			SetInt64(rataDie),
+		false,
+		ePrefix)
+}
+
+// NewFromReducedJulianDate - Returns a new instance of
+// JulianDayNoDto computed from a Reduced Julian Date (RJD).
+// Input parameter 'reducedJulianDate' includes the time fraction.
+// Like Julian Day Numbers, Reduced Julian Dates begin at noon.
+//
+//   JD = RJD + 2400000
+//
+func (jDNDto JulianDayNoDto) NewFromReducedJulianDate(
+	reducedJulianDate *big.Float,
+	ePrefix string) (JulianDayNoDto, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.NewFromReducedJulianDate() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.newFromVariantDayNoTime(
+		JDNVariant.ReducedJulianDate(),
+		reducedJulianDate,
+		false,
+		ePrefix)
+}
+
+// NewFromTruncatedJulianDate - Returns a new instance of
+// JulianDayNoDto computed from a Truncated Julian Date (TJD) as
+// defined by NASA. Input parameter 'truncatedJulianDate' includes
+// the time fraction. Truncated Julian Dates begin at midnight
+// Universal Coordinated Time (UTC).
+//
+//   JD = TJD + 2440000.5
+//
+func (jDNDto JulianDayNoDto) NewFromTruncatedJulianDate(
+	truncatedJulianDate *big.Float,
+	ePrefix string) (JulianDayNoDto, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.NewFromTruncatedJulianDate() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.newFromVariantDayNoTime(
+		JDNVariant.TruncatedJulianDate(),
+		truncatedJulianDate,
+		false,
+		ePrefix)
+}
+
+// NewFromVariantDayNoTime - Returns a new instance of JulianDayNoDto
+// computed from a day number/time expressed in the Julian Day Number
+// variant specified by input parameter 'variant'.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  variant                  JulianDayNoVariantType
+//     - Specifies the Julian Day Number variant in which input
+//       parameter 'variantDayNoTime' is expressed. Reference type
+//       'JulianDayNoVariantType'.
+//
+//
+//  variantDayNoTime         *big.Float
+//     - The day number and time fraction expressed in the variant
+//       specified by input parameter 'variant'.
+//
+//
+//  applyLeapSecond          bool
+//     - Set this boolean parameter to 'true' if, and ONLY if, the
+//       specified day includes a leap second.
+//
+//
+//  ePrefix                  string
+//     - Error Prefix. A string consisting of the method chain used
+//       to call this method. In case of error, this text string is
+//       included in the error message.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  JulianDayNoDto
+//     - If successful, this method will return a new instance of
+//       'JulianDayNoDto' populated with the equivalent Julian Day
+//       Number/Time.
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+func (jDNDto JulianDayNoDto) NewFromVariantDayNoTime(
+	variant JulianDayNoVariantType,
+	variantDayNoTime *big.Float,
+	applyLeapSecond bool,
+	ePrefix string) (JulianDayNoDto, error) {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix += "JulianDayNoDto.NewFromVariantDayNoTime() "
+
+	jDNVariantMech := julianDayNoVariantMechanics{}
+
+	return jDNVariantMech.newFromVariantDayNoTime(
+		variant,
+		variantDayNoTime,
+		applyLeapSecond,
+		ePrefix)
+}
+
 // NewZero - Returns a new instance of JulianDayNoDto with
 // all internal data elements initialized to their zero
 // values. The returned JulianDayNoDto is in all respects,
@@ -1653,4 +2222,100 @@ func (jDNDto *JulianDayNoDto) SetApplyLeapSecond(applyLeapSecond bool){
 	defer jDNDto.lock.Unlock()
 
 	jDNDto.hasLeapSecond = applyLeapSecond
-}
\ No newline at end of file
+}
+
+// UnmarshalBinary - Implements the encoding.BinaryUnmarshaler interface. The
+// current JulianDayNoDto instance is rebuilt from 'data', which must hold
+// the binary wire format generated by JulianDayNoDto.MarshalBinary().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (jDNDto *JulianDayNoDto) UnmarshalBinary(data []byte) error {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix := "JulianDayNoDto.UnmarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := julianDayNoDtoWire{}
+
+	err := wireMech.unmarshalBinary(data, wireTypeCodeJulianDayNoDto, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.julianDayNoDtoFromWire(jDNDto, &wire, ePrefix)
+}
+
+// UnmarshalJSON - Implements the json.Unmarshaler interface. The
+// current JulianDayNoDto instance is rebuilt from 'data', which must hold
+// the JSON wire format generated by JulianDayNoDto.MarshalJSON().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (jDNDto *JulianDayNoDto) UnmarshalJSON(data []byte) error {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix := "JulianDayNoDto.UnmarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := julianDayNoDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.julianDayNoDtoFromWire(jDNDto, &wire, ePrefix)
+}
+
+// UnmarshalText - Implements the encoding.TextUnmarshaler interface. The
+// current JulianDayNoDto instance is rebuilt from 'data', which must hold
+// the text form generated by JulianDayNoDto.MarshalText().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (jDNDto *JulianDayNoDto) UnmarshalText(data []byte) error {
+
+	if jDNDto.lock == nil {
+		jDNDto.lock = new(sync.Mutex)
+	}
+
+	jDNDto.lock.Lock()
+
+	defer jDNDto.lock.Unlock()
+
+	ePrefix := "JulianDayNoDto.UnmarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := julianDayNoDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.julianDayNoDtoFromWire(jDNDto, &wire, ePrefix)
+}
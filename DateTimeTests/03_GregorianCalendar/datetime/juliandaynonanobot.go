@@ -97,7 +97,7 @@ func (jDNNanobot *julianDayNoNanobot) testJulianDayNoDtoValidity(
 				jDNDto.netGregorianNanoSeconds)
 	}
 
-	if jDNDto.hours < 0 || jDNDto.hours >= 23 {
+	if jDNDto.hours < 0 || jDNDto.hours > 23 {
 		return false,
 			fmt.Errorf(ePrefix + "\n" +
 				"Data Field 'hours' is INVALID!\n" +
@@ -105,7 +105,7 @@ func (jDNNanobot *julianDayNoNanobot) testJulianDayNoDtoValidity(
 				jDNDto.hours)
 	}
 
-	if jDNDto.minutes < 0 || jDNDto.minutes >= 59 {
+	if jDNDto.minutes < 0 || jDNDto.minutes > 59 {
 		return false,
 			fmt.Errorf(ePrefix + "\n" +
 				"Data Field 'minutes' is INVALID!\n" +
@@ -0,0 +1,288 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+)
+
+// julianDayNoVariantMechanics - Provides helper methods used to
+// convert between the classic Julian Day Number/Time and the day
+// counts derived from it. Reference type 'JulianDayNoVariantType'.
+//
+// Every variant is computed by subtracting a fixed epoch offset
+// from the Julian Date:
+//
+//   Variant Day Number/Time = JD - Epoch Offset
+//
+// Offsets ending in '.5' shift the start of each day from noon,
+// the Julian Day Number convention, to midnight. All epoch offsets
+// are maintained in method getVariantEpochOffset().
+//
+type julianDayNoVariantMechanics struct {
+	lock *sync.Mutex
+}
+
+// getVariantDayNo - Returns the integer day number for the variant
+// specified by input parameter 'variant'. The returned value is the
+// floor of the variant day number/time. For variants which begin
+// each day at midnight, this is the day number of the Universal
+// Coordinated Time (UTC) calendar date.
+//
+func (jDNVariantMech *julianDayNoVariantMechanics) getVariantDayNo(
+	jDNDto *JulianDayNoDto,
+	variant JulianDayNoVariantType,
+	ePrefix string) (
+	variantDayNo *big.Int,
+	err error) {
+
+	ePrefix += "julianDayNoVariantMechanics.getVariantDayNo() "
+
+	variantDayNo = big.NewInt(0)
+
+	jDNVariantMech2 := julianDayNoVariantMechanics{}
+
+	var variantDayNoTime *big.Float
+
+	variantDayNoTime, err = jDNVariantMech2.getVariantDayNoTime(
+		jDNDto,
+		variant,
+		ePrefix)
+
+	if err != nil {
+		return variantDayNo, err
+	}
+
+	var accuracy big.Accuracy
+
+	variantDayNo, accuracy = variantDayNoTime.Int(nil)
+
+	// big.Float.Int() truncates toward zero. Negative
+	// values with a time fraction must be adjusted to
+	// the floor value.
+	if accuracy == big.Above {
+		variantDayNo =
+			big.NewInt(0).
+				Sub(variantDayNo, big.NewInt(1))
+	}
+
+	return variantDayNo, err
+}
+
+// getVariantDayNoTime - Returns the day number/time for the variant
+// specified by input parameter 'variant'. The returned value is
+// computed from the Julian Day Number/Time encapsulated by input
+// parameter 'jDNDto'.
+//
+// This method does NOT lock 'jDNDto'.
+//
+func (jDNVariantMech *julianDayNoVariantMechanics) getVariantDayNoTime(
+	jDNDto *JulianDayNoDto,
+	variant JulianDayNoVariantType,
+	ePrefix string) (
+	variantDayNoTime *big.Float,
+	err error) {
+
+	if jDNVariantMech.lock == nil {
+		jDNVariantMech.lock = new(sync.Mutex)
+	}
+
+	jDNVariantMech.lock.Lock()
+
+	defer jDNVariantMech.lock.Unlock()
+
+	ePrefix += "julianDayNoVariantMechanics.getVariantDayNoTime() "
+
+	variantDayNoTime = big.NewFloat(0.0)
+
+	jDNNanobot := julianDayNoNanobot{}
+
+	_, err = jDNNanobot.testJulianDayNoDtoValidity(
+		jDNDto,
+		ePrefix + "- Testing input parameter 'jDNDto' validity. ")
+
+	if err != nil {
+		return variantDayNoTime, err
+	}
+
+	precision := jDNDto.julianDayNoTime.Prec()
+
+	if precision < 1024 {
+		precision = 1024
+	}
+
+	jDNVariantMech2 := julianDayNoVariantMechanics{}
+
+	var epochOffset *big.Float
+
+	epochOffset, err = jDNVariantMech2.getVariantEpochOffset(
+		variant,
+		precision,
+		ePrefix)
+
+	if err != nil {
+		return variantDayNoTime, err
+	}
+
+	julianDayNoTime :=
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			Copy(jDNDto.julianDayNoTime)
+
+	if jDNDto.julianDayNoNumericalSign == -1 {
+		julianDayNoTime.Neg(julianDayNoTime)
+	}
+
+	variantDayNoTime =
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			Sub(julianDayNoTime, epochOffset)
+
+	return variantDayNoTime, err
+}
+
+// getVariantEpochOffset - Returns the epoch offset which is
+// subtracted from the Julian Date in order to compute the day
+// number/time for the variant specified by input parameter
+// 'variant'.
+//
+//   JulianDayNumber      0
+//   ModifiedJulianDate   2400000.5
+//   ReducedJulianDate    2400000
+//   TruncatedJulianDate  2440000.5
+//   LilianDayNumber      2299159.5
+//   RataDie              1721424.5
+//
+func (jDNVariantMech *julianDayNoVariantMechanics) getVariantEpochOffset(
+	variant JulianDayNoVariantType,
+	precision uint,
+	ePrefix string) (
+	epochOffset *big.Float,
+	err error) {
+
+	ePrefix += "julianDayNoVariantMechanics.getVariantEpochOffset() "
+
+	var offset float64
+
+	switch variant {
+
+	case JDNVariant.JulianDayNumber():
+		offset = 0.0
+
+	case JDNVariant.ModifiedJulianDate():
+		offset = 2400000.5
+
+	case JDNVariant.ReducedJulianDate():
+		offset = 2400000.0
+
+	case JDNVariant.TruncatedJulianDate():
+		offset = 2440000.5
+
+	case JDNVariant.LilianDayNumber():
+		offset = 2299159.5
+
+	case JDNVariant.RataDie():
+		offset = 1721424.5
+
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'variant' is INVALID!\n" +
+			"variant='%v'\n", variant.XValueInt())
+
+		return big.NewFloat(0.0), err
+	}
+
+	// All offsets are exactly representable as
+	// binary floating point values.
+	epochOffset =
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			SetFloat64(offset)
+
+	return epochOffset, err
+}
+
+// newFromVariantDayNoTime - Creates and returns a new JulianDayNoDto
+// instance from a day number/time expressed in the variant specified
+// by input parameter 'variant'.
+//
+func (jDNVariantMech *julianDayNoVariantMechanics) newFromVariantDayNoTime(
+	variant JulianDayNoVariantType,
+	variantDayNoTime *big.Float,
+	applyLeapSecond bool,
+	ePrefix string) (
+	newJDNDto JulianDayNoDto,
+	err error) {
+
+	if jDNVariantMech.lock == nil {
+		jDNVariantMech.lock = new(sync.Mutex)
+	}
+
+	jDNVariantMech.lock.Lock()
+
+	defer jDNVariantMech.lock.Unlock()
+
+	ePrefix += "julianDayNoVariantMechanics.newFromVariantDayNoTime() "
+
+	newJDNDto = JulianDayNoDto{}
+
+	if variantDayNoTime == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'variantDayNoTime' is a nil pointer!\n")
+
+		return newJDNDto, err
+	}
+
+	if variantDayNoTime.IsInf() {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'variantDayNoTime' is an infinite value!\n")
+
+		return newJDNDto, err
+	}
+
+	precision := variantDayNoTime.Prec()
+
+	if precision < 1024 {
+		precision = 1024
+	}
+
+	jDNVariantMech2 := julianDayNoVariantMechanics{}
+
+	var epochOffset *big.Float
+
+	epochOffset, err = jDNVariantMech2.getVariantEpochOffset(
+		variant,
+		precision,
+		ePrefix)
+
+	if err != nil {
+		return newJDNDto, err
+	}
+
+	julianDayNoTime :=
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			Add(variantDayNoTime, epochOffset)
+
+	jDNMech := julianDayNoDtoMechanics{}
+
+	err = jDNMech.rationalizeJulianDayNoDto(&newJDNDto, ePrefix)
+
+	if err != nil {
+		return newJDNDto, err
+	}
+
+	err = jDNMech.setBigValDto(
+		&newJDNDto,
+		julianDayNoTime,
+		precision,
+		applyLeapSecond,
+		ePrefix)
+
+	return newJDNDto, err
+}
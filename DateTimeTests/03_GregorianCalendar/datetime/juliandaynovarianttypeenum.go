@@ -0,0 +1,404 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mJulianDayNoVariantTypeStringToCode = map[string]JulianDayNoVariantType{
+	"None"                : JulianDayNoVariantType(0),
+	"JulianDayNumber"     : JulianDayNoVariantType(1),
+	"ModifiedJulianDate"  : JulianDayNoVariantType(2),
+	"ReducedJulianDate"   : JulianDayNoVariantType(3),
+	"TruncatedJulianDate" : JulianDayNoVariantType(4),
+	"LilianDayNumber"     : JulianDayNoVariantType(5),
+	"RataDie"             : JulianDayNoVariantType(6),
+}
+
+var mJulianDayNoVariantTypeLwrCaseStringToCode = map[string]JulianDayNoVariantType{
+	"none"                : JulianDayNoVariantType(0),
+	"juliandaynumber"     : JulianDayNoVariantType(1),
+	"modifiedjuliandate"  : JulianDayNoVariantType(2),
+	"reducedjuliandate"   : JulianDayNoVariantType(3),
+	"truncatedjuliandate" : JulianDayNoVariantType(4),
+	"liliandaynumber"     : JulianDayNoVariantType(5),
+	"ratadie"             : JulianDayNoVariantType(6),
+}
+
+var mJulianDayNoVariantTypeCodeToString = map[JulianDayNoVariantType]string{
+	JulianDayNoVariantType(0) : "None",
+	JulianDayNoVariantType(1) : "JulianDayNumber",
+	JulianDayNoVariantType(2) : "ModifiedJulianDate",
+	JulianDayNoVariantType(3) : "ReducedJulianDate",
+	JulianDayNoVariantType(4) : "TruncatedJulianDate",
+	JulianDayNoVariantType(5) : "LilianDayNumber",
+	JulianDayNoVariantType(6) : "RataDie",
+}
+
+// JulianDayNoVariantType - An enumeration of the day counts derived
+// from the classic Julian Day Number. Each variant is computed by
+// subtracting a fixed epoch offset from the Julian Date. Variants
+// with a fractional offset begin each day at midnight rather than
+// at noon.
+//
+// Since Go does not directly support enumerations, the 'JulianDayNoVariantType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'JulianDayNoVariantType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of Julian Day
+// Number variants. These methods are listed as follows:
+//
+//
+// None                (0) - Signals that the Julian Day Number Variant
+//                           Type is not initialized. This is an error
+//                           condition.
+//
+// JulianDayNumber     (1) - The classic Julian Day Number/Time. Days
+//                           begin at noon.
+//                             JD
+//
+// ModifiedJulianDate  (2) - Modified Julian Date. Days begin at midnight.
+//                           Day zero began at 00:00:00 UTC on
+//                           November 17, 1858.
+//                             MJD = JD - 2400000.5
+//
+// ReducedJulianDate   (3) - Reduced Julian Date. Days begin at noon.
+//                             RJD = JD - 2400000
+//
+// TruncatedJulianDate (4) - Truncated Julian Date as defined by NASA.
+//                           Days begin at midnight. Day zero began at
+//                           00:00:00 UTC on May 24, 1968.
+//                             TJD = JD - 2440000.5
+//
+// LilianDayNumber     (5) - Lilian Day Number. Days begin at midnight.
+//                           Day one is October 15, 1582, the first day
+//                           of the Gregorian Calendar.
+//                             Lilian = JD - 2299159.5
+//
+// RataDie             (6) - Rata Die (R.D.). Days begin at midnight.
+//                           Day one is January 1, 0001 on the proleptic
+//                           Gregorian Calendar.
+//                             RD = JD - 1721424.5
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'JDNVariant'. Example: JDNVariant.ModifiedJulianDate()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: JulianDayNoVariantType(0).ModifiedJulianDate()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the JulianDayNoVariantType methods in alphabetical order. Be advised that all
+// 'JulianDayNoVariantType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+// For more information on Julian Day Number variants, reference:
+//   https://en.wikipedia.org/wiki/Julian_day#Variants
+//
+type JulianDayNoVariantType int
+
+var lockJulianDayNoVariantType sync.Mutex
+
+// None - Signals that the JulianDayNoVariantType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (jdnVariant JulianDayNoVariantType) None() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return JulianDayNoVariantType(0)
+}
+
+// JulianDayNumber - Signals the classic Julian Day Number/Time.
+// Days begin at noon.
+//
+// This method is part of the standard enumeration.
+//
+func (jdnVariant JulianDayNoVariantType) JulianDayNumber() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return JulianDayNoVariantType(1)
+}
+
+// ModifiedJulianDate - Signals the Modified Julian Date (MJD).
+// Days begin at midnight.
+//
+//   MJD = JD - 2400000.5
+//
+// This method is part of the standard enumeration.
+//
+func (jdnVariant JulianDayNoVariantType) ModifiedJulianDate() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return JulianDayNoVariantType(2)
+}
+
+// ReducedJulianDate - Signals the Reduced Julian Date (RJD).
+// Days begin at noon.
+//
+//   RJD = JD - 2400000
+//
+// This method is part of the standard enumeration.
+//
+func (jdnVariant JulianDayNoVariantType) ReducedJulianDate() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return JulianDayNoVariantType(3)
+}
+
+// TruncatedJulianDate - Signals the Truncated Julian Date (TJD)
+// as defined by NASA. Days begin at midnight.
+//
+//   TJD = JD - 2440000.5
+//
+// This method is part of the standard enumeration.
+//
+func (jdnVariant JulianDayNoVariantType) TruncatedJulianDate() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return JulianDayNoVariantType(4)
+}
+
+// LilianDayNumber - Signals the Lilian Day Number. Days begin
+// at midnight.
+//
+//   Lilian = JD - 2299159.5
+//
+// This method is part of the standard enumeration.
+//
+func (jdnVariant JulianDayNoVariantType) LilianDayNumber() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return JulianDayNoVariantType(5)
+}
+
+// RataDie - Signals the Rata Die (R.D.) day count. Days begin
+// at midnight.
+//
+//   RD = JD - 1721424.5
+//
+// This method is part of the standard enumeration.
+//
+func (jdnVariant JulianDayNoVariantType) RataDie() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return JulianDayNoVariantType(6)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'JulianDayNoVariantType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= JulianDayNoVariantType(0).RataDie()
+// str := t.String()
+//     str is now equal to 'RataDie'
+//
+func (jdnVariant JulianDayNoVariantType) String() string {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	result, ok := mJulianDayNoVariantTypeCodeToString[jdnVariant]
+
+	if !ok {
+		return "Error: Julian Day Number Variant Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current JulianDayNoVariantType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  variantType := JulianDayNoVariantType(0).ModifiedJulianDate()
+//
+//  isValid := variantType.XIsValid()
+//
+func (jdnVariant JulianDayNoVariantType) XIsValid() bool {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	if jdnVariant > 6 ||
+		jdnVariant < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of JulianDayNoVariantType is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'ratadie' will NOT
+//                        match the enumeration name, 'RataDie'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'ratadie'
+//                        will match match enumeration name 'RataDie'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// JulianDayNoVariantType - Upon successful completion, this method will return
+//                          a new instance of JulianDayNoVariantType set to the value
+//                          of the enumeration matched by the string search performed
+//                          on input parameter, 'valueString'.
+//
+// error                  - If this method completes successfully, the returned error
+//                          Type is set equal to 'nil'. If an error condition is
+//                          encountered, this method will return an error type which
+//                          encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := JulianDayNoVariantType(0).XParseString("ModifiedJulianDate", true)
+//
+//     t is now equal to JulianDayNoVariantType(0).ModifiedJulianDate()
+//
+func (jdnVariant JulianDayNoVariantType) XParseString(
+	valueString string,
+	caseSensitive bool) (JulianDayNoVariantType, error) {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	ePrefix := "JulianDayNoVariantType.XParseString() "
+
+	if len(valueString) < 4 {
+		return JulianDayNoVariantType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var variantType JulianDayNoVariantType
+
+	if caseSensitive {
+
+		variantType, ok = mJulianDayNoVariantTypeStringToCode[valueString]
+
+	} else {
+
+		variantType, ok =
+			mJulianDayNoVariantTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return JulianDayNoVariantType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid JulianDayNoVariantType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return variantType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// JulianDayNoVariantType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (jdnVariant JulianDayNoVariantType) XValue() JulianDayNoVariantType {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return jdnVariant
+}
+
+// XValueInt - This method returns the integer value of the current
+// JulianDayNoVariantType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (jdnVariant JulianDayNoVariantType) XValueInt() int {
+
+	lockJulianDayNoVariantType.Lock()
+
+	defer lockJulianDayNoVariantType.Unlock()
+
+	return int(jdnVariant)
+}
+
+// JDNVariant - public global variable of
+// type JulianDayNoVariantType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing JulianDayNoVariantType values.
+//
+// Usage:
+// JDNVariant.None(),
+// JDNVariant.JulianDayNumber(),
+// JDNVariant.ModifiedJulianDate(),
+// JDNVariant.ReducedJulianDate(),
+// JDNVariant.TruncatedJulianDate(),
+// JDNVariant.LilianDayNumber(),
+// JDNVariant.RataDie(),
+//
+var JDNVariant JulianDayNoVariantType
@@ -0,0 +1,119 @@
+package datetime
+
+import (
+	"sync"
+	"time"
+)
+
+// LeapSecondDto - Describes a single entry in a leap second table.
+// Each entry identifies the date on which a new value of TAI - UTC
+// (International Atomic Time minus Coordinated Universal Time) took
+// effect.
+//
+// A positive leap second is inserted as the final second, 23:59:60
+// UTC, of the day preceding the effective date. The first entry in
+// the IERS table, January 1, 1972, marks the start of the modern
+// leap second system and was not preceded by a leap second.
+//
+// Reference type 'LeapSecondTable'.
+//
+type LeapSecondDto struct {
+	effectiveDate time.Time // 00:00:00 UTC on the date the TAI - UTC value takes effect
+	taiMinusUtc   int       // TAI - UTC in seconds beginning on 'effectiveDate'
+	isLeapSecond  bool      // 'true' if a leap second was inserted immediately before 'effectiveDate'
+	lock          *sync.Mutex
+}
+
+// CopyOut - Returns a deep copy of the current LeapSecondDto
+// instance.
+//
+func (leapSecDto *LeapSecondDto) CopyOut() LeapSecondDto {
+
+	if leapSecDto.lock == nil {
+		leapSecDto.lock = new(sync.Mutex)
+	}
+
+	leapSecDto.lock.Lock()
+
+	defer leapSecDto.lock.Unlock()
+
+	return LeapSecondDto{
+		effectiveDate: leapSecDto.effectiveDate,
+		taiMinusUtc:   leapSecDto.taiMinusUtc,
+		isLeapSecond:  leapSecDto.isLeapSecond,
+		lock:          new(sync.Mutex),
+	}
+}
+
+// GetEffectiveDate - Returns the date and time, 00:00:00 UTC, at
+// which the TAI - UTC value for this entry takes effect.
+//
+func (leapSecDto *LeapSecondDto) GetEffectiveDate() time.Time {
+
+	if leapSecDto.lock == nil {
+		leapSecDto.lock = new(sync.Mutex)
+	}
+
+	leapSecDto.lock.Lock()
+
+	defer leapSecDto.lock.Unlock()
+
+	return leapSecDto.effectiveDate
+}
+
+// GetIsLeapSecond - Returns 'true' if a leap second was inserted
+// at 23:59:60 UTC on the day preceding the effective date of this
+// entry.
+//
+func (leapSecDto *LeapSecondDto) GetIsLeapSecond() bool {
+
+	if leapSecDto.lock == nil {
+		leapSecDto.lock = new(sync.Mutex)
+	}
+
+	leapSecDto.lock.Lock()
+
+	defer leapSecDto.lock.Unlock()
+
+	return leapSecDto.isLeapSecond
+}
+
+// GetLeapSecondDate - Returns the UTC date on which the leap
+// second was inserted. This is the day preceding the effective
+// date. If this entry was not preceded by a leap second, the
+// returned boolean value is 'false'.
+//
+func (leapSecDto *LeapSecondDto) GetLeapSecondDate() (
+	leapSecondDate time.Time,
+	isLeapSecond bool) {
+
+	if leapSecDto.lock == nil {
+		leapSecDto.lock = new(sync.Mutex)
+	}
+
+	leapSecDto.lock.Lock()
+
+	defer leapSecDto.lock.Unlock()
+
+	if !leapSecDto.isLeapSecond {
+		return leapSecondDate, false
+	}
+
+	return leapSecDto.effectiveDate.AddDate(0, 0, -1), true
+}
+
+// GetTaiMinusUtc - Returns the difference, TAI - UTC, in seconds
+// which applies beginning on the effective date of this entry.
+//
+func (leapSecDto *LeapSecondDto) GetTaiMinusUtc() int {
+
+	if leapSecDto.lock == nil {
+		leapSecDto.lock = new(sync.Mutex)
+	}
+
+	leapSecDto.lock.Lock()
+
+	defer leapSecDto.lock.Unlock()
+
+	return leapSecDto.taiMinusUtc
+}
@@ -0,0 +1,368 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// LeapSecondTable - Contains the history of leap seconds applied to
+// Coordinated Universal Time (UTC) together with the corresponding
+// values of TAI - UTC (International Atomic Time minus Coordinated
+// Universal Time).
+//
+// The table is populated from data in the format of the IERS
+// (International Earth Rotation and Reference Systems Service) file,
+// 'leap-seconds.list'. A copy of this file is embedded in the
+// package and serves as the default leap second table. Reference:
+//   https://hpiers.obspm.fr/iers/bul/bulc/ntp/leap-seconds.list
+//
+// The package maintains a 'current' leap second table which is used
+// to set leap second flags automatically and to convert date times
+// between the UTC, TAI, GPS and TT time scales. The current table
+// is initialized from the embedded data. It may be replaced with a
+// more recent table by calling LeapSecondTable.LoadCurrentFromFile()
+// and restored by calling LeapSecondTable.ResetCurrent().
+//
+// For more information on the 'leap second', reference:
+//   https://en.wikipedia.org/wiki/Leap_second
+//
+type LeapSecondTable struct {
+	entries        []LeapSecondDto // Table entries in chronological order
+	lastUpdateDate time.Time       // Date on which the table was last updated. May be zero.
+	expirationDate time.Time       // Date on which the table expires. May be zero.
+	lock           *sync.Mutex
+}
+
+// CopyOut - Returns a deep copy of the current LeapSecondTable
+// instance.
+//
+func (leapSecTable *LeapSecondTable) CopyOut() LeapSecondTable {
+
+	if leapSecTable.lock == nil {
+		leapSecTable.lock = new(sync.Mutex)
+	}
+
+	leapSecTable.lock.Lock()
+
+	defer leapSecTable.lock.Unlock()
+
+	newTable := LeapSecondTable{
+		lastUpdateDate: leapSecTable.lastUpdateDate,
+		expirationDate: leapSecTable.expirationDate,
+		lock:           new(sync.Mutex),
+	}
+
+	newTable.entries = make([]LeapSecondDto, len(leapSecTable.entries))
+
+	for i := 0; i < len(leapSecTable.entries); i++ {
+		newTable.entries[i] = leapSecTable.entries[i].CopyOut()
+	}
+
+	return newTable
+}
+
+// GetCurrent - Returns a deep copy of the current leap second table.
+// This is the table used to set leap second flags and to perform time
+// scale conversions. Unless replaced by LoadCurrentFromFile(), the
+// current table is the IERS table embedded in this package.
+//
+func (leapSecTable LeapSecondTable) GetCurrent(
+	ePrefix string) (
+	LeapSecondTable,
+	error) {
+
+	ePrefix += "LeapSecondTable.GetCurrent() "
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	currentTable, err := leapSecMech.getCurrentTable(ePrefix)
+
+	if err != nil {
+		return LeapSecondTable{}, err
+	}
+
+	return currentTable.CopyOut(), nil
+}
+
+// GetEntries - Returns a deep copy of the leap second table entries
+// in chronological order.
+//
+func (leapSecTable *LeapSecondTable) GetEntries() []LeapSecondDto {
+
+	if leapSecTable.lock == nil {
+		leapSecTable.lock = new(sync.Mutex)
+	}
+
+	leapSecTable.lock.Lock()
+
+	defer leapSecTable.lock.Unlock()
+
+	entries := make([]LeapSecondDto, len(leapSecTable.entries))
+
+	for i := 0; i < len(leapSecTable.entries); i++ {
+		entries[i] = leapSecTable.entries[i].CopyOut()
+	}
+
+	return entries
+}
+
+// GetExpirationDate - Returns the expiration date of the leap second
+// table. After this date, the table may not reflect leap seconds
+// announced by the IERS. If the source data did not specify an
+// expiration date, the returned value is the zero time value.
+//
+func (leapSecTable *LeapSecondTable) GetExpirationDate() time.Time {
+
+	if leapSecTable.lock == nil {
+		leapSecTable.lock = new(sync.Mutex)
+	}
+
+	leapSecTable.lock.Lock()
+
+	defer leapSecTable.lock.Unlock()
+
+	return leapSecTable.expirationDate
+}
+
+// GetLastUpdateDate - Returns the date on which the leap second
+// table was last updated. If the source data did not specify an
+// update date, the returned value is the zero time value.
+//
+func (leapSecTable *LeapSecondTable) GetLastUpdateDate() time.Time {
+
+	if leapSecTable.lock == nil {
+		leapSecTable.lock = new(sync.Mutex)
+	}
+
+	leapSecTable.lock.Lock()
+
+	defer leapSecTable.lock.Unlock()
+
+	return leapSecTable.lastUpdateDate
+}
+
+// GetTaiMinusUtc - Returns the value of TAI - UTC in seconds at the
+// date time specified by input parameter 'dateTime'. Date times
+// preceding January 1, 1972 generate an error.
+//
+// Date times following the expiration date of the table return the
+// most recent value of TAI - UTC.
+//
+func (leapSecTable *LeapSecondTable) GetTaiMinusUtc(
+	dateTime time.Time,
+	ePrefix string) (
+	taiMinusUtc int,
+	err error) {
+
+	if leapSecTable.lock == nil {
+		leapSecTable.lock = new(sync.Mutex)
+	}
+
+	leapSecTable.lock.Lock()
+
+	defer leapSecTable.lock.Unlock()
+
+	ePrefix += "LeapSecondTable.GetTaiMinusUtc() "
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	return leapSecMech.getTaiMinusUtc(
+		leapSecTable,
+		dateTime.Unix(),
+		ePrefix)
+}
+
+// IsExpired - Returns 'true' if the date time passed as an input
+// parameter occurs after the expiration date of this leap second
+// table. If the table does not specify an expiration date, this
+// method returns 'false'.
+//
+func (leapSecTable *LeapSecondTable) IsExpired(
+	dateTime time.Time) bool {
+
+	if leapSecTable.lock == nil {
+		leapSecTable.lock = new(sync.Mutex)
+	}
+
+	leapSecTable.lock.Lock()
+
+	defer leapSecTable.lock.Unlock()
+
+	if leapSecTable.expirationDate.IsZero() {
+		return false
+	}
+
+	return dateTime.After(leapSecTable.expirationDate)
+}
+
+// IsLeapSecondDay - Returns 'true' if a leap second was inserted
+// during the specified Gregorian Calendar day in the time zone
+// specified by input parameter 'timeZoneLocation'. If
+// 'timeZoneLocation' is 'nil', the day is evaluated in UTC.
+//
+// Leap seconds are inserted at 23:59:60 UTC. In other time zones,
+// the leap second occurs at a different local time and may fall on
+// a different local date. For example, the leap second inserted on
+// December 31, 2016 UTC occurred at 08:59:60 on January 1, 2017 in
+// the 'Asia/Tokyo' time zone.
+//
+func (leapSecTable *LeapSecondTable) IsLeapSecondDay(
+	year int,
+	month int,
+	day int,
+	timeZoneLocation *time.Location) bool {
+
+	if leapSecTable.lock == nil {
+		leapSecTable.lock = new(sync.Mutex)
+	}
+
+	leapSecTable.lock.Lock()
+
+	defer leapSecTable.lock.Unlock()
+
+	if timeZoneLocation == nil {
+		timeZoneLocation = time.UTC
+	}
+
+	dayStart := time.Date(year, time.Month(month), day, 0, 0, 0, 0, timeZoneLocation)
+
+	dayEnd := time.Date(year, time.Month(month), day+1, 0, 0, 0, 0, timeZoneLocation)
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	return leapSecMech.isLeapSecondDay(
+		leapSecTable,
+		dayStart,
+		dayEnd)
+}
+
+// LoadCurrentFromFile - Replaces the current leap second table with
+// a table loaded from a file in the format of the IERS file,
+// 'leap-seconds.list'. The current table is used to set leap second
+// flags and to perform time scale conversions.
+//
+// If an error is returned, the current table is NOT changed.
+//
+func (leapSecTable LeapSecondTable) LoadCurrentFromFile(
+	pathFileName string,
+	ePrefix string) error {
+
+	ePrefix += "LeapSecondTable.LoadCurrentFromFile() "
+
+	newTable, err := LeapSecondTable{}.NewFromFile(
+		pathFileName,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lockLeapSecondTableCurrent.Lock()
+
+	defer lockLeapSecondTableCurrent.Unlock()
+
+	leapSecondTableCurrent = &newTable
+
+	return nil
+}
+
+// New - Returns a new LeapSecondTable populated from the IERS leap
+// second table embedded in this package.
+//
+func (leapSecTable LeapSecondTable) New(
+	ePrefix string) (
+	LeapSecondTable,
+	error) {
+
+	ePrefix += "LeapSecondTable.New() "
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	return leapSecMech.parseLeapSecondsList(
+		embeddedLeapSecondsList,
+		ePrefix)
+}
+
+// NewFromBytes - Returns a new LeapSecondTable populated from leap
+// second data in the format of the IERS file, 'leap-seconds.list'.
+//
+func (leapSecTable LeapSecondTable) NewFromBytes(
+	leapSecondsList []byte,
+	ePrefix string) (
+	LeapSecondTable,
+	error) {
+
+	ePrefix += "LeapSecondTable.NewFromBytes() "
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	return leapSecMech.parseLeapSecondsList(
+		leapSecondsList,
+		ePrefix)
+}
+
+// NewFromFile - Returns a new LeapSecondTable populated from a file
+// in the format of the IERS file, 'leap-seconds.list'. On many Unix
+// systems, a copy of this file is installed as:
+//   /usr/share/zoneinfo/leap-seconds.list
+//
+func (leapSecTable LeapSecondTable) NewFromFile(
+	pathFileName string,
+	ePrefix string) (
+	LeapSecondTable,
+	error) {
+
+	ePrefix += "LeapSecondTable.NewFromFile() "
+
+	if len(pathFileName) == 0 {
+		return LeapSecondTable{},
+			errors.New(ePrefix + "\n" +
+				"Error: Input parameter 'pathFileName' is an EMPTY STRING!\n")
+	}
+
+	leapSecondsList, err := os.ReadFile(pathFileName)
+
+	if err != nil {
+		return LeapSecondTable{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error returned by os.ReadFile(pathFileName)\n" +
+				"pathFileName='%v'\n" +
+				"Error='%v'\n", pathFileName, err.Error())
+	}
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	return leapSecMech.parseLeapSecondsList(
+		leapSecondsList,
+		ePrefix)
+}
+
+// ResetCurrent - Restores the current leap second table to the IERS
+// leap second table embedded in this package.
+//
+func (leapSecTable LeapSecondTable) ResetCurrent(
+	ePrefix string) error {
+
+	ePrefix += "LeapSecondTable.ResetCurrent() "
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	newTable, err := leapSecMech.parseLeapSecondsList(
+		embeddedLeapSecondsList,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lockLeapSecondTableCurrent.Lock()
+
+	defer lockLeapSecondTableCurrent.Unlock()
+
+	leapSecondTableCurrent = &newTable
+
+	return nil
+}
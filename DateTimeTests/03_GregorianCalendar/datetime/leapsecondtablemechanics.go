@@ -0,0 +1,501 @@
+package datetime
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// embeddedLeapSecondsList - The IERS leap second table compiled
+// into this package. The source file, 'leap-seconds.list', uses the
+// format published by the International Earth Rotation and Reference
+// Systems Service (IERS).
+//
+//go:embed leap-seconds.list
+var embeddedLeapSecondsList []byte
+
+// leapSecondTableCurrent - The leap second table used to set leap
+// second flags and to perform time scale conversions. This table is
+// initialized from 'embeddedLeapSecondsList' on first use and may be
+// replaced by calling LeapSecondTable.LoadCurrentFromFile().
+//
+var leapSecondTableCurrent *LeapSecondTable
+
+var lockLeapSecondTableCurrent sync.Mutex
+
+// ntpEpochUnixSeconds - The number of seconds between the NTP epoch,
+// 1900-01-01 00:00:00 UTC, and the Unix epoch, 1970-01-01 00:00:00
+// UTC. Time values in 'leap-seconds.list' are expressed in NTP
+// seconds.
+//
+const ntpEpochUnixSeconds int64 = 2208988800
+
+// leapSecondTableMechanics - Provides helper methods used to parse
+// IERS leap second tables and to convert time values between the
+// UTC and TAI time scales.
+//
+// Within this type, instants are expressed as a count of seconds
+// since 1970-01-01 00:00:00 on the relevant time scale, ignoring leap
+// seconds, plus a nanosecond component. For UTC, the inserted leap
+// second, 23:59:60, shares the count of 23:59:59 and is identified
+// by a separate boolean flag, 'inLeapSecond'.
+//
+type leapSecondTableMechanics struct {
+	lock *sync.Mutex
+}
+
+// getCurrentTable - Returns a pointer to the current leap second
+// table. If the current table has not yet been initialized, it is
+// loaded from the embedded IERS leap second table.
+//
+func (leapSecMech *leapSecondTableMechanics) getCurrentTable(
+	ePrefix string) (
+	*LeapSecondTable,
+	error) {
+
+	lockLeapSecondTableCurrent.Lock()
+
+	defer lockLeapSecondTableCurrent.Unlock()
+
+	ePrefix += "leapSecondTableMechanics.getCurrentTable() "
+
+	if leapSecondTableCurrent != nil {
+		return leapSecondTableCurrent, nil
+	}
+
+	leapSecMech2 := leapSecondTableMechanics{}
+
+	newTable, err := leapSecMech2.parseLeapSecondsList(
+		embeddedLeapSecondsList,
+		ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	leapSecondTableCurrent = &newTable
+
+	return leapSecondTableCurrent, nil
+}
+
+// getTaiMinusUtc - Returns the value of TAI - UTC in seconds for a
+// UTC instant expressed as seconds since the Unix epoch. UTC
+// instants before January 1, 1972 are not supported and generate
+// an error.
+//
+func (leapSecMech *leapSecondTableMechanics) getTaiMinusUtc(
+	leapSecTable *LeapSecondTable,
+	utcSeconds int64,
+	ePrefix string) (
+	taiMinusUtc int,
+	err error) {
+
+	ePrefix += "leapSecondTableMechanics.getTaiMinusUtc() "
+
+	if leapSecTable == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'leapSecTable' is a nil pointer!\n")
+		return taiMinusUtc, err
+	}
+
+	if len(leapSecTable.entries) == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: The leap second table is EMPTY!\n")
+		return taiMinusUtc, err
+	}
+
+	if utcSeconds < leapSecTable.entries[0].effectiveDate.Unix() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The UTC date time precedes the first entry in the leap second table.\n" +
+			"TAI - UTC is not defined as a whole number of seconds before '%v'.\n" +
+			"UTC Date Time='%v'\n",
+			leapSecTable.entries[0].effectiveDate.Format(FmtDateTimeYrMDayFmtStr),
+			time.Unix(utcSeconds, 0).UTC().Format(FmtDateTimeYrMDayFmtStr))
+		return taiMinusUtc, err
+	}
+
+	for i := len(leapSecTable.entries) - 1; i >= 0; i-- {
+
+		if utcSeconds >= leapSecTable.entries[i].effectiveDate.Unix() {
+			taiMinusUtc = leapSecTable.entries[i].taiMinusUtc
+			break
+		}
+	}
+
+	return taiMinusUtc, err
+}
+
+// isLeapSecondDay - Returns 'true' if a leap second was inserted
+// during the day beginning at 'dayStart' and ending at 'dayEnd'.
+// The leap second ends at the effective date of a table entry.
+//
+func (leapSecMech *leapSecondTableMechanics) isLeapSecondDay(
+	leapSecTable *LeapSecondTable,
+	dayStart time.Time,
+	dayEnd time.Time) bool {
+
+	if leapSecTable == nil {
+		return false
+	}
+
+	for i := 0; i < len(leapSecTable.entries); i++ {
+
+		if !leapSecTable.entries[i].isLeapSecond {
+			continue
+		}
+
+		effectiveDate := leapSecTable.entries[i].effectiveDate
+
+		if effectiveDate.After(dayStart) &&
+			!effectiveDate.After(dayEnd) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isLeapSecondDate - Returns 'true' if, according to the current
+// leap second table, a leap second was inserted during the specified
+// date in the time zone specified by input parameter 'location'. Leap
+// seconds are only recognized for dates on the Gregorian Calendar.
+// If 'location' is 'nil', the date is evaluated in UTC.
+//
+func (leapSecMech *leapSecondTableMechanics) isLeapSecondDate(
+	calendarSystem CalendarSpec,
+	astronomicalYear int64,
+	month int,
+	day int,
+	location *time.Location) bool {
+
+	if calendarSystem != CalendarSpec(0).Gregorian() {
+		return false
+	}
+
+	// The first leap second was inserted in 1972
+	if astronomicalYear < 1972 ||
+		astronomicalYear > 1000000 {
+		return false
+	}
+
+	leapSecMech2 := leapSecondTableMechanics{}
+
+	leapSecTable, err := leapSecMech2.getCurrentTable(
+		"leapSecondTableMechanics.isLeapSecondDate() ")
+
+	if err != nil {
+		return false
+	}
+
+	if location == nil {
+		location = time.UTC
+	}
+
+	dayStart := time.Date(
+		int(astronomicalYear), time.Month(month), day, 0, 0, 0, 0, location)
+
+	dayEnd := time.Date(
+		int(astronomicalYear), time.Month(month), day+1, 0, 0, 0, 0, location)
+
+	return leapSecMech2.isLeapSecondDay(
+		leapSecTable,
+		dayStart,
+		dayEnd)
+}
+
+// parseLeapSecondsList - Parses leap second data in the format of
+// the IERS file, 'leap-seconds.list', and returns a new, populated
+// LeapSecondTable.
+//
+// Lines beginning with '#$' specify the last update time and lines
+// beginning with '#@' specify the expiration time. Both are
+// expressed in NTP seconds. All other lines beginning with '#' are
+// comments. The hash line, '#h', is ignored.
+//
+// Each data line consists of the NTP time at which a new value of
+// TAI - UTC takes effect, followed by that value in seconds and an
+// optional comment. Data lines must be in ascending order and
+// successive values of TAI - UTC must differ by exactly one second.
+//
+func (leapSecMech *leapSecondTableMechanics) parseLeapSecondsList(
+	leapSecondsList []byte,
+	ePrefix string) (
+	newTable LeapSecondTable,
+	err error) {
+
+	if leapSecMech.lock == nil {
+		leapSecMech.lock = new(sync.Mutex)
+	}
+
+	leapSecMech.lock.Lock()
+
+	defer leapSecMech.lock.Unlock()
+
+	ePrefix += "leapSecondTableMechanics.parseLeapSecondsList() "
+
+	newTable = LeapSecondTable{
+		lock: new(sync.Mutex),
+	}
+
+	scanner := bufio.NewScanner(bytes.NewReader(leapSecondsList))
+
+	lineNo := 0
+
+	var ntpSeconds int64
+
+	for scanner.Scan() {
+
+		lineNo++
+
+		line := strings.TrimSpace(scanner.Text())
+
+		if len(line) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(line, "#$") ||
+			strings.HasPrefix(line, "#@") {
+
+			ntpSeconds, err = strconv.ParseInt(
+				strings.TrimSpace(line[2:]), 10, 64)
+
+			if err != nil {
+				return LeapSecondTable{},
+					fmt.Errorf(ePrefix + "\n" +
+						"Error: Invalid NTP time on line %v.\n" +
+						"Line='%v'\n", lineNo, line)
+			}
+
+			if line[1] == '$' {
+				newTable.lastUpdateDate =
+					time.Unix(ntpSeconds - ntpEpochUnixSeconds, 0).UTC()
+			} else {
+				newTable.expirationDate =
+					time.Unix(ntpSeconds - ntpEpochUnixSeconds, 0).UTC()
+			}
+
+			continue
+		}
+
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		if idx := strings.Index(line, "#"); idx > -1 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+
+		if len(fields) != 2 {
+			return LeapSecondTable{},
+				fmt.Errorf(ePrefix + "\n" +
+					"Error: Data line %v does NOT contain two fields.\n" +
+					"Line='%v'\n", lineNo, scanner.Text())
+		}
+
+		ntpSeconds, err = strconv.ParseInt(fields[0], 10, 64)
+
+		if err != nil {
+			return LeapSecondTable{},
+				fmt.Errorf(ePrefix + "\n" +
+					"Error: Invalid NTP time on data line %v.\n" +
+					"Line='%v'\n", lineNo, scanner.Text())
+		}
+
+		var taiMinusUtc int
+
+		taiMinusUtc, err = strconv.Atoi(fields[1])
+
+		if err != nil {
+			return LeapSecondTable{},
+				fmt.Errorf(ePrefix + "\n" +
+					"Error: Invalid TAI - UTC value on data line %v.\n" +
+					"Line='%v'\n", lineNo, scanner.Text())
+		}
+
+		entry := LeapSecondDto{
+			effectiveDate: time.Unix(ntpSeconds - ntpEpochUnixSeconds, 0).UTC(),
+			taiMinusUtc:   taiMinusUtc,
+			lock:          new(sync.Mutex),
+		}
+
+		lastIdx := len(newTable.entries) - 1
+
+		if lastIdx > -1 {
+
+			previous := newTable.entries[lastIdx]
+
+			if !entry.effectiveDate.After(previous.effectiveDate) {
+				return LeapSecondTable{},
+					fmt.Errorf(ePrefix + "\n" +
+						"Error: Data line %v is out of chronological order.\n" +
+						"Line='%v'\n", lineNo, scanner.Text())
+			}
+
+			difference := entry.taiMinusUtc - previous.taiMinusUtc
+
+			if difference != 1 && difference != -1 {
+				return LeapSecondTable{},
+					fmt.Errorf(ePrefix + "\n" +
+						"Error: TAI - UTC on data line %v differs from the previous\n" +
+						"value by %v seconds. The difference must be one second.\n" +
+						"Line='%v'\n", lineNo, difference, scanner.Text())
+			}
+
+			entry.isLeapSecond = difference == 1
+		}
+
+		newTable.entries = append(newTable.entries, entry)
+	}
+
+	err = scanner.Err()
+
+	if err != nil {
+		return LeapSecondTable{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error returned by scanner.Scan()\n" +
+				"Error='%v'\n", err.Error())
+	}
+
+	if len(newTable.entries) == 0 {
+		return LeapSecondTable{},
+			errors.New(ePrefix + "\n" +
+				"Error: The leap second data contains NO data lines!\n")
+	}
+
+	return newTable, nil
+}
+
+// taiToUtc - Converts a TAI instant to UTC. Both instants are
+// expressed as seconds since 1970-01-01 00:00:00 on their respective
+// time scales. If the TAI instant falls within an inserted leap
+// second, the returned UTC seconds identify 23:59:59 and
+// 'inLeapSecond' is set to 'true', signaling 23:59:60.
+//
+func (leapSecMech *leapSecondTableMechanics) taiToUtc(
+	leapSecTable *LeapSecondTable,
+	taiSeconds int64,
+	ePrefix string) (
+	utcSeconds int64,
+	inLeapSecond bool,
+	err error) {
+
+	ePrefix += "leapSecondTableMechanics.taiToUtc() "
+
+	if leapSecTable == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'leapSecTable' is a nil pointer!\n")
+		return utcSeconds, inLeapSecond, err
+	}
+
+	entries := leapSecTable.entries
+
+	if len(entries) == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: The leap second table is EMPTY!\n")
+		return utcSeconds, inLeapSecond, err
+	}
+
+	if taiSeconds < entries[0].effectiveDate.Unix() +
+		int64(entries[0].taiMinusUtc) {
+
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TAI date time precedes the first entry in the leap second table.\n" +
+			"TAI - UTC is not defined as a whole number of seconds before '%v' UTC.\n",
+			entries[0].effectiveDate.Format(FmtDateTimeYrMDayFmtStr))
+
+		return utcSeconds, inLeapSecond, err
+	}
+
+	idx := 0
+
+	for i := len(entries) - 1; i >= 0; i-- {
+
+		if taiSeconds >=
+			entries[i].effectiveDate.Unix() + int64(entries[i].taiMinusUtc) {
+			idx = i
+			break
+		}
+	}
+
+	utcSeconds = taiSeconds - int64(entries[idx].taiMinusUtc)
+
+	if idx + 1 < len(entries) &&
+		entries[idx + 1].isLeapSecond &&
+		taiSeconds >= entries[idx + 1].effectiveDate.Unix() +
+			int64(entries[idx + 1].taiMinusUtc) - 1 {
+
+		utcSeconds = entries[idx + 1].effectiveDate.Unix() - 1
+		inLeapSecond = true
+	}
+
+	return utcSeconds, inLeapSecond, err
+}
+
+// utcToTai - Converts a UTC instant to TAI. Both instants are
+// expressed as seconds since 1970-01-01 00:00:00 on their respective
+// time scales. If 'inLeapSecond' is 'true', 'utcSeconds' must
+// identify 23:59:59 UTC on a day which ended with a leap second.
+//
+func (leapSecMech *leapSecondTableMechanics) utcToTai(
+	leapSecTable *LeapSecondTable,
+	utcSeconds int64,
+	inLeapSecond bool,
+	ePrefix string) (
+	taiSeconds int64,
+	err error) {
+
+	ePrefix += "leapSecondTableMechanics.utcToTai() "
+
+	leapSecMech2 := leapSecondTableMechanics{}
+
+	var taiMinusUtc int
+
+	taiMinusUtc, err = leapSecMech2.getTaiMinusUtc(
+		leapSecTable,
+		utcSeconds,
+		ePrefix)
+
+	if err != nil {
+		return taiSeconds, err
+	}
+
+	taiSeconds = utcSeconds + int64(taiMinusUtc)
+
+	if !inLeapSecond {
+		return taiSeconds, err
+	}
+
+	nextSecond := time.Unix(utcSeconds + 1, 0).UTC()
+
+	isValidLeapSecond := false
+
+	for i := 0; i < len(leapSecTable.entries); i++ {
+
+		if leapSecTable.entries[i].isLeapSecond &&
+			leapSecTable.entries[i].effectiveDate.Equal(nextSecond) {
+			isValidLeapSecond = true
+			break
+		}
+	}
+
+	if !isValidLeapSecond {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: No leap second was inserted at the end of the UTC day.\n" +
+			"UTC Date Time='%v'\n",
+			time.Unix(utcSeconds, 0).UTC().Format(FmtDateTimeYrMDayFmtStr))
+
+		return taiSeconds, err
+	}
+
+	taiSeconds++
+
+	return taiSeconds, err
+}
@@ -0,0 +1,206 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// militaryDtgMonthAbbreviations - Maps the upper case three letter
+// month abbreviations used in Military Date Time Groups to month
+// numbers.
+var militaryDtgMonthAbbreviations = map[string]int{
+	"JAN": 1,
+	"FEB": 2,
+	"MAR": 3,
+	"APR": 4,
+	"MAY": 5,
+	"JUN": 6,
+	"JUL": 7,
+	"AUG": 8,
+	"SEP": 9,
+	"OCT": 10,
+	"NOV": 11,
+	"DEC": 12,
+}
+
+// militaryDtgMechanics - Provides helper methods used to parse
+// Military Date Time Groups (DTG).
+//
+// Reference:
+//    http://www.thefightschool.demon.co.uk/UNMC_Military_Time.htm
+//    http://blog.refactortactical.com/blog/military-date-time-group/
+//
+type militaryDtgMechanics struct {
+	lock *sync.Mutex
+}
+
+// parseDateTimeGroup - Parses a Military Date Time Group string
+// and returns the component date, time and Military Time Zone name.
+//
+// Both the 'Compact' format, "DDHHMMZMONYY", and the 'Open' format,
+// "DD HHMMZ MON YY", are supported. The year may be expressed with
+// two or four digits. Upper and lower case characters are accepted.
+//
+//  Examples:
+//    "061830RJAN12"      January 6, 2012 18:30 Romeo
+//    "06 1830R JAN 12"   January 6, 2012 18:30 Romeo
+//    "011815ZJAN2011"    January 1, 2011 18:15 Zulu
+//
+// Two digit years are mapped to the one year ending in those
+// two digits which falls within the 100-year window beginning with
+// 'centuryPivot'. For example, if 'centuryPivot' is 1950, two digit
+// years '50' through '99' are mapped to 1950 through 1999 and two
+// digit years '00' through '49' are mapped to 2000 through 2049.
+// 'centuryPivot' is ignored for four digit years.
+//
+func (milDtgMech *militaryDtgMechanics) parseDateTimeGroup(
+	dtg string,
+	centuryPivot int,
+	ePrefix string) (
+	year int,
+	month int,
+	day int,
+	hour int,
+	minute int,
+	milTzName string,
+	err error) {
+
+	if milDtgMech.lock == nil {
+		milDtgMech.lock = new(sync.Mutex)
+	}
+
+	milDtgMech.lock.Lock()
+
+	defer milDtgMech.lock.Unlock()
+
+	ePrefix += "militaryDtgMechanics.parseDateTimeGroup() "
+
+	str := strings.ToUpper(strings.Join(strings.Fields(dtg), ""))
+
+	if len(str) == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'dtg' is an empty string!\n")
+
+		return year, month, day, hour, minute, milTzName, err
+	}
+
+	invalidFormatErr := fmt.Errorf(ePrefix + "\n" +
+		"Error: Input parameter 'dtg' is INVALID!\n" +
+		"Expected format 'DDHHMMZMONYY' or 'DD HHMMZ MON YY'.\n" +
+		"dtg='%v'\n",
+		dtg)
+
+	if len(str) != 12 && len(str) != 14 {
+		return year, month, day, hour, minute, milTzName, invalidFormatErr
+	}
+
+	dayTimeStr := str[0:6]
+	milTzLetter := str[6:7]
+	monthStr := str[7:10]
+	yearStr := str[10:]
+
+	for i := 0; i < len(dayTimeStr); i++ {
+		if dayTimeStr[i] < '0' || dayTimeStr[i] > '9' {
+			return year, month, day, hour, minute, milTzName, invalidFormatErr
+		}
+	}
+
+	for i := 0; i < len(yearStr); i++ {
+		if yearStr[i] < '0' || yearStr[i] > '9' {
+			return year, month, day, hour, minute, milTzName, invalidFormatErr
+		}
+	}
+
+	day, _ = strconv.Atoi(dayTimeStr[0:2])
+	hour, _ = strconv.Atoi(dayTimeStr[2:4])
+	minute, _ = strconv.Atoi(dayTimeStr[4:6])
+	year, _ = strconv.Atoi(yearStr)
+
+	var ok bool
+
+	month, ok = militaryDtgMonthAbbreviations[monthStr]
+
+	if !ok {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The month abbreviation in 'dtg' is INVALID!\n" +
+			"Month Abbreviation='%v'\n" +
+			"dtg='%v'\n",
+			monthStr,
+			dtg)
+
+		return year, month, day, hour, minute, milTzName, err
+	}
+
+	milTzData := MilitaryTimeZoneData{}
+
+	milTzName, ok = milTzData.MilTzLetterToTextName(milTzLetter)
+
+	if !ok {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The Military Time Zone letter in 'dtg' is INVALID!\n" +
+			"Military Time Zone Letter='%v'\n" +
+			"dtg='%v'\n",
+			milTzLetter,
+			dtg)
+
+		return year, month, day, hour, minute, milTzName, err
+	}
+
+	if _, ok = milTzData.MilitaryTzToLocation(milTzName); !ok {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Military Time Zone '%v' has no associated location!\n" +
+			"dtg='%v'\n",
+			milTzName,
+			dtg)
+
+		return year, month, day, hour, minute, milTzName, err
+	}
+
+	if len(yearStr) == 2 {
+
+		pivotYear := centuryPivot % 100
+
+		if pivotYear < 0 {
+			pivotYear += 100
+		}
+
+		year += centuryPivot - pivotYear
+
+		if year < centuryPivot {
+			year += 100
+		}
+	}
+
+	if hour > 23 ||
+		minute > 59 {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The time of day in 'dtg' is INVALID!\n" +
+			"hour='%v' minute='%v'\n" +
+			"dtg='%v'\n",
+			hour,
+			minute,
+			dtg)
+
+		return year, month, day, hour, minute, milTzName, err
+	}
+
+	if day < 1 ||
+		time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC).Day() != day {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The day of the month in 'dtg' is INVALID!\n" +
+			"year='%v' month='%v' day='%v'\n" +
+			"dtg='%v'\n",
+			year,
+			month,
+			day,
+			dtg)
+
+		return year, month, day, hour, minute, milTzName, err
+	}
+
+	return year, month, day, hour, minute, milTzName, err
+}
@@ -0,0 +1,599 @@
+package datetime
+
+import (
+	"sync"
+	"time"
+)
+
+// Period - Holds an ISO 8601 style period composed of calendar
+// components (years, months and days) and clock components (hours,
+// minutes, seconds and nanoseconds).
+//
+// Unlike a time.Duration, the calendar components of a Period do not
+// represent a fixed amount of elapsed time. One month added to
+// January 15th spans 31 days while one month added to February 15th
+// spans 28 or 29 days. Likewise, one day added to a local date time
+// on a Daylight Saving Time transition day may span 23 or 25 hours.
+//
+// When a Period is added to a date time, the calendar components are
+// applied to the local date and the clock components are applied as
+// elapsed time. Reference method Period.AddToDateTz().
+//
+// Each component may be positive, negative or zero. The zero value of
+// Period is a valid, empty period.
+//
+// For more information on ISO 8601 durations, reference:
+//    https://en.wikipedia.org/wiki/ISO_8601#Durations
+//
+type Period struct {
+	years       int // Calendar years
+	months      int // Calendar months
+	days        int // Calendar days
+	hours       int // Elapsed hours
+	minutes     int // Elapsed minutes
+	seconds     int // Elapsed seconds
+	nanoseconds int // Elapsed nanoseconds
+	lock        *sync.Mutex
+}
+
+// AddToDateTz - Adds the current Period to 'dTz' and returns the
+// result as a new DateTzDto in the time zone of 'dTz'.
+//
+// Years and months are added to the local date of 'dTz'. If the
+// resulting month is shorter than the local day number, the day is
+// resolved according to input parameter 'eomMode'. Days are then
+// added to the local date, preserving the local time of day. Local
+// date times which fall in a Daylight Saving Time gap are shifted
+// forward by the length of the gap. Ambiguous local date times resolve
+// to the earlier instant. Finally, hours, minutes, seconds and
+// nanoseconds are added as elapsed time.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dTz                 DateTzDto
+//     - The date time to which the current Period will be added.
+//
+//
+//  eomMode             EndOfMonthModeType
+//     - Specifies how a local day number which does not exist in the
+//       target month is resolved.
+//
+//        EndOfMonthMode.Clamp()
+//          2021-01-31 + P1M = 2021-02-28
+//
+//        EndOfMonthMode.Overflow()
+//          2021-01-31 + P1M = 2021-03-03
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  DateTzDto
+//     - If successful, this method returns a new DateTzDto instance
+//       equal to 'dTz' plus the current Period. The date time format
+//       of 'dTz' is retained.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered this error Type will encapsulate an
+//       error message. Note this error message will incorporate the
+//       method chain and text passed by input parameter, 'ePrefix'.
+//
+func (period *Period) AddToDateTz(
+	dTz DateTzDto,
+	eomMode EndOfMonthModeType,
+	ePrefix string) (
+	DateTzDto,
+	error) {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	ePrefix += "Period.AddToDateTz() "
+
+	if !eomMode.XIsValid() ||
+		eomMode == EndOfMonthMode.None() {
+		return DateTzDto{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "eomMode",
+				inputParameterValue: eomMode.String(),
+				errMsg:              "'eomMode' is INVALID!",
+				err:                 nil,
+			}
+	}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&dTz, ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	periodMech := periodMechanics{}
+
+	result, err := periodMech.addToDateTime(
+		dTz.dateTimeValue,
+		period,
+		eomMode,
+		ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, err
+	}
+
+	newDTz := DateTzDto{}
+
+	err = dTzUtil.setFromDateTime(
+		&newDTz,
+		result,
+		dTz.dateTimeFmt,
+		ePrefix)
+
+	return newDTz, err
+}
+
+// CopyOut - Returns a deep copy of the current Period instance.
+//
+func (period *Period) CopyOut() Period {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return Period{
+		years:       period.years,
+		months:      period.months,
+		days:        period.days,
+		hours:       period.hours,
+		minutes:     period.minutes,
+		seconds:     period.seconds,
+		nanoseconds: period.nanoseconds,
+		lock:        new(sync.Mutex),
+	}
+}
+
+// Equal - Returns 'true' if every component of the current Period is
+// equal to the corresponding component of 'period2'.
+//
+// Note that periods are compared component by component. "P1Y" and
+// "P12M" are NOT equal.
+//
+func (period *Period) Equal(period2 Period) bool {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.years == period2.years &&
+		period.months == period2.months &&
+		period.days == period2.days &&
+		period.hours == period2.hours &&
+		period.minutes == period2.minutes &&
+		period.seconds == period2.seconds &&
+		period.nanoseconds == period2.nanoseconds
+}
+
+// GetClockDuration - Returns the hours, minutes, seconds and
+// nanoseconds of the current Period as a time.Duration. The calendar
+// components (years, months and days) are NOT included.
+//
+func (period *Period) GetClockDuration() time.Duration {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	periodMech := periodMechanics{}
+
+	return periodMech.getClockDuration(period)
+}
+
+// GetDays - Returns the days component of the current Period.
+//
+func (period *Period) GetDays() int {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.days
+}
+
+// GetHours - Returns the hours component of the current Period.
+//
+func (period *Period) GetHours() int {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.hours
+}
+
+// GetMinutes - Returns the minutes component of the current Period.
+//
+func (period *Period) GetMinutes() int {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.minutes
+}
+
+// GetMonths - Returns the months component of the current Period.
+//
+func (period *Period) GetMonths() int {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.months
+}
+
+// GetNanoseconds - Returns the nanoseconds component of the current
+// Period.
+//
+func (period *Period) GetNanoseconds() int {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.nanoseconds
+}
+
+// GetSeconds - Returns the seconds component of the current Period.
+//
+func (period *Period) GetSeconds() int {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.seconds
+}
+
+// GetYears - Returns the years component of the current Period.
+//
+func (period *Period) GetYears() int {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.years
+}
+
+// IsZero - Returns 'true' if every component of the current Period
+// is zero.
+//
+func (period *Period) IsZero() bool {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	return period.years == 0 &&
+		period.months == 0 &&
+		period.days == 0 &&
+		period.hours == 0 &&
+		period.minutes == 0 &&
+		period.seconds == 0 &&
+		period.nanoseconds == 0
+}
+
+// Negate - Returns a new Period in which every component of the
+// current Period has been multiplied by minus one (-1).
+//
+func (period *Period) Negate() Period {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	periodMech := periodMechanics{}
+
+	newPeriod := periodMech.negate(period)
+
+	newPeriod.lock = new(sync.Mutex)
+
+	return newPeriod
+}
+
+// New - Creates and returns a new Period from its component values.
+// Components are stored as submitted. They are NOT normalized. For
+// example, 14 months remain 14 months and are not converted to one
+// year and two months.
+//
+// Usage
+//
+//  period := Period{}.New(0, 1, 15, 0, 0, 0, 0)
+//
+//  period.String() = "P1M15D"
+//
+func (period Period) New(
+	years,
+	months,
+	days,
+	hours,
+	minutes,
+	seconds,
+	nanoseconds int) Period {
+
+	return Period{
+		years:       years,
+		months:      months,
+		days:        days,
+		hours:       hours,
+		minutes:     minutes,
+		seconds:     seconds,
+		nanoseconds: nanoseconds,
+		lock:        new(sync.Mutex),
+	}
+}
+
+// NewFromString - Creates and returns a new Period by parsing an
+// ISO 8601 duration string.
+//
+// The string takes the form "PnYnMnDTnHnMnS" or "PnW". Components
+// with a zero value may be omitted, but at least one component must
+// be present. The seconds component may include up to nine
+// fractional digits. Weeks are converted to days.
+//
+// A leading minus sign negates every component. Individual components
+// may also carry a minus sign.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  isoPeriodStr        string
+//     - An ISO 8601 duration string. Examples:
+//         "P1Y2M3DT4H5M6.5S"
+//         "P2W"
+//         "-P1M"
+//         "PT36H"
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  Period
+//     - If successful, this method returns a new Period populated
+//       with the components of 'isoPeriodStr'.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If 'isoPeriodStr' is invalid, the returned error Type will
+//       encapsulate an error message. Note this error message will
+//       incorporate the method chain and text passed by input
+//       parameter, 'ePrefix'.
+//
+func (period Period) NewFromString(
+	isoPeriodStr string,
+	ePrefix string) (
+	Period,
+	error) {
+
+	ePrefix += "Period.NewFromString() "
+
+	periodMech := periodMechanics{}
+
+	newPeriod := Period{}
+
+	err := periodMech.parseIsoString(&newPeriod, isoPeriodStr, ePrefix)
+
+	if err != nil {
+		return Period{}, err
+	}
+
+	newPeriod.lock = new(sync.Mutex)
+
+	return newPeriod, nil
+}
+
+// PeriodBetween - Computes the exact calendar difference between
+// 'startDateTz' and 'endDateTz' in the local time of 'startDateTz'.
+//
+// The returned Period contains the largest whole number of years,
+// months and then days which, when added to 'startDateTz' with end
+// of month clamping, do not pass 'endDateTz'. The remaining elapsed
+// time is expressed as hours, minutes, seconds and nanoseconds.
+// Month lengths are drawn from the Gregorian Calendar Base Data.
+//
+// Because days are measured in local time, the period between noon
+// on the day before a Daylight Saving Time transition and noon on
+// the following day is "P1D", even though 23 or 25 hours elapse.
+//
+// For periods in which 'endDateTz' follows 'startDateTz', adding the
+// returned Period to 'startDateTz' with EndOfMonthMode.Clamp() yields
+// 'endDateTz'.
+//
+// If 'endDateTz' occurs before 'startDateTz', the period from
+// 'endDateTz' to 'startDateTz' is computed and negated. All
+// components of the returned Period will then be zero or negative.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  startDateTz         DateTzDto
+//     - The starting date time. Local dates are computed in the time
+//       zone of 'startDateTz'.
+//
+//
+//  endDateTz           DateTzDto
+//     - The ending date time. 'endDateTz' is converted to the time
+//       zone of 'startDateTz' before local dates are compared.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  Period
+//     - If successful, this method returns the Period from
+//       'startDateTz' to 'endDateTz'.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered this error Type will encapsulate an
+//       error message. Note this error message will incorporate the
+//       method chain and text passed by input parameter, 'ePrefix'.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  period, err := Period{}.PeriodBetween(
+//                   startDateTz, // 2021-01-31 09:00:00 CST
+//                   endDateTz,   // 2021-03-30 10:30:00 CDT
+//                   ePrefix)
+//
+//  period.String() = "P1M30DT1H30M"
+//
+func (period Period) PeriodBetween(
+	startDateTz DateTzDto,
+	endDateTz DateTzDto,
+	ePrefix string) (
+	Period,
+	error) {
+
+	ePrefix += "Period.PeriodBetween() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&startDateTz, ePrefix+"startDateTz ")
+
+	if err != nil {
+		return Period{}, err
+	}
+
+	err = dTzUtil.isValidDateTzDto(&endDateTz, ePrefix+"endDateTz ")
+
+	if err != nil {
+		return Period{}, err
+	}
+
+	periodMech := periodMechanics{}
+
+	newPeriod, err := periodMech.periodBetween(
+		startDateTz.dateTimeValue,
+		endDateTz.dateTimeValue,
+		ePrefix)
+
+	if err != nil {
+		return Period{}, err
+	}
+
+	newPeriod.lock = new(sync.Mutex)
+
+	return newPeriod, nil
+}
+
+// String - Returns the current Period formatted as an ISO 8601
+// duration string. Negative components carry a leading minus sign.
+// Seconds and nanoseconds are combined into decimal seconds. A zero
+// Period is formatted as "PT0S".
+//
+// Examples:
+//   "P1Y2M3DT4H5M6.5S"
+//   "P-1M"
+//   "PT0S"
+//
+func (period *Period) String() string {
+
+	if period.lock == nil {
+		period.lock = new(sync.Mutex)
+	}
+
+	period.lock.Lock()
+
+	defer period.lock.Unlock()
+
+	periodMech := periodMechanics{}
+
+	return periodMech.toIsoString(period)
+}
@@ -0,0 +1,698 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// periodMechanics - Provides helper methods for type Period.
+//
+type periodMechanics struct {
+	lock *sync.Mutex
+}
+
+// addToDateTime - Adds the calendar and clock components of 'period'
+// to 'dateTime'.
+//
+// Years and months are added to the local date first. If the local
+// day number does not exist in the resulting month, it is resolved
+// according to 'eomMode'. Days are then added to the local date.
+// The resulting local date time is converted to an instant in the
+// time zone of 'dateTime'. Local date times which fall in a Daylight
+// Saving Time gap are shifted forward by the length of the gap.
+// Ambiguous local date times resolve to the earlier instant.
+//
+// Finally, the clock components of 'period' (hours, minutes, seconds
+// and nanoseconds) are added as elapsed time.
+//
+func (periodMech *periodMechanics) addToDateTime(
+	dateTime time.Time,
+	period *Period,
+	eomMode EndOfMonthModeType,
+	ePrefix string) (
+	time.Time,
+	error) {
+
+	ePrefix += "periodMechanics.addToDateTime() "
+
+	result, err := periodMech.addCalendarComponents(
+		dateTime,
+		period.years,
+		period.months,
+		period.days,
+		eomMode,
+		ePrefix)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return result.Add(periodMech.getClockDuration(period)), nil
+}
+
+// addCalendarComponents - Adds 'years', 'months' and 'days' to the
+// local date of 'dateTime' and returns the resulting instant in the
+// time zone of 'dateTime'. The local time of day is preserved where
+// it exists.
+//
+func (periodMech *periodMechanics) addCalendarComponents(
+	dateTime time.Time,
+	years,
+	months,
+	days int,
+	eomMode EndOfMonthModeType,
+	ePrefix string) (
+	time.Time,
+	error) {
+
+	ePrefix += "periodMechanics.addCalendarComponents() "
+
+	year, month, day := dateTime.Date()
+
+	totalMonths := int64(year)*12 + int64(month) - 1 +
+		int64(years)*12 + int64(months)
+
+	newYear := totalMonths / 12
+
+	if totalMonths%12 < 0 {
+		newYear--
+	}
+
+	newMonth := int(totalMonths-newYear*12) + 1
+
+	daysInMonth, err := periodMech.getDaysInMonth(newYear, newMonth, ePrefix)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if day > daysInMonth &&
+		eomMode == EndOfMonthMode.Clamp() {
+		day = daysInMonth
+	}
+
+	// time.Date() normalizes days in excess of the month length.
+	naiveLocal := time.Date(
+		int(newYear),
+		time.Month(newMonth),
+		day+days,
+		dateTime.Hour(),
+		dateTime.Minute(),
+		dateTime.Second(),
+		dateTime.Nanosecond(),
+		time.UTC)
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	earlier, later, _, isNonexistent :=
+		tzDstMech.classifyLocalDateTime(naiveLocal, dateTime.Location())
+
+	if isNonexistent {
+		return later, nil
+	}
+
+	return earlier, nil
+}
+
+// getClockDuration - Returns the hours, minutes, seconds and
+// nanoseconds of 'period' as a time.Duration.
+//
+func (periodMech *periodMechanics) getClockDuration(
+	period *Period) time.Duration {
+
+	return time.Duration(period.hours)*time.Hour +
+		time.Duration(period.minutes)*time.Minute +
+		time.Duration(period.seconds)*time.Second +
+		time.Duration(period.nanoseconds)
+}
+
+// getDaysInCivilSpan - Returns the number of days from the local
+// date 'startYear'-'startMonth'-'startDay' to the later local date
+// 'endYear'-'endMonth'-'endDay'. Month lengths are taken from the
+// Gregorian Calendar Base Data.
+//
+func (periodMech *periodMechanics) getDaysInCivilSpan(
+	startYear int64,
+	startMonth int,
+	startDay int,
+	endYear int64,
+	endMonth int,
+	endDay int,
+	ePrefix string) (
+	int,
+	error) {
+
+	ePrefix += "periodMechanics.getDaysInCivilSpan() "
+
+	days := 0
+
+	year := startYear
+	month := startMonth
+	day := startDay
+
+	for year < endYear ||
+		(year == endYear && month < endMonth) {
+
+		daysInMonth, err := periodMech.getDaysInMonth(year, month, ePrefix)
+
+		if err != nil {
+			return 0, err
+		}
+
+		days += daysInMonth - day + 1
+
+		day = 1
+
+		month++
+
+		if month > 12 {
+			month = 1
+			year++
+		}
+	}
+
+	days += endDay - day
+
+	return days, nil
+}
+
+// getDaysInMonth - Returns the number of days in month 'month' of
+// astronomical year 'year' on the Gregorian Calendar.
+//
+func (periodMech *periodMechanics) getDaysInMonth(
+	year int64,
+	month int,
+	ePrefix string) (
+	int,
+	error) {
+
+	ePrefix += "periodMechanics.getDaysInMonth() "
+
+	gregCalBData := CalendarGregorianBaseData{}
+
+	isLeapYear, err := gregCalBData.IsLeapYear(
+		year,
+		CalYearType.Astronomical(),
+		ePrefix)
+
+	if err != nil {
+		return 0, err
+	}
+
+	var monthDays map[int]int
+
+	if isLeapYear {
+		monthDays = gregCalBData.GetLeapYearMonthDays()
+	} else {
+		monthDays = gregCalBData.GetStandardYearMonthDays()
+	}
+
+	daysInMonth, ok := monthDays[month]
+
+	if !ok {
+		return 0, fmt.Errorf(ePrefix+"\n"+
+			"Error: Input parameter 'month' is INVALID!\n"+
+			"month='%v'\n", month)
+	}
+
+	return daysInMonth, nil
+}
+
+// parseIsoString - Parses an ISO 8601 duration string such as
+// "P1Y2M3DT4H5M6.5S" or "P2W" and populates 'period'.
+//
+// A leading minus sign negates every component. Individual
+// components may also carry a sign, as in "P1Y-2M". The seconds
+// component may include up to nine fractional digits. Weeks are
+// converted to days.
+//
+func (periodMech *periodMechanics) parseIsoString(
+	period *Period,
+	isoPeriodStr string,
+	ePrefix string) error {
+
+	ePrefix += "periodMechanics.parseIsoString() "
+
+	str := strings.ToUpper(strings.TrimSpace(isoPeriodStr))
+
+	negateAll := false
+
+	if strings.HasPrefix(str, "-") {
+		negateAll = true
+		str = str[1:]
+	} else if strings.HasPrefix(str, "+") {
+		str = str[1:]
+	}
+
+	if !strings.HasPrefix(str, "P") ||
+		len(str) < 3 {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "isoPeriodStr",
+			inputParameterValue: isoPeriodStr,
+			errMsg:              "'isoPeriodStr' is not a valid ISO 8601 duration string.",
+			err:                 nil,
+		}
+	}
+
+	str = str[1:]
+
+	newPeriod := Period{}
+
+	isTimeSection := false
+	componentCount := 0
+	lastDesignatorIdx := -1
+
+	datePartDesignators := "YMWD"
+	timePartDesignators := "HMS"
+
+	for len(str) > 0 {
+
+		if str[0] == 'T' {
+
+			if isTimeSection ||
+				len(str) == 1 {
+				return &InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "isoPeriodStr",
+					inputParameterValue: isoPeriodStr,
+					errMsg:              "'isoPeriodStr' contains an invalid time designator 'T'.",
+					err:                 nil,
+				}
+			}
+
+			isTimeSection = true
+			lastDesignatorIdx = -1
+			str = str[1:]
+
+			continue
+		}
+
+		numEnd := 0
+
+		for numEnd < len(str) &&
+			(str[numEnd] == '-' ||
+				str[numEnd] == '+' ||
+				str[numEnd] == '.' ||
+				str[numEnd] == ',' ||
+				(str[numEnd] >= '0' && str[numEnd] <= '9')) {
+			numEnd++
+		}
+
+		if numEnd == 0 ||
+			numEnd == len(str) {
+			return &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "isoPeriodStr",
+				inputParameterValue: isoPeriodStr,
+				errMsg:              "'isoPeriodStr' contains a component without a value or designator.",
+				err:                 nil,
+			}
+		}
+
+		numStr := strings.Replace(str[:numEnd], ",", ".", 1)
+		designator := str[numEnd]
+		str = str[numEnd+1:]
+
+		designators := datePartDesignators
+
+		if isTimeSection {
+			designators = timePartDesignators
+		}
+
+		designatorIdx := strings.IndexByte(designators, designator)
+
+		if designatorIdx <= lastDesignatorIdx {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: Input parameter 'isoPeriodStr' contains a misplaced or "+
+				"unknown designator.\n"+
+				"isoPeriodStr='%v'\n"+
+				"designator='%v'\n", isoPeriodStr, string(designator))
+		}
+
+		lastDesignatorIdx = designatorIdx
+
+		if isTimeSection && designator == 'S' {
+
+			seconds, nanoseconds, err := periodMech.parseSeconds(numStr, ePrefix)
+
+			if err != nil {
+				return fmt.Errorf(ePrefix+"\n"+
+					"Error: Input parameter 'isoPeriodStr' contains an invalid "+
+					"seconds component.\n"+
+					"isoPeriodStr='%v'\n"+
+					"Error='%v'\n", isoPeriodStr, err.Error())
+			}
+
+			newPeriod.seconds = seconds
+			newPeriod.nanoseconds = nanoseconds
+			componentCount++
+
+			continue
+		}
+
+		value, err := strconv.Atoi(numStr)
+
+		if err != nil {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: Input parameter 'isoPeriodStr' contains an invalid "+
+				"integer component.\n"+
+				"isoPeriodStr='%v'\n"+
+				"component='%v'\n", isoPeriodStr, numStr+string(designator))
+		}
+
+		componentCount++
+
+		if isTimeSection {
+
+			switch designator {
+			case 'H':
+				newPeriod.hours = value
+			case 'M':
+				newPeriod.minutes = value
+			}
+
+			continue
+		}
+
+		switch designator {
+		case 'Y':
+			newPeriod.years = value
+		case 'M':
+			newPeriod.months = value
+		case 'W':
+			newPeriod.days += value * 7
+		case 'D':
+			newPeriod.days += value
+		}
+	}
+
+	if componentCount == 0 {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "isoPeriodStr",
+			inputParameterValue: isoPeriodStr,
+			errMsg:              "'isoPeriodStr' does not contain any components.",
+			err:                 nil,
+		}
+	}
+
+	if negateAll {
+		newPeriod = periodMech.negate(&newPeriod)
+	}
+
+	period.years = newPeriod.years
+	period.months = newPeriod.months
+	period.days = newPeriod.days
+	period.hours = newPeriod.hours
+	period.minutes = newPeriod.minutes
+	period.seconds = newPeriod.seconds
+	period.nanoseconds = newPeriod.nanoseconds
+
+	return nil
+}
+
+// parseSeconds - Parses a seconds value with an optional fraction of
+// up to nine digits. The sign of the seconds value is applied to the
+// returned nanoseconds.
+//
+func (periodMech *periodMechanics) parseSeconds(
+	numStr string,
+	ePrefix string) (
+	seconds int,
+	nanoseconds int,
+	err error) {
+
+	ePrefix += "periodMechanics.parseSeconds() "
+
+	isNegative := strings.HasPrefix(numStr, "-")
+
+	wholeStr := numStr
+	fracStr := ""
+
+	if dotIdx := strings.IndexByte(numStr, '.'); dotIdx > -1 {
+		wholeStr = numStr[:dotIdx]
+		fracStr = numStr[dotIdx+1:]
+	}
+
+	if wholeStr == "" ||
+		wholeStr == "-" ||
+		wholeStr == "+" {
+		wholeStr += "0"
+	}
+
+	seconds, err = strconv.Atoi(wholeStr)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(fracStr) > 9 {
+		return 0, 0, errors.New(ePrefix + "\n" +
+			"Error: The seconds fraction exceeds nine digits.\n")
+	}
+
+	if fracStr == "" {
+		return seconds, 0, nil
+	}
+
+	for i := 0; i < len(fracStr); i++ {
+		if fracStr[i] < '0' || fracStr[i] > '9' {
+			return 0, 0, fmt.Errorf(ePrefix+"\n"+
+				"Error: The seconds fraction contains an invalid character.\n"+
+				"fraction='%v'\n", fracStr)
+		}
+	}
+
+	nanoseconds, _ = strconv.Atoi(fracStr + strings.Repeat("0", 9-len(fracStr)))
+
+	if isNegative {
+		nanoseconds = -nanoseconds
+	}
+
+	return seconds, nanoseconds, nil
+}
+
+// negate - Returns a new Period in which each component of 'period'
+// has been multiplied by minus one.
+//
+func (periodMech *periodMechanics) negate(
+	period *Period) Period {
+
+	return Period{
+		years:       -period.years,
+		months:      -period.months,
+		days:        -period.days,
+		hours:       -period.hours,
+		minutes:     -period.minutes,
+		seconds:     -period.seconds,
+		nanoseconds: -period.nanoseconds,
+	}
+}
+
+// periodBetween - Computes the period from 'startDateTime' to
+// 'endDateTime' in the local time of 'startDateTime'.
+//
+// The calendar components are the largest whole number of months
+// and then days which, when added to 'startDateTime' with end of
+// month clamping, do not pass 'endDateTime'. The remaining elapsed
+// time is returned as hours, minutes, seconds and nanoseconds.
+//
+// If 'endDateTime' occurs before 'startDateTime', the period is
+// computed from 'endDateTime' to 'startDateTime' and negated.
+//
+func (periodMech *periodMechanics) periodBetween(
+	startDateTime time.Time,
+	endDateTime time.Time,
+	ePrefix string) (
+	Period,
+	error) {
+
+	ePrefix += "periodMechanics.periodBetween() "
+
+	endDateTime = endDateTime.In(startDateTime.Location())
+
+	if endDateTime.Before(startDateTime) {
+
+		period, err := periodMech.periodBetween(endDateTime, startDateTime, ePrefix)
+
+		if err != nil {
+			return Period{}, err
+		}
+
+		return periodMech.negate(&period), nil
+	}
+
+	startYear, startMonth, _ := startDateTime.Date()
+	endYear, endMonth, _ := endDateTime.Date()
+
+	// The month count of the local dates is an upper bound.
+	months := (endYear*12 + int(endMonth)) - (startYear*12 + int(startMonth))
+
+	var candidate time.Time
+	var err error
+
+	for months > 0 {
+
+		candidate, err = periodMech.addCalendarComponents(
+			startDateTime, 0, months, 0, EndOfMonthMode.Clamp(), ePrefix)
+
+		if err != nil {
+			return Period{}, err
+		}
+
+		if !candidate.After(endDateTime) {
+			break
+		}
+
+		months--
+	}
+
+	base, err := periodMech.addCalendarComponents(
+		startDateTime, 0, months, 0, EndOfMonthMode.Clamp(), ePrefix)
+
+	if err != nil {
+		return Period{}, err
+	}
+
+	baseYear, baseMonth, baseDay := base.Date()
+	endDay := endDateTime.Day()
+
+	// The day count of the local dates is an upper bound.
+	days, err := periodMech.getDaysInCivilSpan(
+		int64(baseYear),
+		int(baseMonth),
+		baseDay,
+		int64(endYear),
+		int(endMonth),
+		endDay,
+		ePrefix)
+
+	if err != nil {
+		return Period{}, err
+	}
+
+	for days > 0 {
+
+		candidate, err = periodMech.addCalendarComponents(
+			startDateTime, 0, months, days, EndOfMonthMode.Clamp(), ePrefix)
+
+		if err != nil {
+			return Period{}, err
+		}
+
+		if !candidate.After(endDateTime) {
+			break
+		}
+
+		days--
+	}
+
+	if days < 0 {
+		days = 0
+	}
+
+	base, err = periodMech.addCalendarComponents(
+		startDateTime, 0, months, days, EndOfMonthMode.Clamp(), ePrefix)
+
+	if err != nil {
+		return Period{}, err
+	}
+
+	remainder := endDateTime.Sub(base)
+
+	period := Period{
+		years:  months / 12,
+		months: months % 12,
+		days:   days,
+	}
+
+	period.hours = int(remainder / time.Hour)
+	remainder -= time.Duration(period.hours) * time.Hour
+
+	period.minutes = int(remainder / time.Minute)
+	remainder -= time.Duration(period.minutes) * time.Minute
+
+	period.seconds = int(remainder / time.Second)
+	remainder -= time.Duration(period.seconds) * time.Second
+
+	period.nanoseconds = int(remainder)
+
+	return period, nil
+}
+
+// toIsoString - Formats 'period' as an ISO 8601 duration string.
+// Negative components carry a leading minus sign, as in "P-1M".
+// Seconds and nanoseconds are combined into a single decimal seconds
+// component. A zero period is formatted as "PT0S".
+//
+func (periodMech *periodMechanics) toIsoString(
+	period *Period) string {
+
+	var b strings.Builder
+
+	b.WriteString("P")
+
+	if period.years != 0 {
+		b.WriteString(fmt.Sprintf("%dY", period.years))
+	}
+
+	if period.months != 0 {
+		b.WriteString(fmt.Sprintf("%dM", period.months))
+	}
+
+	if period.days != 0 {
+		b.WriteString(fmt.Sprintf("%dD", period.days))
+	}
+
+	totalNanos := int64(period.seconds)*int64(time.Second) +
+		int64(period.nanoseconds)
+
+	if period.hours == 0 &&
+		period.minutes == 0 &&
+		totalNanos == 0 {
+
+		if b.Len() == 1 {
+			return "PT0S"
+		}
+
+		return b.String()
+	}
+
+	b.WriteString("T")
+
+	if period.hours != 0 {
+		b.WriteString(fmt.Sprintf("%dH", period.hours))
+	}
+
+	if period.minutes != 0 {
+		b.WriteString(fmt.Sprintf("%dM", period.minutes))
+	}
+
+	if totalNanos != 0 {
+
+		sign := ""
+
+		if totalNanos < 0 {
+			sign = "-"
+			totalNanos = -totalNanos
+		}
+
+		b.WriteString(fmt.Sprintf("%v%d", sign, totalNanos/int64(time.Second)))
+
+		fraction := totalNanos % int64(time.Second)
+
+		if fraction != 0 {
+			b.WriteString(
+				strings.TrimRight(fmt.Sprintf(".%09d", fraction), "0"))
+		}
+
+		b.WriteString("S")
+	}
+
+	return b.String()
+}
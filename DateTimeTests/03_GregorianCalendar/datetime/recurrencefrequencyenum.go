@@ -0,0 +1,406 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mRecurrenceFrequencyStringToCode = map[string]RecurrenceFrequency{
+	"None"      : RecurrenceFrequency(0),
+	"Secondly"  : RecurrenceFrequency(1),
+	"Minutely"  : RecurrenceFrequency(2),
+	"Hourly"    : RecurrenceFrequency(3),
+	"Daily"     : RecurrenceFrequency(4),
+	"Weekly"    : RecurrenceFrequency(5),
+	"Monthly"   : RecurrenceFrequency(6),
+	"Yearly"    : RecurrenceFrequency(7),
+}
+
+var mRecurrenceFrequencyLwrCaseStringToCode = map[string]RecurrenceFrequency{
+	"none"      : RecurrenceFrequency(0),
+	"secondly"  : RecurrenceFrequency(1),
+	"minutely"  : RecurrenceFrequency(2),
+	"hourly"    : RecurrenceFrequency(3),
+	"daily"     : RecurrenceFrequency(4),
+	"weekly"    : RecurrenceFrequency(5),
+	"monthly"   : RecurrenceFrequency(6),
+	"yearly"    : RecurrenceFrequency(7),
+}
+
+var mRecurrenceFrequencyCodeToString = map[RecurrenceFrequency]string{
+	RecurrenceFrequency(0) : "None",
+	RecurrenceFrequency(1) : "Secondly",
+	RecurrenceFrequency(2) : "Minutely",
+	RecurrenceFrequency(3) : "Hourly",
+	RecurrenceFrequency(4) : "Daily",
+	RecurrenceFrequency(5) : "Weekly",
+	RecurrenceFrequency(6) : "Monthly",
+	RecurrenceFrequency(7) : "Yearly",
+}
+
+// RecurrenceFrequency - An enumeration of the frequencies at which
+// a recurrence rule repeats. These values correspond to the 'FREQ'
+// rule part of an iCalendar recurrence rule as defined by RFC 5545.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.3.10
+//
+// Since Go does not directly support enumerations, the 'RecurrenceFrequency'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'RecurrenceFrequency' is declared as a type 'int'. The
+// method names effectively represent an enumeration of recurrence
+// frequencies. These methods are listed as follows:
+//
+//
+// None         (0) - Signals that the Recurrence Frequency is not
+//                    initialized. This is an error condition.
+//
+// Secondly     (1) - The rule repeats at intervals of one or more
+//                    seconds. RFC 5545 'SECONDLY'.
+//
+// Minutely     (2) - The rule repeats at intervals of one or more
+//                    minutes. RFC 5545 'MINUTELY'.
+//
+// Hourly       (3) - The rule repeats at intervals of one or more
+//                    hours. RFC 5545 'HOURLY'.
+//
+// Daily        (4) - The rule repeats at intervals of one or more
+//                    days. RFC 5545 'DAILY'.
+//
+// Weekly       (5) - The rule repeats at intervals of one or more
+//                    weeks. RFC 5545 'WEEKLY'.
+//
+// Monthly      (6) - The rule repeats at intervals of one or more
+//                    months. RFC 5545 'MONTHLY'.
+//
+// Yearly       (7) - The rule repeats at intervals of one or more
+//                    years. RFC 5545 'YEARLY'.
+//
+//
+// Enumeration values are ordered from the shortest to the longest
+// repetition period.
+//
+// For easy access to these enumeration values, use the global variable
+// 'RecurFreq'. Example: RecurFreq.Monthly()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: RecurrenceFrequency(0).Monthly()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the RecurrenceFrequency methods in alphabetical order. Be advised that all
+// 'RecurrenceFrequency' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type RecurrenceFrequency int
+
+var lockRecurrenceFrequency sync.Mutex
+
+// None - Signals that the RecurrenceFrequency is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) None() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(0)
+}
+
+// Secondly - Signals that a recurrence rule repeats at intervals
+// of one or more seconds.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) Secondly() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(1)
+}
+
+// Minutely - Signals that a recurrence rule repeats at intervals
+// of one or more minutes.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) Minutely() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(2)
+}
+
+// Hourly - Signals that a recurrence rule repeats at intervals
+// of one or more hours.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) Hourly() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(3)
+}
+
+// Daily - Signals that a recurrence rule repeats at intervals
+// of one or more days.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) Daily() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(4)
+}
+
+// Weekly - Signals that a recurrence rule repeats at intervals
+// of one or more weeks.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) Weekly() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(5)
+}
+
+// Monthly - Signals that a recurrence rule repeats at intervals
+// of one or more months.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) Monthly() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(6)
+}
+
+// Yearly - Signals that a recurrence rule repeats at intervals
+// of one or more years.
+//
+// This method is part of the standard enumeration.
+//
+func (recurFreq RecurrenceFrequency) Yearly() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return RecurrenceFrequency(7)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'RecurrenceFrequency'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= RecurrenceFrequency(0).Monthly()
+// str := t.String()
+//     str is now equal to 'Monthly'
+//
+func (recurFreq RecurrenceFrequency) String() string {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	result, ok := mRecurrenceFrequencyCodeToString[recurFreq]
+
+	if !ok {
+		return "Error: Recurrence Frequency UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current RecurrenceFrequency value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  recurFreq := RecurrenceFrequency(0).Monthly()
+//
+//  isValid := recurFreq.XIsValid()
+//
+func (recurFreq RecurrenceFrequency) XIsValid() bool {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	if recurFreq > 7 ||
+		recurFreq < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of RecurrenceFrequency is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'monthly' will NOT
+//                        match the enumeration name, 'Monthly'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'MONTHLY'
+//                        will match match enumeration name 'Monthly'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// RecurrenceFrequency - Upon successful completion, this method will return
+//                       a new instance of RecurrenceFrequency set to the value
+//                       of the enumeration matched by the string search
+//                       performed on input parameter, 'valueString'.
+//
+// error               - If this method completes successfully, the returned error
+//                       Type is set equal to 'nil'. If an error condition is
+//                       encountered, this method will return an error type which
+//                       encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := RecurrenceFrequency(0).XParseString("MONTHLY", false)
+//
+//     t is now equal to RecurrenceFrequency(0).Monthly()
+//
+func (recurFreq RecurrenceFrequency) XParseString(
+	valueString string,
+	caseSensitive bool) (RecurrenceFrequency, error) {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	ePrefix := "RecurrenceFrequency.XParseString() "
+
+	if len(valueString) < 4 {
+		return RecurrenceFrequency(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var recurrenceFrequency RecurrenceFrequency
+
+	if caseSensitive {
+
+		recurrenceFrequency, ok = mRecurrenceFrequencyStringToCode[valueString]
+
+	} else {
+
+		recurrenceFrequency, ok =
+			mRecurrenceFrequencyLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return RecurrenceFrequency(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid RecurrenceFrequency Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return recurrenceFrequency, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// RecurrenceFrequency instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (recurFreq RecurrenceFrequency) XValue() RecurrenceFrequency {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return recurFreq
+}
+
+// XValueInt - This method returns the integer value of the current
+// RecurrenceFrequency instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (recurFreq RecurrenceFrequency) XValueInt() int {
+
+	lockRecurrenceFrequency.Lock()
+
+	defer lockRecurrenceFrequency.Unlock()
+
+	return int(recurFreq)
+}
+
+// RecurFreq - public global variable of
+// type RecurrenceFrequency.
+//
+// This variable serves as an easier, short hand
+// technique for accessing RecurrenceFrequency values.
+//
+// Usage:
+// RecurFreq.None(),
+// RecurFreq.Secondly(),
+// RecurFreq.Minutely(),
+// RecurFreq.Hourly(),
+// RecurFreq.Daily(),
+// RecurFreq.Weekly(),
+// RecurFreq.Monthly(),
+// RecurFreq.Yearly(),
+//
+var RecurFreq RecurrenceFrequency
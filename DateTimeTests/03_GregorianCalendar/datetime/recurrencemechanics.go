@@ -0,0 +1,1254 @@
+package datetime
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// recurrenceMechanics - Provides helper methods used to expand
+// recurrence rules and recurrence sets into occurrence date times.
+//
+// Recurrence rules are expanded on the date time components of the
+// recurrence set start date time. The Time Math Calculation Mode of
+// the recurrence set determines which date time components are used.
+// Reference type 'TimeMathCalcMode'.
+//
+//  TCalcMode.LocalTimeZone()
+//     - Rules are expanded on the local date time components of the
+//       time zone of the recurrence set. Occurrences retain their
+//       local time of day across Daylight Saving Time transitions.
+//       As required by RFC 5545, a local time of day which does not
+//       exist on a given date is shifted forward by the length of
+//       the gap and a local time of day which occurs twice resolves
+//       to the first of the two instants.
+//
+//  TCalcMode.UtcTimeZone()
+//     - Rules are expanded on the UTC date time components of the
+//       recurrence set start date time. Every day consists of
+//       24-consecutive hours. Occurrences are then converted to the
+//       time zone of the recurrence set and may therefore shift
+//       their local time of day across Daylight Saving Time
+//       transitions.
+//
+// Month lengths, leap years and day of the week numbers are taken
+// from the Gregorian Calendar base data.
+//
+type recurrenceMechanics struct {
+	lock *sync.Mutex
+}
+
+// recurrenceYearInfo - Contains the Gregorian Calendar base data for
+// a single year.
+//
+type recurrenceYearInfo struct {
+	daysInYear  int         // 365 or 366
+	monthDays   map[int]int // Number of days in each month, 1-12
+	monthStart  [14]int     // Ordinal day number of the first day of each month
+	jan1WeekDay int         // ISO 8601 day of the week number for January 1st
+}
+
+// recurrenceDay - Describes a single candidate date.
+//
+type recurrenceDay struct {
+	year    int
+	month   int
+	day     int
+	yearDay int // Ordinal day number within the year, 1-366
+	weekDay int // ISO 8601 day of the week number, 1-7
+}
+
+// recurrenceRuleExpansion - Contains the state used to expand a
+// single recurrence rule.
+//
+type recurrenceRuleExpansion struct {
+	frequency  RecurrenceFrequency
+	interval   int
+	weekStart  int
+	bySecond   []int
+	byMinute   []int
+	byHour     []int
+	byDay      []recurrenceWeekDay
+	byMonthDay []int
+	byYearDay  []int
+	byWeekNo   []int
+	byMonth    []int
+	bySetPos   []int
+	startNaive time.Time // Start date time components in the expansion frame
+	yearInfo   map[int]*recurrenceYearInfo
+	ePrefix    string
+}
+
+// recurrenceInstantKey - A comparable key identifying an instant.
+//
+type recurrenceInstantKey struct {
+	seconds     int64
+	nanoseconds int
+}
+
+// expandRule - Expands a recurrence rule beginning at 'dtStart' and
+// returns the resulting occurrence instants in ascending order.
+//
+// Only occurrences on or after 'windowStart' are returned if
+// 'hasWindowStart' is 'true'. Expansion stops at the first occurrence
+// after 'windowEnd' if 'hasWindowEnd' is 'true'. Occurrences preceding
+// 'windowStart' are still counted against the 'COUNT' rule part.
+//
+// The start date time always counts as the first occurrence against
+// the 'COUNT' rule part, whether or not it matches the rule. It is
+// not returned by this method. Reference RFC 5545, section 3.3.10.
+//
+// If 'maxOccurrences' is greater than zero, expansion stops once
+// 'maxOccurrences' occurrences have been returned.
+//
+// Return value 'isExhausted' is set to 'true' if the rule cannot
+// generate additional occurrences within the window.
+//
+func (recurMech *recurrenceMechanics) expandRule(
+	recurRule *RecurrenceRule,
+	dtStart time.Time,
+	calcMode TimeMathCalcMode,
+	windowStart time.Time,
+	hasWindowStart bool,
+	windowEnd time.Time,
+	hasWindowEnd bool,
+	maxOccurrences int,
+	ePrefix string) (
+	occurrences []time.Time,
+	isExhausted bool,
+	err error) {
+
+	ePrefix += "recurrenceMechanics.expandRule() "
+
+	locPtr := dtStart.Location()
+
+	frameDateTime := dtStart
+
+	if calcMode == TCalcMode.UtcTimeZone() {
+		frameDateTime = dtStart.UTC()
+	}
+
+	expansion := recurrenceRuleExpansion{
+		frequency: recurRule.frequency,
+		interval:  recurRule.interval,
+		weekStart: int(recurRule.weekStart),
+		bySecond:  recurRule.bySecond,
+		byMinute:  recurRule.byMinute,
+		byHour:    recurRule.byHour,
+		byDay:     recurRule.byDay,
+		byMonthDay: recurRule.byMonthDay,
+		byYearDay: recurRule.byYearDay,
+		byWeekNo:  recurRule.byWeekNo,
+		byMonth:   recurRule.byMonth,
+		bySetPos:  recurRule.bySetPos,
+		startNaive: time.Date(
+			frameDateTime.Year(),
+			frameDateTime.Month(),
+			frameDateTime.Day(),
+			frameDateTime.Hour(),
+			frameDateTime.Minute(),
+			frameDateTime.Second(),
+			frameDateTime.Nanosecond(),
+			time.UTC),
+		yearInfo: make(map[int]*recurrenceYearInfo),
+		ePrefix:  ePrefix,
+	}
+
+	if expansion.interval < 1 {
+		expansion.interval = 1
+	}
+
+	if !ISO8601DayOfWeekNo(expansion.weekStart).XIsValid() {
+		expansion.weekStart = 1
+	}
+
+	err = expansion.setDefaults()
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	toInstant := func(naive time.Time) time.Time {
+
+		if calcMode == TCalcMode.UtcTimeZone() {
+			return naive.In(locPtr)
+		}
+
+		tzDstMech := timeZoneDstMechanics{}
+
+		earlier, later, _, isNonexistent :=
+			tzDstMech.classifyLocalDateTime(naive, locPtr)
+
+		if isNonexistent {
+			return later
+		}
+
+		return earlier
+	}
+
+	var untilInstant time.Time
+
+	if recurRule.hasUntil {
+
+		untilNaive := recurRule.untilDateTime
+
+		switch {
+
+		case recurRule.untilIsUtc:
+			untilInstant = untilNaive
+
+		case recurRule.untilIsDate:
+			untilNaive = time.Date(
+				untilNaive.Year(),
+				untilNaive.Month(),
+				untilNaive.Day(),
+				23, 59, 59, 999999999,
+				time.UTC)
+
+			fallthrough
+
+		default:
+			tzDstMech := timeZoneDstMechanics{}
+
+			_, untilInstant, _, _ =
+				tzDstMech.classifyLocalDateTime(untilNaive, locPtr)
+		}
+	}
+
+	maxEmptyPeriods := 146097
+
+	switch expansion.frequency {
+
+	case RecurFreq.Yearly():
+		maxEmptyPeriods = 400
+
+	case RecurFreq.Monthly():
+		maxEmptyPeriods = 4800
+
+	case RecurFreq.Weekly():
+		maxEmptyPeriods = 20871
+	}
+
+	cursor, err := expansion.initialCursor()
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	emptyPeriods := 0
+
+	// The start date time is the first occurrence.
+	emitted := 1
+
+	lastInstant := toInstant(expansion.startNaive)
+
+	if recurRule.count == 1 {
+		return occurrences, true, nil
+	}
+
+	for {
+
+		var candidates []time.Time
+
+		var skipTo time.Time
+
+		candidates, skipTo, err = expansion.periodCandidates(cursor)
+
+		if err != nil {
+			return nil, false, err
+		}
+
+		if len(candidates) == 0 {
+
+			emptyPeriods++
+
+			if emptyPeriods > maxEmptyPeriods {
+				return occurrences, true, nil
+			}
+
+		} else {
+
+			emptyPeriods = 0
+		}
+
+		for i := 0; i < len(candidates); i++ {
+
+			if candidates[i].Before(expansion.startNaive) {
+				continue
+			}
+
+			instant := toInstant(candidates[i])
+
+			if instant.Equal(lastInstant) {
+				continue
+			}
+
+			if recurRule.hasUntil &&
+				instant.After(untilInstant) {
+				return occurrences, true, nil
+			}
+
+			if hasWindowEnd &&
+				instant.After(windowEnd) {
+				return occurrences, true, nil
+			}
+
+			emitted++
+
+			lastInstant = instant
+
+			isCountExhausted := recurRule.count > 0 &&
+				emitted >= recurRule.count
+
+			if !hasWindowStart ||
+				!instant.Before(windowStart) {
+
+				occurrences = append(occurrences, instant)
+
+				if maxOccurrences > 0 &&
+					len(occurrences) >= maxOccurrences {
+					return occurrences, isCountExhausted, nil
+				}
+			}
+
+			if isCountExhausted {
+				return occurrences, true, nil
+			}
+		}
+
+		cursor = expansion.nextCursor(cursor, skipTo)
+	}
+}
+
+// getOccurrences - Expands a RecurrenceSet and returns the resulting
+// occurrences in ascending order as DateTzDto instances in the time
+// zone of the recurrence set.
+//
+// The recurrence set consists of the start date time, the occurrences
+// generated by the recurrence rules and the recurrence dates. Any
+// instant generated by an exclusion rule or listed as an exclusion
+// date is removed.
+//
+// If 'maxOccurrences' is greater than zero, no more than
+// 'maxOccurrences' occurrences are returned. If 'hasWindowEnd' is
+// 'false' and 'maxOccurrences' is less than one, all recurrence rules
+// must be bounded by 'COUNT' or 'UNTIL'.
+//
+func (recurMech *recurrenceMechanics) getOccurrences(
+	recurSet *RecurrenceSet,
+	windowStart time.Time,
+	hasWindowStart bool,
+	windowEnd time.Time,
+	hasWindowEnd bool,
+	maxOccurrences int,
+	ePrefix string) (
+	[]DateTzDto,
+	error) {
+
+	if recurMech.lock == nil {
+		recurMech.lock = new(sync.Mutex)
+	}
+
+	recurMech.lock.Lock()
+
+	defer recurMech.lock.Unlock()
+
+	ePrefix += "recurrenceMechanics.getOccurrences() "
+
+	if !hasWindowEnd && maxOccurrences < 1 {
+
+		for i := 0; i < len(recurSet.rRules); i++ {
+
+			if recurSet.rRules[i].count < 1 &&
+				!recurSet.rRules[i].hasUntil {
+				return nil,
+					errors.New(ePrefix + "\n" +
+						"Error: The recurrence set contains a recurrence rule without\n" +
+						"'COUNT' or 'UNTIL'. The number of occurrences is unlimited.\n" +
+						"Specify an ending date time or a maximum number of occurrences.\n")
+			}
+		}
+	}
+
+	dtStart := recurSet.dtStart.dateTimeValue
+
+	locPtr := dtStart.Location()
+
+	isInWindow := func(instant time.Time) bool {
+
+		if hasWindowStart && instant.Before(windowStart) {
+			return false
+		}
+
+		if hasWindowEnd && instant.After(windowEnd) {
+			return false
+		}
+
+		return true
+	}
+
+	instantKey := func(instant time.Time) recurrenceInstantKey {
+		return recurrenceInstantKey{
+			seconds:     instant.Unix(),
+			nanoseconds: instant.Nanosecond(),
+		}
+	}
+
+	recurMech2 := recurrenceMechanics{}
+
+	limit := maxOccurrences
+
+	var results []time.Time
+
+	for {
+
+		candidates := make([]time.Time, 0)
+
+		isExhausted := true
+
+		if isInWindow(dtStart) {
+			candidates = append(candidates, dtStart)
+		}
+
+		for i := 0; i < len(recurSet.rDates); i++ {
+
+			rDate := recurSet.rDates[i].dateTimeValue
+
+			if isInWindow(rDate) {
+				candidates = append(candidates, rDate)
+			}
+		}
+
+		for i := 0; i < len(recurSet.rRules); i++ {
+
+			occurrences, isRuleExhausted, err := recurMech2.expandRule(
+				&recurSet.rRules[i],
+				dtStart,
+				recurSet.calcMode,
+				windowStart,
+				hasWindowStart,
+				windowEnd,
+				hasWindowEnd,
+				limit,
+				ePrefix)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if !isRuleExhausted {
+				isExhausted = false
+			}
+
+			candidates = append(candidates, occurrences...)
+		}
+
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].Before(candidates[j])
+		})
+
+		excluded := make(map[recurrenceInstantKey]bool)
+
+		for i := 0; i < len(recurSet.exDates); i++ {
+			excluded[instantKey(recurSet.exDates[i].dateTimeValue)] = true
+		}
+
+		if len(candidates) > 0 {
+
+			for i := 0; i < len(recurSet.exRules); i++ {
+
+				exOccurrences, _, err := recurMech2.expandRule(
+					&recurSet.exRules[i],
+					dtStart,
+					recurSet.calcMode,
+					candidates[0],
+					true,
+					candidates[len(candidates)-1],
+					true,
+					0,
+					ePrefix)
+
+				if err != nil {
+					return nil, err
+				}
+
+				for j := 0; j < len(exOccurrences); j++ {
+					excluded[instantKey(exOccurrences[j])] = true
+				}
+			}
+		}
+
+		results = make([]time.Time, 0, len(candidates))
+
+		for i := 0; i < len(candidates); i++ {
+
+			key := instantKey(candidates[i])
+
+			if excluded[key] {
+				continue
+			}
+
+			// Duplicate instants are removed
+			excluded[key] = true
+
+			results = append(results, candidates[i])
+		}
+
+		if maxOccurrences < 1 ||
+			len(results) >= maxOccurrences ||
+			isExhausted {
+			break
+		}
+
+		limit *= 2
+	}
+
+	if maxOccurrences > 0 &&
+		len(results) > maxOccurrences {
+		results = results[:maxOccurrences]
+	}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	dateTzDtos := make([]DateTzDto, 0, len(results))
+
+	for i := 0; i < len(results); i++ {
+
+		dTz := DateTzDto{}
+
+		err := dTzUtil.setFromDateTime(
+			&dTz,
+			results[i].In(locPtr),
+			recurSet.dtStart.dateTimeFmt,
+			ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		dateTzDtos = append(dateTzDtos, dTz)
+	}
+
+	return dateTzDtos, nil
+}
+
+// getYearInfo - Returns the Gregorian Calendar base data for 'year'.
+// 'year' is formatted as an Astronomical Year.
+//
+func (expansion *recurrenceRuleExpansion) getYearInfo(
+	year int) (
+	*recurrenceYearInfo,
+	error) {
+
+	info, ok := expansion.yearInfo[year]
+
+	if ok {
+		return info, nil
+	}
+
+	gregCalBData := CalendarGregorianBaseData{}
+
+	isLeapYear, err := gregCalBData.IsLeapYear(
+		int64(year),
+		CalendarYearNumType(0).Astronomical(),
+		expansion.ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info = &recurrenceYearInfo{}
+
+	if isLeapYear {
+		info.monthDays = gregCalBData.GetLeapYearMonthDays()
+		info.daysInYear = gregCalBData.GetDaysInLeapYear()
+	} else {
+		info.monthDays = gregCalBData.GetStandardYearMonthDays()
+		info.daysInYear = gregCalBData.GetDaysInStandardYear()
+	}
+
+	info.monthStart[1] = 1
+
+	for month := 1; month <= 12; month++ {
+		info.monthStart[month+1] = info.monthStart[month] + info.monthDays[month]
+	}
+
+	calGregUtil := CalendarGregorianUtility{}
+
+	julianDayNoDto, err := calGregUtil.GetJulianDayNumber(
+		int64(year),
+		1,
+		1,
+		12,
+		0,
+		0,
+		0,
+		expansion.ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	isoDayOfWeekNo, err := gregCalBData.GetISODayOfWeekNo(
+		julianDayNoDto,
+		expansion.ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	info.jan1WeekDay = int(isoDayOfWeekNo)
+
+	expansion.yearInfo[year] = info
+
+	return info, nil
+}
+
+// initialCursor - Returns the start of the period containing the
+// start date time.
+//
+func (expansion *recurrenceRuleExpansion) initialCursor() (
+	time.Time,
+	error) {
+
+	start := expansion.startNaive
+
+	switch expansion.frequency {
+
+	case RecurFreq.Yearly():
+		return time.Date(start.Year(), 1, 1, 0, 0, 0, 0, time.UTC), nil
+
+	case RecurFreq.Monthly():
+		return time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+
+	case RecurFreq.Weekly():
+
+		day, err := expansion.newDay(start.Year(), int(start.Month()), start.Day())
+
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		offset := (day.weekDay - expansion.weekStart + 7) % 7
+
+		return time.Date(
+			start.Year(), start.Month(), start.Day()-offset,
+			0, 0, 0, 0, time.UTC), nil
+
+	case RecurFreq.Daily():
+		return time.Date(
+			start.Year(), start.Month(), start.Day(),
+			0, 0, 0, 0, time.UTC), nil
+
+	case RecurFreq.Hourly():
+		return time.Date(
+			start.Year(), start.Month(), start.Day(),
+			start.Hour(), 0, 0, 0, time.UTC), nil
+
+	case RecurFreq.Minutely():
+		return time.Date(
+			start.Year(), start.Month(), start.Day(),
+			start.Hour(), start.Minute(), 0, 0, time.UTC), nil
+
+	default:
+		return time.Date(
+			start.Year(), start.Month(), start.Day(),
+			start.Hour(), start.Minute(), start.Second(), 0, time.UTC), nil
+	}
+}
+
+// isDayMatch - Returns 'true' if 'day' satisfies the date rule parts
+// BYMONTH, BYWEEKNO, BYYEARDAY, BYMONTHDAY and BYDAY.
+//
+func (expansion *recurrenceRuleExpansion) isDayMatch(
+	day recurrenceDay) (
+	bool,
+	error) {
+
+	info, err := expansion.getYearInfo(day.year)
+
+	if err != nil {
+		return false, err
+	}
+
+	if len(expansion.byMonth) > 0 &&
+		!expansion.containsInt(expansion.byMonth, day.month) {
+		return false, nil
+	}
+
+	if len(expansion.byWeekNo) > 0 {
+
+		var weekNo, weeksInYear int
+
+		weekNo, weeksInYear, err = expansion.weekNumber(day)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !expansion.containsOrdinal(expansion.byWeekNo, weekNo, weeksInYear) {
+			return false, nil
+		}
+	}
+
+	if len(expansion.byYearDay) > 0 &&
+		!expansion.containsOrdinal(expansion.byYearDay, day.yearDay, info.daysInYear) {
+		return false, nil
+	}
+
+	daysInMonth := info.monthDays[day.month]
+
+	if len(expansion.byMonthDay) > 0 &&
+		!expansion.containsOrdinal(expansion.byMonthDay, day.day, daysInMonth) {
+		return false, nil
+	}
+
+	if len(expansion.byDay) == 0 {
+		return true, nil
+	}
+
+	// Numeric BYDAY values designate the nth weekday within the month
+	// for MONTHLY rules and YEARLY rules restricted by BYMONTH.
+	// Otherwise, they designate the nth weekday within the year.
+	isMonthScope := expansion.frequency == RecurFreq.Monthly() ||
+		len(expansion.byMonth) > 0
+
+	for i := 0; i < len(expansion.byDay); i++ {
+
+		if int(expansion.byDay[i].weekDay) != day.weekDay {
+			continue
+		}
+
+		nth := expansion.byDay[i].nth
+
+		if nth == 0 {
+			return true, nil
+		}
+
+		var position, positionFromEnd int
+
+		if isMonthScope {
+			position = (day.day-1)/7 + 1
+			positionFromEnd = -((daysInMonth-day.day)/7 + 1)
+		} else {
+			position = (day.yearDay-1)/7 + 1
+			positionFromEnd = -((info.daysInYear-day.yearDay)/7 + 1)
+		}
+
+		if nth == position || nth == positionFromEnd {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// containsInt - Returns 'true' if 'values' contains 'target'.
+//
+func (expansion *recurrenceRuleExpansion) containsInt(
+	values []int,
+	target int) bool {
+
+	for i := 0; i < len(values); i++ {
+		if values[i] == target {
+			return true
+		}
+	}
+
+	return false
+}
+
+// containsOrdinal - Returns 'true' if 'values' contains ordinal
+// 'target' where 'total' is the number of ordinals in the range.
+// Negative values count backwards from the end of the range.
+// Example: For a 30 day month, -1 designates day 30.
+//
+func (expansion *recurrenceRuleExpansion) containsOrdinal(
+	values []int,
+	target int,
+	total int) bool {
+
+	for i := 0; i < len(values); i++ {
+
+		if values[i] == target ||
+			(values[i] < 0 && total+values[i]+1 == target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// newDay - Returns a recurrenceDay for a Gregorian Calendar date.
+//
+func (expansion *recurrenceRuleExpansion) newDay(
+	year int,
+	month int,
+	day int) (
+	recurrenceDay,
+	error) {
+
+	info, err := expansion.getYearInfo(year)
+
+	if err != nil {
+		return recurrenceDay{}, err
+	}
+
+	yearDay := info.monthStart[month] + day - 1
+
+	return recurrenceDay{
+		year:    year,
+		month:   month,
+		day:     day,
+		yearDay: yearDay,
+		weekDay: (info.jan1WeekDay-1+yearDay-1)%7 + 1,
+	}, nil
+}
+
+// nextCursor - Returns the start of the next period. For frequencies
+// shorter than one day, 'skipTo' designates the earliest date time at
+// which a match may occur. Periods preceding 'skipTo' are skipped.
+//
+func (expansion *recurrenceRuleExpansion) nextCursor(
+	cursor time.Time,
+	skipTo time.Time) time.Time {
+
+	interval := expansion.interval
+
+	switch expansion.frequency {
+
+	case RecurFreq.Yearly():
+		return time.Date(cursor.Year()+interval, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	case RecurFreq.Monthly():
+		return time.Date(cursor.Year(), cursor.Month()+time.Month(interval), 1, 0, 0, 0, 0, time.UTC)
+
+	case RecurFreq.Weekly():
+		return cursor.AddDate(0, 0, 7*interval)
+
+	case RecurFreq.Daily():
+		return cursor.AddDate(0, 0, interval)
+	}
+
+	unit := time.Second
+
+	if expansion.frequency == RecurFreq.Hourly() {
+		unit = time.Hour
+	} else if expansion.frequency == RecurFreq.Minutely() {
+		unit = time.Minute
+	}
+
+	step := time.Duration(interval) * unit
+
+	if !skipTo.After(cursor) {
+		return cursor.Add(step)
+	}
+
+	steps := (skipTo.Sub(cursor) + step - 1) / step
+
+	return cursor.Add(steps * step)
+}
+
+// periodCandidates - Returns the candidate date times within the
+// period beginning at 'cursor', in ascending order, after applying
+// all rule parts including BYSETPOS.
+//
+// For frequencies shorter than one day, 'skipTo' is set to the start
+// of the next day, hour or minute when the current day, hour or
+// minute is excluded by the rule.
+//
+func (expansion *recurrenceRuleExpansion) periodCandidates(
+	cursor time.Time) (
+	candidates []time.Time,
+	skipTo time.Time,
+	err error) {
+
+	var days []recurrenceDay
+
+	year := cursor.Year()
+
+	switch expansion.frequency {
+
+	case RecurFreq.Yearly():
+
+		var info *recurrenceYearInfo
+
+		info, err = expansion.getYearInfo(year)
+
+		if err != nil {
+			return nil, skipTo, err
+		}
+
+		for month := 1; month <= 12; month++ {
+			for day := 1; day <= info.monthDays[month]; day++ {
+
+				var rDay recurrenceDay
+
+				rDay, err = expansion.newDay(year, month, day)
+
+				if err != nil {
+					return nil, skipTo, err
+				}
+
+				days = append(days, rDay)
+			}
+		}
+
+	case RecurFreq.Monthly():
+
+		var info *recurrenceYearInfo
+
+		info, err = expansion.getYearInfo(year)
+
+		if err != nil {
+			return nil, skipTo, err
+		}
+
+		month := int(cursor.Month())
+
+		for day := 1; day <= info.monthDays[month]; day++ {
+
+			var rDay recurrenceDay
+
+			rDay, err = expansion.newDay(year, month, day)
+
+			if err != nil {
+				return nil, skipTo, err
+			}
+
+			days = append(days, rDay)
+		}
+
+	case RecurFreq.Weekly():
+
+		for i := 0; i < 7; i++ {
+
+			date := cursor.AddDate(0, 0, i)
+
+			var rDay recurrenceDay
+
+			rDay, err = expansion.newDay(date.Year(), int(date.Month()), date.Day())
+
+			if err != nil {
+				return nil, skipTo, err
+			}
+
+			days = append(days, rDay)
+		}
+
+	default:
+
+		var rDay recurrenceDay
+
+		rDay, err = expansion.newDay(year, int(cursor.Month()), cursor.Day())
+
+		if err != nil {
+			return nil, skipTo, err
+		}
+
+		days = append(days, rDay)
+	}
+
+	matchingDays := make([]recurrenceDay, 0, len(days))
+
+	for i := 0; i < len(days); i++ {
+
+		var isMatch bool
+
+		isMatch, err = expansion.isDayMatch(days[i])
+
+		if err != nil {
+			return nil, skipTo, err
+		}
+
+		if isMatch {
+			matchingDays = append(matchingDays, days[i])
+		}
+	}
+
+	isSubDaily := expansion.frequency < RecurFreq.Daily()
+
+	if len(matchingDays) == 0 {
+
+		if isSubDaily {
+			skipTo = time.Date(
+				cursor.Year(), cursor.Month(), cursor.Day()+1,
+				0, 0, 0, 0, time.UTC)
+		}
+
+		return nil, skipTo, nil
+	}
+
+	hours := expansion.timeValues(
+		expansion.byHour, RecurFreq.Hourly(), expansion.startNaive.Hour(), cursor.Hour())
+
+	if isSubDaily && len(hours) == 0 {
+
+		skipTo = time.Date(
+			cursor.Year(), cursor.Month(), cursor.Day(),
+			cursor.Hour()+1, 0, 0, 0, time.UTC)
+
+		return nil, skipTo, nil
+	}
+
+	minutes := expansion.timeValues(
+		expansion.byMinute, RecurFreq.Minutely(), expansion.startNaive.Minute(), cursor.Minute())
+
+	if isSubDaily &&
+		expansion.frequency < RecurFreq.Minutely() &&
+		len(minutes) == 0 {
+
+		skipTo = time.Date(
+			cursor.Year(), cursor.Month(), cursor.Day(),
+			cursor.Hour(), cursor.Minute()+1, 0, 0, time.UTC)
+
+		return nil, skipTo, nil
+	}
+
+	seconds := expansion.timeValues(
+		expansion.bySecond, RecurFreq.Secondly(), expansion.startNaive.Second(), cursor.Second())
+
+	nanosecond := expansion.startNaive.Nanosecond()
+
+	for i := 0; i < len(matchingDays); i++ {
+		for j := 0; j < len(hours); j++ {
+			for k := 0; k < len(minutes); k++ {
+				for m := 0; m < len(seconds); m++ {
+
+					candidates = append(candidates,
+						time.Date(
+							matchingDays[i].year,
+							time.Month(matchingDays[i].month),
+							matchingDays[i].day,
+							hours[j],
+							minutes[k],
+							seconds[m],
+							nanosecond,
+							time.UTC))
+				}
+			}
+		}
+	}
+
+	if len(expansion.bySetPos) == 0 {
+		return candidates, skipTo, nil
+	}
+
+	selected := make([]time.Time, 0, len(expansion.bySetPos))
+
+	for i := 0; i < len(candidates); i++ {
+		if expansion.containsOrdinal(expansion.bySetPos, i+1, len(candidates)) {
+			selected = append(selected, candidates[i])
+		}
+	}
+
+	return selected, skipTo, nil
+}
+
+// setDefaults - Applies the default rule parts derived from the start
+// date time. If no BYWEEKNO, BYYEARDAY, BYMONTHDAY or BYDAY rule part
+// is specified, YEARLY rules repeat on the month and day of the start
+// date, MONTHLY rules repeat on the day of the month of the start
+// date and WEEKLY rules repeat on the day of the week of the start
+// date.
+//
+func (expansion *recurrenceRuleExpansion) setDefaults() error {
+
+	if len(expansion.byWeekNo) > 0 ||
+		len(expansion.byYearDay) > 0 ||
+		len(expansion.byMonthDay) > 0 ||
+		len(expansion.byDay) > 0 {
+		return nil
+	}
+
+	start := expansion.startNaive
+
+	switch expansion.frequency {
+
+	case RecurFreq.Yearly():
+
+		if len(expansion.byMonth) == 0 {
+			expansion.byMonth = []int{int(start.Month())}
+		}
+
+		expansion.byMonthDay = []int{start.Day()}
+
+	case RecurFreq.Monthly():
+
+		expansion.byMonthDay = []int{start.Day()}
+
+	case RecurFreq.Weekly():
+
+		day, err := expansion.newDay(start.Year(), int(start.Month()), start.Day())
+
+		if err != nil {
+			return err
+		}
+
+		expansion.byDay = []recurrenceWeekDay{
+			{nth: 0, weekDay: ISO8601DayOfWeekNo(day.weekDay)},
+		}
+	}
+
+	return nil
+}
+
+// timeValues - Returns the sorted hour, minute or second values for
+// a period. 'unitFrequency' is the frequency corresponding to the time
+// unit. If the rule frequency is longer than 'unitFrequency', the rule
+// part values are used or, if none are specified, the value from the
+// start date time. Otherwise, the value is fixed by the period and is
+// returned only if it satisfies the rule part values.
+//
+// A BYSECOND value of 60 (leap second) is not generated.
+//
+func (expansion *recurrenceRuleExpansion) timeValues(
+	byValues []int,
+	unitFrequency RecurrenceFrequency,
+	startValue int,
+	cursorValue int) []int {
+
+	if expansion.frequency > unitFrequency {
+
+		if len(byValues) == 0 {
+			return []int{startValue}
+		}
+
+		values := make([]int, 0, len(byValues))
+
+		for i := 0; i < len(byValues); i++ {
+
+			if byValues[i] == 60 ||
+				expansion.containsInt(values, byValues[i]) {
+				continue
+			}
+
+			values = append(values, byValues[i])
+		}
+
+		sort.Ints(values)
+
+		return values
+	}
+
+	if len(byValues) > 0 &&
+		!expansion.containsInt(byValues, cursorValue) {
+		return nil
+	}
+
+	return []int{cursorValue}
+}
+
+// weekNumber - Returns the week number of 'day' and the number of
+// weeks in the week numbering year containing 'day'. Weeks begin on
+// the 'WKST' day of the week. Week number one is the first week
+// containing at least four days of the calendar year. Days at the
+// beginning or end of a calendar year may therefore belong to the
+// last week of the preceding year or the first week of the following
+// year.
+//
+func (expansion *recurrenceRuleExpansion) weekNumber(
+	day recurrenceDay) (
+	weekNo int,
+	weeksInYear int,
+	err error) {
+
+	// week1Start returns the ordinal day number of the first day of
+	// week number one. The result may be less than one.
+	week1Start := func(year int) (int, error) {
+
+		info, err := expansion.getYearInfo(year)
+
+		if err != nil {
+			return 0, err
+		}
+
+		offset := (info.jan1WeekDay - expansion.weekStart + 7) % 7
+
+		if offset <= 3 {
+			return 1 - offset, nil
+		}
+
+		return 8 - offset, nil
+	}
+
+	weeksIn := func(year int) (int, error) {
+
+		info, err := expansion.getYearInfo(year)
+
+		if err != nil {
+			return 0, err
+		}
+
+		start, err := week1Start(year)
+
+		if err != nil {
+			return 0, err
+		}
+
+		nextStart, err := week1Start(year + 1)
+
+		if err != nil {
+			return 0, err
+		}
+
+		return (info.daysInYear + nextStart - start) / 7, nil
+	}
+
+	info, err := expansion.getYearInfo(day.year)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	start, err := week1Start(day.year)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if day.yearDay < start {
+
+		var prevInfo *recurrenceYearInfo
+
+		prevInfo, err = expansion.getYearInfo(day.year - 1)
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		var prevStart int
+
+		prevStart, err = week1Start(day.year - 1)
+
+		if err != nil {
+			return 0, 0, err
+		}
+
+		weekNo = (day.yearDay-(prevStart-prevInfo.daysInYear))/7 + 1
+
+		weeksInYear, err = weeksIn(day.year - 1)
+
+		return weekNo, weeksInYear, err
+	}
+
+	nextStart, err := week1Start(day.year + 1)
+
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if day.yearDay >= info.daysInYear+nextStart {
+
+		weeksInYear, err = weeksIn(day.year + 1)
+
+		return 1, weeksInYear, err
+	}
+
+	weekNo = (day.yearDay-start)/7 + 1
+
+	weeksInYear, err = weeksIn(day.year)
+
+	return weekNo, weeksInYear, err
+}
@@ -0,0 +1,303 @@
+package datetime
+
+import (
+	"sync"
+	"time"
+)
+
+// RecurrenceRule - Contains a recurrence rule as defined by the
+// 'RECUR' value type of the iCalendar specification, RFC 5545.
+// Recurrence rules are used in the 'RRULE' and 'EXRULE' properties
+// of iCalendar components.
+//
+//  Example: "FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"
+//           The last work day of each month.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.3.10
+//
+// A RecurrenceRule does not include a start date time. Recurrence
+// rules are evaluated relative to the start date time of a
+// RecurrenceSet. Reference type 'RecurrenceSet'.
+//
+// The following rule parts are supported:
+//
+//  FREQ, UNTIL, COUNT, INTERVAL, BYSECOND, BYMINUTE, BYHOUR, BYDAY,
+//  BYMONTHDAY, BYYEARDAY, BYWEEKNO, BYMONTH, BYSETPOS and WKST.
+//
+// Rule part values are stored as parsed. Month lengths, leap years
+// and weekday numbers are supplied by the Gregorian Calendar base
+// data when the rule is evaluated.
+//
+type RecurrenceRule struct {
+	frequency     RecurrenceFrequency  // FREQ
+	interval      int                  // INTERVAL. Default is 1.
+	count         int                  // COUNT. Zero signals no COUNT.
+	untilDateTime time.Time            // UNTIL date time components
+	hasUntil      bool                 // Set to 'true' if UNTIL is specified
+	untilIsDate   bool                 // UNTIL is a DATE value (YYYYMMDD)
+	untilIsUtc    bool                 // UNTIL is a UTC DATE-TIME value (YYYYMMDDTHHMMSSZ)
+	bySecond      []int                // BYSECOND 0 to 60
+	byMinute      []int                // BYMINUTE 0 to 59
+	byHour        []int                // BYHOUR 0 to 23
+	byDay         []recurrenceWeekDay  // BYDAY Example: MO, -1FR, +2TU
+	byMonthDay    []int                // BYMONTHDAY -31 to -1 and 1 to 31
+	byYearDay     []int                // BYYEARDAY -366 to -1 and 1 to 366
+	byWeekNo      []int                // BYWEEKNO -53 to -1 and 1 to 53
+	byMonth       []int                // BYMONTH 1 to 12
+	bySetPos      []int                // BYSETPOS -366 to -1 and 1 to 366
+	weekStart     ISO8601DayOfWeekNo   // WKST. Default is Monday.
+	lock          *sync.Mutex
+}
+
+// recurrenceWeekDay - Contains a single 'BYDAY' value. 'nth'
+// is zero if the value applies to every occurrence of 'weekDay'.
+// Otherwise, 'nth' designates the nth occurrence of 'weekDay'
+// within the month or year. Negative values count backwards
+// from the end of the month or year.
+//
+type recurrenceWeekDay struct {
+	nth     int
+	weekDay ISO8601DayOfWeekNo
+}
+
+// CopyOut - Returns a deep copy of the current RecurrenceRule
+// instance.
+//
+func (recurRule *RecurrenceRule) CopyOut() RecurrenceRule {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	recurRuleMech := recurrenceRuleMechanics{}
+
+	return recurRuleMech.copyOut(recurRule)
+}
+
+// GetCount - Returns the value of the 'COUNT' rule part. If the
+// recurrence rule does not specify 'COUNT', this method returns
+// zero.
+//
+func (recurRule *RecurrenceRule) GetCount() int {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	return recurRule.count
+}
+
+// GetFrequency - Returns the value of the 'FREQ' rule part.
+//
+func (recurRule *RecurrenceRule) GetFrequency() RecurrenceFrequency {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	return recurRule.frequency
+}
+
+// GetInterval - Returns the value of the 'INTERVAL' rule part.
+// If the recurrence rule does not specify 'INTERVAL', this method
+// returns the default value of one (1).
+//
+func (recurRule *RecurrenceRule) GetInterval() int {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	if recurRule.interval < 1 {
+		return 1
+	}
+
+	return recurRule.interval
+}
+
+// GetWeekStart - Returns the value of the 'WKST' rule part. If
+// the recurrence rule does not specify 'WKST', this method
+// returns the default value of Monday.
+//
+func (recurRule *RecurrenceRule) GetWeekStart() ISO8601DayOfWeekNo {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	if !recurRule.weekStart.XIsValid() {
+		return ISO8601DayOfWeekNo(0).Monday()
+	}
+
+	return recurRule.weekStart
+}
+
+// HasUntil - Returns 'true' if the recurrence rule specifies an
+// 'UNTIL' rule part.
+//
+func (recurRule *RecurrenceRule) HasUntil() bool {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	return recurRule.hasUntil
+}
+
+// IsBounded - Returns 'true' if the recurrence rule specifies
+// either a 'COUNT' or an 'UNTIL' rule part. Recurrence rules
+// which are not bounded generate an unlimited number of
+// occurrences.
+//
+func (recurRule *RecurrenceRule) IsBounded() bool {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	return recurRule.hasUntil || recurRule.count > 0
+}
+
+// IsValid - Returns an error if the current RecurrenceRule
+// instance is invalid.
+//
+func (recurRule *RecurrenceRule) IsValid(
+	ePrefix string) error {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	ePrefix += "RecurrenceRule.IsValid() "
+
+	recurRuleMech := recurrenceRuleMechanics{}
+
+	return recurRuleMech.testRecurrenceRuleValidity(
+		recurRule,
+		ePrefix)
+}
+
+// NewFromString - Parses an iCalendar recurrence rule string and
+// returns a new RecurrenceRule instance.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  rRule               string
+//     - A recurrence rule formatted in accordance with the 'RECUR'
+//       value type of RFC 5545. Rule parts are separated by
+//       semicolons. An optional 'RRULE:' or 'EXRULE:' property name
+//       prefix is ignored. Rule part names are not case sensitive.
+//
+//         Examples:
+//           "FREQ=DAILY;COUNT=10"
+//           "RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=4TH"
+//           "FREQ=WEEKLY;UNTIL=20211231T235959Z;WKST=SU;BYDAY=TU,TH"
+//
+//       'UNTIL' may be specified as a DATE (YYYYMMDD), a local
+//       DATE-TIME (YYYYMMDDTHHMMSS) or a UTC DATE-TIME
+//       (YYYYMMDDTHHMMSSZ). DATE and local DATE-TIME values are
+//       evaluated in the time zone of the RecurrenceSet. A DATE
+//       value includes all occurrences on that date.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  RecurrenceRule
+//     - If successful, this method returns a new, populated instance
+//       of RecurrenceRule.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If 'rRule' is invalid, the returned error Type will
+//       encapsulate an error message. Note this error message will
+//       incorporate the method chain and text passed by input
+//       parameter, 'ePrefix'.
+//
+func (recurRule RecurrenceRule) NewFromString(
+	rRule string,
+	ePrefix string) (
+	RecurrenceRule,
+	error) {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	ePrefix += "RecurrenceRule.NewFromString() "
+
+	recurRuleMech := recurrenceRuleMechanics{}
+
+	return recurRuleMech.parseRecurrenceRule(
+		rRule,
+		ePrefix)
+}
+
+// String - Returns the recurrence rule formatted in accordance
+// with the 'RECUR' value type of RFC 5545. The property name
+// ('RRULE:' or 'EXRULE:') is NOT included. Rule parts are listed
+// in a standard order and default values are omitted.
+//
+//  Example: "FREQ=YEARLY;BYDAY=4TH;BYMONTH=11"
+//
+func (recurRule *RecurrenceRule) String() string {
+
+	if recurRule.lock == nil {
+		recurRule.lock = new(sync.Mutex)
+	}
+
+	recurRule.lock.Lock()
+
+	defer recurRule.lock.Unlock()
+
+	recurRuleMech := recurrenceRuleMechanics{}
+
+	return recurRuleMech.formatRecurrenceRule(recurRule)
+}
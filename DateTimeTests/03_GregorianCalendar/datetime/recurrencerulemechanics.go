@@ -0,0 +1,655 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// recurrenceRuleMechanics - Provides helper methods used to parse,
+// format and validate RecurrenceRule instances.
+//
+type recurrenceRuleMechanics struct {
+	lock *sync.Mutex
+}
+
+// mRecurrenceWeekDayCodes - Maps the two letter RFC 5545 weekday
+// codes to ISO 8601 day of the week numbers.
+//
+var mRecurrenceWeekDayCodes = map[string]ISO8601DayOfWeekNo{
+	"MO" : ISO8601DayOfWeekNo(1),
+	"TU" : ISO8601DayOfWeekNo(2),
+	"WE" : ISO8601DayOfWeekNo(3),
+	"TH" : ISO8601DayOfWeekNo(4),
+	"FR" : ISO8601DayOfWeekNo(5),
+	"SA" : ISO8601DayOfWeekNo(6),
+	"SU" : ISO8601DayOfWeekNo(7),
+}
+
+// mRecurrenceFrequencyCodes - Maps the RFC 5545 'FREQ' values to
+// RecurrenceFrequency enumeration values.
+//
+var mRecurrenceFrequencyCodes = map[string]RecurrenceFrequency{
+	"SECONDLY" : RecurrenceFrequency(1),
+	"MINUTELY" : RecurrenceFrequency(2),
+	"HOURLY"   : RecurrenceFrequency(3),
+	"DAILY"    : RecurrenceFrequency(4),
+	"WEEKLY"   : RecurrenceFrequency(5),
+	"MONTHLY"  : RecurrenceFrequency(6),
+	"YEARLY"   : RecurrenceFrequency(7),
+}
+
+// copyOut - Returns a deep copy of a RecurrenceRule instance.
+//
+func (recurRuleMech *recurrenceRuleMechanics) copyOut(
+	recurRule *RecurrenceRule) RecurrenceRule {
+
+	copyInts := func(src []int) []int {
+
+		if src == nil {
+			return nil
+		}
+
+		dst := make([]int, len(src))
+
+		copy(dst, src)
+
+		return dst
+	}
+
+	newRecurRule := RecurrenceRule{
+		frequency:     recurRule.frequency,
+		interval:      recurRule.interval,
+		count:         recurRule.count,
+		untilDateTime: recurRule.untilDateTime,
+		hasUntil:      recurRule.hasUntil,
+		untilIsDate:   recurRule.untilIsDate,
+		untilIsUtc:    recurRule.untilIsUtc,
+		bySecond:      copyInts(recurRule.bySecond),
+		byMinute:      copyInts(recurRule.byMinute),
+		byHour:        copyInts(recurRule.byHour),
+		byMonthDay:    copyInts(recurRule.byMonthDay),
+		byYearDay:     copyInts(recurRule.byYearDay),
+		byWeekNo:      copyInts(recurRule.byWeekNo),
+		byMonth:       copyInts(recurRule.byMonth),
+		bySetPos:      copyInts(recurRule.bySetPos),
+		weekStart:     recurRule.weekStart,
+		lock:          new(sync.Mutex),
+	}
+
+	if recurRule.byDay != nil {
+		newRecurRule.byDay = make([]recurrenceWeekDay, len(recurRule.byDay))
+		copy(newRecurRule.byDay, recurRule.byDay)
+	}
+
+	return newRecurRule
+}
+
+// formatRecurrenceRule - Formats a RecurrenceRule as an RFC 5545
+// 'RECUR' value.
+//
+func (recurRuleMech *recurrenceRuleMechanics) formatRecurrenceRule(
+	recurRule *RecurrenceRule) string {
+
+	freqCode := ""
+
+	for code, freq := range mRecurrenceFrequencyCodes {
+		if freq == recurRule.frequency {
+			freqCode = code
+			break
+		}
+	}
+
+	parts := []string{"FREQ=" + freqCode}
+
+	if recurRule.hasUntil {
+
+		var untilStr string
+
+		switch {
+
+		case recurRule.untilIsDate:
+			untilStr = recurRule.untilDateTime.Format("20060102")
+
+		case recurRule.untilIsUtc:
+			untilStr = recurRule.untilDateTime.Format("20060102T150405") + "Z"
+
+		default:
+			untilStr = recurRule.untilDateTime.Format("20060102T150405")
+		}
+
+		parts = append(parts, "UNTIL="+untilStr)
+	}
+
+	if recurRule.count > 0 {
+		parts = append(parts, fmt.Sprintf("COUNT=%v", recurRule.count))
+	}
+
+	if recurRule.interval > 1 {
+		parts = append(parts, fmt.Sprintf("INTERVAL=%v", recurRule.interval))
+	}
+
+	joinInts := func(name string, values []int) {
+
+		if len(values) == 0 {
+			return
+		}
+
+		strValues := make([]string, len(values))
+
+		for i := 0; i < len(values); i++ {
+			strValues[i] = strconv.Itoa(values[i])
+		}
+
+		parts = append(parts, name+"="+strings.Join(strValues, ","))
+	}
+
+	joinInts("BYSECOND", recurRule.bySecond)
+	joinInts("BYMINUTE", recurRule.byMinute)
+	joinInts("BYHOUR", recurRule.byHour)
+
+	if len(recurRule.byDay) > 0 {
+
+		strValues := make([]string, len(recurRule.byDay))
+
+		for i := 0; i < len(recurRule.byDay); i++ {
+
+			strValues[i] = recurRuleMech.weekDayCode(recurRule.byDay[i].weekDay)
+
+			if recurRule.byDay[i].nth != 0 {
+				strValues[i] = strconv.Itoa(recurRule.byDay[i].nth) + strValues[i]
+			}
+		}
+
+		parts = append(parts, "BYDAY="+strings.Join(strValues, ","))
+	}
+
+	joinInts("BYMONTHDAY", recurRule.byMonthDay)
+	joinInts("BYYEARDAY", recurRule.byYearDay)
+	joinInts("BYWEEKNO", recurRule.byWeekNo)
+	joinInts("BYMONTH", recurRule.byMonth)
+	joinInts("BYSETPOS", recurRule.bySetPos)
+
+	if recurRule.weekStart.XIsValid() &&
+		recurRule.weekStart != ISO8601DayOfWeekNo(0).Monday() {
+		parts = append(parts, "WKST="+recurRuleMech.weekDayCode(recurRule.weekStart))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// parseIntList - Parses a comma separated list of integer values.
+// Each value must fall within the range 'minValue' to 'maxValue'.
+// If 'allowNegative' is 'true', values between -'maxValue' and
+// -'minValue' are also accepted. Zero is never accepted unless
+// 'minValue' is zero.
+//
+func (recurRuleMech *recurrenceRuleMechanics) parseIntList(
+	partName string,
+	partValue string,
+	minValue int,
+	maxValue int,
+	allowNegative bool,
+	ePrefix string) (
+	values []int,
+	err error) {
+
+	strValues := strings.Split(partValue, ",")
+
+	values = make([]int, 0, len(strValues))
+
+	for i := 0; i < len(strValues); i++ {
+
+		var value int
+
+		value, err = strconv.Atoi(strings.TrimSpace(strValues[i]))
+
+		isValid := err == nil &&
+			((value >= minValue && value <= maxValue) ||
+				(allowNegative && value <= -minValue && value >= -maxValue && value != 0))
+
+		if !isValid {
+			return nil,
+				&InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "rRule",
+					inputParameterValue: partName + "=" + partValue,
+					errMsg:              fmt.Sprintf("'%v' value '%v' is INVALID!", partName, strValues[i]),
+					err:                 nil,
+				}
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// parseRecurrenceRule - Parses an RFC 5545 'RECUR' value and returns
+// a new RecurrenceRule instance.
+//
+func (recurRuleMech *recurrenceRuleMechanics) parseRecurrenceRule(
+	rRule string,
+	ePrefix string) (
+	RecurrenceRule,
+	error) {
+
+	ePrefix += "recurrenceRuleMechanics.parseRecurrenceRule() "
+
+	ruleStr := strings.TrimSpace(rRule)
+
+	upperRuleStr := strings.ToUpper(ruleStr)
+
+	if strings.HasPrefix(upperRuleStr, "RRULE:") {
+		ruleStr = ruleStr[len("RRULE:"):]
+	} else if strings.HasPrefix(upperRuleStr, "EXRULE:") {
+		ruleStr = ruleStr[len("EXRULE:"):]
+	}
+
+	if len(ruleStr) == 0 {
+		return RecurrenceRule{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "rRule",
+				inputParameterValue: "",
+				errMsg:              "Input parameter 'rRule' is an empty string!",
+				err:                 nil,
+			}
+	}
+
+	newRecurRule := RecurrenceRule{
+		interval:  1,
+		weekStart: ISO8601DayOfWeekNo(0).Monday(),
+		lock:      new(sync.Mutex),
+	}
+
+	recurRuleMech2 := recurrenceRuleMechanics{}
+
+	partNames := make(map[string]bool)
+
+	parts := strings.Split(ruleStr, ";")
+
+	for i := 0; i < len(parts); i++ {
+
+		part := strings.TrimSpace(parts[i])
+
+		if len(part) == 0 {
+			continue
+		}
+
+		idx := strings.Index(part, "=")
+
+		if idx < 1 || idx == len(part)-1 {
+			return RecurrenceRule{},
+				&InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "rRule",
+					inputParameterValue: rRule,
+					errMsg:              fmt.Sprintf("Rule part '%v' is INVALID!", part),
+					err:                 nil,
+				}
+		}
+
+		partName := strings.ToUpper(strings.TrimSpace(part[:idx]))
+
+		partValue := strings.ToUpper(strings.TrimSpace(part[idx+1:]))
+
+		if partNames[partName] {
+			return RecurrenceRule{},
+				&InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "rRule",
+					inputParameterValue: rRule,
+					errMsg:              fmt.Sprintf("Rule part '%v' is duplicated!", partName),
+					err:                 nil,
+				}
+		}
+
+		partNames[partName] = true
+
+		var err error
+
+		switch partName {
+
+		case "FREQ":
+
+			var ok bool
+
+			newRecurRule.frequency, ok = mRecurrenceFrequencyCodes[partValue]
+
+			if !ok {
+				err = &InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "rRule",
+					inputParameterValue: rRule,
+					errMsg:              fmt.Sprintf("'FREQ' value '%v' is INVALID!", partValue),
+					err:                 nil,
+				}
+			}
+
+		case "UNTIL":
+
+			err = recurRuleMech2.parseUntil(&newRecurRule, partValue, ePrefix)
+
+		case "COUNT", "INTERVAL":
+
+			var values []int
+
+			values, err = recurRuleMech2.parseIntList(
+				partName, partValue, 1, 1<<30, false, ePrefix)
+
+			if err == nil && len(values) != 1 {
+				err = &InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "rRule",
+					inputParameterValue: rRule,
+					errMsg:              fmt.Sprintf("'%v' requires a single value!", partName),
+					err:                 nil,
+				}
+			}
+
+			if err == nil {
+				if partName == "COUNT" {
+					newRecurRule.count = values[0]
+				} else {
+					newRecurRule.interval = values[0]
+				}
+			}
+
+		case "BYSECOND":
+			newRecurRule.bySecond, err = recurRuleMech2.parseIntList(
+				partName, partValue, 0, 60, false, ePrefix)
+
+		case "BYMINUTE":
+			newRecurRule.byMinute, err = recurRuleMech2.parseIntList(
+				partName, partValue, 0, 59, false, ePrefix)
+
+		case "BYHOUR":
+			newRecurRule.byHour, err = recurRuleMech2.parseIntList(
+				partName, partValue, 0, 23, false, ePrefix)
+
+		case "BYDAY":
+			newRecurRule.byDay, err = recurRuleMech2.parseWeekDayList(
+				partValue, ePrefix)
+
+		case "BYMONTHDAY":
+			newRecurRule.byMonthDay, err = recurRuleMech2.parseIntList(
+				partName, partValue, 1, 31, true, ePrefix)
+
+		case "BYYEARDAY":
+			newRecurRule.byYearDay, err = recurRuleMech2.parseIntList(
+				partName, partValue, 1, 366, true, ePrefix)
+
+		case "BYWEEKNO":
+			newRecurRule.byWeekNo, err = recurRuleMech2.parseIntList(
+				partName, partValue, 1, 53, true, ePrefix)
+
+		case "BYMONTH":
+			newRecurRule.byMonth, err = recurRuleMech2.parseIntList(
+				partName, partValue, 1, 12, false, ePrefix)
+
+		case "BYSETPOS":
+			newRecurRule.bySetPos, err = recurRuleMech2.parseIntList(
+				partName, partValue, 1, 366, true, ePrefix)
+
+		case "WKST":
+
+			var ok bool
+
+			newRecurRule.weekStart, ok = mRecurrenceWeekDayCodes[partValue]
+
+			if !ok {
+				err = &InputParameterError{
+					ePrefix:             ePrefix,
+					inputParameterName:  "rRule",
+					inputParameterValue: rRule,
+					errMsg:              fmt.Sprintf("'WKST' value '%v' is INVALID!", partValue),
+					err:                 nil,
+				}
+			}
+
+		default:
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "rRule",
+				inputParameterValue: rRule,
+				errMsg:              fmt.Sprintf("Rule part '%v' is NOT supported!", partName),
+				err:                 nil,
+			}
+		}
+
+		if err != nil {
+			return RecurrenceRule{}, err
+		}
+	}
+
+	err := recurRuleMech2.testRecurrenceRuleValidity(
+		&newRecurRule,
+		ePrefix)
+
+	if err != nil {
+		return RecurrenceRule{}, err
+	}
+
+	return newRecurRule, nil
+}
+
+// parseUntil - Parses the value of an 'UNTIL' rule part. The value
+// may be a DATE (YYYYMMDD), a local DATE-TIME (YYYYMMDDTHHMMSS) or
+// a UTC DATE-TIME (YYYYMMDDTHHMMSSZ).
+//
+func (recurRuleMech *recurrenceRuleMechanics) parseUntil(
+	recurRule *RecurrenceRule,
+	untilStr string,
+	ePrefix string) error {
+
+	var err error
+
+	switch len(untilStr) {
+
+	case 8:
+		recurRule.untilDateTime, err = time.Parse("20060102", untilStr)
+		recurRule.untilIsDate = true
+
+	case 15:
+		recurRule.untilDateTime, err = time.Parse("20060102T150405", untilStr)
+
+	case 16:
+
+		if untilStr[15] != 'Z' {
+			err = errors.New("missing 'Z' suffix")
+			break
+		}
+
+		recurRule.untilDateTime, err = time.Parse("20060102T150405", untilStr[:15])
+		recurRule.untilIsUtc = true
+
+	default:
+		err = errors.New("invalid length")
+	}
+
+	if err != nil {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "rRule",
+			inputParameterValue: "UNTIL=" + untilStr,
+			errMsg:              "'UNTIL' value is INVALID!",
+			err:                 err,
+		}
+	}
+
+	recurRule.hasUntil = true
+
+	return nil
+}
+
+// parseWeekDayList - Parses the comma separated values of a 'BYDAY'
+// rule part. Each value consists of an optional signed ordinal
+// number followed by a two letter weekday code.
+//
+//  Examples: "MO", "+1MO", "-1FR", "20TH"
+//
+func (recurRuleMech *recurrenceRuleMechanics) parseWeekDayList(
+	partValue string,
+	ePrefix string) (
+	weekDays []recurrenceWeekDay,
+	err error) {
+
+	strValues := strings.Split(partValue, ",")
+
+	weekDays = make([]recurrenceWeekDay, 0, len(strValues))
+
+	for i := 0; i < len(strValues); i++ {
+
+		value := strings.TrimSpace(strValues[i])
+
+		invalidErr := &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "rRule",
+			inputParameterValue: "BYDAY=" + partValue,
+			errMsg:              fmt.Sprintf("'BYDAY' value '%v' is INVALID!", value),
+			err:                 nil,
+		}
+
+		if len(value) < 2 {
+			return nil, invalidErr
+		}
+
+		weekDay, ok := mRecurrenceWeekDayCodes[value[len(value)-2:]]
+
+		if !ok {
+			return nil, invalidErr
+		}
+
+		nth := 0
+
+		if len(value) > 2 {
+
+			nth, err = strconv.Atoi(value[:len(value)-2])
+
+			if err != nil ||
+				nth == 0 ||
+				nth > 53 ||
+				nth < -53 {
+				return nil, invalidErr
+			}
+		}
+
+		weekDays = append(weekDays, recurrenceWeekDay{nth: nth, weekDay: weekDay})
+	}
+
+	return weekDays, nil
+}
+
+// testRecurrenceRuleValidity - Tests a RecurrenceRule for
+// combinations of rule parts prohibited by RFC 5545.
+//
+func (recurRuleMech *recurrenceRuleMechanics) testRecurrenceRuleValidity(
+	recurRule *RecurrenceRule,
+	ePrefix string) error {
+
+	ePrefix += "recurrenceRuleMechanics.testRecurrenceRuleValidity() "
+
+	if !recurRule.frequency.XIsValid() {
+		return errors.New(ePrefix + "\n" +
+			"Error: The recurrence rule does NOT specify a valid 'FREQ'!\n")
+	}
+
+	if recurRule.count > 0 && recurRule.hasUntil {
+		return errors.New(ePrefix + "\n" +
+			"Error: 'COUNT' and 'UNTIL' may NOT both be specified!\n")
+	}
+
+	if recurRule.count < 0 {
+		return fmt.Errorf(ePrefix + "\n" +
+			"Error: 'COUNT' is INVALID!\n" +
+			"COUNT='%v'\n", recurRule.count)
+	}
+
+	freq := recurRule.frequency
+
+	if len(recurRule.byWeekNo) > 0 &&
+		freq != RecurFreq.Yearly() {
+		return errors.New(ePrefix + "\n" +
+			"Error: 'BYWEEKNO' is only valid with 'FREQ=YEARLY'!\n")
+	}
+
+	if len(recurRule.byYearDay) > 0 &&
+		(freq == RecurFreq.Daily() ||
+			freq == RecurFreq.Weekly() ||
+			freq == RecurFreq.Monthly()) {
+		return fmt.Errorf(ePrefix + "\n" +
+			"Error: 'BYYEARDAY' is NOT valid with 'FREQ=%v'!\n",
+			strings.ToUpper(freq.String()))
+	}
+
+	if len(recurRule.byMonthDay) > 0 &&
+		freq == RecurFreq.Weekly() {
+		return errors.New(ePrefix + "\n" +
+			"Error: 'BYMONTHDAY' is NOT valid with 'FREQ=WEEKLY'!\n")
+	}
+
+	for i := 0; i < len(recurRule.byDay); i++ {
+
+		if recurRule.byDay[i].nth == 0 {
+			continue
+		}
+
+		if freq != RecurFreq.Monthly() &&
+			freq != RecurFreq.Yearly() {
+			return errors.New(ePrefix + "\n" +
+				"Error: Numeric 'BYDAY' values are only valid with\n" +
+				"'FREQ=MONTHLY' or 'FREQ=YEARLY'!\n")
+		}
+
+		if freq == RecurFreq.Yearly() &&
+			len(recurRule.byWeekNo) > 0 {
+			return errors.New(ePrefix + "\n" +
+				"Error: Numeric 'BYDAY' values are NOT valid with 'BYWEEKNO'!\n")
+		}
+
+		if recurRule.byDay[i].nth > 5 ||
+			recurRule.byDay[i].nth < -5 {
+
+			if freq == RecurFreq.Monthly() ||
+				len(recurRule.byMonth) > 0 {
+				return fmt.Errorf(ePrefix + "\n" +
+					"Error: 'BYDAY' ordinal '%v' exceeds the number of weeks in a month!\n",
+					recurRule.byDay[i].nth)
+			}
+		}
+	}
+
+	if len(recurRule.bySetPos) > 0 &&
+		len(recurRule.bySecond) == 0 &&
+		len(recurRule.byMinute) == 0 &&
+		len(recurRule.byHour) == 0 &&
+		len(recurRule.byDay) == 0 &&
+		len(recurRule.byMonthDay) == 0 &&
+		len(recurRule.byYearDay) == 0 &&
+		len(recurRule.byWeekNo) == 0 &&
+		len(recurRule.byMonth) == 0 {
+		return errors.New(ePrefix + "\n" +
+			"Error: 'BYSETPOS' requires at least one other 'BYxxx' rule part!\n")
+	}
+
+	return nil
+}
+
+// weekDayCode - Returns the two letter RFC 5545 weekday code for
+// an ISO 8601 day of the week number.
+//
+func (recurRuleMech *recurrenceRuleMechanics) weekDayCode(
+	weekDay ISO8601DayOfWeekNo) string {
+
+	for code, isoWeekDay := range mRecurrenceWeekDayCodes {
+		if isoWeekDay == weekDay {
+			return code
+		}
+	}
+
+	return ""
+}
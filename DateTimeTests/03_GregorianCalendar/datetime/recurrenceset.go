@@ -0,0 +1,612 @@
+package datetime
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// RecurrenceSet - Defines a set of recurring date times as described
+// by the iCalendar specification, RFC 5545. A recurrence set is
+// composed of:
+//
+//  DTSTART - The start date time. The start date time is always the
+//            first occurrence of the recurrence set. It counts
+//            against the 'COUNT' rule part of each recurrence rule,
+//            even if it does not match the rule.
+//
+//  RRULE   - Zero or more recurrence rules generating occurrences.
+//
+//  RDATE   - Zero or more individual recurrence date times.
+//
+//  EXRULE  - Zero or more exclusion rules. Occurrences generated by
+//            an exclusion rule are removed from the recurrence set.
+//
+//  EXDATE  - Zero or more exclusion date times. Matching occurrences
+//            are removed from the recurrence set.
+//
+// Reference:
+//   https://tools.ietf.org/html/rfc5545#section-3.8.5
+//
+// Occurrences are returned as DateTzDto instances in the Time Zone
+// Definition of the start date time. Recurrence dates and exclusion
+// dates supplied in other time zones are matched by instant and
+// converted to this time zone.
+//
+// The Time Math Calculation Mode determines how recurrence rules are
+// expanded across Daylight Saving Time transitions.
+//
+//  TCalcMode.LocalTimeZone()
+//     - Recurrence rules are expanded in local time. A daily meeting at
+//       09:00 remains at 09:00 local time throughout the year. Local
+//       times which do not exist because clocks were set forward are
+//       shifted forward by the length of the gap. Local times which
+//       occur twice resolve to the first occurrence.
+//
+//  TCalcMode.UtcTimeZone()
+//     - Recurrence rules are expanded in Coordinated Universal Time
+//       (UTC). Every day consists of 24-consecutive hours. A daily job
+//       starting at 09:00 CST will run at 10:00 CDT during Daylight
+//       Saving Time.
+//
+// Reference type 'TimeMathCalcMode'.
+//
+type RecurrenceSet struct {
+	dtStart  DateTzDto        // DTSTART. Defines the time zone of all occurrences.
+	calcMode TimeMathCalcMode // LocalTimeZone or UtcTimeZone
+	rRules   []RecurrenceRule // RRULE
+	exRules  []RecurrenceRule // EXRULE
+	rDates   []DateTzDto      // RDATE
+	exDates  []DateTzDto      // EXDATE
+	lock     *sync.Mutex
+}
+
+// AddExDate - Adds an exclusion date time to the recurrence set. Any
+// occurrence matching the instant of 'exDate' is removed from the
+// recurrence set.
+//
+func (recurSet *RecurrenceSet) AddExDate(
+	exDate DateTzDto,
+	ePrefix string) error {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.AddExDate() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&exDate, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	recurSet.exDates = append(recurSet.exDates, dTzUtil.copyOut(&exDate))
+
+	return nil
+}
+
+// AddExRule - Adds an exclusion rule to the recurrence set. Any
+// occurrence generated by 'exRule' is removed from the recurrence
+// set.
+//
+// Note that the 'EXRULE' property was deprecated by RFC 5545. It is
+// supported here for compatibility with RFC 2445.
+//
+func (recurSet *RecurrenceSet) AddExRule(
+	exRule RecurrenceRule,
+	ePrefix string) error {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.AddExRule() "
+
+	err := exRule.IsValid(ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	recurSet.exRules = append(recurSet.exRules, exRule.CopyOut())
+
+	return nil
+}
+
+// AddRDate - Adds an individual recurrence date time to the
+// recurrence set.
+//
+func (recurSet *RecurrenceSet) AddRDate(
+	rDate DateTzDto,
+	ePrefix string) error {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.AddRDate() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&rDate, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	recurSet.rDates = append(recurSet.rDates, dTzUtil.copyOut(&rDate))
+
+	return nil
+}
+
+// AddRRule - Adds a recurrence rule to the recurrence set.
+//
+func (recurSet *RecurrenceSet) AddRRule(
+	rRule RecurrenceRule,
+	ePrefix string) error {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.AddRRule() "
+
+	err := rRule.IsValid(ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	recurSet.rRules = append(recurSet.rRules, rRule.CopyOut())
+
+	return nil
+}
+
+// After - Returns the first occurrence of the recurrence set
+// following 'dTz'. If 'inclusive' is set to 'true', an occurrence
+// equal to 'dTz' is returned.
+//
+// If no such occurrence exists, return value 'found' is set to
+// 'false'.
+//
+func (recurSet *RecurrenceSet) After(
+	dTz DateTzDto,
+	inclusive bool,
+	ePrefix string) (
+	occurrence DateTzDto,
+	found bool,
+	err error) {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.After() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err = dTzUtil.isValidDateTzDto(&dTz, ePrefix)
+
+	if err != nil {
+		return DateTzDto{}, false, err
+	}
+
+	windowStart := dTz.dateTimeValue
+
+	if !inclusive {
+		windowStart = windowStart.Add(time.Nanosecond)
+	}
+
+	recurMech := recurrenceMechanics{}
+
+	var occurrences []DateTzDto
+
+	occurrences, err = recurMech.getOccurrences(
+		recurSet,
+		windowStart,
+		true,
+		time.Time{},
+		false,
+		1,
+		ePrefix)
+
+	if err != nil || len(occurrences) == 0 {
+		return DateTzDto{}, false, err
+	}
+
+	return occurrences[0], true, nil
+}
+
+// Between - Returns all occurrences of the recurrence set falling on
+// or after 'startDTz' and on or before 'endDTz'. Occurrences are
+// returned in ascending order.
+//
+func (recurSet *RecurrenceSet) Between(
+	startDTz DateTzDto,
+	endDTz DateTzDto,
+	ePrefix string) (
+	[]DateTzDto,
+	error) {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.Between() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&startDTz, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = dTzUtil.isValidDateTzDto(&endDTz, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if endDTz.dateTimeValue.Before(startDTz.dateTimeValue) {
+		return nil,
+			errors.New(ePrefix + "\n" +
+				"Error: Input parameter 'endDTz' occurs before 'startDTz'!\n")
+	}
+
+	recurMech := recurrenceMechanics{}
+
+	return recurMech.getOccurrences(
+		recurSet,
+		startDTz.dateTimeValue,
+		true,
+		endDTz.dateTimeValue,
+		true,
+		0,
+		ePrefix)
+}
+
+// CopyOut - Returns a deep copy of the current RecurrenceSet
+// instance.
+//
+func (recurSet *RecurrenceSet) CopyOut() RecurrenceSet {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	newRecurSet := RecurrenceSet{
+		dtStart:  dTzUtil.copyOut(&recurSet.dtStart),
+		calcMode: recurSet.calcMode,
+		lock:     new(sync.Mutex),
+	}
+
+	for i := 0; i < len(recurSet.rRules); i++ {
+		newRecurSet.rRules = append(newRecurSet.rRules, recurSet.rRules[i].CopyOut())
+	}
+
+	for i := 0; i < len(recurSet.exRules); i++ {
+		newRecurSet.exRules = append(newRecurSet.exRules, recurSet.exRules[i].CopyOut())
+	}
+
+	for i := 0; i < len(recurSet.rDates); i++ {
+		newRecurSet.rDates = append(newRecurSet.rDates, dTzUtil.copyOut(&recurSet.rDates[i]))
+	}
+
+	for i := 0; i < len(recurSet.exDates); i++ {
+		newRecurSet.exDates = append(newRecurSet.exDates, dTzUtil.copyOut(&recurSet.exDates[i]))
+	}
+
+	return newRecurSet
+}
+
+// GetDtStart - Returns a copy of the start date time of the
+// recurrence set.
+//
+func (recurSet *RecurrenceSet) GetDtStart() DateTzDto {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	return dTzUtil.copyOut(&recurSet.dtStart)
+}
+
+// GetExDates - Returns a copy of the exclusion date times.
+//
+func (recurSet *RecurrenceSet) GetExDates() []DateTzDto {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	exDates := make([]DateTzDto, len(recurSet.exDates))
+
+	for i := 0; i < len(recurSet.exDates); i++ {
+		exDates[i] = dTzUtil.copyOut(&recurSet.exDates[i])
+	}
+
+	return exDates
+}
+
+// GetExRules - Returns a copy of the exclusion rules.
+//
+func (recurSet *RecurrenceSet) GetExRules() []RecurrenceRule {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	exRules := make([]RecurrenceRule, len(recurSet.exRules))
+
+	for i := 0; i < len(recurSet.exRules); i++ {
+		exRules[i] = recurSet.exRules[i].CopyOut()
+	}
+
+	return exRules
+}
+
+// GetOccurrences - Returns the first 'maxOccurrences' occurrences of
+// the recurrence set in ascending order.
+//
+// If 'maxOccurrences' is less than one, all occurrences are returned.
+// In this case, every recurrence rule must be bounded by a 'COUNT' or
+// 'UNTIL' rule part. Otherwise, an error is returned.
+//
+func (recurSet *RecurrenceSet) GetOccurrences(
+	maxOccurrences int,
+	ePrefix string) (
+	[]DateTzDto,
+	error) {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.GetOccurrences() "
+
+	recurMech := recurrenceMechanics{}
+
+	return recurMech.getOccurrences(
+		recurSet,
+		time.Time{},
+		false,
+		time.Time{},
+		false,
+		maxOccurrences,
+		ePrefix)
+}
+
+// GetRDates - Returns a copy of the recurrence date times.
+//
+func (recurSet *RecurrenceSet) GetRDates() []DateTzDto {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	dTzUtil := dateTzDtoUtility{}
+
+	rDates := make([]DateTzDto, len(recurSet.rDates))
+
+	for i := 0; i < len(recurSet.rDates); i++ {
+		rDates[i] = dTzUtil.copyOut(&recurSet.rDates[i])
+	}
+
+	return rDates
+}
+
+// GetRRules - Returns a copy of the recurrence rules.
+//
+func (recurSet *RecurrenceSet) GetRRules() []RecurrenceRule {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	rRules := make([]RecurrenceRule, len(recurSet.rRules))
+
+	for i := 0; i < len(recurSet.rRules); i++ {
+		rRules[i] = recurSet.rRules[i].CopyOut()
+	}
+
+	return rRules
+}
+
+// GetTimeMathCalcMode - Returns the Time Math Calculation Mode used
+// to expand the recurrence rules.
+//
+func (recurSet *RecurrenceSet) GetTimeMathCalcMode() TimeMathCalcMode {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	return recurSet.calcMode
+}
+
+// GetTimeZoneDef - Returns the Time Zone Definition of the
+// recurrence set. All occurrences are expressed in this time zone.
+//
+func (recurSet *RecurrenceSet) GetTimeZoneDef() TimeZoneDefinition {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	return recurSet.dtStart.timeZone.CopyOut()
+}
+
+// New - Creates and returns a new RecurrenceSet. Recurrence rules,
+// recurrence dates, exclusion rules and exclusion dates may be added
+// after the RecurrenceSet is created.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  dtStart             DateTzDto
+//     - The start date time of the recurrence set. This is the first
+//       occurrence of the recurrence set. The time zone of 'dtStart'
+//       is applied to all occurrences.
+//
+//
+//  calcMode            TimeMathCalcMode
+//     - Determines whether recurrence rules are expanded in local time
+//       or in UTC. Must be set to either TCalcMode.LocalTimeZone() or
+//       TCalcMode.UtcTimeZone(). Reference type 'RecurrenceSet' above.
+//
+//
+//  ePrefix             string
+//     - This is an error prefix which is included in all returned
+//       error messages. Usually, it contains the names of the calling
+//       method or methods.
+//
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  RecurrenceSet
+//     - If successful, this method returns a new instance of
+//       RecurrenceSet.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message. Note this error
+//       message will incorporate the method chain and text passed
+//       by input parameter, 'ePrefix'.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  rRule, err := RecurrenceRule{}.NewFromString(
+//                 "FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=10",
+//                 ePrefix)
+//
+//  recurSet, err := RecurrenceSet{}.New(
+//                    dtStart,
+//                    TCalcMode.LocalTimeZone(),
+//                    ePrefix)
+//
+//  err = recurSet.AddRRule(rRule, ePrefix)
+//
+//  occurrences, err := recurSet.GetOccurrences(0, ePrefix)
+//
+func (recurSet RecurrenceSet) New(
+	dtStart DateTzDto,
+	calcMode TimeMathCalcMode,
+	ePrefix string) (
+	RecurrenceSet,
+	error) {
+
+	if recurSet.lock == nil {
+		recurSet.lock = new(sync.Mutex)
+	}
+
+	recurSet.lock.Lock()
+
+	defer recurSet.lock.Unlock()
+
+	ePrefix += "RecurrenceSet.New() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(&dtStart, ePrefix)
+
+	if err != nil {
+		return RecurrenceSet{}, err
+	}
+
+	if calcMode != TCalcMode.LocalTimeZone() &&
+		calcMode != TCalcMode.UtcTimeZone() {
+		return RecurrenceSet{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "calcMode",
+				inputParameterValue: calcMode.String(),
+				errMsg:              "Input parameter 'calcMode' is INVALID!",
+				err:                 nil,
+			}
+	}
+
+	newRecurSet := RecurrenceSet{
+		dtStart:  dTzUtil.copyOut(&dtStart),
+		calcMode: calcMode,
+		lock:     new(sync.Mutex),
+	}
+
+	return newRecurSet, nil
+}
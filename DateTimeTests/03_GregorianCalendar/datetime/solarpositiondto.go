@@ -0,0 +1,194 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// SolarPositionDto - Contains the position of the Sun at a given
+// instant, as computed by method
+// AstronomicalCalculator.GetSolarPosition().
+//
+// Geocentric coordinates are apparent coordinates referred to the
+// true equator and equinox of date. Horizontal coordinates, azimuth
+// and elevation, are computed for the observer's latitude and
+// longitude. All angles are expressed in degrees.
+//
+//  Azimuth            - Measured clockwise from north: north = 0,
+//                       east = 90, south = 180 and west = 270.
+//
+//  Elevation          - The geometric altitude of the center of the
+//                       Sun above the horizon. Negative values
+//                       indicate the Sun is below the horizon.
+//
+//  Apparent Elevation - The elevation corrected for atmospheric
+//                       refraction.
+//
+type SolarPositionDto struct {
+	julianDayNo       JulianDayNoDto // Julian Day Number/Time of the observation (UT)
+	latitude          float64        // Observer latitude. North is positive.
+	longitude         float64        // Observer longitude. East is positive.
+	eclipticLongitude float64        // Apparent geocentric ecliptic longitude
+	rightAscension    float64        // Apparent right ascension, 0 through 360 degrees
+	declination       float64        // Apparent declination
+	equationOfTime    float64        // Equation of time in minutes
+	azimuth           float64        // Azimuth measured clockwise from north
+	elevation         float64        // Geometric elevation above the horizon
+	apparentElevation float64        // Elevation corrected for refraction
+	lock              *sync.Mutex
+}
+
+// GetApparentElevation - Returns the elevation of the center of the
+// Sun above the horizon, in degrees, corrected for atmospheric
+// refraction under standard conditions.
+//
+func (solarPos *SolarPositionDto) GetApparentElevation() float64 {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.apparentElevation
+}
+
+// GetAzimuth - Returns the azimuth of the Sun in degrees, measured
+// clockwise from north.
+//
+func (solarPos *SolarPositionDto) GetAzimuth() float64 {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.azimuth
+}
+
+// GetDeclination - Returns the apparent declination of the Sun in
+// degrees.
+//
+func (solarPos *SolarPositionDto) GetDeclination() float64 {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.declination
+}
+
+// GetEclipticLongitude - Returns the apparent geocentric ecliptic
+// longitude of the Sun in degrees.
+//
+func (solarPos *SolarPositionDto) GetEclipticLongitude() float64 {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.eclipticLongitude
+}
+
+// GetElevation - Returns the geometric elevation of the center of
+// the Sun above the horizon in degrees. This value is not corrected
+// for atmospheric refraction. Reference GetApparentElevation().
+//
+func (solarPos *SolarPositionDto) GetElevation() float64 {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.elevation
+}
+
+// GetEquationOfTime - Returns the equation of time in minutes. The
+// equation of time is the difference between apparent solar time
+// and mean solar time.
+//
+func (solarPos *SolarPositionDto) GetEquationOfTime() float64 {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.equationOfTime
+}
+
+// GetJulianDayNo - Returns the Julian Day Number/Time, on the
+// Universal Time (UT) time scale, for which the solar position was
+// computed.
+//
+func (solarPos *SolarPositionDto) GetJulianDayNo(
+	ePrefix string) (
+	JulianDayNoDto,
+	error) {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	ePrefix += "SolarPositionDto.GetJulianDayNo() "
+
+	return solarPos.julianDayNo.CopyOut(ePrefix)
+}
+
+// GetLatitudeLongitude - Returns the observer latitude and longitude
+// in degrees. Latitudes north of the equator and longitudes east of
+// Greenwich are positive.
+//
+func (solarPos *SolarPositionDto) GetLatitudeLongitude() (
+	latitude float64,
+	longitude float64) {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.latitude, solarPos.longitude
+}
+
+// GetRightAscension - Returns the apparent right ascension of the
+// Sun in degrees, within the range 0 through 360 degrees.
+//
+func (solarPos *SolarPositionDto) GetRightAscension() float64 {
+
+	if solarPos.lock == nil {
+		solarPos.lock = new(sync.Mutex)
+	}
+
+	solarPos.lock.Lock()
+
+	defer solarPos.lock.Unlock()
+
+	return solarPos.rightAscension
+}
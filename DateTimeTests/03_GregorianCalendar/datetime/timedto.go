@@ -379,6 +379,95 @@ func (tDto *TimeDto) IsValid() error {
 	return tDtoUtil.isValidDateTimeDto(tDto, ePrefix)
 }
 
+// IsValidInstance - Returns 'true' if the data fields of the current
+// TimeDto instance are valid. This method is identical in operation
+// to method TimeDto.IsValid() except that it returns a boolean value.
+//
+func (tDto *TimeDto) IsValidInstance() bool {
+
+	if tDto.lock == nil {
+		tDto.lock = new(sync.Mutex)
+	}
+
+	tDto.lock.Lock()
+
+	defer tDto.lock.Unlock()
+
+	tDtoUtil := timeDtoUtility{}
+
+	err := tDtoUtil.isValidDateTimeDto(tDto, "")
+
+	return err == nil
+}
+
+// MarshalBinary - Implements the encoding.BinaryMarshaler interface. The
+// current TimeDto instance is encoded in the versioned binary wire
+// format. The binary wire format carries the same members as the
+// JSON wire format. Reference method TimeDto.MarshalJSON().
+//
+func (tDto TimeDto) MarshalBinary() ([]byte, error) {
+
+	if tDto.lock == nil {
+		tDto.lock = new(sync.Mutex)
+	}
+
+	tDto.lock.Lock()
+
+	defer tDto.lock.Unlock()
+
+	wireMech := wireFormatMechanics{}
+
+	wire := wireMech.timeDtoToWire(&tDto)
+
+	return wireMech.marshalBinary(wireTypeCodeTimeDto, &wire), nil
+}
+
+// MarshalJSON - Implements the json.Marshaler interface. The
+// current TimeDto instance is encoded in the JSON wire format. The
+// wire format retains every time component.
+//
+func (tDto TimeDto) MarshalJSON() ([]byte, error) {
+
+	if tDto.lock == nil {
+		tDto.lock = new(sync.Mutex)
+	}
+
+	tDto.lock.Lock()
+
+	defer tDto.lock.Unlock()
+
+	ePrefix := "TimeDto.MarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := wireMech.timeDtoToWire(&tDto)
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
+// MarshalText - Implements the encoding.TextMarshaler interface. The text
+// form of a TimeDto is identical to its JSON wire format. Reference
+// method TimeDto.MarshalJSON().
+//
+func (tDto TimeDto) MarshalText() ([]byte, error) {
+
+	if tDto.lock == nil {
+		tDto.lock = new(sync.Mutex)
+	}
+
+	tDto.lock.Lock()
+
+	defer tDto.lock.Unlock()
+
+	ePrefix := "TimeDto.MarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := wireMech.timeDtoToWire(&tDto)
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
 // New - Returns a new TimeDto instance where member variables
 // are initialized to their zero values.
 //
@@ -833,3 +922,108 @@ func (tDto *TimeDto) SubTimeDto(t2Dto TimeDto) error {
 
 	return tDtoUtil.subTimeDto(tDto, &t2Dto, ePrefix)
 }
+
+// UnmarshalBinary - Implements the encoding.BinaryUnmarshaler interface. The
+// time components of the current TimeDto instance are restored from
+// 'data', which must hold the binary wire format generated by
+// TimeDto.MarshalBinary().
+//
+// Because a TimeDto may hold either date time components or duration
+// components, the restored values are NOT validated.
+//
+func (tDto *TimeDto) UnmarshalBinary(data []byte) error {
+
+	if tDto.lock == nil {
+		tDto.lock = new(sync.Mutex)
+	}
+
+	tDto.lock.Lock()
+
+	defer tDto.lock.Unlock()
+
+	ePrefix := "TimeDto.UnmarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeDtoWire{}
+
+	err := wireMech.unmarshalBinary(data, wireTypeCodeTimeDto, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	wireMech.timeDtoFromWire(tDto, &wire)
+
+	return nil
+}
+
+// UnmarshalJSON - Implements the json.Unmarshaler interface. The
+// time components of the current TimeDto instance are restored from
+// 'data', which must hold the JSON wire format generated by
+// TimeDto.MarshalJSON().
+//
+// Because a TimeDto may hold either date time components or duration
+// components, the restored values are NOT validated.
+//
+func (tDto *TimeDto) UnmarshalJSON(data []byte) error {
+
+	if tDto.lock == nil {
+		tDto.lock = new(sync.Mutex)
+	}
+
+	tDto.lock.Lock()
+
+	defer tDto.lock.Unlock()
+
+	ePrefix := "TimeDto.UnmarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	wireMech.timeDtoFromWire(tDto, &wire)
+
+	return nil
+}
+
+// UnmarshalText - Implements the encoding.TextUnmarshaler interface. The
+// time components of the current TimeDto instance are restored from
+// 'data', which must hold the text form generated by
+// TimeDto.MarshalText().
+//
+// Because a TimeDto may hold either date time components or duration
+// components, the restored values are NOT validated.
+//
+func (tDto *TimeDto) UnmarshalText(data []byte) error {
+
+	if tDto.lock == nil {
+		tDto.lock = new(sync.Mutex)
+	}
+
+	tDto.lock.Lock()
+
+	defer tDto.lock.Unlock()
+
+	ePrefix := "TimeDto.UnmarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeDtoWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	wireMech.timeDtoFromWire(tDto, &wire)
+
+	return nil
+}
@@ -0,0 +1,507 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// timeScaleMechanics - Provides helper methods used to convert date
+// times between the UTC, TAI, GPS and TT time scales. Reference type
+// 'TimeScaleType'.
+//
+// All conversions pass through International Atomic Time (TAI):
+//
+//   TAI = UTC + (TAI - UTC)   Reference type 'LeapSecondTable'
+//   GPS = TAI - 19 seconds
+//   TT  = TAI + 32.184 seconds
+//
+// Instants are expressed as a count of seconds since 1970-01-01
+// 00:00:00 on the relevant time scale, ignoring leap seconds, plus a
+// nanosecond component. For UTC, the inserted leap second, 23:59:60,
+// shares the count of 23:59:59 and is identified by a separate
+// boolean flag, 'inLeapSecond'.
+//
+type timeScaleMechanics struct {
+	lock *sync.Mutex
+}
+
+// convertADateTimeDto - Converts the date time encapsulated by an
+// ADateTimeDto instance from one time scale to another. Only the
+// Gregorian Calendar is supported.
+//
+// The returned ADateTimeDto instance is expressed with a zero UTC
+// offset in the 'UTC' time zone. If the target time scale is UTC,
+// the date and time leap second flags of the returned instance are
+// set from the current leap second table. For all other time
+// scales, the leap second flags are set to 'false'.
+//
+func (timeScaleMech *timeScaleMechanics) convertADateTimeDto(
+	aDateTimeDto *ADateTimeDto,
+	fromScale TimeScaleType,
+	toScale TimeScaleType,
+	ePrefix string) (
+	newDateTimeDto ADateTimeDto,
+	err error) {
+
+	if timeScaleMech.lock == nil {
+		timeScaleMech.lock = new(sync.Mutex)
+	}
+
+	timeScaleMech.lock.Lock()
+
+	defer timeScaleMech.lock.Unlock()
+
+	ePrefix += "timeScaleMechanics.convertADateTimeDto() "
+
+	if aDateTimeDto == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'aDateTimeDto' is a nil pointer!\n")
+		return newDateTimeDto, err
+	}
+
+	aDateTimeDtoNanobot := aDateTimeDtoNanobot{}
+
+	_,
+	err = aDateTimeDtoNanobot.testDateTransferDtoValidity(
+		aDateTimeDto,
+		ePrefix +
+			"Testing validity of input parameter 'aDateTimeDto'. ")
+
+	if err != nil {
+		return newDateTimeDto, err
+	}
+
+	calendarSystem :=
+		aDateTimeDto.date.calendarBaseData.GetCalendarSpecification()
+
+	if calendarSystem != CalendarSpec(0).Gregorian() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Time scale conversions require the Gregorian Calendar.\n" +
+			"Calendar System='%v'\n", calendarSystem.String())
+		return newDateTimeDto, err
+	}
+
+	astronomicalYear := aDateTimeDto.date.astronomicalYear
+
+	if astronomicalYear > math.MaxInt32 ||
+		astronomicalYear < math.MinInt32 {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The year value is out of range for time scale conversion.\n" +
+			"Year='%v'\n", astronomicalYear)
+		return newDateTimeDto, err
+	}
+
+	location := aDateTimeDto.time.timeZone.GetOriginalLocationPtr()
+
+	if location == nil {
+		location = time.UTC
+	}
+
+	second := aDateTimeDto.time.second
+
+	inLeapSecond := false
+
+	if second == 60 {
+		second = 59
+		inLeapSecond = true
+	}
+
+	fromDateTime := time.Date(
+		int(astronomicalYear),
+		time.Month(aDateTimeDto.date.month),
+		aDateTimeDto.date.day,
+		aDateTimeDto.time.hour,
+		aDateTimeDto.time.minute,
+		second,
+		aDateTimeDto.time.nanosecond,
+		location)
+
+	timeScaleMech2 := timeScaleMechanics{}
+
+	var toSeconds int64
+	var toNanoseconds int
+
+	toSeconds,
+	toNanoseconds,
+	inLeapSecond,
+	err = timeScaleMech2.convertTimeScale(
+		fromScale,
+		toScale,
+		fromDateTime.Unix(),
+		fromDateTime.Nanosecond(),
+		inLeapSecond,
+		ePrefix)
+
+	if err != nil {
+		return newDateTimeDto, err
+	}
+
+	toDateTime := time.Unix(toSeconds, int64(toNanoseconds)).UTC()
+
+	second = toDateTime.Second()
+
+	if inLeapSecond {
+		second = 60
+	}
+
+	newDateTimeDto, err = ADateTimeDto{}.New(
+		calendarSystem,
+		int64(toDateTime.Year()),
+		CalendarYearNumType(0).Astronomical(),
+		int(toDateTime.Month()),
+		toDateTime.Day(),
+		inLeapSecond,
+		toDateTime.Hour(),
+		toDateTime.Minute(),
+		second,
+		toDateTime.Nanosecond(),
+		TZones.Other.UTC(),
+		aDateTimeDto.dateTimeFmt,
+		aDateTimeDto.tag,
+		ePrefix)
+
+	if err != nil {
+		return ADateTimeDto{}, err
+	}
+
+	if toScale != TScale.UTC() {
+		// Leap seconds are a property of the UTC time scale
+		newDateTimeDto.date.hasLeapSecond = false
+		newDateTimeDto.time.hasLeapSecond = false
+	}
+
+	return newDateTimeDto, err
+}
+
+// convertJulianDayNoDto - Converts the Julian Day Number/Time
+// encapsulated by a JulianDayNoDto instance from one time scale to
+// another.
+//
+// Julian Dates on the UTC time scale are computed with days of
+// exactly 86,400 seconds. An instant falling within an inserted leap
+// second is expressed as the corresponding instant during the final
+// second, 23:59:59, of the UTC day.
+//
+// If the target time scale is UTC, the leap second flag of the
+// returned JulianDayNoDto is set from the current leap second table.
+// The flag signals that a leap second was inserted during the
+// Julian Day, which extends from noon to noon.
+//
+// This method does NOT lock 'jDNDto'.
+//
+func (timeScaleMech *timeScaleMechanics) convertJulianDayNoDto(
+	jDNDto *JulianDayNoDto,
+	fromScale TimeScaleType,
+	toScale TimeScaleType,
+	ePrefix string) (
+	newJDNDto JulianDayNoDto,
+	err error) {
+
+	if timeScaleMech.lock == nil {
+		timeScaleMech.lock = new(sync.Mutex)
+	}
+
+	timeScaleMech.lock.Lock()
+
+	defer timeScaleMech.lock.Unlock()
+
+	ePrefix += "timeScaleMechanics.convertJulianDayNoDto() "
+
+	jDNNanobot := julianDayNoNanobot{}
+
+	_, err = jDNNanobot.testJulianDayNoDtoValidity(
+		jDNDto,
+		ePrefix + "- Testing input parameter 'jDNDto' validity. ")
+
+	if err != nil {
+		return newJDNDto, err
+	}
+
+	var precision uint = 1024
+
+	julianDayNoTime :=
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			Copy(jDNDto.julianDayNoTime)
+
+	if jDNDto.julianDayNoNumericalSign == -1 {
+		julianDayNoTime.Neg(julianDayNoTime)
+	}
+
+	// Julian Date 2440587.5 is 1970-01-01 00:00:00
+	unixEpochJD :=
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			SetFloat64(2440587.5)
+
+	nanosecondsPerDay :=
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			SetInt64(int64(time.Hour) * 24)
+
+	totalNanoseconds :=
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			Sub(julianDayNoTime, unixEpochJD)
+
+	totalNanoseconds.Mul(totalNanoseconds, nanosecondsPerDay)
+
+	mathBFMech := MathBigFloatHelper{}
+
+	totalNanoseconds = mathBFMech.RoundHalfAwayFromZero(
+		totalNanoseconds,
+		precision,
+		0)
+
+	bigTotalNanoseconds, _ := totalNanoseconds.Int(nil)
+
+	bigSeconds, bigNanoseconds :=
+		big.NewInt(0).DivMod(
+			bigTotalNanoseconds,
+			big.NewInt(int64(time.Second)),
+			big.NewInt(0))
+
+	if !bigSeconds.IsInt64() {
+		err = errors.New(ePrefix + "\n" +
+			"Error: The Julian Day Number/Time is out of range for time scale conversion.\n")
+		return newJDNDto, err
+	}
+
+	timeScaleMech2 := timeScaleMechanics{}
+
+	var toSeconds int64
+	var toNanoseconds int
+
+	toSeconds,
+	toNanoseconds,
+	_,
+	err = timeScaleMech2.convertTimeScale(
+		fromScale,
+		toScale,
+		bigSeconds.Int64(),
+		int(bigNanoseconds.Int64()),
+		false,
+		ePrefix)
+
+	if err != nil {
+		return newJDNDto, err
+	}
+
+	bigTotalNanoseconds =
+		big.NewInt(0).Mul(
+			big.NewInt(toSeconds),
+			big.NewInt(int64(time.Second)))
+
+	bigTotalNanoseconds.Add(
+		bigTotalNanoseconds,
+		big.NewInt(int64(toNanoseconds)))
+
+	julianDayNoTime =
+		big.NewFloat(0.0).
+			SetMode(big.ToNearestAway).
+			SetPrec(precision).
+			SetInt(bigTotalNanoseconds)
+
+	julianDayNoTime.Quo(julianDayNoTime, nanosecondsPerDay)
+
+	julianDayNoTime.Add(julianDayNoTime, unixEpochJD)
+
+	jDNMech := julianDayNoDtoMechanics{}
+
+	err = jDNMech.rationalizeJulianDayNoDto(&newJDNDto, ePrefix)
+
+	if err != nil {
+		return newJDNDto, err
+	}
+
+	err = jDNMech.setBigValDto(
+		&newJDNDto,
+		julianDayNoTime,
+		precision,
+		false,
+		ePrefix)
+
+	if err != nil {
+		return JulianDayNoDto{}, err
+	}
+
+	if toScale != TScale.UTC() {
+		return newJDNDto, err
+	}
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	var leapSecTable *LeapSecondTable
+
+	leapSecTable, err = leapSecMech.getCurrentTable(ePrefix)
+
+	if err != nil {
+		return JulianDayNoDto{}, err
+	}
+
+	utcDateTime := time.Unix(toSeconds, int64(toNanoseconds)).UTC()
+
+	julianDayStart := time.Date(
+		utcDateTime.Year(),
+		utcDateTime.Month(),
+		utcDateTime.Day(),
+		12, 0, 0, 0,
+		time.UTC)
+
+	if utcDateTime.Before(julianDayStart) {
+		julianDayStart = julianDayStart.AddDate(0, 0, -1)
+	}
+
+	// Setting the flag directly preserves the time of day
+	// computed above. Reference JulianDayNoDto.SetApplyLeapSecond().
+	newJDNDto.hasLeapSecond = leapSecMech.isLeapSecondDay(
+		leapSecTable,
+		julianDayStart,
+		julianDayStart.Add(time.Hour * 24))
+
+	return newJDNDto, err
+}
+
+// convertTimeScale - Converts an instant from one time scale to
+// another. Instants are expressed as a count of seconds since
+// 1970-01-01 00:00:00 on the relevant time scale plus a nanosecond
+// component.
+//
+// Input parameter 'inLeapSecond' may only be set to 'true' when
+// 'fromScale' is UTC. Likewise, the returned 'toInLeapSecond' value
+// can only be 'true' when 'toScale' is UTC.
+//
+func (timeScaleMech *timeScaleMechanics) convertTimeScale(
+	fromScale TimeScaleType,
+	toScale TimeScaleType,
+	seconds int64,
+	nanoseconds int,
+	inLeapSecond bool,
+	ePrefix string) (
+	toSeconds int64,
+	toNanoseconds int,
+	toInLeapSecond bool,
+	err error) {
+
+	ePrefix += "timeScaleMechanics.convertTimeScale() "
+
+	if !fromScale.XIsValid() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'fromScale' is INVALID!\n" +
+			"fromScale='%v'\n", fromScale.XValueInt())
+		return toSeconds, toNanoseconds, toInLeapSecond, err
+	}
+
+	if !toScale.XIsValid() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'toScale' is INVALID!\n" +
+			"toScale='%v'\n", toScale.XValueInt())
+		return toSeconds, toNanoseconds, toInLeapSecond, err
+	}
+
+	if nanoseconds < 0 || nanoseconds >= int(time.Second) {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'nanoseconds' is INVALID!\n" +
+			"nanoseconds='%v'\n", nanoseconds)
+		return toSeconds, toNanoseconds, toInLeapSecond, err
+	}
+
+	if inLeapSecond && fromScale != TScale.UTC() {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Leap seconds only occur on the UTC time scale.\n" +
+			"fromScale='%v'\n", fromScale.String())
+		return toSeconds, toNanoseconds, toInLeapSecond, err
+	}
+
+	leapSecMech := leapSecondTableMechanics{}
+
+	var leapSecTable *LeapSecondTable
+
+	if fromScale == TScale.UTC() ||
+		toScale == TScale.UTC() {
+
+		leapSecTable, err = leapSecMech.getCurrentTable(ePrefix)
+
+		if err != nil {
+			return toSeconds, toNanoseconds, toInLeapSecond, err
+		}
+	}
+
+	// TT - TAI = 32.184 seconds
+	ttMinusTaiSeconds := int64(32)
+	ttMinusTaiNanoseconds := 184000000
+
+	// TAI - GPS = 19 seconds
+	taiMinusGpsSeconds := int64(19)
+
+	taiSeconds := seconds
+	taiNanoseconds := nanoseconds
+
+	switch fromScale {
+
+	case TScale.UTC():
+
+		taiSeconds, err = leapSecMech.utcToTai(
+			leapSecTable,
+			seconds,
+			inLeapSecond,
+			ePrefix)
+
+		if err != nil {
+			return toSeconds, toNanoseconds, toInLeapSecond, err
+		}
+
+	case TScale.GPS():
+
+		taiSeconds += taiMinusGpsSeconds
+
+	case TScale.TT():
+
+		taiSeconds -= ttMinusTaiSeconds
+		taiNanoseconds -= ttMinusTaiNanoseconds
+
+		if taiNanoseconds < 0 {
+			taiNanoseconds += int(time.Second)
+			taiSeconds--
+		}
+	}
+
+	toSeconds = taiSeconds
+	toNanoseconds = taiNanoseconds
+
+	switch toScale {
+
+	case TScale.UTC():
+
+		toSeconds,
+		toInLeapSecond,
+		err = leapSecMech.taiToUtc(
+			leapSecTable,
+			taiSeconds,
+			ePrefix)
+
+	case TScale.GPS():
+
+		toSeconds -= taiMinusGpsSeconds
+
+	case TScale.TT():
+
+		toSeconds += ttMinusTaiSeconds
+		toNanoseconds += ttMinusTaiNanoseconds
+
+		if toNanoseconds >= int(time.Second) {
+			toNanoseconds -= int(time.Second)
+			toSeconds++
+		}
+	}
+
+	return toSeconds, toNanoseconds, toInLeapSecond, err
+}
@@ -0,0 +1,340 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mTimeScaleTypeStringToCode = map[string]TimeScaleType{
+	"None" : TimeScaleType(0),
+	"UTC"  : TimeScaleType(1),
+	"TAI"  : TimeScaleType(2),
+	"GPS"  : TimeScaleType(3),
+	"TT"   : TimeScaleType(4),
+}
+
+var mTimeScaleTypeLwrCaseStringToCode = map[string]TimeScaleType{
+	"none" : TimeScaleType(0),
+	"utc"  : TimeScaleType(1),
+	"tai"  : TimeScaleType(2),
+	"gps"  : TimeScaleType(3),
+	"tt"   : TimeScaleType(4),
+}
+
+var mTimeScaleTypeCodeToString = map[TimeScaleType]string{
+	TimeScaleType(0) : "None",
+	TimeScaleType(1) : "UTC",
+	TimeScaleType(2) : "TAI",
+	TimeScaleType(3) : "GPS",
+	TimeScaleType(4) : "TT",
+}
+
+// TimeScaleType - An enumeration of the time scales supported by
+// time scale conversions. Reference type 'LeapSecondTable'.
+//
+// Since Go does not directly support enumerations, the 'TimeScaleType'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'TimeScaleType' is declared as a type 'int'. The
+// method names effectively represent an enumeration of time scales.
+// These methods are listed as follows:
+//
+//
+// None (0) - Signals that the Time Scale Type is not initialized.
+//            This is an error condition.
+//
+// UTC  (1) - Coordinated Universal Time. UTC is kept within 0.9
+//            seconds of solar time through the insertion of leap
+//            seconds.
+//
+// TAI  (2) - International Atomic Time. TAI is a continuous time
+//            scale without leap seconds.
+//              TAI = UTC + (TAI - UTC)
+//
+// GPS  (3) - Global Positioning System Time. GPS Time is a
+//            continuous time scale equal to UTC on January 6, 1980.
+//              GPS = TAI - 19 seconds
+//
+// TT   (4) - Terrestrial Time, the time scale used for astronomical
+//            ephemerides.
+//              TT = TAI + 32.184 seconds
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'TScale'. Example: TScale.TAI()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: TimeScaleType(0).TAI()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the TimeScaleType methods in alphabetical order. Be advised that all
+// 'TimeScaleType' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+// For more information on time scales, reference:
+//   https://en.wikipedia.org/wiki/International_Atomic_Time
+//   https://en.wikipedia.org/wiki/Terrestrial_Time
+//
+type TimeScaleType int
+
+var lockTimeScaleType sync.Mutex
+
+// None - Signals that the TimeScaleType is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (timeScale TimeScaleType) None() TimeScaleType {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	return TimeScaleType(0)
+}
+
+// UTC - Signals Coordinated Universal Time.
+//
+// This method is part of the standard enumeration.
+//
+func (timeScale TimeScaleType) UTC() TimeScaleType {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	return TimeScaleType(1)
+}
+
+// TAI - Signals International Atomic Time.
+//
+// This method is part of the standard enumeration.
+//
+func (timeScale TimeScaleType) TAI() TimeScaleType {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	return TimeScaleType(2)
+}
+
+// GPS - Signals Global Positioning System Time.
+//
+// This method is part of the standard enumeration.
+//
+func (timeScale TimeScaleType) GPS() TimeScaleType {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	return TimeScaleType(3)
+}
+
+// TT - Signals Terrestrial Time.
+//
+// This method is part of the standard enumeration.
+//
+func (timeScale TimeScaleType) TT() TimeScaleType {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	return TimeScaleType(4)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'TimeScaleType'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= TimeScaleType(0).TAI()
+// str := t.String()
+//     str is now equal to 'TAI'
+//
+func (timeScale TimeScaleType) String() string {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	result, ok := mTimeScaleTypeCodeToString[timeScale]
+
+	if !ok {
+		return "Error: Time Scale Type UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current TimeScaleType value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  timeScale := TimeScaleType(0).TAI()
+//
+//  isValid := timeScale.XIsValid()
+//
+func (timeScale TimeScaleType) XIsValid() bool {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	if timeScale > 4 ||
+		timeScale < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of TimeScaleType is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'tai' will NOT
+//                        match the enumeration name, 'TAI'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'tai'
+//                        will match match enumeration name 'TAI'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// TimeScaleType - Upon successful completion, this method will return
+//                 a new instance of TimeScaleType set to the value
+//                 of the enumeration matched by the string search performed
+//                 on input parameter, 'valueString'.
+//
+// error         - If this method completes successfully, the returned error
+//                 Type is set equal to 'nil'. If an error condition is
+//                 encountered, this method will return an error type which
+//                 encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := TimeScaleType(0).XParseString("TAI", true)
+//
+//     t is now equal to TimeScaleType(0).TAI()
+//
+func (timeScale TimeScaleType) XParseString(
+	valueString string,
+	caseSensitive bool) (TimeScaleType, error) {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	ePrefix := "TimeScaleType.XParseString() "
+
+	if len(valueString) < 2 {
+		return TimeScaleType(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '2'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var timeScaleType TimeScaleType
+
+	if caseSensitive {
+
+		timeScaleType, ok = mTimeScaleTypeStringToCode[valueString]
+
+	} else {
+
+		timeScaleType, ok =
+			mTimeScaleTypeLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return TimeScaleType(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid TimeScaleType Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return timeScaleType, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// TimeScaleType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (timeScale TimeScaleType) XValue() TimeScaleType {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	return timeScale
+}
+
+// XValueInt - This method returns the integer value of the current
+// TimeScaleType instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (timeScale TimeScaleType) XValueInt() int {
+
+	lockTimeScaleType.Lock()
+
+	defer lockTimeScaleType.Unlock()
+
+	return int(timeScale)
+}
+
+// TScale - public global variable of
+// type TimeScaleType.
+//
+// This variable serves as an easier, short hand
+// technique for accessing TimeScaleType values.
+//
+// Usage:
+// TScale.None(),
+// TScale.UTC(),
+// TScale.TAI(),
+// TScale.GPS(),
+// TScale.TT(),
+//
+var TScale TimeScaleType
@@ -236,10 +236,8 @@ func (timeTransDto *TimeTransferDto) CopyOut(
 
 	timeTransDtoUtil := timeTransferDtoUtility{}
 
-	newTimeTransDto := TimeTransferDto{}
-
 	return timeTransDtoUtil.copyOut(
-		&newTimeTransDto,
+		timeTransDto,
 		ePrefix)
 }
 
@@ -0,0 +1,32 @@
+package datetime
+
+import "time"
+
+// TimeZoneAbbreviationContext - Describes the preferences used to
+// resolve a time zone abbreviation which may designate several
+// time zones. For example, "IST" designates India Standard Time,
+// Irish Standard Time and Israel Standard Time.
+//
+// All fields are optional. Reference method
+// TimeZoneMechanics.ResolveTzAbbreviation().
+//
+type TimeZoneAbbreviationContext struct {
+	PreferredRegion    string    // Example: "Asia", "Europe", "North America"
+	PreferredCountry   string    // ISO 3166 alpha-2 country code. Example: "IN"
+	ReferenceDateTime  time.Time // Candidates using the abbreviation at this instant are preferred
+	CandidateTimeZones []string  // If populated, only these IANA time zones are considered
+}
+
+// TimeZoneAbbreviationCandidate - Identifies an IANA time zone which
+// may be designated by a time zone abbreviation, together with a
+// confidence value in the range 0.0 through 1.0. Candidates are
+// returned by method TimeZoneMechanics.ResolveTzAbbreviation().
+//
+type TimeZoneAbbreviationCandidate struct {
+	TimeZoneName   string                  // Example: "Asia/Kolkata"
+	Abbreviation   TimeZoneAbbreviationDto // Example: Id "IST+0530"
+	Confidence     float64                 // 0.0 through 1.0
+	IsInEffect     bool                    // Abbreviation in effect at the reference date time
+	MatchesCountry bool                    // Time zone belongs to the preferred country
+	MatchesRegion  bool                    // Time zone belongs to the preferred region
+}
@@ -0,0 +1,188 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RegisterTzAbbreviation - Registers a time zone abbreviation and
+// its associated IANA time zones at runtime. Registered abbreviations
+// are added to the standard abbreviation maps and are therefore
+// recognized by all methods which convert time zone abbreviations,
+// including TimeZoneMechanics.ConvertTzAbbreviationToTimeZone() and
+// TimeZoneMechanics.ResolveTzAbbreviation().
+//
+// If the abbreviation is already registered, the reference data is
+// replaced and the time zones in 'timeZones' are added to those
+// previously associated with the abbreviation.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  tzAbbrv     TimeZoneAbbreviationDto
+//     - Describes the time zone abbreviation. 'tzAbbrv.Abbrv' and
+//       'tzAbbrv.UtcOffset' are required. 'tzAbbrv.UtcOffset' must
+//       be formatted as a sign followed by four digits. Example:
+//       "+0530". If 'tzAbbrv.Id' is empty, it is set to the
+//       abbreviation followed by the UTC offset. Example: "IST+0530"
+//
+//  timeZones   []string
+//     - One or more IANA time zone names associated with the
+//       abbreviation. Each time zone must be loadable from the
+//       current time zone database.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  error       - If successful the returned error Type is set equal to 'nil'. If errors are
+//                encountered this error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  stdAbbrvs := StdTZoneAbbreviations{}
+//
+//  err := stdAbbrvs.RegisterTzAbbreviation(
+//            TimeZoneAbbreviationDto{
+//              Abbrv: "SGT",
+//              AbbrvDescription: "Singapore Time",
+//              Location: "Asia",
+//              UtcOffset: "+0800"},
+//            []string{"Asia/Singapore"})
+//
+func (stdTzAbbrvs *StdTZoneAbbreviations) RegisterTzAbbreviation(
+	tzAbbrv TimeZoneAbbreviationDto,
+	timeZones []string) error {
+
+	ePrefix := "StdTZoneAbbreviations.RegisterTzAbbreviation() "
+
+	tzAbbrv.Abbrv = strings.TrimSpace(tzAbbrv.Abbrv)
+
+	if len(tzAbbrv.Abbrv) == 0 ||
+		strings.ContainsAny(tzAbbrv.Abbrv, " \t") {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "tzAbbrv.Abbrv",
+			inputParameterValue: tzAbbrv.Abbrv,
+			errMsg:              "Input parameter 'tzAbbrv.Abbrv' is empty or contains spaces!",
+			err:                 nil,
+		}
+	}
+
+	utcOffset := tzAbbrv.UtcOffset
+
+	isValidOffset := len(utcOffset) == 5 &&
+		(utcOffset[0] == '+' || utcOffset[0] == '-')
+
+	for i := 1; isValidOffset && i < len(utcOffset); i++ {
+		if utcOffset[i] < '0' || utcOffset[i] > '9' {
+			isValidOffset = false
+		}
+	}
+
+	if !isValidOffset {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "tzAbbrv.UtcOffset",
+			inputParameterValue: utcOffset,
+			errMsg:              "Input parameter 'tzAbbrv.UtcOffset' must be formatted as '+HHMM' or '-HHMM'!",
+			err:                 nil,
+		}
+	}
+
+	if len(tzAbbrv.Id) == 0 {
+		tzAbbrv.Id = tzAbbrv.Abbrv + tzAbbrv.UtcOffset
+	}
+
+	if tzAbbrv.Id != tzAbbrv.Abbrv+tzAbbrv.UtcOffset {
+		return fmt.Errorf(ePrefix + "\n" +
+			"Error: 'tzAbbrv.Id' must equal the abbreviation followed by the UTC offset!\n" +
+			"tzAbbrv.Id='%v'\n" +
+			"Expected Id='%v'\n",
+			tzAbbrv.Id,
+			tzAbbrv.Abbrv+tzAbbrv.UtcOffset)
+	}
+
+	if len(timeZones) == 0 {
+		return errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'timeZones' is empty!\n")
+	}
+
+	dtMech := DTimeNanobot{}
+
+	for i := 0; i < len(timeZones); i++ {
+
+		_, err := dtMech.LoadTzLocation(timeZones[i], ePrefix)
+
+		if err != nil {
+			return fmt.Errorf(ePrefix + "\n" +
+				"Error: 'timeZones[%v]' is INVALID!\n" +
+				"timeZone='%v'\n" +
+				"Error='%v'\n",
+				i,
+				timeZones[i],
+				err.Error())
+		}
+	}
+
+	lockMapTzAbbreviationReference.Lock()
+
+	mapTzAbbreviationReference[tzAbbrv.Id] = tzAbbrv.CopyOut()
+
+	lockMapTzAbbreviationReference.Unlock()
+
+	lockMapTzAbbrvsToTimeZones.Lock()
+
+	mapTzAbbrvsToTimeZones[tzAbbrv.Id] =
+		stdTzAbbrvs.mergeStrings(mapTzAbbrvsToTimeZones[tzAbbrv.Id], timeZones)
+
+	lockMapTzAbbrvsToTimeZones.Unlock()
+
+	lockMapTimeZonesToTzAbbrvs.Lock()
+
+	for i := 0; i < len(timeZones); i++ {
+		mapTimeZonesToTzAbbrvs[timeZones[i]] =
+			stdTzAbbrvs.mergeStrings(
+				mapTimeZonesToTzAbbrvs[timeZones[i]],
+				[]string{tzAbbrv.Id})
+	}
+
+	lockMapTimeZonesToTzAbbrvs.Unlock()
+
+	return nil
+}
+
+// mergeStrings - Returns a new string array containing the elements
+// of 'existing' followed by those elements of 'additions' which are
+// not already present.
+//
+func (stdTzAbbrvs *StdTZoneAbbreviations) mergeStrings(
+	existing []string,
+	additions []string) []string {
+
+	merged := make([]string, 0, len(existing)+len(additions))
+
+	merged = append(merged, existing...)
+
+	for i := 0; i < len(additions); i++ {
+
+		isFound := false
+
+		for j := 0; j < len(merged); j++ {
+			if merged[j] == additions[i] {
+				isFound = true
+				break
+			}
+		}
+
+		if !isFound {
+			merged = append(merged, additions[i])
+		}
+	}
+
+	return merged
+}
@@ -0,0 +1,485 @@
+package datetime
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// embeddedZoneTab - The contents of the IANA 'zone.tab' file. Each
+// line maps an ISO 3166 alpha-2 country code to a time zone.
+//
+//go:embed zone.tab
+var embeddedZoneTab []byte
+
+// mapCountryCodeToTimeZones - Maps upper case ISO 3166 alpha-2
+// country codes to IANA time zone names. Populated from
+// 'embeddedZoneTab' on first use.
+var mapCountryCodeToTimeZones map[string][]string
+
+var lockMapCountryCodeToTimeZones sync.Mutex
+
+// Weights applied to the individual criteria used to compute the
+// confidence of a time zone abbreviation candidate. The weights
+// total 1.0.
+const (
+	tzAbbrvResolverTimeWeight     = 0.35
+	tzAbbrvResolverCountryWeight  = 0.30
+	tzAbbrvResolverRegionWeight   = 0.20
+	tzAbbrvResolverPriorityWeight = 0.15
+)
+
+// tzAbbrvResolverMechanics - Provides helper methods used to resolve
+// a time zone abbreviation to a ranked list of IANA time zones.
+//
+type tzAbbrvResolverMechanics struct {
+	lock *sync.Mutex
+}
+
+// getCountryTimeZones - Returns the IANA time zones associated with
+// an ISO 3166 alpha-2 country code in the embedded 'zone.tab' file.
+//
+func (tzAbbrvResMech *tzAbbrvResolverMechanics) getCountryTimeZones(
+	countryCode string) ([]string, bool) {
+
+	lockMapCountryCodeToTimeZones.Lock()
+
+	defer lockMapCountryCodeToTimeZones.Unlock()
+
+	if mapCountryCodeToTimeZones == nil {
+
+		mapCountryCodeToTimeZones = make(map[string][]string)
+
+		scanner := bufio.NewScanner(bytes.NewReader(embeddedZoneTab))
+
+		for scanner.Scan() {
+
+			line := scanner.Text()
+
+			if len(line) == 0 ||
+				line[0] == '#' {
+				continue
+			}
+
+			fields := strings.Split(line, "\t")
+
+			if len(fields) < 3 {
+				continue
+			}
+
+			mapCountryCodeToTimeZones[fields[0]] =
+				append(mapCountryCodeToTimeZones[fields[0]], fields[2])
+		}
+	}
+
+	timeZones, ok :=
+		mapCountryCodeToTimeZones[strings.ToUpper(strings.TrimSpace(countryCode))]
+
+	return timeZones, ok
+}
+
+// getLookupIds - Returns the sorted abbreviation look up ids
+// matching 'tzAbbrv'. If 'tzAbbrv' includes a UTC offset, as in
+// "IST+0530", only that look up id is returned. Otherwise, all look
+// up ids for the abbreviation are returned. Example: "IST" returns
+// "IST+0100", "IST+0200" and "IST+0530".
+//
+func (tzAbbrvResMech *tzAbbrvResolverMechanics) getLookupIds(
+	tzAbbrv string) []string {
+
+	lookupIds := make([]string, 0)
+
+	lockMapTzAbbreviationReference.Lock()
+
+	defer lockMapTzAbbreviationReference.Unlock()
+
+	if _, ok := mapTzAbbreviationReference[tzAbbrv]; ok {
+		lookupIds = append(lookupIds, tzAbbrv)
+		return lookupIds
+	}
+
+	upperAbbrv := strings.ToUpper(tzAbbrv)
+
+	for id, abbrvDto := range mapTzAbbreviationReference {
+		if strings.ToUpper(abbrvDto.Abbrv) == upperAbbrv {
+			lookupIds = append(lookupIds, id)
+		}
+	}
+
+	sort.Strings(lookupIds)
+
+	return lookupIds
+}
+
+// getPriority - Returns the index of the first entry in
+// 'tzAbbrvToTimeZonePriorityList' which is a prefix of 'timeZoneName'.
+// If no entry matches, the length of the list is returned.
+//
+func (tzAbbrvResMech *tzAbbrvResolverMechanics) getPriority(
+	timeZoneName string) int {
+
+	lockTzAbbrvToTimeZonePriorityList.Lock()
+
+	defer lockTzAbbrvToTimeZonePriorityList.Unlock()
+
+	for i := 0; i < lenTzAbbrvToTimeZonePriorityList; i++ {
+		if strings.HasPrefix(timeZoneName, tzAbbrvToTimeZonePriorityList[i]) {
+			return i
+		}
+	}
+
+	return lenTzAbbrvToTimeZonePriorityList
+}
+
+// isCountryTimeZone - Returns 'true' if 'timeZoneName' is one of the
+// time zones in 'countryTimeZones' or is a link to one of them. Links,
+// such as "Asia/Calcutta" for "Asia/Kolkata", are identified by
+// comparing TZif data in the current time zone database.
+//
+func (tzAbbrvResMech *tzAbbrvResolverMechanics) isCountryTimeZone(
+	timeZoneName string,
+	countryTimeZones []string,
+	tzDatabase *TimeZoneDatabase) bool {
+
+	for i := 0; i < len(countryTimeZones); i++ {
+		if countryTimeZones[i] == timeZoneName {
+			return true
+		}
+	}
+
+	if tzDatabase == nil {
+		return false
+	}
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	zoneData, ok := tzDatabase.zoneData[timeZoneName]
+
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < len(countryTimeZones); i++ {
+
+		countryData, ok := tzDatabase.zoneData[countryTimeZones[i]]
+
+		if ok &&
+			bytes.Equal(zoneData, countryData) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isRegionTimeZone - Returns 'true' if 'region' matches the location
+// of the abbreviation or the leading element of 'timeZoneName'.
+//
+func (tzAbbrvResMech *tzAbbrvResolverMechanics) isRegionTimeZone(
+	timeZoneName string,
+	abbrvDto TimeZoneAbbreviationDto,
+	region string) bool {
+
+	region = strings.ToLower(strings.TrimSpace(region))
+
+	if strings.ToLower(abbrvDto.Location) == region {
+		return true
+	}
+
+	return strings.HasPrefix(strings.ToLower(timeZoneName), region+"/")
+}
+
+// resolveTzAbbreviation - Returns the IANA time zones which may be
+// designated by time zone abbreviation 'tzAbbrv', ranked in order
+// of descending confidence.
+//
+// Each candidate is scored against four criteria:
+//
+//  Reference Date Time (35%) - The abbreviation is in effect in the time
+//                              zone at 'tzAbbrvContext.ReferenceDateTime'.
+//                              Candidates observing the abbreviation's UTC
+//                              offset, but not the abbreviation, receive
+//                              half credit.
+//
+//  Preferred Country   (30%) - The time zone belongs to
+//                              'tzAbbrvContext.PreferredCountry'.
+//
+//  Preferred Region    (20%) - The time zone belongs to
+//                              'tzAbbrvContext.PreferredRegion'.
+//
+//  Priority            (15%) - The rank of the time zone in the internal
+//                              priority list, 'tzAbbrvToTimeZonePriorityList'.
+//
+// Criteria not specified in 'tzAbbrvContext' award half credit to all
+// candidates.
+//
+func (tzAbbrvResMech *tzAbbrvResolverMechanics) resolveTzAbbreviation(
+	tzAbbrv string,
+	tzAbbrvContext TimeZoneAbbreviationContext,
+	ePrefix string) (
+	candidates []TimeZoneAbbreviationCandidate,
+	err error) {
+
+	if tzAbbrvResMech.lock == nil {
+		tzAbbrvResMech.lock = new(sync.Mutex)
+	}
+
+	tzAbbrvResMech.lock.Lock()
+
+	defer tzAbbrvResMech.lock.Unlock()
+
+	ePrefix += "tzAbbrvResolverMechanics.resolveTzAbbreviation() "
+
+	candidates = make([]TimeZoneAbbreviationCandidate, 0)
+
+	tzAbbrv = strings.TrimSpace(tzAbbrv)
+
+	if len(tzAbbrv) == 0 {
+		err = &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "tzAbbrv",
+			inputParameterValue: "",
+			errMsg:              "Input parameter 'tzAbbrv' is an empty string!",
+			err:                 nil,
+		}
+
+		return candidates, err
+	}
+
+	tzAbbrvResMech2 := tzAbbrvResolverMechanics{}
+
+	lookupIds := tzAbbrvResMech2.getLookupIds(tzAbbrv)
+
+	if len(lookupIds) == 0 {
+		err = &TzAbbrvMapLookupError{
+			ePrefix:  ePrefix,
+			mapName:  "mapTzAbbreviationReference",
+			lookUpId: tzAbbrv,
+			errMsg:   "",
+			err:      nil,
+		}
+
+		return candidates, err
+	}
+
+	var countryTimeZones []string
+	var tzDatabase *TimeZoneDatabase
+
+	hasCountry := len(strings.TrimSpace(tzAbbrvContext.PreferredCountry)) > 0
+
+	if hasCountry {
+
+		var ok bool
+
+		countryTimeZones, ok =
+			tzAbbrvResMech2.getCountryTimeZones(tzAbbrvContext.PreferredCountry)
+
+		if !ok {
+			err = &InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "tzAbbrvContext.PreferredCountry",
+				inputParameterValue: tzAbbrvContext.PreferredCountry,
+				errMsg:              "The preferred country code was NOT found in 'zone.tab'!",
+				err:                 nil,
+			}
+
+			return candidates, err
+		}
+
+		tzDbMech := timeZoneDatabaseMechanics{}
+
+		tzDatabase, err = tzDbMech.getCurrentDatabase(ePrefix)
+
+		if err != nil {
+			return candidates, err
+		}
+	}
+
+	hasRegion := len(strings.TrimSpace(tzAbbrvContext.PreferredRegion)) > 0
+
+	hasReferenceDateTime := !tzAbbrvContext.ReferenceDateTime.IsZero()
+
+	candidateFilter := make(map[string]bool)
+
+	for i := 0; i < len(tzAbbrvContext.CandidateTimeZones); i++ {
+		candidateFilter[tzAbbrvContext.CandidateTimeZones[i]] = true
+	}
+
+	stdAbbrvs := StdTZoneAbbreviations{}
+
+	dtMech := DTimeNanobot{}
+
+	candidateIndex := make(map[string]int)
+
+	priorities := make(map[string]int)
+
+	for i := 0; i < len(lookupIds); i++ {
+
+		abbrvDto, _ := stdAbbrvs.AbbrvOffsetToTzReference(lookupIds[i])
+
+		timeZones, _ := stdAbbrvs.AbbrvOffsetToTimeZones(lookupIds[i])
+
+		for j := 0; j < len(timeZones); j++ {
+
+			timeZoneName := timeZones[j]
+
+			if len(candidateFilter) > 0 &&
+				!candidateFilter[timeZoneName] {
+				continue
+			}
+
+			locPtr, err2 := dtMech.LoadTzLocation(timeZoneName, ePrefix)
+
+			if err2 != nil {
+				continue
+			}
+
+			candidate := TimeZoneAbbreviationCandidate{}
+			candidate.TimeZoneName = timeZoneName
+			candidate.Abbreviation = abbrvDto.CopyOut()
+
+			timeScore := 0.5
+
+			if hasReferenceDateTime {
+
+				refAbbrv, refOffsetSeconds :=
+					tzAbbrvContext.ReferenceDateTime.In(locPtr).Zone()
+
+				refUtcOffset := tzAbbrvResMech2.formatUtcOffset(refOffsetSeconds)
+
+				if refAbbrv+refUtcOffset == lookupIds[i] {
+
+					candidate.IsInEffect = true
+					timeScore = 1.0
+
+				} else if refUtcOffset == abbrvDto.UtcOffset {
+
+					timeScore = 0.5
+
+				} else {
+
+					timeScore = 0.0
+				}
+			}
+
+			countryScore := 0.5
+
+			if hasCountry {
+
+				candidate.MatchesCountry =
+					tzAbbrvResMech2.isCountryTimeZone(timeZoneName, countryTimeZones, tzDatabase)
+
+				countryScore = 0.0
+
+				if candidate.MatchesCountry {
+					countryScore = 1.0
+				}
+			}
+
+			regionScore := 0.5
+
+			if hasRegion {
+
+				candidate.MatchesRegion =
+					tzAbbrvResMech2.isRegionTimeZone(
+						timeZoneName,
+						abbrvDto,
+						tzAbbrvContext.PreferredRegion)
+
+				regionScore = 0.0
+
+				if candidate.MatchesRegion {
+					regionScore = 1.0
+				}
+			}
+
+			priority := tzAbbrvResMech2.getPriority(timeZoneName)
+
+			priorityScore := 1.0 -
+				float64(priority)/float64(lenTzAbbrvToTimeZonePriorityList)
+
+			candidate.Confidence =
+				tzAbbrvResolverTimeWeight*timeScore +
+					tzAbbrvResolverCountryWeight*countryScore +
+					tzAbbrvResolverRegionWeight*regionScore +
+					tzAbbrvResolverPriorityWeight*priorityScore
+
+			candidate.Confidence = math.Round(candidate.Confidence*10000.0) / 10000.0
+
+			idx, isDuplicate := candidateIndex[timeZoneName]
+
+			if isDuplicate {
+
+				if candidate.Confidence > candidates[idx].Confidence {
+					candidates[idx] = candidate
+				}
+
+				continue
+			}
+
+			candidateIndex[timeZoneName] = len(candidates)
+
+			priorities[timeZoneName] = priority
+
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	if len(candidates) == 0 {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: No time zones matched time zone abbreviation '%v'.\n" +
+			"Candidate Time Zones='%v'\n",
+			tzAbbrv,
+			tzAbbrvContext.CandidateTimeZones)
+
+		return candidates, err
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+
+		if candidates[i].Confidence != candidates[j].Confidence {
+			return candidates[i].Confidence > candidates[j].Confidence
+		}
+
+		priorityI := priorities[candidates[i].TimeZoneName]
+		priorityJ := priorities[candidates[j].TimeZoneName]
+
+		if priorityI != priorityJ {
+			return priorityI < priorityJ
+		}
+
+		return candidates[i].TimeZoneName < candidates[j].TimeZoneName
+	})
+
+	return candidates, err
+}
+
+// formatUtcOffset - Formats a UTC offset expressed in seconds as a
+// sign followed by hours and minutes. Example: "+0530"
+//
+func (tzAbbrvResMech *tzAbbrvResolverMechanics) formatUtcOffset(
+	offsetSeconds int) string {
+
+	sign := "+"
+
+	if offsetSeconds < 0 {
+		sign = "-"
+		offsetSeconds = -offsetSeconds
+	}
+
+	offsetMinutes := offsetSeconds / int(time.Minute/time.Second)
+
+	return fmt.Sprintf("%v%02d%02d", sign, offsetMinutes/60, offsetMinutes%60)
+}
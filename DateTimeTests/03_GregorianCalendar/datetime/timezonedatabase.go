@@ -0,0 +1,463 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+)
+
+// TimeZoneDatabase - Contains a set of TZif (Time Zone Information
+// Format) files compiled from the IANA time zone database together
+// with the tzdata release version from which they were compiled.
+//
+// By default, this package loads time zones from a copy of the IANA
+// time zone database embedded in the package. As a result, time zone
+// definitions do not depend on the zoneinfo database installed on
+// the host computer and remain consistent across machines.
+//
+// The package maintains a 'current' time zone database which is
+// used by DTimeNanobot.LoadTzLocation() and therefore by
+// TimeZoneSpecification, TimeZoneDefinition and the date time types
+// which use them. The current database is initialized from the
+// embedded data. It may be replaced by calling LoadCurrentFromDirectory()
+// or LoadCurrentFromZipFile() and restored by calling ResetCurrent().
+//
+// For more information on the IANA time zone database, reference:
+//   https://www.iana.org/time-zones
+//   https://datatracker.ietf.org/doc/html/rfc8536
+//
+type TimeZoneDatabase struct {
+	sourceName string                    // Embedded archive, directory path or zip file path
+	version    string                    // tzdata release version. Example: "2026c"
+	zoneData   map[string][]byte         // TZif file data keyed by time zone name
+	locations  map[string]*time.Location // Cache of loaded time zone locations
+	lock       *sync.Mutex
+}
+
+// CopyOut - Returns a copy of the current TimeZoneDatabase instance.
+//
+// TZif data is never modified after a database is loaded. Therefore,
+// the returned copy shares TZif data and cached locations with the
+// original instance.
+//
+func (tzDatabase *TimeZoneDatabase) CopyOut() TimeZoneDatabase {
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	newDatabase := TimeZoneDatabase{
+		sourceName: tzDatabase.sourceName,
+		version:    tzDatabase.version,
+		zoneData:   make(map[string][]byte, len(tzDatabase.zoneData)),
+		locations:  make(map[string]*time.Location, len(tzDatabase.locations)),
+		lock:       new(sync.Mutex),
+	}
+
+	for zoneName, data := range tzDatabase.zoneData {
+		newDatabase.zoneData[zoneName] = data
+	}
+
+	for zoneName, locPtr := range tzDatabase.locations {
+		newDatabase.locations[zoneName] = locPtr
+	}
+
+	return newDatabase
+}
+
+// GetCurrent - Returns a copy of the current time zone database.
+// This is the database used to load time zone locations throughout
+// this package. Unless replaced by LoadCurrentFromDirectory() or
+// LoadCurrentFromZipFile(), the current database is the IANA time
+// zone database embedded in this package.
+//
+func (tzDatabase TimeZoneDatabase) GetCurrent(
+	ePrefix string) (
+	TimeZoneDatabase,
+	error) {
+
+	ePrefix += "TimeZoneDatabase.GetCurrent() "
+
+	tzDbMech := timeZoneDatabaseMechanics{}
+
+	currentDatabase, err := tzDbMech.getCurrentDatabase(ePrefix)
+
+	if err != nil {
+		return TimeZoneDatabase{}, err
+	}
+
+	return currentDatabase.CopyOut(), nil
+}
+
+// GetSourceName - Returns the name of the source from which this
+// time zone database was loaded. For the embedded database, this
+// value is "Embedded zoneinfo.zip". Otherwise, it is the path of the
+// zoneinfo directory or zip file.
+//
+func (tzDatabase *TimeZoneDatabase) GetSourceName() string {
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	return tzDatabase.sourceName
+}
+
+// GetTimeZoneNames - Returns the names of all time zones contained
+// in this database, sorted in ascending order.
+//
+func (tzDatabase *TimeZoneDatabase) GetTimeZoneNames() []string {
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	zoneNames := make([]string, 0, len(tzDatabase.zoneData))
+
+	for zoneName := range tzDatabase.zoneData {
+		zoneNames = append(zoneNames, zoneName)
+	}
+
+	sort.Strings(zoneNames)
+
+	return zoneNames
+}
+
+// GetTzifData - Returns the parsed TZif data for the time zone
+// specified by input parameter 'timeZoneName'.
+//
+func (tzDatabase *TimeZoneDatabase) GetTzifData(
+	timeZoneName string,
+	ePrefix string) (
+	TzifDataDto,
+	error) {
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	ePrefix += "TimeZoneDatabase.GetTzifData() "
+
+	data, ok := tzDatabase.zoneData[timeZoneName]
+
+	if !ok {
+		return TzifDataDto{},
+			&TimeZoneError{
+				ePrefix: ePrefix,
+				errMsg: fmt.Sprintf("Time zone name was NOT found in the time zone database!\n"+
+					"timeZoneName='%v'\n"+
+					"Source='%v'\n", timeZoneName, tzDatabase.sourceName),
+				err: nil,
+			}
+	}
+
+	tzifMech := tzifMechanics{}
+
+	return tzifMech.parseTzifData(timeZoneName, data, ePrefix)
+}
+
+// GetVersion - Returns the tzdata release version of this time zone
+// database. Example: "2026c"
+//
+// If the version could not be determined from the source data, this
+// method returns an empty string.
+//
+func (tzDatabase *TimeZoneDatabase) GetVersion() string {
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	return tzDatabase.version
+}
+
+// HasTimeZone - Returns 'true' if the time zone specified by input
+// parameter 'timeZoneName' is contained in this database.
+//
+func (tzDatabase *TimeZoneDatabase) HasTimeZone(
+	timeZoneName string) bool {
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	_, ok := tzDatabase.zoneData[timeZoneName]
+
+	return ok
+}
+
+// LoadCurrentFromDirectory - Replaces the current time zone database
+// with a database loaded from a zoneinfo directory such as
+// '/usr/share/zoneinfo'. The current database is used to load time
+// zone locations throughout this package.
+//
+// If an error is returned, the current database is NOT changed.
+//
+func (tzDatabase TimeZoneDatabase) LoadCurrentFromDirectory(
+	dirPath string,
+	ePrefix string) error {
+
+	ePrefix += "TimeZoneDatabase.LoadCurrentFromDirectory() "
+
+	newDatabase, err := TimeZoneDatabase{}.NewFromDirectory(
+		dirPath,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lockTimeZoneDatabaseCurrent.Lock()
+
+	defer lockTimeZoneDatabaseCurrent.Unlock()
+
+	timeZoneDatabaseCurrent = &newDatabase
+
+	return nil
+}
+
+// LoadCurrentFromZipFile - Replaces the current time zone database
+// with a database loaded from a zoneinfo.zip archive. The current
+// database is used to load time zone locations throughout this
+// package.
+//
+// If an error is returned, the current database is NOT changed.
+//
+func (tzDatabase TimeZoneDatabase) LoadCurrentFromZipFile(
+	pathFileName string,
+	ePrefix string) error {
+
+	ePrefix += "TimeZoneDatabase.LoadCurrentFromZipFile() "
+
+	newDatabase, err := TimeZoneDatabase{}.NewFromZipFile(
+		pathFileName,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lockTimeZoneDatabaseCurrent.Lock()
+
+	defer lockTimeZoneDatabaseCurrent.Unlock()
+
+	timeZoneDatabaseCurrent = &newDatabase
+
+	return nil
+}
+
+// LoadLocation - Returns a location pointer (*time.Location) for the
+// time zone specified by input parameter 'timeZoneName'. The location
+// is built from the TZif data contained in this database.
+//
+// Consistent with time.LoadLocation(), the time zone name "UTC"
+// returns time.UTC and the time zone name "Local" returns time.Local.
+// Note that "Local" identifies the time zone configured on the host
+// computer.
+//
+func (tzDatabase *TimeZoneDatabase) LoadLocation(
+	timeZoneName string,
+	ePrefix string) (
+	*time.Location,
+	error) {
+
+	if tzDatabase.lock == nil {
+		tzDatabase.lock = new(sync.Mutex)
+	}
+
+	tzDatabase.lock.Lock()
+
+	defer tzDatabase.lock.Unlock()
+
+	ePrefix += "TimeZoneDatabase.LoadLocation() "
+
+	if len(timeZoneName) == 0 {
+		return nil,
+			&TimeZoneError{
+				ePrefix: ePrefix,
+				errMsg:  "Input parameter 'timeZoneName' is a empty!",
+				err:     nil,
+			}
+	}
+
+	if timeZoneName == "UTC" {
+		return time.UTC, nil
+	}
+
+	if timeZoneName == "Local" {
+		return time.Local, nil
+	}
+
+	if tzDatabase.locations == nil {
+		tzDatabase.locations = make(map[string]*time.Location)
+	}
+
+	locPtr, ok := tzDatabase.locations[timeZoneName]
+
+	if ok {
+		return locPtr, nil
+	}
+
+	data, ok := tzDatabase.zoneData[timeZoneName]
+
+	if !ok {
+		return nil,
+			&TimeZoneError{
+				ePrefix: ePrefix,
+				errMsg: fmt.Sprintf("Time zone name was NOT found in the time zone database!\n"+
+					"timeZoneName='%v'\n"+
+					"Source='%v'\n", timeZoneName, tzDatabase.sourceName),
+				err: nil,
+			}
+	}
+
+	locPtr, err := time.LoadLocationFromTZData(timeZoneName, data)
+
+	if err != nil {
+		return nil,
+			&TimeZoneError{
+				ePrefix: ePrefix,
+				errMsg: fmt.Sprintf("Error returned by time.LoadLocationFromTZData(timeZoneName)!\n"+
+					"timeZoneName='%v'\nError='%v'\n", timeZoneName, err.Error()),
+				err: err,
+			}
+	}
+
+	tzDatabase.locations[timeZoneName] = locPtr
+
+	return locPtr, nil
+}
+
+// New - Returns a new TimeZoneDatabase populated from the IANA time
+// zone database embedded in this package.
+//
+func (tzDatabase TimeZoneDatabase) New(
+	ePrefix string) (
+	TimeZoneDatabase,
+	error) {
+
+	ePrefix += "TimeZoneDatabase.New() "
+
+	tzDbMech := timeZoneDatabaseMechanics{}
+
+	return tzDbMech.newFromZipData(
+		embeddedZoneInfoZip,
+		timeZoneDatabaseEmbeddedSource,
+		ePrefix)
+}
+
+// NewFromDirectory - Returns a new TimeZoneDatabase populated from
+// the TZif files contained in a zoneinfo directory. On many Unix
+// systems, the IANA time zone database is installed in the directory:
+//   /usr/share/zoneinfo
+//
+// Time zone names are formed from the paths of the TZif files
+// relative to 'dirPath'. The tzdata version is read from a '+VERSION'
+// file or a 'tzdata.zi' file in 'dirPath'.
+//
+func (tzDatabase TimeZoneDatabase) NewFromDirectory(
+	dirPath string,
+	ePrefix string) (
+	TimeZoneDatabase,
+	error) {
+
+	ePrefix += "TimeZoneDatabase.NewFromDirectory() "
+
+	tzDbMech := timeZoneDatabaseMechanics{}
+
+	return tzDbMech.newFromDirectory(
+		dirPath,
+		ePrefix)
+}
+
+// NewFromZipFile - Returns a new TimeZoneDatabase populated from the
+// TZif files contained in a zoneinfo.zip archive. The Go distribution
+// includes such an archive at:
+//   $GOROOT/lib/time/zoneinfo.zip
+//
+// Time zone names are formed from the names of the archive entries.
+// The tzdata version is read from a '+VERSION' entry or a 'tzdata.zi'
+// entry. If neither entry exists, GetVersion() returns an empty
+// string.
+//
+func (tzDatabase TimeZoneDatabase) NewFromZipFile(
+	pathFileName string,
+	ePrefix string) (
+	TimeZoneDatabase,
+	error) {
+
+	ePrefix += "TimeZoneDatabase.NewFromZipFile() "
+
+	if len(pathFileName) == 0 {
+		return TimeZoneDatabase{},
+			errors.New(ePrefix + "\n" +
+				"Error: Input parameter 'pathFileName' is an EMPTY STRING!\n")
+	}
+
+	zipData, err := os.ReadFile(pathFileName)
+
+	if err != nil {
+		return TimeZoneDatabase{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error returned by os.ReadFile(pathFileName)\n" +
+				"pathFileName='%v'\n" +
+				"Error='%v'\n", pathFileName, err.Error())
+	}
+
+	tzDbMech := timeZoneDatabaseMechanics{}
+
+	return tzDbMech.newFromZipData(
+		zipData,
+		pathFileName,
+		ePrefix)
+}
+
+// ResetCurrent - Restores the current time zone database to the IANA
+// time zone database embedded in this package.
+//
+func (tzDatabase TimeZoneDatabase) ResetCurrent(
+	ePrefix string) error {
+
+	ePrefix += "TimeZoneDatabase.ResetCurrent() "
+
+	newDatabase, err := TimeZoneDatabase{}.New(ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lockTimeZoneDatabaseCurrent.Lock()
+
+	defer lockTimeZoneDatabaseCurrent.Unlock()
+
+	timeZoneDatabaseCurrent = &newDatabase
+
+	return nil
+}
@@ -0,0 +1,368 @@
+package datetime
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// embeddedZoneInfoZip - The IANA time zone database compiled into
+// this package. The archive contains TZif files for every IANA time
+// zone name together with a '+VERSION' entry identifying the tzdata
+// release. The archive is stored without compression.
+//
+//go:embed zoneinfo.zip
+var embeddedZoneInfoZip []byte
+
+// timeZoneDatabaseEmbeddedSource - The source name reported for the
+// time zone database embedded in this package.
+//
+const timeZoneDatabaseEmbeddedSource = "Embedded zoneinfo.zip"
+
+// timeZoneDatabaseCurrent - The time zone database used to load
+// time zone locations throughout this package. This database is
+// initialized from 'embeddedZoneInfoZip' on first use and may be
+// replaced by calling TimeZoneDatabase.LoadCurrentFromDirectory()
+// or TimeZoneDatabase.LoadCurrentFromZipFile().
+//
+var timeZoneDatabaseCurrent *TimeZoneDatabase
+
+var lockTimeZoneDatabaseCurrent sync.Mutex
+
+// timeZoneDatabaseMechanics - Provides helper methods used to build
+// time zone databases from zoneinfo directories and zoneinfo.zip
+// archives.
+//
+type timeZoneDatabaseMechanics struct {
+	lock *sync.Mutex
+}
+
+// getCurrentDatabase - Returns a pointer to the current time zone
+// database. If the current database has not yet been initialized,
+// it is loaded from the embedded zoneinfo.zip archive.
+//
+func (tzDbMech *timeZoneDatabaseMechanics) getCurrentDatabase(
+	ePrefix string) (
+	*TimeZoneDatabase,
+	error) {
+
+	lockTimeZoneDatabaseCurrent.Lock()
+
+	defer lockTimeZoneDatabaseCurrent.Unlock()
+
+	ePrefix += "timeZoneDatabaseMechanics.getCurrentDatabase() "
+
+	if timeZoneDatabaseCurrent != nil {
+		return timeZoneDatabaseCurrent, nil
+	}
+
+	tzDbMech2 := timeZoneDatabaseMechanics{}
+
+	newDatabase, err := tzDbMech2.newFromZipData(
+		embeddedZoneInfoZip,
+		timeZoneDatabaseEmbeddedSource,
+		ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	timeZoneDatabaseCurrent = &newDatabase
+
+	return timeZoneDatabaseCurrent, nil
+}
+
+// getVersionFromData - Extracts a tzdata release version from the
+// contents of a '+VERSION' file or a 'tzdata.zi' file. If a version
+// cannot be identified, an empty string is returned.
+//
+//  '+VERSION'  - The first line contains the version. Example: "2026c"
+//
+//  'tzdata.zi' - The first line has the form, "# version 2026c".
+//
+func (tzDbMech *timeZoneDatabaseMechanics) getVersionFromData(
+	fileName string,
+	data []byte) string {
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+
+	if !scanner.Scan() {
+		return ""
+	}
+
+	firstLine := strings.TrimSpace(scanner.Text())
+
+	if fileName == "+VERSION" {
+		return firstLine
+	}
+
+	if strings.HasPrefix(firstLine, "# version ") {
+		return strings.TrimSpace(firstLine[len("# version "):])
+	}
+
+	return ""
+}
+
+// newFromDirectory - Builds a new time zone database from the TZif
+// files contained in a zoneinfo directory such as
+// '/usr/share/zoneinfo'. Time zone names are formed from the paths
+// of the TZif files relative to 'dirPath'. Files which are not TZif
+// files are ignored.
+//
+// The tzdata version is read from a '+VERSION' file or a
+// 'tzdata.zi' file located in 'dirPath'.
+//
+func (tzDbMech *timeZoneDatabaseMechanics) newFromDirectory(
+	dirPath string,
+	ePrefix string) (
+	TimeZoneDatabase,
+	error) {
+
+	if tzDbMech.lock == nil {
+		tzDbMech.lock = new(sync.Mutex)
+	}
+
+	tzDbMech.lock.Lock()
+
+	defer tzDbMech.lock.Unlock()
+
+	ePrefix += "timeZoneDatabaseMechanics.newFromDirectory() "
+
+	if len(dirPath) == 0 {
+		return TimeZoneDatabase{},
+			errors.New(ePrefix + "\n" +
+				"Error: Input parameter 'dirPath' is an EMPTY STRING!\n")
+	}
+
+	dirInfo, err := os.Stat(dirPath)
+
+	if err != nil {
+		return TimeZoneDatabase{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error returned by os.Stat(dirPath)\n" +
+				"dirPath='%v'\n" +
+				"Error='%v'\n", dirPath, err.Error())
+	}
+
+	if !dirInfo.IsDir() {
+		return TimeZoneDatabase{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error: Input parameter 'dirPath' is NOT a directory!\n" +
+				"dirPath='%v'\n", dirPath)
+	}
+
+	newDatabase := TimeZoneDatabase{
+		sourceName: dirPath,
+		zoneData:   make(map[string][]byte),
+		locations:  make(map[string]*time.Location),
+		lock:       new(sync.Mutex),
+	}
+
+	tzifMech := tzifMechanics{}
+
+	err = filepath.WalkDir(
+		dirPath,
+		func(pathName string, dirEntry fs.DirEntry, walkErr error) error {
+
+			if walkErr != nil {
+				return walkErr
+			}
+
+			if dirEntry.IsDir() {
+				return nil
+			}
+
+			fileInfo, err2 := os.Stat(pathName)
+
+			if err2 != nil {
+				// Skip broken symbolic links
+				return nil
+			}
+
+			if !fileInfo.Mode().IsRegular() {
+				return nil
+			}
+
+			relPath, err2 := filepath.Rel(dirPath, pathName)
+
+			if err2 != nil {
+				return err2
+			}
+
+			zoneName := filepath.ToSlash(relPath)
+
+			data, err2 := os.ReadFile(pathName)
+
+			if err2 != nil {
+				return err2
+			}
+
+			if zoneName == "+VERSION" ||
+				zoneName == "tzdata.zi" {
+
+				if len(newDatabase.version) == 0 {
+					newDatabase.version =
+						tzDbMech.getVersionFromData(zoneName, data)
+				}
+
+				return nil
+			}
+
+			if !tzifMech.isTzifData(data) {
+				return nil
+			}
+
+			_, err2 = tzifMech.parseTzifData(zoneName, data, ePrefix)
+
+			if err2 != nil {
+				return err2
+			}
+
+			newDatabase.zoneData[zoneName] = data
+
+			return nil
+		})
+
+	if err != nil {
+		return TimeZoneDatabase{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error loading zoneinfo directory.\n" +
+				"dirPath='%v'\n" +
+				"Error='%v'\n", dirPath, err.Error())
+	}
+
+	if len(newDatabase.zoneData) == 0 {
+		return TimeZoneDatabase{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error: The zoneinfo directory does NOT contain any TZif files!\n" +
+				"dirPath='%v'\n", dirPath)
+	}
+
+	return newDatabase, nil
+}
+
+// newFromZipData - Builds a new time zone database from the TZif
+// files contained in a zoneinfo.zip archive. Time zone names are
+// formed from the names of the archive entries. Entries which are
+// not TZif files are ignored.
+//
+// The tzdata version is read from a '+VERSION' entry or a
+// 'tzdata.zi' entry.
+//
+func (tzDbMech *timeZoneDatabaseMechanics) newFromZipData(
+	zipData []byte,
+	sourceName string,
+	ePrefix string) (
+	TimeZoneDatabase,
+	error) {
+
+	if tzDbMech.lock == nil {
+		tzDbMech.lock = new(sync.Mutex)
+	}
+
+	tzDbMech.lock.Lock()
+
+	defer tzDbMech.lock.Unlock()
+
+	ePrefix += "timeZoneDatabaseMechanics.newFromZipData() "
+
+	zipReader, err := zip.NewReader(
+		bytes.NewReader(zipData),
+		int64(len(zipData)))
+
+	if err != nil {
+		return TimeZoneDatabase{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error returned by zip.NewReader()\n" +
+				"sourceName='%v'\n" +
+				"Error='%v'\n", sourceName, err.Error())
+	}
+
+	newDatabase := TimeZoneDatabase{
+		sourceName: sourceName,
+		zoneData:   make(map[string][]byte),
+		locations:  make(map[string]*time.Location),
+		lock:       new(sync.Mutex),
+	}
+
+	tzifMech := tzifMechanics{}
+
+	for _, zipFile := range zipReader.File {
+
+		if zipFile.FileInfo().IsDir() {
+			continue
+		}
+
+		zoneName := strings.TrimPrefix(zipFile.Name, "zoneinfo/")
+
+		var fileReader io.ReadCloser
+
+		fileReader, err = zipFile.Open()
+
+		if err != nil {
+			return TimeZoneDatabase{},
+				fmt.Errorf(ePrefix + "\n" +
+					"Error opening zip archive entry.\n" +
+					"sourceName='%v'\n" +
+					"Entry='%v'\n" +
+					"Error='%v'\n", sourceName, zipFile.Name, err.Error())
+		}
+
+		var data []byte
+
+		data, err = io.ReadAll(fileReader)
+
+		_ = fileReader.Close()
+
+		if err != nil {
+			return TimeZoneDatabase{},
+				fmt.Errorf(ePrefix + "\n" +
+					"Error reading zip archive entry.\n" +
+					"sourceName='%v'\n" +
+					"Entry='%v'\n" +
+					"Error='%v'\n", sourceName, zipFile.Name, err.Error())
+		}
+
+		if zoneName == "+VERSION" ||
+			zoneName == "tzdata.zi" {
+
+			if len(newDatabase.version) == 0 {
+				newDatabase.version =
+					tzDbMech.getVersionFromData(zoneName, data)
+			}
+
+			continue
+		}
+
+		if !tzifMech.isTzifData(data) {
+			continue
+		}
+
+		_, err = tzifMech.parseTzifData(zoneName, data, ePrefix)
+
+		if err != nil {
+			return TimeZoneDatabase{}, err
+		}
+
+		newDatabase.zoneData[zoneName] = data
+	}
+
+	if len(newDatabase.zoneData) == 0 {
+		return TimeZoneDatabase{},
+			fmt.Errorf(ePrefix + "\n" +
+				"Error: The zip archive does NOT contain any TZif files!\n" +
+				"sourceName='%v'\n", sourceName)
+	}
+
+	return newDatabase, nil
+}
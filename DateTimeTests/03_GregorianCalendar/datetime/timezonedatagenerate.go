@@ -0,0 +1,32 @@
+package datetime
+
+// The IANA time zone catalog in source file 'timezonedata.go' (type
+// 'TimeZones') and the time zone abbreviation maps in source file
+// 'timezoneabbreviations.go' are generated from the IANA time zone
+// database source files by the tool located in sub-directory
+// 'tzdatagen'. Neither file should be edited by hand.
+//
+// To update these files to a new IANA time zone database release:
+//
+//  1. Download the release source archive, 'tzdataYYYYx.tar.gz', from
+//        https://data.iana.org/time-zones/releases/
+//
+//  2. Unpack the archive into directory 'tzdata' located in this
+//     package directory. The generator reads 'zone1970.tab',
+//     'backward', 'version' and the region files 'africa',
+//     'antarctica', 'asia', 'australasia', 'etcetera', 'europe',
+//     'factory', 'northamerica' and 'southamerica'.
+//
+//  3. From this package directory run:
+//        go generate
+//
+// Time zone abbreviation descriptions and locations are not part of
+// the IANA source files. Descriptions and locations of abbreviations
+// which already exist in 'timezoneabbreviations.go' are retained.
+//
+// Note that the embedded time zone database, 'zoneinfo.zip', is NOT
+// updated by 'go generate'. It should be replaced with a 'zoneinfo.zip'
+// built from the same release. Reference type 'TimeZoneDatabase'.
+//
+
+//go:generate go run ./tzdatagen -tzdata tzdata -out .
@@ -1590,6 +1590,62 @@ func (tzdef *TimeZoneDefinition) GetMilitaryTimeZoneName() (string, error) {
 	return tzdef.originalTimeZone.militaryTimeZoneName, nil
 }
 
+// IsAmbiguousLocalTime - Returns 'true' if the local date time
+// specified by the input parameter time components occurs twice
+// in the time zone designated by 'timeZoneName'.
+//
+// Ambiguous local times occur when clocks are set back, as at the
+// end of Daylight Saving Time. For example, in 'America/Chicago' on
+// November 7, 2021, the local time 01:30:00 occurred once in CDT
+// and once again in CST.
+//
+// Input parameter 'timeZoneName' must be set to a valid IANA Time
+// Zone name, the time zone "Local" or a valid Military Time Zone.
+//
+// Usage
+//
+//  isAmbiguous, err := TimeZoneDefinition{}.IsAmbiguousLocalTime(
+//                        2021, 11, 7, 1, 30, 0, 0,
+//                        TZones.America.Chicago())
+//
+//      isAmbiguous is now equal to 'true'
+//
+func (tzdef TimeZoneDefinition) IsAmbiguousLocalTime(
+	years,
+	months,
+	days,
+	hours,
+	minutes,
+	seconds,
+	nanoseconds int,
+	timeZoneName string) (bool, error) {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.IsAmbiguousLocalTime() "
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	isAmbiguous, _, err := tzDstMech.testLocalDateTime(
+		years,
+		months,
+		days,
+		hours,
+		minutes,
+		seconds,
+		nanoseconds,
+		timeZoneName,
+		ePrefix)
+
+	return isAmbiguous, err
+}
+
 // IsEmpty - Determines whether the current TimeZoneDefinition
 // instance is Empty.
 //
@@ -1612,6 +1668,62 @@ func (tzdef *TimeZoneDefinition) IsEmpty() bool {
 
 }
 
+// IsNonexistentLocalTime - Returns 'true' if the local date time
+// specified by the input parameter time components never occurs
+// in the time zone designated by 'timeZoneName'.
+//
+// Nonexistent local times occur when clocks are set forward, as at
+// the start of Daylight Saving Time. For example, in 'America/Chicago'
+// on March 14, 2021, clocks advanced from 02:00:00 CST to 03:00:00
+// CDT. Consequently, the local time 02:30:00 never occurred.
+//
+// Input parameter 'timeZoneName' must be set to a valid IANA Time
+// Zone name, the time zone "Local" or a valid Military Time Zone.
+//
+// Usage
+//
+//  isNonexistent, err := TimeZoneDefinition{}.IsNonexistentLocalTime(
+//                          2021, 3, 14, 2, 30, 0, 0,
+//                          TZones.America.Chicago())
+//
+//      isNonexistent is now equal to 'true'
+//
+func (tzdef TimeZoneDefinition) IsNonexistentLocalTime(
+	years,
+	months,
+	days,
+	hours,
+	minutes,
+	seconds,
+	nanoseconds int,
+	timeZoneName string) (bool, error) {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.IsNonexistentLocalTime() "
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	_, isNonexistent, err := tzDstMech.testLocalDateTime(
+		years,
+		months,
+		days,
+		hours,
+		minutes,
+		seconds,
+		nanoseconds,
+		timeZoneName,
+		ePrefix)
+
+	return isNonexistent, err
+}
+
 // IsValid - Analyzes the current TimeZoneDefinition instance
 // to determine validity.
 //
@@ -1635,6 +1747,27 @@ func (tzdef *TimeZoneDefinition) IsValid() error {
 	return tzDefUtil.isValidTimeZoneDef(tzdef, ePrefix)
 }
 
+// IsValidInstance - Returns 'true' if the data fields of the current
+// TimeZoneDefinition instance are valid. This method is identical in operation
+// to method TimeZoneDefinition.IsValid() except that it returns a boolean value.
+//
+func (tzdef *TimeZoneDefinition) IsValidInstance() bool {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	tzDefUtil := timeZoneDefUtility{}
+
+	err := tzDefUtil.isValidTimeZoneDef(tzdef, "")
+
+	return err == nil
+}
+
 // IsValidInstanceError - Tests the current instance of
 // TimeZoneDefinition for validity. If the instance is
 // invalid, an error is returned.
@@ -1666,6 +1799,92 @@ func (tzdef *TimeZoneDefinition) IsValidInstanceError(
 
 }
 
+// MarshalBinary - Implements the encoding.BinaryMarshaler interface. The
+// current TimeZoneDefinition instance is encoded in the versioned binary wire
+// format. The binary wire format carries the same members as the
+// JSON wire format. Reference method TimeZoneDefinition.MarshalJSON().
+//
+func (tzdef TimeZoneDefinition) MarshalBinary() ([]byte, error) {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.MarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.timeZoneDefToWire(&tzdef, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalBinary(wireTypeCodeTimeZoneDef, &wire), nil
+}
+
+// MarshalJSON - Implements the json.Marshaler interface. The
+// current TimeZoneDefinition instance is encoded in the JSON wire format. The
+// wire format retains both the original and the convertible time zone
+// specifications.
+//
+// An invalid TimeZoneDefinition instance cannot be marshaled and will
+// generate an error.
+//
+func (tzdef TimeZoneDefinition) MarshalJSON() ([]byte, error) {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.MarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.timeZoneDefToWire(&tzdef, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
+// MarshalText - Implements the encoding.TextMarshaler interface. The text
+// form of a TimeZoneDefinition is identical to its JSON wire format. Reference
+// method TimeZoneDefinition.MarshalJSON().
+//
+func (tzdef TimeZoneDefinition) MarshalText() ([]byte, error) {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.MarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.timeZoneDefToWire(&tzdef, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
 // New - Returns a new TimeZoneDefinition instance with
 // member variables initialized to zero values.
 //
@@ -1747,6 +1966,10 @@ func (tzdef TimeZoneDefinition) NewDateTime(
 //
 // The date time components, years, months, days, hours, seconds and subMicrosecondNanoseconds are used
 // in the Time Zone Name to construct an instance of 'TimeZoneDefinition'.
+//
+// Local times which are ambiguous or nonexistent in the designated
+// time zone are resolved by the Go time package, time.Date(). To
+// control this resolution, use method NewFromTimeComponentsWithDstPolicy().
 
 // Input Parameter
 // ===============
@@ -1842,10 +2065,153 @@ func (tzdef TimeZoneDefinition) NewFromTimeComponents(
 
 	tzDef2 := TimeZoneDefinition{}
 
-	err = tzDefUtil.setFromTimeDto(
+	err = tzDefUtil.setFromLocalTimeDto(
+		&tzDef2,
+		timeDto,
+		timeZoneName,
+		TzDstPolicy.None(),
+		ePrefix)
+
+	if err != nil {
+		return TimeZoneDefinition{}, err
+	}
+
+	return tzDef2, nil
+}
+
+// NewFromTimeComponentsWithDstPolicy - Creates and returns a new 'TimeZoneDefinition' instance based on
+// date time components and Time Zone Name input parameters.
+//
+// The date time components, years, months, days, hours, seconds and subMicrosecondNanoseconds are used
+// in the Time Zone Name to construct an instance of 'TimeZoneDefinition'.
+
+// Input Parameter
+// ===============
+//
+//  years       int        - Years value used to construct a date time object (time.Time).
+//
+//  months      int        - Months value used to construct a date time object (time.Time).
+//
+//  days        int        - Days value used to construct a date time object (time.Time).
+//
+//  hours       int        - Hours value used to construct a date time object (time.Time).
+//
+//  minutes     int        - Minutes value used to construct a date time object (time.Time).
+//
+//  seconds     int        - Seconds value used to construct a date time object (time.Time).
+//
+//  subMicrosecondNanoseconds int        - Nanoseconds value used to construct a date time object (time.Time).
+//
+//  timeZoneName string    - This string contains the name of a valid time zone.
+//                           The 'timeZoneName' string must be set to one of three values:
+//
+//                           1. A valid IANA Time Zone name.
+//
+//                           2. The time zone "Local", which Golang accepts as the time
+//                              zone currently configured on the host computer.
+//
+//                           3. A valid Military Time Zone which can be submitted either as
+//                              a single alphabetic character Military Time Zone abbreviation
+//                              or as a full Military Time Zone name.
+//
+//  dstPolicy   TimeZoneDstPolicy
+//                         - Determines how local times which are ambiguous or
+//                           nonexistent in time zone 'timeZoneName' are resolved.
+//                           Valid values are:
+//
+//                             TzDstPolicy.Earlier()
+//                             TzDstPolicy.Later()
+//                             TzDstPolicy.Reject()
+//                             TzDstPolicy.ShiftForward()
+//
+//                           If TzDstPolicy.Reject() is specified, ambiguous local
+//                           times generate an error of type *TimeZoneAmbiguousTimeError
+//                           and nonexistent local times generate an error of type
+//                           *TimeZoneNonexistentTimeError.
+//
+//                           TzDstPolicy.None() is INVALID and generates an error.
+//
+// Return Values
+// =============
+//
+// This method will return two values:
+//      (1) A Time Zone Definition (TimeZoneDefinition)
+//      (2) An 'error' type
+//
+//  (1) If successful, this method will return a valid, populated TimeZoneDefinition
+//      instance.
+//
+//  (2) If successful, this method will set the returned 'error' instance to 'nil'.
+//      If errors are encountered a valid error message will be returned in the
+//      error instance.
+//
+func (tzdef TimeZoneDefinition) NewFromTimeComponentsWithDstPolicy(
+	years,
+	months,
+	days,
+	hours,
+	minutes,
+	seconds,
+	nanoseconds int,
+	timeZoneName string,
+	dstPolicy TimeZoneDstPolicy) (TimeZoneDefinition, error) {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.NewFromTimeComponentsWithDstPolicy() "
+
+	if !dstPolicy.XIsValid() {
+		return TimeZoneDefinition{}, &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "dstPolicy",
+			inputParameterValue: dstPolicy.String(),
+			errMsg:              "Input parameter 'dstPolicy' is INVALID!",
+			err:                 nil,
+		}
+	}
+
+	timeDto, err := TimeDto{}.NewTimeElements(
+		years,
+		months,
+		days,
+		hours,
+		minutes,
+		seconds,
+		nanoseconds)
+
+	if err != nil {
+		return TimeZoneDefinition{},
+				fmt.Errorf(ePrefix +
+					"\nError retunred by TimeDto{}.NewTimeElements(...)\n" +
+					"Error='%v'\n", err.Error())
+	}
+
+	if len(timeZoneName) == 0 {
+		return TimeZoneDefinition{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "timeZoneName",
+				inputParameterValue: "",
+				errMsg:              "Input parameter 'timeZoneName' is an EMPTY string!",
+				err:                 nil,
+			}
+	}
+
+	tzDefUtil := timeZoneDefUtility{}
+
+	tzDef2 := TimeZoneDefinition{}
+
+	err = tzDefUtil.setFromLocalTimeDto(
 		&tzDef2,
 		timeDto,
 		timeZoneName,
+		dstPolicy,
 		ePrefix)
 
 	if err != nil {
@@ -2754,4 +3120,100 @@ func (tzdef *TimeZoneDefinition) SetFromTimeZoneSpec(
 		tzSpec,
 		ePrefix)
 
-}
\ No newline at end of file
+}
+
+// UnmarshalBinary - Implements the encoding.BinaryUnmarshaler interface. The
+// current TimeZoneDefinition instance is rebuilt from 'data', which must hold
+// the binary wire format generated by TimeZoneDefinition.MarshalBinary().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (tzdef *TimeZoneDefinition) UnmarshalBinary(data []byte) error {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.UnmarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeZoneDefWire{}
+
+	err := wireMech.unmarshalBinary(data, wireTypeCodeTimeZoneDef, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.timeZoneDefFromWire(tzdef, &wire, ePrefix)
+}
+
+// UnmarshalJSON - Implements the json.Unmarshaler interface. The
+// current TimeZoneDefinition instance is rebuilt from 'data', which must hold
+// the JSON wire format generated by TimeZoneDefinition.MarshalJSON().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (tzdef *TimeZoneDefinition) UnmarshalJSON(data []byte) error {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.UnmarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeZoneDefWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.timeZoneDefFromWire(tzdef, &wire, ePrefix)
+}
+
+// UnmarshalText - Implements the encoding.TextUnmarshaler interface. The
+// current TimeZoneDefinition instance is rebuilt from 'data', which must hold
+// the text form generated by TimeZoneDefinition.MarshalText().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (tzdef *TimeZoneDefinition) UnmarshalText(data []byte) error {
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	tzdef.lock.Lock()
+
+	defer tzdef.lock.Unlock()
+
+	ePrefix := "TimeZoneDefinition.UnmarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeZoneDefWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.timeZoneDefFromWire(tzdef, &wire, ePrefix)
+}
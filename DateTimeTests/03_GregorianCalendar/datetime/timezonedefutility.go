@@ -530,6 +530,99 @@ func (tzDefUtil *timeZoneDefUtility) newFromTimeZoneName(
 }
 
 
+// setFromLocalTimeDto - Re-initializes the values of a
+// 'TimeZoneDefinition' instance based on local time components
+// passed through input parameter 'TimeDto' ('tDto').
+//
+// Local times which are ambiguous or nonexistent in time zone
+// 'timeZoneName' are resolved according to input parameter
+// 'dstPolicy'. If 'dstPolicy' is set to TzDstPolicy.None(), the
+// local time is resolved by method setFromTimeDto().
+//
+func (tzDefUtil *timeZoneDefUtility) setFromLocalTimeDto(
+	tzdef *TimeZoneDefinition,
+	tDto TimeDto,
+	timeZoneName string,
+	dstPolicy TimeZoneDstPolicy,
+	ePrefix string) error {
+
+	ePrefix += "timeZoneDefUtility.setFromLocalTimeDto() "
+
+	if tzdef == nil {
+		return &InputParameterError{
+			ePrefix:             ePrefix,
+			inputParameterName:  "tzdef",
+			inputParameterValue: "",
+			errMsg:              "Input parameter 'tzdef' pointer is nil!",
+			err:                 nil,
+		}
+	}
+
+	if tzdef.lock == nil {
+		tzdef.lock = new(sync.Mutex)
+	}
+
+	if dstPolicy == TzDstPolicy.None() {
+
+		tzDefUtil2 := timeZoneDefUtility{}
+
+		return tzDefUtil2.setFromTimeDto(
+			tzdef,
+			tDto,
+			timeZoneName,
+			ePrefix)
+	}
+
+	tDto2 := tDto.CopyOut()
+
+	err := tDto2.NormalizeTimeElements()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+
+			"\nError returned by tDto2.NormalizeTimeElements().\nError='%v'\n",
+			err.Error())
+	}
+
+	tDto2.ConvertToAbsoluteValues()
+
+	err = tDto2.IsValid()
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+
+			"\nError: Input Parameter tDto (TimeDto) is INVALID.\nError='%v'\n",
+			err.Error())
+	}
+
+	tzDstMech := timeZoneDstMechanics{}
+
+	var dateTime time.Time
+
+	dateTime, err = tzDstMech.resolveLocalDateTime(
+		tDto2.Years,
+		tDto2.Months,
+		tDto2.DateDays,
+		tDto2.Hours,
+		tDto2.Minutes,
+		tDto2.Seconds,
+		tDto2.TotSubSecNanoseconds,
+		timeZoneName,
+		dstPolicy,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	tzDefUtil2 := timeZoneDefUtility{}
+
+	return tzDefUtil2.setFromTimeZoneName(
+		tzdef,
+		dateTime,
+		TzConvertType.Relative(),
+		timeZoneName,
+		ePrefix)
+}
+
 // SetFromDateTimeComponents - Re-initializes the values of a
 // 'TimeZoneDefinition' instance based on time components (i.e.
 // years, months, days, hours, minutes, seconds and subMicrosecondNanoseconds)
@@ -0,0 +1,403 @@
+package datetime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// timeZoneDstMechanics - Provides helper methods used to convert
+// local date time components to an instant in a time zone location.
+//
+// Local date times falling within the gap created when clocks are
+// set forward are 'nonexistent'. Local date times falling within the
+// overlap created when clocks are set back are 'ambiguous'. These
+// local date times are resolved according to a policy of type
+// TimeZoneDstPolicy.
+//
+type timeZoneDstMechanics struct {
+	lock *sync.Mutex
+}
+
+// classifyLocalDateTime - Analyzes 'localDateTime' and determines
+// whether it is a valid, ambiguous or nonexistent local date time
+// in time zone location 'locPtr'. Only the date time components of
+// 'localDateTime' are evaluated. The time zone of 'localDateTime'
+// is ignored.
+//
+// Each UTC offset in effect within 36-hours of 'localDateTime' is
+// applied to the date time components. An offset which remains in
+// effect at the resulting instant produces a valid interpretation.
+// Two valid interpretations signal an ambiguous local date time. No
+// valid interpretation signals a nonexistent local date time.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  earlier          time.Time
+//     - For ambiguous local date times, the first of the two
+//       instants. For nonexistent local date times, the instant
+//       computed with the UTC offset in effect after the gap.
+//       Otherwise, the single instant matching the local date
+//       time.
+//
+//  later            time.Time
+//     - For ambiguous local date times, the second of the two
+//       instants. For nonexistent local date times, the instant
+//       computed with the UTC offset in effect before the gap.
+//       Otherwise, the single instant matching the local date
+//       time.
+//
+//  isAmbiguous      bool
+//     - Set to 'true' if the local date time occurs twice.
+//
+//  isNonexistent    bool
+//     - Set to 'true' if the local date time does not occur.
+//
+func (tzDstMech *timeZoneDstMechanics) classifyLocalDateTime(
+	localDateTime time.Time,
+	locPtr *time.Location) (
+	earlier time.Time,
+	later time.Time,
+	isAmbiguous bool,
+	isNonexistent bool) {
+
+	naiveUtc := time.Date(
+		localDateTime.Year(),
+		localDateTime.Month(),
+		localDateTime.Day(),
+		localDateTime.Hour(),
+		localDateTime.Minute(),
+		localDateTime.Second(),
+		localDateTime.Nanosecond(),
+		time.UTC)
+
+	_, offsetBefore := naiveUtc.Add(-36 * time.Hour).In(locPtr).Zone()
+
+	_, offsetCurrent := naiveUtc.In(locPtr).Zone()
+
+	_, offsetAfter := naiveUtc.Add(36 * time.Hour).In(locPtr).Zone()
+
+	candidates := make([]time.Time, 0, 2)
+
+	for _, offset := range []int{offsetBefore, offsetCurrent, offsetAfter} {
+
+		instant := naiveUtc.Add(time.Duration(-offset) * time.Second).In(locPtr)
+
+		_, instantOffset := instant.Zone()
+
+		if instantOffset != offset {
+			continue
+		}
+
+		isDuplicate := false
+
+		for i := 0; i < len(candidates); i++ {
+			if candidates[i].Equal(instant) {
+				isDuplicate = true
+				break
+			}
+		}
+
+		if !isDuplicate {
+			candidates = append(candidates, instant)
+		}
+	}
+
+	switch len(candidates) {
+
+	case 0:
+		// Clocks were set forward. 'offsetAfter' exceeds
+		// 'offsetBefore'.
+		earlier = naiveUtc.Add(time.Duration(-offsetAfter) * time.Second).In(locPtr)
+		later = naiveUtc.Add(time.Duration(-offsetBefore) * time.Second).In(locPtr)
+		isNonexistent = true
+
+	case 1:
+		earlier = candidates[0]
+		later = candidates[0]
+
+	default:
+		earlier = candidates[0]
+		later = candidates[len(candidates)-1]
+
+		if later.Before(earlier) {
+			earlier, later = later, earlier
+		}
+
+		isAmbiguous = true
+	}
+
+	return earlier, later, isAmbiguous, isNonexistent
+}
+
+// getGapBounds - Receives the instant returned as 'earlier' by
+// classifyLocalDateTime() for a nonexistent local date time and
+// returns the bounds of the gap containing that local date time.
+//
+// Both return values identify the transition instant. 'gapStart'
+// is expressed in the UTC offset in effect before the gap, and
+// therefore displays the first skipped local time. 'gapEnd' is
+// expressed in time zone location 'locPtr', and therefore displays
+// the first valid local time following the gap.
+//
+func (tzDstMech *timeZoneDstMechanics) getGapBounds(
+	earlier time.Time,
+	locPtr *time.Location) (
+	gapStart time.Time,
+	gapEnd time.Time) {
+
+	tzTransMech := timeZoneTransitionMechanics{}
+
+	gapEnd, found := tzTransMech.nextTransition(earlier, locPtr)
+
+	if !found {
+		return earlier, earlier
+	}
+
+	abbrvBefore, offsetBefore := earlier.In(locPtr).Zone()
+
+	gapStart = gapEnd.In(time.FixedZone(abbrvBefore, offsetBefore))
+
+	return gapStart, gapEnd
+}
+
+// getLocationPtr - Returns the time zone location associated with
+// 'timeZoneName'. 'timeZoneName' may be any time zone name accepted
+// by TimeZoneMechanics.GetTimeZoneFromName().
+//
+func (tzDstMech *timeZoneDstMechanics) getLocationPtr(
+	localDateTime time.Time,
+	timeZoneName string,
+	ePrefix string) (
+	locPtr *time.Location,
+	err error) {
+
+	ePrefix += "timeZoneDstMechanics.getLocationPtr() "
+
+	tzMech := TimeZoneMechanics{}
+
+	var tzSpec TimeZoneSpecification
+
+	tzSpec, err = tzMech.GetTimeZoneFromName(
+		localDateTime,
+		timeZoneName,
+		TzConvertType.Absolute(),
+		ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	locPtr = tzSpec.locationPtr
+
+	if locPtr == nil {
+		locPtr = tzSpec.referenceDateTime.Location()
+	}
+
+	return locPtr, nil
+}
+
+// resolveLocalDateTime - Converts local date time components to an
+// instant in the time zone designated by 'timeZoneName'. Ambiguous
+// and nonexistent local date times are resolved according to input
+// parameter 'dstPolicy'.
+//
+// If 'dstPolicy' is set to TzDstPolicy.Reject(), an ambiguous local
+// date time will generate an error of type *TimeZoneAmbiguousTimeError
+// and a nonexistent local date time will generate an error of type
+// *TimeZoneNonexistentTimeError.
+//
+func (tzDstMech *timeZoneDstMechanics) resolveLocalDateTime(
+	year,
+	month,
+	day,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	timeZoneName string,
+	dstPolicy TimeZoneDstPolicy,
+	ePrefix string) (
+	dateTime time.Time,
+	err error) {
+
+	if tzDstMech.lock == nil {
+		tzDstMech.lock = new(sync.Mutex)
+	}
+
+	tzDstMech.lock.Lock()
+
+	defer tzDstMech.lock.Unlock()
+
+	ePrefix += "timeZoneDstMechanics.resolveLocalDateTime() "
+
+	if !dstPolicy.XIsValid() {
+		return time.Time{},
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "dstPolicy",
+				inputParameterValue: dstPolicy.String(),
+				errMsg:              "Input parameter 'dstPolicy' is INVALID!",
+				err:                 nil,
+			}
+	}
+
+	localDateTime := time.Date(
+		year,
+		time.Month(month),
+		day,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		time.UTC)
+
+	tzDstMech2 := timeZoneDstMechanics{}
+
+	var locPtr *time.Location
+
+	locPtr, err = tzDstMech2.getLocationPtr(
+		localDateTime,
+		timeZoneName,
+		ePrefix)
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	earlier,
+	later,
+	isAmbiguous,
+	isNonexistent := tzDstMech2.classifyLocalDateTime(localDateTime, locPtr)
+
+	if isAmbiguous {
+
+		switch dstPolicy {
+
+		case TzDstPolicy.Reject():
+			return time.Time{},
+				&TimeZoneAmbiguousTimeError{
+					ePrefix:       ePrefix,
+					timeZoneName:  timeZoneName,
+					localDateTime: localDateTime.Format("2006-01-02 15:04:05.000000000"),
+					earlier:       earlier,
+					later:         later,
+					errMsg:        "",
+					err:           nil,
+				}
+
+		case TzDstPolicy.Later():
+			return later, nil
+
+		default:
+			return earlier, nil
+		}
+	}
+
+	if isNonexistent {
+
+		gapStart, gapEnd := tzDstMech2.getGapBounds(earlier, locPtr)
+
+		switch dstPolicy {
+
+		case TzDstPolicy.Reject():
+			return time.Time{},
+				&TimeZoneNonexistentTimeError{
+					ePrefix:       ePrefix,
+					timeZoneName:  timeZoneName,
+					localDateTime: localDateTime.Format("2006-01-02 15:04:05.000000000"),
+					gapStart:      gapStart,
+					gapEnd:        gapEnd,
+					errMsg:        "",
+					err:           nil,
+				}
+
+		case TzDstPolicy.Earlier():
+			return earlier, nil
+
+		case TzDstPolicy.Later():
+			return later, nil
+
+		default:
+			return gapEnd, nil
+		}
+	}
+
+	return earlier, nil
+}
+
+// testLocalDateTime - Determines whether the local date time
+// components are ambiguous or nonexistent in the time zone
+// designated by 'timeZoneName'.
+//
+func (tzDstMech *timeZoneDstMechanics) testLocalDateTime(
+	year,
+	month,
+	day,
+	hour,
+	minute,
+	second,
+	nanosecond int,
+	timeZoneName string,
+	ePrefix string) (
+	isAmbiguous bool,
+	isNonexistent bool,
+	err error) {
+
+	if tzDstMech.lock == nil {
+		tzDstMech.lock = new(sync.Mutex)
+	}
+
+	tzDstMech.lock.Lock()
+
+	defer tzDstMech.lock.Unlock()
+
+	ePrefix += "timeZoneDstMechanics.testLocalDateTime() "
+
+	if len(timeZoneName) == 0 {
+		return false, false,
+			&InputParameterError{
+				ePrefix:             ePrefix,
+				inputParameterName:  "timeZoneName",
+				inputParameterValue: "",
+				errMsg:              "Input parameter 'timeZoneName' is an EMPTY string!",
+				err:                 nil,
+			}
+	}
+
+	localDateTime := time.Date(
+		year,
+		time.Month(month),
+		day,
+		hour,
+		minute,
+		second,
+		nanosecond,
+		time.UTC)
+
+	tzDstMech2 := timeZoneDstMechanics{}
+
+	var locPtr *time.Location
+
+	locPtr, err = tzDstMech2.getLocationPtr(
+		localDateTime,
+		timeZoneName,
+		ePrefix)
+
+	if err != nil {
+		return false, false,
+			fmt.Errorf(ePrefix+
+				"\nError returned by getLocationPtr(timeZoneName).\n"+
+				"timeZoneName='%v'\nError='%v'\n",
+				timeZoneName, err.Error())
+	}
+
+	_,
+	_,
+	isAmbiguous,
+	isNonexistent = tzDstMech2.classifyLocalDateTime(localDateTime, locPtr)
+
+	return isAmbiguous, isNonexistent, nil
+}
@@ -0,0 +1,369 @@
+package datetime
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+var mTimeZoneDstPolicyStringToCode = map[string]TimeZoneDstPolicy{
+	"None"         : TimeZoneDstPolicy(0),
+	"Earlier"      : TimeZoneDstPolicy(1),
+	"Later"        : TimeZoneDstPolicy(2),
+	"Reject"       : TimeZoneDstPolicy(3),
+	"ShiftForward" : TimeZoneDstPolicy(4),
+}
+
+var mTimeZoneDstPolicyLwrCaseStringToCode = map[string]TimeZoneDstPolicy{
+	"none"         : TimeZoneDstPolicy(0),
+	"earlier"      : TimeZoneDstPolicy(1),
+	"later"        : TimeZoneDstPolicy(2),
+	"reject"       : TimeZoneDstPolicy(3),
+	"shiftforward" : TimeZoneDstPolicy(4),
+}
+
+var mTimeZoneDstPolicyCodeToString = map[TimeZoneDstPolicy]string{
+	TimeZoneDstPolicy(0) : "None",
+	TimeZoneDstPolicy(1) : "Earlier",
+	TimeZoneDstPolicy(2) : "Later",
+	TimeZoneDstPolicy(3) : "Reject",
+	TimeZoneDstPolicy(4) : "ShiftForward",
+}
+
+// TimeZoneDstPolicy - An enumeration of the policies used to resolve
+// local date times which do not map to exactly one instant in a time
+// zone location.
+//
+// When clocks are set forward, as at the start of Daylight Saving
+// Time, a range of local times is skipped. These local times are
+// 'nonexistent'. For example, in 'America/Chicago' on March 14, 2021,
+// the local time 02:30:00 never occurred.
+//
+// When clocks are set back, as at the end of Daylight Saving Time,
+// a range of local times occurs twice. These local times are
+// 'ambiguous'. For example, in 'America/Chicago' on November 7, 2021,
+// the local time 01:30:00 occurred once in CDT and once again in CST.
+//
+// Since Go does not directly support enumerations, the 'TimeZoneDstPolicy'
+// type has been adapted to function in a manner similar to classic
+// enumerations. 'TimeZoneDstPolicy' is declared as a type 'int'. The
+// method names effectively represent an enumeration of resolution
+// policies. These methods are listed as follows:
+//
+//
+// None         (0) - Signals that the Time Zone DST Policy is not
+//                    initialized. This is an error condition.
+//
+// Earlier      (1) - Ambiguous local times resolve to the earlier of
+//                    the two instants, the instant occurring before
+//                    the clocks were set back.
+//
+//                    Nonexistent local times are interpreted using
+//                    the UTC offset in effect after the gap. The
+//                    result precedes the gap by the amount of time
+//                    the local time falls within the gap.
+//                      Example: 02:30 resolves to 01:30 CST
+//
+// Later        (2) - Ambiguous local times resolve to the later of
+//                    the two instants, the instant occurring after
+//                    the clocks were set back.
+//
+//                    Nonexistent local times are interpreted using
+//                    the UTC offset in effect before the gap. The
+//                    result is shifted forward by the length of the
+//                    gap.
+//                      Example: 02:30 resolves to 03:30 CDT
+//
+// Reject       (3) - Ambiguous and nonexistent local times are
+//                    rejected. Ambiguous local times generate an
+//                    error of type *TimeZoneAmbiguousTimeError.
+//                    Nonexistent local times generate an error of
+//                    type *TimeZoneNonexistentTimeError.
+//
+// ShiftForward (4) - Ambiguous local times resolve to the earlier of
+//                    the two instants.
+//
+//                    Nonexistent local times resolve to the instant
+//                    at which the gap ends.
+//                      Example: 02:30 resolves to 03:00 CDT
+//
+//
+// For easy access to these enumeration values, use the global variable
+// 'TzDstPolicy'. Example: TzDstPolicy.Reject()
+//
+// Otherwise you will need to use the formal syntax.
+// Example: TimeZoneDstPolicy(0).Reject()
+//
+// Depending on your editor, intellisense (a.k.a. intelligent code completion) may not
+// list the TimeZoneDstPolicy methods in alphabetical order. Be advised that all
+// 'TimeZoneDstPolicy' methods beginning with 'X', as well as the method 'String()',
+// are utility methods and not part of the enumeration values.
+//
+type TimeZoneDstPolicy int
+
+var lockTimeZoneDstPolicy sync.Mutex
+
+// None - Signals that the TimeZoneDstPolicy is uninitialized.
+// This is an error condition.
+//
+// This method is part of the standard enumeration.
+//
+func (dstPolicy TimeZoneDstPolicy) None() TimeZoneDstPolicy {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	return TimeZoneDstPolicy(0)
+}
+
+// Earlier - Signals that ambiguous and nonexistent local times
+// will be resolved to the earlier instant.
+//
+// This method is part of the standard enumeration.
+//
+func (dstPolicy TimeZoneDstPolicy) Earlier() TimeZoneDstPolicy {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	return TimeZoneDstPolicy(1)
+}
+
+// Later - Signals that ambiguous and nonexistent local times
+// will be resolved to the later instant.
+//
+// This method is part of the standard enumeration.
+//
+func (dstPolicy TimeZoneDstPolicy) Later() TimeZoneDstPolicy {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	return TimeZoneDstPolicy(2)
+}
+
+// Reject - Signals that ambiguous and nonexistent local times
+// will generate an error.
+//
+// This method is part of the standard enumeration.
+//
+func (dstPolicy TimeZoneDstPolicy) Reject() TimeZoneDstPolicy {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	return TimeZoneDstPolicy(3)
+}
+
+// ShiftForward - Signals that nonexistent local times will be
+// resolved to the end of the gap and ambiguous local times will
+// be resolved to the earlier instant.
+//
+// This method is part of the standard enumeration.
+//
+func (dstPolicy TimeZoneDstPolicy) ShiftForward() TimeZoneDstPolicy {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	return TimeZoneDstPolicy(4)
+}
+
+// String - Returns a string with the name of the enumeration associated
+// with this instance of 'TimeZoneDstPolicy'.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t:= TimeZoneDstPolicy(0).Reject()
+// str := t.String()
+//     str is now equal to 'Reject'
+//
+func (dstPolicy TimeZoneDstPolicy) String() string {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	result, ok := mTimeZoneDstPolicyCodeToString[dstPolicy]
+
+	if !ok {
+		return "Error: Time Zone DST Policy UNKNOWN!"
+	}
+
+	return result
+}
+
+// XIsValid - Returns a boolean value signaling whether the
+// current TimeZoneDstPolicy value is valid.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  dstPolicy := TimeZoneDstPolicy(0).Reject()
+//
+//  isValid := dstPolicy.XIsValid()
+//
+func (dstPolicy TimeZoneDstPolicy) XIsValid() bool {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	if dstPolicy > 4 ||
+		dstPolicy < 1 {
+		return false
+	}
+
+	return true
+}
+
+// XParseString - Receives a string and attempts to match it with
+// the string value of a supported enumeration. If successful, a
+// new instance of TimeZoneDstPolicy is returned set to the value
+// of the associated enumeration.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+// valueString   string - A string which will be matched against the
+//                        enumeration string values. If 'valueString'
+//                        is equal to one of the enumeration names, this
+//                        method will proceed to successful completion
+//                        and return the correct enumeration value.
+//
+// caseSensitive   bool - If 'true' the search for enumeration names
+//                        will be case sensitive and will require an
+//                        exact match. Therefore, 'reject' will NOT
+//                        match the enumeration name, 'Reject'.
+//
+//                        If 'false' a case insensitive search is conducted
+//                        for the enumeration name. In this case, 'reject'
+//                        will match match enumeration name 'Reject'.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+// TimeZoneDstPolicy - Upon successful completion, this method will return
+//                     a new instance of TimeZoneDstPolicy set to the value
+//                     of the enumeration matched by the string search
+//                     performed on input parameter, 'valueString'.
+//
+// error             - If this method completes successfully, the returned error
+//                     Type is set equal to 'nil'. If an error condition is
+//                     encountered, this method will return an error type which
+//                     encapsulates an appropriate error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+// t, err := TimeZoneDstPolicy(0).XParseString("Reject", true)
+//
+//     t is now equal to TimeZoneDstPolicy(0).Reject()
+//
+func (dstPolicy TimeZoneDstPolicy) XParseString(
+	valueString string,
+	caseSensitive bool) (TimeZoneDstPolicy, error) {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	ePrefix := "TimeZoneDstPolicy.XParseString() "
+
+	if len(valueString) < 4 {
+		return TimeZoneDstPolicy(0),
+			fmt.Errorf(ePrefix+
+				"\nInput parameter 'valueString' is INVALID!\n" +
+				"String length is less than '4'.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	var ok bool
+	var timeZoneDstPolicy TimeZoneDstPolicy
+
+	if caseSensitive {
+
+		timeZoneDstPolicy, ok = mTimeZoneDstPolicyStringToCode[valueString]
+
+	} else {
+
+		timeZoneDstPolicy, ok =
+			mTimeZoneDstPolicyLwrCaseStringToCode[strings.ToLower(valueString)]
+	}
+
+	if !ok {
+		return TimeZoneDstPolicy(0),
+			fmt.Errorf(ePrefix+
+				"\n'valueString' did NOT MATCH a valid TimeZoneDstPolicy Value.\n" +
+				"valueString='%v'\n", valueString)
+	}
+
+	return timeZoneDstPolicy, nil
+}
+
+// XValue - This method returns the enumeration value of the current
+// TimeZoneDstPolicy instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (dstPolicy TimeZoneDstPolicy) XValue() TimeZoneDstPolicy {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	return dstPolicy
+}
+
+// XValueInt - This method returns the integer value of the current
+// TimeZoneDstPolicy instance.
+//
+// This is a standard utility method and is not part of the valid enumerations
+// for this type.
+//
+//
+func (dstPolicy TimeZoneDstPolicy) XValueInt() int {
+
+	lockTimeZoneDstPolicy.Lock()
+
+	defer lockTimeZoneDstPolicy.Unlock()
+
+	return int(dstPolicy)
+}
+
+// TzDstPolicy - public global variable of
+// type TimeZoneDstPolicy.
+//
+// This variable serves as an easier, short hand
+// technique for accessing TimeZoneDstPolicy values.
+//
+// Usage:
+// TzDstPolicy.None(),
+// TzDstPolicy.Earlier(),
+// TzDstPolicy.Later(),
+// TzDstPolicy.Reject(),
+// TzDstPolicy.ShiftForward(),
+//
+var TzDstPolicy TimeZoneDstPolicy
@@ -1941,3 +1941,106 @@ func (tzMech *TimeZoneMechanics) PreProcessTimeZoneLocation(
 	return timeZoneLocation
 
 }
+
+// ResolveTzAbbreviation - Receives a time zone abbreviation and
+// returns the IANA time zones which it may designate, ranked in
+// order of descending confidence.
+//
+// Many time zone abbreviations are ambiguous. "IST" designates India
+// Standard Time, Irish Standard Time and Israel Standard Time. "CST"
+// designates Central Standard Time in North America, China Standard
+// Time and Cuba Standard Time. Unlike ConvertTzAbbreviationToTimeZone(),
+// which selects a single time zone from a fixed priority list, this
+// method ranks all candidates against the preferences supplied in
+// 'tzAbbrvContext'.
+//
+// Abbreviations registered at runtime through method
+// StdTZoneAbbreviations.RegisterTzAbbreviation() are included in
+// the search.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  tzAbbreviation     string
+//     - A time zone abbreviation with or without a UTC offset.
+//       Examples: "IST", "IST+0530", "CST-0600"
+//
+//       If the UTC offset is omitted, all time zones associated
+//       with the abbreviation are considered.
+//
+//  tzAbbrvContext     TimeZoneAbbreviationContext
+//     - Preferences used to rank the candidates. All fields are
+//       optional.
+//
+//         PreferredRegion    - A region such as "Asia" or "Europe".
+//                              Matched against the abbreviation's
+//                              location and the leading element of
+//                              the IANA time zone name.
+//
+//         PreferredCountry   - An ISO 3166 alpha-2 country code.
+//                              Example: "IN"
+//
+//         ReferenceDateTime  - Time zones in which the abbreviation
+//                              is in effect at this instant are
+//                              preferred.
+//
+//         CandidateTimeZones - If populated, only these IANA time
+//                              zones are considered.
+//
+//  ePrefix            string
+//     - The error prefix containing the names of all
+//       the methods executed up to this point.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  candidates         []TimeZoneAbbreviationCandidate
+//     - The candidate time zones sorted by descending confidence.
+//       Confidence values range from 0.0 through 1.0. Ties are
+//       broken by the internal priority list,
+//       'tzAbbrvToTimeZonePriorityList'.
+//
+//  err                error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If the abbreviation is unknown, an error of type
+//       *TzAbbrvMapLookupError is returned.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  tzMech := TimeZoneMechanics{}
+//
+//  candidates, err := tzMech.ResolveTzAbbreviation(
+//                       "IST",
+//                       TimeZoneAbbreviationContext{PreferredCountry: "IN"},
+//                       "")
+//
+//  candidates[0].TimeZoneName is now equal to "Asia/Kolkata"
+//
+func (tzMech *TimeZoneMechanics) ResolveTzAbbreviation(
+	tzAbbreviation string,
+	tzAbbrvContext TimeZoneAbbreviationContext,
+	ePrefix string) (
+	candidates []TimeZoneAbbreviationCandidate,
+	err error) {
+
+	if tzMech.lock == nil {
+		tzMech.lock = new(sync.Mutex)
+	}
+
+	tzMech.lock.Lock()
+
+	defer tzMech.lock.Unlock()
+
+	ePrefix += "TimeZoneMechanics.ResolveTzAbbreviation() "
+
+	tzAbbrvResMech := tzAbbrvResolverMechanics{}
+
+	return tzAbbrvResMech.resolveTzAbbreviation(
+		tzAbbreviation,
+		tzAbbrvContext,
+		ePrefix)
+}
@@ -231,6 +231,16 @@ func (tzSpec *TimeZoneSpecification) IsValid(ePrefix string) error {
 	return nil
 }
 
+// IsValidInstance - Returns 'true' if the data fields of the current
+// TimeZoneSpecification instance are valid. This method is identical
+// in operation to method TimeZoneSpecification.IsValid() except that
+// it returns a boolean value.
+//
+func (tzSpec *TimeZoneSpecification) IsValidInstance() bool {
+
+	return tzSpec.IsValid("") == nil
+}
+
 // GetLocationPointer - Returns the time zone location in the form of
 // a pointer to 'time.Location'.
 //
@@ -826,6 +836,93 @@ func (tzSpec *TimeZoneSpecification) GetZoneSignValue() int {
 	return tzSpec.zoneSignValue
 }
 
+// MarshalBinary - Implements the encoding.BinaryMarshaler interface. The
+// current TimeZoneSpecification instance is encoded in the versioned binary wire
+// format. The binary wire format carries the same members as the
+// JSON wire format. Reference method TimeZoneSpecification.MarshalJSON().
+//
+func (tzSpec TimeZoneSpecification) MarshalBinary() ([]byte, error) {
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	tzSpec.lock.Lock()
+
+	defer tzSpec.lock.Unlock()
+
+	ePrefix := "TimeZoneSpecification.MarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.timeZoneSpecToWire(&tzSpec, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalBinary(wireTypeCodeTimeZoneSpec, &wire), nil
+}
+
+// MarshalJSON - Implements the json.Marshaler interface. The
+// current TimeZoneSpecification instance is encoded in the JSON wire format. The
+// wire format retains the reference date time, the location name, the zone
+// label, the tag description, military time zone names and the
+// time zone classification values.
+//
+// An invalid TimeZoneSpecification instance cannot be marshaled and will
+// generate an error.
+//
+func (tzSpec TimeZoneSpecification) MarshalJSON() ([]byte, error) {
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	tzSpec.lock.Lock()
+
+	defer tzSpec.lock.Unlock()
+
+	ePrefix := "TimeZoneSpecification.MarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.timeZoneSpecToWire(&tzSpec, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
+// MarshalText - Implements the encoding.TextMarshaler interface. The text
+// form of a TimeZoneSpecification is identical to its JSON wire format. Reference
+// method TimeZoneSpecification.MarshalJSON().
+//
+func (tzSpec TimeZoneSpecification) MarshalText() ([]byte, error) {
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	tzSpec.lock.Lock()
+
+	defer tzSpec.lock.Unlock()
+
+	ePrefix := "TimeZoneSpecification.MarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire, err := wireMech.timeZoneSpecToWire(&tzSpec, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return wireMech.marshalJSON(&wire, ePrefix)
+}
+
 // New - Returns a new TimeZoneSpecification instance with member
 // variables initialized to zero values.
 //
@@ -901,6 +998,108 @@ func (tzSpec TimeZoneSpecification) NewRefDate(
 	return tzSpecOut, err
 }
 
+// NewTransitionsBetween - Returns a series of TimeZoneSpecification
+// instances identifying every transition in the UTC offset or time
+// zone abbreviation of the time zone location, 'timeZoneName', which
+// occurs on or after 'startDateTime' and before 'endDateTime'.
+// Daylight Saving Time changes are the most common example of such
+// transitions.
+//
+// The returned TimeZoneSpecification instances are arranged in
+// chronological order. The reference date time of each instance is
+// set to the instant of the transition, expressed in the time zone
+// location, 'timeZoneName'. The UTC offset and abbreviation of each
+// instance are those which take effect at the transition.
+//
+// ------------------------------------------------------------------------
+//
+// Input Parameters
+//
+//  timeZoneName          string
+//     - An IANA time zone location name.
+//         Example: TZones.America.Chicago()
+//
+//
+//  startDateTime         time.Time
+//     - The beginning of the search period. Transitions occurring at
+//       this instant are included in the results.
+//
+//
+//  endDateTime           time.Time
+//     - The end of the search period. Transitions occurring at this
+//       instant are NOT included in the results. 'endDateTime' must
+//       not occur before 'startDateTime'.
+//
+//
+//  ePrefix               string
+//     - A string containing the names of the calling functions
+//       which invoked this method. The last character in this
+//       string should be a blank space.
+//
+// ------------------------------------------------------------------------
+//
+// Return Values
+//
+//  []TimeZoneSpecification
+//     - A series of time zone specifications describing each
+//       transition in the search period. If no transitions occur,
+//       an empty array is returned.
+//
+//
+//  error
+//     - If successful the returned error Type is set equal to 'nil'.
+//       If errors are encountered during processing, the returned
+//       error Type will encapsulate an error message.
+//
+// ------------------------------------------------------------------------
+//
+// Usage
+//
+//  startDateTime := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+//  endDateTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+//
+//  transitions, err := TimeZoneSpecification{}.NewTransitionsBetween(
+//                         TZones.America.Chicago(),
+//                         startDateTime,
+//                         endDateTime,
+//                         "")
+//
+//  transitions[0] is now set to 2021-03-14 03:00:00 CDT
+//  transitions[1] is now set to 2021-11-07 01:00:00 CST
+//
+func (tzSpec TimeZoneSpecification) NewTransitionsBetween(
+	timeZoneName string,
+	startDateTime time.Time,
+	endDateTime time.Time,
+	ePrefix string) ([]TimeZoneSpecification, error) {
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	tzSpec.lock.Lock()
+
+	defer tzSpec.lock.Unlock()
+
+	ePrefix += "TimeZoneSpecification.NewTransitionsBetween() "
+
+	dtMech := DTimeNanobot{}
+
+	locPtr, err := dtMech.LoadTzLocation(timeZoneName, ePrefix)
+
+	if err != nil {
+		return make([]TimeZoneSpecification, 0), err
+	}
+
+	tzTransMech := timeZoneTransitionMechanics{}
+
+	return tzTransMech.getTransitionsBetween(
+		locPtr,
+		startDateTime,
+		endDateTime,
+		ePrefix)
+}
+
 // SetOriginalTagDescription - Sets the value of member variable
 // and data field, TimeZoneSpecification.tagDescription. This
 // field is available for users to tag, classify or
@@ -970,4 +1169,100 @@ func (tzSpec *TimeZoneSpecification) SetTimeZone(
 		tagDescription,
 		timeZoneClass,
 		ePrefix)
-}
\ No newline at end of file
+}
+
+// UnmarshalBinary - Implements the encoding.BinaryUnmarshaler interface. The
+// current TimeZoneSpecification instance is rebuilt from 'data', which must hold
+// the binary wire format generated by TimeZoneSpecification.MarshalBinary().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (tzSpec *TimeZoneSpecification) UnmarshalBinary(data []byte) error {
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	tzSpec.lock.Lock()
+
+	defer tzSpec.lock.Unlock()
+
+	ePrefix := "TimeZoneSpecification.UnmarshalBinary() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeZoneSpecWire{}
+
+	err := wireMech.unmarshalBinary(data, wireTypeCodeTimeZoneSpec, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.timeZoneSpecFromWire(tzSpec, &wire, ePrefix)
+}
+
+// UnmarshalJSON - Implements the json.Unmarshaler interface. The
+// current TimeZoneSpecification instance is rebuilt from 'data', which must hold
+// the JSON wire format generated by TimeZoneSpecification.MarshalJSON().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (tzSpec *TimeZoneSpecification) UnmarshalJSON(data []byte) error {
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	tzSpec.lock.Lock()
+
+	defer tzSpec.lock.Unlock()
+
+	ePrefix := "TimeZoneSpecification.UnmarshalJSON() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeZoneSpecWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.timeZoneSpecFromWire(tzSpec, &wire, ePrefix)
+}
+
+// UnmarshalText - Implements the encoding.TextUnmarshaler interface. The
+// current TimeZoneSpecification instance is rebuilt from 'data', which must hold
+// the text form generated by TimeZoneSpecification.MarshalText().
+//
+// The rebuilt instance is validated. If 'data' is invalid, an error
+// is returned and the current instance is NOT modified.
+//
+func (tzSpec *TimeZoneSpecification) UnmarshalText(data []byte) error {
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	tzSpec.lock.Lock()
+
+	defer tzSpec.lock.Unlock()
+
+	ePrefix := "TimeZoneSpecification.UnmarshalText() "
+
+	wireMech := wireFormatMechanics{}
+
+	wire := timeZoneSpecWire{}
+
+	err := wireMech.unmarshalJSON(data, &wire, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	return wireMech.timeZoneSpecFromWire(tzSpec, &wire, ePrefix)
+}
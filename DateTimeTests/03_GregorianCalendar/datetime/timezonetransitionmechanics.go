@@ -0,0 +1,290 @@
+package datetime
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// timeZoneTransitionMechanics - Provides helper methods used to
+// identify time zone transitions. A time zone transition is an
+// instant at which the UTC offset or the time zone abbreviation of a
+// time zone location changes. Daylight Saving Time changes are the
+// most common example.
+//
+// Transitions are located with time.Time.ZoneBounds(). As a result,
+// transitions described by the footer TZ string of a TZif file are
+// identified in addition to the transitions explicitly listed in the
+// file. Zone boundaries which change neither the UTC offset nor the
+// time zone abbreviation are skipped.
+//
+type timeZoneTransitionMechanics struct {
+	lock *sync.Mutex
+}
+
+// getTransitionsBetween - Returns a time zone specification for
+// every transition occurring in time zone location 'locPtr' on or
+// after 'startDateTime' and before 'endDateTime'. The time zone
+// specifications are returned in chronological order.
+//
+// The reference date time of each time zone specification is set
+// to the instant of the transition, expressed in time zone location
+// 'locPtr'. Consequently, the UTC offset and abbreviation of each
+// specification are those which take effect at the transition.
+//
+func (tzTransMech *timeZoneTransitionMechanics) getTransitionsBetween(
+	locPtr *time.Location,
+	startDateTime time.Time,
+	endDateTime time.Time,
+	ePrefix string) (
+	transitions []TimeZoneSpecification,
+	err error) {
+
+	if tzTransMech.lock == nil {
+		tzTransMech.lock = new(sync.Mutex)
+	}
+
+	tzTransMech.lock.Lock()
+
+	defer tzTransMech.lock.Unlock()
+
+	ePrefix += "timeZoneTransitionMechanics.getTransitionsBetween() "
+
+	transitions = make([]TimeZoneSpecification, 0)
+
+	if locPtr == nil {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'locPtr' is a nil pointer!\n")
+		return transitions, err
+	}
+
+	if endDateTime.Before(startDateTime) {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: Input parameter 'endDateTime' occurs before 'startDateTime'!\n" +
+			"startDateTime='%v'\n" +
+			"endDateTime='%v'\n",
+			startDateTime.Format(FmtDateTimeYrMDayFmtStr),
+			endDateTime.Format(FmtDateTimeYrMDayFmtStr))
+		return transitions, err
+	}
+
+	tzTransMech2 := timeZoneTransitionMechanics{}
+
+	// The next transition following this instant
+	// may occur at 'startDateTime'.
+	searchDateTime := startDateTime.Add(-1 * time.Nanosecond)
+
+	for {
+
+		transitionTime, found :=
+			tzTransMech2.nextTransition(searchDateTime, locPtr)
+
+		if !found ||
+			!transitionTime.Before(endDateTime) {
+			break
+		}
+
+		var tzSpec TimeZoneSpecification
+
+		tzSpec, err = tzTransMech2.newTransitionTzSpec(
+			transitionTime,
+			locPtr,
+			ePrefix)
+
+		if err != nil {
+			return make([]TimeZoneSpecification, 0), err
+		}
+
+		transitions = append(transitions, tzSpec)
+
+		searchDateTime = transitionTime
+	}
+
+	return transitions, err
+}
+
+// bisectTransition - Returns the instant of the first transition
+// in time zone location 'locPtr' which occurs after 'startDateTime'
+// and on or before 'endDateTime'. The caller must ensure that the
+// UTC offset or time zone abbreviation in effect at 'startDateTime'
+// differs from that in effect at 'endDateTime'.
+//
+// Time zone transitions always occur on whole seconds.
+//
+func (tzTransMech *timeZoneTransitionMechanics) bisectTransition(
+	startDateTime time.Time,
+	endDateTime time.Time,
+	locPtr *time.Location) time.Time {
+
+	lowSeconds := startDateTime.Unix()
+
+	highSeconds := endDateTime.Unix()
+
+	if endDateTime.Nanosecond() > 0 {
+		highSeconds++
+	}
+
+	for highSeconds-lowSeconds > 1 {
+
+		midSeconds := lowSeconds + (highSeconds-lowSeconds)/2
+
+		if tzTransMech.isSameZone(
+			startDateTime,
+			time.Unix(midSeconds, 0),
+			locPtr) {
+
+			lowSeconds = midSeconds
+
+		} else {
+
+			highSeconds = midSeconds
+		}
+	}
+
+	return time.Unix(highSeconds, 0).In(locPtr)
+}
+
+// isSameZone - Returns 'true' if the UTC offset and the time zone
+// abbreviation in effect at 'dateTime1' are equal to those in
+// effect at 'dateTime2'.
+//
+func (tzTransMech *timeZoneTransitionMechanics) isSameZone(
+	dateTime1 time.Time,
+	dateTime2 time.Time,
+	locPtr *time.Location) bool {
+
+	abbrv1, offset1 := dateTime1.In(locPtr).Zone()
+
+	abbrv2, offset2 := dateTime2.In(locPtr).Zone()
+
+	return abbrv1 == abbrv2 &&
+		offset1 == offset2
+}
+
+// isTransition - Returns 'true' if the UTC offset or the time zone
+// abbreviation in effect at 'dateTime' differs from that in effect
+// one nanosecond earlier.
+//
+func (tzTransMech *timeZoneTransitionMechanics) isTransition(
+	dateTime time.Time,
+	locPtr *time.Location) bool {
+
+	return !tzTransMech.isSameZone(
+		dateTime.Add(-1*time.Nanosecond),
+		dateTime,
+		locPtr)
+}
+
+// newTransitionTzSpec - Returns a new time zone specification for
+// the transition which occurs at 'transitionTime' in time zone
+// location 'locPtr'.
+//
+func (tzTransMech *timeZoneTransitionMechanics) newTransitionTzSpec(
+	transitionTime time.Time,
+	locPtr *time.Location,
+	ePrefix string) (
+	TimeZoneSpecification,
+	error) {
+
+	ePrefix += "timeZoneTransitionMechanics.newTransitionTzSpec() "
+
+	tzSpec := TimeZoneSpecification{}
+
+	tzSpec.lock = new(sync.Mutex)
+
+	tzSpecUtil := timeZoneSpecUtility{}
+
+	err := tzSpecUtil.setTimeZone(
+		&tzSpec,
+		transitionTime.In(locPtr),
+		"",
+		"",
+		"",
+		"",
+		TzClass.OriginalTimeZone(),
+		ePrefix)
+
+	if err != nil {
+		return TimeZoneSpecification{}, err
+	}
+
+	return tzSpec, nil
+}
+
+// nextTransition - Returns the instant of the first transition in
+// time zone location 'locPtr' which occurs after 'dateTime'. If no
+// further transitions occur, 'found' is set to 'false'.
+//
+func (tzTransMech *timeZoneTransitionMechanics) nextTransition(
+	dateTime time.Time,
+	locPtr *time.Location) (
+	transitionTime time.Time,
+	found bool) {
+
+	dateTime = dateTime.In(locPtr)
+
+	for {
+
+		_, zoneEnd := dateTime.ZoneBounds()
+
+		if zoneEnd.IsZero() {
+			return time.Time{}, false
+		}
+
+		if !zoneEnd.After(dateTime) {
+			// For instants governed by the footer TZ string,
+			// time.Time.ZoneBounds() may return a zone end
+			// preceding 'dateTime' during the last day of a
+			// leap year. In this case, the search resumes at
+			// the start of the following UTC day.
+			zoneEnd = dateTime.UTC().
+				Truncate(24 * time.Hour).
+				Add(24 * time.Hour).
+				In(locPtr)
+
+			if tzTransMech.isTransition(zoneEnd, locPtr) {
+				return zoneEnd, true
+			}
+
+			if !tzTransMech.isSameZone(dateTime, zoneEnd, locPtr) {
+				return tzTransMech.bisectTransition(dateTime, zoneEnd, locPtr), true
+			}
+		}
+
+		if tzTransMech.isTransition(zoneEnd, locPtr) {
+			return zoneEnd, true
+		}
+
+		dateTime = zoneEnd
+	}
+}
+
+// previousTransition - Returns the instant of the last transition
+// in time zone location 'locPtr' which occurs on or before
+// 'dateTime'. If no such transition exists, 'found' is set to
+// 'false'.
+//
+func (tzTransMech *timeZoneTransitionMechanics) previousTransition(
+	dateTime time.Time,
+	locPtr *time.Location) (
+	transitionTime time.Time,
+	found bool) {
+
+	dateTime = dateTime.In(locPtr)
+
+	for {
+
+		zoneStart, _ := dateTime.ZoneBounds()
+
+		if zoneStart.IsZero() {
+			return time.Time{}, false
+		}
+
+		if tzTransMech.isTransition(zoneStart, locPtr) {
+			return zoneStart, true
+		}
+
+		dateTime = zoneStart.Add(-1 * time.Nanosecond)
+	}
+}
@@ -0,0 +1,69 @@
+// Command tzdatagen regenerates the IANA time zone catalog, source file
+// 'timezonedata.go', and the time zone abbreviation maps, source file
+// 'timezoneabbreviations.go', for package 'datetime' from the source
+// files distributed with an IANA time zone database release.
+//
+// The tool reads 'zone1970.tab', 'backward' and the region files
+// ('africa', 'antarctica', 'asia', 'australasia', 'etcetera', 'europe',
+// 'factory', 'northamerica' and 'southamerica'). Link time zones, including
+// the deprecated links listed in 'backward', are documented with their
+// canonical time zone targets.
+//
+// Usage:
+//
+//	go run ./tzdatagen -tzdata tzdata -out .
+//
+// This command is normally executed by 'go generate'. Reference source
+// file 'timezonedatagenerate.go' in package 'datetime'.
+//
+// Flags:
+//
+//	-tzdata  Directory containing the unpacked IANA tzdata source files
+//	-out     Output directory for 'timezonedata.go' and 'timezoneabbreviations.go'
+//	-files   Comma separated list of region files. Defaults to the standard list
+//	-version IANA release version. Defaults to the 'version' file in '-tzdata'
+//	-year    Year used to compute current abbreviations and UTC offsets
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+func main() {
+
+	cfg := tzDataGeneratorConfig{}
+
+	var fileList string
+
+	flag.StringVar(&cfg.tzDataDir, "tzdata", "tzdata",
+		"directory containing the unpacked IANA tzdata source files")
+
+	flag.StringVar(&cfg.outputDir, "out", ".",
+		"output directory for the generated source files")
+
+	flag.StringVar(&fileList, "files", strings.Join(tzDataDefaultRegionFiles, ","),
+		"comma separated list of tzdata region files")
+
+	flag.StringVar(&cfg.version, "version", "",
+		"IANA time zone database release version")
+
+	flag.IntVar(&cfg.year, "year", time.Now().Year(),
+		"year used to compute current abbreviations and UTC offsets")
+
+	flag.Parse()
+
+	cfg.regionFiles = strings.Split(fileList, ",")
+
+	cfg.creationDateTime = time.Now()
+
+	err := tzDataGenerator{}.generate(cfg)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err.Error())
+		os.Exit(1)
+	}
+}
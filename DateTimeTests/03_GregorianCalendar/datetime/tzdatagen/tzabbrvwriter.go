@@ -0,0 +1,300 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// tzAbbrvReference - Mirrors type 'TimeZoneAbbreviationDto' in package
+// 'datetime'.
+type tzAbbrvReference struct {
+	id               string
+	abbrv            string
+	abbrvDescription string
+	location         string
+	utcOffset        string
+}
+
+// tzAbbrvWriter - Writes source file 'timezoneabbreviations.go'.
+type tzAbbrvWriter struct{}
+
+var tzAbbrvReferenceLineRegex = regexp.MustCompile(
+	`^"([^"]+)"\s*:\s*\{\s*"([^"]*)"\s*,\s*"([^"]*)"\s*,\s*"([^"]*)"\s*,\s*"([^"]*)"\s*,\s*"([^"]*)"\s*\}`)
+
+// readExistingReferences - Reads the entries of map
+// 'mapTzAbbreviationReference' from a previously generated
+// 'timezoneabbreviations.go'. Abbreviation descriptions and locations
+// are not part of the tzdata source files. They are therefore carried
+// forward from the existing file. If the file does not exist, an empty
+// map is returned.
+func (tzAbbrvWrt tzAbbrvWriter) readExistingReferences(
+	fileName string) map[string]tzAbbrvReference {
+
+	references := make(map[string]tzAbbrvReference)
+
+	content, err := os.ReadFile(fileName)
+
+	if err != nil {
+		return references
+	}
+
+	lines := strings.Split(string(content), "\n")
+
+	for i := 0; i < len(lines); i++ {
+
+		match := tzAbbrvReferenceLineRegex.FindStringSubmatch(strings.TrimSpace(lines[i]))
+
+		if match == nil {
+			continue
+		}
+
+		references[match[1]] = tzAbbrvReference{
+			id:               match[2],
+			abbrv:            match[3],
+			abbrvDescription: match[4],
+			location:         match[5],
+			utcOffset:        match[6],
+		}
+	}
+
+	return references
+}
+
+// writeTzAbbreviations - Returns the contents of source file
+// 'timezoneabbreviations.go'.
+func (tzAbbrvWrt tzAbbrvWriter) writeTzAbbreviations(
+	catalog *tzCatalog,
+	srcData *tzSourceData,
+	existingRefs map[string]tzAbbrvReference,
+	year int,
+	ePrefix string) ([]byte, error) {
+
+	ePrefix += "tzAbbrvWriter.writeTzAbbreviations() "
+
+	tzGen := tzDataGenerator{}
+
+	references := make(map[string]tzAbbrvReference)
+
+	abbrvsToTimeZones := make(map[string][]string)
+
+	type zoneAbbrv struct {
+		id        string
+		utcOffset int
+	}
+
+	timeZonesToAbbrvs := make(map[string][]zoneAbbrv)
+
+	isCatalogZone := make(map[string]bool)
+
+	for i := 0; i < len(catalog.entries); i++ {
+
+		entry := catalog.entries[i]
+
+		isCatalogZone[entry.name] = true
+
+		abbrvs := srcData.zoneAbbreviations(entry.name, year)
+
+		for j := 0; j < len(abbrvs); j++ {
+
+			utcOffset := tzGen.formatUtcOffset(abbrvs[j].utcOffset)
+
+			id := abbrvs[j].abbrv + utcOffset
+
+			if _, ok := references[id]; !ok {
+				references[id] = tzAbbrvWrt.newReference(
+					id, abbrvs[j].abbrv, utcOffset, entry, existingRefs)
+			}
+
+			abbrvsToTimeZones[id] = append(abbrvsToTimeZones[id], entry.name)
+
+			timeZonesToAbbrvs[entry.name] = append(timeZonesToAbbrvs[entry.name],
+				zoneAbbrv{id: id, utcOffset: abbrvs[j].utcOffset})
+		}
+	}
+
+	zoneNames := make([]string, 0, len(timeZonesToAbbrvs))
+
+	for zoneName, zoneAbbrvs := range timeZonesToAbbrvs {
+
+		// Daylight saving abbreviations, having the larger UTC offset, are
+		// listed first.
+		sort.SliceStable(zoneAbbrvs, func(i, j int) bool {
+
+			if zoneAbbrvs[i].utcOffset != zoneAbbrvs[j].utcOffset {
+				return zoneAbbrvs[i].utcOffset > zoneAbbrvs[j].utcOffset
+			}
+
+			return zoneAbbrvs[i].id < zoneAbbrvs[j].id
+		})
+
+		zoneNames = append(zoneNames, zoneName)
+	}
+
+	abbrvIds := make([]string, 0, len(abbrvsToTimeZones)+len(tzMilitaryTimeZones))
+
+	for id := range abbrvsToTimeZones {
+		abbrvIds = append(abbrvIds, id)
+	}
+
+	sort.Strings(abbrvIds)
+
+	// Military time zone abbreviations are listed by letter in
+	// 'mapTimeZonesToTzAbbrvs'.
+	militaryLetters := make(map[string][]string)
+
+	for i := 0; i < len(tzMilitaryTimeZones); i++ {
+
+		milTz := tzMilitaryTimeZones[i]
+
+		utcOffset := tzGen.formatUtcOffset(milTz.utcOffsetHours * 3600)
+
+		id := milTz.letter + utcOffset
+
+		// Military time zone abbreviations follow the standard abbreviations
+		abbrvIds = append(abbrvIds, id)
+
+		ref, ok := existingRefs[id]
+
+		if !ok {
+			ref = tzAbbrvReference{
+				id:               id,
+				abbrv:            milTz.letter,
+				abbrvDescription: milTz.name,
+				location:         "Military",
+				utcOffset:        utcOffset,
+			}
+		}
+
+		references[id] = ref
+
+		ianaName := milTz.ianaTimeZoneName()
+
+		abbrvsToTimeZones[id] = append(abbrvsToTimeZones[id], ianaName)
+
+		if isCatalogZone[ianaName] {
+			militaryLetters[ianaName] = append(militaryLetters[ianaName], milTz.letter)
+		}
+	}
+
+	sort.Strings(zoneNames)
+
+	ids := make([]string, 0, len(references))
+
+	for id := range references {
+		ids = append(ids, id)
+	}
+
+	sort.Strings(ids)
+
+	typesSrc, err := tzGen.readTemplate("tzabbreviationtypes.txt", ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf := bytes.Buffer{}
+
+	buf.WriteString(typesSrc)
+
+	buf.WriteString("// mapTzAbbreviationReference - A reference map including all valid\n" +
+		"// alphabetic Time Zone abbreviations.\n" +
+		"//\n\n" +
+		"var lockMapTzAbbreviationReference sync.Mutex\n\n" +
+		"var mapTzAbbreviationReference = map[string]TimeZoneAbbreviationDto{\n")
+
+	for i := 0; i < len(ids); i++ {
+
+		ref := references[ids[i]]
+
+		buf.WriteString(fmt.Sprintf("%-14v:{%v},\n",
+			tzGen.quote(ref.id),
+			tzGen.joinQuoted([]string{
+				ref.id, ref.abbrv, ref.abbrvDescription, ref.location, ref.utcOffset})))
+	}
+
+	buf.WriteString("}\n\n\n" +
+		"// mapTzAbbrvsToTimeZones - A cross reference that maps\n" +
+		"// Time Zone Abbreviations to Time Zone Canonical Values.\n" +
+		"// \n\n" +
+		"var lockMapTzAbbrvsToTimeZones sync.Mutex\n\n" +
+		"var mapTzAbbrvsToTimeZones = map[string][]string {\n")
+
+	for i := 0; i < len(abbrvIds); i++ {
+
+		timeZones := abbrvsToTimeZones[abbrvIds[i]]
+
+		sort.Strings(timeZones)
+
+		buf.WriteString(fmt.Sprintf("%-14v:{ %v},\n",
+			tzGen.quote(abbrvIds[i]), tzGen.joinQuoted(timeZones)))
+	}
+
+	buf.WriteString("}\n\n\n" +
+		"// mapTimeZonesToTzAbbrvs - A cross reference that maps\n" +
+		"// Time Zone Canonical Values to Time Zone Abbreviations.\n" +
+		"// \n\n" +
+		"var lockMapTimeZonesToTzAbbrvs sync.Mutex\n\n" +
+		"var mapTimeZonesToTzAbbrvs = map[string][]string {\n")
+
+	for i := 0; i < len(zoneNames); i++ {
+
+		zoneAbbrvs := timeZonesToAbbrvs[zoneNames[i]]
+
+		zoneAbbrvIds := make([]string, 0, len(zoneAbbrvs))
+
+		for j := 0; j < len(zoneAbbrvs); j++ {
+			zoneAbbrvIds = append(zoneAbbrvIds, zoneAbbrvs[j].id)
+		}
+
+		zoneAbbrvIds = append(zoneAbbrvIds, militaryLetters[zoneNames[i]]...)
+
+		buf.WriteString(fmt.Sprintf("%-37v:{ %v},\n",
+			tzGen.quote(zoneNames[i]), tzGen.joinQuoted(zoneAbbrvIds)))
+	}
+
+	buf.WriteString("}\n\n\n")
+
+	return buf.Bytes(), nil
+}
+
+// newReference - Creates the reference data for a time zone
+// abbreviation. The description and location of a previously
+// generated reference are retained.
+func (tzAbbrvWrt tzAbbrvWriter) newReference(
+	id string,
+	abbrv string,
+	utcOffset string,
+	entry tzCatalogEntry,
+	existingRefs map[string]tzAbbrvReference) tzAbbrvReference {
+
+	ref := tzAbbrvReference{
+		id:        id,
+		abbrv:     abbrv,
+		utcOffset: utcOffset,
+	}
+
+	if existingRef, ok := existingRefs[id]; ok {
+		ref.abbrvDescription = existingRef.abbrvDescription
+		ref.location = existingRef.location
+		return ref
+	}
+
+	if strings.HasPrefix(abbrv, "+") ||
+		strings.HasPrefix(abbrv, "-") {
+		ref.abbrvDescription = "Numerical Time Zone " + abbrv
+		ref.location = abbrv
+		return ref
+	}
+
+	ref.abbrvDescription = "Time Zone Abbreviation " + abbrv
+
+	if entry.areaIndex < len(tzStandardAreas) {
+		ref.location = tzStandardAreas[entry.areaIndex]
+	}
+
+	return ref
+}
@@ -0,0 +1,621 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// tzStandardAreas - The standard IANA time zone areas. Time zones
+// located outside these areas are counted in area 'Other'.
+var tzStandardAreas = []string{
+	"Africa",
+	"America",
+	"Antarctica",
+	"Asia",
+	"Atlantic",
+	"Australia",
+	"Europe",
+	"Indian",
+	"Pacific",
+	"Etc",
+}
+
+// tzCatalogEntry - Describes a single IANA time zone in the catalog.
+type tzCatalogEntry struct {
+	name          string // Example: "America/Argentina/Buenos_Aires"
+	group         string // Example: "America"
+	subGroup      string // Example: "Argentina"
+	leafName      string // Example: "Buenos_Aires"
+	canonicalName string // Equal to 'name' unless the time zone is a link
+	isLink        bool
+	isDeprecated  bool // Link retained in tzdata file 'backward'
+	areaIndex     int  // Index in 'tzStandardAreas'. 'Other' follows the last area
+}
+
+// tzCatalog - The IANA time zones organized by group and sub-group.
+type tzCatalog struct {
+	entries   []tzCatalogEntry
+	groups    []string            // Primary groups sorted by name
+	subGroups map[string][]string // Sub-groups keyed by parent group
+}
+
+// tzCatalogWriter - Writes source file 'timezonedata.go'.
+type tzCatalogWriter struct{}
+
+// newCatalog - Creates a catalog containing all zones and links.
+func (tzCatWriter tzCatalogWriter) newCatalog(srcData *tzSourceData) *tzCatalog {
+
+	catalog := &tzCatalog{subGroups: make(map[string][]string)}
+
+	names := srcData.timeZoneNames()
+
+	groupSet := make(map[string]bool)
+
+	subGroupSet := make(map[string]bool)
+
+	for i := 0; i < len(names); i++ {
+
+		// These names are reserved for files generated by 'zic'
+		if names[i] == "posixrules" || names[i] == "localtime" {
+			continue
+		}
+
+		entry := tzCatalogEntry{name: names[i]}
+
+		parts := strings.Split(names[i], "/")
+
+		switch len(parts) {
+		case 1:
+			entry.group = "Other"
+			entry.leafName = parts[0]
+		case 2:
+			entry.group = parts[0]
+			entry.leafName = parts[1]
+		default:
+			entry.group = parts[0]
+			entry.subGroup = parts[1]
+			entry.leafName = strings.Join(parts[2:], "/")
+		}
+
+		groupSet[entry.group] = true
+
+		if len(entry.subGroup) > 0 &&
+			!subGroupSet[entry.group+"/"+entry.subGroup] {
+
+			subGroupSet[entry.group+"/"+entry.subGroup] = true
+
+			catalog.subGroups[entry.group] =
+				append(catalog.subGroups[entry.group], entry.subGroup)
+		}
+
+		entry.canonicalName, _ = srcData.canonicalName(names[i])
+
+		if link, ok := srcData.links[names[i]]; ok {
+			entry.isLink = true
+			entry.isDeprecated = link.sourceFile == tzDataBackwardFile
+		}
+
+		entry.areaIndex = len(tzStandardAreas)
+
+		for j := 0; len(parts) > 1 && j < len(tzStandardAreas); j++ {
+			if parts[0] == tzStandardAreas[j] {
+				entry.areaIndex = j
+				break
+			}
+		}
+
+		catalog.entries = append(catalog.entries, entry)
+	}
+
+	for group := range groupSet {
+		catalog.groups = append(catalog.groups, group)
+	}
+
+	sort.Strings(catalog.groups)
+
+	for group := range catalog.subGroups {
+		sort.Strings(catalog.subGroups[group])
+	}
+
+	return catalog
+}
+
+// groupEntries - Returns the catalog entries belonging to the
+// specified group and sub-group, sorted by name.
+func (tzCatWriter tzCatalogWriter) groupEntries(
+	catalog *tzCatalog,
+	group string,
+	subGroup string) []tzCatalogEntry {
+
+	entries := make([]tzCatalogEntry, 0)
+
+	for i := 0; i < len(catalog.entries); i++ {
+		if catalog.entries[i].group == group &&
+			catalog.entries[i].subGroup == subGroup {
+			entries = append(entries, catalog.entries[i])
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return tzDataGenerator{}.lessTimeZoneName(entries[i].leafName, entries[j].leafName)
+	})
+
+	return entries
+}
+
+// typeName - Returns the type name for a group or sub-group.
+// Example: "America" -> "americaTimeZones".
+func (tzCatWriter tzCatalogWriter) typeName(group string) string {
+	return strings.ToLower(group[:1]) + group[1:] + "TimeZones"
+}
+
+// receiverName - Returns the receiver name used by methods of
+// a group type. Example: "americaTimeZones" -> "ameri".
+func (tzCatWriter tzCatalogWriter) receiverName(typeName string) string {
+	return typeName[:5]
+}
+
+// methodName - Converts a time zone name to a method name. Minus and
+// plus signs are spelled out and single digits are zero padded.
+// Examples: "Port-au-Prince" -> "PortMinusauMinusPrince",
+// "GMT+1" -> "GMTPlus01", "CST6CDT" -> "CST06CDT".
+func (tzCatWriter tzCatalogWriter) methodName(leafName string) string {
+
+	buf := strings.Builder{}
+
+	for i := 0; i < len(leafName); {
+
+		c := leafName[i]
+
+		switch {
+
+		case c == '-':
+			buf.WriteString("Minus")
+			i++
+
+		case c == '+':
+			buf.WriteString("Plus")
+			i++
+
+		case c >= '0' && c <= '9':
+
+			j := i
+
+			for j < len(leafName) && leafName[j] >= '0' && leafName[j] <= '9' {
+				j++
+			}
+
+			if j-i == 1 {
+				buf.WriteByte('0')
+			}
+
+			buf.WriteString(leafName[i:j])
+
+			i = j
+
+		case c == '/':
+			buf.WriteByte('_')
+			i++
+
+		default:
+			buf.WriteByte(c)
+			i++
+		}
+	}
+
+	return buf.String()
+}
+
+// writeTimeZoneData - Returns the contents of source file
+// 'timezonedata.go'.
+func (tzCatWriter tzCatalogWriter) writeTimeZoneData(
+	catalog *tzCatalog,
+	srcData *tzSourceData,
+	cfg tzDataGeneratorConfig,
+	ePrefix string) ([]byte, error) {
+
+	ePrefix += "tzCatalogWriter.writeTimeZoneData() "
+
+	tzGen := tzDataGenerator{}
+
+	buf := bytes.Buffer{}
+
+	buf.WriteString("package datetime\n\n\nimport (\n" +
+		"      \"fmt\"\n" +
+		"      \"strings\"\n" +
+		"      \"sync\"\n" +
+		"      \"time\"\n" +
+		")\n\n\n\n")
+
+	err := tzCatWriter.writeHeader(&buf, catalog, srcData, cfg, ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Primary groups, including 'Military', sorted by name
+	groups := append([]string{"Military"}, catalog.groups...)
+
+	sort.Strings(groups)
+
+	buf.WriteString("type TimeZones struct {\n")
+
+	for i := 0; i < len(groups); i++ {
+		buf.WriteString(fmt.Sprintf("    %-35s%v\n", groups[i], tzCatWriter.typeName(groups[i])))
+	}
+
+	buf.WriteString("}\n\n")
+
+	globalSrc, err := tzGen.readTemplate("timezonesglobal.txt", ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.WriteString(globalSrc)
+
+	buf.WriteString("\n\n")
+
+	militarySrc, err := tzGen.readTemplate("militarytimezones.txt", ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(groups); i++ {
+
+		if groups[i] == "Military" {
+			buf.WriteString("\n" + militarySrc + "\n")
+			continue
+		}
+
+		tzCatWriter.writeGroup(&buf, catalog, srcData, groups[i], "")
+	}
+
+	for i := 0; i < len(catalog.groups); i++ {
+
+		subGroups := catalog.subGroups[catalog.groups[i]]
+
+		for j := 0; j < len(subGroups); j++ {
+			tzCatWriter.writeGroup(&buf, catalog, srcData, catalog.groups[i], subGroups[j])
+		}
+	}
+
+	utcOffsetSrc, err := tzGen.readTemplate("utcoffsetreference.txt", ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.WriteString("\n\n" + utcOffsetSrc)
+
+	tzCatWriter.writeUtcOffsets(&buf, catalog, srcData, cfg.year)
+
+	tzCatWriter.writeAlphabeticListing(&buf, catalog)
+
+	militaryListingSrc, err := tzGen.readTemplate("militarylisting.txt", ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	buf.WriteString(militaryListingSrc)
+
+	return buf.Bytes(), nil
+}
+
+// writeHeader - Writes the documentation for type 'TimeZones'.
+func (tzCatWriter tzCatalogWriter) writeHeader(
+	buf *bytes.Buffer,
+	catalog *tzCatalog,
+	srcData *tzSourceData,
+	cfg tzDataGeneratorConfig,
+	ePrefix string) error {
+
+	headerSrc, err := tzDataGenerator{}.readTemplate("timezonesheader.tmpl", ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	tmpl, err := template.New("header").Parse(headerSrc)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error parsing header template.\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	areaCounts := make([]int, len(tzStandardAreas)+1)
+
+	for i := 0; i < len(catalog.entries); i++ {
+		areaCounts[catalog.entries[i].areaIndex]++
+	}
+
+	regions := make([]string, 0, len(areaCounts))
+
+	for i := 0; i < len(areaCounts); i++ {
+
+		areaName := "Other"
+
+		if i < len(tzStandardAreas) {
+			areaName = tzStandardAreas[i]
+		}
+
+		regions = append(regions,
+			areaName+strings.Repeat(".", 40-len(areaName))+fmt.Sprintf("%4d", areaCounts[i]))
+	}
+
+	subGroupCount := 0
+
+	for _, subGroups := range catalog.subGroups {
+		subGroupCount += len(subGroups)
+	}
+
+	data := struct {
+		IanaCount         int
+		MilitaryCount     int
+		TotalCount        int
+		Regions           []string
+		Version           string
+		SubGroupCount     int
+		PrimaryGroupCount int
+		CreationDate      string
+	}{
+		IanaCount:         len(catalog.entries),
+		MilitaryCount:     len(tzMilitaryTimeZones),
+		TotalCount:        len(catalog.entries) + len(tzMilitaryTimeZones) + 1,
+		Regions:           regions,
+		Version:           srcData.version,
+		SubGroupCount:     subGroupCount,
+		PrimaryGroupCount: len(catalog.groups),
+		CreationDate:      cfg.creationDateTime.Format("2006-01-02 Monday 15:04:05 -0700 MST"),
+	}
+
+	err = tmpl.Execute(buf, data)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error executing header template.\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	return nil
+}
+
+// writeGroup - Writes the type and methods for a primary group or,
+// if 'subGroup' is populated, for a sub-group.
+func (tzCatWriter tzCatalogWriter) writeGroup(
+	buf *bytes.Buffer,
+	catalog *tzCatalog,
+	srcData *tzSourceData,
+	group string,
+	subGroup string) {
+
+	typeName := tzCatWriter.typeName(group)
+
+	if len(subGroup) > 0 {
+		typeName = tzCatWriter.typeName(subGroup)
+	}
+
+	receiver := tzCatWriter.receiverName(typeName)
+
+	references := "// For documentation on IANA Time Zones, see type\n" +
+		"// 'TimeZones'.\n" +
+		"//  \n" +
+		"// Reference:\n" +
+		"//   https://en.wikipedia.org/wiki/List_of_tz_database_time_zones\n" +
+		"//   https://en.wikipedia.org/wiki/Tz_database\n" +
+		"//   https://www.iana.org/time-zones\n" +
+		"//  \n"
+
+	buf.WriteString("\n")
+
+	if len(subGroup) > 0 {
+		buf.WriteString("// " + typeName + " - A Sub-Group of Time Zones. These are\n" +
+			"// IANA Time Zones located in '" + subGroup + "'.\n" +
+			"//  \n" +
+			"// The Parent Group is '" + group + "'.\n" +
+			"//  \n" +
+			references)
+	} else {
+		buf.WriteString("// " + typeName + " - IANA Time Zones for '" + group + "'.\n" +
+			"//  \n" +
+			references)
+	}
+
+	if group == "Other" {
+		buf.WriteString("//  \n" +
+			"// The 'Other' IANA Time Zone Group contains deprecated or obsolete time zones as\n" +
+			"// well as time zone abbreviations.  All deprecated time zones map to current,\n" +
+			"// valid time zones.\n" +
+			"//  \n")
+	}
+
+	buf.WriteString("type " + typeName + " string\n")
+
+	entries := tzCatWriter.groupEntries(catalog, group, subGroup)
+
+	for i := 0; i < len(entries); i++ {
+
+		buf.WriteString("\n// " + entries[i].leafName +
+			" - IANA Time Zone '" + entries[i].name + "'.\n" +
+			"//  \n")
+
+		tzCatWriter.writeEntryNotes(buf, srcData, entries[i])
+
+		buf.WriteString(fmt.Sprintf("func (%v %v) %v() string {return \"%v\" }\n",
+			receiver, typeName, tzCatWriter.methodName(entries[i].leafName), entries[i].name))
+	}
+
+	if len(subGroup) == 0 {
+
+		subGroups := catalog.subGroups[group]
+
+		for i := 0; i < len(subGroups); i++ {
+			buf.WriteString("\n// " + subGroups[i] + " - A place holder which defines a sub-group\n" +
+				"// of IANA Time Zones.\n" +
+				"//  \n" +
+				fmt.Sprintf("func (%v %v) %v() %v {return \"\" }\n",
+					receiver, typeName, subGroups[i], tzCatWriter.typeName(subGroups[i])))
+		}
+	}
+
+	buf.WriteString("\n")
+}
+
+// writeEntryNotes - Writes the documentation describing the country
+// codes, link target and 'Etc' conventions of a catalog entry.
+func (tzCatWriter tzCatalogWriter) writeEntryNotes(
+	buf *bytes.Buffer,
+	srcData *tzSourceData,
+	entry tzCatalogEntry) {
+
+	if zoneTab, ok := srcData.zoneTab[entry.name]; ok {
+
+		note := "// Country Codes: " + zoneTab.countryCodes
+
+		if len(zoneTab.comments) > 0 {
+			note += " - " + zoneTab.comments
+		}
+
+		buf.WriteString(note + "\n//  \n")
+	}
+
+	if entry.isDeprecated {
+		buf.WriteString("// This is a deprecated time zone retained for backward compatibility.\n" +
+			"// It is a link to the canonical IANA Time Zone '" + entry.canonicalName + "'.\n" +
+			"//  \n")
+	} else if entry.isLink {
+		buf.WriteString("// This time zone is a link to the canonical IANA Time Zone\n" +
+			"// '" + entry.canonicalName + "'.\n" +
+			"//  \n")
+	}
+
+	if entry.group != "Etc" {
+		return
+	}
+
+	if len(entry.leafName) > 4 &&
+		strings.HasPrefix(entry.leafName, "GMT") &&
+		(entry.leafName[3] == '+' || entry.leafName[3] == '-') {
+
+		hours, err := strconv.Atoi(entry.leafName[4:])
+
+		if err == nil && hours != 0 {
+
+			utcSign := "-"
+
+			if entry.leafName[3] == '-' {
+				utcSign = "+"
+			}
+
+			buf.WriteString("// This is an 'Etc' IANA Time Zone. The syntax for 'Etc' Time Zones\n" +
+				"// can be confusing. The numeric sign of 'Etc' offsets is the opposite\n" +
+				"// of the equivalent UTC offset. For example the 'Etc' Time Zone\n" +
+				fmt.Sprintf("// %v has a UTC offset of UTC%v%02d00. \n", entry.name, utcSign, hours) +
+				"//  \n")
+		}
+	}
+
+	buf.WriteString("// The 'Etc' time zone group is documented in the IANA Time Zone\n" +
+		"// Database at:\n" +
+		"//    https://en.wikipedia.org/wiki/Tz_database#Area\n" +
+		"//  \n")
+}
+
+// writeUtcOffsets - Writes map 'mapAllTimeZonesToUtcOffsets'.
+func (tzCatWriter tzCatalogWriter) writeUtcOffsets(
+	buf *bytes.Buffer,
+	catalog *tzCatalog,
+	srcData *tzSourceData,
+	year int) {
+
+	tzGen := tzDataGenerator{}
+
+	buf.WriteString("\nvar mapAllTimeZonesToUtcOffsets = map[string][]string{\n")
+
+	names := make(map[string]bool)
+
+	for i := 0; i < len(catalog.entries); i++ {
+
+		names[catalog.entries[i].name] = true
+
+		juneOffset, decemberOffset := srcData.zoneUtcOffsets(catalog.entries[i].name, year)
+
+		buf.WriteString(fmt.Sprintf("  %-37v: { \"UTC%v\", \"UTC%v\"},\n",
+			tzGen.quote(catalog.entries[i].name),
+			tzGen.formatUtcOffset(juneOffset),
+			tzGen.formatUtcOffset(decemberOffset)))
+	}
+
+	for i := 0; i < len(tzMilitaryTimeZones); i++ {
+
+		if names[tzMilitaryTimeZones[i].name] {
+			continue
+		}
+
+		utcOffset := tzGen.formatUtcOffset(tzMilitaryTimeZones[i].utcOffsetHours * 3600)
+
+		buf.WriteString(fmt.Sprintf("  %-37v: { \"UTC%v\", \"UTC%v\"},\n",
+			tzGen.quote(tzMilitaryTimeZones[i].name), utcOffset, utcOffset))
+	}
+
+	buf.WriteString("}\n\n\n")
+}
+
+// writeAlphabeticListing - Writes the alphabetic listing of all IANA
+// time zones ordered by area.
+func (tzCatWriter tzCatalogWriter) writeAlphabeticListing(
+	buf *bytes.Buffer,
+	catalog *tzCatalog) {
+
+	separator := "// ----------------------------------------------------------------------\n"
+
+	entries := make([]tzCatalogEntry, len(catalog.entries))
+
+	copy(entries, catalog.entries)
+
+	sort.SliceStable(entries, func(i, j int) bool {
+
+		if entries[i].areaIndex != entries[j].areaIndex {
+			return entries[i].areaIndex < entries[j].areaIndex
+		}
+
+		return tzDataGenerator{}.lessTimeZoneName(entries[i].name, entries[j].name)
+	})
+
+	buf.WriteString("//           IANA Time Zones Listed in Alphabetical Order\n" +
+		separator +
+		"// \n" +
+		fmt.Sprintf("//  Expected Number of Total Iana Time Zones:%5d\n", len(catalog.entries)) +
+		"// \n" +
+		fmt.Sprintf("// Actual Number of Captured Iana Time Zones:%5d\n", len(entries)) +
+		"// \n" +
+		"// \n" +
+		"//        Item    Region  Region         Time Zone\n" +
+		"//         No.    Index    Name          Canonical XValue\n" +
+		separator +
+		"// \n")
+
+	for i := 0; i < len(entries); i++ {
+
+		areaName := "Other"
+
+		if entries[i].areaIndex < len(tzStandardAreas) {
+			areaName = tzStandardAreas[entries[i].areaIndex]
+		}
+
+		buf.WriteString(fmt.Sprintf("//%10d.%8d     %-15v%v\n",
+			i+1, entries[i].areaIndex, areaName, entries[i].name))
+	}
+
+	buf.WriteString("// \n" +
+		separator +
+		"// \n" +
+		"// \n")
+}
@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+//go:embed templates
+var tzTemplates embed.FS
+
+// tzDataGeneratorConfig - Specifies the input and output of the
+// generator.
+type tzDataGeneratorConfig struct {
+	tzDataDir        string    // Directory containing the tzdata source files
+	outputDir        string    // Directory receiving the generated source files
+	regionFiles      []string  // tzdata region files
+	version          string    // IANA release version. If empty, read from tzdata
+	year             int       // Year used to compute abbreviations and UTC offsets
+	creationDateTime time.Time // Recorded in the generated source file
+}
+
+// tzMilitaryTimeZone - Describes a military time zone.
+type tzMilitaryTimeZone struct {
+	letter         string
+	name           string
+	utcOffsetHours int
+}
+
+// tzMilitaryTimeZones - The 25 military time zones. Military Time
+// Zone 'J' (Juliet) designates the observer's local time and is not
+// included.
+var tzMilitaryTimeZones = []tzMilitaryTimeZone{
+	{"A", "Alpha", 1},
+	{"B", "Bravo", 2},
+	{"C", "Charlie", 3},
+	{"D", "Delta", 4},
+	{"E", "Echo", 5},
+	{"F", "Foxtrot", 6},
+	{"G", "Golf", 7},
+	{"H", "Hotel", 8},
+	{"I", "India", 9},
+	{"K", "Kilo", 10},
+	{"L", "Lima", 11},
+	{"M", "Mike", 12},
+	{"N", "November", -1},
+	{"O", "Oscar", -2},
+	{"P", "Papa", -3},
+	{"Q", "Quebec", -4},
+	{"R", "Romeo", -5},
+	{"S", "Sierra", -6},
+	{"T", "Tango", -7},
+	{"U", "Uniform", -8},
+	{"V", "Victor", -9},
+	{"W", "Whiskey", -10},
+	{"X", "X-ray", -11},
+	{"Y", "Yankee", -12},
+	{"Z", "Zulu", 0},
+}
+
+// ianaTimeZoneName - Returns the IANA time zone equivalent to the
+// military time zone.
+func (milTz tzMilitaryTimeZone) ianaTimeZoneName() string {
+
+	if milTz.utcOffsetHours == 0 {
+		return "UTC"
+	}
+
+	if milTz.utcOffsetHours > 0 {
+		return fmt.Sprintf("Etc/GMT-%v", milTz.utcOffsetHours)
+	}
+
+	return fmt.Sprintf("Etc/GMT+%v", -milTz.utcOffsetHours)
+}
+
+// tzDataGenerator - Generates source files 'timezonedata.go' and
+// 'timezoneabbreviations.go' from the tzdata source files.
+type tzDataGenerator struct{}
+
+// generate - Parses the tzdata source files specified by 'cfg' and
+// writes the generated source files to 'cfg.outputDir'. Generated
+// source is parsed before it is written. If any error occurs, no
+// files are written.
+func (tzGen tzDataGenerator) generate(cfg tzDataGeneratorConfig) error {
+
+	ePrefix := "tzDataGenerator.generate() "
+
+	srcData, err := tzSourceParser{}.parseSourceFiles(
+		cfg.tzDataDir,
+		cfg.regionFiles,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	if len(cfg.version) > 0 {
+		srcData.version = cfg.version
+	}
+
+	if len(srcData.version) == 0 {
+		return errors.New(ePrefix + "\n" +
+			"Error: Unable to determine the IANA time zone database version.\n" +
+			"The tzdata 'version' file is missing. Use flag '-version'.\n")
+	}
+
+	catalog := tzCatalogWriter{}.newCatalog(srcData)
+
+	tzDataSrc, err := tzCatalogWriter{}.writeTimeZoneData(catalog, srcData, cfg, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	abbrvFile := filepath.Join(cfg.outputDir, "timezoneabbreviations.go")
+
+	existingAbbrvs := tzAbbrvWriter{}.readExistingReferences(abbrvFile)
+
+	tzAbbrvSrc, err := tzAbbrvWriter{}.writeTzAbbreviations(
+		catalog,
+		srcData,
+		existingAbbrvs,
+		cfg.year,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	outputs := []struct {
+		fileName string
+		src      []byte
+	}{
+		{"timezonedata.go", tzDataSrc},
+		{"timezoneabbreviations.go", tzAbbrvSrc},
+	}
+
+	for i := 0; i < len(outputs); i++ {
+
+		_, err = parser.ParseFile(token.NewFileSet(), outputs[i].fileName, outputs[i].src, 0)
+
+		if err != nil {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: Generated source file '%v' is invalid!\n"+
+				"Error='%v'\n", outputs[i].fileName, err.Error())
+		}
+	}
+
+	for i := 0; i < len(outputs); i++ {
+
+		err = os.WriteFile(
+			filepath.Join(cfg.outputDir, outputs[i].fileName),
+			outputs[i].src,
+			0644)
+
+		if err != nil {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error writing '%v'\n"+
+				"Error='%v'\n", outputs[i].fileName, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// readTemplate - Returns the contents of an embedded template file.
+func (tzGen tzDataGenerator) readTemplate(
+	fileName string,
+	ePrefix string) (string, error) {
+
+	content, err := tzTemplates.ReadFile("templates/" + fileName)
+
+	if err != nil {
+		return "", fmt.Errorf(ePrefix+"\n"+
+			"Error reading template '%v'\n"+
+			"Error='%v'\n", fileName, err.Error())
+	}
+
+	return string(content), nil
+}
+
+// formatUtcOffset - Formats a UTC offset in seconds as a sign
+// followed by hours and minutes. Example: "-0600", "+0530".
+func (tzGen tzDataGenerator) formatUtcOffset(utcOffset int) string {
+
+	sign := "+"
+
+	if utcOffset < 0 {
+		sign = "-"
+		utcOffset = -utcOffset
+	}
+
+	return fmt.Sprintf("%v%02d%02d", sign, utcOffset/3600, (utcOffset%3600)/60)
+}
+
+// quote - Returns 'str' enclosed in double quotes.
+func (tzGen tzDataGenerator) quote(str string) string {
+	return "\"" + str + "\""
+}
+
+// joinQuoted - Returns the elements of 'strs' enclosed in double
+// quotes and separated by commas.
+func (tzGen tzDataGenerator) joinQuoted(strs []string) string {
+
+	buf := bytes.Buffer{}
+
+	for i := 0; i < len(strs); i++ {
+
+		if i > 0 {
+			buf.WriteString(",")
+		}
+
+		buf.WriteString(tzGen.quote(strs[i]))
+	}
+
+	return buf.String()
+}
+
+// lessTimeZoneName - Compares time zone names without regard to case.
+// Runs of digits are compared by numeric value. This places
+// 'Etc/GMT+2' before 'Etc/GMT+10'.
+func (tzGen tzDataGenerator) lessTimeZoneName(a, b string) bool {
+
+	a = strings.ToLower(a)
+	b = strings.ToLower(b)
+
+	isDigit := func(c byte) bool { return c >= '0' && c <= '9' }
+
+	i, j := 0, 0
+
+	for i < len(a) && j < len(b) {
+
+		if isDigit(a[i]) && isDigit(b[j]) {
+
+			startA, startB := i, j
+
+			for i < len(a) && isDigit(a[i]) {
+				i++
+			}
+
+			for j < len(b) && isDigit(b[j]) {
+				j++
+			}
+
+			numA := strings.TrimLeft(a[startA:i], "0")
+			numB := strings.TrimLeft(b[startB:j], "0")
+
+			if len(numA) != len(numB) {
+				return len(numA) < len(numB)
+			}
+
+			if numA != numB {
+				return numA < numB
+			}
+
+			continue
+		}
+
+		if a[i] != b[j] {
+			return a[i] < b[j]
+		}
+
+		i++
+		j++
+	}
+
+	return len(a)-i < len(b)-j
+}
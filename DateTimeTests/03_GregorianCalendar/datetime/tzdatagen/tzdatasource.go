@@ -0,0 +1,921 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// tzDataDefaultRegionFiles - The tzdata region files which define
+// 'Rule', 'Zone' and 'Link' lines. The file 'backward' is always read
+// in addition to these files.
+var tzDataDefaultRegionFiles = []string{
+	"africa",
+	"antarctica",
+	"asia",
+	"australasia",
+	"etcetera",
+	"europe",
+	"factory",
+	"northamerica",
+	"southamerica",
+}
+
+// tzDataBackwardFile - The tzdata file containing deprecated links
+// retained for backward compatibility.
+const tzDataBackwardFile = "backward"
+
+// tzDataZone1970TabFile - The tzdata file listing the canonical time
+// zones together with their ISO 3166 country codes.
+const tzDataZone1970TabFile = "zone1970.tab"
+
+// tzRule - Describes one tzdata 'Rule' line.
+type tzRule struct {
+	fromYear int
+	toYear   int
+	month    int
+	day      int // Approximate day of month used to order rules
+	save     int // Seconds added to standard time
+	letter   string
+}
+
+// tzZoneLine - Describes one tzdata 'Zone' line or zone
+// continuation line.
+type tzZoneLine struct {
+	stdOffset int // Seconds east of UTC
+	rules     string
+	format    string
+	untilYear int
+	hasUntil  bool
+}
+
+// tzLink - Describes one tzdata 'Link' line.
+type tzLink struct {
+	target     string
+	sourceFile string
+}
+
+// tzZoneTabEntry - Describes one line of 'zone1970.tab'.
+type tzZoneTabEntry struct {
+	countryCodes string
+	comments     string
+}
+
+// tzAbbreviation - A time zone abbreviation together with the
+// UTC offset in seconds to which it applies.
+type tzAbbreviation struct {
+	abbrv     string
+	utcOffset int
+}
+
+// tzSourceData - Holds the parsed contents of the tzdata source
+// files.
+type tzSourceData struct {
+	version string
+	rules   map[string][]tzRule
+	zones   map[string][]tzZoneLine
+	links   map[string]tzLink
+	zoneTab map[string]tzZoneTabEntry
+}
+
+// tzSourceParser - Parses the tzdata source files.
+type tzSourceParser struct{}
+
+var tzSourceKeywords = []string{"Link", "Rule", "Zone"}
+
+var tzSourceMonths = []string{
+	"January", "February", "March", "April", "May", "June", "July",
+	"August", "September", "October", "November", "December"}
+
+// parseSourceFiles - Reads and parses the region files, 'backward'
+// and 'zone1970.tab' located in directory 'tzDataDir'. Region files
+// which do not exist are skipped. However, at least one region file,
+// 'backward' and 'zone1970.tab' must exist.
+func (tzParser tzSourceParser) parseSourceFiles(
+	tzDataDir string,
+	regionFiles []string,
+	ePrefix string) (*tzSourceData, error) {
+
+	ePrefix += "tzSourceParser.parseSourceFiles() "
+
+	srcData := &tzSourceData{
+		rules:   make(map[string][]tzRule),
+		zones:   make(map[string][]tzZoneLine),
+		links:   make(map[string]tzLink),
+		zoneTab: make(map[string]tzZoneTabEntry),
+	}
+
+	info, err := os.Stat(tzDataDir)
+
+	if err != nil || !info.IsDir() {
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"Error: The tzdata directory does not exist!\n"+
+			"tzDataDir='%v'\n", tzDataDir)
+	}
+
+	filesRead := 0
+
+	for i := 0; i < len(regionFiles); i++ {
+
+		fileName := strings.TrimSpace(regionFiles[i])
+
+		if len(fileName) == 0 {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(tzDataDir, fileName))
+
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf(ePrefix+"\n"+
+				"Error reading tzdata file '%v'\n"+
+				"Error='%v'\n", fileName, err.Error())
+		}
+
+		err = tzParser.parseRegionFile(srcData, fileName, string(content), ePrefix)
+
+		if err != nil {
+			return nil, err
+		}
+
+		filesRead++
+	}
+
+	if filesRead == 0 {
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"Error: No tzdata region files were found in directory '%v'\n"+
+			"regionFiles='%v'\n", tzDataDir, strings.Join(regionFiles, ","))
+	}
+
+	content, err := os.ReadFile(filepath.Join(tzDataDir, tzDataBackwardFile))
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"Error reading tzdata file '%v'\n"+
+			"Error='%v'\n", tzDataBackwardFile, err.Error())
+	}
+
+	err = tzParser.parseRegionFile(srcData, tzDataBackwardFile, string(content), ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	content, err = os.ReadFile(filepath.Join(tzDataDir, tzDataZone1970TabFile))
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"Error reading tzdata file '%v'\n"+
+			"Error='%v'\n", tzDataZone1970TabFile, err.Error())
+	}
+
+	err = tzParser.parseZoneTab(srcData, string(content), ePrefix)
+
+	if err != nil {
+		return nil, err
+	}
+
+	content, err = os.ReadFile(filepath.Join(tzDataDir, "version"))
+
+	if err == nil {
+		srcData.version = strings.TrimSpace(string(content))
+	}
+
+	return srcData, srcData.validate(ePrefix)
+}
+
+// parseRegionFile - Parses the 'Rule', 'Zone' and 'Link' lines of a
+// single tzdata source file. Keywords, month names and the year words
+// 'only', 'max' and 'min' may be abbreviated as permitted by 'zic'.
+func (tzParser tzSourceParser) parseRegionFile(
+	srcData *tzSourceData,
+	fileName string,
+	content string,
+	ePrefix string) error {
+
+	ePrefix += "tzSourceParser.parseRegionFile() "
+
+	lines := strings.Split(content, "\n")
+
+	zoneName := ""
+
+	expectContinuation := false
+
+	for i := 0; i < len(lines); i++ {
+
+		line := lines[i]
+
+		if len(srcData.version) == 0 &&
+			strings.HasPrefix(line, "# version ") {
+			srcData.version = strings.TrimSpace(line[len("# version "):])
+		}
+
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = line[:idx]
+		}
+
+		fields := strings.Fields(line)
+
+		if len(fields) == 0 {
+			continue
+		}
+
+		lineErr := func(msg string) error {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: %v\n"+
+				"File='%v' Line=%v\n"+
+				"Text='%v'\n", msg, fileName, i+1, lines[i])
+		}
+
+		var err error
+
+		if expectContinuation {
+
+			var zLine tzZoneLine
+
+			zLine, err = tzParser.parseZoneFields(fields)
+
+			if err != nil {
+				return lineErr(err.Error())
+			}
+
+			srcData.zones[zoneName] = append(srcData.zones[zoneName], zLine)
+
+			expectContinuation = zLine.hasUntil
+
+			continue
+		}
+
+		switch tzParser.matchWord(fields[0], tzSourceKeywords) {
+
+		case "Rule":
+
+			if len(fields) < 10 {
+				return lineErr("'Rule' line has too few fields")
+			}
+
+			var rule tzRule
+
+			rule, err = tzParser.parseRuleFields(fields)
+
+			if err != nil {
+				return lineErr(err.Error())
+			}
+
+			srcData.rules[fields[1]] = append(srcData.rules[fields[1]], rule)
+
+		case "Zone":
+
+			if len(fields) < 5 {
+				return lineErr("'Zone' line has too few fields")
+			}
+
+			zoneName = fields[1]
+
+			if _, ok := srcData.zones[zoneName]; ok {
+				return lineErr("Duplicate 'Zone' name '" + zoneName + "'")
+			}
+
+			var zLine tzZoneLine
+
+			zLine, err = tzParser.parseZoneFields(fields[2:])
+
+			if err != nil {
+				return lineErr(err.Error())
+			}
+
+			srcData.zones[zoneName] = []tzZoneLine{zLine}
+
+			expectContinuation = zLine.hasUntil
+
+		case "Link":
+
+			if len(fields) != 3 {
+				return lineErr("'Link' line must contain exactly 3 fields")
+			}
+
+			srcData.links[fields[2]] = tzLink{
+				target:     fields[1],
+				sourceFile: fileName,
+			}
+
+		default:
+			return lineErr("Unknown line type '" + fields[0] + "'")
+		}
+	}
+
+	return nil
+}
+
+// parseRuleFields - Parses the fields of a 'Rule' line:
+//
+//	Rule NAME FROM TO - IN ON AT SAVE LETTER/S
+func (tzParser tzSourceParser) parseRuleFields(
+	fields []string) (tzRule, error) {
+
+	rule := tzRule{letter: fields[9]}
+
+	var err error
+
+	rule.fromYear, err = tzParser.parseYear(fields[2], -9999)
+
+	if err != nil {
+		return rule, err
+	}
+
+	switch tzParser.matchWord(fields[3], []string{"only", "maximum"}) {
+	case "only":
+		rule.toYear = rule.fromYear
+	case "maximum":
+		rule.toYear = 9999
+	default:
+		rule.toYear, err = tzParser.parseYear(fields[3], 9999)
+
+		if err != nil {
+			return rule, err
+		}
+	}
+
+	rule.month, err = tzParser.parseMonth(fields[5])
+
+	if err != nil {
+		return rule, err
+	}
+
+	rule.day, err = tzParser.parseDay(fields[6])
+
+	if err != nil {
+		return rule, err
+	}
+
+	rule.save, err = tzParser.parseHms(fields[8])
+
+	return rule, err
+}
+
+// parseZoneFields - Parses the fields of a 'Zone' line following the
+// zone name, or the fields of a zone continuation line:
+//
+//	STDOFF RULES FORMAT [UNTIL]
+func (tzParser tzSourceParser) parseZoneFields(
+	fields []string) (tzZoneLine, error) {
+
+	zLine := tzZoneLine{}
+
+	if len(fields) < 3 {
+		return zLine, errors.New("zone line has too few fields")
+	}
+
+	var err error
+
+	zLine.stdOffset, err = tzParser.parseHms(fields[0])
+
+	if err != nil {
+		return zLine, err
+	}
+
+	zLine.rules = fields[1]
+	zLine.format = fields[2]
+
+	if len(fields) > 3 {
+
+		zLine.hasUntil = true
+
+		zLine.untilYear, err = strconv.Atoi(fields[3])
+
+		if err != nil {
+			return zLine, fmt.Errorf("invalid UNTIL year '%v'", fields[3])
+		}
+	}
+
+	return zLine, nil
+}
+
+// parseYear - Parses a 'Rule' FROM or TO year. The word 'minimum'
+// or any abbreviation thereof returns 'minMaxYear'.
+func (tzParser tzSourceParser) parseYear(
+	field string,
+	minMaxYear int) (int, error) {
+
+	if tzParser.matchWord(field, []string{"minimum"}) == "minimum" {
+		return minMaxYear, nil
+	}
+
+	year, err := strconv.Atoi(field)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid year '%v'", field)
+	}
+
+	return year, nil
+}
+
+// parseMonth - Parses a month name or an abbreviation thereof.
+func (tzParser tzSourceParser) parseMonth(field string) (int, error) {
+
+	month := tzParser.matchWord(field, tzSourceMonths)
+
+	for i := 0; i < len(tzSourceMonths); i++ {
+		if tzSourceMonths[i] == month {
+			return i + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid month '%v'", field)
+}
+
+// parseDay - Parses a 'Rule' ON field and returns an approximate day
+// of the month. The approximation is sufficient to order the rules
+// taking effect within a year. Examples: "15", "lastSun", "Sun>=8".
+func (tzParser tzSourceParser) parseDay(field string) (int, error) {
+
+	if strings.HasPrefix(field, "last") {
+		return 28, nil
+	}
+
+	if idx := strings.Index(field, ">="); idx >= 0 {
+
+		day, err := strconv.Atoi(field[idx+2:])
+
+		if err != nil {
+			return 0, fmt.Errorf("invalid day '%v'", field)
+		}
+
+		return day + 3, nil
+	}
+
+	if idx := strings.Index(field, "<="); idx >= 0 {
+
+		day, err := strconv.Atoi(field[idx+2:])
+
+		if err != nil {
+			return 0, fmt.Errorf("invalid day '%v'", field)
+		}
+
+		return day - 3, nil
+	}
+
+	day, err := strconv.Atoi(field)
+
+	if err != nil {
+		return 0, fmt.Errorf("invalid day '%v'", field)
+	}
+
+	return day, nil
+}
+
+// parseHms - Parses a signed time value formatted as 'hh[:mm[:ss]]'
+// and returns the value in seconds. A trailing suffix letter, such as
+// the 's' or 'd' accepted by 'zic', is ignored.
+func (tzParser tzSourceParser) parseHms(field string) (int, error) {
+
+	value := strings.TrimRight(field, "abcdefghijklmnopqrstuvwxyz")
+
+	if value == "-" {
+		return 0, nil
+	}
+
+	sign := 1
+
+	if strings.HasPrefix(value, "-") {
+		sign = -1
+		value = value[1:]
+	}
+
+	parts := strings.Split(value, ":")
+
+	if len(parts) > 3 || len(value) == 0 {
+		return 0, fmt.Errorf("invalid time value '%v'", field)
+	}
+
+	seconds := 0
+
+	multipliers := []int{3600, 60, 1}
+
+	for i := 0; i < len(parts); i++ {
+
+		part := parts[i]
+
+		// Fractional seconds are truncated
+		if idx := strings.Index(part, "."); idx >= 0 && i == 2 {
+			part = part[:idx]
+		}
+
+		num, err := strconv.Atoi(part)
+
+		if err != nil || num < 0 {
+			return 0, fmt.Errorf("invalid time value '%v'", field)
+		}
+
+		seconds += num * multipliers[i]
+	}
+
+	return sign * seconds, nil
+}
+
+// matchWord - Returns the element of 'words' of which 'field' is a
+// case insensitive, unambiguous abbreviation. If no such element
+// exists, an empty string is returned.
+func (tzParser tzSourceParser) matchWord(
+	field string,
+	words []string) string {
+
+	lowerField := strings.ToLower(field)
+
+	match := ""
+
+	for i := 0; i < len(words); i++ {
+
+		lowerWord := strings.ToLower(words[i])
+
+		if lowerWord == lowerField {
+			return words[i]
+		}
+
+		if strings.HasPrefix(lowerWord, lowerField) {
+
+			if len(match) > 0 {
+				// Ambiguous abbreviation
+				return ""
+			}
+
+			match = words[i]
+		}
+	}
+
+	return match
+}
+
+// parseZoneTab - Parses 'zone1970.tab'. Each non-comment line
+// contains tab separated country codes, coordinates, time zone name
+// and optional comments.
+func (tzParser tzSourceParser) parseZoneTab(
+	srcData *tzSourceData,
+	content string,
+	ePrefix string) error {
+
+	ePrefix += "tzSourceParser.parseZoneTab() "
+
+	lines := strings.Split(content, "\n")
+
+	for i := 0; i < len(lines); i++ {
+
+		line := strings.TrimRight(lines[i], "\r")
+
+		if len(strings.TrimSpace(line)) == 0 ||
+			strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+
+		if len(fields) < 3 {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: '%v' line has too few fields.\n"+
+				"Line=%v Text='%v'\n", tzDataZone1970TabFile, i+1, line)
+		}
+
+		entry := tzZoneTabEntry{countryCodes: fields[0]}
+
+		if len(fields) > 3 {
+			entry.comments = fields[3]
+		}
+
+		srcData.zoneTab[fields[2]] = entry
+	}
+
+	return nil
+}
+
+// validate - Verifies that every link resolves to a zone and that
+// every time zone listed in 'zone1970.tab' is defined by a 'Zone'
+// line.
+func (srcData *tzSourceData) validate(ePrefix string) error {
+
+	ePrefix += "tzSourceData.validate() "
+
+	for name := range srcData.links {
+
+		if _, ok := srcData.zones[name]; ok {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: '%v' is defined as both a 'Zone' and a 'Link'!\n", name)
+		}
+
+		_, err := srcData.canonicalName(name)
+
+		if err != nil {
+			return fmt.Errorf(ePrefix+"\n%v", err.Error())
+		}
+	}
+
+	for name := range srcData.zoneTab {
+
+		if _, ok := srcData.zones[name]; !ok {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: '%v' references time zone '%v' which is NOT "+
+				"defined by a 'Zone' line!\n", tzDataZone1970TabFile, name)
+		}
+	}
+
+	for name, zLines := range srcData.zones {
+
+		for i := 0; i < len(zLines); i++ {
+
+			if !srcData.isNamedRule(zLines[i].rules) {
+				continue
+			}
+
+			if _, ok := srcData.rules[zLines[i].rules]; !ok {
+				return fmt.Errorf(ePrefix+"\n"+
+					"Error: Zone '%v' references undefined rule '%v'!\n",
+					name, zLines[i].rules)
+			}
+		}
+	}
+
+	return nil
+}
+
+// canonicalName - Follows links from 'name' to the time zone defined
+// by a 'Zone' line.
+func (srcData *tzSourceData) canonicalName(name string) (string, error) {
+
+	current := name
+
+	for i := 0; i <= len(srcData.links); i++ {
+
+		if _, ok := srcData.zones[current]; ok {
+			return current, nil
+		}
+
+		link, ok := srcData.links[current]
+
+		if !ok {
+			return "", fmt.Errorf("Error: Link '%v' refers to undefined "+
+				"time zone '%v'!\n", name, current)
+		}
+
+		current = link.target
+	}
+
+	return "", fmt.Errorf("Error: Link '%v' is circular!\n", name)
+}
+
+// timeZoneNames - Returns the names of all zones and links.
+func (srcData *tzSourceData) timeZoneNames() []string {
+
+	names := make([]string, 0, len(srcData.zones)+len(srcData.links))
+
+	for name := range srcData.zones {
+		names = append(names, name)
+	}
+
+	for name := range srcData.links {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// isNamedRule - Returns 'true' if the RULES field of a zone line
+// names a set of rules as opposed to '-' or a fixed amount of
+// daylight saving time.
+func (srcData *tzSourceData) isNamedRule(rules string) bool {
+
+	if rules == "-" || len(rules) == 0 {
+		return false
+	}
+
+	first := rules[0]
+
+	return !(first >= '0' && first <= '9') && first != '-'
+}
+
+// zoneLineForYear - Returns the zone line in effect during 'year'
+// for the time zone defined by 'Zone' line 'zoneName'.
+func (srcData *tzSourceData) zoneLineForYear(
+	zoneName string,
+	year int) tzZoneLine {
+
+	zLines := srcData.zones[zoneName]
+
+	for i := 0; i < len(zLines); i++ {
+
+		if !zLines[i].hasUntil ||
+			zLines[i].untilYear > year {
+			return zLines[i]
+		}
+	}
+
+	return zLines[len(zLines)-1]
+}
+
+// ruleStateAt - Returns the daylight saving time in seconds and the
+// rule letter in effect at 'month' and 'day' of 'year' for zone line
+// 'zLine'.
+func (srcData *tzSourceData) ruleStateAt(
+	zLine tzZoneLine,
+	year, month, day int) (save int, letter string) {
+
+	if !srcData.isNamedRule(zLine.rules) {
+
+		if zLine.rules == "-" {
+			return 0, ""
+		}
+
+		save, _ = tzSourceParser{}.parseHms(zLine.rules)
+
+		return save, ""
+	}
+
+	rules := srcData.rules[zLine.rules]
+
+	bestKey := -1 << 62
+
+	var best *tzRule
+
+	for i := 0; i < len(rules); i++ {
+
+		occurrenceYear := rules[i].toYear
+
+		if occurrenceYear >= year {
+
+			occurrenceYear = year
+
+			if rules[i].month*100+rules[i].day > month*100+day {
+				occurrenceYear = year - 1
+			}
+		}
+
+		if occurrenceYear < rules[i].fromYear {
+			continue
+		}
+
+		key := occurrenceYear*10000 + rules[i].month*100 + rules[i].day
+
+		if key > bestKey {
+			bestKey = key
+			best = &rules[i]
+		}
+	}
+
+	if best != nil {
+		return best.save, best.letter
+	}
+
+	// No rule has taken effect. Standard time applies
+	// together with the letter of the first standard time rule.
+	for i := 0; i < len(rules); i++ {
+		if rules[i].save == 0 {
+			return 0, rules[i].letter
+		}
+	}
+
+	return 0, ""
+}
+
+// formatAbbreviation - Applies a zone line FORMAT to produce a time
+// zone abbreviation. Slash separated formats select the standard
+// or daylight saving abbreviation, '%s' is replaced by the rule letter
+// and '%z' is replaced by the numeric UTC offset.
+func (srcData *tzSourceData) formatAbbreviation(
+	format string,
+	save int,
+	letter string,
+	utcOffset int) string {
+
+	if idx := strings.Index(format, "/"); idx >= 0 {
+
+		if save == 0 {
+			return format[:idx]
+		}
+
+		return format[idx+1:]
+	}
+
+	if letter == "-" {
+		letter = ""
+	}
+
+	if strings.Contains(format, "%z") {
+		return strings.Replace(format, "%z", srcData.numericAbbreviation(utcOffset), 1)
+	}
+
+	return strings.Replace(format, "%s", letter, 1)
+}
+
+// numericAbbreviation - Formats a UTC offset in seconds as a numeric
+// time zone abbreviation. Examples: "+05", "+0530", "-03".
+func (srcData *tzSourceData) numericAbbreviation(utcOffset int) string {
+
+	sign := "+"
+
+	if utcOffset < 0 {
+		sign = "-"
+		utcOffset = -utcOffset
+	}
+
+	hours := utcOffset / 3600
+	minutes := (utcOffset % 3600) / 60
+
+	if minutes == 0 {
+		return fmt.Sprintf("%v%02d", sign, hours)
+	}
+
+	return fmt.Sprintf("%v%02d%02d", sign, hours, minutes)
+}
+
+// zoneAbbreviations - Returns the time zone abbreviations in use
+// during 'year' by the time zone 'timeZoneName'. Links return the
+// abbreviations of their canonical time zone.
+func (srcData *tzSourceData) zoneAbbreviations(
+	timeZoneName string,
+	year int) []tzAbbreviation {
+
+	zoneName, err := srcData.canonicalName(timeZoneName)
+
+	if err != nil {
+		return nil
+	}
+
+	zLine := srcData.zoneLineForYear(zoneName, year)
+
+	type monthDay struct{ month, day int }
+
+	dates := []monthDay{{1, 1}}
+
+	if srcData.isNamedRule(zLine.rules) {
+
+		rules := srcData.rules[zLine.rules]
+
+		for i := 0; i < len(rules); i++ {
+			if rules[i].fromYear <= year &&
+				rules[i].toYear >= year {
+				dates = append(dates, monthDay{rules[i].month, rules[i].day})
+			}
+		}
+	}
+
+	abbrvs := make([]tzAbbreviation, 0, len(dates))
+
+	for i := 0; i < len(dates); i++ {
+
+		save, letter := srcData.ruleStateAt(zLine, year, dates[i].month, dates[i].day)
+
+		utcOffset := zLine.stdOffset + save
+
+		abbrv := tzAbbreviation{
+			abbrv:     srcData.formatAbbreviation(zLine.format, save, letter, utcOffset),
+			utcOffset: utcOffset,
+		}
+
+		isFound := false
+
+		for j := 0; j < len(abbrvs); j++ {
+			if abbrvs[j] == abbrv {
+				isFound = true
+				break
+			}
+		}
+
+		if !isFound {
+			abbrvs = append(abbrvs, abbrv)
+		}
+	}
+
+	return abbrvs
+}
+
+// zoneUtcOffsets - Returns the UTC offsets in seconds in effect on
+// June 15th and December 15th of 'year' for time zone 'timeZoneName'.
+func (srcData *tzSourceData) zoneUtcOffsets(
+	timeZoneName string,
+	year int) (juneOffset, decemberOffset int) {
+
+	zoneName, err := srcData.canonicalName(timeZoneName)
+
+	if err != nil {
+		return 0, 0
+	}
+
+	zLine := srcData.zoneLineForYear(zoneName, year)
+
+	save, _ := srcData.ruleStateAt(zLine, year, 6, 15)
+
+	juneOffset = zLine.stdOffset + save
+
+	save, _ = srcData.ruleStateAt(zLine, year, 12, 15)
+
+	decemberOffset = zLine.stdOffset + save
+
+	return juneOffset, decemberOffset
+}
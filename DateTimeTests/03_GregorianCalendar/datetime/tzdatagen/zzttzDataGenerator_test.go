@@ -0,0 +1,357 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTzDataGenerator01(t *testing.T) {
+
+	outputDir := t.TempDir()
+
+	// Descriptions and locations are carried forward from
+	// an existing abbreviations file.
+	err := os.WriteFile(
+		filepath.Join(outputDir, "timezoneabbreviations.go"),
+		[]byte("package datetime\n\n"+
+			"var mapTzAbbreviationReference = map[string]TimeZoneAbbreviationDto{\n"+
+			"\"CST-0600\"    :{\"CST-0600\",\"CST\",\"Central Standard Time\",\"North America\",\"-0600\"},\n"+
+			"}\n"),
+		0644)
+
+	if err != nil {
+		t.Errorf("Error writing existing abbreviations file.\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	cfg := tzDataGeneratorConfig{
+		tzDataDir:        filepath.Join("testdata", "tzdata"),
+		outputDir:        outputDir,
+		regionFiles:      tzDataDefaultRegionFiles,
+		year:             2021,
+		creationDateTime: time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	err = tzDataGenerator{}.generate(cfg)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDataGenerator{}.generate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	tzData, err := os.ReadFile(filepath.Join(outputDir, "timezonedata.go"))
+
+	if err != nil {
+		t.Errorf("Error reading 'timezonedata.go'\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	utcOffsetLine := func(timeZoneName, juneOffset, decemberOffset string) string {
+		return fmt.Sprintf("  %-37v: { \"%v\", \"%v\"},",
+			"\""+timeZoneName+"\"", juneOffset, decemberOffset)
+	}
+
+	expectedTzData := []string{
+		"IANA Time Zone Database Version: 2021a",
+		"Type Creation Date: 2021-03-01 Monday 12:00:00 +0000 UTC",
+		"    America                            americaTimeZones",
+		"    Military                           militaryTimeZones",
+		"func (afric africaTimeZones) Accra() string {return \"Africa/Accra\" }",
+		"// This time zone is a link to the canonical IANA Time Zone\n// 'Africa/Abidjan'.",
+		"func (ameri americaTimeZones) Chicago() string {return \"America/Chicago\" }",
+		"// Country Codes: US - Central (most areas)",
+		"func (ameri americaTimeZones) Argentina() argentinaTimeZones {return \"\" }",
+		"func (argen argentinaTimeZones) Buenos_Aires() string {return \"America/Argentina/Buenos_Aires\" }",
+		"// IANA Time Zones located in 'Argentina'.\n//  \n// The Parent Group is 'America'.",
+		"func (asiaT asiaTimeZones) Calcutta() string {return \"Asia/Calcutta\" }",
+		"// It is a link to the canonical IANA Time Zone 'Asia/Kolkata'.",
+		"func (etcTi etcTimeZones) GMTPlus01() string {return \"Etc/GMT+1\" }",
+		"func (etcTi etcTimeZones) GMTMinus14() string {return \"Etc/GMT-14\" }",
+		"// Etc/GMT-14 has a UTC offset of UTC+1400. ",
+		"func (etcTi etcTimeZones) GMT00() string {return \"Etc/GMT0\" }",
+		"func (other otherTimeZones) EST05EDT() string {return \"EST5EDT\" }",
+		"func (other otherTimeZones) GBMinusEire() string {return \"GB-Eire\" }",
+		"// It is a link to the canonical IANA Time Zone 'America/Denver'.\n//  \n" +
+			"func (other otherTimeZones) Navajo() string {return \"Navajo\" }",
+		"func (uSTim uSTimeZones) Central() string {return \"US/Central\" }",
+		"func (milTz militaryTimeZones) Zulu() string {return \"UTC\" }",
+		"func(tzUtcOffset TimeZoneUtcOffsetReference) GetTimeZoneUtcOffsets(",
+		utcOffsetLine("America/Chicago", "UTC-0500", "UTC-0600"),
+		utcOffsetLine("America/Phoenix", "UTC-0700", "UTC-0700"),
+		utcOffsetLine("America/Argentina/Buenos_Aires", "UTC-0300", "UTC-0300"),
+		utcOffsetLine("Europe/Dublin", "UTC+0100", "UTC+0000"),
+		utcOffsetLine("Asia/Calcutta", "UTC+0530", "UTC+0530"),
+		utcOffsetLine("Africa/Cairo", "UTC+0200", "UTC+0200"),
+		utcOffsetLine("Alpha", "UTC+0100", "UTC+0100"),
+		"//         1.       0     Africa         Africa/Abidjan",
+		"Alphabetical Listing Of Military Time Zones",
+	}
+
+	for i := 0; i < len(expectedTzData); i++ {
+		if !strings.Contains(string(tzData), expectedTzData[i]) {
+			t.Errorf("Error: Expected 'timezonedata.go' to contain:\n"+
+				"'%v'\n"+
+				"Test Case #%v\n", expectedTzData[i], i)
+		}
+	}
+
+	// Natural sort order places 'Etc/GMT+1' before 'Etc/GMT+12'
+	// and 'Etc/GMT-1' before 'Etc/GMT-14'.
+	orderedTzData := []string{
+		"func (etcTi etcTimeZones) GMT() string",
+		"func (etcTi etcTimeZones) GMTPlus00() string",
+		"func (etcTi etcTimeZones) GMTPlus01() string",
+		"func (etcTi etcTimeZones) GMTPlus12() string",
+		"func (etcTi etcTimeZones) GMTMinus01() string",
+		"func (etcTi etcTimeZones) GMTMinus14() string",
+		"func (etcTi etcTimeZones) GMT00() string",
+		"func (etcTi etcTimeZones) UTC() string",
+	}
+
+	lastIdx := -1
+
+	for i := 0; i < len(orderedTzData); i++ {
+
+		idx := strings.Index(string(tzData), orderedTzData[i])
+
+		if idx <= lastIdx {
+			t.Errorf("Error: '%v' is out of order or missing.\n", orderedTzData[i])
+		}
+
+		lastIdx = idx
+	}
+
+	tzAbbrvs, err := os.ReadFile(filepath.Join(outputDir, "timezoneabbreviations.go"))
+
+	if err != nil {
+		t.Errorf("Error reading 'timezoneabbreviations.go'\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	expectedTzAbbrvs := []string{
+		"func (stdTzAbbrvs *StdTZoneAbbreviations) TimeZonesToAbbrvs(",
+		"\"CST-0600\"    :{\"CST-0600\",\"CST\",\"Central Standard Time\",\"North America\",\"-0600\"},",
+		"\"CDT-0500\"    :{\"CDT-0500\",\"CDT\",\"Time Zone Abbreviation CDT\",\"America\",\"-0500\"},",
+		"\"+0545+0545\"  :{\"+0545+0545\",\"+0545\",\"Numerical Time Zone +0545\",\"+0545\",\"+0545\"},",
+		"\"A+0100\"      :{\"A+0100\",\"A\",\"Alpha\",\"Military\",\"+0100\"},",
+		"\"CST-0600\"    :{ \"America/Chicago\",\"US/Central\"},",
+		"\"IST+0530\"    :{ \"Asia/Calcutta\",\"Asia/Kolkata\"},",
+		"\"Z+0000\"      :{ \"UTC\"},",
+		"\"America/Chicago\"                    :{ \"CDT-0500\",\"CST-0600\"},",
+		"\"Europe/Dublin\"                      :{ \"IST+0100\",\"GMT+0000\"},",
+		"\"Europe/London\"                      :{ \"BST+0100\",\"GMT+0000\"},",
+		"\"America/Argentina/Cordoba\"          :{ \"-03-0300\"},",
+		"\"Africa/Cairo\"                       :{ \"EET+0200\"},",
+		"\"Etc/GMT-1\"                          :{ \"+01+0100\",\"A\"},",
+		"\"UTC\"                                :{ \"UTC+0000\",\"Z\"},",
+	}
+
+	for i := 0; i < len(expectedTzAbbrvs); i++ {
+		if !strings.Contains(string(tzAbbrvs), expectedTzAbbrvs[i]) {
+			t.Errorf("Error: Expected 'timezoneabbreviations.go' to contain:\n"+
+				"'%v'\n"+
+				"Test Case #%v\n", expectedTzAbbrvs[i], i)
+		}
+	}
+}
+
+func TestTzDataGenerator02(t *testing.T) {
+
+	srcDir := filepath.Join("testdata", "tzdata")
+
+	testCases := []struct {
+		description string
+		fileName    string
+		appendText  string
+		removeFile  bool
+	}{
+		{
+			"zone1970.tab references an undefined zone",
+			"zone1970.tab",
+			"XX\t+0000+00000\tAfrica/Atlantis\n",
+			false,
+		},
+		{
+			"Link refers to an undefined zone",
+			"backward",
+			"Link\tAfrica/Atlantis\tAtlantis\n",
+			false,
+		},
+		{
+			"Circular link",
+			"backward",
+			"Link\tCircleB\tCircleA\nLink\tCircleA\tCircleB\n",
+			false,
+		},
+		{
+			"Zone references an undefined rule",
+			"asia",
+			"Zone\tAsia/Atlantis\t1:00\tAtlantis\tA%sT\n",
+			false,
+		},
+		{
+			"Invalid month",
+			"europe",
+			"Rule\tEU\t2030\tmax\t-\tXyz\tlastSun\t1:00u\t1:00\tS\n",
+			false,
+		},
+		{
+			"Missing 'backward'",
+			"backward",
+			"",
+			true,
+		},
+		{
+			"Missing 'zone1970.tab'",
+			"zone1970.tab",
+			"",
+			true,
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+
+		tzDataDir := t.TempDir()
+
+		outputDir := t.TempDir()
+
+		entries, err := os.ReadDir(srcDir)
+
+		if err != nil {
+			t.Errorf("Error reading '%v'\nError='%v'\n", srcDir, err.Error())
+			return
+		}
+
+		for j := 0; j < len(entries); j++ {
+
+			content, err := os.ReadFile(filepath.Join(srcDir, entries[j].Name()))
+
+			if err != nil {
+				t.Errorf("Error reading '%v'\nError='%v'\n", entries[j].Name(), err.Error())
+				return
+			}
+
+			if entries[j].Name() == testCases[i].fileName {
+
+				if testCases[i].removeFile {
+					continue
+				}
+
+				content = append(content, []byte(testCases[i].appendText)...)
+			}
+
+			err = os.WriteFile(filepath.Join(tzDataDir, entries[j].Name()), content, 0644)
+
+			if err != nil {
+				t.Errorf("Error writing '%v'\nError='%v'\n", entries[j].Name(), err.Error())
+				return
+			}
+		}
+
+		err = tzDataGenerator{}.generate(tzDataGeneratorConfig{
+			tzDataDir:   tzDataDir,
+			outputDir:   outputDir,
+			regionFiles: tzDataDefaultRegionFiles,
+			year:        2021,
+		})
+
+		if err == nil {
+			t.Errorf("Error: Expected an error return from generate().\n"+
+				"Test Case #%v - %v\n"+
+				"However, NO ERROR WAS RETURNED!\n", i, testCases[i].description)
+		}
+
+		_, err = os.Stat(filepath.Join(outputDir, "timezonedata.go"))
+
+		if err == nil {
+			t.Errorf("Error: Test Case #%v - %v\n"+
+				"'timezonedata.go' was written although generate() failed!\n",
+				i, testCases[i].description)
+		}
+	}
+
+	err := tzDataGenerator{}.generate(tzDataGeneratorConfig{
+		tzDataDir:   filepath.Join("testdata", "atlantis"),
+		outputDir:   t.TempDir(),
+		regionFiles: tzDataDefaultRegionFiles,
+		year:        2021,
+	})
+
+	if err == nil {
+		t.Error("Error: Expected an error return from generate()\n" +
+			"because the tzdata directory does not exist.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestTzDataGenerator03(t *testing.T) {
+
+	// 'zic' accepts abbreviated keywords, month names and years
+	// as found in the compact file 'tzdata.zi'.
+	content := "# version 2099z\n" +
+		"R u 2007 ma - Mar Su>=8 2 1 D\n" +
+		"R u 2007 ma - N Su>=1 2 0 S\n" +
+		"Z America/Chicago -5:50:36 - LMT 1883 N 18 18u\n" +
+		"-6 u C%sT\n" +
+		"Z Asia/Kolkata 5:53:28 - LMT 1854 Jun 28\n" +
+		"5:30 - IST\n" +
+		"L America/Chicago US/Central\n"
+
+	srcData := &tzSourceData{
+		rules:   make(map[string][]tzRule),
+		zones:   make(map[string][]tzZoneLine),
+		links:   make(map[string]tzLink),
+		zoneTab: make(map[string]tzZoneTabEntry),
+	}
+
+	err := tzSourceParser{}.parseRegionFile(srcData, "tzdata.zi", content, "")
+
+	if err != nil {
+		t.Errorf("Error returned by parseRegionFile()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if srcData.version != "2099z" {
+		t.Errorf("Error: Expected version='2099z'. Instead, version='%v'\n",
+			srcData.version)
+	}
+
+	abbrvs := srcData.zoneAbbreviations("US/Central", 2021)
+
+	if len(abbrvs) != 2 ||
+		abbrvs[0] != (tzAbbreviation{"CST", -6 * 3600}) ||
+		abbrvs[1] != (tzAbbreviation{"CDT", -5 * 3600}) {
+		t.Errorf("Error: Expected abbreviations 'CST' and 'CDT' for US/Central.\n"+
+			"Instead, abbreviations='%v'\n", abbrvs)
+	}
+
+	methodNames := []struct {
+		leafName   string
+		methodName string
+	}{
+		{"Port-au-Prince", "PortMinusauMinusPrince"},
+		{"GMT+1", "GMTPlus01"},
+		{"GMT-10", "GMTMinus10"},
+		{"CST6CDT", "CST06CDT"},
+		{"Buenos_Aires", "Buenos_Aires"},
+	}
+
+	for i := 0; i < len(methodNames); i++ {
+
+		actual := tzCatalogWriter{}.methodName(methodNames[i].leafName)
+
+		if actual != methodNames[i].methodName {
+			t.Errorf("Error: Expected method name='%v'\n"+
+				"Instead, method name='%v'\n", methodNames[i].methodName, actual)
+		}
+	}
+}
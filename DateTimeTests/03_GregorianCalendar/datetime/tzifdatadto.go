@@ -0,0 +1,216 @@
+package datetime
+
+import (
+	"sync"
+)
+
+// TzifLocalTimeTypeDto - Describes a single local time type defined
+// in a TZif (Time Zone Information Format) file. Each time zone
+// transition identifies the local time type which takes effect at
+// the moment of the transition.
+//
+// Reference:
+//   https://datatracker.ietf.org/doc/html/rfc8536
+//
+type TzifLocalTimeTypeDto struct {
+	UtcOffsetSeconds        int    // Example: -18000 for UTC-0500
+	IsDst                   bool   // 'true' if this local time type is Daylight Saving Time
+	Abbreviation            string // Example: "EST"
+	IsStandardTimeIndicator bool   // 'true' if transitions were specified in standard time
+	IsUtIndicator           bool   // 'true' if transitions were specified in Universal Time
+}
+
+// TzifTransitionDto - Describes a single time zone transition
+// defined in a TZif (Time Zone Information Format) file.
+//
+type TzifTransitionDto struct {
+	TransitionTime int64                // Seconds since 1970-01-01 00:00:00 UTC
+	LocalTimeType  TzifLocalTimeTypeDto // The local time type in effect after the transition
+}
+
+// TzifLeapSecondDto - Describes a single leap second record
+// defined in a TZif (Time Zone Information Format) file. Leap
+// second records are only present in 'right/' time zone files.
+//
+type TzifLeapSecondDto struct {
+	Occurrence int64 // Seconds since 1970-01-01 00:00:00 UTC, including prior leap seconds
+	Correction int   // Total leap second correction in effect after 'Occurrence'
+}
+
+// TzifDataDto - Contains the parsed contents of a single TZif (Time
+// Zone Information Format) file. TZif files are compiled from the
+// IANA time zone database by the 'zic' compiler and are commonly
+// distributed in 'zoneinfo' directories or 'zoneinfo.zip' archives.
+//
+// This type supports TZif versions 1, 2 and 3. For versions 2 and
+// 3, the 64-bit data block and the footer TZ string are used. The
+// footer TZ string describes the rules applied to instants following
+// the last transition in the file.
+//
+// Instances of TzifDataDto are returned by TimeZoneDatabase.GetTzifData().
+//
+// Reference:
+//   https://datatracker.ietf.org/doc/html/rfc8536
+//   https://www.iana.org/time-zones
+//
+type TzifDataDto struct {
+	zoneName       string                 // The time zone name. Example: "America/Chicago"
+	version        int                    // TZif version 1, 2 or 3
+	transitions    []TzifTransitionDto    // Time zone transitions in chronological order
+	localTimeTypes []TzifLocalTimeTypeDto // Local time types defined by the TZif file
+	leapSeconds    []TzifLeapSecondDto    // Leap second records. Usually empty.
+	footerTzString string                 // Version 2+ footer TZ string. Example: "CST6CDT,M3.2.0,M11.1.0"
+	lock           *sync.Mutex
+}
+
+// CopyOut - Returns a deep copy of the current TzifDataDto
+// instance.
+//
+func (tzifData *TzifDataDto) CopyOut() TzifDataDto {
+
+	if tzifData.lock == nil {
+		tzifData.lock = new(sync.Mutex)
+	}
+
+	tzifData.lock.Lock()
+
+	defer tzifData.lock.Unlock()
+
+	newTzifData := TzifDataDto{
+		zoneName:       tzifData.zoneName,
+		version:        tzifData.version,
+		footerTzString: tzifData.footerTzString,
+		lock:           new(sync.Mutex),
+	}
+
+	newTzifData.transitions =
+		make([]TzifTransitionDto, len(tzifData.transitions))
+
+	copy(newTzifData.transitions, tzifData.transitions)
+
+	newTzifData.localTimeTypes =
+		make([]TzifLocalTimeTypeDto, len(tzifData.localTimeTypes))
+
+	copy(newTzifData.localTimeTypes, tzifData.localTimeTypes)
+
+	newTzifData.leapSeconds =
+		make([]TzifLeapSecondDto, len(tzifData.leapSeconds))
+
+	copy(newTzifData.leapSeconds, tzifData.leapSeconds)
+
+	return newTzifData
+}
+
+// GetFooterTzString - Returns the footer TZ string. The footer TZ
+// string uses the POSIX 'TZ' environment variable format and
+// describes the local time rules applied to instants following the
+// last transition. Version 1 TZif files do not contain a footer and
+// return an empty string.
+//
+// Example: "CST6CDT,M3.2.0,M11.1.0"
+//
+func (tzifData *TzifDataDto) GetFooterTzString() string {
+
+	if tzifData.lock == nil {
+		tzifData.lock = new(sync.Mutex)
+	}
+
+	tzifData.lock.Lock()
+
+	defer tzifData.lock.Unlock()
+
+	return tzifData.footerTzString
+}
+
+// GetLeapSeconds - Returns a copy of the leap second records. Most
+// TZif files do not contain leap second records and return an empty
+// array.
+//
+func (tzifData *TzifDataDto) GetLeapSeconds() []TzifLeapSecondDto {
+
+	if tzifData.lock == nil {
+		tzifData.lock = new(sync.Mutex)
+	}
+
+	tzifData.lock.Lock()
+
+	defer tzifData.lock.Unlock()
+
+	leapSeconds := make([]TzifLeapSecondDto, len(tzifData.leapSeconds))
+
+	copy(leapSeconds, tzifData.leapSeconds)
+
+	return leapSeconds
+}
+
+// GetLocalTimeTypes - Returns a copy of the local time types defined
+// by the TZif file.
+//
+func (tzifData *TzifDataDto) GetLocalTimeTypes() []TzifLocalTimeTypeDto {
+
+	if tzifData.lock == nil {
+		tzifData.lock = new(sync.Mutex)
+	}
+
+	tzifData.lock.Lock()
+
+	defer tzifData.lock.Unlock()
+
+	localTimeTypes := make([]TzifLocalTimeTypeDto, len(tzifData.localTimeTypes))
+
+	copy(localTimeTypes, tzifData.localTimeTypes)
+
+	return localTimeTypes
+}
+
+// GetTransitions - Returns a copy of the time zone transitions in
+// chronological order.
+//
+func (tzifData *TzifDataDto) GetTransitions() []TzifTransitionDto {
+
+	if tzifData.lock == nil {
+		tzifData.lock = new(sync.Mutex)
+	}
+
+	tzifData.lock.Lock()
+
+	defer tzifData.lock.Unlock()
+
+	transitions := make([]TzifTransitionDto, len(tzifData.transitions))
+
+	copy(transitions, tzifData.transitions)
+
+	return transitions
+}
+
+// GetVersion - Returns the TZif version number. Valid values are 1,
+// 2 and 3.
+//
+func (tzifData *TzifDataDto) GetVersion() int {
+
+	if tzifData.lock == nil {
+		tzifData.lock = new(sync.Mutex)
+	}
+
+	tzifData.lock.Lock()
+
+	defer tzifData.lock.Unlock()
+
+	return tzifData.version
+}
+
+// GetZoneName - Returns the name of the time zone described by this
+// TZif data. Example: "America/Chicago"
+//
+func (tzifData *TzifDataDto) GetZoneName() string {
+
+	if tzifData.lock == nil {
+		tzifData.lock = new(sync.Mutex)
+	}
+
+	tzifData.lock.Lock()
+
+	defer tzifData.lock.Unlock()
+
+	return tzifData.zoneName
+}
@@ -0,0 +1,471 @@
+package datetime
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+)
+
+// tzifHeaderLength - The length in bytes of a TZif header.
+//
+const tzifHeaderLength = 44
+
+// tzifHeader - Contains the version and element counts read from a
+// TZif header.
+//
+type tzifHeader struct {
+	version  int
+	isUtCnt  int
+	isStdCnt int
+	leapCnt  int
+	timeCnt  int
+	typeCnt  int
+	charCnt  int
+}
+
+// tzifMechanics - Provides helper methods used to parse TZif (Time
+// Zone Information Format) files as specified by RFC 8536.
+//
+// Reference:
+//   https://datatracker.ietf.org/doc/html/rfc8536
+//
+type tzifMechanics struct {
+	lock *sync.Mutex
+}
+
+// isTzifData - Returns 'true' if the byte array begins with the
+// TZif magic number, "TZif".
+//
+func (tzifMech *tzifMechanics) isTzifData(
+	data []byte) bool {
+
+	return len(data) >= 4 &&
+		string(data[0:4]) == "TZif"
+}
+
+// parseTzifData - Parses a TZif file and returns the result as a
+// new instance of TzifDataDto. TZif versions 1, 2 and 3 are
+// supported. For versions 2 and 3, the version 1 data block is
+// skipped and the 64-bit data block and footer are parsed.
+//
+func (tzifMech *tzifMechanics) parseTzifData(
+	zoneName string,
+	data []byte,
+	ePrefix string) (
+	tzifData TzifDataDto,
+	err error) {
+
+	if tzifMech.lock == nil {
+		tzifMech.lock = new(sync.Mutex)
+	}
+
+	tzifMech.lock.Lock()
+
+	defer tzifMech.lock.Unlock()
+
+	ePrefix += "tzifMechanics.parseTzifData() "
+
+	tzifData = TzifDataDto{}
+
+	if len(zoneName) == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: Input parameter 'zoneName' is an EMPTY STRING!\n")
+		return tzifData, err
+	}
+
+	ePrefix += "zoneName='" + zoneName + "' "
+
+	var hdr tzifHeader
+
+	hdr, err = tzifMech.readHeader(data, 0, ePrefix)
+
+	if err != nil {
+		return tzifData, err
+	}
+
+	offset := tzifHeaderLength
+
+	timeSize := 4
+
+	if hdr.version > 1 {
+
+		// Skip the version 1 data block
+		offset += tzifMech.getDataBlockLength(hdr, 4)
+
+		var hdr2 tzifHeader
+
+		hdr2, err = tzifMech.readHeader(data, offset, ePrefix)
+
+		if err != nil {
+			return tzifData, err
+		}
+
+		if hdr2.version != hdr.version {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: The version of the second TZif header does not match the first header.\n" +
+				"First Header Version='%v'  Second Header Version='%v'\n",
+				hdr.version, hdr2.version)
+			return tzifData, err
+		}
+
+		hdr = hdr2
+
+		offset += tzifHeaderLength
+
+		timeSize = 8
+	}
+
+	blockLength := tzifMech.getDataBlockLength(hdr, timeSize)
+
+	if offset+blockLength > len(data) {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TZif data block is truncated.\n" +
+			"Required Length='%v'  Actual Length='%v'\n",
+			offset+blockLength, len(data))
+		return tzifData, err
+	}
+
+	block := data[offset : offset+blockLength]
+
+	offset += blockLength
+
+	// Transition times
+	transitionTimes := make([]int64, hdr.timeCnt)
+
+	idx := 0
+
+	for i := 0; i < hdr.timeCnt; i++ {
+		transitionTimes[i] = tzifMech.readInt(block[idx:], timeSize)
+		idx += timeSize
+
+		if i > 0 &&
+			transitionTimes[i] <= transitionTimes[i-1] {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif transition times are NOT in ascending order.\n" +
+				"Transition Index='%v'\n", i)
+			return tzifData, err
+		}
+	}
+
+	// Transition types
+	transitionTypes := make([]int, hdr.timeCnt)
+
+	for i := 0; i < hdr.timeCnt; i++ {
+
+		transitionTypes[i] = int(block[idx])
+
+		idx++
+
+		if transitionTypes[i] >= hdr.typeCnt {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif transition type index is out of range.\n" +
+				"Transition Index='%v'  Type Index='%v'  Type Count='%v'\n",
+				i, transitionTypes[i], hdr.typeCnt)
+			return tzifData, err
+		}
+	}
+
+	// Local time type records
+	designationIdxs := make([]int, hdr.typeCnt)
+
+	tzifData.localTimeTypes = make([]TzifLocalTimeTypeDto, hdr.typeCnt)
+
+	for i := 0; i < hdr.typeCnt; i++ {
+
+		utOffset := tzifMech.readInt(block[idx:], 4)
+
+		if utOffset == math.MinInt32 {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif local time type UT offset is INVALID.\n" +
+				"Type Index='%v'  UT Offset='%v'\n",
+				i, utOffset)
+			return tzifData, err
+		}
+
+		if block[idx+4] > 1 {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif local time type DST indicator is INVALID.\n" +
+				"Type Index='%v'  DST Indicator='%v'\n",
+				i, block[idx+4])
+			return tzifData, err
+		}
+
+		tzifData.localTimeTypes[i].UtcOffsetSeconds = int(utOffset)
+		tzifData.localTimeTypes[i].IsDst = block[idx+4] == 1
+		designationIdxs[i] = int(block[idx+5])
+
+		idx += 6
+	}
+
+	// Time zone designations
+	designations := block[idx : idx+hdr.charCnt]
+
+	idx += hdr.charCnt
+
+	for i := 0; i < hdr.typeCnt; i++ {
+
+		if designationIdxs[i] >= hdr.charCnt {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif time zone designation index is out of range.\n" +
+				"Type Index='%v'  Designation Index='%v'  Character Count='%v'\n",
+				i, designationIdxs[i], hdr.charCnt)
+			return tzifData, err
+		}
+
+		nulIdx := bytes.IndexByte(designations[designationIdxs[i]:], 0)
+
+		if nulIdx < 0 {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif time zone designation is not NUL terminated.\n" +
+				"Type Index='%v'\n", i)
+			return tzifData, err
+		}
+
+		tzifData.localTimeTypes[i].Abbreviation =
+			string(designations[designationIdxs[i] : designationIdxs[i]+nulIdx])
+	}
+
+	// Leap second records
+	tzifData.leapSeconds = make([]TzifLeapSecondDto, hdr.leapCnt)
+
+	for i := 0; i < hdr.leapCnt; i++ {
+
+		tzifData.leapSeconds[i].Occurrence = tzifMech.readInt(block[idx:], timeSize)
+
+		idx += timeSize
+
+		tzifData.leapSeconds[i].Correction = int(tzifMech.readInt(block[idx:], 4))
+
+		idx += 4
+
+		if i > 0 &&
+			tzifData.leapSeconds[i].Occurrence <= tzifData.leapSeconds[i-1].Occurrence {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif leap second records are NOT in ascending order.\n" +
+				"Leap Second Index='%v'\n", i)
+			return tzifData, err
+		}
+	}
+
+	// Standard/wall indicators
+	for i := 0; i < hdr.isStdCnt; i++ {
+
+		if block[idx] > 1 {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif standard/wall indicator is INVALID.\n" +
+				"Type Index='%v'  Indicator='%v'\n",
+				i, block[idx])
+			return tzifData, err
+		}
+
+		tzifData.localTimeTypes[i].IsStandardTimeIndicator = block[idx] == 1
+
+		idx++
+	}
+
+	// UT/local indicators
+	for i := 0; i < hdr.isUtCnt; i++ {
+
+		if block[idx] > 1 {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif UT/local indicator is INVALID.\n" +
+				"Type Index='%v'  Indicator='%v'\n",
+				i, block[idx])
+			return tzifData, err
+		}
+
+		tzifData.localTimeTypes[i].IsUtIndicator = block[idx] == 1
+
+		if tzifData.localTimeTypes[i].IsUtIndicator &&
+			!tzifData.localTimeTypes[i].IsStandardTimeIndicator {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: TZif UT indicator is set but the standard time indicator is NOT set.\n" +
+				"Type Index='%v'\n", i)
+			return tzifData, err
+		}
+
+		idx++
+	}
+
+	tzifData.transitions = make([]TzifTransitionDto, hdr.timeCnt)
+
+	for i := 0; i < hdr.timeCnt; i++ {
+		tzifData.transitions[i].TransitionTime = transitionTimes[i]
+		tzifData.transitions[i].LocalTimeType =
+			tzifData.localTimeTypes[transitionTypes[i]]
+	}
+
+	if hdr.version > 1 {
+
+		// The footer consists of a TZ string enclosed by
+		// newline characters.
+		footer := data[offset:]
+
+		if len(footer) < 2 ||
+			footer[0] != '\n' {
+			err = errors.New(ePrefix + "\n" +
+				"Error: The TZif footer is missing or does NOT begin with a newline character.\n")
+			return tzifData, err
+		}
+
+		endIdx := bytes.IndexByte(footer[1:], '\n')
+
+		if endIdx < 0 {
+			err = errors.New(ePrefix + "\n" +
+				"Error: The TZif footer is NOT terminated by a newline character.\n")
+			return tzifData, err
+		}
+
+		tzifData.footerTzString = string(footer[1 : endIdx+1])
+	}
+
+	tzifData.zoneName = zoneName
+	tzifData.version = hdr.version
+	tzifData.lock = new(sync.Mutex)
+
+	return tzifData, err
+}
+
+// getDataBlockLength - Returns the length in bytes of a TZif data
+// block described by 'hdr'. 'timeSize' is 4 for a version 1 data
+// block and 8 for a version 2+ data block.
+//
+func (tzifMech *tzifMechanics) getDataBlockLength(
+	hdr tzifHeader,
+	timeSize int) int {
+
+	return hdr.timeCnt*timeSize +
+		hdr.timeCnt +
+		hdr.typeCnt*6 +
+		hdr.charCnt +
+		hdr.leapCnt*(timeSize+4) +
+		hdr.isStdCnt +
+		hdr.isUtCnt
+}
+
+// readHeader - Reads and validates a TZif header beginning at index
+// 'offset' of the byte array, 'data'.
+//
+func (tzifMech *tzifMechanics) readHeader(
+	data []byte,
+	offset int,
+	ePrefix string) (
+	hdr tzifHeader,
+	err error) {
+
+	ePrefix += "tzifMechanics.readHeader() "
+
+	hdr = tzifHeader{}
+
+	if offset+tzifHeaderLength > len(data) {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TZif header is truncated.\n" +
+			"Header Offset='%v'  Data Length='%v'\n",
+			offset, len(data))
+		return hdr, err
+	}
+
+	header := data[offset : offset+tzifHeaderLength]
+
+	if string(header[0:4]) != "TZif" {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TZif header does NOT begin with the magic number 'TZif'.\n" +
+			"Header Offset='%v'\n", offset)
+		return hdr, err
+	}
+
+	switch header[4] {
+	case 0:
+		hdr.version = 1
+	case '2':
+		hdr.version = 2
+	case '3':
+		hdr.version = 3
+	default:
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TZif version is NOT supported.\n" +
+			"Supported versions are 1, 2 and 3.\n" +
+			"Version Byte='%q'\n", header[4])
+		return hdr, err
+	}
+
+	counts := make([]int, 6)
+
+	for i := 0; i < 6; i++ {
+
+		count := binary.BigEndian.Uint32(header[20+i*4:])
+
+		if count > math.MaxInt32 {
+			err = fmt.Errorf(ePrefix + "\n" +
+				"Error: The TZif header count is INVALID.\n" +
+				"Count Index='%v'  Count='%v'\n",
+				i, count)
+			return hdr, err
+		}
+
+		counts[i] = int(count)
+	}
+
+	hdr.isUtCnt = counts[0]
+	hdr.isStdCnt = counts[1]
+	hdr.leapCnt = counts[2]
+	hdr.timeCnt = counts[3]
+	hdr.typeCnt = counts[4]
+	hdr.charCnt = counts[5]
+
+	if hdr.typeCnt == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: The TZif header local time type count is zero.\n")
+		return hdr, err
+	}
+
+	if hdr.typeCnt > 256 {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TZif header local time type count exceeds 256.\n" +
+			"Type Count='%v'\n", hdr.typeCnt)
+		return hdr, err
+	}
+
+	if hdr.charCnt == 0 {
+		err = errors.New(ePrefix + "\n" +
+			"Error: The TZif header character count is zero.\n")
+		return hdr, err
+	}
+
+	if hdr.isStdCnt != 0 &&
+		hdr.isStdCnt != hdr.typeCnt {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TZif standard/wall indicator count must be zero or equal to the type count.\n" +
+			"Indicator Count='%v'  Type Count='%v'\n",
+			hdr.isStdCnt, hdr.typeCnt)
+		return hdr, err
+	}
+
+	if hdr.isUtCnt != 0 &&
+		hdr.isUtCnt != hdr.typeCnt {
+		err = fmt.Errorf(ePrefix + "\n" +
+			"Error: The TZif UT/local indicator count must be zero or equal to the type count.\n" +
+			"Indicator Count='%v'  Type Count='%v'\n",
+			hdr.isUtCnt, hdr.typeCnt)
+		return hdr, err
+	}
+
+	return hdr, err
+}
+
+// readInt - Reads a big-endian, two's complement signed integer of
+// 'size' bytes. 'size' must be 4 or 8.
+//
+func (tzifMech *tzifMechanics) readInt(
+	data []byte,
+	size int) int64 {
+
+	if size == 4 {
+		return int64(int32(binary.BigEndian.Uint32(data)))
+	}
+
+	return int64(binary.BigEndian.Uint64(data))
+}
@@ -0,0 +1,369 @@
+package datetime
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// The binary wire format begins with a four byte header:
+//
+//   Byte 0-1  Magic characters 'D' 'T'
+//   Byte 2    Wire format version
+//   Byte 3    Type code identifying the encoded type
+//
+// The header is followed by the members of the wire format data
+// transfer object in declaration order. Integers are encoded as
+// signed or unsigned varints. Strings are encoded as an unsigned
+// varint byte length followed by the UTF-8 bytes. Booleans occupy a
+// single byte. An optional member is preceded by a presence byte.
+//
+const (
+	wireBinaryMagic0  = byte('D')
+	wireBinaryMagic1  = byte('T')
+	wireBinaryVersion = byte(1)
+)
+
+// Binary wire format type codes.
+const (
+	wireTypeCodeTimeZoneSpec   = byte(1)
+	wireTypeCodeTimeZoneDef    = byte(2)
+	wireTypeCodeDateTzDto      = byte(3)
+	wireTypeCodeTimeDto        = byte(4)
+	wireTypeCodeJulianDayNoDto = byte(5)
+	wireTypeCodeADateTimeDto   = byte(6)
+)
+
+// wireBinaryEncoder - Appends binary wire format values to a byte
+// buffer.
+//
+type wireBinaryEncoder struct {
+	buf []byte
+}
+
+// putHeader - Appends the binary wire format header for 'typeCode'.
+//
+func (enc *wireBinaryEncoder) putHeader(typeCode byte) {
+	enc.buf = append(enc.buf,
+		wireBinaryMagic0,
+		wireBinaryMagic1,
+		wireBinaryVersion,
+		typeCode)
+}
+
+func (enc *wireBinaryEncoder) putBool(value bool) {
+	if value {
+		enc.buf = append(enc.buf, 1)
+	} else {
+		enc.buf = append(enc.buf, 0)
+	}
+}
+
+func (enc *wireBinaryEncoder) putInt(value int64) {
+	enc.buf = binary.AppendVarint(enc.buf, value)
+}
+
+func (enc *wireBinaryEncoder) putUint(value uint64) {
+	enc.buf = binary.AppendUvarint(enc.buf, value)
+}
+
+func (enc *wireBinaryEncoder) putString(value string) {
+	enc.buf = binary.AppendUvarint(enc.buf, uint64(len(value)))
+	enc.buf = append(enc.buf, value...)
+}
+
+// wireBinaryDecoder - Reads binary wire format values from a byte
+// buffer. The first error encountered is retained in 'err' and all
+// subsequent reads return zero values.
+//
+type wireBinaryDecoder struct {
+	buf []byte
+	pos int
+	err error
+}
+
+// getHeader - Reads and verifies the binary wire format header.
+//
+func (dec *wireBinaryDecoder) getHeader(
+	typeCode byte,
+	ePrefix string) {
+
+	if dec.err != nil {
+		return
+	}
+
+	if len(dec.buf) < 4 ||
+		dec.buf[0] != wireBinaryMagic0 ||
+		dec.buf[1] != wireBinaryMagic1 {
+		dec.err = errors.New(ePrefix + "\n" +
+			"Error: The binary data is not in the date time wire format!\n")
+		return
+	}
+
+	if dec.buf[2] != wireBinaryVersion {
+		dec.err = fmt.Errorf(ePrefix+"\n"+
+			"Error: The binary wire format version is NOT supported!\n"+
+			"version='%v'\n", dec.buf[2])
+		return
+	}
+
+	if dec.buf[3] != typeCode {
+		dec.err = fmt.Errorf(ePrefix+"\n"+
+			"Error: The binary data encodes a different type!\n"+
+			"Expected type code='%v'\n"+
+			"  Actual type code='%v'\n", typeCode, dec.buf[3])
+		return
+	}
+
+	dec.pos = 4
+}
+
+func (dec *wireBinaryDecoder) getBool() bool {
+
+	if dec.err != nil {
+		return false
+	}
+
+	if dec.pos >= len(dec.buf) {
+		dec.err = errors.New("Error: The binary data is truncated!\n")
+		return false
+	}
+
+	value := dec.buf[dec.pos]
+
+	dec.pos++
+
+	if value > 1 {
+		dec.err = fmt.Errorf("Error: Invalid binary boolean value '%v'!\n", value)
+		return false
+	}
+
+	return value == 1
+}
+
+func (dec *wireBinaryDecoder) getInt() int64 {
+
+	if dec.err != nil {
+		return 0
+	}
+
+	value, n := binary.Varint(dec.buf[dec.pos:])
+
+	if n <= 0 {
+		dec.err = errors.New("Error: The binary data contains an invalid integer!\n")
+		return 0
+	}
+
+	dec.pos += n
+
+	return value
+}
+
+func (dec *wireBinaryDecoder) getUint() uint64 {
+
+	if dec.err != nil {
+		return 0
+	}
+
+	value, n := binary.Uvarint(dec.buf[dec.pos:])
+
+	if n <= 0 {
+		dec.err = errors.New("Error: The binary data contains an invalid unsigned integer!\n")
+		return 0
+	}
+
+	dec.pos += n
+
+	return value
+}
+
+func (dec *wireBinaryDecoder) getString() string {
+
+	length := dec.getUint()
+
+	if dec.err != nil {
+		return ""
+	}
+
+	if length > uint64(len(dec.buf)-dec.pos) {
+		dec.err = errors.New("Error: The binary data is truncated!\n")
+		return ""
+	}
+
+	value := string(dec.buf[dec.pos : dec.pos+int(length)])
+
+	dec.pos += int(length)
+
+	return value
+}
+
+// finish - Returns the first decoding error, if any. An error is
+// also returned if unread bytes remain in the buffer.
+//
+func (dec *wireBinaryDecoder) finish(ePrefix string) error {
+
+	if dec.err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error decoding binary wire format.\n"+
+			"%v", dec.err.Error())
+	}
+
+	if dec.pos != len(dec.buf) {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The binary data contains %v unexpected trailing bytes!\n",
+			len(dec.buf)-dec.pos)
+	}
+
+	return nil
+}
+
+func (tzSpecW *timeZoneSpecWire) encodeBinary(enc *wireBinaryEncoder) {
+	enc.putString(tzSpecW.ReferenceDateTime)
+	enc.putString(tzSpecW.LocationName)
+	enc.putString(tzSpecW.LocationNameType)
+	enc.putString(tzSpecW.ZoneName)
+	enc.putInt(int64(tzSpecW.UtcOffsetSeconds))
+	enc.putString(tzSpecW.MilitaryTimeZoneLetter)
+	enc.putString(tzSpecW.MilitaryTimeZoneName)
+	enc.putString(tzSpecW.ZoneLabel)
+	enc.putString(tzSpecW.TagDescription)
+	enc.putString(tzSpecW.TimeZoneCategory)
+	enc.putString(tzSpecW.TimeZoneClass)
+	enc.putString(tzSpecW.TimeZoneType)
+	enc.putString(tzSpecW.TimeZoneUtcOffsetStatus)
+}
+
+func (tzSpecW *timeZoneSpecWire) decodeBinary(dec *wireBinaryDecoder) {
+	tzSpecW.ReferenceDateTime = dec.getString()
+	tzSpecW.LocationName = dec.getString()
+	tzSpecW.LocationNameType = dec.getString()
+	tzSpecW.ZoneName = dec.getString()
+	tzSpecW.UtcOffsetSeconds = int(dec.getInt())
+	tzSpecW.MilitaryTimeZoneLetter = dec.getString()
+	tzSpecW.MilitaryTimeZoneName = dec.getString()
+	tzSpecW.ZoneLabel = dec.getString()
+	tzSpecW.TagDescription = dec.getString()
+	tzSpecW.TimeZoneCategory = dec.getString()
+	tzSpecW.TimeZoneClass = dec.getString()
+	tzSpecW.TimeZoneType = dec.getString()
+	tzSpecW.TimeZoneUtcOffsetStatus = dec.getString()
+}
+
+func (tzDefW *timeZoneDefWire) encodeBinary(enc *wireBinaryEncoder) {
+	tzDefW.OriginalTimeZone.encodeBinary(enc)
+	tzDefW.ConvertibleTimeZone.encodeBinary(enc)
+}
+
+func (tzDefW *timeZoneDefWire) decodeBinary(dec *wireBinaryDecoder) {
+	tzDefW.OriginalTimeZone.decodeBinary(dec)
+	tzDefW.ConvertibleTimeZone.decodeBinary(dec)
+}
+
+func (dTzW *dateTzDtoWire) encodeBinary(enc *wireBinaryEncoder) {
+	enc.putString(dTzW.DateTime)
+	enc.putString(dTzW.DateTimeFormat)
+	enc.putString(dTzW.TagDescription)
+	dTzW.TimeZone.encodeBinary(enc)
+}
+
+func (dTzW *dateTzDtoWire) decodeBinary(dec *wireBinaryDecoder) {
+	dTzW.DateTime = dec.getString()
+	dTzW.DateTimeFormat = dec.getString()
+	dTzW.TagDescription = dec.getString()
+	dTzW.TimeZone.decodeBinary(dec)
+}
+
+func (tDtoW *timeDtoWire) encodeBinary(enc *wireBinaryEncoder) {
+	enc.putInt(int64(tDtoW.Years))
+	enc.putInt(int64(tDtoW.Months))
+	enc.putInt(int64(tDtoW.Weeks))
+	enc.putInt(int64(tDtoW.WeekDays))
+	enc.putInt(int64(tDtoW.DateDays))
+	enc.putInt(int64(tDtoW.Hours))
+	enc.putInt(int64(tDtoW.Minutes))
+	enc.putInt(int64(tDtoW.Seconds))
+	enc.putInt(int64(tDtoW.Milliseconds))
+	enc.putInt(int64(tDtoW.Microseconds))
+	enc.putInt(int64(tDtoW.Nanoseconds))
+	enc.putInt(int64(tDtoW.TotSubSecNanoseconds))
+	enc.putInt(tDtoW.TotTimeNanoseconds)
+}
+
+func (tDtoW *timeDtoWire) decodeBinary(dec *wireBinaryDecoder) {
+	tDtoW.Years = int(dec.getInt())
+	tDtoW.Months = int(dec.getInt())
+	tDtoW.Weeks = int(dec.getInt())
+	tDtoW.WeekDays = int(dec.getInt())
+	tDtoW.DateDays = int(dec.getInt())
+	tDtoW.Hours = int(dec.getInt())
+	tDtoW.Minutes = int(dec.getInt())
+	tDtoW.Seconds = int(dec.getInt())
+	tDtoW.Milliseconds = int(dec.getInt())
+	tDtoW.Microseconds = int(dec.getInt())
+	tDtoW.Nanoseconds = int(dec.getInt())
+	tDtoW.TotSubSecNanoseconds = int(dec.getInt())
+	tDtoW.TotTimeNanoseconds = dec.getInt()
+}
+
+func (jDNW *julianDayNoDtoWire) encodeBinary(enc *wireBinaryEncoder) {
+	enc.putString(jDNW.JulianDayNoTime)
+	enc.putUint(uint64(jDNW.Precision))
+	enc.putBool(jDNW.HasLeapSecond)
+}
+
+func (jDNW *julianDayNoDtoWire) decodeBinary(dec *wireBinaryDecoder) {
+	jDNW.JulianDayNoTime = dec.getString()
+	jDNW.Precision = uint(dec.getUint())
+	jDNW.HasLeapSecond = dec.getBool()
+}
+
+func (aDateTimeW *aDateTimeDtoWire) encodeBinary(enc *wireBinaryEncoder) {
+	enc.putString(aDateTimeW.CalendarSystem)
+	enc.putInt(aDateTimeW.Year)
+	enc.putString(aDateTimeW.YearType)
+	enc.putInt(int64(aDateTimeW.Month))
+	enc.putInt(int64(aDateTimeW.Day))
+	enc.putBool(aDateTimeW.DateHasLeapSecond)
+	enc.putString(aDateTimeW.DateTag)
+	enc.putInt(int64(aDateTimeW.Hour))
+	enc.putInt(int64(aDateTimeW.Minute))
+	enc.putInt(int64(aDateTimeW.Second))
+	enc.putInt(int64(aDateTimeW.Nanosecond))
+	enc.putBool(aDateTimeW.TimeHasLeapSecond)
+	enc.putString(aDateTimeW.TimeTag)
+	aDateTimeW.TimeZone.encodeBinary(enc)
+
+	enc.putBool(aDateTimeW.JulianDayNumber != nil)
+
+	if aDateTimeW.JulianDayNumber != nil {
+		aDateTimeW.JulianDayNumber.encodeBinary(enc)
+	}
+
+	enc.putString(aDateTimeW.DateTimeFormat)
+	enc.putString(aDateTimeW.Tag)
+}
+
+func (aDateTimeW *aDateTimeDtoWire) decodeBinary(dec *wireBinaryDecoder) {
+	aDateTimeW.CalendarSystem = dec.getString()
+	aDateTimeW.Year = dec.getInt()
+	aDateTimeW.YearType = dec.getString()
+	aDateTimeW.Month = int(dec.getInt())
+	aDateTimeW.Day = int(dec.getInt())
+	aDateTimeW.DateHasLeapSecond = dec.getBool()
+	aDateTimeW.DateTag = dec.getString()
+	aDateTimeW.Hour = int(dec.getInt())
+	aDateTimeW.Minute = int(dec.getInt())
+	aDateTimeW.Second = int(dec.getInt())
+	aDateTimeW.Nanosecond = int(dec.getInt())
+	aDateTimeW.TimeHasLeapSecond = dec.getBool()
+	aDateTimeW.TimeTag = dec.getString()
+	aDateTimeW.TimeZone.decodeBinary(dec)
+
+	if dec.getBool() {
+		aDateTimeW.JulianDayNumber = &julianDayNoDtoWire{}
+		aDateTimeW.JulianDayNumber.decodeBinary(dec)
+	}
+
+	aDateTimeW.DateTimeFormat = dec.getString()
+	aDateTimeW.Tag = dec.getString()
+}
@@ -0,0 +1,98 @@
+package datetime
+
+// This source file defines the wire format data transfer objects
+// used to marshal and unmarshal date time types. The member names
+// and JSON keys of these objects constitute the stable wire format.
+// New members may be added. Existing members must NOT be renamed or
+// removed.
+//
+// Date times are encoded as RFC 3339 strings with nanosecond
+// precision. Enumerations are encoded as their String() values.
+
+// timeZoneSpecWire - Wire format for type TimeZoneSpecification.
+//
+type timeZoneSpecWire struct {
+	ReferenceDateTime       string `json:"referenceDateTime"`
+	LocationName            string `json:"locationName"`
+	LocationNameType        string `json:"locationNameType"`
+	ZoneName                string `json:"zoneName"`
+	UtcOffsetSeconds        int    `json:"utcOffsetSeconds"`
+	MilitaryTimeZoneLetter  string `json:"militaryTimeZoneLetter,omitempty"`
+	MilitaryTimeZoneName    string `json:"militaryTimeZoneName,omitempty"`
+	ZoneLabel               string `json:"zoneLabel"`
+	TagDescription          string `json:"tagDescription"`
+	TimeZoneCategory        string `json:"timeZoneCategory"`
+	TimeZoneClass           string `json:"timeZoneClass"`
+	TimeZoneType            string `json:"timeZoneType"`
+	TimeZoneUtcOffsetStatus string `json:"timeZoneUtcOffsetStatus"`
+}
+
+// timeZoneDefWire - Wire format for type TimeZoneDefinition.
+//
+type timeZoneDefWire struct {
+	OriginalTimeZone    timeZoneSpecWire `json:"originalTimeZone"`
+	ConvertibleTimeZone timeZoneSpecWire `json:"convertibleTimeZone"`
+}
+
+// dateTzDtoWire - Wire format for type DateTzDto. Time components
+// are derived from 'DateTime' and are not transmitted.
+//
+type dateTzDtoWire struct {
+	DateTime       string          `json:"dateTime"`
+	DateTimeFormat string          `json:"dateTimeFormat"`
+	TagDescription string          `json:"tagDescription"`
+	TimeZone       timeZoneDefWire `json:"timeZone"`
+}
+
+// timeDtoWire - Wire format for type TimeDto.
+//
+type timeDtoWire struct {
+	Years                int   `json:"years"`
+	Months               int   `json:"months"`
+	Weeks                int   `json:"weeks"`
+	WeekDays             int   `json:"weekDays"`
+	DateDays             int   `json:"dateDays"`
+	Hours                int   `json:"hours"`
+	Minutes              int   `json:"minutes"`
+	Seconds              int   `json:"seconds"`
+	Milliseconds         int   `json:"milliseconds"`
+	Microseconds         int   `json:"microseconds"`
+	Nanoseconds          int   `json:"nanoseconds"`
+	TotSubSecNanoseconds int   `json:"totSubSecNanoseconds"`
+	TotTimeNanoseconds   int64 `json:"totTimeNanoseconds"`
+}
+
+// julianDayNoDtoWire - Wire format for type JulianDayNoDto. The
+// signed Julian Day Number/Time is encoded as a decimal string which
+// reproduces the original value exactly when parsed at 'Precision'
+// bits.
+//
+type julianDayNoDtoWire struct {
+	JulianDayNoTime string `json:"julianDayNoTime"`
+	Precision       uint   `json:"precision"`
+	HasLeapSecond   bool   `json:"hasLeapSecond"`
+}
+
+// aDateTimeDtoWire - Wire format for type ADateTimeDto. 'Year' is
+// always an astronomical year. 'YearType' records the year numbering
+// type originally associated with the date.
+//
+type aDateTimeDtoWire struct {
+	CalendarSystem    string              `json:"calendarSystem"`
+	Year              int64               `json:"year"`
+	YearType          string              `json:"yearType"`
+	Month             int                 `json:"month"`
+	Day               int                 `json:"day"`
+	DateHasLeapSecond bool                `json:"dateHasLeapSecond"`
+	DateTag           string              `json:"dateTag"`
+	Hour              int                 `json:"hour"`
+	Minute            int                 `json:"minute"`
+	Second            int                 `json:"second"`
+	Nanosecond        int                 `json:"nanosecond"`
+	TimeHasLeapSecond bool                `json:"timeHasLeapSecond"`
+	TimeTag           string              `json:"timeTag"`
+	TimeZone          timeZoneDefWire     `json:"timeZone"`
+	JulianDayNumber   *julianDayNoDtoWire `json:"julianDayNumber,omitempty"`
+	DateTimeFormat    string              `json:"dateTimeFormat"`
+	Tag               string              `json:"tag"`
+}
@@ -0,0 +1,754 @@
+package datetime
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+)
+
+// wireFormatMechanics - Converts date time types to and from their
+// wire format data transfer objects. Reference source file
+// 'wireformatdtos.go'.
+//
+type wireFormatMechanics struct {
+	lock *sync.Mutex
+}
+
+// wireBinaryMessage - Implemented by wire format data transfer
+// objects which support the binary wire format.
+//
+type wireBinaryMessage interface {
+	encodeBinary(enc *wireBinaryEncoder)
+
+	decodeBinary(dec *wireBinaryDecoder)
+}
+
+// marshalBinary - Encodes 'msg' in the binary wire format with the
+// header for 'typeCode'.
+//
+func (wireMech *wireFormatMechanics) marshalBinary(
+	typeCode byte,
+	msg wireBinaryMessage) []byte {
+
+	enc := wireBinaryEncoder{}
+
+	enc.putHeader(typeCode)
+
+	msg.encodeBinary(&enc)
+
+	return enc.buf
+}
+
+// marshalJSON - Encodes 'msg' in the JSON wire format.
+//
+func (wireMech *wireFormatMechanics) marshalJSON(
+	msg interface{},
+	ePrefix string) (
+	[]byte,
+	error) {
+
+	data, err := json.Marshal(msg)
+
+	if err != nil {
+		return nil, fmt.Errorf(ePrefix+"\n"+
+			"Error returned by json.Marshal().\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	return data, nil
+}
+
+// unmarshalBinary - Decodes binary wire format 'data' into 'msg'.
+// 'data' must carry the header for 'typeCode'.
+//
+func (wireMech *wireFormatMechanics) unmarshalBinary(
+	data []byte,
+	typeCode byte,
+	msg wireBinaryMessage,
+	ePrefix string) error {
+
+	dec := wireBinaryDecoder{buf: data}
+
+	dec.getHeader(typeCode, ePrefix)
+
+	if dec.err != nil {
+		return dec.err
+	}
+
+	msg.decodeBinary(&dec)
+
+	return dec.finish(ePrefix)
+}
+
+// unmarshalJSON - Decodes JSON wire format 'data' into 'msg'.
+//
+func (wireMech *wireFormatMechanics) unmarshalJSON(
+	data []byte,
+	msg interface{},
+	ePrefix string) error {
+
+	err := json.Unmarshal(data, msg)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error returned by json.Unmarshal().\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	return nil
+}
+
+// parseWireDateTime - Parses an RFC 3339 wire format date time and
+// converts it to location 'locPtr'.
+//
+func (wireMech *wireFormatMechanics) parseWireDateTime(
+	dateTimeStr string,
+	locPtr *time.Location,
+	ePrefix string) (
+	time.Time,
+	error) {
+
+	dateTime, err := time.Parse(time.RFC3339Nano, dateTimeStr)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format date time is INVALID!\n"+
+			"dateTime='%v'\n"+
+			"Error='%v'\n", dateTimeStr, err.Error())
+	}
+
+	return dateTime.In(locPtr), nil
+}
+
+// aDateTimeDtoToWire - Converts 'aDateTimeDto' to its wire format.
+// Invalid instances cannot be converted.
+//
+func (wireMech *wireFormatMechanics) aDateTimeDtoToWire(
+	aDateTimeDto *ADateTimeDto,
+	ePrefix string) (
+	aDateTimeDtoWire,
+	error) {
+
+	ePrefix += "wireFormatMechanics.aDateTimeDtoToWire() "
+
+	aDateTimeDtoNanobot := aDateTimeDtoNanobot{}
+
+	_, err := aDateTimeDtoNanobot.testDateTransferDtoValidity(
+		aDateTimeDto,
+		ePrefix)
+
+	if err != nil {
+		return aDateTimeDtoWire{}, err
+	}
+
+	tzDefWire, err := wireMech.timeZoneDefToWire(
+		&aDateTimeDto.time.timeZone,
+		ePrefix)
+
+	if err != nil {
+		return aDateTimeDtoWire{}, err
+	}
+
+	wire := aDateTimeDtoWire{
+		CalendarSystem: aDateTimeDto.date.calendarBaseData.
+			GetCalendarSpecification().String(),
+		Year:              aDateTimeDto.date.astronomicalYear,
+		YearType:          aDateTimeDto.date.yearNumType.String(),
+		Month:             aDateTimeDto.date.month,
+		Day:               aDateTimeDto.date.day,
+		DateHasLeapSecond: aDateTimeDto.date.hasLeapSecond,
+		DateTag:           aDateTimeDto.date.tag,
+		Hour:              aDateTimeDto.time.hour,
+		Minute:            aDateTimeDto.time.minute,
+		Second:            aDateTimeDto.time.second,
+		Nanosecond:        aDateTimeDto.time.nanosecond,
+		TimeHasLeapSecond: aDateTimeDto.time.hasLeapSecond,
+		TimeTag:           aDateTimeDto.time.tag,
+		TimeZone:          tzDefWire,
+		DateTimeFormat:    aDateTimeDto.dateTimeFmt,
+		Tag:               aDateTimeDto.tag,
+	}
+
+	if aDateTimeDto.julianDayNumber.isThisInstanceValid {
+
+		jDNWire, err := wireMech.julianDayNoDtoToWire(
+			&aDateTimeDto.julianDayNumber,
+			ePrefix)
+
+		if err != nil {
+			return aDateTimeDtoWire{}, err
+		}
+
+		wire.JulianDayNumber = &jDNWire
+	}
+
+	return wire, nil
+}
+
+// aDateTimeDtoFromWire - Rebuilds 'aDateTimeDto' from its wire
+// format. 'aDateTimeDto' is only modified if the rebuilt instance is
+// valid.
+//
+func (wireMech *wireFormatMechanics) aDateTimeDtoFromWire(
+	aDateTimeDto *ADateTimeDto,
+	wire *aDateTimeDtoWire,
+	ePrefix string) error {
+
+	ePrefix += "wireFormatMechanics.aDateTimeDtoFromWire() "
+
+	calendarSystem, err := CalSpec.XParseString(wire.CalendarSystem, true)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format calendar system is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	yearType, err := CalYearType.XParseString(wire.YearType, true)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format year type is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	tzDef := TimeZoneDefinition{}
+
+	err = wireMech.timeZoneDefFromWire(&tzDef, &wire.TimeZone, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	newDateTimeDto, err := ADateTimeDto{}.New(
+		calendarSystem,
+		wire.Year,
+		CalYearType.Astronomical(),
+		wire.Month,
+		wire.Day,
+		wire.DateHasLeapSecond,
+		wire.Hour,
+		wire.Minute,
+		wire.Second,
+		wire.Nanosecond,
+		tzDef.convertibleTimeZone.locationName,
+		wire.DateTimeFormat,
+		wire.Tag,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	newDateTimeDto.date.yearNumType = yearType
+	newDateTimeDto.date.yearNumberingMode = yearType.XGetCalendarYearNumberMode()
+	newDateTimeDto.date.hasLeapSecond = wire.DateHasLeapSecond
+	newDateTimeDto.date.tag = wire.DateTag
+	newDateTimeDto.time.hasLeapSecond = wire.TimeHasLeapSecond
+	newDateTimeDto.time.tag = wire.TimeTag
+	newDateTimeDto.time.timeZone = tzDef
+
+	if wire.JulianDayNumber != nil {
+
+		err = wireMech.julianDayNoDtoFromWire(
+			&newDateTimeDto.julianDayNumber,
+			wire.JulianDayNumber,
+			ePrefix)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	aDateTimeDtoNanobot := aDateTimeDtoNanobot{}
+
+	_, err = aDateTimeDtoNanobot.testDateTransferDtoValidity(
+		&newDateTimeDto,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lock := aDateTimeDto.lock
+
+	*aDateTimeDto = newDateTimeDto
+
+	aDateTimeDto.lock = lock
+
+	return nil
+}
+
+// dateTzDtoToWire - Converts 'dTz' to its wire format. Invalid
+// instances cannot be converted.
+//
+func (wireMech *wireFormatMechanics) dateTzDtoToWire(
+	dTz *DateTzDto,
+	ePrefix string) (
+	dateTzDtoWire,
+	error) {
+
+	ePrefix += "wireFormatMechanics.dateTzDtoToWire() "
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err := dTzUtil.isValidDateTzDto(dTz, ePrefix)
+
+	if err != nil {
+		return dateTzDtoWire{}, err
+	}
+
+	tzDefWire, err := wireMech.timeZoneDefToWire(&dTz.timeZone, ePrefix)
+
+	if err != nil {
+		return dateTzDtoWire{}, err
+	}
+
+	return dateTzDtoWire{
+		DateTime:       dTz.dateTimeValue.Format(time.RFC3339Nano),
+		DateTimeFormat: dTz.dateTimeFmt,
+		TagDescription: dTz.tagDescription,
+		TimeZone:       tzDefWire,
+	}, nil
+}
+
+// dateTzDtoFromWire - Rebuilds 'dTz' from its wire format. The date
+// time is expressed in the original time zone. Time components are
+// recomputed from the date time. 'dTz' is only modified if the
+// rebuilt instance is valid.
+//
+func (wireMech *wireFormatMechanics) dateTzDtoFromWire(
+	dTz *DateTzDto,
+	wire *dateTzDtoWire,
+	ePrefix string) error {
+
+	ePrefix += "wireFormatMechanics.dateTzDtoFromWire() "
+
+	tzDef := TimeZoneDefinition{}
+
+	err := wireMech.timeZoneDefFromWire(&tzDef, &wire.TimeZone, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	dateTime, err := wireMech.parseWireDateTime(
+		wire.DateTime,
+		tzDef.originalTimeZone.locationPtr,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	tDto := TimeDto{}
+
+	tDtoUtil := timeDtoUtility{}
+
+	err = tDtoUtil.setFromDateTime(&tDto, dateTime, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	newDTz := DateTzDto{
+		dateTimeValue:  dateTime,
+		dateTimeFmt:    wire.DateTimeFormat,
+		tagDescription: wire.TagDescription,
+		timeComponents: tDto,
+		timeZone:       tzDef,
+		lock:           new(sync.Mutex),
+	}
+
+	dTzUtil := dateTzDtoUtility{}
+
+	err = dTzUtil.isValidDateTzDto(&newDTz, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lock := dTz.lock
+
+	*dTz = newDTz
+
+	dTz.lock = lock
+
+	return nil
+}
+
+// julianDayNoDtoToWire - Converts 'jDNDto' to its wire format.
+// Invalid instances cannot be converted.
+//
+func (wireMech *wireFormatMechanics) julianDayNoDtoToWire(
+	jDNDto *JulianDayNoDto,
+	ePrefix string) (
+	julianDayNoDtoWire,
+	error) {
+
+	ePrefix += "wireFormatMechanics.julianDayNoDtoToWire() "
+
+	jDNNanobot := julianDayNoNanobot{}
+
+	_, err := jDNNanobot.testJulianDayNoDtoValidity(jDNDto, ePrefix)
+
+	if err != nil {
+		return julianDayNoDtoWire{}, err
+	}
+
+	// Text() with a precision of -1 generates the minimal number of
+	// decimal digits required to reproduce the value exactly.
+	julianDayNoTimeStr := jDNDto.julianDayNoTime.Text('f', -1)
+
+	if jDNDto.julianDayNoNumericalSign == -1 {
+		julianDayNoTimeStr = "-" + julianDayNoTimeStr
+	}
+
+	return julianDayNoDtoWire{
+		JulianDayNoTime: julianDayNoTimeStr,
+		Precision:       jDNDto.julianDayNoTime.Prec(),
+		HasLeapSecond:   jDNDto.hasLeapSecond,
+	}, nil
+}
+
+// julianDayNoDtoFromWire - Rebuilds 'jDNDto' from its wire format.
+// 'jDNDto' is only modified if the rebuilt instance is valid.
+//
+func (wireMech *wireFormatMechanics) julianDayNoDtoFromWire(
+	jDNDto *JulianDayNoDto,
+	wire *julianDayNoDtoWire,
+	ePrefix string) error {
+
+	ePrefix += "wireFormatMechanics.julianDayNoDtoFromWire() "
+
+	if wire.Precision == 0 ||
+		wire.Precision > big.MaxPrec {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format precision is INVALID!\n"+
+			"precision='%v'\n", wire.Precision)
+	}
+
+	julianDayNoTime, _, err := big.ParseFloat(
+		wire.JulianDayNoTime,
+		10,
+		wire.Precision,
+		big.ToNearestAway)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format Julian Day Number Time is INVALID!\n"+
+			"julianDayNoTime='%v'\n"+
+			"Error='%v'\n", wire.JulianDayNoTime, err.Error())
+	}
+
+	newJDNDto := JulianDayNoDto{}
+
+	jDNMech := julianDayNoDtoMechanics{}
+
+	err = jDNMech.rationalizeJulianDayNoDto(&newJDNDto, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	err = jDNMech.setBigValDto(
+		&newJDNDto,
+		julianDayNoTime,
+		wire.Precision,
+		wire.HasLeapSecond,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lock := jDNDto.lock
+
+	*jDNDto = newJDNDto
+
+	jDNDto.lock = lock
+
+	return nil
+}
+
+// timeDtoToWire - Converts 'tDto' to its wire format.
+//
+func (wireMech *wireFormatMechanics) timeDtoToWire(
+	tDto *TimeDto) timeDtoWire {
+
+	return timeDtoWire{
+		Years:                tDto.Years,
+		Months:               tDto.Months,
+		Weeks:                tDto.Weeks,
+		WeekDays:             tDto.WeekDays,
+		DateDays:             tDto.DateDays,
+		Hours:                tDto.Hours,
+		Minutes:              tDto.Minutes,
+		Seconds:              tDto.Seconds,
+		Milliseconds:         tDto.Milliseconds,
+		Microseconds:         tDto.Microseconds,
+		Nanoseconds:          tDto.Nanoseconds,
+		TotSubSecNanoseconds: tDto.TotSubSecNanoseconds,
+		TotTimeNanoseconds:   tDto.TotTimeNanoseconds,
+	}
+}
+
+// timeDtoFromWire - Restores the members of 'tDto' from its wire
+// format. Because a TimeDto may hold either date time components or
+// duration components, the restored values are NOT validated.
+//
+func (wireMech *wireFormatMechanics) timeDtoFromWire(
+	tDto *TimeDto,
+	wire *timeDtoWire) {
+
+	tDto.Years = wire.Years
+	tDto.Months = wire.Months
+	tDto.Weeks = wire.Weeks
+	tDto.WeekDays = wire.WeekDays
+	tDto.DateDays = wire.DateDays
+	tDto.Hours = wire.Hours
+	tDto.Minutes = wire.Minutes
+	tDto.Seconds = wire.Seconds
+	tDto.Milliseconds = wire.Milliseconds
+	tDto.Microseconds = wire.Microseconds
+	tDto.Nanoseconds = wire.Nanoseconds
+	tDto.TotSubSecNanoseconds = wire.TotSubSecNanoseconds
+	tDto.TotTimeNanoseconds = wire.TotTimeNanoseconds
+}
+
+// timeZoneDefToWire - Converts 'tzDef' to its wire format. Both the
+// original and the convertible time zones are retained.
+//
+func (wireMech *wireFormatMechanics) timeZoneDefToWire(
+	tzDef *TimeZoneDefinition,
+	ePrefix string) (
+	timeZoneDefWire,
+	error) {
+
+	ePrefix += "wireFormatMechanics.timeZoneDefToWire() "
+
+	originalWire, err := wireMech.timeZoneSpecToWire(
+		&tzDef.originalTimeZone,
+		ePrefix+"originalTimeZone ")
+
+	if err != nil {
+		return timeZoneDefWire{}, err
+	}
+
+	convertibleWire, err := wireMech.timeZoneSpecToWire(
+		&tzDef.convertibleTimeZone,
+		ePrefix+"convertibleTimeZone ")
+
+	if err != nil {
+		return timeZoneDefWire{}, err
+	}
+
+	return timeZoneDefWire{
+		OriginalTimeZone:    originalWire,
+		ConvertibleTimeZone: convertibleWire,
+	}, nil
+}
+
+// timeZoneDefFromWire - Rebuilds 'tzDef' from its wire format.
+// 'tzDef' is only modified if the rebuilt instance is valid.
+//
+func (wireMech *wireFormatMechanics) timeZoneDefFromWire(
+	tzDef *TimeZoneDefinition,
+	wire *timeZoneDefWire,
+	ePrefix string) error {
+
+	ePrefix += "wireFormatMechanics.timeZoneDefFromWire() "
+
+	newTzDef := TimeZoneDefinition{
+		lock: new(sync.Mutex),
+	}
+
+	err := wireMech.timeZoneSpecFromWire(
+		&newTzDef.originalTimeZone,
+		&wire.OriginalTimeZone,
+		ePrefix+"originalTimeZone ")
+
+	if err != nil {
+		return err
+	}
+
+	err = wireMech.timeZoneSpecFromWire(
+		&newTzDef.convertibleTimeZone,
+		&wire.ConvertibleTimeZone,
+		ePrefix+"convertibleTimeZone ")
+
+	if err != nil {
+		return err
+	}
+
+	tzDefUtil := timeZoneDefUtility{}
+
+	err = tzDefUtil.isValidTimeZoneDef(&newTzDef, ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	lock := tzDef.lock
+
+	*tzDef = newTzDef
+
+	tzDef.lock = lock
+
+	return nil
+}
+
+// timeZoneSpecToWire - Converts 'tzSpec' to its wire format. An
+// instance without a time zone location cannot be converted.
+//
+func (wireMech *wireFormatMechanics) timeZoneSpecToWire(
+	tzSpec *TimeZoneSpecification,
+	ePrefix string) (
+	timeZoneSpecWire,
+	error) {
+
+	ePrefix += "wireFormatMechanics.timeZoneSpecToWire() "
+
+	if tzSpec.locationPtr == nil ||
+		tzSpec.locationName == "" {
+		return timeZoneSpecWire{},
+			errors.New(ePrefix + "\n" +
+				"Error: The Time Zone Specification does NOT contain a " +
+				"time zone location!\n")
+	}
+
+	return timeZoneSpecWire{
+		ReferenceDateTime:       tzSpec.referenceDateTime.Format(time.RFC3339Nano),
+		LocationName:            tzSpec.locationName,
+		LocationNameType:        tzSpec.locationNameType.String(),
+		ZoneName:                tzSpec.zoneName,
+		UtcOffsetSeconds:        tzSpec.zoneOffsetTotalSeconds,
+		MilitaryTimeZoneLetter:  tzSpec.militaryTimeZoneLetter,
+		MilitaryTimeZoneName:    tzSpec.militaryTimeZoneName,
+		ZoneLabel:               tzSpec.zoneLabel,
+		TagDescription:          tzSpec.tagDescription,
+		TimeZoneCategory:        tzSpec.timeZoneCategory.String(),
+		TimeZoneClass:           tzSpec.timeZoneClass.String(),
+		TimeZoneType:            tzSpec.timeZoneType.String(),
+		TimeZoneUtcOffsetStatus: tzSpec.timeZoneUtcOffsetStatus.String(),
+	}, nil
+}
+
+// timeZoneSpecFromWire - Rebuilds 'tzSpec' from its wire format.
+//
+// The time zone location is loaded by name. If the location cannot
+// be loaded and the wire format identifies it as a non-convertible
+// time zone, a fixed time zone with the recorded UTC offset is
+// substituted. Offset values are recomputed from the reference date
+// time. Classification values are restored as recorded.
+//
+func (wireMech *wireFormatMechanics) timeZoneSpecFromWire(
+	tzSpec *TimeZoneSpecification,
+	wire *timeZoneSpecWire,
+	ePrefix string) error {
+
+	ePrefix += "wireFormatMechanics.timeZoneSpecFromWire() "
+
+	locNameType, err := LocNameType.XParseString(wire.LocationNameType, true)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format location name type is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	tzCategory, err := TzCat.XParseString(wire.TimeZoneCategory, true)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format time zone category is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	tzClass, err := TzClass.XParseString(wire.TimeZoneClass, true)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format time zone class is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	tzType, err := TzType.XParseString(wire.TimeZoneType, true)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format time zone type is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	tzUtcOffsetStatus, err := TzUtcStatus.XParseString(wire.TimeZoneUtcOffsetStatus, true)
+
+	if err != nil {
+		return fmt.Errorf(ePrefix+"\n"+
+			"Error: The wire format time zone UTC offset status is INVALID!\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	dtMech := DTimeNanobot{}
+
+	locPtr, err := dtMech.LoadTzLocation(wire.LocationName, ePrefix)
+
+	if err != nil {
+
+		if locNameType != LocNameType.NonConvertibleTimeZone() {
+			return fmt.Errorf(ePrefix+"\n"+
+				"Error: The wire format time zone location could NOT be loaded!\n"+
+				"locationName='%v'\n"+
+				"Error='%v'\n", wire.LocationName, err.Error())
+		}
+
+		locPtr = time.FixedZone(wire.LocationName, wire.UtcOffsetSeconds)
+	}
+
+	referenceDateTime, err := wireMech.parseWireDateTime(
+		wire.ReferenceDateTime,
+		locPtr,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	newTzSpec := TimeZoneSpecification{}
+
+	tzSpecUtil := timeZoneSpecUtility{}
+
+	err = tzSpecUtil.setTimeZone(
+		&newTzSpec,
+		referenceDateTime,
+		wire.MilitaryTimeZoneLetter,
+		wire.MilitaryTimeZoneName,
+		wire.ZoneLabel,
+		wire.TagDescription,
+		tzClass,
+		ePrefix)
+
+	if err != nil {
+		return err
+	}
+
+	newTzSpec.locationNameType = locNameType
+	newTzSpec.timeZoneCategory = tzCategory
+	newTzSpec.timeZoneType = tzType
+	newTzSpec.timeZoneUtcOffsetStatus = tzUtcOffsetStatus
+
+	lock := tzSpec.lock
+
+	*tzSpec = newTzSpec
+
+	tzSpec.lock = lock
+
+	if tzSpec.lock == nil {
+		tzSpec.lock = new(sync.Mutex)
+	}
+
+	return nil
+}
@@ -0,0 +1,608 @@
+package datetime
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAstronomicalCalculator01(t *testing.T) {
+
+	ePrefix := "TestAstronomicalCalculator01() "
+
+	utcTzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		time.Now(),
+		TZones.UTC(),
+		TzConvertType.Absolute())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName(UTC)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// 2024 equinoxes and solstices. Source: U.S. Naval Observatory,
+	// Earth's Seasons 2024.
+	expectedEvents := []struct {
+		eventType AstronomicalEventType
+		dateTime  time.Time
+	}{
+		{AstroEventType.MarchEquinox(), time.Date(2024, 3, 20, 3, 6, 0, 0, time.UTC)},
+		{AstroEventType.JuneSolstice(), time.Date(2024, 6, 20, 20, 51, 0, 0, time.UTC)},
+		{AstroEventType.SeptemberEquinox(), time.Date(2024, 9, 22, 12, 44, 0, 0, time.UTC)},
+		{AstroEventType.DecemberSolstice(), time.Date(2024, 12, 21, 9, 20, 0, 0, time.UTC)},
+	}
+
+	events, err := AstronomicalCalculator{}.GetEquinoxesAndSolstices(
+		2024,
+		utcTzDef,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetEquinoxesAndSolstices(2024)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(events) != len(expectedEvents) {
+		t.Errorf("Error: Expected %v events. Instead, %v events were returned.\n",
+			len(expectedEvents), len(events))
+		return
+	}
+
+	for i, expected := range expectedEvents {
+
+		if events[i].GetEventType() != expected.eventType {
+			t.Errorf("Error: Event #%v Expected event type='%v'\n"+
+				"Instead, event type='%v'\n",
+				i, expected.eventType.String(), events[i].GetEventType().String())
+		}
+
+		dTz := events[i].GetDateTime()
+
+		actual := dTz.GetDateTimeValue()
+
+		if math.Abs(actual.Sub(expected.dateTime).Minutes()) > 2.0 {
+			t.Errorf("Error: %v Expected date time='%v'\n"+
+				"Instead, date time='%v'\n",
+				expected.eventType.String(), expected.dateTime, actual)
+		}
+
+		julianDayNo, err := events[i].GetJulianDayNo(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by events[%v].GetJulianDayNo()\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		julianDayFloat, err := julianDayNo.GetDayNoTimeFloat64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNo.GetDayNoTimeFloat64()\n"+
+				"Error='%v'\n", err.Error())
+			continue
+		}
+
+		expectedJulianDay := 2440587.5 + float64(expected.dateTime.Unix())/86400.0
+
+		if math.Abs(julianDayFloat-expectedJulianDay) > 0.0015 {
+			t.Errorf("Error: %v Expected Julian Day Number/Time='%v'\n"+
+				"Instead, Julian Day Number/Time='%v'\n",
+				expected.eventType.String(), expectedJulianDay, julianDayFloat)
+		}
+	}
+
+	// The March 2024 equinox expressed in the New York time zone.
+	nyTzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		time.Now(),
+		TZones.America.New_York(),
+		TzConvertType.Absolute())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName(New_York)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	events, err = AstronomicalCalculator{}.GetEquinoxesAndSolstices(
+		2024,
+		nyTzDef,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetEquinoxesAndSolstices(2024, New_York)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dTz := events[0].GetDateTime()
+
+	actualStr := dTz.GetDateTimeValue().Format("2006-01-02 15:04 MST")
+
+	if actualStr != "2024-03-19 23:06 EDT" {
+		t.Errorf("Error: Expected March equinox='2024-03-19 23:06 EDT'\n"+
+			"Instead, March equinox='%v'\n", actualStr)
+	}
+}
+
+func TestAstronomicalCalculator02(t *testing.T) {
+
+	ePrefix := "TestAstronomicalCalculator02() "
+
+	utcTzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		time.Now(),
+		TZones.UTC(),
+		TzConvertType.Absolute())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName(UTC)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	events, err := AstronomicalCalculator{}.GetMoonPhases(
+		2024,
+		utcTzDef,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetMoonPhases(2024)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// 2024 contains 13 New Moons, 12 First Quarters, 12 Full Moons
+	// and 13 Last Quarters.
+	if len(events) != 50 {
+		t.Errorf("Error: Expected 50 Moon phases in 2024.\n"+
+			"Instead, %v Moon phases were returned.\n", len(events))
+	}
+
+	// Source: U.S. Naval Observatory, Phases of the Moon 2024.
+	expectedEvents := []struct {
+		eventType AstronomicalEventType
+		dateTime  time.Time
+	}{
+		{AstroEventType.LastQuarter(), time.Date(2024, 1, 4, 3, 30, 0, 0, time.UTC)},
+		{AstroEventType.NewMoon(), time.Date(2024, 1, 11, 11, 57, 0, 0, time.UTC)},
+		{AstroEventType.FirstQuarter(), time.Date(2024, 1, 18, 3, 53, 0, 0, time.UTC)},
+		{AstroEventType.FullMoon(), time.Date(2024, 1, 25, 17, 54, 0, 0, time.UTC)},
+		{AstroEventType.NewMoon(), time.Date(2024, 4, 8, 18, 21, 0, 0, time.UTC)},
+		{AstroEventType.FullMoon(), time.Date(2024, 9, 18, 2, 34, 0, 0, time.UTC)},
+		{AstroEventType.NewMoon(), time.Date(2024, 12, 30, 22, 27, 0, 0, time.UTC)},
+	}
+
+	for _, expected := range expectedEvents {
+
+		isFound := false
+
+		for i := 0; i < len(events); i++ {
+
+			dTz := events[i].GetDateTime()
+
+			actual := dTz.GetDateTimeValue()
+
+			if events[i].GetEventType() != expected.eventType ||
+				math.Abs(actual.Sub(expected.dateTime).Hours()) > 24.0 {
+				continue
+			}
+
+			isFound = true
+
+			if math.Abs(actual.Sub(expected.dateTime).Minutes()) > 2.0 {
+				t.Errorf("Error: %v Expected date time='%v'\n"+
+					"Instead, date time='%v'\n",
+					expected.eventType.String(), expected.dateTime, actual)
+			}
+
+			break
+		}
+
+		if !isFound {
+			t.Errorf("Error: %v on '%v' was NOT found!\n",
+				expected.eventType.String(), expected.dateTime)
+		}
+	}
+
+	for i := 1; i < len(events); i++ {
+
+		previous := events[i-1].GetDateTime()
+		current := events[i].GetDateTime()
+
+		if !previous.GetDateTimeValue().Before(current.GetDateTimeValue()) {
+			t.Errorf("Error: Moon phases are NOT in chronological order.\n"+
+				"events[%v]='%v' events[%v]='%v'\n",
+				i-1, previous.GetDateTimeValue(), i, current.GetDateTimeValue())
+			break
+		}
+	}
+}
+
+func TestAstronomicalCalculator03(t *testing.T) {
+
+	ePrefix := "TestAstronomicalCalculator03() "
+
+	londonTzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		time.Now(),
+		TZones.Europe.London(),
+		TzConvertType.Absolute())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName(London)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	events, err := AstronomicalCalculator{}.GetSunEvents(
+		2024,
+		6,
+		20,
+		51.5074,
+		-0.1278,
+		londonTzDef,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetSunEvents(London)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// Source: HM Nautical Almanac Office / timeanddate.com for
+	// London on June 20, 2024.
+	expectedEvents := []struct {
+		eventType AstronomicalEventType
+		dateTime  string
+	}{
+		{AstroEventType.CivilDawn(), "2024-06-20 03:55 BST"},
+		{AstroEventType.Sunrise(), "2024-06-20 04:43 BST"},
+		{AstroEventType.SolarNoon(), "2024-06-20 13:02 BST"},
+		{AstroEventType.Sunset(), "2024-06-20 21:21 BST"},
+		{AstroEventType.CivilDusk(), "2024-06-20 22:09 BST"},
+	}
+
+	fmtStr := "2006-01-02 15:04 MST"
+
+	if len(events) != len(expectedEvents) {
+		t.Errorf("Error: Expected %v sun events. Instead, %v events were returned.\n",
+			len(expectedEvents), len(events))
+		return
+	}
+
+	for i, expected := range expectedEvents {
+
+		if events[i].GetEventType() != expected.eventType {
+			t.Errorf("Error: Event #%v Expected event type='%v'\n"+
+				"Instead, event type='%v'\n",
+				i, expected.eventType.String(), events[i].GetEventType().String())
+			continue
+		}
+
+		dTz := events[i].GetDateTime()
+
+		actual := dTz.GetDateTimeValue()
+
+		expectedTime, _ := time.ParseInLocation(
+			fmtStr,
+			expected.dateTime,
+			actual.Location())
+
+		if actual.Format("MST") != "BST" ||
+			math.Abs(actual.Sub(expectedTime).Minutes()) > 2.0 {
+			t.Errorf("Error: %v Expected date time='%v'\n"+
+				"Instead, date time='%v'\n",
+				expected.eventType.String(), expected.dateTime, actual.Format(fmtStr))
+		}
+	}
+
+	osloTzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		time.Now(),
+		TZones.Europe.Oslo(),
+		TzConvertType.Absolute())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName(Oslo)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// Tromsø, Norway experiences the polar day in June. Only solar
+	// noon occurs.
+	events, err = AstronomicalCalculator{}.GetSunEvents(
+		2024,
+		6,
+		20,
+		69.6492,
+		18.9553,
+		osloTzDef,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetSunEvents(Tromsø, June)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(events) != 1 ||
+		events[0].GetEventType() != AstroEventType.SolarNoon() {
+		t.Errorf("Error: Expected only solar noon during the polar day.\n"+
+			"Instead, %v events were returned.\n", len(events))
+	}
+
+	// During the polar night the Sun does not rise, but civil
+	// twilight still occurs.
+	events, err = AstronomicalCalculator{}.GetSunEvents(
+		2024,
+		12,
+		20,
+		69.6492,
+		18.9553,
+		osloTzDef,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetSunEvents(Tromsø, December)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(events) != 3 ||
+		events[0].GetEventType() != AstroEventType.CivilDawn() ||
+		events[1].GetEventType() != AstroEventType.SolarNoon() ||
+		events[2].GetEventType() != AstroEventType.CivilDusk() {
+		t.Errorf("Error: Expected civil dawn, solar noon and civil dusk during "+
+			"the polar night.\nInstead, %v events were returned.\n", len(events))
+	}
+
+	nyTzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		time.Now(),
+		TZones.America.New_York(),
+		TzConvertType.Absolute())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName(New_York)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// Daylight saving time begins in New York on March 10, 2024.
+	// Sunrise 07:15 EDT, sunset 18:58 EDT.
+	events, err = AstronomicalCalculator{}.GetSunEvents(
+		2024,
+		3,
+		10,
+		40.7128,
+		-74.0060,
+		nyTzDef,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetSunEvents(New York)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(events) != 5 {
+		t.Errorf("Error: Expected 5 sun events for New York.\n"+
+			"Instead, %v events were returned.\n", len(events))
+		return
+	}
+
+	sunrise := events[1].GetDateTime()
+	sunset := events[3].GetDateTime()
+
+	if sunrise.GetDateTimeValue().Format(fmtStr) != "2024-03-10 07:14 EDT" &&
+		sunrise.GetDateTimeValue().Format(fmtStr) != "2024-03-10 07:15 EDT" {
+		t.Errorf("Error: Expected New York sunrise='2024-03-10 07:15 EDT'\n"+
+			"Instead, sunrise='%v'\n", sunrise.GetDateTimeValue().Format(fmtStr))
+	}
+
+	if sunset.GetDateTimeValue().Format(fmtStr) != "2024-03-10 18:57 EDT" &&
+		sunset.GetDateTimeValue().Format(fmtStr) != "2024-03-10 18:58 EDT" {
+		t.Errorf("Error: Expected New York sunset='2024-03-10 18:58 EDT'\n"+
+			"Instead, sunset='%v'\n", sunset.GetDateTimeValue().Format(fmtStr))
+	}
+}
+
+func TestAstronomicalCalculator04(t *testing.T) {
+
+	ePrefix := "TestAstronomicalCalculator04() "
+
+	// Meeus, Astronomical Algorithms, 2nd Ed., Example 25.a.
+	// 1992 October 13.0 TD
+	_, julianDayNo, err := JulianDayNoDto{}.NewFromGregorianDate(
+		time.Date(1992, 10, 13, 0, 0, 0, 0, time.UTC),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromGregorianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	solarPos, err := AstronomicalCalculator{}.GetSolarPosition(
+		julianDayNo,
+		0.0,
+		0.0,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetSolarPosition(1992-10-13)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	expectedValues := []struct {
+		name     string
+		expected float64
+		actual   float64
+	}{
+		{"apparent longitude", 199.90988, solarPos.GetEclipticLongitude()},
+		{"right ascension", 198.38083, solarPos.GetRightAscension()},
+		{"declination", -7.78507, solarPos.GetDeclination()},
+	}
+
+	for _, value := range expectedValues {
+		if math.Abs(value.actual-value.expected) > 0.005 {
+			t.Errorf("Error: Expected %v='%v'\nInstead, %v='%v'\n",
+				value.name, value.expected, value.name, value.actual)
+		}
+	}
+
+	// Meeus, Astronomical Algorithms, 2nd Ed., Example 28.b.
+	if math.Abs(solarPos.GetEquationOfTime()-13.71) > 0.05 {
+		t.Errorf("Error: Expected equation of time='13.71' minutes\n"+
+			"Instead, equation of time='%v' minutes\n",
+			solarPos.GetEquationOfTime())
+	}
+
+	// Solar noon in London on June 20, 2024 occurs at 12:02 UTC.
+	_, julianDayNo, err = JulianDayNoDto{}.NewFromGregorianDate(
+		time.Date(2024, 6, 20, 12, 2, 13, 0, time.UTC),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromGregorianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	solarPos, err = AstronomicalCalculator{}.GetSolarPosition(
+		julianDayNo,
+		51.5074,
+		-0.1278,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by GetSolarPosition(London)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// Elevation = 90 - latitude + declination (23.44)
+	if math.Abs(solarPos.GetElevation()-61.93) > 0.02 {
+		t.Errorf("Error: Expected solar noon elevation='61.93'\n"+
+			"Instead, elevation='%v'\n", solarPos.GetElevation())
+	}
+
+	if math.Abs(solarPos.GetAzimuth()-180.0) > 0.1 {
+		t.Errorf("Error: Expected solar noon azimuth='180.0'\n"+
+			"Instead, azimuth='%v'\n", solarPos.GetAzimuth())
+	}
+
+	if solarPos.GetApparentElevation() <= solarPos.GetElevation() {
+		t.Errorf("Error: Expected apparent elevation to exceed elevation.\n"+
+			"Apparent elevation='%v' Elevation='%v'\n",
+			solarPos.GetApparentElevation(), solarPos.GetElevation())
+	}
+
+	latitude, longitude := solarPos.GetLatitudeLongitude()
+
+	if latitude != 51.5074 || longitude != -0.1278 {
+		t.Errorf("Error: Expected latitude='51.5074' longitude='-0.1278'\n"+
+			"Instead, latitude='%v' longitude='%v'\n", latitude, longitude)
+	}
+}
+
+func TestAstronomicalCalculator05(t *testing.T) {
+
+	ePrefix := "TestAstronomicalCalculator05() "
+
+	utcTzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		time.Now(),
+		TZones.UTC(),
+		TzConvertType.Absolute())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName(UTC)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	_, err = AstronomicalCalculator{}.GetEquinoxesAndSolstices(
+		5000,
+		utcTzDef,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from GetEquinoxesAndSolstices(5000). " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	_, err = AstronomicalCalculator{}.GetMoonPhases(
+		2024,
+		TimeZoneDefinition{},
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from GetMoonPhases() with an empty " +
+			"time zone definition. NO ERROR WAS RETURNED!")
+	}
+
+	_, err = AstronomicalCalculator{}.GetSunEvents(
+		2024,
+		2,
+		30,
+		51.5074,
+		-0.1278,
+		utcTzDef,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from GetSunEvents() with an invalid " +
+			"date. NO ERROR WAS RETURNED!")
+	}
+
+	_, err = AstronomicalCalculator{}.GetSunEvents(
+		2024,
+		6,
+		20,
+		91.0,
+		-0.1278,
+		utcTzDef,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from GetSunEvents() with latitude=91. " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	_, julianDayNo, err := JulianDayNoDto{}.NewFromGregorianDate(
+		time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromGregorianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	_, err = AstronomicalCalculator{}.GetSolarPosition(
+		julianDayNo,
+		51.5074,
+		-181.0,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from GetSolarPosition() with " +
+			"longitude=-181. NO ERROR WAS RETURNED!")
+	}
+
+	eventType, err := AstronomicalEventType(0).XParseString("fullmoon", false)
+
+	if err != nil {
+		t.Errorf("Error returned by XParseString(\"fullmoon\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if eventType != AstroEventType.FullMoon() {
+		t.Errorf("Error: Expected event type='FullMoon'\n"+
+			"Instead, event type='%v'\n", eventType.String())
+	}
+
+	if AstroEventType.None().XIsValid() {
+		t.Error("Error: Expected AstroEventType.None() to be invalid.")
+	}
+}
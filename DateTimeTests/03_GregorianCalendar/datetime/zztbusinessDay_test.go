@@ -0,0 +1,370 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTzDtoAddBusinessDays01(t *testing.T) {
+
+	ePrefix := "TestDateTzDtoAddBusinessDays01() "
+
+	holCal, err := HolidayCalendar{}.NewFromJSON(
+		testUsFederalHolidaysJSON,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayCalendar{}.NewFromJSON()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	standardCal := BusinessDayCalendar{}.NewStandardWeekend(nil)
+
+	holidayCal := BusinessDayCalendar{}.NewStandardWeekend(&holCal)
+
+	// Friday and Saturday weekend expressed in US Day Numbers
+	friSatCal, err := BusinessDayCalendar{}.New(
+		DayOfWeekNumberingSystemType(0).UsDayOfWeek(),
+		[]int{5, 6},
+		nil,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by BusinessDayCalendar{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testDays := []struct {
+		busDayCal    BusinessDayCalendar
+		startDate    string
+		businessDays int
+		expectedDate string
+	}{
+		{standardCal, "2021-06-04 10:00:00", 1, "2021-06-07 10:00:00"},
+		{standardCal, "2021-06-04 10:00:00", 5, "2021-06-11 10:00:00"},
+		{standardCal, "2021-06-07 10:00:00", -1, "2021-06-04 10:00:00"},
+		{standardCal, "2021-06-05 10:00:00", 1, "2021-06-07 10:00:00"},
+		{standardCal, "2021-06-05 10:00:00", 0, "2021-06-05 10:00:00"},
+		{holidayCal, "2021-07-02 10:00:00", 1, "2021-07-06 10:00:00"},
+		{holidayCal, "2021-07-06 10:00:00", -1, "2021-07-02 10:00:00"},
+		{holidayCal, "2021-12-22 10:00:00", 3, "2021-12-28 10:00:00"},
+		{friSatCal, "2021-06-03 10:00:00", 1, "2021-06-06 10:00:00"},
+	}
+
+	chicago, err := time.LoadLocation(TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	fmtStr := "2006-01-02 15:04:05"
+
+	for i := 0; i < len(testDays); i++ {
+
+		startTime, err := time.ParseInLocation(
+			fmtStr,
+			testDays[i].startDate,
+			chicago)
+
+		if err != nil {
+			t.Errorf("Error returned by time.ParseInLocation()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		dTz, err := DateTzDto{}.NewDateTime(startTime, fmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		dTz2, err := dTz.AddBusinessDays(
+			testDays[i].businessDays,
+			testDays[i].busDayCal,
+			"")
+
+		if err != nil {
+			t.Errorf("Error returned by dTz.AddBusinessDays()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		actualDate := dTz2.GetDateTimeValue().Format(fmtStr)
+
+		if actualDate != testDays[i].expectedDate {
+			t.Errorf("Error: AddBusinessDays(%v) from '%v'\n"+
+				"Expected='%v'\n"+
+				"  Actual='%v'\n",
+				testDays[i].businessDays,
+				testDays[i].startDate,
+				testDays[i].expectedDate,
+				actualDate)
+		}
+
+		if dTz2.GetTimeZoneLocationName() != TZones.America.Chicago() {
+			t.Errorf("Error: Expected time zone '%v'.\n"+
+				"Instead, time zone='%v'\n",
+				TZones.America.Chicago(),
+				dTz2.GetTimeZoneLocationName())
+		}
+	}
+}
+
+func TestDateTzDtoAddBusinessDays02(t *testing.T) {
+
+	ePrefix := "TestDateTzDtoAddBusinessDays02() "
+
+	// Every day is a business day
+	everyDayCal, err := BusinessDayCalendar{}.New(
+		DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek(),
+		[]int{},
+		nil,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by BusinessDayCalendar{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	chicago, err := time.LoadLocation(TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// Daylight Saving Time began in Chicago at 2:00AM on
+	// Sunday, March 14, 2021. The local time 02:30 did not
+	// exist on that date.
+	dTz, err := DateTzDto{}.NewDateTime(
+		time.Date(2021, 3, 13, 2, 30, 0, 0, chicago),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dTz2, err := dTz.NextBusinessDay(everyDayCal, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dTz.NextBusinessDay()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	actualDate := dTz2.GetDateTimeValue().Format("2006-01-02")
+
+	if actualDate != "2021-03-14" {
+		t.Errorf("Error: Expected next business day '2021-03-14'.\n"+
+			"Instead, next business day='%v'\n", actualDate)
+	}
+
+	// Two days across the transition retain the original time
+	dTz2, err = dTz.AddBusinessDays(2, everyDayCal, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dTz.AddBusinessDays()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	actualDate = dTz2.GetDateTimeValue().Format("2006-01-02 15:04:05 MST")
+
+	if actualDate != "2021-03-15 02:30:00 CDT" {
+		t.Errorf("Error: Expected '2021-03-15 02:30:00 CDT'.\n"+
+			"Instead, date='%v'\n", actualDate)
+	}
+}
+
+func TestDateTzDtoBusinessDaysBetween01(t *testing.T) {
+
+	ePrefix := "TestDateTzDtoBusinessDaysBetween01() "
+
+	holCal, err := HolidayCalendar{}.NewFromJSON(
+		testUsFederalHolidaysJSON,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayCalendar{}.NewFromJSON()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	standardCal := BusinessDayCalendar{}.NewStandardWeekend(nil)
+
+	holidayCal := BusinessDayCalendar{}.NewStandardWeekend(&holCal)
+
+	chicago, err := time.LoadLocation(TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	tokyo, err := time.LoadLocation(TZones.Asia.Tokyo())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testSpans := []struct {
+		busDayCal    BusinessDayCalendar
+		startTime    time.Time
+		endTime      time.Time
+		businessDays int64
+	}{
+		{standardCal,
+			time.Date(2021, 6, 4, 10, 0, 0, 0, chicago),
+			time.Date(2021, 6, 8, 10, 0, 0, 0, chicago),
+			2},
+		{standardCal,
+			time.Date(2021, 6, 8, 10, 0, 0, 0, chicago),
+			time.Date(2021, 6, 4, 10, 0, 0, 0, chicago),
+			-2},
+		{standardCal,
+			time.Date(2021, 6, 8, 10, 0, 0, 0, chicago),
+			time.Date(2021, 6, 8, 23, 0, 0, 0, chicago),
+			0},
+		{holidayCal,
+			time.Date(2021, 7, 1, 10, 0, 0, 0, chicago),
+			time.Date(2021, 7, 31, 10, 0, 0, 0, chicago),
+			20},
+		// 2021-06-07 20:00 Chicago is 2021-06-08 10:00 Tokyo.
+		// Dates are evaluated in the time zone of the starting
+		// date.
+		{standardCal,
+			time.Date(2021, 6, 4, 10, 0, 0, 0, chicago),
+			time.Date(2021, 6, 8, 10, 0, 0, 0, tokyo),
+			1},
+	}
+
+	for i := 0; i < len(testSpans); i++ {
+
+		startDTz, err := DateTzDto{}.NewDateTime(
+			testSpans[i].startTime,
+			FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		endDTz, err := DateTzDto{}.NewDateTime(
+			testSpans[i].endTime,
+			FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		businessDays, err := startDTz.BusinessDaysBetween(
+			endDTz,
+			testSpans[i].busDayCal)
+
+		if err != nil {
+			t.Errorf("Error returned by startDTz.BusinessDaysBetween()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if businessDays != testSpans[i].businessDays {
+			t.Errorf("Error: Test Span #%v\n"+
+				"Expected business days='%v'\n"+
+				"  Actual business days='%v'\n",
+				i,
+				testSpans[i].businessDays,
+				businessDays)
+		}
+	}
+}
+
+func TestDateTzDtoPreviousBusinessDay01(t *testing.T) {
+
+	ePrefix := "TestDateTzDtoPreviousBusinessDay01() "
+
+	holCal, err := HolidayCalendar{}.NewFromJSON(
+		testUsFederalHolidaysJSON,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayCalendar{}.NewFromJSON()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	holidayCal := BusinessDayCalendar{}.NewStandardWeekend(&holCal)
+
+	tokyo, err := time.LoadLocation(TZones.Asia.Tokyo())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// Monday, July 5, 2021 is a holiday in the Tokyo time zone
+	dTz, err := DateTzDto{}.NewDateTime(
+		time.Date(2021, 7, 6, 8, 0, 0, 0, tokyo),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dTz2, err := dTz.PreviousBusinessDay(holidayCal, "")
+
+	if err != nil {
+		t.Errorf("Error returned by dTz.PreviousBusinessDay()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	actualDate := dTz2.GetDateTimeValue().Format("2006-01-02 15:04:05")
+
+	if actualDate != "2021-07-02 08:00:00" {
+		t.Errorf("Error: Expected previous business day '2021-07-02 08:00:00'.\n"+
+			"Instead, previous business day='%v'\n", actualDate)
+	}
+
+	_, err = BusinessDayCalendar{}.New(
+		DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek(),
+		[]int{1, 2, 3, 4, 5, 6, 7},
+		nil,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from BusinessDayCalendar{}.New()\n" +
+			"because all seven days are weekend days.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = BusinessDayCalendar{}.New(
+		DayOfWeekNumberingSystemType(0).UsDayOfWeek(),
+		[]int{7},
+		nil,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from BusinessDayCalendar{}.New()\n" +
+			"because US day number '7' is invalid.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
@@ -0,0 +1,801 @@
+package datetime
+
+import (
+	"testing"
+)
+
+func TestCalendarDateTimeConvertTo01 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeConvertTo01() "
+
+	// Julian Calendar Date 1582-10-04 is immediately followed
+	// by Gregorian Calendar Date 1582-10-15.
+	julianCalDTime, err := CalendarDateTime{}.NewCalDateTime(
+		CalSpec.Julian(),
+		1582,
+		CalYearType.CE(),
+		10,
+		4,
+		13,
+		30,
+		15,
+		500,
+		false,
+		"America/Chicago",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTime()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	var gregorianCalDTime CalendarDateTime
+
+	gregorianCalDTime, err = julianCalDTime.ConvertTo(
+		CalSpec.Gregorian(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianCalDTime.ConvertTo()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	year,
+	yearType,
+	_,
+	calendarSystem,
+	month,
+	day,
+	err := gregorianCalDTime.GetDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by gregorianCalDTime.GetDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if year != 1582 ||
+		yearType != CalYearType.CE() ||
+		calendarSystem != CalSpec.Gregorian() ||
+		month != 10 ||
+		day != 14 {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Date='Gregorian 1582-10-14 CE'\n" +
+			"  Actual Date='%v %v-%v-%v %v'\n",
+			calendarSystem.String(),
+			year,
+			month,
+			day,
+			yearType.String())
+	}
+
+	hour,
+	minute,
+	second,
+	nanosecond,
+	timeZone,
+	_,
+	err := gregorianCalDTime.GetTime(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by gregorianCalDTime.GetTime()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if hour != 13 ||
+		minute != 30 ||
+		second != 15 ||
+		nanosecond != 500 {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Time='13:30:15.000000500'\n" +
+			"  Actual Time='%v:%v:%v.%v'\n",
+			hour,
+			minute,
+			second,
+			nanosecond)
+	}
+
+	if timeZone.GetOriginalLocationName() != "America/Chicago" {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Time Zone='America/Chicago'\n" +
+			"  Actual Time Zone='%v'\n",
+			timeZone.GetOriginalLocationName())
+	}
+
+	return
+}
+
+func TestCalendarDateTimeConvertTo02 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeConvertTo02() "
+
+	gregorianCalDTime, err := CalendarDateTime{}.NewCalDateTime(
+		CalSpec.Gregorian(),
+		2000,
+		CalYearType.Astronomical(),
+		1,
+		1,
+		12,
+		0,
+		0,
+		0,
+		false,
+		"UTC",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTime()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testTargets := []struct {
+		calendarSystem CalendarSpec
+		year           int64
+		month          int
+		day            int
+	}{
+		{CalSpec.Gregorian(), 2000, 1, 1},
+		{CalSpec.Julian(), 1999, 12, 19},
+		{CalSpec.RevisedJulian(), 2000, 1, 1},
+		{CalSpec.RevisedGoucherParker(), 2000, 2, 8},
+	}
+
+	for i:=0; i < len(testTargets); i++ {
+
+		var convertedCalDTime CalendarDateTime
+
+		convertedCalDTime, err = gregorianCalDTime.ConvertTo(
+			testTargets[i].calendarSystem,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by gregorianCalDTime.ConvertTo()\n" +
+				"Target Calendar='%v'\n" +
+				"Error='%v'\n",
+				testTargets[i].calendarSystem.String(),
+				err.Error())
+			return
+		}
+
+		year,
+		yearType,
+		_,
+		calendarSystem,
+		month,
+		day,
+		err := convertedCalDTime.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by convertedCalDTime.GetDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if year != testTargets[i].year ||
+			yearType != CalYearType.Astronomical() ||
+			calendarSystem != testTargets[i].calendarSystem ||
+			month != testTargets[i].month ||
+			day != testTargets[i].day {
+			t.Errorf("Result INVALID!\n" +
+				"Expected Date='%v %v-%v-%v Astronomical'\n" +
+				"  Actual Date='%v %v-%v-%v %v'\n",
+				testTargets[i].calendarSystem.String(),
+				testTargets[i].year,
+				testTargets[i].month,
+				testTargets[i].day,
+				calendarSystem.String(),
+				year,
+				month,
+				day,
+				yearType.String())
+		}
+	}
+
+	return
+}
+
+func TestCalendarDateTimeConvertTo03 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeConvertTo03() "
+
+	// Julian Calendar Date 0001-01-01 CE is equivalent
+	// to Gregorian Calendar Date 0001-12-30 BCE.
+	julianCalDTime, err := CalendarDateTime{}.NewCalDateTime(
+		CalSpec.Julian(),
+		1,
+		CalYearType.CE(),
+		1,
+		1,
+		6,
+		0,
+		0,
+		0,
+		true,
+		"UTC",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTime()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	var gregorianCalDTime CalendarDateTime
+
+	gregorianCalDTime, err = julianCalDTime.ConvertTo(
+		CalSpec.Gregorian(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianCalDTime.ConvertTo()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	year,
+	yearType,
+	_,
+	_,
+	month,
+	day,
+	err := gregorianCalDTime.GetDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by gregorianCalDTime.GetDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if year != 1 ||
+		yearType != CalYearType.BCE() ||
+		month != 12 ||
+		day != 30 {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Date='0001-12-30 BCE'\n" +
+			"  Actual Date='%v-%v-%v %v'\n",
+			year,
+			month,
+			day,
+			yearType.String())
+	}
+
+	if !gregorianCalDTime.dateTimeDto.GetDateHasLeapSecond() {
+		t.Error(ePrefix + "Result INVALID!\n" +
+			"Expected the converted date to retain the leap second flag.\n" +
+			"However, the leap second flag is 'false'.\n")
+	}
+
+	var julianRoundTrip CalendarDateTime
+
+	julianRoundTrip, err = gregorianCalDTime.ConvertTo(
+		CalSpec.Julian(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by gregorianCalDTime.ConvertTo()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	year,
+	yearType,
+	_,
+	_,
+	month,
+	day,
+	err = julianRoundTrip.GetDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianRoundTrip.GetDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if year != 1 ||
+		yearType != CalYearType.CE() ||
+		month != 1 ||
+		day != 1 {
+		t.Errorf("Round Trip Result INVALID!\n" +
+			"Expected Date='0001-01-01 CE'\n" +
+			"  Actual Date='%v-%v-%v %v'\n",
+			year,
+			month,
+			day,
+			yearType.String())
+	}
+
+	return
+}
+
+func TestCalendarDateTimeConvertTo04 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeConvertTo04() "
+
+	gregorianCalDTime, err := CalendarDateTime{}.NewCalDateTime(
+		CalSpec.Gregorian(),
+		2020,
+		CalYearType.CE(),
+		2,
+		29,
+		0,
+		0,
+		0,
+		0,
+		false,
+		"UTC",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTime()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	_, err = gregorianCalDTime.ConvertTo(
+		CalendarSpec(-1),
+		ePrefix)
+
+	if err == nil {
+		t.Error(ePrefix + "Error!\n" +
+			"Expected an error return from gregorianCalDTime.ConvertTo()\n" +
+			"because the target calendar is invalid.\n" +
+			"However, NO ERROR WAS RETURNED!!!\n")
+	}
+
+	return
+}
+
+func TestCalendarDateTimeConvertTo05 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeConvertTo05() "
+
+	// Before Common Era (BCE) years carry no year zero. N BCE is
+	// Astronomical Year 1-N.
+	testDates := []struct {
+		calendarSystem   CalendarSpec
+		bceYear          int64
+		month            int
+		day              int
+		astronomicalYear int64
+		julianDayNumber  int64
+		targetCalendar   CalendarSpec
+		targetYear       int64
+		targetYearType   CalendarYearNumType
+		targetMonth      int
+		targetDay        int
+	}{
+		// Ides of March, 44 BCE, Julian Calendar
+		{CalSpec.Julian(), 44, 3, 15, -43, 1705426,
+			CalSpec.Gregorian(), 44, CalYearType.BCE(), 3, 13},
+		{CalSpec.Gregorian(), 1, 12, 31, 0, 1721425,
+			CalSpec.Julian(), 1, CalYearType.CE(), 1, 2},
+		{CalSpec.Gregorian(), 4714, 11, 24, -4713, 0,
+			CalSpec.Julian(), 4713, CalYearType.BCE(), 1, 1},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		var calDTime CalendarDateTime
+		var err error
+
+		if testDates[i].calendarSystem == CalSpec.Julian() {
+
+			calDTime, err = CalendarDateTime{}.NewJulianDate(
+				testDates[i].bceYear,
+				CalYearType.BCE(),
+				testDates[i].month,
+				testDates[i].day,
+				12,
+				0,
+				0,
+				0,
+				"UTC",
+				"",
+				ePrefix)
+
+		} else {
+
+			calDTime, err = CalendarDateTime{}.NewGregorianDate(
+				testDates[i].bceYear,
+				CalYearType.BCE(),
+				testDates[i].month,
+				testDates[i].day,
+				12,
+				0,
+				0,
+				0,
+				false,
+				"UTC",
+				"",
+				ePrefix)
+		}
+
+		if err != nil {
+			t.Errorf("Error returned by CalendarDateTime{}.New%vDate()\n" +
+				"Error='%v'\n",
+				testDates[i].calendarSystem.String(),
+				err.Error())
+			return
+		}
+
+		if calDTime.dateTimeDto.date.astronomicalYear !=
+			testDates[i].astronomicalYear {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v BCE %v-%v %v'\n" +
+				"Expected Astronomical Year='%v'\n" +
+				"  Actual Astronomical Year='%v'\n",
+				testDates[i].bceYear,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].calendarSystem.String(),
+				testDates[i].astronomicalYear,
+				calDTime.dateTimeDto.date.astronomicalYear)
+			continue
+		}
+
+		year,
+		yearType,
+		_,
+		_,
+		month,
+		day,
+		err := calDTime.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.GetDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if year != testDates[i].bceYear ||
+			yearType != CalYearType.BCE() ||
+			month != testDates[i].month ||
+			day != testDates[i].day {
+			t.Errorf("Result INVALID!\n" +
+				"Expected Date='%v BCE %v-%v'\n" +
+				"  Actual Date='%v %v %v-%v'\n",
+				testDates[i].bceYear,
+				testDates[i].month,
+				testDates[i].day,
+				year,
+				yearType.String(),
+				month,
+				day)
+		}
+
+		calDtMech := calendarDateTimeMechanics{}
+
+		var julianDayNoDto JulianDayNoDto
+
+		julianDayNoDto, err = calDtMech.getJulianDayNumber(
+			testDates[i].calendarSystem,
+			calDTime.dateTimeDto.date.astronomicalYear,
+			testDates[i].month,
+			testDates[i].day,
+			12,
+			0,
+			0,
+			0,
+			0,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDtMech.getJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var julianDayNo int64
+
+		julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if julianDayNo != testDates[i].julianDayNumber {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v BCE %v-%v %v'\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				testDates[i].bceYear,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].calendarSystem.String(),
+				testDates[i].julianDayNumber,
+				julianDayNo)
+		}
+
+		var targetCalDTime CalendarDateTime
+
+		targetCalDTime, err = calDTime.ConvertTo(
+			testDates[i].targetCalendar,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.ConvertTo()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		year,
+		yearType,
+		_,
+		_,
+		month,
+		day,
+		err = targetCalDTime.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by targetCalDTime.GetDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if year != testDates[i].targetYear ||
+			yearType != testDates[i].targetYearType ||
+			month != testDates[i].targetMonth ||
+			day != testDates[i].targetDay {
+			t.Errorf("Result INVALID!\n" +
+				"Expected %v Date='%v %v %v-%v'\n" +
+				"  Actual %v Date='%v %v %v-%v'\n",
+				testDates[i].targetCalendar.String(),
+				testDates[i].targetYear,
+				testDates[i].targetYearType.String(),
+				testDates[i].targetMonth,
+				testDates[i].targetDay,
+				testDates[i].targetCalendar.String(),
+				year,
+				yearType.String(),
+				month,
+				day)
+		}
+
+		var roundTripCalDTime CalendarDateTime
+
+		roundTripCalDTime, err = targetCalDTime.ConvertTo(
+			testDates[i].calendarSystem,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by targetCalDTime.ConvertTo()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		year,
+		yearType,
+		_,
+		_,
+		month,
+		day,
+		err = roundTripCalDTime.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by roundTripCalDTime.GetDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if year != testDates[i].bceYear ||
+			yearType != CalYearType.BCE() ||
+			month != testDates[i].month ||
+			day != testDates[i].day {
+			t.Errorf("Round Trip Result INVALID!\n" +
+				"Expected %v Date='%v BCE %v-%v'\n" +
+				"  Actual %v Date='%v %v %v-%v'\n",
+				testDates[i].calendarSystem.String(),
+				testDates[i].bceYear,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].calendarSystem.String(),
+				year,
+				yearType.String(),
+				month,
+				day)
+		}
+	}
+
+	return
+}
+
+func TestCalendarDateTimeConvertTo06 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeConvertTo06() "
+
+	// Great Britain adopted the Gregorian Calendar on
+	// 14 September 1752 Gregorian, Julian Day Number 2361222.
+	// The preceding day was 2 September 1752 Julian.
+	var britishCutover int64 = 2361222
+
+	testDates := []struct {
+		year            int64
+		month           int
+		day             int
+		julianDayNumber int64
+		gregorianYear   int64
+		gregorianMonth  int
+		gregorianDay    int
+	}{
+		{1752, 9, 2, 2361221, 1752, 9, 13},
+		{1752, 9, 14, 2361222, 1752, 9, 14},
+		// 1700 is a leap year under the Julian Calendar.
+		{1700, 2, 29, 2342042, 1700, 3, 11},
+		{1582, 10, 10, 2299166, 1582, 10, 20},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		calDTime, err := CalendarDateTime{}.NewJulianGregorianDate(
+			britishCutover,
+			testDates[i].year,
+			CalYearType.CE(),
+			testDates[i].month,
+			testDates[i].day,
+			12,
+			0,
+			0,
+			0,
+			"UTC",
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by CalendarDateTime{}.NewJulianGregorianDate()\n" +
+				"Date='%v-%v-%v'\n" +
+				"Error='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				err.Error())
+			return
+		}
+
+		var julianDayNo int64
+
+		julianDayNo, err = calDTime.julianDayNumber.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.julianDayNumber.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if julianDayNo != testDates[i].julianDayNumber {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v Julian/Gregorian'\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].julianDayNumber,
+				julianDayNo)
+			continue
+		}
+
+		var gregorianCalDTime CalendarDateTime
+
+		gregorianCalDTime, err = calDTime.ConvertTo(
+			CalSpec.Gregorian(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.ConvertTo(Gregorian)\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		gregorianDate := &gregorianCalDTime.dateTimeDto.date
+
+		if gregorianDate.astronomicalYear != testDates[i].gregorianYear ||
+			gregorianDate.month != testDates[i].gregorianMonth ||
+			gregorianDate.day != testDates[i].gregorianDay {
+			t.Errorf("Result INVALID!\n" +
+				"Expected Gregorian Date='%v-%v-%v'\n" +
+				"  Actual Gregorian Date='%v-%v-%v'\n",
+				testDates[i].gregorianYear,
+				testDates[i].gregorianMonth,
+				testDates[i].gregorianDay,
+				gregorianDate.astronomicalYear,
+				gregorianDate.month,
+				gregorianDate.day)
+			continue
+		}
+
+		var julianGregCalDTime CalendarDateTime
+
+		julianGregCalDTime, err = gregorianCalDTime.ConvertToJulianGregorian(
+			britishCutover,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by gregorianCalDTime.ConvertToJulianGregorian()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		julianGregDate := &julianGregCalDTime.dateTimeDto.date
+
+		if julianGregDate.astronomicalYear != testDates[i].year ||
+			julianGregDate.month != testDates[i].month ||
+			julianGregDate.day != testDates[i].day {
+			t.Errorf("Round Trip Result INVALID!\n" +
+				"Expected Julian/Gregorian Date='%v-%v-%v'\n" +
+				"  Actual Julian/Gregorian Date='%v-%v-%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				julianGregDate.astronomicalYear,
+				julianGregDate.month,
+				julianGregDate.day)
+		}
+
+		julianGregBData, ok :=
+			julianGregDate.calendarBaseData.(*CalendarJulianGregorianBaseData)
+
+		if !ok {
+			t.Errorf("Error: Expected Julian/Gregorian Calendar Base Data.\n" +
+				"Instead, Calendar='%v'\n",
+				julianGregDate.calendarBaseData.GetCalendarSpecification().String())
+			continue
+		}
+
+		if julianGregBData.GetCutoverJulianDayNo() != britishCutover {
+			t.Errorf("Error: Expected Cutover Julian Day Number='%v'\n" +
+				"Instead, Cutover Julian Day Number='%v'\n",
+				britishCutover,
+				julianGregBData.GetCutoverJulianDayNo())
+		}
+	}
+
+	// 29 February 1700 does NOT exist under the default cutover.
+	_, err := CalendarDateTime{}.NewJulianGregorianDate(
+		0,
+		1700,
+		CalYearType.CE(),
+		2,
+		29,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return for 1700-02-29 under\n" +
+			"the default cutover. However, NO ERROR WAS RETURNED!\n")
+	}
+
+	// 5 September 1752 was skipped by the British reform.
+	_, err = CalendarDateTime{}.NewJulianGregorianDate(
+		britishCutover,
+		1752,
+		CalYearType.CE(),
+		9,
+		5,
+		12,
+		0,
+		0,
+		0,
+		"UTC",
+		"",
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return for 1752-09-05 under\n" +
+			"the British cutover. However, NO ERROR WAS RETURNED!\n")
+	}
+}
@@ -0,0 +1,304 @@
+package datetime
+
+import (
+	"testing"
+)
+
+func TestCalendarEcclesiasticalUtilityGetEasterSunday01(t *testing.T) {
+
+	ePrefix := "TestCalendarEcclesiasticalUtilityGetEasterSunday01() "
+
+	testDates := []struct {
+		calendarSystem CalendarSpec
+		year           int64
+		month          int
+		day            int
+	}{
+		{CalendarSpec(0).Gregorian(), 1818, 3, 22},
+		{CalendarSpec(0).Gregorian(), 1943, 4, 25},
+		{CalendarSpec(0).Gregorian(), 2000, 4, 23},
+		{CalendarSpec(0).Gregorian(), 2019, 4, 21},
+		{CalendarSpec(0).Gregorian(), 2021, 4, 4},
+		{CalendarSpec(0).Gregorian(), 2024, 3, 31},
+		{CalendarSpec(0).Gregorian(), 2025, 4, 20},
+		{CalendarSpec(0).Gregorian(), 2285, 3, 22},
+		{CalendarSpec(0).Julian(), 2000, 4, 17},
+		{CalendarSpec(0).Julian(), 2019, 4, 15},
+		{CalendarSpec(0).Julian(), 2021, 4, 19},
+		{CalendarSpec(0).Julian(), 2024, 4, 22},
+		{CalendarSpec(0).Julian(), 2025, 4, 7},
+	}
+
+	calEcclesUtil := CalendarEcclesiasticalUtility{}
+
+	for i := 0; i < len(testDates); i++ {
+
+		calDTime, err := calEcclesUtil.GetEasterSunday(
+			testDates[i].calendarSystem,
+			testDates[i].year,
+			CalendarYearNumType(0).Astronomical(),
+			"UTC",
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calEcclesUtil.GetEasterSunday()\n"+
+				"calendarSystem='%v' year='%v'\n"+
+				"Error='%v'\n",
+				testDates[i].calendarSystem.String(),
+				testDates[i].year,
+				err.Error())
+			return
+		}
+
+		year, _, _, calendarSystem, month, day, err := calDTime.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.GetDate()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if year != testDates[i].year ||
+			month != testDates[i].month ||
+			day != testDates[i].day ||
+			calendarSystem != testDates[i].calendarSystem {
+			t.Errorf("Error: Easter Sunday is INVALID!\n"+
+				"Calendar='%v'\n"+
+				"Expected='%v-%02d-%02d'\n"+
+				"  Actual='%v-%02d-%02d' Calendar='%v'\n",
+				testDates[i].calendarSystem.String(),
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				year,
+				month,
+				day,
+				calendarSystem.String())
+		}
+	}
+}
+
+func TestCalendarEcclesiasticalUtilityGetEasterSunday02(t *testing.T) {
+
+	ePrefix := "TestCalendarEcclesiasticalUtilityGetEasterSunday02() "
+
+	// Orthodox Easter Sunday 2021 expressed on the Gregorian Calendar
+	calEcclesUtil := CalendarEcclesiasticalUtility{}
+
+	calDTime, err := calEcclesUtil.GetEasterSunday(
+		CalendarSpec(0).Julian(),
+		2021,
+		CalendarYearNumType(0).CE(),
+		"UTC",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by calEcclesUtil.GetEasterSunday()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	var gregorianCalDTime CalendarDateTime
+
+	gregorianCalDTime, err = calDTime.ConvertTo(
+		CalendarSpec(0).Gregorian(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by calDTime.ConvertTo()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	year, _, _, _, month, day, err := gregorianCalDTime.GetDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by gregorianCalDTime.GetDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if year != 2021 || month != 5 || day != 2 {
+		t.Errorf("Error: Expected Gregorian date '2021-05-02'.\n"+
+			"Instead, date='%v-%02d-%02d'\n",
+			year, month, day)
+	}
+
+	_, err = calEcclesUtil.GetEasterSunday(
+		CalendarSpec(0).RevisedJulian(),
+		2021,
+		CalendarYearNumType(0).Astronomical(),
+		"UTC",
+		"",
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from GetEasterSunday()\n" +
+			"because the Revised Julian Calendar is not supported.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestCalendarEcclesiasticalUtilityGetEasterSunday03(t *testing.T) {
+
+	ePrefix := "TestCalendarEcclesiasticalUtilityGetEasterSunday03() "
+
+	// The Julian Easter cycle repeats every 532 years. The Gregorian
+	// Easter cycle repeats every 5,700,000 years. Easter Sunday must
+	// always fall on a Sunday, including astronomical years less than
+	// or equal to zero.
+	testYears := []struct {
+		calendarSystem CalendarSpec
+		year           int64
+		cycleYears     int64
+	}{
+		{CalendarSpec(0).Julian(), 2021, 532},
+		{CalendarSpec(0).Julian(), 0, 532},
+		{CalendarSpec(0).Julian(), -1, 532},
+		{CalendarSpec(0).Julian(), -4000, 532},
+		{CalendarSpec(0).Gregorian(), 2021, 5700000},
+		{CalendarSpec(0).Gregorian(), 0, 5700000},
+		{CalendarSpec(0).Gregorian(), -1, 5700000},
+		{CalendarSpec(0).Gregorian(), -4000, 5700000},
+	}
+
+	calEcclesUtil := CalendarEcclesiasticalUtility{}
+
+	isoWeekDateMech := isoWeekDateMechanics{}
+
+	for i := 0; i < len(testYears); i++ {
+
+		month, day, err := calEcclesUtil.GetEasterSundayMonthDay(
+			testYears[i].calendarSystem,
+			testYears[i].year,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by GetEasterSundayMonthDay()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		cycleMonth, cycleDay, err := calEcclesUtil.GetEasterSundayMonthDay(
+			testYears[i].calendarSystem,
+			testYears[i].year-testYears[i].cycleYears,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by GetEasterSundayMonthDay()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if month != cycleMonth || day != cycleDay {
+			t.Errorf("Error: Easter cycle mismatch!\n"+
+				"Calendar='%v' Year='%v'\n"+
+				"Easter='%02d-%02d' Cycle Easter='%02d-%02d'\n",
+				testYears[i].calendarSystem.String(),
+				testYears[i].year,
+				month, day, cycleMonth, cycleDay)
+		}
+
+		julianDayNo, err := isoWeekDateMech.getJulianDayNo(
+			testYears[i].calendarSystem,
+			testYears[i].year,
+			month,
+			day,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by isoWeekDateMech.getJulianDayNo()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if isoWeekDateMech.getISODayOfWeekNo(julianDayNo) !=
+			ISO8601DayOfWeekNo(0).Sunday() {
+			t.Errorf("Error: Easter does NOT fall on a Sunday!\n"+
+				"Calendar='%v' Date='%v-%02d-%02d'\n",
+				testYears[i].calendarSystem.String(),
+				testYears[i].year,
+				month, day)
+		}
+	}
+}
+
+func TestCalendarEcclesiasticalUtilityMovableFeasts01(t *testing.T) {
+
+	ePrefix := "TestCalendarEcclesiasticalUtilityMovableFeasts01() "
+
+	calEcclesUtil := CalendarEcclesiasticalUtility{}
+
+	type feastFunc func(
+		CalendarSpec,
+		int64,
+		CalendarYearNumType,
+		string,
+		string,
+		string) (CalendarDateTime, error)
+
+	testFeasts := []struct {
+		feastName      string
+		feast          feastFunc
+		calendarSystem CalendarSpec
+		year           int64
+		expectedYear   int64
+		expectedMonth  int
+		expectedDay    int
+	}{
+		{"Ash Wednesday", calEcclesUtil.GetAshWednesday, CalendarSpec(0).Gregorian(), 2024, 2024, 2, 14},
+		{"Ascension Day", calEcclesUtil.GetAscensionDay, CalendarSpec(0).Gregorian(), 2024, 2024, 5, 9},
+		{"Pentecost", calEcclesUtil.GetPentecost, CalendarSpec(0).Gregorian(), 2024, 2024, 5, 19},
+		{"Ash Wednesday", calEcclesUtil.GetAshWednesday, CalendarSpec(0).Gregorian(), 2025, 2025, 3, 5},
+		{"Ascension Day", calEcclesUtil.GetAscensionDay, CalendarSpec(0).Gregorian(), 2025, 2025, 5, 29},
+		{"Pentecost", calEcclesUtil.GetPentecost, CalendarSpec(0).Gregorian(), 2025, 2025, 6, 8},
+		{"Ascension Day", calEcclesUtil.GetAscensionDay, CalendarSpec(0).Julian(), 2021, 2021, 5, 28},
+		{"Pentecost", calEcclesUtil.GetPentecost, CalendarSpec(0).Julian(), 2021, 2021, 6, 7},
+	}
+
+	for i := 0; i < len(testFeasts); i++ {
+
+		calDTime, err := testFeasts[i].feast(
+			testFeasts[i].calendarSystem,
+			testFeasts[i].year,
+			CalendarYearNumType(0).Astronomical(),
+			"America/Chicago",
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned computing %v\n"+
+				"Error='%v'\n",
+				testFeasts[i].feastName,
+				err.Error())
+			return
+		}
+
+		year, _, _, _, month, day, err := calDTime.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.GetDate()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if year != testFeasts[i].expectedYear ||
+			month != testFeasts[i].expectedMonth ||
+			day != testFeasts[i].expectedDay {
+			t.Errorf("Error: %v date is INVALID!\n"+
+				"Calendar='%v'\n"+
+				"Expected='%v-%02d-%02d'\n"+
+				"  Actual='%v-%02d-%02d'\n",
+				testFeasts[i].feastName,
+				testFeasts[i].calendarSystem.String(),
+				testFeasts[i].expectedYear,
+				testFeasts[i].expectedMonth,
+				testFeasts[i].expectedDay,
+				year,
+				month,
+				day)
+		}
+	}
+}
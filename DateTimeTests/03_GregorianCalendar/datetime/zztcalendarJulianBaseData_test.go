@@ -0,0 +1,438 @@
+package datetime
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCalendarJulianBaseDataIsLeapYear01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianBaseDataIsLeapYear01() "
+
+	julianCalBData := CalendarJulianBaseData{}
+
+	testYears := []struct {
+		year        int64
+		yearNumType CalendarYearNumType
+		expected    bool
+	}{
+		{1900, CalYearType.CE(), true},
+		{2000, CalYearType.CE(), true},
+		{2021, CalYearType.CE(), false},
+		{0, CalYearType.Astronomical(), true},
+		{-4, CalYearType.Astronomical(), true},
+		{-4712, CalYearType.Astronomical(), true},
+		{-4713, CalYearType.Astronomical(), false},
+		{1, CalYearType.CE(), false},
+	}
+
+	for i:=0; i < len(testYears); i++ {
+
+		isLeapYear, err := julianCalBData.IsLeapYear(
+			testYears[i].year,
+			testYears[i].yearNumType,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianCalBData.IsLeapYear()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if isLeapYear != testYears[i].expected {
+			t.Errorf("Result INVALID!\n" +
+				"year='%v'\n" +
+				"Expected isLeapYear='%v'\n" +
+				"  Actual isLeapYear='%v'\n",
+				testYears[i].year,
+				testYears[i].expected,
+				isLeapYear)
+		}
+	}
+
+	return
+}
+
+func TestCalendarJulianBaseDataIsValidDate01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianBaseDataIsValidDate01() "
+
+	julianCalBData := CalendarJulianBaseData{}
+
+	testDates := []struct {
+		year     int64
+		month    int
+		day      int
+		expected bool
+	}{
+		{1900, 2, 29, true},
+		{2021, 2, 29, false},
+		{2021, 4, 31, false},
+		{2021, 12, 31, true},
+		{-4712, 1, 1, true},
+		{2021, 13, 1, false},
+		{2021, 1, 0, false},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		isValid, _ := julianCalBData.IsValidDate(
+			testDates[i].year,
+			CalYearType.Astronomical(),
+			testDates[i].month,
+			testDates[i].day,
+			ePrefix)
+
+		if isValid != testDates[i].expected {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected isValid='%v'\n" +
+				"  Actual isValid='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].expected,
+				isValid)
+		}
+	}
+
+	return
+}
+
+func TestCalendarJulianBaseDataOrdinalDays01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianBaseDataOrdinalDays01() "
+
+	julianCalBData := CalendarJulianBaseData{}
+
+	year := int64(1900)
+	month := 3
+	day := 1
+
+	expectedOrdinalDayNo := 61
+	expectedRemainingDays := 305
+
+	ordinalDayNo, err := julianCalBData.GetOrdinalDayNoFromDate(
+		year,
+		CalYearType.CE(),
+		month,
+		day,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianCalBData.GetOrdinalDayNoFromDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if ordinalDayNo != expectedOrdinalDayNo {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Ordinal Day No='%v'\n" +
+			"  Actual Ordinal Day No='%v'\n",
+			expectedOrdinalDayNo,
+			ordinalDayNo)
+	}
+
+	var remainingDays int
+
+	remainingDays, err = julianCalBData.GetRemainingDaysInYear(
+		year,
+		CalYearType.CE(),
+		month,
+		day,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianCalBData.GetRemainingDaysInYear()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if remainingDays != expectedRemainingDays {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Remaining Days='%v'\n" +
+			"  Actual Remaining Days='%v'\n",
+			expectedRemainingDays,
+			remainingDays)
+	}
+
+	var yearAdjustment int
+
+	yearAdjustment,
+	month,
+	day,
+	err = julianCalBData.GetMonthDayFromOrdinalDayNo(
+		366,
+		true,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianCalBData.GetMonthDayFromOrdinalDayNo()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if yearAdjustment != 0 ||
+		month != 12 ||
+		day != 31 {
+		t.Errorf("Result INVALID!\n" +
+			"Expected yearAdjustment='0' month='12' day='31'\n" +
+			"  Actual yearAdjustment='%v' month='%v' day='%v'\n",
+			yearAdjustment,
+			month,
+			day)
+	}
+
+	return
+}
+
+func TestCalendarJulianBaseDataDayOfWeek01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianBaseDataDayOfWeek01() "
+
+	julianCalBData := CalendarJulianBaseData{}
+
+	// Julian Calendar Date 2000-01-01 is equivalent
+	// to Gregorian Calendar Date 2000-01-14, a Friday.
+	julianDayNoDto, err := JulianDayNoDto{}.New(
+		2451558,
+		big.NewFloat(0.0),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.New()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	var usDayOfWeekNo UsDayOfWeekNo
+
+	usDayOfWeekNo, err = julianCalBData.GetUsDayOfWeekNo(
+		julianDayNoDto,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianCalBData.GetUsDayOfWeekNo()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if usDayOfWeekNo != UsDayOfWeekNo(0).Friday() {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Day Of Week='Friday'\n" +
+			"  Actual Day Of Week='%v'\n",
+			usDayOfWeekNo.String())
+	}
+
+	return
+}
+
+func TestCalendarJulianUtilityJulianDayNumber01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianUtilityJulianDayNumber01() "
+
+	testDates := []struct {
+		year             int64
+		month            int
+		day              int
+		julianDayNumber  int64
+	}{
+		{-4712, 1, 1, 0},
+		{-4713, 12, 31, -1},
+		{-4713, 1, 1, -365},
+		{-4712, 12, 31, 365},
+		{1, 1, 1, 1721424},
+		{1582, 10, 4, 2299160},
+		{2000, 1, 1, 2451558},
+		{2020, 2, 29, 2458922},
+		{-5000, 3, 1, -105132},
+	}
+
+	calJulianUtil := CalendarJulianUtility{}
+
+	for i:=0; i < len(testDates); i++ {
+
+		julianDayNoDto, err := calJulianUtil.GetJulianDayNumber(
+			testDates[i].year,
+			testDates[i].month,
+			testDates[i].day,
+			12,
+			0,
+			0,
+			0,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calJulianUtil.GetJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var julianDayNo int64
+
+		julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if julianDayNo != testDates[i].julianDayNumber {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].julianDayNumber,
+				julianDayNo)
+		}
+
+		var aDateTimeDto ADateTimeDto
+
+		aDateTimeDto, err =
+			calJulianUtil.DateTimeFromJulianDateTime(
+				julianDayNoDto,
+				ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calJulianUtil.DateTimeFromJulianDateTime()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if aDateTimeDto.GetYearAstronomical() != testDates[i].year ||
+			aDateTimeDto.GetMonth() != testDates[i].month ||
+			aDateTimeDto.GetDay() != testDates[i].day ||
+			aDateTimeDto.GetHour() != 12 {
+			t.Errorf("Result INVALID!\n" +
+				"Julian Day Number='%v'\n" +
+				"Expected Date='%v-%v-%v 12'\n" +
+				"  Actual Date='%v-%v-%v %v'\n",
+				testDates[i].julianDayNumber,
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				aDateTimeDto.GetYearAstronomical(),
+				aDateTimeDto.GetMonth(),
+				aDateTimeDto.GetDay(),
+				aDateTimeDto.GetHour())
+		}
+	}
+
+	return
+}
+
+func TestCalendarJulianUtilityJulianDayNumber02 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianUtilityJulianDayNumber02() "
+
+	calJulianUtil := CalendarJulianUtility{}
+
+	for julianDayNo := int64(-1500); julianDayNo <= 1500; julianDayNo += 3 {
+
+		julianDayNoDto, err := JulianDayNoDto{}.New(
+			julianDayNo,
+			big.NewFloat(0.0),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by JulianDayNoDto{}.New()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var aDateTimeDto ADateTimeDto
+
+		aDateTimeDto, err =
+			calJulianUtil.DateTimeFromJulianDateTime(
+				julianDayNoDto,
+				ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calJulianUtil.DateTimeFromJulianDateTime()\n" +
+				"julianDayNo='%v'\n" +
+				"Error='%v'\n", julianDayNo, err.Error())
+			return
+		}
+
+		julianDayNoDto, err = calJulianUtil.GetJulianDayNumber(
+			aDateTimeDto.GetYearAstronomical(),
+			aDateTimeDto.GetMonth(),
+			aDateTimeDto.GetDay(),
+			aDateTimeDto.GetHour(),
+			aDateTimeDto.GetMinute(),
+			aDateTimeDto.GetSecond(),
+			aDateTimeDto.GetNanosecond(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calJulianUtil.GetJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var roundTripDayNo int64
+
+		roundTripDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if roundTripDayNo != julianDayNo {
+			t.Errorf("Round Trip Result INVALID!\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				julianDayNo,
+				roundTripDayNo)
+			return
+		}
+	}
+
+	return
+}
+
+func TestCalendarJulianBaseDataAlias01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianBaseDataAlias01() "
+
+	julianBData := JulianCalendarBaseData{}
+
+	var calBaseData ICalendarBaseData = &julianBData
+
+	if calBaseData.GetCalendarSpecification() != CalendarSpec(0).Julian() {
+		t.Errorf("Error: Expected calendar specification='Julian'\n" +
+			"Instead, calendar specification='%v'\n",
+			calBaseData.GetCalendarSpecification().String())
+	}
+
+	if julianBData.GetDaysInLeapYear() != 366 ||
+		julianBData.GetDaysInStandardYear() != 365 {
+		t.Errorf("Error: Expected days in leap year='366' and days in " +
+			"standard year='365'\n" +
+			"Instead, days in leap year='%v' and days in standard year='%v'\n",
+			julianBData.GetDaysInLeapYear(),
+			julianBData.GetDaysInStandardYear())
+	}
+
+	isLeapYear, err := julianBData.IsLeapYear(
+		1900,
+		CalendarYearNumType(0).CE(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianBData.IsLeapYear(1900)\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !isLeapYear {
+		t.Error("Error: Expected year 1900 to be a Julian leap year.\n" +
+			"Instead, isLeapYear='false'\n")
+	}
+}
@@ -0,0 +1,533 @@
+package datetime
+
+import (
+	"testing"
+)
+
+func TestCalendarJulianGregorianBaseDataIsValidDate01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianGregorianBaseDataIsValidDate01() "
+
+	julianGregCalBData := CalendarJulianGregorianBaseData{}
+
+	testDates := []struct {
+		year     int64
+		month    int
+		day      int
+		expected bool
+	}{
+		{1582, 10, 4, true},
+		{1582, 10, 5, false},
+		{1582, 10, 10, false},
+		{1582, 10, 14, false},
+		{1582, 10, 15, true},
+		{1500, 2, 29, true},
+		{1700, 2, 29, false},
+		{1600, 2, 29, true},
+		{1582, 2, 29, false},
+		{2021, 4, 31, false},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		isValid, _ := julianGregCalBData.IsValidDate(
+			testDates[i].year,
+			CalYearType.Astronomical(),
+			testDates[i].month,
+			testDates[i].day,
+			ePrefix)
+
+		if isValid != testDates[i].expected {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected isValid='%v'\n" +
+				"  Actual isValid='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].expected,
+				isValid)
+		}
+	}
+
+	return
+}
+
+func TestCalendarJulianGregorianBaseDataDaysInYear01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianGregorianBaseDataDaysInYear01() "
+
+	julianGregCalBData := CalendarJulianGregorianBaseData{}
+
+	testYears := []struct {
+		year     int64
+		expected int
+	}{
+		{1500, 366},
+		{1580, 366},
+		{1581, 365},
+		{1582, 355},
+		{1583, 365},
+		{1700, 365},
+		{2000, 366},
+	}
+
+	for i:=0; i < len(testYears); i++ {
+
+		daysInYear, err := julianGregCalBData.GetDaysInYear(
+			testYears[i].year,
+			CalYearType.CE(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianGregCalBData.GetDaysInYear()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if daysInYear != testYears[i].expected {
+			t.Errorf("Result INVALID!\n" +
+				"year='%v'\n" +
+				"Expected daysInYear='%v'\n" +
+				"  Actual daysInYear='%v'\n",
+				testYears[i].year,
+				testYears[i].expected,
+				daysInYear)
+		}
+	}
+
+	return
+}
+
+func TestCalendarJulianGregorianBaseDataOrdinalDays01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianGregorianBaseDataOrdinalDays01() "
+
+	julianGregCalBData := CalendarJulianGregorianBaseData{}
+
+	testDates := []struct {
+		month                 int
+		day                   int
+		expectedOrdinalDayNo  int
+		expectedRemainingDays int
+	}{
+		{1, 1, 1, 354},
+		{10, 4, 277, 78},
+		{10, 15, 278, 77},
+		{12, 31, 355, 0},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		ordinalDayNo, err := julianGregCalBData.GetOrdinalDayNoFromDate(
+			1582,
+			CalYearType.CE(),
+			testDates[i].month,
+			testDates[i].day,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianGregCalBData.GetOrdinalDayNoFromDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if ordinalDayNo != testDates[i].expectedOrdinalDayNo {
+			t.Errorf("Result INVALID!\n" +
+				"Date='1582-%v-%v'\n" +
+				"Expected Ordinal Day No='%v'\n" +
+				"  Actual Ordinal Day No='%v'\n",
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].expectedOrdinalDayNo,
+				ordinalDayNo)
+		}
+
+		var remainingDays int
+
+		remainingDays, err = julianGregCalBData.GetRemainingDaysInYear(
+			1582,
+			CalYearType.CE(),
+			testDates[i].month,
+			testDates[i].day,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianGregCalBData.GetRemainingDaysInYear()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if remainingDays != testDates[i].expectedRemainingDays {
+			t.Errorf("Result INVALID!\n" +
+				"Date='1582-%v-%v'\n" +
+				"Expected Remaining Days='%v'\n" +
+				"  Actual Remaining Days='%v'\n",
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].expectedRemainingDays,
+				remainingDays)
+		}
+
+		var astronomicalYear int64
+		var month, day int
+
+		astronomicalYear,
+		month,
+		day,
+		err = julianGregCalBData.GetYearMonthDayFromOrdinalDayNo(
+			ordinalDayNo,
+			1582,
+			CalYearType.CE(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianGregCalBData.GetYearMonthDayFromOrdinalDayNo()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if astronomicalYear != 1582 ||
+			month != testDates[i].month ||
+			day != testDates[i].day {
+			t.Errorf("Result INVALID!\n" +
+				"Ordinal Day No='%v'\n" +
+				"Expected Date='1582-%v-%v'\n" +
+				"  Actual Date='%v-%v-%v'\n",
+				ordinalDayNo,
+				testDates[i].month,
+				testDates[i].day,
+				astronomicalYear,
+				month,
+				day)
+		}
+	}
+
+	return
+}
+
+func TestCalendarJulianGregorianBaseDataCutover01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianGregorianBaseDataCutover01() "
+
+	// Great Britain: Wednesday, 2 September 1752 (Julian) was
+	// followed by Thursday, 14 September 1752 (Gregorian).
+	julianGregCalBData, err :=
+		CalendarJulianGregorianBaseData{}.NewFromCutoverJulianDayNo(
+			2361222,
+			ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by CalendarJulianGregorianBaseData{}." +
+			"NewFromCutoverJulianDayNo()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	var daysInYear int
+
+	daysInYear, err = julianGregCalBData.GetDaysInYear(
+		1752,
+		CalYearType.CE(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by julianGregCalBData.GetDaysInYear()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if daysInYear != 355 {
+		t.Errorf("Result INVALID!\n" +
+			"Expected daysInYear='355'\n" +
+			"  Actual daysInYear='%v'\n",
+			daysInYear)
+	}
+
+	testDates := []struct {
+		year     int64
+		month    int
+		day      int
+		expected bool
+	}{
+		{1582, 10, 10, true},
+		{1700, 2, 29, true},
+		{1752, 9, 2, true},
+		{1752, 9, 3, false},
+		{1752, 9, 13, false},
+		{1752, 9, 14, true},
+		{1800, 2, 29, false},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		isValid, _ := julianGregCalBData.IsValidDate(
+			testDates[i].year,
+			CalYearType.Astronomical(),
+			testDates[i].month,
+			testDates[i].day,
+			ePrefix)
+
+		if isValid != testDates[i].expected {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected isValid='%v'\n" +
+				"  Actual isValid='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].expected,
+				isValid)
+		}
+	}
+
+	_, err = CalendarJulianGregorianBaseData{}.NewFromCutoverJulianDayNo(
+		1794167,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Expected an error return from NewFromCutoverJulianDayNo()\n" +
+			"because 'cutoverJulianDayNo' is less than 1794168.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	return
+}
+
+func TestCalendarJulianGregorianUtilityJulianDayNumber01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianGregorianUtilityJulianDayNumber01() "
+
+	testDates := []struct {
+		cutover          int64
+		year             int64
+		month            int
+		day              int
+		julianDayNumber  int64
+	}{
+		{0, 1582, 10, 4, 2299160},
+		{0, 1582, 10, 15, 2299161},
+		{0, 1, 1, 1, 1721424},
+		{0, 2000, 1, 1, 2451545},
+		{2361222, 1752, 9, 2, 2361221},
+		{2361222, 1752, 9, 14, 2361222},
+		{2361222, 1700, 2, 29, 2342042},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		calJulianGregUtil := CalendarJulianGregorianUtility{}
+
+		if testDates[i].cutover != 0 {
+
+			err := calJulianGregUtil.SetCutoverJulianDayNo(
+				testDates[i].cutover,
+				ePrefix)
+
+			if err != nil {
+				t.Errorf("Error returned by calJulianGregUtil.SetCutoverJulianDayNo()\n" +
+					"Error='%v'\n", err.Error())
+				return
+			}
+		}
+
+		julianDayNoDto, err := calJulianGregUtil.GetJulianDayNumber(
+			testDates[i].year,
+			testDates[i].month,
+			testDates[i].day,
+			12,
+			0,
+			0,
+			0,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calJulianGregUtil.GetJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var julianDayNo int64
+
+		julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if julianDayNo != testDates[i].julianDayNumber {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].julianDayNumber,
+				julianDayNo)
+		}
+
+		var aDateTimeDto ADateTimeDto
+
+		aDateTimeDto, err =
+			calJulianGregUtil.DateTimeFromJulianDateTime(
+				julianDayNoDto,
+				ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calJulianGregUtil.DateTimeFromJulianDateTime()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if aDateTimeDto.GetYearAstronomical() != testDates[i].year ||
+			aDateTimeDto.GetMonth() != testDates[i].month ||
+			aDateTimeDto.GetDay() != testDates[i].day ||
+			aDateTimeDto.GetHour() != 12 {
+			t.Errorf("Result INVALID!\n" +
+				"Julian Day Number='%v'\n" +
+				"Expected Date='%v-%v-%v 12'\n" +
+				"  Actual Date='%v-%v-%v %v'\n",
+				testDates[i].julianDayNumber,
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				aDateTimeDto.GetYearAstronomical(),
+				aDateTimeDto.GetMonth(),
+				aDateTimeDto.GetDay(),
+				aDateTimeDto.GetHour())
+		}
+	}
+
+	calJulianGregUtil := CalendarJulianGregorianUtility{}
+
+	_, err := calJulianGregUtil.GetJulianDayNumber(
+		1582,
+		10,
+		10,
+		12,
+		0,
+		0,
+		0,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Expected an error return from GetJulianDayNumber()\n" +
+			"because date 1582-10-10 was skipped by the reform.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	return
+}
+
+func TestCalendarJulianGregorianConvertTo01 (t *testing.T) {
+
+	ePrefix := "TestCalendarJulianGregorianConvertTo01() "
+
+	testDates := []struct {
+		gregorianDay int
+		expectedDay  int
+	}{
+		{15, 15},
+		{14, 4},
+		{5, 25},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		gregorianCalDTime, err := CalendarDateTime{}.NewCalDateTime(
+			CalSpec.Gregorian(),
+			1582,
+			CalYearType.CE(),
+			10,
+			testDates[i].gregorianDay,
+			0,
+			0,
+			0,
+			0,
+			false,
+			"UTC",
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTime()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var julianGregCalDTime CalendarDateTime
+
+		julianGregCalDTime, err = gregorianCalDTime.ConvertTo(
+			CalSpec.JulianGregorian(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by gregorianCalDTime.ConvertTo()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		year,
+		_,
+		_,
+		calendarSystem,
+		month,
+		day,
+		err := julianGregCalDTime.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianGregCalDTime.GetDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		expectedMonth := 10
+
+		if testDates[i].gregorianDay < testDates[i].expectedDay {
+			expectedMonth = 9
+		}
+
+		if year != 1582 ||
+			calendarSystem != CalSpec.JulianGregorian() ||
+			month != expectedMonth ||
+			day != testDates[i].expectedDay {
+			t.Errorf("Result INVALID!\n" +
+				"Gregorian Date='1582-10-%v'\n" +
+				"Expected Date='JulianGregorian 1582-%v-%v'\n" +
+				"  Actual Date='%v %v-%v-%v'\n",
+				testDates[i].gregorianDay,
+				expectedMonth,
+				testDates[i].expectedDay,
+				calendarSystem.String(),
+				year,
+				month,
+				day)
+		}
+	}
+
+	_, err := CalendarDateTime{}.NewCalDateTime(
+		CalSpec.JulianGregorian(),
+		1582,
+		CalYearType.CE(),
+		10,
+		10,
+		0,
+		0,
+		0,
+		0,
+		false,
+		"UTC",
+		"",
+		ePrefix)
+
+	if err == nil {
+		t.Error("Expected an error return from CalendarDateTime{}.NewCalDateTime()\n" +
+			"because date 1582-10-10 was skipped by the reform.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	return
+}
@@ -11,7 +11,7 @@ func TestConvertAnyYearToAstronomicalYear01 (t *testing.T) {
 	year := int64(4715)
 	yearNumType := CalYearType.BCE()
 
-	expectedYear := int64(-4716)
+	expectedYear := int64(-4714)
 
 	calMech := calendarMechanics{}
 	var astronomicalYearValue int64
@@ -0,0 +1,304 @@
+package datetime
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCalendarRevisedGoucherParkerBaseDataIsLeapYear01 (t *testing.T) {
+
+	ePrefix := "TestCalendarRevisedGoucherParkerBaseDataIsLeapYear01() "
+
+	revGoucherParkerCalBData := CalendarRevisedGoucherParkerBaseData{}
+
+	testYears := []struct {
+		year        int64
+		yearNumType CalendarYearNumType
+		expected    bool
+	}{
+		{2000, CalYearType.CE(), true},
+		{1900, CalYearType.CE(), true},
+		{1920, CalYearType.CE(), false},
+		{1536, CalYearType.CE(), false},
+		{2021, CalYearType.CE(), false},
+		{0, CalYearType.Astronomical(), true},
+		{-4, CalYearType.Astronomical(), true},
+		{-128, CalYearType.Astronomical(), false},
+		{-4712, CalYearType.Astronomical(), true},
+	}
+
+	for i:=0; i < len(testYears); i++ {
+
+		isLeapYear, err := revGoucherParkerCalBData.IsLeapYear(
+			testYears[i].year,
+			testYears[i].yearNumType,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revGoucherParkerCalBData.IsLeapYear()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if isLeapYear != testYears[i].expected {
+			t.Errorf("Result INVALID!\n" +
+				"year='%v'\n" +
+				"Expected isLeapYear='%v'\n" +
+				"  Actual isLeapYear='%v'\n",
+				testYears[i].year,
+				testYears[i].expected,
+				isLeapYear)
+		}
+	}
+
+	return
+}
+
+func TestCalendarRevisedGoucherParkerUtilityJulianDayNumber01 (t *testing.T) {
+
+	ePrefix := "TestCalendarRevisedGoucherParkerUtilityJulianDayNumber01() "
+
+	testDates := []struct {
+		year             int64
+		month            int
+		day              int
+		julianDayNumber  int64
+	}{
+		{-4712, 1, 1, 0},
+		{-4713, 12, 31, -1},
+		{1, 1, 1, 1721388},
+		{1536, 3, 1, 2282094},
+		{2000, 1, 1, 2451507},
+		{2021, 12, 31, 2459542},
+		{-5000, 3, 1, -105129},
+	}
+
+	revGoucherParkerUtil := CalendarRevisedGoucherParkerUtility{}
+
+	for i:=0; i < len(testDates); i++ {
+
+		julianDayNoDto, err := revGoucherParkerUtil.GetJulianDayNumber(
+			testDates[i].year,
+			testDates[i].month,
+			testDates[i].day,
+			12,
+			0,
+			0,
+			0,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revGoucherParkerUtil.GetJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var julianDayNo int64
+
+		julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if julianDayNo != testDates[i].julianDayNumber {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].julianDayNumber,
+				julianDayNo)
+		}
+
+		var aDateTimeDto ADateTimeDto
+
+		aDateTimeDto, err =
+			revGoucherParkerUtil.DateTimeFromJulianDateTime(
+				julianDayNoDto,
+				ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revGoucherParkerUtil.DateTimeFromJulianDateTime()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if aDateTimeDto.GetYearAstronomical() != testDates[i].year ||
+			aDateTimeDto.GetMonth() != testDates[i].month ||
+			aDateTimeDto.GetDay() != testDates[i].day ||
+			aDateTimeDto.GetHour() != 12 {
+			t.Errorf("Result INVALID!\n" +
+				"Julian Day Number='%v'\n" +
+				"Expected Date='%v-%v-%v 12'\n" +
+				"  Actual Date='%v-%v-%v %v'\n",
+				testDates[i].julianDayNumber,
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				aDateTimeDto.GetYearAstronomical(),
+				aDateTimeDto.GetMonth(),
+				aDateTimeDto.GetDay(),
+				aDateTimeDto.GetHour())
+		}
+	}
+
+	return
+}
+
+func TestCalendarRevisedGoucherParkerUtilityJulianDayNumber02 (t *testing.T) {
+
+	ePrefix := "TestCalendarRevisedGoucherParkerUtilityJulianDayNumber02() "
+
+	revGoucherParkerUtil := CalendarRevisedGoucherParkerUtility{}
+
+	for julianDayNo := int64(-1500); julianDayNo <= 1500; julianDayNo += 3 {
+
+		julianDayNoDto, err := JulianDayNoDto{}.New(
+			julianDayNo,
+			big.NewFloat(0.0),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by JulianDayNoDto{}.New()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var aDateTimeDto ADateTimeDto
+
+		aDateTimeDto, err =
+			revGoucherParkerUtil.DateTimeFromJulianDateTime(
+				julianDayNoDto,
+				ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revGoucherParkerUtil.DateTimeFromJulianDateTime()\n" +
+				"julianDayNo='%v'\n" +
+				"Error='%v'\n", julianDayNo, err.Error())
+			return
+		}
+
+		julianDayNoDto, err = revGoucherParkerUtil.GetJulianDayNumber(
+			aDateTimeDto.GetYearAstronomical(),
+			aDateTimeDto.GetMonth(),
+			aDateTimeDto.GetDay(),
+			aDateTimeDto.GetHour(),
+			aDateTimeDto.GetMinute(),
+			aDateTimeDto.GetSecond(),
+			aDateTimeDto.GetNanosecond(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revGoucherParkerUtil.GetJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var roundTripDayNo int64
+
+		roundTripDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if roundTripDayNo != julianDayNo {
+			t.Errorf("Round Trip Result INVALID!\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				julianDayNo,
+				roundTripDayNo)
+			return
+		}
+	}
+
+	return
+}
+
+func TestCalendarRevisedGoucherParkerUtilityJulianDayNumber03 (t *testing.T) {
+
+	ePrefix := "TestCalendarRevisedGoucherParkerUtilityJulianDayNumber03() "
+
+	// The Julian Day Numbers for January 1st of consecutive years
+	// must differ by the number of days in the first year. These
+	// years straddle the 4, 128 and 454,545-year leap year rules
+	// and the main cycle start years.
+	testYears := []int64{
+		-909091, -454546, -454545, -5000, -4737, -4736, -4713,
+		-4712, -4609, -4608, -129, -128, -1, 0, 1, 127, 128,
+		2020, 2021, 454544, 454545, 454546, 909090, 58181760,
+	}
+
+	revGoucherParkerUtil := CalendarRevisedGoucherParkerUtility{}
+
+	revGoucherParkerBData := CalendarRevisedGoucherParkerBaseData{}
+
+	getYearStartDayNo := func(year int64) int64 {
+
+		julianDayNoDto, err := revGoucherParkerUtil.GetJulianDayNumber(
+			year,
+			1,
+			1,
+			12,
+			0,
+			0,
+			0,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revGoucherParkerUtil.GetJulianDayNumber()\n" +
+				"year='%v'\n" +
+				"Error='%v'\n", year, err.Error())
+			return 0
+		}
+
+		var julianDayNo int64
+
+		julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return 0
+		}
+
+		return julianDayNo
+	}
+
+	for i:=0; i < len(testYears); i++ {
+
+		daysInYear, err := revGoucherParkerBData.GetDaysInYear(
+			testYears[i],
+			CalendarYearNumType(0).Astronomical(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revGoucherParkerBData.GetDaysInYear()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		actualDays :=
+			getYearStartDayNo(testYears[i] + 1) -
+				getYearStartDayNo(testYears[i])
+
+		if actualDays != int64(daysInYear) {
+			t.Errorf("Result INVALID!\n" +
+				"Year='%v'\n" +
+				"Expected Days In Year='%v'\n" +
+				"  Actual Days In Year='%v'\n",
+				testYears[i],
+				daysInYear,
+				actualDays)
+		}
+	}
+
+	return
+}
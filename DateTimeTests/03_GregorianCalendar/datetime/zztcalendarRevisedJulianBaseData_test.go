@@ -0,0 +1,227 @@
+package datetime
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCalendarRevisedJulianBaseDataIsLeapYear01 (t *testing.T) {
+
+	ePrefix := "TestCalendarRevisedJulianBaseDataIsLeapYear01() "
+
+	revJulianCalBData := CalendarRevisedJulianBaseData{}
+
+	testYears := []struct {
+		year        int64
+		yearNumType CalendarYearNumType
+		expected    bool
+	}{
+		{2000, CalYearType.CE(), true},
+		{1900, CalYearType.CE(), false},
+		{2021, CalYearType.CE(), false},
+		{2024, CalYearType.CE(), true},
+		{2400, CalYearType.CE(), true},
+		{2800, CalYearType.CE(), false},
+		{2900, CalYearType.CE(), true},
+		{0, CalYearType.Astronomical(), false},
+		{-300, CalYearType.Astronomical(), true},
+		{-700, CalYearType.Astronomical(), true},
+		{-1100, CalYearType.Astronomical(), false},
+	}
+
+	for i:=0; i < len(testYears); i++ {
+
+		isLeapYear, err := revJulianCalBData.IsLeapYear(
+			testYears[i].year,
+			testYears[i].yearNumType,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revJulianCalBData.IsLeapYear()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if isLeapYear != testYears[i].expected {
+			t.Errorf("Result INVALID!\n" +
+				"year='%v'\n" +
+				"Expected isLeapYear='%v'\n" +
+				"  Actual isLeapYear='%v'\n",
+				testYears[i].year,
+				testYears[i].expected,
+				isLeapYear)
+		}
+	}
+
+	return
+}
+
+func TestCalendarRevisedJulianUtilityJulianDayNumber01 (t *testing.T) {
+
+	ePrefix := "TestCalendarRevisedJulianUtilityJulianDayNumber01() "
+
+	testDates := []struct {
+		year             int64
+		month            int
+		day              int
+		julianDayNumber  int64
+	}{
+		{-4713, 11, 22, 0},
+		{-4713, 11, 21, -1},
+		{1, 1, 1, 1721426},
+		{1500, 2, 29, 2268983},
+		{1600, 3, 1, 2305508},
+		{2000, 1, 1, 2451545},
+		{2800, 2, 28, 2743797},
+		{2900, 3, 1, 2780323},
+		{-5000, 3, 1, -105091},
+	}
+
+	revJulianUtil := CalendarRevisedJulianUtility{}
+
+	for i:=0; i < len(testDates); i++ {
+
+		julianDayNoDto, err := revJulianUtil.GetJulianDayNumber(
+			testDates[i].year,
+			testDates[i].month,
+			testDates[i].day,
+			12,
+			0,
+			0,
+			0,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revJulianUtil.GetJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var julianDayNo int64
+
+		julianDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if julianDayNo != testDates[i].julianDayNumber {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].julianDayNumber,
+				julianDayNo)
+		}
+
+		var aDateTimeDto ADateTimeDto
+
+		aDateTimeDto, err =
+			revJulianUtil.DateTimeFromJulianDateTime(
+				julianDayNoDto,
+				ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revJulianUtil.DateTimeFromJulianDateTime()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if aDateTimeDto.GetYearAstronomical() != testDates[i].year ||
+			aDateTimeDto.GetMonth() != testDates[i].month ||
+			aDateTimeDto.GetDay() != testDates[i].day ||
+			aDateTimeDto.GetHour() != 12 {
+			t.Errorf("Result INVALID!\n" +
+				"Julian Day Number='%v'\n" +
+				"Expected Date='%v-%v-%v 12'\n" +
+				"  Actual Date='%v-%v-%v %v'\n",
+				testDates[i].julianDayNumber,
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				aDateTimeDto.GetYearAstronomical(),
+				aDateTimeDto.GetMonth(),
+				aDateTimeDto.GetDay(),
+				aDateTimeDto.GetHour())
+		}
+	}
+
+	return
+}
+
+func TestCalendarRevisedJulianUtilityJulianDayNumber02 (t *testing.T) {
+
+	ePrefix := "TestCalendarRevisedJulianUtilityJulianDayNumber02() "
+
+	revJulianUtil := CalendarRevisedJulianUtility{}
+
+	for julianDayNo := int64(2305000); julianDayNo <= 2306500; julianDayNo += 3 {
+
+		julianDayNoDto, err := JulianDayNoDto{}.New(
+			julianDayNo,
+			big.NewFloat(0.0),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by JulianDayNoDto{}.New()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var aDateTimeDto ADateTimeDto
+
+		aDateTimeDto, err =
+			revJulianUtil.DateTimeFromJulianDateTime(
+				julianDayNoDto,
+				ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revJulianUtil.DateTimeFromJulianDateTime()\n" +
+				"julianDayNo='%v'\n" +
+				"Error='%v'\n", julianDayNo, err.Error())
+			return
+		}
+
+		julianDayNoDto, err = revJulianUtil.GetJulianDayNumber(
+			aDateTimeDto.GetYearAstronomical(),
+			aDateTimeDto.GetMonth(),
+			aDateTimeDto.GetDay(),
+			aDateTimeDto.GetHour(),
+			aDateTimeDto.GetMinute(),
+			aDateTimeDto.GetSecond(),
+			aDateTimeDto.GetNanosecond(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by revJulianUtil.GetJulianDayNumber()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var roundTripDayNo int64
+
+		roundTripDayNo, err = julianDayNoDto.GetJulianDayInt64(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by julianDayNoDto.GetJulianDayInt64()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if roundTripDayNo != julianDayNo {
+			t.Errorf("Round Trip Result INVALID!\n" +
+				"Expected Julian Day Number='%v'\n" +
+				"  Actual Julian Day Number='%v'\n",
+				julianDayNo,
+				roundTripDayNo)
+			return
+		}
+	}
+
+	return
+}
@@ -0,0 +1,319 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTimeLocale01(t *testing.T) {
+
+	ePrefix := "TestDateTimeLocale01() "
+
+	dateTime := time.Date(2024, 2, 15, 14, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		localeId string
+		layout   string
+		expected string
+	}{
+		{"fr", "2 January 2006", "15 février 2024"},
+		{"fr", "Monday 2 Jan 2006 15:04", "jeudi 15 févr. 2024 14:30"},
+		{"de", "Monday, 2. January 2006", "Donnerstag, 15. Februar 2024"},
+		{"es", "Mon 2 Jan 2006 3:04 PM", "jue 15 feb 2024 2:30 p. m."},
+		{"es", "3:04 pm", "2:30 p. m."},
+		{"it", "Monday 2 January 2006", "giovedì 15 febbraio 2024"},
+		{"pt-BR", "Monday, 2 January 2006", "quinta-feira, 15 fevereiro 2024"},
+		{"nl", "Mon 2 Jan 2006", "do 15 feb 2024"},
+		{"sv", "Monday 2 January 2006", "torsdag 15 februari 2024"},
+		{"pl", "2 January 2006", "15 lutego 2024"},
+		{"ru", "2 January 2006, Monday", "15 февраля 2024, четверг"},
+		{"tr", "2 January 2006 Monday", "15 Şubat 2024 Perşembe"},
+		{"fi", "Monday 2. January 2006", "torstai 15. helmikuuta 2024"},
+		{"en_US", "Monday January 2, 2006 3:04 PM", "Thursday February 15, 2024 2:30 PM"},
+	}
+
+	for i, tc := range testCases {
+
+		dtLocale, err := DateTimeLocaleRegistry{}.Get(tc.localeId, ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by DateTimeLocaleRegistry{}.Get(%q)\n"+
+				"Error='%v'\n", i, tc.localeId, err.Error())
+			continue
+		}
+
+		actual := dtLocale.FormatDateTime(dateTime, tc.layout)
+
+		if actual != tc.expected {
+			t.Errorf("Test Case #%v Error: FormatDateTime(%q) locale=%q\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				i, tc.layout, tc.localeId, tc.expected, actual)
+			continue
+		}
+
+		parsed, err := dtLocale.ParseDateTime(
+			tc.layout,
+			actual,
+			time.UTC,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by ParseDateTime(%q, %q)\n"+
+				"Error='%v'\n", i, tc.layout, actual, err.Error())
+			continue
+		}
+
+		expectedTime, _ := time.Parse(tc.layout, dateTime.Format(tc.layout))
+
+		if !parsed.Equal(expectedTime) {
+			t.Errorf("Test Case #%v Error: ParseDateTime(%q, %q)\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				i, tc.layout, actual, expectedTime, parsed)
+		}
+	}
+}
+
+func TestDateTimeLocale02(t *testing.T) {
+
+	ePrefix := "TestDateTimeLocale02() "
+
+	// Localized names are matched without regard to case.
+	dtzDto, err := DateTzDto{}.NewFromLocaleString(
+		"15 FÉVRIER 2024 14:30",
+		"2 January 2006 15:04",
+		"fr",
+		TZones.Europe.Paris())
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewFromLocaleString()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	expected := "2024-02-15 14:30:00 +0100 CET"
+
+	actual := dtzDto.GetDateTimeValue().Format("2006-01-02 15:04:05 -0700 MST")
+
+	if actual != expected {
+		t.Errorf("Error: Expected dateTime='%v'\n  Actual dateTime='%v'\n",
+			expected, actual)
+	}
+
+	actual, err = dtzDto.GetLocaleDateTimeStr("fr")
+
+	if err != nil {
+		t.Errorf("Error returned by dtzDto.GetLocaleDateTimeStr(\"fr\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if actual != "15 février 2024 14:30" {
+		t.Errorf("Error: Expected='15 février 2024 14:30'\n  Actual='%v'\n", actual)
+	}
+
+	dtzDto.SetDateTimeFmt("Monday 2 January 2006")
+
+	actual, err = dtzDto.GetLocaleDateTimeStr("de-AT")
+
+	if err != nil {
+		t.Errorf("Error returned by dtzDto.GetLocaleDateTimeStr(\"de-AT\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if actual != "Donnerstag 15 Februar 2024" {
+		t.Errorf("Error: Expected='Donnerstag 15 Februar 2024'\n  Actual='%v'\n", actual)
+	}
+
+	// Spanish "mar" designates both March and Tuesday. The layout
+	// resolves the ambiguity.
+	dtzDto, err = DateTzDto{}.NewFromLocaleString(
+		"mar 5 mar 2024",
+		"Mon 2 Jan 2006",
+		"es",
+		"")
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewFromLocaleString(\"es\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !dtzDto.GetDateTimeValue().Equal(time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Error: Expected dateTime='2024-03-05 00:00:00 UTC'\n"+
+			"  Actual dateTime='%v'\n", dtzDto.GetDateTimeValue())
+	}
+
+	// Without a layout, the longest localized name is translated.
+	dtLocale, err := DateTimeLocaleRegistry{}.Get("tr", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTimeLocaleRegistry{}.Get(\"tr\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	actual = dtLocale.TranslateToEnglish("Pazartesi 12 Şubat 2024 ÖS", "")
+
+	if actual != "Monday 12 February 2024 PM" {
+		t.Errorf("Error: Expected='Monday 12 February 2024 PM'\n  Actual='%v'\n", actual)
+	}
+
+	isoWeekDays, err := dtLocale.GetDaysOfWeekNames(
+		DayOfWeekNumberingSystemType(0).ISO8601DayOfWeek(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by dtLocale.GetDaysOfWeekNames()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if isoWeekDays[1] != "Pazartesi" || isoWeekDays[7] != "Pazar" {
+		t.Errorf("Error: Expected ISO week days 1='Pazartesi' and 7='Pazar'.\n"+
+			"Instead, 1='%v' and 7='%v'\n", isoWeekDays[1], isoWeekDays[7])
+	}
+
+	usWeekDayAbbrvs, err := dtLocale.GetDaysOfWeekNameAbbreviations(
+		DayOfWeekNumberingSystemType(0).UsDayOfWeek(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by dtLocale.GetDaysOfWeekNameAbbreviations()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if usWeekDayAbbrvs[0] != "Paz" || usWeekDayAbbrvs[6] != "Cmt" {
+		t.Errorf("Error: Expected US week day abbreviations 0='Paz' and 6='Cmt'.\n"+
+			"Instead, 0='%v' and 6='%v'\n", usWeekDayAbbrvs[0], usWeekDayAbbrvs[6])
+	}
+
+	localeIds, err := DateTimeLocaleRegistry{}.GetLocaleIds(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTimeLocaleRegistry{}.GetLocaleIds()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(localeIds) < 12 {
+		t.Errorf("Error: Expected at least 12 registered locales.\n"+
+			"Instead, registered locales='%v'\n", localeIds)
+	}
+}
+
+func TestDateTimeLocale03(t *testing.T) {
+
+	ePrefix := "TestDateTimeLocale03() "
+
+	dtLocale, err := DateTimeLocale{}.New(
+		"x-Pirate",
+		"Pirate",
+		[]string{"Janarr", "Febarr", "Marrch", "Aprarr", "Mayarr", "Junarr",
+			"Julyarr", "Augarr", "Separr", "Octarr", "Novarr", "Decarr"},
+		[]string{"Jar", "Far", "Mar", "Aar", "Yar", "Nar",
+			"Lar", "Gar", "Sar", "Oar", "Var", "Dar"},
+		[]string{"Sunarr", "Monarr", "Tuesarr", "Wedarr",
+			"Thurarr", "Friarr", "Satarr"},
+		[]string{"Su", "Mo", "Tu", "We", "Th", "Fr", "Sa"},
+		"Morn",
+		"Eve",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTimeLocale{}.New()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	err = DateTimeLocaleRegistry{}.Register(dtLocale, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTimeLocaleRegistry{}.Register()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dtzDto, err := DateTzDto{}.NewFromLocaleString(
+		"Friarr 4 Julyarr 2025 9:15 eve",
+		"Monday 2 January 2006 3:04 pm",
+		"X_PIRATE",
+		"")
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewFromLocaleString(\"X_PIRATE\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !dtzDto.GetDateTimeValue().Equal(time.Date(2025, 7, 4, 21, 15, 0, 0, time.UTC)) {
+		t.Errorf("Error: Expected dateTime='2025-07-04 21:15:00 UTC'\n"+
+			"  Actual dateTime='%v'\n", dtzDto.GetDateTimeValue())
+	}
+
+	err = DateTimeLocaleRegistry{}.RegisterFromJSON(
+		[]byte(`{"locales": [{"localeId": "x-bad", "monthNames": ["one"]}]}`),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from RegisterFromJSON() with an " +
+			"incomplete locale pack. NO ERROR WAS RETURNED!")
+	}
+
+	_, err = DateTimeLocaleRegistry{}.Get("x-bad", ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from Get(\"x-bad\"). " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	_, err = DateTimeLocaleRegistry{}.Get("", ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from Get(\"\"). " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	_, err = DateTimeLocale{}.New(
+		"xx",
+		"",
+		[]string{"one"},
+		nil,
+		nil,
+		nil,
+		"AM",
+		"PM",
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from DateTimeLocale{}.New() with " +
+			"missing names. NO ERROR WAS RETURNED!")
+	}
+
+	frLocale, err := DateTimeLocaleRegistry{}.Get("fr", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTimeLocaleRegistry{}.Get(\"fr\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	_, err = frLocale.ParseDateTime("2 January 2006", "15 Smarch 2024", nil, ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from ParseDateTime(\"15 Smarch 2024\"). " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	_, err = DateTzDto{}.NewFromLocaleString(
+		"15 février 2024",
+		"",
+		"fr",
+		"")
+
+	if err == nil {
+		t.Error("Error: Expected an error from NewFromLocaleString() with an " +
+			"empty format. NO ERROR WAS RETURNED!")
+	}
+}
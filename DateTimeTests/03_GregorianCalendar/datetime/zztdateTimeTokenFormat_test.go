@@ -0,0 +1,165 @@
+package datetime
+
+import (
+	"testing"
+)
+
+func TestDateTimeTokenFormat01(t *testing.T) {
+
+	ePrefix := "TestDateTimeTokenFormat01() "
+
+	testCases := []struct {
+		calendarSystem CalendarSpec
+		year           int64
+		month          int
+		day            int
+		hour           int
+		minute         int
+		second         int
+		nanosecond     int
+		timeZone       string
+		format         string
+		expected       string
+	}{
+		// Ides of March, 44 BCE. Astronomical year -43.
+		{CalSpec.Julian(), -43, 3, 15, 13, 30, 5, 500000000, "UTC",
+			"%y %E %B %-d, %A %H:%M:%S.%.3f",
+			"44 BCE March 15, Wednesday 13:30:05.500"},
+		{CalSpec.Julian(), -43, 3, 15, 13, 30, 5, 500000000, "UTC",
+			"%Y-%m-%d %C JDN %J %.6J",
+			"-0043-03-15 Julian JDN 1705426 1705426.062564"},
+		{CalSpec.Julian(), -43, 3, 15, 13, 30, 5, 500000000, "UTC",
+			"%G-W%V-%u %j",
+			"-0043-W11-3 074"},
+		// Astronomical year zero is 1 BCE.
+		{CalSpec.Gregorian(), 0, 1, 1, 0, 0, 0, 0, "UTC",
+			"%Y %-Y %y %E",
+			"0000 0 1 BCE"},
+		// Five and six digit years.
+		{CalSpec.Gregorian(), 12345, 6, 1, 1, 2, 3, 4, "America/Chicago",
+			"%a %b %d %Y %I:%M:%S.%f %p %z %Z",
+			"Fri Jun 01 12345 01:02:03.000000004 AM -0500 CDT"},
+		{CalSpec.Gregorian(), -100000, 12, 31, 23, 59, 59, 0, "UTC",
+			"%Y-%m-%d %j %y %E",
+			"-100000-12-31 366 100001 BCE"},
+		// ISO week-based year differs from the calendar year.
+		{CalSpec.Gregorian(), 2021, 1, 3, 0, 0, 0, 0, "UTC",
+			"%Y-%m-%d = %G-W%V-%u %I %p",
+			"2021-01-03 = 2020-W53-7 12 AM"},
+		// Leap second.
+		{CalSpec.Gregorian(), 2016, 12, 31, 23, 59, 60, 0, "UTC",
+			"%Y-%m-%dT%H:%M:%S 100%%",
+			"2016-12-31T23:59:60 100%"},
+		{CalSpec.Gregorian(), 2021, 7, 4, 9, 5, 7, 0, "UTC",
+			"%-m/%-d/%Y %-H:%M",
+			"7/4/2021 9:05"},
+	}
+
+	for i, tc := range testCases {
+
+		calDTime, err := CalendarDateTime{}.NewCalDateTime(
+			tc.calendarSystem,
+			tc.year,
+			CalYearType.Astronomical(),
+			tc.month,
+			tc.day,
+			tc.hour,
+			tc.minute,
+			tc.second,
+			tc.nanosecond,
+			tc.second == 60,
+			tc.timeZone,
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by CalendarDateTime{}.NewCalDateTime()\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		actual, err := calDTime.FormatDateTime(tc.format, ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by calDTime.FormatDateTime(%q)\n"+
+				"Error='%v'\n", i, tc.format, err.Error())
+			continue
+		}
+
+		if actual != tc.expected {
+			t.Errorf("Test Case #%v Error: FormatDateTime(%q)\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				i, tc.format, tc.expected, actual)
+		}
+	}
+}
+
+func TestDateTimeTokenFormat02(t *testing.T) {
+
+	ePrefix := "TestDateTimeTokenFormat02() "
+
+	aDateTime, err := ADateTimeDto{}.New(
+		CalSpec.Gregorian(),
+		-9999,
+		CalYearType.Astronomical(),
+		2,
+		28,
+		false,
+		6,
+		0,
+		0,
+		0,
+		TZones.UTC(),
+		FmtDateTimeYrMDayFmtStr,
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.New()\nError='%v'\n", err.Error())
+		return
+	}
+
+	actual, err := aDateTime.FormatDateTime("%Y-%m-%d %H:%M %y %E", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by aDateTime.FormatDateTime()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	expected := "-9999-02-28 06:00 10000 BCE"
+
+	if actual != expected {
+		t.Errorf("Error: Expected='%v'\n  Actual='%v'\n", expected, actual)
+	}
+
+	invalidFormats := []string{
+		"",
+		"%Y-%m-%",
+		"%Q",
+		"%.3Y",
+		"%.f",
+		"%.0f",
+		"%.10f",
+		"%-",
+	}
+
+	for _, format := range invalidFormats {
+
+		_, err = aDateTime.FormatDateTime(format, ePrefix)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error from FormatDateTime(%q). "+
+				"NO ERROR WAS RETURNED!", format)
+		}
+	}
+
+	emptyDateTime := ADateTimeDto{}
+
+	_, err = emptyDateTime.FormatDateTime("%Y", ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from FormatDateTime() on an empty " +
+			"ADateTimeDto. NO ERROR WAS RETURNED!")
+	}
+}
@@ -0,0 +1,241 @@
+package datetime
+
+import (
+	"testing"
+)
+
+func TestDateTimeTokenParse01(t *testing.T) {
+
+	ePrefix := "TestDateTimeTokenParse01() "
+
+	outputFmt := "%Y-%m-%d %H:%M:%S.%f %C"
+
+	testCases := []struct {
+		calendarSystem CalendarSpec
+		dateTimeStr    string
+		format         string
+		timeZone       string
+		expected       string
+	}{
+		{CalSpec.Julian(), "0044-03-15 BCE 12:00:00", "%y-%m-%d %E %H:%M:%S", "UTC",
+			"-0043-03-15 12:00:00.000000000 Julian"},
+		{CalSpec.Julian(), "-0043-03-15", "%Y-%m-%d", "UTC",
+			"-0043-03-15 00:00:00.000000000 Julian"},
+		{CalSpec.Julian(), "4713-01-01 BCE", "%y-%m-%d %E", "UTC",
+			"-4712-01-01 00:00:00.000000000 Julian"},
+		{CalSpec.Julian(), "March 15, 44 bc", "%B %-d, %y %E", "UTC",
+			"-0043-03-15 00:00:00.000000000 Julian"},
+		{CalSpec.Gregorian(), "1 BCE Dec 31", "%y %E %b %d", "UTC",
+			"0000-12-31 00:00:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "2021 AD", "%y %E", "UTC",
+			"2021-01-01 00:00:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "2020-W53-7", "%G-W%V-%u", "UTC",
+			"2021-01-03 00:00:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "2021-074", "%Y-%j", "UTC",
+			"2021-03-15 00:00:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "20210315T133005.5", "%Y%m%dT%H%M%S.%f", "UTC",
+			"2021-03-15 13:30:05.500000000 Gregorian"},
+		{CalSpec.Gregorian(), "7/4/2021 9:05:07 pm", "%-m/%-d/%Y %-I:%M:%S %p", "UTC",
+			"2021-07-04 21:05:07.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "12:30 AM 2021-07-04", "%I:%M %p %Y-%m-%d", "UTC",
+			"2021-07-04 00:30:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "Julian 1582-10-04", "%C %Y-%m-%d", "UTC",
+			"1582-10-04 00:00:00.000000000 Julian"},
+		{CalSpec.Gregorian(), "12345-06-01", "%Y-%m-%d", "UTC",
+			"12345-06-01 00:00:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "+12345-06-01", "%Y-%m-%d", "UTC",
+			"12345-06-01 00:00:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "2016-12-31 23:59:60", "%Y-%m-%d %H:%M:%S", "UTC",
+			"2016-12-31 23:59:60.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "Friday 2021-01-01 100%", "%A %Y-%m-%d 100%%", "UTC",
+			"2021-01-01 00:00:00.000000000 Gregorian"},
+		{CalSpec.Gregorian(), "2021-07-04 09:00:00.123 -05:00", "%Y-%m-%d %H:%M:%S.%.3f %z", "UTC",
+			"2021-07-04 09:00:00.123000000 Gregorian"},
+	}
+
+	for i, tc := range testCases {
+
+		calDTime, err := CalendarDateTime{}.NewCalDateTimeFromString(
+			tc.calendarSystem,
+			tc.dateTimeStr,
+			tc.format,
+			tc.timeZone,
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by NewCalDateTimeFromString(%q, %q)\n"+
+				"Error='%v'\n", i, tc.dateTimeStr, tc.format, err.Error())
+			continue
+		}
+
+		actual, err := calDTime.FormatDateTime(outputFmt, ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by calDTime.FormatDateTime()\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if actual != tc.expected {
+			t.Errorf("Test Case #%v Error: NewCalDateTimeFromString(%q, %q)\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				i, tc.dateTimeStr, tc.format, tc.expected, actual)
+		}
+	}
+}
+
+func TestDateTimeTokenParse02(t *testing.T) {
+
+	ePrefix := "TestDateTimeTokenParse02() "
+
+	// Era years retain the Common Era year numbering mode.
+	calDTime, err := CalendarDateTime{}.NewCalDateTimeFromString(
+		CalSpec.Julian(),
+		"4713-01-01 BCE 12:00:00",
+		"%y-%m-%d %E %H:%M:%S",
+		"UTC",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by NewCalDateTimeFromString()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	year, yearType, _, calendarSystem, month, day, err := calDTime.GetDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by calDTime.GetDate()\nError='%v'\n", err.Error())
+		return
+	}
+
+	if year != 4713 ||
+		yearType != CalYearType.BCE() ||
+		calendarSystem != CalSpec.Julian() ||
+		month != 1 ||
+		day != 1 {
+		t.Errorf("Error: Expected date='4713-01-01 BCE Julian'.\n"+
+			"Instead, date='%v-%v-%v %v %v'\n",
+			year, month, day, yearType.XYearSuffix(), calendarSystem.String())
+	}
+
+	// Julian Day Number zero began at noon on January 1, 4713 BCE.
+	actual, err := calDTime.FormatDateTime("%J %y %E", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by calDTime.FormatDateTime()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if actual != "0 4713 BCE" {
+		t.Errorf("Error: Expected='0 4713 BCE'\n  Actual='%v'\n", actual)
+	}
+
+	// Astronomical years retain the Astronomical year numbering mode.
+	aDateTime, err := ADateTimeDto{}.NewFromString(
+		CalSpec.Julian(),
+		"-0043-03-15",
+		"%Y-%m-%d",
+		"",
+		FmtDateTimeYrMDayFmtStr,
+		"Ides of March",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.NewFromString()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	yearValue, yearNumType, err := aDateTime.GetYearWithType(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by aDateTime.GetYearWithType()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if yearValue != -43 || yearNumType != CalYearType.Astronomical() {
+		t.Errorf("Error: Expected year='-43 Astronomical'.\n"+
+			"Instead, year='%v %v'\n", yearValue, yearNumType.String())
+	}
+
+	if aDateTime.GetTag() != "Ides of March" {
+		t.Errorf("Error: Expected tag='Ides of March'. Instead, tag='%v'\n",
+			aDateTime.GetTag())
+	}
+
+	// The time zone is parsed with token '%Z'.
+	aDateTime, err = ADateTimeDto{}.NewFromString(
+		CalSpec.Gregorian(),
+		"2021-07-04 09:00 America/Chicago",
+		"%Y-%m-%d %H:%M %Z",
+		"",
+		"",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.NewFromString(%%Z)\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	actual, err = aDateTime.FormatDateTime("%Y-%m-%d %H:%M %Z", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by aDateTime.FormatDateTime()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if actual != "2021-07-04 09:00 CDT" {
+		t.Errorf("Error: Expected='2021-07-04 09:00 CDT'\n  Actual='%v'\n", actual)
+	}
+}
+
+func TestDateTimeTokenParse03(t *testing.T) {
+
+	ePrefix := "TestDateTimeTokenParse03() "
+
+	testCases := []struct {
+		dateTimeStr string
+		format      string
+	}{
+		{"", "%Y-%m-%d"},
+		{"2021-01-01", ""},
+		{"Thursday 2021-01-01", "%A %Y-%m-%d"},
+		{"2021-13-01", "%Y-%m-%d"},
+		{"2021-02-29", "%Y-%m-%d"},
+		{"2021-01-01x", "%Y-%m-%d"},
+		{"2021/01/01", "%Y-%m-%d"},
+		{"2021-01-01", "%Y-%m-%d %H"},
+		{"2459215", "%J"},
+		{"01-01", "%m-%d"},
+		{"0-01-01 BCE", "%y-%m-%d %E"},
+		{"2021-367", "%Y-%j"},
+		{"13:00 PM 2021-01-01", "%I:%M %p %Y-%m-%d"},
+		{"2021-01-01 Smarch", "%Y-%m-%d %B"},
+		{"2021-01-01", "%Y-%m-%"},
+	}
+
+	for i, tc := range testCases {
+
+		_, err := CalendarDateTime{}.NewCalDateTimeFromString(
+			CalSpec.Gregorian(),
+			tc.dateTimeStr,
+			tc.format,
+			"UTC",
+			"",
+			ePrefix)
+
+		if err == nil {
+			t.Errorf("Test Case #%v Error: Expected an error from "+
+				"NewCalDateTimeFromString(%q, %q). NO ERROR WAS RETURNED!",
+				i, tc.dateTimeStr, tc.format)
+		}
+	}
+}
@@ -0,0 +1,498 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+// testNewDateTzInterval - Creates a DateTzInterval from start and
+// end date time strings formatted as "2006-01-02 15:04:05" in time
+// zone 'timeZoneName'.
+func testNewDateTzInterval(
+	t *testing.T,
+	startStr string,
+	endStr string,
+	timeZoneName string,
+	bounds IntervalBoundType) (DateTzInterval, bool) {
+
+	ePrefix := "testNewDateTzInterval() "
+
+	start, ok := testNewRecurrenceDateTz(t, startStr, timeZoneName)
+
+	if !ok {
+		return DateTzInterval{}, false
+	}
+
+	end, ok := testNewRecurrenceDateTz(t, endStr, timeZoneName)
+
+	if !ok {
+		return DateTzInterval{}, false
+	}
+
+	interval, err := DateTzInterval{}.New(start, end, bounds, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzInterval{}.New()\n"+
+			"start='%v' end='%v'\n"+
+			"Error='%v'\n", startStr, endStr, err.Error())
+		return DateTzInterval{}, false
+	}
+
+	return interval, true
+}
+
+// testCompareIntervals - Compares the String() values of a series
+// of intervals with expected values.
+func testCompareIntervals(
+	t *testing.T,
+	testName string,
+	intervals []DateTzInterval,
+	expected []string) {
+
+	if len(intervals) != len(expected) {
+		t.Errorf("Error: %v\nExpected %v intervals. Instead, %v intervals "+
+			"were returned.\n", testName, len(expected), len(intervals))
+	}
+
+	for i := 0; i < len(intervals) && i < len(expected); i++ {
+
+		actual := intervals[i].String()
+
+		if actual != expected[i] {
+			t.Errorf("Error: %v Interval #%v\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				testName, i, expected[i], actual)
+		}
+	}
+}
+
+func TestDateTzInterval01(t *testing.T) {
+
+	chicago := TZones.America.Chicago()
+
+	// Contains, Overlaps and bounds.
+	halfOpen, ok := testNewDateTzInterval(t,
+		"2021-03-13 09:00:00", "2021-03-13 10:00:00", chicago, IntervalBound.HalfOpen())
+
+	if !ok {
+		return
+	}
+
+	closed, ok := testNewDateTzInterval(t,
+		"2021-03-13 10:00:00", "2021-03-13 11:00:00", chicago, IntervalBound.Closed())
+
+	if !ok {
+		return
+	}
+
+	at10, ok := testNewRecurrenceDateTz(t, "2021-03-13 10:00:00", chicago)
+
+	if !ok {
+		return
+	}
+
+	at11, ok := testNewRecurrenceDateTz(t, "2021-03-13 11:00:00", chicago)
+
+	if !ok {
+		return
+	}
+
+	if halfOpen.Contains(at10) {
+		t.Error("Error: Expected half-open interval to exclude its end date time.")
+	}
+
+	if !closed.Contains(at10) || !closed.Contains(at11) {
+		t.Error("Error: Expected closed interval to include its start and end date times.")
+	}
+
+	if halfOpen.Overlaps(closed) {
+		t.Error("Error: Expected abutting intervals NOT to overlap.")
+	}
+
+	// The same instant expressed in another time zone.
+	london, err := time.LoadLocation(TZones.Europe.London())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\nError='%v'\n", err.Error())
+		return
+	}
+
+	at10London, err := DateTzDto{}.NewDateTime(
+		at10.GetDateTimeValue().In(london),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !closed.Contains(at10London) {
+		t.Error("Error: Expected closed interval to contain the London instant.")
+	}
+
+	// Union of abutting intervals.
+	union, err := halfOpen.Union(closed, "TestDateTzInterval01() ")
+
+	if err != nil {
+		t.Errorf("Error returned by halfOpen.Union()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Union", []DateTzInterval{union}, []string{
+		"[2021-03-13 09:00:00 -0600 CST, 2021-03-13 11:00:00 -0600 CST]",
+	})
+
+	// Intersect.
+	later, ok := testNewDateTzInterval(t,
+		"2021-03-13 09:30:00", "2021-03-13 10:30:00", chicago, IntervalBound.Closed())
+
+	if !ok {
+		return
+	}
+
+	intersection, found, err := halfOpen.Intersect(later, "TestDateTzInterval01() ")
+
+	if err != nil {
+		t.Errorf("Error returned by halfOpen.Intersect()\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !found {
+		t.Error("Error: Expected an intersection. None was found.")
+	} else {
+		testCompareIntervals(t, "Intersect", []DateTzInterval{intersection}, []string{
+			"[2021-03-13 09:30:00 -0600 CST, 2021-03-13 10:00:00 -0600 CST)",
+		})
+	}
+
+	_, found, err = halfOpen.Intersect(closed, "TestDateTzInterval01() ")
+
+	if err != nil {
+		t.Errorf("Error returned by halfOpen.Intersect(closed)\nError='%v'\n", err.Error())
+		return
+	}
+
+	if found {
+		t.Error("Error: Expected NO intersection for abutting intervals.")
+	}
+
+	// Two closed intervals sharing a single instant intersect.
+	closedEarlier, ok := testNewDateTzInterval(t,
+		"2021-03-13 09:00:00", "2021-03-13 10:00:00", chicago, IntervalBound.Closed())
+
+	if !ok {
+		return
+	}
+
+	intersection, found, err = closedEarlier.Intersect(closed, "TestDateTzInterval01() ")
+
+	if err != nil || !found {
+		t.Errorf("Error: Expected a single instant intersection. found='%v' err='%v'\n",
+			found, err)
+	} else if intersection.GetDuration() != 0 || intersection.IsEmpty() {
+		t.Errorf("Error: Expected a non-empty, zero duration intersection. "+
+			"Interval='%v'\n", intersection.String())
+	}
+
+	// Gap.
+	evening, ok := testNewDateTzInterval(t,
+		"2021-03-13 18:00:00", "2021-03-13 19:00:00", chicago, IntervalBound.HalfOpen())
+
+	if !ok {
+		return
+	}
+
+	gap, hasGap, err := evening.Gap(halfOpen, "TestDateTzInterval01() ")
+
+	if err != nil {
+		t.Errorf("Error returned by evening.Gap()\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !hasGap {
+		t.Error("Error: Expected a gap. None was found.")
+	} else {
+		testCompareIntervals(t, "Gap", []DateTzInterval{gap}, []string{
+			"[2021-03-13 10:00:00 -0600 CST, 2021-03-13 18:00:00 -0600 CST)",
+		})
+	}
+
+	_, hasGap, err = halfOpen.Gap(closed, "TestDateTzInterval01() ")
+
+	if err != nil || hasGap {
+		t.Errorf("Error: Expected NO gap for abutting intervals. hasGap='%v' err='%v'\n",
+			hasGap, err)
+	}
+
+	_, err = halfOpen.Union(evening, "TestDateTzInterval01() ")
+
+	if err == nil {
+		t.Error("Error: Expected an error from Union() of disjoint intervals. " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	// End before start.
+	_, err = DateTzInterval{}.New(at11, at10, IntervalBound.Closed(), "TestDateTzInterval01() ")
+
+	if err == nil {
+		t.Error("Error: Expected an error from New() with end before start. " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	_, err = DateTzInterval{}.New(at10, at11, IntervalBound.None(), "TestDateTzInterval01() ")
+
+	if err == nil {
+		t.Error("Error: Expected an error from New() with invalid bounds. " +
+			"NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestDateTzInterval02(t *testing.T) {
+
+	ePrefix := "TestDateTzInterval02() "
+
+	chicago := TZones.America.Chicago()
+
+	// Spring forward: 2021-03-14 is 23 hours long.
+	interval, ok := testNewDateTzInterval(t,
+		"2021-03-13 12:00:00", "2021-03-15 12:00:00", chicago, IntervalBound.HalfOpen())
+
+	if !ok {
+		return
+	}
+
+	days, err := interval.Split(CalUnit.Day(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by interval.Split(Day)\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Split Day Spring", days, []string{
+		"[2021-03-13 12:00:00 -0600 CST, 2021-03-14 00:00:00 -0600 CST)",
+		"[2021-03-14 00:00:00 -0600 CST, 2021-03-15 00:00:00 -0500 CDT)",
+		"[2021-03-15 00:00:00 -0500 CDT, 2021-03-15 12:00:00 -0500 CDT)",
+	})
+
+	expectedDurations := []time.Duration{12 * time.Hour, 23 * time.Hour, 12 * time.Hour}
+
+	for i := 0; i < len(days) && i < len(expectedDurations); i++ {
+		if days[i].GetDuration() != expectedDurations[i] {
+			t.Errorf("Error: Split Day Spring #%v Expected duration='%v'. "+
+				"Instead, duration='%v'\n", i, expectedDurations[i], days[i].GetDuration())
+		}
+	}
+
+	// Fall back: 2021-11-07 is 25 hours long. The closed bound of the
+	// interval is retained by the last piece.
+	interval, ok = testNewDateTzInterval(t,
+		"2021-11-07 00:00:00", "2021-11-08 00:00:00", chicago, IntervalBound.Closed())
+
+	if !ok {
+		return
+	}
+
+	days, err = interval.Split(CalUnit.Day(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by interval.Split(Day)\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Split Day Fall", days, []string{
+		"[2021-11-07 00:00:00 -0500 CDT, 2021-11-08 00:00:00 -0600 CST]",
+	})
+
+	if len(days) == 1 && days[0].GetDuration() != 25*time.Hour {
+		t.Errorf("Error: Expected 25 hour day. Instead, duration='%v'\n",
+			days[0].GetDuration())
+	}
+
+	// Weeks begin on Monday.
+	interval, ok = testNewDateTzInterval(t,
+		"2021-03-10 08:00:00", "2021-03-24 08:00:00", chicago, IntervalBound.HalfOpen())
+
+	if !ok {
+		return
+	}
+
+	weeks, err := interval.Split(CalUnit.Week(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by interval.Split(Week)\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Split Week", weeks, []string{
+		"[2021-03-10 08:00:00 -0600 CST, 2021-03-15 00:00:00 -0500 CDT)",
+		"[2021-03-15 00:00:00 -0500 CDT, 2021-03-22 00:00:00 -0500 CDT)",
+		"[2021-03-22 00:00:00 -0500 CDT, 2021-03-24 08:00:00 -0500 CDT)",
+	})
+
+	// Months.
+	interval, ok = testNewDateTzInterval(t,
+		"2021-01-15 00:00:00", "2021-03-01 00:00:00", chicago, IntervalBound.HalfOpen())
+
+	if !ok {
+		return
+	}
+
+	months, err := interval.Split(CalUnit.Month(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by interval.Split(Month)\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Split Month", months, []string{
+		"[2021-01-15 00:00:00 -0600 CST, 2021-02-01 00:00:00 -0600 CST)",
+		"[2021-02-01 00:00:00 -0600 CST, 2021-03-01 00:00:00 -0600 CST)",
+	})
+
+	// Local midnight does not exist in America/Sao_Paulo on
+	// 2018-11-04. The day begins at 01:00.
+	interval, ok = testNewDateTzInterval(t,
+		"2018-11-03 12:00:00", "2018-11-04 12:00:00", TZones.America.Sao_Paulo(),
+		IntervalBound.HalfOpen())
+
+	if !ok {
+		return
+	}
+
+	days, err = interval.Split(CalUnit.Day(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by interval.Split(Day)\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Split Day Sao Paulo", days, []string{
+		"[2018-11-03 12:00:00 -0300 -03, 2018-11-04 01:00:00 -0200 -02)",
+		"[2018-11-04 01:00:00 -0200 -02, 2018-11-04 12:00:00 -0200 -02)",
+	})
+
+	_, err = interval.Split(CalUnit.None(), ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from Split(None). NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestIntervalSet01(t *testing.T) {
+
+	ePrefix := "TestIntervalSet01() "
+
+	chicago := TZones.America.Chicago()
+
+	inputs := [][2]string{
+		{"2021-03-13 13:00:00", "2021-03-13 14:00:00"},
+		{"2021-03-13 09:00:00", "2021-03-13 10:00:00"},
+		{"2021-03-13 09:30:00", "2021-03-13 11:00:00"},
+		{"2021-03-13 11:00:00", "2021-03-13 12:00:00"},
+		{"2021-03-13 16:00:00", "2021-03-13 16:00:00"},
+	}
+
+	intervals := make([]DateTzInterval, 0, len(inputs))
+
+	for _, input := range inputs {
+
+		interval, ok := testNewDateTzInterval(t, input[0], input[1], chicago,
+			IntervalBound.HalfOpen())
+
+		if !ok {
+			return
+		}
+
+		intervals = append(intervals, interval)
+	}
+
+	intervalSet, err := IntervalSet{}.New(intervals, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by IntervalSet{}.New()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Normalize", intervalSet.GetIntervals(), []string{
+		"[2021-03-13 09:00:00 -0600 CST, 2021-03-13 12:00:00 -0600 CST)",
+		"[2021-03-13 13:00:00 -0600 CST, 2021-03-13 14:00:00 -0600 CST)",
+	})
+
+	if intervalSet.GetTotalDuration() != 4*time.Hour {
+		t.Errorf("Error: Expected total duration='4h0m0s'. Instead, total duration='%v'\n",
+			intervalSet.GetTotalDuration())
+	}
+
+	gaps, err := intervalSet.GetGaps(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by intervalSet.GetGaps()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Gaps", gaps, []string{
+		"[2021-03-13 12:00:00 -0600 CST, 2021-03-13 13:00:00 -0600 CST)",
+	})
+
+	at1230, ok := testNewRecurrenceDateTz(t, "2021-03-13 12:30:00", chicago)
+
+	if !ok {
+		return
+	}
+
+	if intervalSet.Contains(at1230) {
+		t.Error("Error: Expected the interval set NOT to contain 12:30.")
+	}
+
+	// Adding the gap merges the set into a single interval.
+	err = intervalSet.Add(gaps[0], ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by intervalSet.Add()\nError='%v'\n", err.Error())
+		return
+	}
+
+	if intervalSet.GetCount() != 1 || !intervalSet.Contains(at1230) {
+		t.Errorf("Error: Expected a single merged interval containing 12:30. "+
+			"Count='%v'\n", intervalSet.GetCount())
+	}
+
+	// Intersect and Union.
+	other, ok := testNewDateTzInterval(t,
+		"2021-03-13 13:30:00", "2021-03-13 15:00:00", chicago, IntervalBound.Closed())
+
+	if !ok {
+		return
+	}
+
+	otherSet := IntervalSet{}
+
+	err = otherSet.Add(other, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by otherSet.Add()\nError='%v'\n", err.Error())
+		return
+	}
+
+	intersection, err := intervalSet.Intersect(otherSet, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by intervalSet.Intersect()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Set Intersect", intersection.GetIntervals(), []string{
+		"[2021-03-13 13:30:00 -0600 CST, 2021-03-13 14:00:00 -0600 CST)",
+	})
+
+	union, err := intervalSet.Union(otherSet, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by intervalSet.Union()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareIntervals(t, "Set Union", union.GetIntervals(), []string{
+		"[2021-03-13 09:00:00 -0600 CST, 2021-03-13 15:00:00 -0600 CST]",
+	})
+}
@@ -0,0 +1,302 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+var testUsFederalHolidaysJSON = []byte(`{
+  "name": "US Federal Holidays",
+  "rules": [
+    {"name": "New Year's Day", "type": "FixedDate", "month": 1, "day": 1, "observed": "NearestWeekday"},
+    {"name": "Martin Luther King Jr. Day", "type": "NthWeekdayOfMonth", "month": 1, "weekDay": "Monday", "nth": 3},
+    {"name": "Washington's Birthday", "type": "NthWeekdayOfMonth", "month": 2, "weekDay": "Monday", "nth": 3},
+    {"name": "Memorial Day", "type": "LastWeekdayOfMonth", "month": 5, "weekDay": "Monday"},
+    {"name": "Juneteenth", "type": "FixedDate", "month": 6, "day": 19, "observed": "NearestWeekday"},
+    {"name": "Independence Day", "type": "FixedDate", "month": 7, "day": 4, "observed": "nearestweekday"},
+    {"name": "Labor Day", "type": "NthWeekdayOfMonth", "month": 9, "weekDay": "Monday", "nth": 1},
+    {"name": "Columbus Day", "type": "NthWeekdayOfMonth", "month": 10, "weekDay": "Monday", "nth": 2},
+    {"name": "Veterans Day", "type": "FixedDate", "month": 11, "day": 11, "observed": "NearestWeekday"},
+    {"name": "Thanksgiving Day", "type": "nthweekdayofmonth", "month": 11, "weekDay": "Thursday", "nth": 4},
+    {"name": "Christmas Day", "type": "FixedDate", "month": 12, "day": 25, "observed": "NearestWeekday"}
+  ]
+}`)
+
+func TestHolidayCalendarHolidaysInYear01(t *testing.T) {
+
+	ePrefix := "TestHolidayCalendarHolidaysInYear01() "
+
+	holCal, err := HolidayCalendar{}.NewFromJSON(
+		testUsFederalHolidaysJSON,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayCalendar{}.NewFromJSON()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if holCal.GetName() != "US Federal Holidays" {
+		t.Errorf("Error: Expected calendar name 'US Federal Holidays'.\n"+
+			"Instead, name='%v'\n", holCal.GetName())
+	}
+
+	expected := []string{
+		"2021-01-01 New Year's Day",
+		"2021-01-18 Martin Luther King Jr. Day",
+		"2021-02-15 Washington's Birthday",
+		"2021-05-31 Memorial Day",
+		"2021-06-19 Juneteenth (Observed 2021-06-18)",
+		"2021-07-04 Independence Day (Observed 2021-07-05)",
+		"2021-09-06 Labor Day",
+		"2021-10-11 Columbus Day",
+		"2021-11-11 Veterans Day",
+		"2021-11-25 Thanksgiving Day",
+		"2021-12-25 Christmas Day (Observed 2021-12-24)",
+	}
+
+	holidays, err := holCal.HolidaysInYear(2021, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by holCal.HolidaysInYear(2021)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(holidays) != len(expected) {
+		t.Errorf("Error: Expected %v holidays.\n"+
+			"Instead, %v holidays were returned.\n",
+			len(expected), len(holidays))
+		return
+	}
+
+	for i := 0; i < len(holidays); i++ {
+
+		if holidays[i].String() != expected[i] {
+			t.Errorf("Error: Holiday is INVALID!\n"+
+				"Expected='%v'\n"+
+				"  Actual='%v'\n",
+				expected[i],
+				holidays[i].String())
+		}
+	}
+}
+
+func TestHolidayCalendarHolidaysInYear02(t *testing.T) {
+
+	ePrefix := "TestHolidayCalendarHolidaysInYear02() "
+
+	holCal := HolidayCalendar{}.New("Test Holidays")
+
+	rule, err := HolidayRule{}.NewNthWeekdayOfMonth(
+		"Fifth Monday Of February",
+		2,
+		ISO8601DayOfWeekNo(0).Monday(),
+		5,
+		HolidayObservedType(0).Actual(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayRule{}.NewNthWeekdayOfMonth()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = holCal.AddRule(rule, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by holCal.AddRule()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	rule, err = HolidayRule{}.NewFixedDate(
+		"Leap Day",
+		2,
+		29,
+		HolidayObservedType(0).FollowingMonday(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayRule{}.NewFixedDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = holCal.AddRule(rule, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by holCal.AddRule()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	rule, err = HolidayRule{}.NewEasterOffset(
+		"Good Friday",
+		-2,
+		HolidayObservedType(0).Actual(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayRule{}.NewEasterOffset()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = holCal.AddRule(rule, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by holCal.AddRule()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testYears := []struct {
+		year     int64
+		expected []string
+	}{
+		// February 2021 has only four Mondays and no leap day
+		{2021, []string{"2021-04-02 Good Friday"}},
+		// February 29th, 2020 fell on a Saturday. February
+		// 2020 had five Saturdays but only four Mondays.
+		{2020, []string{
+			"2020-02-29 Leap Day (Observed 2020-03-02)",
+			"2020-04-10 Good Friday"}},
+		// February 2016 had five Mondays
+		{2016, []string{
+			"2016-02-29 Fifth Monday Of February",
+			"2016-02-29 Leap Day",
+			"2016-03-25 Good Friday"}},
+	}
+
+	for i := 0; i < len(testYears); i++ {
+
+		holidays, err := holCal.HolidaysInYear(testYears[i].year, ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by holCal.HolidaysInYear(%v)\n"+
+				"Error='%v'\n", testYears[i].year, err.Error())
+			return
+		}
+
+		if len(holidays) != len(testYears[i].expected) {
+			t.Errorf("Error: Year %v - Expected %v holidays.\n"+
+				"Instead, %v holidays were returned.\n",
+				testYears[i].year,
+				len(testYears[i].expected),
+				len(holidays))
+			continue
+		}
+
+		for j := 0; j < len(holidays); j++ {
+
+			if holidays[j].String() != testYears[i].expected[j] {
+				t.Errorf("Error: Holiday is INVALID!\n"+
+					"Expected='%v'\n"+
+					"  Actual='%v'\n",
+					testYears[i].expected[j],
+					holidays[j].String())
+			}
+		}
+	}
+}
+
+func TestHolidayCalendarIsHoliday01(t *testing.T) {
+
+	ePrefix := "TestHolidayCalendarIsHoliday01() "
+
+	holCal, err := HolidayCalendar{}.NewFromJSON(
+		testUsFederalHolidaysJSON,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by HolidayCalendar{}.NewFromJSON()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	chicago, err := time.LoadLocation(TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testDates := []struct {
+		dateTime  time.Time
+		isHoliday bool
+	}{
+		{time.Date(2021, 11, 25, 10, 0, 0, 0, chicago), true},
+		{time.Date(2021, 11, 26, 10, 0, 0, 0, chicago), false},
+		// Independence Day 2021 fell on a Sunday
+		{time.Date(2021, 7, 4, 10, 0, 0, 0, chicago), true},
+		{time.Date(2021, 7, 5, 10, 0, 0, 0, chicago), true},
+		{time.Date(2021, 7, 2, 10, 0, 0, 0, chicago), false},
+		// New Year's Day 2022 was observed on December 31, 2021
+		{time.Date(2021, 12, 31, 23, 30, 0, 0, chicago), true},
+		{time.Date(2021, 12, 30, 23, 30, 0, 0, chicago), false},
+	}
+
+	for i := 0; i < len(testDates); i++ {
+
+		dTz, err := DateTzDto{}.NewDateTime(
+			testDates[i].dateTime,
+			FmtDateTimeYrMDayFmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		isHoliday, err := holCal.IsHoliday(dTz, ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by holCal.IsHoliday()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if isHoliday != testDates[i].isHoliday {
+			t.Errorf("Error: Expected isHoliday='%v' for date '%v'.\n"+
+				"Instead, isHoliday='%v'\n",
+				testDates[i].isHoliday,
+				dTz.GetDateTimeValue().Format(FmtDateTimeYrMDayFmtStr),
+				isHoliday)
+		}
+	}
+}
+
+func TestHolidayCalendarNewFromJSON01(t *testing.T) {
+
+	ePrefix := "TestHolidayCalendarNewFromJSON01() "
+
+	badRuleSets := []string{
+		``,
+		`{"name": "Bad", "rules": [`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "FixedDate", "month": 1, "day": 1, "color": "red"}]}`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "Unknown", "month": 1, "day": 1}]}`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "FixedDate", "month": 2, "day": 30}]}`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "FixedDate", "month": 13, "day": 1}]}`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "NthWeekdayOfMonth", "month": 1, "weekDay": "Monday", "nth": 6}]}`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "LastWeekdayOfMonth", "month": 1, "weekDay": "Funday"}]}`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "LastWeekdayOfMonth", "month": 1}]}`,
+		`{"name": "Bad", "rules": [{"name": "X", "type": "FixedDate", "month": 1, "day": 1, "observed": "Never"}]}`,
+		`{"name": "Bad", "rules": [{"name": "", "type": "FixedDate", "month": 1, "day": 1}]}`,
+	}
+
+	for i := 0; i < len(badRuleSets); i++ {
+
+		_, err := HolidayCalendar{}.NewFromJSON(
+			[]byte(badRuleSets[i]),
+			ePrefix)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error return from NewFromJSON()\n"+
+				"because the rule set is INVALID.\n"+
+				"However, NO ERROR WAS RETURNED!\n"+
+				"Rule Set='%v'\n", badRuleSets[i])
+		}
+	}
+}
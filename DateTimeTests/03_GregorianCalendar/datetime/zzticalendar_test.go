@@ -0,0 +1,898 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testICalendarText = "BEGIN:VCALENDAR\r\n" +
+	"VERSION:2.0\r\n" +
+	"PRODID:-//Example Corp//Scheduler 1.0//EN\r\n" +
+	"CALSCALE:GREGORIAN\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Eastern Standard Time\r\n" +
+	"X-LIC-LOCATION:America/New_York\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16011104T020000\r\n" +
+	"RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11\r\n" +
+	"TZOFFSETFROM:-0400\r\n" +
+	"TZOFFSETTO:-0500\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:zoned-001@example.com\r\n" +
+	"DTSTAMP:20210101T120000Z\r\n" +
+	"DTSTART;TZID=Eastern Standard Time:20210308T090000\r\n" +
+	"DTEND;TZID=Eastern Standard Time:20210308T100000\r\n" +
+	"SUMMARY:Team meeting\\, weekly\r\n" +
+	"DESCRIPTION:Line one\\nLine two\\; with a very long description which m\r\n" +
+	" ust be folded across multiple lines\r\n" +
+	"RRULE:FREQ=WEEKLY;COUNT=3\r\n" +
+	"EXDATE;TZID=Eastern Standard Time:20210315T090000\r\n" +
+	"DURATION:PT1H\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:floating-002@example.com\r\n" +
+	"DTSTART:20210704T080000\r\n" +
+	"SUMMARY:Breakfast\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:utc-003@example.com\r\n" +
+	"DTSTART:20210704T170000Z\r\n" +
+	"RDATE;TZID=/mozilla.org/20050126_1/Europe/London:20210705T090000\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"UID:date-004@example.com\r\n" +
+	"DTSTART;VALUE=DATE:20211225\r\n" +
+	"DTEND;VALUE=DATE:20211226\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VTODO\r\n" +
+	"UID:todo-005@example.com\r\n" +
+	"SUMMARY:Kept as is\r\n" +
+	"END:VTODO\r\n" +
+	"END:VCALENDAR\r\n"
+
+// testICalendarOutlookText - An iCalendar file in the form exported by
+// Microsoft Outlook. 'TZID' values are Windows time zone names and
+// the 'VTIMEZONE' components carry no 'X-LIC-LOCATION' property.
+const testICalendarOutlookText = "BEGIN:VCALENDAR\r\n" +
+	"PRODID:-//Microsoft Corporation//Outlook 16.0 MIMEDIR//EN\r\n" +
+	"VERSION:2.0\r\n" +
+	"METHOD:PUBLISH\r\n" +
+	"X-MS-OLK-FORCEINSPECTOROPEN:TRUE\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:Eastern Standard Time\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16011104T020000\r\n" +
+	"RRULE:FREQ=YEARLY;BYDAY=1SU;BYMONTH=11\r\n" +
+	"TZOFFSETFROM:-0400\r\n" +
+	"TZOFFSETTO:-0500\r\n" +
+	"END:STANDARD\r\n" +
+	"BEGIN:DAYLIGHT\r\n" +
+	"DTSTART:16010311T020000\r\n" +
+	"RRULE:FREQ=YEARLY;BYDAY=2SU;BYMONTH=3\r\n" +
+	"TZOFFSETFROM:-0500\r\n" +
+	"TZOFFSETTO:-0400\r\n" +
+	"END:DAYLIGHT\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VTIMEZONE\r\n" +
+	"TZID:India Standard Time\r\n" +
+	"BEGIN:STANDARD\r\n" +
+	"DTSTART:16010101T000000\r\n" +
+	"TZOFFSETFROM:+0530\r\n" +
+	"TZOFFSETTO:+0530\r\n" +
+	"END:STANDARD\r\n" +
+	"END:VTIMEZONE\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"CLASS:PUBLIC\r\n" +
+	"CREATED:20240102T150000Z\r\n" +
+	"DTEND;TZID=Eastern Standard Time:20240115T100000\r\n" +
+	"DTSTAMP:20240102T150000Z\r\n" +
+	"DTSTART;TZID=Eastern Standard Time:20240115T090000\r\n" +
+	"LAST-MODIFIED:20240102T150000Z\r\n" +
+	"LOCATION:Conference Room A\r\n" +
+	"PRIORITY:5\r\n" +
+	"RRULE:FREQ=MONTHLY;COUNT=6;BYDAY=3MO\r\n" +
+	"SEQUENCE:0\r\n" +
+	"SUMMARY;LANGUAGE=en-us:Planning review\r\n" +
+	"TRANSP:OPAQUE\r\n" +
+	"UID:040000008200E00074C5B7101A82E0080000000010A1B2C3D4E5F601000000000000000\r\n" +
+	" 0100000004A1C2D3E4F5061728394A5B6C7D8E9F0\r\n" +
+	"X-MICROSOFT-CDO-BUSYSTATUS:BUSY\r\n" +
+	"X-MICROSOFT-CDO-IMPORTANCE:1\r\n" +
+	"BEGIN:VALARM\r\n" +
+	"TRIGGER:-PT15M\r\n" +
+	"ACTION:DISPLAY\r\n" +
+	"DESCRIPTION:Reminder\r\n" +
+	"END:VALARM\r\n" +
+	"END:VEVENT\r\n" +
+	"BEGIN:VEVENT\r\n" +
+	"DTEND;TZID=India Standard Time:20240704T103000\r\n" +
+	"DTSTAMP:20240102T150000Z\r\n" +
+	"DTSTART;TZID=India Standard Time:20240704T093000\r\n" +
+	"SUMMARY;LANGUAGE=en-us:Offshore sync\r\n" +
+	"UID:040000008200E00074C5B7101A82E0080000000020A1B2C3D4E5F60100000000000000\r\n" +
+	"END:VEVENT\r\n" +
+	"END:VCALENDAR\r\n"
+
+// testICalendarDateTime - Compares the form, TZID and date time of
+// an ICalendarDateTime with expected values. The date time is
+// formatted with testRecurrenceFmtStr.
+func testICalendarDateTime(
+	t *testing.T,
+	title string,
+	iCalDt ICalendarDateTime,
+	expectedForm ICalendarTimeForm,
+	expectedTzId string,
+	expectedDateTime string) {
+
+	if iCalDt.GetForm() != expectedForm {
+		t.Errorf("%v: Expected form='%v'. Instead, form='%v'\n",
+			title, expectedForm.String(), iCalDt.GetForm().String())
+	}
+
+	if iCalDt.GetTzId() != expectedTzId {
+		t.Errorf("%v: Expected TZID='%v'. Instead, TZID='%v'\n",
+			title, expectedTzId, iCalDt.GetTzId())
+	}
+
+	dTz := iCalDt.GetDateTime()
+
+	actualDateTime := dTz.GetDateTimeValue().Format(testRecurrenceFmtStr)
+
+	if actualDateTime != expectedDateTime {
+		t.Errorf("%v: Expected date time='%v'. Instead, date time='%v'\n",
+			title, expectedDateTime, actualDateTime)
+	}
+}
+
+func TestICalendar01(t *testing.T) {
+
+	ePrefix := "TestICalendar01() "
+
+	iCal, err := ICalendar{}.NewFromString(
+		testICalendarText,
+		TZones.America.Chicago(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendar{}.NewFromString()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if iCal.GetProdId() != "-//Example Corp//Scheduler 1.0//EN" {
+		t.Errorf("Error: Expected PRODID='-//Example Corp//Scheduler 1.0//EN'.\n"+
+			"Instead, PRODID='%v'\n", iCal.GetProdId())
+	}
+
+	otherProps := iCal.GetOtherProperties()
+
+	if len(otherProps) != 1 || otherProps[0] != "CALSCALE:GREGORIAN" {
+		t.Errorf("Error: Expected other properties='[CALSCALE:GREGORIAN]'.\n"+
+			"Instead, other properties='%v'\n", otherProps)
+	}
+
+	events := iCal.GetEvents()
+
+	if len(events) != 4 {
+		t.Errorf("Error: Expected 4 events. Instead, events='%v'\n", len(events))
+		return
+	}
+
+	// Zoned event. 'TZID' resolved through 'X-LIC-LOCATION'.
+	zoned := events[0]
+
+	if zoned.GetUid() != "zoned-001@example.com" {
+		t.Errorf("Error: Expected UID='zoned-001@example.com'. Instead, UID='%v'\n",
+			zoned.GetUid())
+	}
+
+	if zoned.GetSummary() != "Team meeting, weekly" {
+		t.Errorf("Error: Expected SUMMARY='Team meeting, weekly'. Instead, SUMMARY='%v'\n",
+			zoned.GetSummary())
+	}
+
+	expectedDesc := "Line one\nLine two; with a very long description which " +
+		"must be folded across multiple lines"
+
+	if zoned.GetDescription() != expectedDesc {
+		t.Errorf("Error: Expected DESCRIPTION='%v'.\nInstead, DESCRIPTION='%v'\n",
+			expectedDesc, zoned.GetDescription())
+	}
+
+	dtStamp, hasDtStamp := zoned.GetDtStamp()
+
+	if !hasDtStamp {
+		t.Error("Error: Expected DTSTAMP. None was found.")
+	} else {
+		testICalendarDateTime(t, "DTSTAMP", dtStamp, ICalTimeForm.Utc(), "",
+			"2021-01-01 12:00:00 +0000 UTC")
+	}
+
+	testICalendarDateTime(t, "Zoned DTSTART", zoned.GetDtStart(),
+		ICalTimeForm.Zoned(), "Eastern Standard Time",
+		"2021-03-08 09:00:00 -0500 EST")
+
+	dtEnd, hasDtEnd := zoned.GetDtEnd()
+
+	if !hasDtEnd {
+		t.Error("Error: Expected DTEND. None was found.")
+	} else {
+		testICalendarDateTime(t, "Zoned DTEND", dtEnd,
+			ICalTimeForm.Zoned(), "Eastern Standard Time",
+			"2021-03-08 10:00:00 -0500 EST")
+	}
+
+	dtStart := zoned.GetDtStart()
+
+	tzSpec := dtStart.GetTimeZoneSpec()
+
+	if tzSpec.GetLocationName() != TZones.America.New_York() {
+		t.Errorf("Error: Expected time zone location='%v'. Instead, location='%v'\n",
+			TZones.America.New_York(), tzSpec.GetLocationName())
+	}
+
+	otherLines := zoned.GetOtherProperties()
+
+	expectedLines := []string{
+		"DURATION:PT1H",
+		"BEGIN:VALARM",
+		"ACTION:DISPLAY",
+		"TRIGGER:-PT15M",
+		"END:VALARM",
+	}
+
+	if strings.Join(otherLines, "|") != strings.Join(expectedLines, "|") {
+		t.Errorf("Error: Expected other properties='%v'.\nInstead, other properties='%v'\n",
+			expectedLines, otherLines)
+	}
+
+	// The recurrence set spans the DST transition of 2021-03-14.
+	recurSet, err := zoned.GetRecurrenceSet(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by zoned.GetRecurrenceSet()\n"+
+			"Error='%v'\n", err.Error())
+	} else {
+
+		occurrences, err := recurSet.GetOccurrences(0, ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+				"Error='%v'\n", err.Error())
+		} else {
+			testCompareOccurrences(t, "Zoned RRULE", occurrences, []string{
+				"2021-03-08 09:00:00.000000000 -0500 EST",
+				"2021-03-22 09:00:00.000000000 -0400 EDT",
+			})
+		}
+	}
+
+	// Floating event. Placed in the floating time zone.
+	testICalendarDateTime(t, "Floating DTSTART", events[1].GetDtStart(),
+		ICalTimeForm.Floating(), "",
+		"2021-07-04 08:00:00 -0500 CDT")
+
+	// UTC event with a zoned RDATE. 'TZID' resolved through its
+	// trailing path components.
+	testICalendarDateTime(t, "UTC DTSTART", events[2].GetDtStart(),
+		ICalTimeForm.Utc(), "",
+		"2021-07-04 17:00:00 +0000 UTC")
+
+	rDates := events[2].GetRDates()
+
+	if len(rDates) != 1 {
+		t.Errorf("Error: Expected 1 RDATE. Instead, RDATEs='%v'\n", len(rDates))
+	} else {
+		testICalendarDateTime(t, "Zoned RDATE", rDates[0],
+			ICalTimeForm.Zoned(), "/mozilla.org/20050126_1/Europe/London",
+			"2021-07-05 09:00:00 +0100 BST")
+	}
+
+	// Date event.
+	testICalendarDateTime(t, "Date DTSTART", events[3].GetDtStart(),
+		ICalTimeForm.Date(), "",
+		"2021-12-25 00:00:00 -0600 CST")
+}
+
+func TestICalendar02(t *testing.T) {
+
+	ePrefix := "TestICalendar02() "
+
+	iCal, err := ICalendar{}.NewFromString(
+		testICalendarText,
+		TZones.America.Chicago(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendar{}.NewFromString()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	iCalText, err := iCal.GetICalendarText(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.GetICalendarText()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !strings.HasSuffix(iCalText, "END:VCALENDAR\r\n") {
+		t.Error("Error: Expected the iCalendar text to end with 'END:VCALENDAR' and CRLF.")
+	}
+
+	rawLines := strings.Split(strings.TrimSuffix(iCalText, "\r\n"), "\r\n")
+
+	for _, rawLine := range rawLines {
+
+		if len(rawLine) > 75 {
+			t.Errorf("Error: Line exceeds 75 octets. Line='%v'\n", rawLine)
+		}
+
+		if strings.Contains(rawLine, "\n") {
+			t.Errorf("Error: Line contains a bare LF. Line='%v'\n", rawLine)
+		}
+	}
+
+	expectedLines := []string{
+		"DTSTART;TZID=Eastern Standard Time:20210308T090000",
+		"EXDATE;TZID=Eastern Standard Time:20210315T090000",
+		"DTSTAMP:20210101T120000Z",
+		"DTSTART:20210704T080000",
+		"DTSTART:20210704T170000Z",
+		"RDATE;TZID=/mozilla.org/20050126_1/Europe/London:20210705T090000",
+		"DTSTART;VALUE=DATE:20211225",
+		"SUMMARY:Team meeting\\, weekly",
+		"RRULE:FREQ=WEEKLY;COUNT=3",
+		"TRIGGER:-PT15M",
+		"TZID:Eastern Standard Time",
+		"TZID:/mozilla.org/20050126_1/Europe/London",
+		"CALSCALE:GREGORIAN",
+		"UID:todo-005@example.com",
+	}
+
+	for _, expectedLine := range expectedLines {
+
+		found := false
+
+		for _, rawLine := range rawLines {
+			if rawLine == expectedLine {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			t.Errorf("Error: Expected line '%v' was NOT found.\n", expectedLine)
+		}
+	}
+
+	// Round trip.
+	iCal2, err := ICalendar{}.NewFromString(
+		iCalText,
+		TZones.America.Chicago(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by second ICalendar{}.NewFromString()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	iCalText2, err := iCal2.GetICalendarText(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by second iCal.GetICalendarText()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if iCalText != iCalText2 {
+		t.Errorf("Error: Round trip text does NOT match.\n"+
+			"First='%v'\nSecond='%v'\n", iCalText, iCalText2)
+	}
+
+	events := iCal2.GetEvents()
+
+	if len(events) != 4 {
+		t.Errorf("Error: Expected 4 events. Instead, events='%v'\n", len(events))
+		return
+	}
+
+	testICalendarDateTime(t, "Round Trip Floating", events[1].GetDtStart(),
+		ICalTimeForm.Floating(), "",
+		"2021-07-04 08:00:00 -0500 CDT")
+
+	if events[0].GetDescription() != "Line one\nLine two; with a very long description which "+
+		"must be folded across multiple lines" {
+		t.Errorf("Error: Round trip DESCRIPTION does NOT match. DESCRIPTION='%v'\n",
+			events[0].GetDescription())
+	}
+}
+
+func TestICalendar03(t *testing.T) {
+
+	ePrefix := "TestICalendar03() "
+
+	iCal, err := ICalendar{}.New("-//Example Corp//Scheduler 1.0//EN", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendar{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dtStartTz, ok := testNewRecurrenceDateTz(t, "2021-01-04 09:00:00", TZones.America.New_York())
+
+	if !ok {
+		return
+	}
+
+	dtEndTz, ok := testNewRecurrenceDateTz(t, "2021-01-04 10:00:00", TZones.America.New_York())
+
+	if !ok {
+		return
+	}
+
+	dtStampTz, ok := testNewRecurrenceDateTz(t, "2021-01-01 12:00:00", TZones.UTC())
+
+	if !ok {
+		return
+	}
+
+	dtStart, err := ICalendarDateTime{}.New(dtStartTz, ICalTimeForm.Zoned(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendarDateTime{}.New(dtStart)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dtEnd, err := ICalendarDateTime{}.New(dtEndTz, ICalTimeForm.Zoned(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendarDateTime{}.New(dtEnd)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dtStamp, err := ICalendarDateTime{}.New(dtStampTz, ICalTimeForm.Utc(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendarDateTime{}.New(dtStamp)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	event, err := ICalendarEvent{}.New(
+		"weekly-001@example.com",
+		dtStamp,
+		dtStart,
+		dtEnd,
+		"Status meeting",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendarEvent{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	rRule, err := RecurrenceRule{}.NewFromString("FREQ=WEEKLY;BYDAY=MO", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by RecurrenceRule{}.NewFromString()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = event.AddRRule(rRule, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by event.AddRRule()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = iCal.AddEvent(event, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.AddEvent()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	iCalText, err := iCal.GetICalendarText(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.GetICalendarText()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	expectedText := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//Example Corp//Scheduler 1.0//EN\r\n" +
+		"BEGIN:VTIMEZONE\r\n" +
+		"TZID:America/New_York\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:20201101T020000\r\n" +
+		"TZOFFSETFROM:-0400\r\n" +
+		"TZOFFSETTO:-0500\r\n" +
+		"TZNAME:EST\r\n" +
+		"END:STANDARD\r\n" +
+		"BEGIN:DAYLIGHT\r\n" +
+		"DTSTART:20210314T020000\r\n" +
+		"RRULE:FREQ=YEARLY;BYMONTH=3;BYDAY=2SU\r\n" +
+		"TZOFFSETFROM:-0500\r\n" +
+		"TZOFFSETTO:-0400\r\n" +
+		"TZNAME:EDT\r\n" +
+		"END:DAYLIGHT\r\n" +
+		"BEGIN:STANDARD\r\n" +
+		"DTSTART:20211107T020000\r\n" +
+		"RRULE:FREQ=YEARLY;BYMONTH=11;BYDAY=1SU\r\n" +
+		"TZOFFSETFROM:-0400\r\n" +
+		"TZOFFSETTO:-0500\r\n" +
+		"TZNAME:EST\r\n" +
+		"END:STANDARD\r\n" +
+		"END:VTIMEZONE\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:weekly-001@example.com\r\n" +
+		"DTSTAMP:20210101T120000Z\r\n" +
+		"DTSTART;TZID=America/New_York:20210104T090000\r\n" +
+		"DTEND;TZID=America/New_York:20210104T100000\r\n" +
+		"SUMMARY:Status meeting\r\n" +
+		"RRULE:FREQ=WEEKLY;BYDAY=MO\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	if iCalText != expectedText {
+		t.Errorf("Error: Generated iCalendar text does NOT match.\n"+
+			"Expected='%v'\nActual='%v'\n", expectedText, iCalText)
+	}
+
+	// Nonexistent local times shift forward by the length of the
+	// gap. Ambiguous local times resolve to the first occurrence.
+	gapText := "BEGIN:VCALENDAR\r\n" +
+		"VERSION:2.0\r\n" +
+		"PRODID:-//Example Corp//Scheduler 1.0//EN\r\n" +
+		"BEGIN:VEVENT\r\n" +
+		"UID:gap-001@example.com\r\n" +
+		"DTSTART;TZID=America/New_York:20210314T023000\r\n" +
+		"RDATE;TZID=America/New_York:20211107T013000\r\n" +
+		"END:VEVENT\r\n" +
+		"END:VCALENDAR\r\n"
+
+	iCal2, err := ICalendar{}.NewFromString(gapText, "", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendar{}.NewFromString(gapText)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	events := iCal2.GetEvents()
+
+	if len(events) != 1 {
+		t.Errorf("Error: Expected 1 event. Instead, events='%v'\n", len(events))
+		return
+	}
+
+	testICalendarDateTime(t, "Gap DTSTART", events[0].GetDtStart(),
+		ICalTimeForm.Zoned(), "America/New_York",
+		"2021-03-14 03:30:00 -0400 EDT")
+
+	rDates := events[0].GetRDates()
+
+	if len(rDates) != 1 {
+		t.Errorf("Error: Expected 1 RDATE. Instead, RDATEs='%v'\n", len(rDates))
+	} else {
+		testICalendarDateTime(t, "Overlap RDATE", rDates[0],
+			ICalTimeForm.Zoned(), "America/New_York",
+			"2021-11-07 01:30:00 -0400 EDT")
+	}
+
+	// The wall clock time of a nonexistent DTSTART is written as
+	// resolved.
+	iCalText, err = iCal2.GetICalendarText(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by iCal2.GetICalendarText()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !strings.Contains(iCalText, "DTSTART;TZID=America/New_York:20210314T033000\r\n") {
+		t.Errorf("Error: Expected resolved DTSTART. iCalText='%v'\n", iCalText)
+	}
+}
+
+func TestICalendar04(t *testing.T) {
+
+	ePrefix := "TestICalendar04() "
+
+	wrap := func(eventLines ...string) string {
+		return "BEGIN:VCALENDAR\r\n" +
+			"VERSION:2.0\r\n" +
+			"PRODID:-//Example Corp//Scheduler 1.0//EN\r\n" +
+			"BEGIN:VEVENT\r\n" +
+			strings.Join(eventLines, "\r\n") + "\r\n" +
+			"END:VEVENT\r\n" +
+			"END:VCALENDAR\r\n"
+	}
+
+	badTexts := []struct {
+		title string
+		text  string
+	}{
+		{"Empty Text", ""},
+		{"Missing BEGIN:VCALENDAR", "VERSION:2.0\r\nEND:VCALENDAR\r\n"},
+		{"Missing END:VCALENDAR",
+			"BEGIN:VCALENDAR\r\nVERSION:2.0\r\nBEGIN:VEVENT\r\nUID:a\r\n" +
+				"DTSTART:20210101T000000Z\r\nEND:VEVENT\r\n"},
+		{"Mismatched END",
+			"BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nUID:a\r\n" +
+				"DTSTART:20210101T000000Z\r\nEND:VTODO\r\nEND:VCALENDAR\r\n"},
+		{"Unsupported VERSION",
+			"BEGIN:VCALENDAR\r\nVERSION:1.0\r\nEND:VCALENDAR\r\n"},
+		{"Missing UID", wrap("DTSTART:20210101T000000Z")},
+		{"Missing DTSTART", wrap("UID:a")},
+		{"Missing Colon", wrap("UID:a", "DTSTART:20210101T000000Z", "SUMMARY")},
+		{"Unterminated Quote", wrap("UID:a", "DTSTART;TZID=\"America/Chicago:20210101T000000")},
+		{"Invalid Date", wrap("UID:a", "DTSTART:20210230T000000Z")},
+		{"Invalid Time", wrap("UID:a", "DTSTART:20210101T250000")},
+		{"Unknown TZID", wrap("UID:a", "DTSTART;TZID=Nowhere/Special:20210101T090000")},
+		{"DTEND Before DTSTART",
+			wrap("UID:a", "DTSTART:20210102T000000Z", "DTEND:20210101T000000Z")},
+		{"Mixed Value Types",
+			wrap("UID:a", "DTSTART;VALUE=DATE:20210101", "DTEND:20210102T000000Z")},
+		{"Duplicate DTSTART",
+			wrap("UID:a", "DTSTART:20210101T000000Z", "DTSTART:20210102T000000Z")},
+		{"Invalid RRULE", wrap("UID:a", "DTSTART:20210101T000000Z", "RRULE:FREQ=SOMETIMES")},
+	}
+
+	for _, badText := range badTexts {
+
+		_, err := ICalendar{}.NewFromString(badText.text, "", ePrefix)
+
+		if err == nil {
+			t.Errorf("%v: Expected an error return. NO ERROR WAS RETURNED!\n",
+				badText.title)
+		}
+	}
+
+	_, err := ICalendar{}.NewFromString(wrap("UID:a", "DTSTART:20210101T000000Z"),
+		"Invalid/TimeZone", ePrefix)
+
+	if err == nil {
+		t.Error("Invalid Floating Time Zone: Expected an error return. " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	_, err = ICalendar{}.New("", ePrefix)
+
+	if err == nil {
+		t.Error("Empty PRODID: Expected an error return. NO ERROR WAS RETURNED!")
+	}
+
+	dTz, err := DateTzDto{}.NewDateTime(
+		time.Date(2021, 1, 1, 9, 0, 0, 0, time.UTC),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	_, err = ICalendarDateTime{}.New(dTz, ICalTimeForm.None(), ePrefix)
+
+	if err == nil {
+		t.Error("Invalid Form: Expected an error return. NO ERROR WAS RETURNED!")
+	}
+
+	floating, err := ICalendarDateTime{}.New(dTz, ICalTimeForm.Floating(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendarDateTime{}.New(Floating)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if floating.String() != "20210101T090000" {
+		t.Errorf("Error: Expected floating.String()='20210101T090000'. "+
+			"Instead, floating.String()='%v'\n", floating.String())
+	}
+
+	_, err = ICalendarEvent{}.New("a", floating, floating, ICalendarDateTime{}, "", ePrefix)
+
+	if err == nil {
+		t.Error("Floating DTSTAMP: Expected an error return. NO ERROR WAS RETURNED!")
+	}
+
+	event := ICalendarEvent{}
+
+	err = event.SetOtherProperties([]string{"DTSTART:20210101T000000Z"}, ePrefix)
+
+	if err == nil {
+		t.Error("SetOtherProperties(DTSTART): Expected an error return. " +
+			"NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestICalendar05(t *testing.T) {
+
+	ePrefix := "TestICalendar05() "
+
+	iCal, err := ICalendar{}.NewFromString(
+		testICalendarOutlookText,
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ICalendar{}.NewFromString()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	events := iCal.GetEvents()
+
+	if len(events) != 2 {
+		t.Errorf("Error: Expected 2 events. Instead, events='%v'\n", len(events))
+		return
+	}
+
+	// 'TZID' resolved by matching the 'VTIMEZONE' observances.
+	eastern := events[0]
+
+	testICalendarDateTime(t, "Eastern DTSTART", eastern.GetDtStart(),
+		ICalTimeForm.Zoned(), "Eastern Standard Time", "2024-01-15 09:00:00 -0500 EST")
+
+	dtStart := eastern.GetDtStart()
+
+	tzSpec := dtStart.GetTimeZoneSpec()
+
+	if tzSpec.GetLocationName() != TZones.America.New_York() {
+		t.Errorf("Error: Expected 'Eastern Standard Time' to resolve to '%v'.\n"+
+			"Instead, location='%v'\n",
+			TZones.America.New_York(), tzSpec.GetLocationName())
+	}
+
+	recurSet, err := eastern.GetRecurrenceSet(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by eastern.GetRecurrenceSet()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	occurrences, err := recurSet.GetOccurrences(0, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	expectedOccurrences := []string{
+		"2024-01-15 09:00:00 -0500 EST",
+		"2024-02-19 09:00:00 -0500 EST",
+		"2024-03-18 09:00:00 -0400 EDT",
+		"2024-04-15 09:00:00 -0400 EDT",
+		"2024-05-20 09:00:00 -0400 EDT",
+		"2024-06-17 09:00:00 -0400 EDT",
+	}
+
+	if len(occurrences) != len(expectedOccurrences) {
+		t.Errorf("Error: Expected %v occurrences. Instead, occurrences='%v'\n",
+			len(expectedOccurrences), len(occurrences))
+		return
+	}
+
+	for i, occurrence := range occurrences {
+
+		actual := occurrence.GetDateTimeValue().Format(testRecurrenceFmtStr)
+
+		if actual != expectedOccurrences[i] {
+			t.Errorf("Error: Expected occurrence[%v]='%v'. Instead, occurrence='%v'\n",
+				i, expectedOccurrences[i], actual)
+		}
+	}
+
+	// Fixed UTC offset observance.
+	india := events[1]
+
+	dtStart = india.GetDtStart()
+
+	dTz := dtStart.GetDateTime()
+
+	expectedUtc := time.Date(2024, 7, 4, 4, 0, 0, 0, time.UTC)
+
+	if !dTz.GetDateTimeValue().Equal(expectedUtc) {
+		t.Errorf("Error: Expected 'India Standard Time' DTSTART='%v'.\n"+
+			"Instead, DTSTART='%v'\n",
+			expectedUtc.Format(testRecurrenceFmtStr),
+			dTz.GetDateTimeValue().UTC().Format(testRecurrenceFmtStr))
+	}
+
+	if dtStart.GetTzId() != "India Standard Time" {
+		t.Errorf("Error: Expected TZID='India Standard Time'. Instead, TZID='%v'\n",
+			dtStart.GetTzId())
+	}
+
+	// The written 'VTIMEZONE' components identify the IANA time zones.
+	iCalText, err := iCal.GetICalendarText(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by iCal.GetICalendarText()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !strings.Contains(iCalText,
+		"TZID:Eastern Standard Time\r\nX-LIC-LOCATION:America/New_York\r\n") {
+		t.Errorf("Error: Expected 'X-LIC-LOCATION:America/New_York'.\n"+
+			"iCalendar Text=\n%v\n", iCalText)
+	}
+
+	// Observances which match no time zone.
+	unmatched := strings.Replace(testICalendarOutlookText,
+		"TZOFFSETFROM:+0530\r\nTZOFFSETTO:+0530",
+		"TZOFFSETFROM:+0517\r\nTZOFFSETTO:+0517", 1)
+
+	_, err = ICalendar{}.NewFromString(unmatched, "", ePrefix)
+
+	if err == nil {
+		t.Error("Unmatched VTIMEZONE: Expected an error return. " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	// Observance missing 'TZOFFSETTO'.
+	incomplete := strings.Replace(testICalendarOutlookText,
+		"TZOFFSETFROM:+0530\r\nTZOFFSETTO:+0530\r\n",
+		"TZOFFSETFROM:+0530\r\n", 1)
+
+	_, err = ICalendar{}.NewFromString(incomplete, "", ePrefix)
+
+	if err == nil {
+		t.Error("Incomplete VTIMEZONE: Expected an error return. " +
+			"NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestICalendarTimeForm01(t *testing.T) {
+
+	form, err := ICalendarTimeForm(0).XParseString("FLOATING", false)
+
+	if err != nil {
+		t.Errorf("Error returned by XParseString(\"FLOATING\")\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if form != ICalTimeForm.Floating() {
+		t.Errorf("Error: Expected form='Floating'. Instead, form='%v'\n",
+			form.String())
+	}
+
+	_, err = ICalendarTimeForm(0).XParseString("FLOATING", true)
+
+	if err == nil {
+		t.Error("Case Sensitive: Expected an error return. NO ERROR WAS RETURNED!")
+	}
+
+	if ICalTimeForm.None().XIsValid() {
+		t.Error("Error: Expected ICalTimeForm.None() to be INVALID.")
+	}
+
+	if !ICalTimeForm.Zoned().XIsValid() {
+		t.Error("Error: Expected ICalTimeForm.Zoned() to be VALID.")
+	}
+}
@@ -0,0 +1,438 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestISOWeekDateDtoNewFromString01 (t *testing.T) {
+
+	ePrefix := "TestISOWeekDateDtoNewFromString01() "
+
+	testStrs := []struct {
+		isoWeekDateStr string
+		weekYear       int64
+		weekNumber     int
+		weekDay        ISO8601DayOfWeekNo
+		expectedStr    string
+	}{
+		{"2009-W01-1", 2009, 1, ISO8601DayOfWeekNo(0).Monday(), "2009-W01-1"},
+		{"2004W537", 2004, 53, ISO8601DayOfWeekNo(0).Sunday(), "2004-W53-7"},
+		{"-0001-W52-7", -1, 52, ISO8601DayOfWeekNo(0).Sunday(), "-0001-W52-7"},
+		{"+12345-W10-3", 12345, 10, ISO8601DayOfWeekNo(0).Wednesday(), "+12345-W10-3"},
+		{"0000-W01-6", 0, 1, ISO8601DayOfWeekNo(0).Saturday(), "0000-W01-6"},
+	}
+
+	for i:=0; i < len(testStrs); i++ {
+
+		isoWeekDate, err := ISOWeekDateDto{}.NewFromString(
+			testStrs[i].isoWeekDateStr,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by ISOWeekDateDto{}.NewFromString()\n" +
+				"isoWeekDateStr='%v'\n" +
+				"Error='%v'\n",
+				testStrs[i].isoWeekDateStr,
+				err.Error())
+			return
+		}
+
+		if isoWeekDate.GetWeekYear() != testStrs[i].weekYear ||
+			isoWeekDate.GetWeekNumber() != testStrs[i].weekNumber ||
+			isoWeekDate.GetWeekDay() != testStrs[i].weekDay {
+			t.Errorf("Result INVALID!\n" +
+				"isoWeekDateStr='%v'\n" +
+				"Expected='%v-%v-%v'\n" +
+				"  Actual='%v-%v-%v'\n",
+				testStrs[i].isoWeekDateStr,
+				testStrs[i].weekYear,
+				testStrs[i].weekNumber,
+				testStrs[i].weekDay.XValueInt(),
+				isoWeekDate.GetWeekYear(),
+				isoWeekDate.GetWeekNumber(),
+				isoWeekDate.GetWeekDay().XValueInt())
+		}
+
+		if isoWeekDate.String() != testStrs[i].expectedStr {
+			t.Errorf("Result INVALID!\n" +
+				"Expected String='%v'\n" +
+				"  Actual String='%v'\n",
+				testStrs[i].expectedStr,
+				isoWeekDate.String())
+		}
+	}
+
+	invalidStrs := []string{
+		"",
+		"2009-W1-1",
+		"2009-W01-8",
+		"2009-W00-1",
+		"2009-W54-1",
+		"12345-W01-1",
+		"2009-01-1",
+		"2009W01-1",
+		"2009-W011",
+	}
+
+	for i:=0; i < len(invalidStrs); i++ {
+
+		_, err := ISOWeekDateDto{}.NewFromString(
+			invalidStrs[i],
+			ePrefix)
+
+		if err == nil {
+			t.Errorf("Expected an error return from ISOWeekDateDto{}.NewFromString()\n" +
+				"because 'isoWeekDateStr' is invalid.\n" +
+				"isoWeekDateStr='%v'\n" +
+				"However, NO ERROR WAS RETURNED!\n",
+				invalidStrs[i])
+		}
+	}
+
+	return
+}
+
+func TestCalendarDateTimeGetISOWeekDate01 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeGetISOWeekDate01() "
+
+	// Expected values are computed by time.Time.ISOWeek()
+	// which applies the proleptic Gregorian Calendar using
+	// Astronomical Year numbering.
+	testDates := []struct {
+		year  int64
+		month int
+		day   int
+	}{
+		{2008, 12, 29},
+		{2010, 1, 3},
+		{2005, 1, 2},
+		{2020, 12, 31},
+		{2021, 1, 1},
+		{1582, 10, 15},
+		{1, 1, 1},
+		{0, 1, 1},
+		{0, 12, 31},
+		{-1, 1, 1},
+		{-4713, 11, 24},
+		{12345, 3, 8},
+		{99999, 12, 31},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		calDTime, err := CalendarDateTime{}.NewCalDateTime(
+			CalSpec.Gregorian(),
+			testDates[i].year,
+			CalYearType.Astronomical(),
+			testDates[i].month,
+			testDates[i].day,
+			10,
+			0,
+			0,
+			0,
+			false,
+			"UTC",
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTime()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var isoWeekDate ISOWeekDateDto
+
+		isoWeekDate, err = calDTime.GetISOWeekDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.GetISOWeekDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		expectedDate := time.Date(
+			int(testDates[i].year),
+			time.Month(testDates[i].month),
+			testDates[i].day,
+			10,
+			0,
+			0,
+			0,
+			time.UTC)
+
+		expectedWeekYear, expectedWeek := expectedDate.ISOWeek()
+
+		expectedWeekDay := int(expectedDate.Weekday())
+
+		if expectedWeekDay == 0 {
+			expectedWeekDay = 7
+		}
+
+		if isoWeekDate.GetWeekYear() != int64(expectedWeekYear) ||
+			isoWeekDate.GetWeekNumber() != expectedWeek ||
+			isoWeekDate.GetWeekDay().XValueInt() != expectedWeekDay {
+			t.Errorf("Result INVALID!\n" +
+				"Date='%v-%v-%v'\n" +
+				"Expected ISO Week Date='%v-%v-%v'\n" +
+				"  Actual ISO Week Date='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				expectedWeekYear,
+				expectedWeek,
+				expectedWeekDay,
+				isoWeekDate.String())
+		}
+
+		var calDTime2 CalendarDateTime
+
+		calDTime2, err = CalendarDateTime{}.NewCalDateTimeFromISOWeekDate(
+			CalSpec.Gregorian(),
+			isoWeekDate.String(),
+			10,
+			0,
+			0,
+			0,
+			false,
+			"UTC",
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTimeFromISOWeekDate()\n" +
+				"isoWeekDate='%v'\n" +
+				"Error='%v'\n",
+				isoWeekDate.String(),
+				err.Error())
+			return
+		}
+
+		year,
+		yearType,
+		_,
+		_,
+		month,
+		day,
+		err := calDTime2.GetDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime2.GetDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if year != testDates[i].year ||
+			yearType != CalYearType.Astronomical() ||
+			month != testDates[i].month ||
+			day != testDates[i].day {
+			t.Errorf("Round Trip Result INVALID!\n" +
+				"ISO Week Date='%v'\n" +
+				"Expected Date='%v-%v-%v'\n" +
+				"  Actual Date='%v-%v-%v'\n",
+				isoWeekDate.String(),
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				year,
+				month,
+				day)
+		}
+	}
+
+	return
+}
+
+func TestCalendarDateTimeGetISOWeekDate02 (t *testing.T) {
+
+	ePrefix := "TestCalendarDateTimeGetISOWeekDate02() "
+
+	// Julian Calendar Date 1582-10-04 fell on a Thursday.
+	// 1582-01-01 (Julian) fell on a Monday.
+	testDates := []struct {
+		year        int64
+		yearType    CalendarYearNumType
+		month       int
+		day         int
+		expectedStr string
+	}{
+		{1582, CalYearType.CE(), 10, 4, "1582-W40-4"},
+		{1582, CalYearType.CE(), 1, 1, "1582-W01-1"},
+		{0, CalYearType.Astronomical(), 12, 31, "0000-W53-5"},
+	}
+
+	for i:=0; i < len(testDates); i++ {
+
+		calDTime, err := CalendarDateTime{}.NewCalDateTime(
+			CalSpec.Julian(),
+			testDates[i].year,
+			testDates[i].yearType,
+			testDates[i].month,
+			testDates[i].day,
+			0,
+			0,
+			0,
+			0,
+			false,
+			"UTC",
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by CalendarDateTime{}.NewCalDateTime()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		var isoWeekDate ISOWeekDateDto
+
+		isoWeekDate, err = calDTime.GetISOWeekDate(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by calDTime.GetISOWeekDate()\n" +
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if isoWeekDate.String() != testDates[i].expectedStr {
+			t.Errorf("Result INVALID!\n" +
+				"Julian Date='%v-%v-%v %v'\n" +
+				"Expected ISO Week Date='%v'\n" +
+				"  Actual ISO Week Date='%v'\n",
+				testDates[i].year,
+				testDates[i].month,
+				testDates[i].day,
+				testDates[i].yearType.String(),
+				testDates[i].expectedStr,
+				isoWeekDate.String())
+		}
+	}
+
+	_, err := CalendarDateTime{}.NewCalDateTimeFromISOWeekDate(
+		CalSpec.Gregorian(),
+		"2021-W53-1",
+		0,
+		0,
+		0,
+		0,
+		false,
+		"UTC",
+		"",
+		ePrefix)
+
+	if err == nil {
+		t.Error("Expected an error return from NewCalDateTimeFromISOWeekDate()\n" +
+			"because week-based year 2021 does NOT contain week 53.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	return
+}
+
+func TestADateTimeDtoGetISOWeekDate01 (t *testing.T) {
+
+	ePrefix := "TestADateTimeDtoGetISOWeekDate01() "
+
+	dateTimeDto, err := ADateTimeDto{}.NewFromISOWeekDate(
+		CalSpec.Gregorian(),
+		"2020W537",
+		false,
+		23,
+		59,
+		59,
+		0,
+		"UTC",
+		"",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.NewFromISOWeekDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if dateTimeDto.GetYearAstronomical() != 2021 ||
+		dateTimeDto.GetMonth() != 1 ||
+		dateTimeDto.GetDay() != 3 ||
+		dateTimeDto.GetHour() != 23 {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Date='2021-1-3 23'\n" +
+			"  Actual Date='%v-%v-%v %v'\n",
+			dateTimeDto.GetYearAstronomical(),
+			dateTimeDto.GetMonth(),
+			dateTimeDto.GetDay(),
+			dateTimeDto.GetHour())
+	}
+
+	var isoWeekDate ISOWeekDateDto
+
+	isoWeekDate, err = dateTimeDto.GetISOWeekDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by dateTimeDto.GetISOWeekDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if isoWeekDate.String() != "2020-W53-7" {
+		t.Errorf("Result INVALID!\n" +
+			"Expected ISO Week Date='2020-W53-7'\n" +
+			"  Actual ISO Week Date='%v'\n",
+			isoWeekDate.String())
+	}
+
+	return
+}
+
+func TestDateTzDtoGetISOWeekDate01 (t *testing.T) {
+
+	dTz, err := DateTzDto{}.NewFromISOWeekDate(
+		"2009-W01-1",
+		14,
+		30,
+		0,
+		0,
+		TZones.America.Chicago(),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewFromISOWeekDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	dateTime := dTz.GetDateTimeValue()
+
+	if dateTime.Year() != 2008 ||
+		dateTime.Month() != time.December ||
+		dateTime.Day() != 29 ||
+		dateTime.Hour() != 14 ||
+		dateTime.Minute() != 30 {
+		t.Errorf("Result INVALID!\n" +
+			"Expected Date Time='2008-12-29 14:30'\n" +
+			"  Actual Date Time='%v'\n",
+			dateTime.Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	var isoWeekDate ISOWeekDateDto
+
+	isoWeekDate, err = dTz.GetISOWeekDate()
+
+	if err != nil {
+		t.Errorf("Error returned by dTz.GetISOWeekDate()\n" +
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if isoWeekDate.String() != "2009-W01-1" {
+		t.Errorf("Result INVALID!\n" +
+			"Expected ISO Week Date='2009-W01-1'\n" +
+			"  Actual ISO Week Date='%v'\n",
+			isoWeekDate.String())
+	}
+
+	return
+}
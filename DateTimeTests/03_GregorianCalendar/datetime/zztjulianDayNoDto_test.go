@@ -0,0 +1,84 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestJulianDayNoDto01(t *testing.T) {
+
+	ePrefix := "TestJulianDayNoDto01() "
+
+	// Julian time is measured from noon. Gregorian 11:59:30 UTC
+	// is Julian time 23:59:30.
+	_, jDNDto, err := JulianDayNoDto{}.NewFromGregorianDate(
+		time.Date(2024, 6, 20, 11, 59, 30, 0, time.UTC),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromGregorianDate(11:59:30)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if jDNDto.GetJulianHours() != 23 ||
+		jDNDto.GetMinutes() != 59 ||
+		jDNDto.GetSeconds() != 30 {
+		t.Errorf("Error: Expected Julian time='23:59:30'\n"+
+			"Instead, Julian time='%02d:%02d:%02d'\n",
+			jDNDto.GetJulianHours(),
+			jDNDto.GetMinutes(),
+			jDNDto.GetSeconds())
+	}
+
+	if !jDNDto.IsValidInstance() {
+		t.Error("Error: Expected Julian time 23:59:30 to be valid.\n" +
+			"Instead, IsValidInstance()='false'\n")
+	}
+
+	dayNoTime, err := jDNDto.GetDayNoTimeFloat64(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetDayNoTimeFloat64()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	// 2024-06-20 12:00:00 UTC = JDN 2460482.0
+	expectedDayNoTime := 2460481.0 + (86400.0-30.0)/86400.0
+
+	if dayNoTime < expectedDayNoTime-0.00001 ||
+		dayNoTime > expectedDayNoTime+0.00001 {
+		t.Errorf("Error: Expected Julian Day Number/Time='%v'\n"+
+			"Instead, Julian Day Number/Time='%v'\n",
+			expectedDayNoTime, dayNoTime)
+	}
+
+	// Gregorian 23:59:59 UTC is Julian time 11:59:59.
+	_, jDNDto, err = JulianDayNoDto{}.NewFromGregorianDate(
+		time.Date(2024, 6, 20, 23, 59, 59, 0, time.UTC),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromGregorianDate(23:59:59)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if jDNDto.GetGregorianHours() != 23 ||
+		jDNDto.GetMinutes() != 59 ||
+		jDNDto.GetSeconds() != 59 {
+		t.Errorf("Error: Expected Gregorian time='23:59:59'\n"+
+			"Instead, Gregorian time='%02d:%02d:%02d'\n",
+			jDNDto.GetGregorianHours(),
+			jDNDto.GetMinutes(),
+			jDNDto.GetSeconds())
+	}
+
+	_, err = jDNDto.GetDayNoTimeFloat64(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetDayNoTimeFloat64(23:59:59)\n"+
+			"Error='%v'\n", err.Error())
+	}
+}
@@ -0,0 +1,311 @@
+package datetime
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestJulianDayNoDtoVariants01(t *testing.T) {
+
+	ePrefix := "TestJulianDayNoDtoVariants01() "
+
+	// Julian Day Number/Time 2451545.0
+	gregorianDateTime := time.Date(
+		2000, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	_, jDNDto, err := JulianDayNoDto{}.NewFromGregorianDate(
+		gregorianDateTime,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromGregorianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testVariants := []struct {
+		variant           JulianDayNoVariantType
+		expectedDayNo     int64
+		expectedDayNoTime string
+	}{
+		{JDNVariant.JulianDayNumber(), 2451545, "2451545.0"},
+		{JDNVariant.ModifiedJulianDate(), 51544, "51544.5"},
+		{JDNVariant.ReducedJulianDate(), 51545, "51545.0"},
+		{JDNVariant.TruncatedJulianDate(), 11544, "11544.5"},
+		{JDNVariant.LilianDayNumber(), 152385, "152385.5"},
+		{JDNVariant.RataDie(), 730120, "730120.5"},
+	}
+
+	for i := 0; i < len(testVariants); i++ {
+
+		dayNoTime, err := jDNDto.GetVariantDayNoTime(
+			testVariants[i].variant,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by jDNDto.GetVariantDayNoTime(%v)\n"+
+				"Error='%v'\n", testVariants[i].variant.String(), err.Error())
+			return
+		}
+
+		actualDayNoTime := dayNoTime.Text('f', 1)
+
+		if actualDayNoTime != testVariants[i].expectedDayNoTime {
+			t.Errorf("Error: Variant %v\n"+
+				"Expected day number/time='%v'\n"+
+				"  Actual day number/time='%v'\n",
+				testVariants[i].variant.String(),
+				testVariants[i].expectedDayNoTime,
+				actualDayNoTime)
+		}
+
+		dayNo, err := jDNDto.GetVariantDayNo(
+			testVariants[i].variant,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by jDNDto.GetVariantDayNo(%v)\n"+
+				"Error='%v'\n", testVariants[i].variant.String(), err.Error())
+			return
+		}
+
+		if dayNo.Int64() != testVariants[i].expectedDayNo {
+			t.Errorf("Error: Variant %v\n"+
+				"Expected day number='%v'\n"+
+				"  Actual day number='%v'\n",
+				testVariants[i].variant.String(),
+				testVariants[i].expectedDayNo,
+				dayNo.Text(10))
+		}
+	}
+
+	mjd, err := jDNDto.GetModifiedJulianDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetModifiedJulianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if mjd.Text('f', 1) != "51544.5" {
+		t.Errorf("Error: Expected MJD='51544.5'.\n"+
+			"Instead, MJD='%v'\n", mjd.Text('f', 1))
+	}
+
+	rataDie, err := jDNDto.GetRataDie(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetRataDie()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if rataDie.Int64() != 730120 {
+		t.Errorf("Error: Expected Rata Die='730120'.\n"+
+			"Instead, Rata Die='%v'\n", rataDie.Text(10))
+	}
+
+	_, err = jDNDto.GetVariantDayNoTime(JDNVariant.None(), ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from GetVariantDayNoTime()\n" +
+			"because the variant is 'None'.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestJulianDayNoDtoVariants02(t *testing.T) {
+
+	ePrefix := "TestJulianDayNoDtoVariants02() "
+
+	// Lilian day number one (1) is October 15, 1582.
+	// Days preceding the Gregorian reform yield zero
+	// and negative Lilian day numbers.
+	testDates := []struct {
+		dateTime    time.Time
+		lilianDayNo int64
+	}{
+		{time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC), 1},
+		{time.Date(1582, 10, 14, 12, 0, 0, 0, time.UTC), 0},
+		{time.Date(1582, 10, 13, 18, 0, 0, 0, time.UTC), -1},
+	}
+
+	for i := 0; i < len(testDates); i++ {
+
+		_, jDNDto, err := JulianDayNoDto{}.NewFromGregorianDate(
+			testDates[i].dateTime,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by JulianDayNoDto{}.NewFromGregorianDate()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		lilianDayNo, err := jDNDto.GetLilianDayNo(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by jDNDto.GetLilianDayNo()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if lilianDayNo.Int64() != testDates[i].lilianDayNo {
+			t.Errorf("Error: Date '%v'\n"+
+				"Expected Lilian day number='%v'\n"+
+				"  Actual Lilian day number='%v'\n",
+				testDates[i].dateTime.Format(FmtDateTimeYrMDayFmtStr),
+				testDates[i].lilianDayNo,
+				lilianDayNo.Text(10))
+		}
+	}
+}
+
+func TestJulianDayNoDtoVariants03(t *testing.T) {
+
+	ePrefix := "TestJulianDayNoDtoVariants03() "
+
+	// MJD zero is 00:00:00 UTC November 17, 1858
+	jDNDto, err := JulianDayNoDto{}.NewFromModifiedJulianDate(
+		big.NewFloat(0.0),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromModifiedJulianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	julianDayNoTime, err := jDNDto.GetDayNoTimeBigFloat(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetDayNoTimeBigFloat()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if julianDayNoTime.Text('f', 1) != "2400000.5" {
+		t.Errorf("Error: Expected JD='2400000.5'.\n"+
+			"Instead, JD='%v'\n", julianDayNoTime.Text('f', 1))
+	}
+
+	if jDNDto.GetGregorianHours() != 0 {
+		t.Errorf("Error: Expected Gregorian hours='0'.\n"+
+			"Instead, Gregorian hours='%v'\n", jDNDto.GetGregorianHours())
+	}
+
+	// Rata Die one (1) is 00:00:00 UTC January 1, 0001
+	jDNDto, err = JulianDayNoDto{}.NewFromRataDie(1, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromRataDie()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	julianDayNoTime, err = jDNDto.GetDayNoTimeBigFloat(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetDayNoTimeBigFloat()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if julianDayNoTime.Text('f', 1) != "1721425.5" {
+		t.Errorf("Error: Expected JD='1721425.5'.\n"+
+			"Instead, JD='%v'\n", julianDayNoTime.Text('f', 1))
+	}
+
+	// Round trip through each variant
+	tjd := big.NewFloat(0.0).SetPrec(1024)
+
+	tjd.SetString("19000.75")
+
+	jDNDto, err = JulianDayNoDto{}.NewFromTruncatedJulianDate(
+		tjd,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromTruncatedJulianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	rjd, err := jDNDto.GetReducedJulianDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetReducedJulianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if rjd.Text('f', 2) != "59001.25" {
+		t.Errorf("Error: Expected RJD='59001.25'.\n"+
+			"Instead, RJD='%v'\n", rjd.Text('f', 2))
+	}
+
+	jDNDto, err = JulianDayNoDto{}.NewFromReducedJulianDate(
+		rjd,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromReducedJulianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	tjd2, err := jDNDto.GetTruncatedJulianDate(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetTruncatedJulianDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if tjd2.Text('f', 2) != "19000.75" {
+		t.Errorf("Error: Expected TJD='19000.75'.\n"+
+			"Instead, TJD='%v'\n", tjd2.Text('f', 2))
+	}
+
+	jDNDto, err = JulianDayNoDto{}.NewFromLilianDayNo(152385, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromLilianDayNo()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	julianDayNoTime, err = jDNDto.GetDayNoTimeBigFloat(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by jDNDto.GetDayNoTimeBigFloat()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if julianDayNoTime.Text('f', 1) != "2451544.5" {
+		t.Errorf("Error: Expected JD='2451544.5'.\n"+
+			"Instead, JD='%v'\n", julianDayNoTime.Text('f', 1))
+	}
+
+	_, err = JulianDayNoDto{}.NewFromVariantDayNoTime(
+		JulianDayNoVariantType(99),
+		big.NewFloat(1.0),
+		false,
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewFromVariantDayNoTime()\n" +
+			"because the variant is INVALID.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = JulianDayNoDto{}.NewFromModifiedJulianDate(nil, ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewFromModifiedJulianDate()\n" +
+			"because the input parameter is 'nil'.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
@@ -0,0 +1,644 @@
+package datetime
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLeapSecondTable01(t *testing.T) {
+
+	ePrefix := "TestLeapSecondTable01() "
+
+	leapSecTable, err := LeapSecondTable{}.New(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by LeapSecondTable{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	entries := leapSecTable.GetEntries()
+
+	if len(entries) != 28 {
+		t.Errorf("Error: Expected 28 leap second table entries.\n"+
+			"Instead, there are %v entries.\n", len(entries))
+		return
+	}
+
+	if entries[0].GetIsLeapSecond() {
+		t.Error("Error: Expected the first entry, January 1, 1972, " +
+			"would NOT be a leap second.\n")
+	}
+
+	leapSecondDate, isLeapSecond := entries[27].GetLeapSecondDate()
+
+	if !isLeapSecond ||
+		leapSecondDate.Format("2006-01-02") != "2016-12-31" {
+		t.Errorf("Error: Expected the last leap second date '2016-12-31'.\n"+
+			"Instead, leap second date='%v' isLeapSecond='%v'\n",
+			leapSecondDate.Format("2006-01-02"), isLeapSecond)
+	}
+
+	// The expiration date changes whenever the embedded file is
+	// refreshed. It must follow the final table entry.
+	expirationDate := leapSecTable.GetExpirationDate()
+
+	if expirationDate.IsZero() ||
+		!expirationDate.After(entries[27].GetEffectiveDate()) ||
+		!expirationDate.After(leapSecTable.GetLastUpdateDate()) {
+		t.Errorf("Error: Expected an expiration date following the final entry\n"+
+			"and the last update date.\n"+
+			"Instead, expiration date='%v' final entry='%v' last update='%v'\n",
+			expirationDate.Format("2006-01-02"),
+			entries[27].GetEffectiveDate().Format("2006-01-02"),
+			leapSecTable.GetLastUpdateDate().Format("2006-01-02"))
+	}
+
+	testDates := []struct {
+		dateTime    time.Time
+		taiMinusUtc int
+	}{
+		{time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC), 10},
+		{time.Date(1972, 6, 30, 23, 59, 59, 0, time.UTC), 10},
+		{time.Date(1972, 7, 1, 0, 0, 0, 0, time.UTC), 11},
+		{time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), 36},
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+		{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), 37},
+	}
+
+	for i := 0; i < len(testDates); i++ {
+
+		taiMinusUtc, err := leapSecTable.GetTaiMinusUtc(
+			testDates[i].dateTime,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by leapSecTable.GetTaiMinusUtc()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if taiMinusUtc != testDates[i].taiMinusUtc {
+			t.Errorf("Error: Date '%v'\n"+
+				"Expected TAI - UTC='%v'\n"+
+				"  Actual TAI - UTC='%v'\n",
+				testDates[i].dateTime.Format(FmtDateTimeYrMDayFmtStr),
+				testDates[i].taiMinusUtc,
+				taiMinusUtc)
+		}
+	}
+
+	_, err = leapSecTable.GetTaiMinusUtc(
+		time.Date(1971, 12, 31, 0, 0, 0, 0, time.UTC),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from GetTaiMinusUtc()\n" +
+			"because the date precedes January 1, 1972.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	tokyo, err := time.LoadLocation(TZones.Asia.Tokyo())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testDays := []struct {
+		year         int
+		month        int
+		day          int
+		location     *time.Location
+		isLeapSecond bool
+	}{
+		{2016, 12, 31, nil, true},
+		{2016, 12, 30, nil, false},
+		{2017, 1, 1, nil, false},
+		{2015, 6, 30, time.UTC, true},
+		{1971, 12, 31, nil, false},
+		// 23:59:60 UTC on December 31, 2016 was 08:59:60 JST
+		// on January 1, 2017.
+		{2017, 1, 1, tokyo, true},
+		{2016, 12, 31, tokyo, false},
+	}
+
+	for i := 0; i < len(testDays); i++ {
+
+		isLeapSecondDay := leapSecTable.IsLeapSecondDay(
+			testDays[i].year,
+			testDays[i].month,
+			testDays[i].day,
+			testDays[i].location)
+
+		if isLeapSecondDay != testDays[i].isLeapSecond {
+			t.Errorf("Error: Test Day #%v %v-%v-%v\n"+
+				"Expected IsLeapSecondDay='%v'\n"+
+				"  Actual IsLeapSecondDay='%v'\n",
+				i,
+				testDays[i].year,
+				testDays[i].month,
+				testDays[i].day,
+				testDays[i].isLeapSecond,
+				isLeapSecondDay)
+		}
+	}
+}
+
+func TestLeapSecondTable02(t *testing.T) {
+
+	ePrefix := "TestLeapSecondTable02() "
+
+	badTables := []string{
+		``,
+		"# Comments only\n",
+		"2272060800\t10\n2272060800\t11\n",
+		"2272060800\t10\n2287785600\t12\n",
+		"2272060800\n",
+		"2272060800\tten\n",
+		"#@\tnever\n2272060800\t10\n",
+	}
+
+	for i := 0; i < len(badTables); i++ {
+
+		_, err := LeapSecondTable{}.NewFromBytes(
+			[]byte(badTables[i]),
+			ePrefix)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error return from NewFromBytes()\n"+
+				"because the leap second data is INVALID.\n"+
+				"However, NO ERROR WAS RETURNED!\n"+
+				"Data='%v'\n", badTables[i])
+		}
+	}
+
+	// A hypothetical leap second on December 31, 2027
+	updatedTable := string(embeddedLeapSecondsList) +
+		"4039286400\t38\t# 1 Jan 2028\n"
+
+	pathFileName := filepath.Join(t.TempDir(), "leap-seconds.list")
+
+	err := os.WriteFile(pathFileName, []byte(updatedTable), 0644)
+
+	if err != nil {
+		t.Errorf("Error returned by os.WriteFile()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = LeapSecondTable{}.LoadCurrentFromFile(pathFileName, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by LeapSecondTable{}.LoadCurrentFromFile()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	defer func() {
+		_ = LeapSecondTable{}.ResetCurrent(ePrefix)
+	}()
+
+	aDateTime, err := ADateTimeDto{}.New(
+		CalendarSpec(0).Gregorian(),
+		2027,
+		CalendarYearNumType(0).Astronomical(),
+		12,
+		31,
+		false,
+		23,
+		59,
+		60,
+		0,
+		TZones.Other.UTC(),
+		FmtDateTimeYrMDayFmtStr,
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !aDateTime.GetDateHasLeapSecond() ||
+		!aDateTime.GetTimeHasLeapSecond() {
+		t.Errorf("Error: Expected leap second flags to be set from the loaded table.\n"+
+			"GetDateHasLeapSecond()='%v' GetTimeHasLeapSecond()='%v'\n",
+			aDateTime.GetDateHasLeapSecond(),
+			aDateTime.GetTimeHasLeapSecond())
+	}
+
+	err = LeapSecondTable{}.ResetCurrent(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by LeapSecondTable{}.ResetCurrent()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	currentTable, err := LeapSecondTable{}.GetCurrent(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by LeapSecondTable{}.GetCurrent()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(currentTable.GetEntries()) != 28 {
+		t.Errorf("Error: Expected 28 entries after ResetCurrent().\n"+
+			"Instead, there are %v entries.\n",
+			len(currentTable.GetEntries()))
+	}
+
+	err = LeapSecondTable{}.LoadCurrentFromFile(
+		filepath.Join(t.TempDir(), "missing.list"),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from LoadCurrentFromFile()\n" +
+			"because the file does not exist.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestADateTimeDtoConvertTimeScale01(t *testing.T) {
+
+	ePrefix := "TestADateTimeDtoConvertTimeScale01() "
+
+	testConversions := []struct {
+		fromScale    TimeScaleType
+		fromDateTime [7]int
+		timeZone     string
+		toScale      TimeScaleType
+		toDateTime   [7]int
+	}{
+		{TScale.UTC(), [7]int{2017, 1, 1, 0, 0, 0, 0}, TZones.Other.UTC(),
+			TScale.TAI(), [7]int{2017, 1, 1, 0, 0, 37, 0}},
+		{TScale.UTC(), [7]int{2016, 12, 31, 23, 59, 60, 500000000}, TZones.Other.UTC(),
+			TScale.TAI(), [7]int{2017, 1, 1, 0, 0, 36, 500000000}},
+		{TScale.UTC(), [7]int{2016, 12, 31, 18, 59, 60, 0}, TZones.America.New_York(),
+			TScale.TAI(), [7]int{2017, 1, 1, 0, 0, 36, 0}},
+		{TScale.TAI(), [7]int{2017, 1, 1, 0, 0, 36, 250}, TZones.Other.UTC(),
+			TScale.UTC(), [7]int{2016, 12, 31, 23, 59, 60, 250}},
+		{TScale.TAI(), [7]int{2017, 1, 1, 0, 0, 35, 0}, TZones.Other.UTC(),
+			TScale.UTC(), [7]int{2016, 12, 31, 23, 59, 59, 0}},
+		{TScale.UTC(), [7]int{2017, 1, 1, 0, 0, 0, 0}, TZones.Other.UTC(),
+			TScale.GPS(), [7]int{2017, 1, 1, 0, 0, 18, 0}},
+		{TScale.GPS(), [7]int{1980, 1, 6, 0, 0, 0, 0}, TZones.Other.UTC(),
+			TScale.UTC(), [7]int{1980, 1, 6, 0, 0, 0, 0}},
+		{TScale.UTC(), [7]int{2017, 1, 1, 0, 0, 0, 0}, TZones.Other.UTC(),
+			TScale.TT(), [7]int{2017, 1, 1, 0, 1, 9, 184000000}},
+		{TScale.TT(), [7]int{2000, 1, 1, 12, 0, 0, 0}, TZones.Other.UTC(),
+			TScale.UTC(), [7]int{2000, 1, 1, 11, 58, 55, 816000000}},
+		{TScale.TT(), [7]int{2000, 1, 1, 12, 0, 0, 0}, TZones.Other.UTC(),
+			TScale.GPS(), [7]int{2000, 1, 1, 11, 59, 8, 816000000}},
+	}
+
+	for i := 0; i < len(testConversions); i++ {
+
+		from := testConversions[i].fromDateTime
+
+		aDateTime, err := ADateTimeDto{}.New(
+			CalendarSpec(0).Gregorian(),
+			int64(from[0]),
+			CalendarYearNumType(0).Astronomical(),
+			from[1],
+			from[2],
+			false,
+			from[3],
+			from[4],
+			from[5],
+			from[6],
+			testConversions[i].timeZone,
+			FmtDateTimeYrMDayFmtStr,
+			"",
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by ADateTimeDto{}.New()\n"+
+				"Test Conversion #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			return
+		}
+
+		converted, err := aDateTime.ConvertTimeScale(
+			testConversions[i].fromScale,
+			testConversions[i].toScale,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by aDateTime.ConvertTimeScale()\n"+
+				"Test Conversion #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			return
+		}
+
+		actual := [7]int{
+			int(converted.GetYear()),
+			converted.GetMonth(),
+			converted.GetDay(),
+			converted.GetHour(),
+			converted.GetMinute(),
+			converted.GetSecond(),
+			converted.GetNanosecond(),
+		}
+
+		if actual != testConversions[i].toDateTime {
+			t.Errorf("Error: Test Conversion #%v %v to %v\n"+
+				"Expected='%v'\n"+
+				"  Actual='%v'\n",
+				i,
+				testConversions[i].fromScale.String(),
+				testConversions[i].toScale.String(),
+				testConversions[i].toDateTime,
+				actual)
+		}
+
+		expectLeapSecond := testConversions[i].toScale == TScale.UTC() &&
+			actual[5] == 60
+
+		if converted.GetTimeHasLeapSecond() != expectLeapSecond {
+			t.Errorf("Error: Test Conversion #%v\n"+
+				"Expected GetTimeHasLeapSecond()='%v'\n"+
+				"Instead, GetTimeHasLeapSecond()='%v'\n",
+				i,
+				expectLeapSecond,
+				converted.GetTimeHasLeapSecond())
+		}
+	}
+}
+
+func TestADateTimeDtoConvertTimeScale02(t *testing.T) {
+
+	ePrefix := "TestADateTimeDtoConvertTimeScale02() "
+
+	aDateTime, err := ADateTimeDto{}.New(
+		CalendarSpec(0).Gregorian(),
+		2016,
+		CalendarYearNumType(0).Astronomical(),
+		12,
+		31,
+		false,
+		23,
+		59,
+		60,
+		0,
+		TZones.Other.UTC(),
+		FmtDateTimeYrMDayFmtStr,
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !aDateTime.GetDateHasLeapSecond() {
+		t.Error("Error: Expected GetDateHasLeapSecond()='true' for 2016-12-31.\n" +
+			"Instead, GetDateHasLeapSecond()='false'\n")
+	}
+
+	if !aDateTime.GetTimeHasLeapSecond() {
+		t.Error("Error: Expected GetTimeHasLeapSecond()='true' for 23:59:60.\n" +
+			"Instead, GetTimeHasLeapSecond()='false'\n")
+	}
+
+	_, err = aDateTime.ConvertTimeScale(
+		TScale.TAI(),
+		TScale.UTC(),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ConvertTimeScale()\n" +
+			"because a TAI time value cannot contain a leap second.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	aDateTime, err = ADateTimeDto{}.New(
+		CalendarSpec(0).Gregorian(),
+		2016,
+		CalendarYearNumType(0).Astronomical(),
+		12,
+		30,
+		true,
+		23,
+		59,
+		60,
+		0,
+		TZones.Other.UTC(),
+		FmtDateTimeYrMDayFmtStr,
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	_, err = ADateTimeDto{}.New(
+		CalendarSpec(0).Gregorian(),
+		2016,
+		CalendarYearNumType(0).Astronomical(),
+		12,
+		30,
+		false,
+		23,
+		59,
+		60,
+		0,
+		TZones.Other.UTC(),
+		FmtDateTimeYrMDayFmtStr,
+		"",
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ADateTimeDto{}.New()\n" +
+			"because no leap second was inserted on 2016-12-30.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = aDateTime.ConvertTimeScale(
+		TScale.UTC(),
+		TScale.TAI(),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ConvertTimeScale()\n" +
+			"because no leap second was inserted on 2016-12-30.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	aDateTime, err = ADateTimeDto{}.New(
+		CalendarSpec(0).Gregorian(),
+		1960,
+		CalendarYearNumType(0).Astronomical(),
+		1,
+		1,
+		false,
+		0,
+		0,
+		0,
+		0,
+		TZones.Other.UTC(),
+		FmtDateTimeYrMDayFmtStr,
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	_, err = aDateTime.ConvertTimeScale(
+		TScale.UTC(),
+		TScale.TAI(),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ConvertTimeScale()\n" +
+			"because the UTC date precedes January 1, 1972.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	// TAI to TT does not require the leap second table
+	converted, err := aDateTime.ConvertTimeScale(
+		TScale.TAI(),
+		TScale.TT(),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by aDateTime.ConvertTimeScale(TAI, TT)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if converted.GetSecond() != 32 ||
+		converted.GetNanosecond() != 184000000 {
+		t.Errorf("Error: Expected TT time 00:00:32.184.\n"+
+			"Instead, second='%v' nanosecond='%v'\n",
+			converted.GetSecond(),
+			converted.GetNanosecond())
+	}
+}
+
+func TestJulianDayNoDtoConvertTimeScale01(t *testing.T) {
+
+	ePrefix := "TestJulianDayNoDtoConvertTimeScale01() "
+
+	// 2017-01-01 00:00:00 UTC
+	utcJDN, err := JulianDayNoDto{}.NewFromFloat64(2457754.5, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromFloat64()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testConversions := []struct {
+		toScale       TimeScaleType
+		offsetSeconds string
+	}{
+		{TScale.TAI(), "37"},
+		{TScale.GPS(), "18"},
+		{TScale.TT(), "69.184"},
+	}
+
+	for i := 0; i < len(testConversions); i++ {
+
+		converted, err := utcJDN.ConvertTimeScale(
+			TScale.UTC(),
+			testConversions[i].toScale,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by utcJDN.ConvertTimeScale(%v)\n"+
+				"Error='%v'\n", testConversions[i].toScale.String(), err.Error())
+			return
+		}
+
+		expectedJD := big.NewFloat(0.0).SetPrec(1024)
+
+		expectedJD.SetString(testConversions[i].offsetSeconds)
+
+		expectedJD.Quo(expectedJD, big.NewFloat(86400.0))
+
+		expectedJD.Add(expectedJD, big.NewFloat(2457754.5))
+
+		actualJD, err := converted.GetDayNoTimeBigFloat(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by converted.GetDayNoTimeBigFloat()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if actualJD.Text('f', 12) != expectedJD.Text('f', 12) {
+			t.Errorf("Error: UTC to %v\n"+
+				"Expected JD='%v'\n"+
+				"  Actual JD='%v'\n",
+				testConversions[i].toScale.String(),
+				expectedJD.Text('f', 12),
+				actualJD.Text('f', 12))
+		}
+
+		if converted.GetHasLeapSecond() {
+			t.Errorf("Error: UTC to %v\n"+
+				"Expected GetHasLeapSecond()='false'.\n",
+				testConversions[i].toScale.String())
+		}
+
+		roundTrip, err := converted.ConvertTimeScale(
+			testConversions[i].toScale,
+			TScale.UTC(),
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by converted.ConvertTimeScale(%v, UTC)\n"+
+				"Error='%v'\n", testConversions[i].toScale.String(), err.Error())
+			return
+		}
+
+		actualJD, err = roundTrip.GetDayNoTimeBigFloat(ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by roundTrip.GetDayNoTimeBigFloat()\n"+
+				"Error='%v'\n", err.Error())
+			return
+		}
+
+		if actualJD.Text('f', 12) != "2457754.500000000000" {
+			t.Errorf("Error: %v to UTC\n"+
+				"Expected JD='2457754.500000000000'\n"+
+				"  Actual JD='%v'\n",
+				testConversions[i].toScale.String(),
+				actualJD.Text('f', 12))
+		}
+
+		// The Julian Day beginning at noon on December 31,
+		// 2016 includes the leap second.
+		if !roundTrip.GetHasLeapSecond() {
+			t.Errorf("Error: %v to UTC\n"+
+				"Expected GetHasLeapSecond()='true'.\n",
+				testConversions[i].toScale.String())
+		}
+	}
+
+	_, err = utcJDN.ConvertTimeScale(
+		TScale.None(),
+		TScale.TAI(),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ConvertTimeScale()\n" +
+			"because 'fromScale' is INVALID.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
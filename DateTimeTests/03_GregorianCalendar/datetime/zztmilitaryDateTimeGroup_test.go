@@ -0,0 +1,149 @@
+package datetime
+
+import (
+	"testing"
+)
+
+func TestMilitaryDateTimeGroup01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700"
+
+	testCases := []struct {
+		dtg          string
+		centuryPivot int
+		expected     string
+		compactDtg   string
+		openDtg      string
+	}{
+		{
+			"061830RJAN12",
+			1950,
+			"2012-01-06 18:30:00 -0500",
+			"061830RJAN12",
+			"06 1830R JAN 12",
+		},
+		{
+			"06 1830R JAN 12",
+			1950,
+			"2012-01-06 18:30:00 -0500",
+			"061830RJAN12",
+			"06 1830R JAN 12",
+		},
+		{
+			// Two digit year '60' falls before the 2000-2099 window
+			// in the 1950-2049 window.
+			"01 1815z jan 60",
+			1950,
+			"1960-01-01 18:15:00 +0000",
+			"011815ZJAN60",
+			"01 1815Z JAN 60",
+		},
+		{
+			"011815ZJAN60",
+			2000,
+			"2060-01-01 18:15:00 +0000",
+			"011815ZJAN60",
+			"01 1815Z JAN 60",
+		},
+		{
+			// Four digit years ignore the century pivot
+			"290000BFEB2024",
+			1950,
+			"2024-02-29 00:00:00 +0200",
+			"290000BFEB24",
+			"29 0000B FEB 24",
+		},
+		{
+			"312359YDEC99",
+			1950,
+			"1999-12-31 23:59:00 -1200",
+			"312359YDEC99",
+			"31 2359Y DEC 99",
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+
+		dTz, err := DateTzDto{}.NewFromMilitaryDateTimeGroup(
+			testCases[i].dtg,
+			testCases[i].centuryPivot)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewFromMilitaryDateTimeGroup()\n"+
+				"Test Case #%v dtg='%v'\n"+
+				"Error='%v'\n", i, testCases[i].dtg, err.Error())
+			continue
+		}
+
+		actual := dTz.GetDateTimeValue().Format(fmtStr)
+
+		if actual != testCases[i].expected {
+			t.Errorf("Error: Test Case #%v dtg='%v'\n"+
+				"Expected date time='%v'\n"+
+				"  Actual date time='%v'\n",
+				i, testCases[i].dtg, testCases[i].expected, actual)
+		}
+
+		compactDtg, err := dTz.GetMilitaryCompactDateTimeGroup()
+
+		if err != nil {
+			t.Errorf("Error returned by dTz.GetMilitaryCompactDateTimeGroup()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if compactDtg != testCases[i].compactDtg {
+			t.Errorf("Error: Test Case #%v\n"+
+				"Expected Compact DTG='%v'\n"+
+				"  Actual Compact DTG='%v'\n",
+				i, testCases[i].compactDtg, compactDtg)
+		}
+
+		openDtg, err := dTz.GetMilitaryOpenDateTimeGroup()
+
+		if err != nil {
+			t.Errorf("Error returned by dTz.GetMilitaryOpenDateTimeGroup()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if openDtg != testCases[i].openDtg {
+			t.Errorf("Error: Test Case #%v\n"+
+				"Expected Open DTG='%v'\n"+
+				"  Actual Open DTG='%v'\n",
+				i, testCases[i].openDtg, openDtg)
+		}
+	}
+}
+
+func TestMilitaryDateTimeGroup02(t *testing.T) {
+
+	invalidDtgs := []string{
+		"",
+		"061830RJAN1",
+		"061830RXYZ12",
+		"061830JJAN12",
+		"062430RJAN12",
+		"061860RJAN12",
+		"300000ZFEB21",
+		"0618A0RJAN12",
+		"061830RJAN2X",
+	}
+
+	for i := 0; i < len(invalidDtgs); i++ {
+
+		_, err := DateTzDto{}.NewFromMilitaryDateTimeGroup(
+			invalidDtgs[i],
+			1950)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error return from "+
+				"NewFromMilitaryDateTimeGroup()\n"+
+				"because the DTG is INVALID.\n"+
+				"However, NO ERROR WAS RETURNED!\n"+
+				"Test Case #%v dtg='%v'\n", i, invalidDtgs[i])
+		}
+	}
+}
@@ -0,0 +1,279 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPeriod01(t *testing.T) {
+
+	ePrefix := "TestPeriod01() "
+
+	chicago := TZones.America.Chicago()
+
+	testCases := []struct {
+		start    string
+		period   string
+		eomMode  EndOfMonthModeType
+		expected string
+	}{
+		{"2021-01-31 09:00:00", "P1M", EndOfMonthMode.Clamp(),
+			"2021-02-28 09:00:00 -0600 CST"},
+		{"2020-01-31 09:00:00", "P1M", EndOfMonthMode.Clamp(),
+			"2020-02-29 09:00:00 -0600 CST"},
+		{"2021-01-31 09:00:00", "P1M", EndOfMonthMode.Overflow(),
+			"2021-03-03 09:00:00 -0600 CST"},
+		{"2020-01-31 09:00:00", "P1M", EndOfMonthMode.Overflow(),
+			"2020-03-02 09:00:00 -0600 CST"},
+		{"2020-02-29 09:00:00", "P1Y", EndOfMonthMode.Clamp(),
+			"2021-02-28 09:00:00 -0600 CST"},
+		{"2021-03-31 09:00:00", "-P1M", EndOfMonthMode.Clamp(),
+			"2021-02-28 09:00:00 -0600 CST"},
+		// One calendar day across the spring transition spans 23 hours.
+		{"2021-03-13 12:00:00", "P1D", EndOfMonthMode.Clamp(),
+			"2021-03-14 12:00:00 -0500 CDT"},
+		// 24 elapsed hours across the spring transition.
+		{"2021-03-13 12:00:00", "PT24H", EndOfMonthMode.Clamp(),
+			"2021-03-14 13:00:00 -0500 CDT"},
+		// A local time in the gap is shifted forward.
+		{"2021-03-13 02:30:00", "P1D", EndOfMonthMode.Clamp(),
+			"2021-03-14 03:30:00 -0500 CDT"},
+		// One calendar day across the fall transition spans 25 hours.
+		{"2021-11-06 12:00:00", "P1DT1H", EndOfMonthMode.Clamp(),
+			"2021-11-07 13:00:00 -0600 CST"},
+	}
+
+	for i, tc := range testCases {
+
+		start, ok := testNewRecurrenceDateTz(t, tc.start, chicago)
+
+		if !ok {
+			return
+		}
+
+		period, err := Period{}.NewFromString(tc.period, ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by Period{}.NewFromString()\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		result, err := period.AddToDateTz(start, tc.eomMode, ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by period.AddToDateTz()\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		actual := result.GetDateTimeValue().Format(testRecurrenceFmtStr)
+
+		if actual != tc.expected {
+			t.Errorf("Test Case #%v Error: %v + %v (%v)\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				i, tc.start, tc.period, tc.eomMode.String(), tc.expected, actual)
+		}
+	}
+
+	start, ok := testNewRecurrenceDateTz(t, "2021-01-31 09:00:00", chicago)
+
+	if !ok {
+		return
+	}
+
+	period := Period{}.New(0, 1, 0, 0, 0, 0, 0)
+
+	_, err := period.AddToDateTz(start, EndOfMonthMode.None(), ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error from AddToDateTz() with " +
+			"EndOfMonthMode.None(). NO ERROR WAS RETURNED!")
+	}
+}
+
+func TestPeriod02(t *testing.T) {
+
+	ePrefix := "TestPeriod02() "
+
+	chicago := TZones.America.Chicago()
+
+	testCases := []struct {
+		start    string
+		end      string
+		expected string
+	}{
+		{"2021-01-31 09:00:00", "2021-03-30 10:30:00", "P1M30DT1H30M"},
+		{"2021-01-31 09:00:00", "2021-02-28 09:00:00", "P1M"},
+		{"2020-02-29 00:00:00", "2024-02-29 00:00:00", "P4Y"},
+		{"2020-02-29 00:00:00", "2021-02-28 00:00:00", "P1Y"},
+		{"2021-01-15 18:00:00", "2021-02-15 12:00:00", "P30DT18H"},
+		{"2021-03-13 12:00:00", "2021-03-14 12:00:00", "P1D"},
+		{"2021-03-13 12:00:00", "2021-03-14 11:00:00", "PT22H"},
+		{"2021-11-06 12:00:00", "2021-11-07 12:00:00", "P1D"},
+		{"2021-11-06 12:00:00", "2021-11-07 11:30:00", "PT24H30M"},
+		{"2021-03-13 02:30:00", "2021-03-14 03:30:00", "P1D"},
+		{"2021-05-01 00:00:00", "2021-05-01 00:00:00", "PT0S"},
+		{"2021-03-30 10:30:00", "2021-01-31 09:00:00", "P-1M-30DT-1H-30M"},
+	}
+
+	for i, tc := range testCases {
+
+		start, ok := testNewRecurrenceDateTz(t, tc.start, chicago)
+
+		if !ok {
+			return
+		}
+
+		end, ok := testNewRecurrenceDateTz(t, tc.end, chicago)
+
+		if !ok {
+			return
+		}
+
+		period, err := Period{}.PeriodBetween(start, end, ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by Period{}.PeriodBetween()\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if period.String() != tc.expected {
+			t.Errorf("Test Case #%v Error: PeriodBetween(%v, %v)\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				i, tc.start, tc.end, tc.expected, period.String())
+			continue
+		}
+
+		if end.GetDateTimeValue().Before(start.GetDateTimeValue()) {
+			continue
+		}
+
+		result, err := period.AddToDateTz(start, EndOfMonthMode.Clamp(), ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by period.AddToDateTz()\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if !result.GetDateTimeValue().Equal(end.GetDateTimeValue()) {
+			t.Errorf("Test Case #%v Error: Expected start + period = end.\n"+
+				"Expected='%v'\n  Actual='%v'\n", i,
+				end.GetDateTimeValue().Format(testRecurrenceFmtStr),
+				result.GetDateTimeValue().Format(testRecurrenceFmtStr))
+		}
+	}
+
+	// The end date time is converted to the start time zone.
+	start, ok := testNewRecurrenceDateTz(t, "2021-03-13 12:00:00", chicago)
+
+	if !ok {
+		return
+	}
+
+	end, err := DateTzDto{}.NewDateTime(
+		start.GetDateTimeValue().Add(23*time.Hour).UTC(),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	period, err := Period{}.PeriodBetween(start, end, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by Period{}.PeriodBetween(UTC)\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if period.String() != "P1D" {
+		t.Errorf("Error: Expected PeriodBetween(CST, UTC)='P1D'. Instead, "+
+			"period='%v'\n", period.String())
+	}
+}
+
+func TestPeriod03(t *testing.T) {
+
+	ePrefix := "TestPeriod03() "
+
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{"P1Y2M3DT4H5M6.5S", "P1Y2M3DT4H5M6.5S"},
+		{"P2W", "P14D"},
+		{"PT36H", "PT36H"},
+		{"-P1M", "P-1M"},
+		{"P1Y-2M", "P1Y-2M"},
+		{"PT0.000000001S", "PT0.000000001S"},
+		{"PT-0.5S", "PT-0.5S"},
+		{"pt1,25s", "PT1.25S"},
+		{"P0D", "PT0S"},
+	}
+
+	for i, tc := range testCases {
+
+		period, err := Period{}.NewFromString(tc.input, ePrefix)
+
+		if err != nil {
+			t.Errorf("Test Case #%v Error returned by Period{}.NewFromString(%v)\n"+
+				"Error='%v'\n", i, tc.input, err.Error())
+			continue
+		}
+
+		if period.String() != tc.expected {
+			t.Errorf("Test Case #%v Error: NewFromString(%v)\n"+
+				"Expected='%v'\n  Actual='%v'\n",
+				i, tc.input, tc.expected, period.String())
+		}
+	}
+
+	period, err := Period{}.NewFromString("-PT1H30M", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by Period{}.NewFromString()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if period.GetClockDuration() != -90*time.Minute {
+		t.Errorf("Error: Expected clock duration='-1h30m0s'. Instead, "+
+			"duration='%v'\n", period.GetClockDuration())
+	}
+
+	negated := period.Negate()
+
+	if !negated.Equal(Period{}.New(0, 0, 0, 1, 30, 0, 0)) {
+		t.Errorf("Error: Expected negated period='PT1H30M'. Instead, "+
+			"period='%v'\n", negated.String())
+	}
+
+	invalidInputs := []string{
+		"",
+		"P",
+		"PT",
+		"1Y",
+		"P1H",
+		"PT1D",
+		"P1M1Y",
+		"P1YT",
+		"P1.5Y",
+		"PT1.0000000001S",
+		"PXY",
+	}
+
+	for _, input := range invalidInputs {
+
+		_, err = Period{}.NewFromString(input, ePrefix)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error from NewFromString(%q). "+
+				"NO ERROR WAS RETURNED!", input)
+		}
+	}
+}
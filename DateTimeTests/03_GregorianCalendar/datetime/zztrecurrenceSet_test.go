@@ -0,0 +1,869 @@
+package datetime
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+const testRecurrenceFmtStr = "2006-01-02 15:04:05 -0700 MST"
+
+// testNewRecurrenceSet - Creates a RecurrenceSet from a start date
+// time string formatted as "2006-01-02 15:04:05" in time zone
+// 'timeZoneName' and a series of recurrence rule strings.
+func testNewRecurrenceSet(
+	t *testing.T,
+	dtStartStr string,
+	timeZoneName string,
+	calcMode TimeMathCalcMode,
+	rRules ...string) (RecurrenceSet, bool) {
+
+	ePrefix := "testNewRecurrenceSet() "
+
+	dTz, ok := testNewRecurrenceDateTz(t, dtStartStr, timeZoneName)
+
+	if !ok {
+		return RecurrenceSet{}, false
+	}
+
+	recurSet, err := RecurrenceSet{}.New(dTz, calcMode, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by RecurrenceSet{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return RecurrenceSet{}, false
+	}
+
+	for i := 0; i < len(rRules); i++ {
+
+		rRule, err := RecurrenceRule{}.NewFromString(rRules[i], ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by RecurrenceRule{}.NewFromString()\n"+
+				"rRule='%v'\n"+
+				"Error='%v'\n", rRules[i], err.Error())
+			return RecurrenceSet{}, false
+		}
+
+		err = recurSet.AddRRule(rRule, ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by recurSet.AddRRule()\n"+
+				"Error='%v'\n", err.Error())
+			return RecurrenceSet{}, false
+		}
+	}
+
+	return recurSet, true
+}
+
+// testNewRecurrenceDateTz - Creates a DateTzDto from a date time
+// string formatted as "2006-01-02 15:04:05" in time zone
+// 'timeZoneName'.
+func testNewRecurrenceDateTz(
+	t *testing.T,
+	dateTimeStr string,
+	timeZoneName string) (DateTzDto, bool) {
+
+	locPtr, err := time.LoadLocation(timeZoneName)
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation(%v)\n"+
+			"Error='%v'\n", timeZoneName, err.Error())
+		return DateTzDto{}, false
+	}
+
+	dateTime, err := time.ParseInLocation("2006-01-02 15:04:05", dateTimeStr, locPtr)
+
+	if err != nil {
+		t.Errorf("Error returned by time.ParseInLocation(%v)\n"+
+			"Error='%v'\n", dateTimeStr, err.Error())
+		return DateTzDto{}, false
+	}
+
+	dTz, err := DateTzDto{}.NewDateTime(dateTime, testRecurrenceFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+			"Error='%v'\n", err.Error())
+		return DateTzDto{}, false
+	}
+
+	return dTz, true
+}
+
+// testCompareOccurrences - Compares occurrences with expected date
+// time strings formatted with 'testRecurrenceFmtStr'.
+func testCompareOccurrences(
+	t *testing.T,
+	testName string,
+	occurrences []DateTzDto,
+	expected []string) {
+
+	if len(occurrences) != len(expected) {
+
+		actual := make([]string, len(occurrences))
+
+		for i := 0; i < len(occurrences); i++ {
+			actual[i] = occurrences[i].String()
+		}
+
+		t.Errorf("Error: %v\n"+
+			"Expected %v occurrences. Instead, received %v occurrences.\n"+
+			"Occurrences=\n%v\n",
+			testName, len(expected), len(occurrences), strings.Join(actual, "\n"))
+		return
+	}
+
+	for i := 0; i < len(expected); i++ {
+
+		if occurrences[i].String() != expected[i] {
+			t.Errorf("Error: %v Occurrence #%v\n"+
+				"Expected='%v'\n"+
+				"  Actual='%v'\n",
+				testName, i, expected[i], occurrences[i].String())
+		}
+	}
+}
+
+func TestRecurrenceSet01(t *testing.T) {
+
+	ePrefix := "TestRecurrenceSet01() "
+
+	// Examples from RFC 5545 Section 3.8.5.3. All examples
+	// begin in America/New_York.
+	testCases := []struct {
+		dtStart  string
+		rRule    string
+		expected []string
+	}{
+		{
+			"1997-09-02 09:00:00",
+			"RRULE:FREQ=DAILY;COUNT=5",
+			[]string{
+				"1997-09-02 09:00:00 -0400 EDT",
+				"1997-09-03 09:00:00 -0400 EDT",
+				"1997-09-04 09:00:00 -0400 EDT",
+				"1997-09-05 09:00:00 -0400 EDT",
+				"1997-09-06 09:00:00 -0400 EDT",
+			},
+		},
+		{
+			// Every 10 days. Crosses the end of Daylight Saving Time
+			// on October 26, 1997.
+			"1997-09-02 09:00:00",
+			"FREQ=DAILY;INTERVAL=10;COUNT=7",
+			[]string{
+				"1997-09-02 09:00:00 -0400 EDT",
+				"1997-09-12 09:00:00 -0400 EDT",
+				"1997-09-22 09:00:00 -0400 EDT",
+				"1997-10-02 09:00:00 -0400 EDT",
+				"1997-10-12 09:00:00 -0400 EDT",
+				"1997-10-22 09:00:00 -0400 EDT",
+				"1997-11-01 09:00:00 -0500 EST",
+			},
+		},
+		{
+			"1997-09-02 09:00:00",
+			"FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,TH;COUNT=8",
+			[]string{
+				"1997-09-02 09:00:00 -0400 EDT",
+				"1997-09-04 09:00:00 -0400 EDT",
+				"1997-09-16 09:00:00 -0400 EDT",
+				"1997-09-18 09:00:00 -0400 EDT",
+				"1997-09-30 09:00:00 -0400 EDT",
+				"1997-10-02 09:00:00 -0400 EDT",
+				"1997-10-14 09:00:00 -0400 EDT",
+				"1997-10-16 09:00:00 -0400 EDT",
+			},
+		},
+		{
+			// WKST changes the result
+			"1997-08-05 09:00:00",
+			"FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=MO",
+			[]string{
+				"1997-08-05 09:00:00 -0400 EDT",
+				"1997-08-10 09:00:00 -0400 EDT",
+				"1997-08-19 09:00:00 -0400 EDT",
+				"1997-08-24 09:00:00 -0400 EDT",
+			},
+		},
+		{
+			"1997-08-05 09:00:00",
+			"FREQ=WEEKLY;INTERVAL=2;COUNT=4;BYDAY=TU,SU;WKST=SU",
+			[]string{
+				"1997-08-05 09:00:00 -0400 EDT",
+				"1997-08-17 09:00:00 -0400 EDT",
+				"1997-08-19 09:00:00 -0400 EDT",
+				"1997-08-31 09:00:00 -0400 EDT",
+			},
+		},
+		{
+			"1997-09-05 09:00:00",
+			"FREQ=MONTHLY;COUNT=6;BYDAY=1FR",
+			[]string{
+				"1997-09-05 09:00:00 -0400 EDT",
+				"1997-10-03 09:00:00 -0400 EDT",
+				"1997-11-07 09:00:00 -0500 EST",
+				"1997-12-05 09:00:00 -0500 EST",
+				"1998-01-02 09:00:00 -0500 EST",
+				"1998-02-06 09:00:00 -0500 EST",
+			},
+		},
+		{
+			"1997-09-28 09:00:00",
+			"FREQ=MONTHLY;BYMONTHDAY=-3;COUNT=6",
+			[]string{
+				"1997-09-28 09:00:00 -0400 EDT",
+				"1997-10-29 09:00:00 -0500 EST",
+				"1997-11-28 09:00:00 -0500 EST",
+				"1997-12-29 09:00:00 -0500 EST",
+				"1998-01-29 09:00:00 -0500 EST",
+				"1998-02-26 09:00:00 -0500 EST",
+			},
+		},
+		{
+			// The last work day of the month
+			"1997-09-30 09:00:00",
+			"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=6",
+			[]string{
+				"1997-09-30 09:00:00 -0400 EDT",
+				"1997-10-31 09:00:00 -0500 EST",
+				"1997-11-28 09:00:00 -0500 EST",
+				"1997-12-31 09:00:00 -0500 EST",
+				"1998-01-30 09:00:00 -0500 EST",
+				"1998-02-27 09:00:00 -0500 EST",
+			},
+		},
+		{
+			"1997-09-04 09:00:00",
+			"FREQ=MONTHLY;COUNT=3;BYDAY=TU,WE,TH;BYSETPOS=3",
+			[]string{
+				"1997-09-04 09:00:00 -0400 EDT",
+				"1997-10-07 09:00:00 -0400 EDT",
+				"1997-11-06 09:00:00 -0500 EST",
+			},
+		},
+		{
+			// Invalid dates, such as February 30th, are skipped
+			"2007-01-15 09:00:00",
+			"FREQ=MONTHLY;BYMONTHDAY=15,30;COUNT=5",
+			[]string{
+				"2007-01-15 09:00:00 -0500 EST",
+				"2007-01-30 09:00:00 -0500 EST",
+				"2007-02-15 09:00:00 -0500 EST",
+				"2007-03-15 09:00:00 -0400 EDT",
+				"2007-03-30 09:00:00 -0400 EDT",
+			},
+		},
+		{
+			"1997-01-01 09:00:00",
+			"FREQ=YEARLY;INTERVAL=3;COUNT=10;BYYEARDAY=1,100,200",
+			[]string{
+				"1997-01-01 09:00:00 -0500 EST",
+				"1997-04-10 09:00:00 -0400 EDT",
+				"1997-07-19 09:00:00 -0400 EDT",
+				"2000-01-01 09:00:00 -0500 EST",
+				"2000-04-09 09:00:00 -0400 EDT",
+				"2000-07-18 09:00:00 -0400 EDT",
+				"2003-01-01 09:00:00 -0500 EST",
+				"2003-04-10 09:00:00 -0400 EDT",
+				"2003-07-19 09:00:00 -0400 EDT",
+				"2006-01-01 09:00:00 -0500 EST",
+			},
+		},
+		{
+			"1997-05-12 09:00:00",
+			"FREQ=YEARLY;BYWEEKNO=20;BYDAY=MO;COUNT=3",
+			[]string{
+				"1997-05-12 09:00:00 -0400 EDT",
+				"1998-05-11 09:00:00 -0400 EDT",
+				"1999-05-17 09:00:00 -0400 EDT",
+			},
+		},
+		{
+			// US Presidential Election Day
+			"1996-11-05 09:00:00",
+			"FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYDAY=TU;BYMONTHDAY=2,3,4,5,6,7,8;COUNT=3",
+			[]string{
+				"1996-11-05 09:00:00 -0500 EST",
+				"2000-11-07 09:00:00 -0500 EST",
+				"2004-11-02 09:00:00 -0500 EST",
+			},
+		},
+		{
+			// Thanksgiving Day
+			"2020-11-26 12:00:00",
+			"FREQ=YEARLY;BYMONTH=11;BYDAY=4TH;UNTIL=20231231",
+			[]string{
+				"2020-11-26 12:00:00 -0500 EST",
+				"2021-11-25 12:00:00 -0500 EST",
+				"2022-11-24 12:00:00 -0500 EST",
+				"2023-11-23 12:00:00 -0500 EST",
+			},
+		},
+		{
+			// The 20th Monday of the year
+			"1997-05-19 09:00:00",
+			"FREQ=YEARLY;BYDAY=20MO;COUNT=3",
+			[]string{
+				"1997-05-19 09:00:00 -0400 EDT",
+				"1998-05-18 09:00:00 -0400 EDT",
+				"1999-05-17 09:00:00 -0400 EDT",
+			},
+		},
+		{
+			// Leap day
+			"2020-02-29 09:00:00",
+			"FREQ=YEARLY;COUNT=3",
+			[]string{
+				"2020-02-29 09:00:00 -0500 EST",
+				"2024-02-29 09:00:00 -0500 EST",
+				"2028-02-29 09:00:00 -0500 EST",
+			},
+		},
+		{
+			"1997-09-02 09:00:00",
+			"FREQ=HOURLY;INTERVAL=3;UNTIL=19970902T190000Z",
+			[]string{
+				"1997-09-02 09:00:00 -0400 EDT",
+				"1997-09-02 12:00:00 -0400 EDT",
+				"1997-09-02 15:00:00 -0400 EDT",
+			},
+		},
+		{
+			"1997-09-02 09:00:00",
+			"FREQ=MINUTELY;INTERVAL=90;COUNT=4",
+			[]string{
+				"1997-09-02 09:00:00 -0400 EDT",
+				"1997-09-02 10:30:00 -0400 EDT",
+				"1997-09-02 12:00:00 -0400 EDT",
+				"1997-09-02 13:30:00 -0400 EDT",
+			},
+		},
+		{
+			"1997-09-02 09:00:00",
+			"FREQ=DAILY;BYHOUR=9,10,11,12,13,14,15,16;BYMINUTE=0,20,40;COUNT=4",
+			[]string{
+				"1997-09-02 09:00:00 -0400 EDT",
+				"1997-09-02 09:20:00 -0400 EDT",
+				"1997-09-02 09:40:00 -0400 EDT",
+				"1997-09-02 10:00:00 -0400 EDT",
+			},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+
+		recurSet, ok := testNewRecurrenceSet(
+			t,
+			testCases[i].dtStart,
+			TZones.America.New_York(),
+			TCalcMode.LocalTimeZone(),
+			testCases[i].rRule)
+
+		if !ok {
+			continue
+		}
+
+		occurrences, err := recurSet.GetOccurrences(0, ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+				"Test Case #%v rRule='%v'\n"+
+				"Error='%v'\n", i, testCases[i].rRule, err.Error())
+			continue
+		}
+
+		testCompareOccurrences(
+			t,
+			"Test Case #"+testCases[i].rRule,
+			occurrences,
+			testCases[i].expected)
+	}
+}
+
+func TestRecurrenceSet02(t *testing.T) {
+
+	ePrefix := "TestRecurrenceSet02() "
+
+	// Local Time Zone: The local time of day is retained. 02:30 does
+	// not exist on March 14, 2021 in America/Chicago and is shifted
+	// forward by the length of the gap. 01:30 occurs twice on November
+	// 7, 2021 and resolves to the first occurrence.
+	recurSet, ok := testNewRecurrenceSet(
+		t,
+		"2021-03-13 02:30:00",
+		TZones.America.Chicago(),
+		TCalcMode.LocalTimeZone(),
+		"FREQ=DAILY;COUNT=3")
+
+	if !ok {
+		return
+	}
+
+	occurrences, err := recurSet.GetOccurrences(0, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testCompareOccurrences(
+		t,
+		"Local Time Zone - Spring Forward",
+		occurrences,
+		[]string{
+			"2021-03-13 02:30:00 -0600 CST",
+			"2021-03-14 03:30:00 -0500 CDT",
+			"2021-03-15 02:30:00 -0500 CDT",
+		})
+
+	recurSet, ok = testNewRecurrenceSet(
+		t,
+		"2021-11-06 01:30:00",
+		TZones.America.Chicago(),
+		TCalcMode.LocalTimeZone(),
+		"FREQ=DAILY;COUNT=3")
+
+	if !ok {
+		return
+	}
+
+	occurrences, err = recurSet.GetOccurrences(0, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testCompareOccurrences(
+		t,
+		"Local Time Zone - Fall Back",
+		occurrences,
+		[]string{
+			"2021-11-06 01:30:00 -0500 CDT",
+			"2021-11-07 01:30:00 -0500 CDT",
+			"2021-11-08 01:30:00 -0600 CST",
+		})
+
+	// UTC Time Zone: Every day is 24-hours in length. The local
+	// time of day shifts with the UTC offset.
+	recurSet, ok = testNewRecurrenceSet(
+		t,
+		"2021-03-13 09:00:00",
+		TZones.America.Chicago(),
+		TCalcMode.UtcTimeZone(),
+		"FREQ=DAILY;COUNT=3")
+
+	if !ok {
+		return
+	}
+
+	occurrences, err = recurSet.GetOccurrences(0, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testCompareOccurrences(
+		t,
+		"UTC Time Zone",
+		occurrences,
+		[]string{
+			"2021-03-13 09:00:00 -0600 CST",
+			"2021-03-14 10:00:00 -0500 CDT",
+			"2021-03-15 10:00:00 -0500 CDT",
+		})
+
+	// Hourly rules in local time skip the nonexistent hour
+	recurSet, ok = testNewRecurrenceSet(
+		t,
+		"2021-03-14 00:00:00",
+		TZones.America.Chicago(),
+		TCalcMode.LocalTimeZone(),
+		"FREQ=HOURLY;COUNT=4")
+
+	if !ok {
+		return
+	}
+
+	occurrences, err = recurSet.GetOccurrences(0, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testCompareOccurrences(
+		t,
+		"Local Time Zone - Hourly",
+		occurrences,
+		[]string{
+			"2021-03-14 00:00:00 -0600 CST",
+			"2021-03-14 01:00:00 -0600 CST",
+			"2021-03-14 03:00:00 -0500 CDT",
+			"2021-03-14 04:00:00 -0500 CDT",
+		})
+}
+
+func TestRecurrenceSet03(t *testing.T) {
+
+	ePrefix := "TestRecurrenceSet03() "
+
+	// Every Friday the 13th, excluding the start date time
+	recurSet, ok := testNewRecurrenceSet(
+		t,
+		"1997-09-02 09:00:00",
+		TZones.America.New_York(),
+		TCalcMode.LocalTimeZone(),
+		"FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13")
+
+	if !ok {
+		return
+	}
+
+	exDate, ok := testNewRecurrenceDateTz(t, "1997-09-02 09:00:00", TZones.America.New_York())
+
+	if !ok {
+		return
+	}
+
+	err := recurSet.AddExDate(exDate, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.AddExDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	occurrences, err := recurSet.GetOccurrences(5, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testCompareOccurrences(
+		t,
+		"Friday the 13th",
+		occurrences,
+		[]string{
+			"1998-02-13 09:00:00 -0500 EST",
+			"1998-03-13 09:00:00 -0500 EST",
+			"1998-11-13 09:00:00 -0500 EST",
+			"1999-08-13 09:00:00 -0400 EDT",
+			"2000-10-13 09:00:00 -0400 EDT",
+		})
+
+	// Week days only. The exclusion rule removes weekends. A
+	// recurrence date in another time zone is converted to the
+	// time zone of the recurrence set.
+	recurSet, ok = testNewRecurrenceSet(
+		t,
+		"2021-06-03 09:00:00",
+		TZones.America.Chicago(),
+		TCalcMode.LocalTimeZone(),
+		"FREQ=DAILY;COUNT=6")
+
+	if !ok {
+		return
+	}
+
+	exRule, err := RecurrenceRule{}.NewFromString("EXRULE:FREQ=WEEKLY;BYDAY=SA,SU", ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by RecurrenceRule{}.NewFromString()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = recurSet.AddExRule(exRule, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.AddExRule()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	rDate, ok := testNewRecurrenceDateTz(t, "2021-06-12 16:00:00", TZones.Europe.London())
+
+	if !ok {
+		return
+	}
+
+	err = recurSet.AddRDate(rDate, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.AddRDate()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	occurrences, err = recurSet.GetOccurrences(0, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testCompareOccurrences(
+		t,
+		"Exclusion Rule",
+		occurrences,
+		[]string{
+			"2021-06-03 09:00:00 -0500 CDT",
+			"2021-06-04 09:00:00 -0500 CDT",
+			"2021-06-07 09:00:00 -0500 CDT",
+			"2021-06-08 09:00:00 -0500 CDT",
+			"2021-06-12 10:00:00 -0500 CDT",
+		})
+
+	for i := 0; i < len(occurrences); i++ {
+		if occurrences[i].GetTimeZoneName() != TZones.America.Chicago() {
+			t.Errorf("Error: Expected occurrence time zone='%v'\n"+
+				"Instead, time zone='%v'\n",
+				TZones.America.Chicago(), occurrences[i].GetTimeZoneName())
+		}
+	}
+
+	// Unbounded rules require a window or a maximum
+	recurSet, ok = testNewRecurrenceSet(
+		t,
+		"2021-06-01 09:00:00",
+		TZones.America.Chicago(),
+		TCalcMode.LocalTimeZone(),
+		"FREQ=WEEKLY;BYDAY=TU")
+
+	if !ok {
+		return
+	}
+
+	_, err = recurSet.GetOccurrences(0, ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from GetOccurrences(0)\n" +
+			"for an unbounded recurrence rule.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	startDTz, _ := testNewRecurrenceDateTz(t, "2021-07-01 00:00:00", TZones.America.Chicago())
+
+	endDTz, _ := testNewRecurrenceDateTz(t, "2021-07-20 09:00:00", TZones.America.Chicago())
+
+	occurrences, err = recurSet.Between(startDTz, endDTz, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.Between()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testCompareOccurrences(
+		t,
+		"Between",
+		occurrences,
+		[]string{
+			"2021-07-06 09:00:00 -0500 CDT",
+			"2021-07-13 09:00:00 -0500 CDT",
+			"2021-07-20 09:00:00 -0500 CDT",
+		})
+
+	occurrence, found, err := recurSet.After(endDTz, false, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.After()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !found ||
+		occurrence.String() != "2021-07-27 09:00:00 -0500 CDT" {
+		t.Errorf("Error: Expected After()='2021-07-27 09:00:00 -0500 CDT'\n"+
+			"Instead, found='%v' After()='%v'\n", found, occurrence.String())
+	}
+
+	occurrence, found, err = recurSet.After(endDTz, true, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by recurSet.After()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !found ||
+		occurrence.String() != "2021-07-20 09:00:00 -0500 CDT" {
+		t.Errorf("Error: Expected inclusive After()='2021-07-20 09:00:00 -0500 CDT'\n"+
+			"Instead, found='%v' After()='%v'\n", found, occurrence.String())
+	}
+}
+
+func TestRecurrenceSet04(t *testing.T) {
+
+	ePrefix := "TestRecurrenceSet04() "
+
+	// The start date time does not match the rule. It counts as the
+	// first occurrence against 'COUNT'. RFC 5545 Section 3.3.10.
+	testCases := []struct {
+		title    string
+		dtStart  string
+		rRule    string
+		expected []string
+	}{
+		{
+			"Weekly Sunday",
+			"2021-03-01 02:30:00",
+			"FREQ=WEEKLY;BYDAY=SU;COUNT=3",
+			[]string{
+				"2021-03-01 02:30:00 -0600 CST",
+				"2021-03-07 02:30:00 -0600 CST",
+				"2021-03-14 03:30:00 -0500 CDT",
+			},
+		},
+		{
+			"Last Work Day Of Month",
+			"2021-01-31 09:00:00",
+			"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;COUNT=4",
+			[]string{
+				"2021-01-31 09:00:00 -0600 CST",
+				"2021-02-26 09:00:00 -0600 CST",
+				"2021-03-31 09:00:00 -0500 CDT",
+				"2021-04-30 09:00:00 -0500 CDT",
+			},
+		},
+		{
+			"Count Of One",
+			"2021-03-01 09:00:00",
+			"FREQ=WEEKLY;BYDAY=SU;COUNT=1",
+			[]string{
+				"2021-03-01 09:00:00 -0600 CST",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+
+		recurSet, ok := testNewRecurrenceSet(
+			t,
+			testCase.dtStart,
+			TZones.America.Chicago(),
+			TCalcMode.LocalTimeZone(),
+			testCase.rRule)
+
+		if !ok {
+			return
+		}
+
+		occurrences, err := recurSet.GetOccurrences(0, ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by recurSet.GetOccurrences()\n"+
+				"%v\nError='%v'\n", testCase.title, err.Error())
+			continue
+		}
+
+		testCompareOccurrences(t, testCase.title, occurrences, testCase.expected)
+	}
+}
+
+func TestRecurrenceRule01(t *testing.T) {
+
+	ePrefix := "TestRecurrenceRule01() "
+
+	roundTrips := []struct {
+		rRule    string
+		expected string
+	}{
+		{"RRULE:FREQ=DAILY;COUNT=10", "FREQ=DAILY;COUNT=10"},
+		{"freq=yearly;bymonth=11;byday=4th", "FREQ=YEARLY;BYDAY=4TH;BYMONTH=11"},
+		{"FREQ=WEEKLY;UNTIL=20211231T235959Z;INTERVAL=2;WKST=SU;BYDAY=TU,TH",
+			"FREQ=WEEKLY;UNTIL=20211231T235959Z;INTERVAL=2;BYDAY=TU,TH;WKST=SU"},
+		{"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1;INTERVAL=1",
+			"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1"},
+		{"FREQ=YEARLY;UNTIL=20301231;BYDAY=-1SU;BYMONTH=10",
+			"FREQ=YEARLY;UNTIL=20301231;BYDAY=-1SU;BYMONTH=10"},
+	}
+
+	for i := 0; i < len(roundTrips); i++ {
+
+		rRule, err := RecurrenceRule{}.NewFromString(roundTrips[i].rRule, ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by RecurrenceRule{}.NewFromString()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if rRule.String() != roundTrips[i].expected {
+			t.Errorf("Error: Test Case #%v\n"+
+				"Expected rRule.String()='%v'\n"+
+				"  Actual rRule.String()='%v'\n",
+				i, roundTrips[i].expected, rRule.String())
+		}
+	}
+
+	invalidRules := []string{
+		"",
+		"COUNT=5",
+		"FREQ=FORTNIGHTLY",
+		"FREQ=DAILY;FOO=1",
+		"FREQ=DAILY;COUNT=2;UNTIL=20210101",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;INTERVAL=-1",
+		"FREQ=DAILY;BYDAY=1MO",
+		"FREQ=DAILY;BYYEARDAY=10",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYWEEKNO=1",
+		"FREQ=MONTHLY;BYDAY=6MO",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=MONTHLY;BYMONTHDAY=0",
+		"FREQ=YEARLY;BYWEEKNO=54",
+		"FREQ=YEARLY;BYMONTH=13",
+		"FREQ=YEARLY;BYHOUR=24",
+		"FREQ=YEARLY;BYDAY=XX",
+		"FREQ=YEARLY;UNTIL=2021-01-01",
+		"FREQ=YEARLY;WKST=XX",
+		"FREQ=DAILY;FREQ=WEEKLY",
+	}
+
+	for i := 0; i < len(invalidRules); i++ {
+
+		_, err := RecurrenceRule{}.NewFromString(invalidRules[i], ePrefix)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error return from NewFromString()\n"+
+				"because rRule='%v' is INVALID.\n"+
+				"However, NO ERROR WAS RETURNED!\n", invalidRules[i])
+		}
+	}
+
+	_, err := RecurrenceSet{}.New(DateTzDto{}, TCalcMode.LocalTimeZone(), ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from RecurrenceSet{}.New()\n" +
+			"because 'dtStart' is empty.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	dTz, ok := testNewRecurrenceDateTz(t, "2021-06-01 09:00:00", TZones.America.Chicago())
+
+	if !ok {
+		return
+	}
+
+	_, err = RecurrenceSet{}.New(dTz, TCalcMode.None(), ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from RecurrenceSet{}.New()\n" +
+			"because 'calcMode' is None.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
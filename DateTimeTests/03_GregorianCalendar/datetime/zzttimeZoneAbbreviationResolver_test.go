@@ -0,0 +1,332 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeZoneAbbreviationResolver01(t *testing.T) {
+
+	ePrefix := "TestTimeZoneAbbreviationResolver01() "
+
+	testCases := []struct {
+		tzAbbrv        string
+		tzAbbrvContext TimeZoneAbbreviationContext
+		expectedId     string
+		expectedZones  []string
+	}{
+		{
+			"IST",
+			TimeZoneAbbreviationContext{PreferredCountry: "IN"},
+			"IST+0530",
+			[]string{"Asia/Calcutta", "Asia/Kolkata"},
+		},
+		{
+			"IST",
+			TimeZoneAbbreviationContext{PreferredCountry: "ie"},
+			"IST+0100",
+			[]string{"Europe/Dublin", "Eire"},
+		},
+		{
+			"IST",
+			TimeZoneAbbreviationContext{PreferredCountry: "IL"},
+			"IST+0200",
+			[]string{"Asia/Jerusalem", "Asia/Tel_Aviv", "Israel"},
+		},
+		{
+			"CST",
+			TimeZoneAbbreviationContext{
+				PreferredRegion:   "Asia",
+				ReferenceDateTime: time.Date(2021, 7, 1, 0, 0, 0, 0, time.UTC)},
+			"CST+0800",
+			[]string{"Asia/Shanghai"},
+		},
+		{
+			"CST",
+			TimeZoneAbbreviationContext{
+				PreferredRegion:   "North America",
+				ReferenceDateTime: time.Date(2021, 1, 15, 0, 0, 0, 0, time.UTC)},
+			"CST-0600",
+			[]string{"America/Chicago"},
+		},
+		{
+			"cst",
+			TimeZoneAbbreviationContext{
+				CandidateTimeZones: []string{"America/Havana", "Europe/Paris"}},
+			"CST-0500",
+			[]string{"America/Havana"},
+		},
+		{
+			"IST+0530",
+			TimeZoneAbbreviationContext{},
+			"IST+0530",
+			[]string{"Asia/Calcutta", "Asia/Kolkata"},
+		},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+
+		tzMech := TimeZoneMechanics{}
+
+		candidates, err := tzMech.ResolveTzAbbreviation(
+			testCases[i].tzAbbrv,
+			testCases[i].tzAbbrvContext,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by tzMech.ResolveTzAbbreviation()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if len(candidates) == 0 {
+			t.Errorf("Error: Test Case #%v returned zero candidates!\n", i)
+			continue
+		}
+
+		if candidates[0].Abbreviation.Id != testCases[i].expectedId {
+			t.Errorf("Error: Test Case #%v\n"+
+				"Expected top candidate abbreviation='%v'\n"+
+				"  Actual top candidate abbreviation='%v'\n",
+				i, testCases[i].expectedId, candidates[0].Abbreviation.Id)
+		}
+
+		isExpectedZone := false
+
+		for j := 0; j < len(testCases[i].expectedZones); j++ {
+			if candidates[0].TimeZoneName == testCases[i].expectedZones[j] {
+				isExpectedZone = true
+			}
+		}
+
+		if !isExpectedZone {
+			t.Errorf("Error: Test Case #%v\n"+
+				"Expected top candidate time zone to be one of '%v'\n"+
+				"Instead, top candidate time zone='%v'\n",
+				i, testCases[i].expectedZones, candidates[0].TimeZoneName)
+		}
+
+		for j := 0; j < len(candidates); j++ {
+
+			if candidates[j].Confidence < 0.0 ||
+				candidates[j].Confidence > 1.0 {
+				t.Errorf("Error: Test Case #%v Candidate #%v\n"+
+					"Confidence is out of range. Confidence='%v'\n",
+					i, j, candidates[j].Confidence)
+			}
+
+			if j > 0 &&
+				candidates[j].Confidence > candidates[j-1].Confidence {
+				t.Errorf("Error: Test Case #%v\n"+
+					"Candidates are NOT ranked by descending confidence.\n"+
+					"Candidate #%v Confidence='%v'\n"+
+					"Candidate #%v Confidence='%v'\n",
+					i, j-1, candidates[j-1].Confidence, j, candidates[j].Confidence)
+			}
+		}
+	}
+}
+
+func TestTimeZoneAbbreviationResolver02(t *testing.T) {
+
+	ePrefix := "TestTimeZoneAbbreviationResolver02() "
+
+	tzMech := TimeZoneMechanics{}
+
+	// Dublin observes 'IST' in summer and 'GMT' in winter.
+	candidates, err := tzMech.ResolveTzAbbreviation(
+		"IST",
+		TimeZoneAbbreviationContext{
+			CandidateTimeZones: []string{"Europe/Dublin"},
+			ReferenceDateTime:  time.Date(2021, 1, 15, 12, 0, 0, 0, time.UTC)},
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzMech.ResolveTzAbbreviation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(candidates) != 1 {
+		t.Errorf("Error: Expected 1 candidate. Instead, received %v candidates.\n",
+			len(candidates))
+		return
+	}
+
+	if candidates[0].IsInEffect {
+		t.Error("Error: Expected 'IST' NOT in effect for Europe/Dublin in January.\n" +
+			"Instead, IsInEffect='true'\n")
+	}
+
+	winterConfidence := candidates[0].Confidence
+
+	candidates, err = tzMech.ResolveTzAbbreviation(
+		"IST",
+		TimeZoneAbbreviationContext{
+			CandidateTimeZones: []string{"Europe/Dublin"},
+			ReferenceDateTime:  time.Date(2021, 7, 15, 12, 0, 0, 0, time.UTC)},
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzMech.ResolveTzAbbreviation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if !candidates[0].IsInEffect {
+		t.Error("Error: Expected 'IST' in effect for Europe/Dublin in July.\n" +
+			"Instead, IsInEffect='false'\n")
+	}
+
+	if candidates[0].Confidence <= winterConfidence {
+		t.Errorf("Error: Expected July confidence > January confidence.\n"+
+			"July Confidence='%v'\nJanuary Confidence='%v'\n",
+			candidates[0].Confidence, winterConfidence)
+	}
+
+	_, err = tzMech.ResolveTzAbbreviation(
+		"QQQ",
+		TimeZoneAbbreviationContext{},
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ResolveTzAbbreviation()\n" +
+			"because abbreviation 'QQQ' is unknown.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = tzMech.ResolveTzAbbreviation(
+		"IST",
+		TimeZoneAbbreviationContext{PreferredCountry: "XX"},
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ResolveTzAbbreviation()\n" +
+			"because country code 'XX' is unknown.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = tzMech.ResolveTzAbbreviation(
+		"IST",
+		TimeZoneAbbreviationContext{CandidateTimeZones: []string{"America/Chicago"}},
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from ResolveTzAbbreviation()\n" +
+			"because no candidate time zone uses 'IST'.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestTimeZoneAbbreviationRegistry01(t *testing.T) {
+
+	ePrefix := "TestTimeZoneAbbreviationRegistry01() "
+
+	stdAbbrvs := StdTZoneAbbreviations{}
+
+	err := stdAbbrvs.RegisterTzAbbreviation(
+		TimeZoneAbbreviationDto{
+			Abbrv:            "ZQT",
+			AbbrvDescription: "Test Registered Time",
+			Location:         "Asia",
+			UtcOffset:        "+0330"},
+		[]string{"Asia/Tehran"})
+
+	if err != nil {
+		t.Errorf("Error returned by stdAbbrvs.RegisterTzAbbreviation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	abbrvDto, ok := stdAbbrvs.AbbrvOffsetToTzReference("ZQT+0330")
+
+	if !ok ||
+		abbrvDto.Id != "ZQT+0330" {
+		t.Errorf("Error: Expected registered abbreviation 'ZQT+0330'.\n"+
+			"ok='%v' Id='%v'\n", ok, abbrvDto.Id)
+	}
+
+	tzAbbrvs, ok := stdAbbrvs.TimeZonesToAbbrvs("Asia/Tehran")
+
+	isFound := false
+
+	for i := 0; ok && i < len(tzAbbrvs); i++ {
+		if tzAbbrvs[i] == "ZQT+0330" {
+			isFound = true
+		}
+	}
+
+	if !isFound {
+		t.Errorf("Error: Expected 'ZQT+0330' in abbreviations for Asia/Tehran.\n"+
+			"Abbreviations='%v'\n", tzAbbrvs)
+	}
+
+	tzMech := TimeZoneMechanics{}
+
+	candidates, err := tzMech.ResolveTzAbbreviation(
+		"ZQT",
+		TimeZoneAbbreviationContext{},
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzMech.ResolveTzAbbreviation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if len(candidates) != 1 ||
+		candidates[0].TimeZoneName != "Asia/Tehran" {
+		t.Errorf("Error: Expected a single candidate 'Asia/Tehran'.\n"+
+			"Candidates='%v'\n", candidates)
+	}
+
+	tzSpec, err := tzMech.ConvertTzAbbreviationToTimeZone(
+		time.Date(2021, 1, 15, 12, 0, 0, 0, time.UTC),
+		TzConvertType.Absolute(),
+		"ZQT+0330",
+		"",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzMech.ConvertTzAbbreviationToTimeZone()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if tzSpec.GetLocationName() != "Asia/Tehran" {
+		t.Errorf("Error: Expected location name='Asia/Tehran'.\n"+
+			"Instead, location name='%v'\n", tzSpec.GetLocationName())
+	}
+
+	invalidRegistrations := []struct {
+		tzAbbrv   TimeZoneAbbreviationDto
+		timeZones []string
+	}{
+		{TimeZoneAbbreviationDto{Abbrv: "", UtcOffset: "+0100"}, []string{"Europe/Paris"}},
+		{TimeZoneAbbreviationDto{Abbrv: "ZQU", UtcOffset: "0100"}, []string{"Europe/Paris"}},
+		{TimeZoneAbbreviationDto{Abbrv: "ZQU", UtcOffset: "+01:00"}, []string{"Europe/Paris"}},
+		{TimeZoneAbbreviationDto{Id: "ZQV+0100", Abbrv: "ZQU", UtcOffset: "+0100"}, []string{"Europe/Paris"}},
+		{TimeZoneAbbreviationDto{Abbrv: "ZQU", UtcOffset: "+0100"}, []string{}},
+		{TimeZoneAbbreviationDto{Abbrv: "ZQU", UtcOffset: "+0100"}, []string{"Europe/Atlantis"}},
+	}
+
+	for i := 0; i < len(invalidRegistrations); i++ {
+
+		err = stdAbbrvs.RegisterTzAbbreviation(
+			invalidRegistrations[i].tzAbbrv,
+			invalidRegistrations[i].timeZones)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error return from RegisterTzAbbreviation()\n"+
+				"Test Case #%v\n"+
+				"However, NO ERROR WAS RETURNED!\n", i)
+		}
+	}
+
+	_, ok = stdAbbrvs.AbbrvOffsetToTzReference("ZQU+0100")
+
+	if ok {
+		t.Error("Error: Invalid registration 'ZQU+0100' was added to the reference map!\n")
+	}
+}
@@ -0,0 +1,616 @@
+package datetime
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testBuildTzifData - Builds a TZif file with the specified version
+// byte, transitions and local time types. For version 2+ files, the
+// version 1 data block is written with the same content as the
+// 64-bit data block and the footer TZ string is appended.
+func testBuildTzifData(
+	version byte,
+	transitionTimes []int64,
+	transitionTypes []byte,
+	utcOffsets []int32,
+	isDsts []byte,
+	abbreviations []string,
+	footer string) []byte {
+
+	designations := make([]byte, 0)
+	designationIdxs := make([]byte, len(abbreviations))
+
+	for i := 0; i < len(abbreviations); i++ {
+		designationIdxs[i] = byte(len(designations))
+		designations = append(designations, []byte(abbreviations[i])...)
+		designations = append(designations, 0)
+	}
+
+	writeBlock := func(buf *bytes.Buffer, timeSize int) {
+
+		buf.WriteString("TZif")
+		buf.WriteByte(version)
+		buf.Write(make([]byte, 15))
+
+		counts := []uint32{
+			0, // isutcnt
+			0, // isstdcnt
+			0, // leapcnt
+			uint32(len(transitionTimes)),
+			uint32(len(utcOffsets)),
+			uint32(len(designations)),
+		}
+
+		for _, count := range counts {
+			_ = binary.Write(buf, binary.BigEndian, count)
+		}
+
+		for _, transitionTime := range transitionTimes {
+			if timeSize == 4 {
+				_ = binary.Write(buf, binary.BigEndian, int32(transitionTime))
+			} else {
+				_ = binary.Write(buf, binary.BigEndian, transitionTime)
+			}
+		}
+
+		buf.Write(transitionTypes)
+
+		for i := 0; i < len(utcOffsets); i++ {
+			_ = binary.Write(buf, binary.BigEndian, utcOffsets[i])
+			buf.WriteByte(isDsts[i])
+			buf.WriteByte(designationIdxs[i])
+		}
+
+		buf.Write(designations)
+	}
+
+	buf := new(bytes.Buffer)
+
+	writeBlock(buf, 4)
+
+	if version != 0 {
+		writeBlock(buf, 8)
+		buf.WriteString("\n" + footer + "\n")
+	}
+
+	return buf.Bytes()
+}
+
+// testReadEmbeddedZoneInfo - Returns the raw TZif data for a time
+// zone in the embedded zoneinfo.zip archive.
+func testReadEmbeddedZoneInfo(
+	zoneName string) ([]byte, error) {
+
+	zipReader, err := zip.NewReader(
+		bytes.NewReader(embeddedZoneInfoZip),
+		int64(len(embeddedZoneInfoZip)))
+
+	if err != nil {
+		return nil, err
+	}
+
+	fileReader, err := zipReader.Open(zoneName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer fileReader.Close()
+
+	return io.ReadAll(fileReader)
+}
+
+func TestTimeZoneDatabase01(t *testing.T) {
+
+	ePrefix := "TestTimeZoneDatabase01() "
+
+	tzDatabase, err := TimeZoneDatabase{}.New(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDatabase{}.New()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if tzDatabase.GetVersion() != "2026c" {
+		t.Errorf("Error: Expected tzdata version '2026c'.\n"+
+			"Instead, version='%v'\n", tzDatabase.GetVersion())
+	}
+
+	if tzDatabase.GetSourceName() != "Embedded zoneinfo.zip" {
+		t.Errorf("Error: Expected source name 'Embedded zoneinfo.zip'.\n"+
+			"Instead, source name='%v'\n", tzDatabase.GetSourceName())
+	}
+
+	zoneNames := tzDatabase.GetTimeZoneNames()
+
+	if len(zoneNames) < 500 {
+		t.Errorf("Error: Expected more than 500 time zone names.\n"+
+			"Instead, there are %v time zone names.\n", len(zoneNames))
+	}
+
+	for _, zoneName := range []string{
+		TZones.America.Chicago(),
+		TZones.Asia.Tokyo(),
+		TZones.Europe.London(),
+		"Etc/GMT+5",
+	} {
+
+		if !tzDatabase.HasTimeZone(zoneName) {
+			t.Errorf("Error: Expected time zone '%v' in the embedded database.\n",
+				zoneName)
+		}
+	}
+
+	if tzDatabase.HasTimeZone("+VERSION") {
+		t.Error("Error: '+VERSION' should NOT be a time zone name.\n")
+	}
+
+	locPtr, err := tzDatabase.LoadLocation(TZones.America.Chicago(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDatabase.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testTimes := []struct {
+		dateTime     time.Time
+		abbreviation string
+		offset       int
+	}{
+		{time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC), "CDT", -18000},
+		{time.Date(2020, 12, 1, 12, 0, 0, 0, time.UTC), "CST", -21600},
+		// Instants following the last transition rely on the footer TZ string.
+		{time.Date(2100, 7, 1, 12, 0, 0, 0, time.UTC), "CDT", -18000},
+	}
+
+	for i := 0; i < len(testTimes); i++ {
+
+		abbreviation, offset := testTimes[i].dateTime.In(locPtr).Zone()
+
+		if abbreviation != testTimes[i].abbreviation ||
+			offset != testTimes[i].offset {
+			t.Errorf("Error: Test Time #%v\n"+
+				"Expected Zone='%v %v'\n"+
+				"  Actual Zone='%v %v'\n",
+				i,
+				testTimes[i].abbreviation,
+				testTimes[i].offset,
+				abbreviation,
+				offset)
+		}
+	}
+
+	locPtr, err = tzDatabase.LoadLocation(TZones.UTC(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDatabase.LoadLocation(UTC)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if locPtr != time.UTC {
+		t.Error("Error: Expected LoadLocation(\"UTC\") to return time.UTC.\n")
+	}
+
+	_, err = tzDatabase.LoadLocation("America/Atlantis", ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from LoadLocation()\n" +
+			"because the time zone name is INVALID.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	tzifData, err := tzDatabase.GetTzifData(TZones.America.New_York(), ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzDatabase.GetTzifData()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if tzifData.GetVersion() < 2 {
+		t.Errorf("Error: Expected TZif version 2 or higher.\n"+
+			"Instead, version='%v'\n", tzifData.GetVersion())
+	}
+
+	if tzifData.GetFooterTzString() != "EST5EDT,M3.2.0,M11.1.0" {
+		t.Errorf("Error: Expected footer TZ string 'EST5EDT,M3.2.0,M11.1.0'.\n"+
+			"Instead, footer='%v'\n", tzifData.GetFooterTzString())
+	}
+
+	transitions := tzifData.GetTransitions()
+
+	if len(transitions) == 0 {
+		t.Error("Error: Expected 'America/New_York' transitions.\n")
+		return
+	}
+
+	// 2006-10-29 06:00:00 UTC: EDT -> EST. Later transitions are
+	// described by the footer TZ string.
+	foundTransition := false
+
+	for i := 0; i < len(transitions); i++ {
+
+		if transitions[i].TransitionTime == 1162101600 {
+
+			foundTransition = true
+
+			if transitions[i].LocalTimeType.Abbreviation != "EST" ||
+				transitions[i].LocalTimeType.UtcOffsetSeconds != -18000 ||
+				transitions[i].LocalTimeType.IsDst {
+				t.Errorf("Error: Expected transition to 'EST -18000'.\n"+
+					"Instead, transition='%v %v' IsDst='%v'\n",
+					transitions[i].LocalTimeType.Abbreviation,
+					transitions[i].LocalTimeType.UtcOffsetSeconds,
+					transitions[i].LocalTimeType.IsDst)
+			}
+		}
+	}
+
+	if !foundTransition {
+		t.Error("Error: Expected a 'America/New_York' transition at 2006-10-29 06:00:00 UTC.\n")
+	}
+}
+
+func TestTzifMechanics01(t *testing.T) {
+
+	ePrefix := "TestTzifMechanics01() "
+
+	tzifMech := tzifMechanics{}
+
+	// Version 1
+	v1Data := testBuildTzifData(
+		0,
+		[]int64{-1000, 1000},
+		[]byte{1, 0},
+		[]int32{3600, 7200},
+		[]byte{0, 1},
+		[]string{"TST", "TDT"},
+		"")
+
+	tzifData, err := tzifMech.parseTzifData("Test/Zone", v1Data, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzifMech.parseTzifData(v1)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if tzifData.GetVersion() != 1 ||
+		tzifData.GetFooterTzString() != "" ||
+		tzifData.GetZoneName() != "Test/Zone" {
+		t.Errorf("Error: Expected version 1, no footer and zone name 'Test/Zone'.\n"+
+			"Instead, version='%v' footer='%v' zone name='%v'\n",
+			tzifData.GetVersion(),
+			tzifData.GetFooterTzString(),
+			tzifData.GetZoneName())
+	}
+
+	transitions := tzifData.GetTransitions()
+
+	if len(transitions) != 2 ||
+		transitions[0].TransitionTime != -1000 ||
+		transitions[0].LocalTimeType.Abbreviation != "TDT" ||
+		!transitions[0].LocalTimeType.IsDst ||
+		transitions[1].LocalTimeType.UtcOffsetSeconds != 3600 {
+		t.Errorf("Error: v1 transitions are INVALID.\n"+
+			"transitions='%v'\n", transitions)
+	}
+
+	// Version 3 with a 64-bit transition time
+	v3Data := testBuildTzifData(
+		'3',
+		[]int64{-5000000000},
+		[]byte{0},
+		[]int32{-10800},
+		[]byte{0},
+		[]string{"-03"},
+		"<-03>3")
+
+	tzifData, err = tzifMech.parseTzifData("Test/Zone3", v3Data, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by tzifMech.parseTzifData(v3)\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	transitions = tzifData.GetTransitions()
+
+	if tzifData.GetVersion() != 3 ||
+		tzifData.GetFooterTzString() != "<-03>3" ||
+		len(transitions) != 1 ||
+		transitions[0].TransitionTime != -5000000000 {
+		t.Errorf("Error: v3 data is INVALID.\n"+
+			"version='%v' footer='%v' transitions='%v'\n",
+			tzifData.GetVersion(),
+			tzifData.GetFooterTzString(),
+			transitions)
+	}
+
+	badData := map[string][]byte{
+		"Empty":               {},
+		"Bad Magic":           append([]byte("TZiX"), v1Data[4:]...),
+		"Bad Version":         append(append([]byte("TZif"), '9'), v1Data[5:]...),
+		"Truncated":           v1Data[:len(v1Data)-3],
+		"Missing Footer":      v3Data[:len(v3Data)-len("\n<-03>3\n")],
+		"Unterminated Footer": v3Data[:len(v3Data)-1],
+		"Descending Transitions": testBuildTzifData(
+			0, []int64{1000, -1000}, []byte{0, 0},
+			[]int32{0}, []byte{0}, []string{"UTC"}, ""),
+		"Bad Type Index": testBuildTzifData(
+			0, []int64{1000}, []byte{1},
+			[]int32{0}, []byte{0}, []string{"UTC"}, ""),
+	}
+
+	for testName, data := range badData {
+
+		_, err = tzifMech.parseTzifData("Test/Bad", data, ePrefix)
+
+		if err == nil {
+			t.Errorf("Error: Expected an error return from parseTzifData()\n"+
+				"for test '%v'.\n"+
+				"However, NO ERROR WAS RETURNED!\n", testName)
+		}
+	}
+}
+
+func TestTimeZoneDatabase02(t *testing.T) {
+
+	ePrefix := "TestTimeZoneDatabase02() "
+
+	dirPath := t.TempDir()
+
+	chicagoData, err := testReadEmbeddedZoneInfo(TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by testReadEmbeddedZoneInfo()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testFiles := map[string][]byte{
+		"America/Chicago": chicagoData,
+		"Etc/Test": testBuildTzifData(
+			'2', nil, nil, []int32{0}, []byte{0}, []string{"TST"}, "TST0"),
+		"tzdata.zi": []byte("# version 2099z\n# ddeps\n"),
+		"zone.tab":  []byte("# Not a TZif file\n"),
+	}
+
+	for fileName, data := range testFiles {
+
+		pathFileName := filepath.Join(dirPath, filepath.FromSlash(fileName))
+
+		err = os.MkdirAll(filepath.Dir(pathFileName), 0755)
+
+		if err == nil {
+			err = os.WriteFile(pathFileName, data, 0644)
+		}
+
+		if err != nil {
+			t.Errorf("Error writing test file '%v'\n"+
+				"Error='%v'\n", fileName, err.Error())
+			return
+		}
+	}
+
+	tzDatabase, err := TimeZoneDatabase{}.NewFromDirectory(dirPath, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDatabase{}.NewFromDirectory()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if tzDatabase.GetVersion() != "2099z" {
+		t.Errorf("Error: Expected tzdata version '2099z'.\n"+
+			"Instead, version='%v'\n", tzDatabase.GetVersion())
+	}
+
+	zoneNames := tzDatabase.GetTimeZoneNames()
+
+	if len(zoneNames) != 2 ||
+		zoneNames[0] != "America/Chicago" ||
+		zoneNames[1] != "Etc/Test" {
+		t.Errorf("Error: Expected time zone names [America/Chicago Etc/Test].\n"+
+			"Instead, time zone names='%v'\n", zoneNames)
+	}
+
+	_, err = TimeZoneDatabase{}.NewFromDirectory(
+		filepath.Join(dirPath, "Etc"),
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDatabase{}.NewFromDirectory(Etc)\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	_, err = TimeZoneDatabase{}.NewFromDirectory(
+		filepath.Join(dirPath, "zone.tab"),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewFromDirectory()\n" +
+			"because 'dirPath' is a file.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = TimeZoneDatabase{}.NewFromDirectory(
+		t.TempDir(),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewFromDirectory()\n" +
+			"because the directory does not contain TZif files.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestTimeZoneDatabase03(t *testing.T) {
+
+	ePrefix := "TestTimeZoneDatabase03() "
+
+	// A zoneinfo.zip archive in which 'America/Chicago' is fixed
+	// at UTC-0600 with no Daylight Saving Time.
+	zipBuf := new(bytes.Buffer)
+
+	zipWriter := zip.NewWriter(zipBuf)
+
+	zipEntries := map[string][]byte{
+		"+VERSION": []byte("2000a\n"),
+		"America/Chicago": testBuildTzifData(
+			'2', nil, nil, []int32{-21600}, []byte{0}, []string{"CST"}, "CST6"),
+	}
+
+	for entryName, data := range zipEntries {
+
+		fileWriter, err := zipWriter.Create(entryName)
+
+		if err == nil {
+			_, err = fileWriter.Write(data)
+		}
+
+		if err != nil {
+			t.Errorf("Error writing zip entry '%v'\n"+
+				"Error='%v'\n", entryName, err.Error())
+			return
+		}
+	}
+
+	err := zipWriter.Close()
+
+	if err != nil {
+		t.Errorf("Error returned by zipWriter.Close()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	pathFileName := filepath.Join(t.TempDir(), "zoneinfo.zip")
+
+	err = os.WriteFile(pathFileName, zipBuf.Bytes(), 0644)
+
+	if err != nil {
+		t.Errorf("Error returned by os.WriteFile()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	err = TimeZoneDatabase{}.LoadCurrentFromZipFile(pathFileName, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDatabase{}.LoadCurrentFromZipFile()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	defer func() {
+		_ = TimeZoneDatabase{}.ResetCurrent(ePrefix)
+	}()
+
+	currentDatabase, err := TimeZoneDatabase{}.GetCurrent(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDatabase{}.GetCurrent()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if currentDatabase.GetVersion() != "2000a" ||
+		currentDatabase.GetSourceName() != pathFileName {
+		t.Errorf("Error: Expected version '2000a' and source '%v'.\n"+
+			"Instead, version='%v' source='%v'\n",
+			pathFileName,
+			currentDatabase.GetVersion(),
+			currentDatabase.GetSourceName())
+	}
+
+	summerTime := time.Date(2020, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	tzDef, err := TimeZoneDefinition{}.NewFromTimeZoneName(
+		summerTime,
+		TZones.America.Chicago(),
+		TzConvertType.Relative())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	abbreviation, offset := tzDef.GetOriginalDateTime().Zone()
+
+	if abbreviation != "CST" ||
+		offset != -21600 {
+		t.Errorf("Error: Expected the loaded database to define 'CST -21600'.\n"+
+			"Instead, zone='%v %v'\n", abbreviation, offset)
+	}
+
+	dtMech := DTimeNanobot{}
+
+	_, err = dtMech.LoadTzLocation(TZones.Asia.Tokyo(), ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from LoadTzLocation()\n" +
+			"because 'Asia/Tokyo' is not in the loaded database.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	err = TimeZoneDatabase{}.ResetCurrent(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDatabase{}.ResetCurrent()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	tzDef, err = TimeZoneDefinition{}.NewFromTimeZoneName(
+		summerTime,
+		TZones.America.Chicago(),
+		TzConvertType.Relative())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeZoneName()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	abbreviation, offset = tzDef.GetOriginalDateTime().Zone()
+
+	if abbreviation != "CDT" ||
+		offset != -18000 {
+		t.Errorf("Error: Expected the embedded database to define 'CDT -18000'.\n"+
+			"Instead, zone='%v %v'\n", abbreviation, offset)
+	}
+
+	err = TimeZoneDatabase{}.LoadCurrentFromZipFile(
+		filepath.Join(t.TempDir(), "missing.zip"),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from LoadCurrentFromZipFile()\n" +
+			"because the file does not exist.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	currentDatabase, err = TimeZoneDatabase{}.GetCurrent(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDatabase{}.GetCurrent()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	if currentDatabase.GetVersion() != "2026c" {
+		t.Errorf("Error: Expected the current database to remain version '2026c'.\n"+
+			"Instead, version='%v'\n", currentDatabase.GetVersion())
+	}
+}
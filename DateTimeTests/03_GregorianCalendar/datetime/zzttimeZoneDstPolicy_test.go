@@ -0,0 +1,442 @@
+package datetime
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTimeZoneDstPolicy01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	testCases := []struct {
+		day       int
+		hour      int
+		minute    int
+		dstPolicy TimeZoneDstPolicy
+		expected  string
+	}{
+		// Nonexistent local time: clocks advanced from
+		// 02:00 CST to 03:00 CDT.
+		{14, 2, 30, TzDstPolicy.Earlier(), "2021-03-14 01:30:00 -0600 CST"},
+		{14, 2, 30, TzDstPolicy.Later(), "2021-03-14 03:30:00 -0500 CDT"},
+		{14, 2, 30, TzDstPolicy.ShiftForward(), "2021-03-14 03:00:00 -0500 CDT"},
+		// Valid local times are unaffected by the policy
+		{14, 3, 30, TzDstPolicy.Earlier(), "2021-03-14 03:30:00 -0500 CDT"},
+		{14, 1, 30, TzDstPolicy.Later(), "2021-03-14 01:30:00 -0600 CST"},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+
+		dTz, err := DateTzDto{}.NewDateTimeComponentsWithDstPolicy(
+			2021,
+			3,
+			testCases[i].day,
+			testCases[i].hour,
+			testCases[i].minute,
+			0,
+			0,
+			0,
+			0,
+			TZones.America.Chicago(),
+			testCases[i].dstPolicy,
+			fmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewDateTimeComponentsWithDstPolicy()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if dTz.String() != testCases[i].expected {
+			t.Errorf("Error: Test Case #%v Policy='%v'\n"+
+				"Expected date time='%v'\n"+
+				"  Actual date time='%v'\n",
+				i,
+				testCases[i].dstPolicy.String(),
+				testCases[i].expected,
+				dTz.String())
+		}
+
+		if dTz.GetTimeComponents().Hours != dTz.GetDateTimeValue().Hour() {
+			t.Errorf("Error: Test Case #%v\n"+
+				"Expected time component hours='%v'\n"+
+				"  Actual time component hours='%v'\n",
+				i,
+				dTz.GetDateTimeValue().Hour(),
+				dTz.GetTimeComponents().Hours)
+		}
+	}
+
+	_, err := DateTzDto{}.NewDateTimeComponentsWithDstPolicy(
+		2021, 3, 14, 2, 30, 0, 0, 0, 0,
+		TZones.America.Chicago(),
+		TzDstPolicy.Reject(),
+		fmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewDateTimeComponentsWithDstPolicy()\n" +
+			"because 2021-03-14 02:30:00 does NOT EXIST in America/Chicago.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+		return
+	}
+
+	var nonexistentErr *TimeZoneNonexistentTimeError
+
+	if !errors.As(err, &nonexistentErr) {
+		t.Errorf("Error: Expected error type *TimeZoneNonexistentTimeError.\n"+
+			"Instead, error='%v'\n", err.Error())
+		return
+	}
+
+	if nonexistentErr.GetGapStart().Format(fmtStr) != "2021-03-14 02:00:00 -0600 CST" {
+		t.Errorf("Error: Expected gap start='2021-03-14 02:00:00 -0600 CST'\n"+
+			"Instead, gap start='%v'\n",
+			nonexistentErr.GetGapStart().Format(fmtStr))
+	}
+
+	if nonexistentErr.GetGapEnd().Format(fmtStr) != "2021-03-14 03:00:00 -0500 CDT" {
+		t.Errorf("Error: Expected gap end='2021-03-14 03:00:00 -0500 CDT'\n"+
+			"Instead, gap end='%v'\n",
+			nonexistentErr.GetGapEnd().Format(fmtStr))
+	}
+
+	if !errors.Is(err, &TimeZoneError{}) {
+		t.Error("Error: Expected errors.Is(err, &TimeZoneError{}) == 'true'.\n" +
+			"Instead, errors.Is() returned 'false'.\n")
+	}
+
+	_, err = DateTzDto{}.NewDateTimeComponentsWithDstPolicy(
+		2021, 3, 14, 2, 30, 0, 0, 0, 0,
+		TZones.America.Chicago(),
+		TzDstPolicy.None(),
+		fmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewDateTimeComponentsWithDstPolicy()\n" +
+			"because 'dstPolicy' is TzDstPolicy.None().\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestTimeZoneDstPolicy02(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	testCases := []struct {
+		dstPolicy TimeZoneDstPolicy
+		expected  string
+	}{
+		// Ambiguous local time: clocks were set back from
+		// 02:00 CDT to 01:00 CST.
+		{TzDstPolicy.Earlier(), "2021-11-07 01:30:00 -0500 CDT"},
+		{TzDstPolicy.Later(), "2021-11-07 01:30:00 -0600 CST"},
+		{TzDstPolicy.ShiftForward(), "2021-11-07 01:30:00 -0500 CDT"},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+
+		dTz, err := DateTzDto{}.NewDateTimeElementsWithDstPolicy(
+			2021,
+			11,
+			7,
+			1,
+			30,
+			0,
+			0,
+			TZones.America.Chicago(),
+			testCases[i].dstPolicy,
+			fmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewDateTimeElementsWithDstPolicy()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if dTz.String() != testCases[i].expected {
+			t.Errorf("Error: Test Case #%v Policy='%v'\n"+
+				"Expected date time='%v'\n"+
+				"  Actual date time='%v'\n",
+				i,
+				testCases[i].dstPolicy.String(),
+				testCases[i].expected,
+				dTz.String())
+		}
+
+		tzDef, err := TimeZoneDefinition{}.NewFromTimeComponentsWithDstPolicy(
+			2021,
+			11,
+			7,
+			1,
+			30,
+			0,
+			0,
+			TZones.America.Chicago(),
+			testCases[i].dstPolicy)
+
+		if err != nil {
+			t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeComponentsWithDstPolicy()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if tzDef.GetOriginalDateTime().Format(fmtStr) != testCases[i].expected {
+			t.Errorf("Error: Test Case #%v Policy='%v'\n"+
+				"Expected TimeZoneDefinition date time='%v'\n"+
+				"  Actual TimeZoneDefinition date time='%v'\n",
+				i,
+				testCases[i].dstPolicy.String(),
+				testCases[i].expected,
+				tzDef.GetOriginalDateTime().Format(fmtStr))
+		}
+	}
+
+	dTz := DateTzDto{}
+
+	err := dTz.SetFromDateTimeElementsWithDstPolicy(
+		2021, 11, 7, 1, 30, 0, 0,
+		TZones.America.Chicago(),
+		TzDstPolicy.Reject(),
+		fmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from SetFromDateTimeElementsWithDstPolicy()\n" +
+			"because 2021-11-07 01:30:00 is AMBIGUOUS in America/Chicago.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+		return
+	}
+
+	var ambiguousErr *TimeZoneAmbiguousTimeError
+
+	if !errors.As(err, &ambiguousErr) {
+		t.Errorf("Error: Expected error type *TimeZoneAmbiguousTimeError.\n"+
+			"Instead, error='%v'\n", err.Error())
+		return
+	}
+
+	if ambiguousErr.GetEarlier().Format(fmtStr) != "2021-11-07 01:30:00 -0500 CDT" {
+		t.Errorf("Error: Expected earlier='2021-11-07 01:30:00 -0500 CDT'\n"+
+			"Instead, earlier='%v'\n",
+			ambiguousErr.GetEarlier().Format(fmtStr))
+	}
+
+	if ambiguousErr.GetLater().Format(fmtStr) != "2021-11-07 01:30:00 -0600 CST" {
+		t.Errorf("Error: Expected later='2021-11-07 01:30:00 -0600 CST'\n"+
+			"Instead, later='%v'\n",
+			ambiguousErr.GetLater().Format(fmtStr))
+	}
+
+	_, err = TimeZoneDefinition{}.NewFromTimeComponentsWithDstPolicy(
+		2021, 11, 7, 1, 30, 0, 0,
+		TZones.America.Chicago(),
+		TzDstPolicy.Reject())
+
+	if !errors.Is(err, &TimeZoneAmbiguousTimeError{}) {
+		t.Errorf("Error: Expected a *TimeZoneAmbiguousTimeError from\n"+
+			"TimeZoneDefinition{}.NewFromTimeComponentsWithDstPolicy().\n"+
+			"Instead, err='%v'\n", err)
+	}
+}
+
+func TestTimeZoneDstPolicy03(t *testing.T) {
+
+	testCases := []struct {
+		timeZoneName  string
+		year          int
+		month         int
+		day           int
+		hour          int
+		minute        int
+		isAmbiguous   bool
+		isNonexistent bool
+	}{
+		{TZones.America.Chicago(), 2021, 3, 14, 2, 30, false, true},
+		{TZones.America.Chicago(), 2021, 3, 14, 3, 0, false, false},
+		{TZones.America.Chicago(), 2021, 11, 7, 1, 0, true, false},
+		{TZones.America.Chicago(), 2021, 11, 7, 2, 0, false, false},
+		{TZones.America.Chicago(), 2021, 6, 1, 12, 0, false, false},
+		{TZones.Europe.London(), 2100, 3, 28, 1, 15, false, true},
+		{TZones.Europe.London(), 2100, 10, 31, 1, 15, true, false},
+		{TZones.Asia.Tokyo(), 2021, 3, 14, 2, 30, false, false},
+		{TZones.UTC(), 2021, 3, 14, 2, 30, false, false},
+	}
+
+	for i := 0; i < len(testCases); i++ {
+
+		isAmbiguous, err := TimeZoneDefinition{}.IsAmbiguousLocalTime(
+			testCases[i].year,
+			testCases[i].month,
+			testCases[i].day,
+			testCases[i].hour,
+			testCases[i].minute,
+			0,
+			0,
+			testCases[i].timeZoneName)
+
+		if err != nil {
+			t.Errorf("Error returned by TimeZoneDefinition{}.IsAmbiguousLocalTime()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if isAmbiguous != testCases[i].isAmbiguous {
+			t.Errorf("Error: Test Case #%v %v\n"+
+				"Expected isAmbiguous='%v'\n"+
+				"  Actual isAmbiguous='%v'\n",
+				i, testCases[i].timeZoneName,
+				testCases[i].isAmbiguous, isAmbiguous)
+		}
+
+		isNonexistent, err := TimeZoneDefinition{}.IsNonexistentLocalTime(
+			testCases[i].year,
+			testCases[i].month,
+			testCases[i].day,
+			testCases[i].hour,
+			testCases[i].minute,
+			0,
+			0,
+			testCases[i].timeZoneName)
+
+		if err != nil {
+			t.Errorf("Error returned by TimeZoneDefinition{}.IsNonexistentLocalTime()\n"+
+				"Test Case #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			continue
+		}
+
+		if isNonexistent != testCases[i].isNonexistent {
+			t.Errorf("Error: Test Case #%v %v\n"+
+				"Expected isNonexistent='%v'\n"+
+				"  Actual isNonexistent='%v'\n",
+				i, testCases[i].timeZoneName,
+				testCases[i].isNonexistent, isNonexistent)
+		}
+	}
+
+	_, err := TimeZoneDefinition{}.IsAmbiguousLocalTime(
+		2021, 11, 7, 1, 0, 0, 0,
+		"America/Atlantis")
+
+	if err == nil {
+		t.Error("Error: Expected an error return from IsAmbiguousLocalTime()\n" +
+			"because the time zone name is INVALID.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestTimeZoneDstPolicy04(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	// Methods without a DST Policy parameter retain
+	// their original behavior.
+	dTz, err := DateTzDto{}.NewDateTimeComponents(
+		2021,
+		3,
+		14,
+		3,
+		30,
+		0,
+		0,
+		0,
+		0,
+		TZones.America.Chicago(),
+		fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTimeComponents()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	expected := "2021-03-14 03:30:00 -0500 CDT"
+
+	if dTz.String() != expected {
+		t.Errorf("Error: Expected date time='%v'\n"+
+			"Instead, date time='%v'\n",
+			expected, dTz.String())
+	}
+
+	dTz, err = DateTzDto{}.NewDateTimeElements(
+		2021,
+		11,
+		8,
+		1,
+		30,
+		0,
+		0,
+		TZones.America.Chicago(),
+		fmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTimeElements()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	expected = "2021-11-08 01:30:00 -0600 CST"
+
+	if dTz.String() != expected {
+		t.Errorf("Error: Expected date time='%v'\n"+
+			"Instead, date time='%v'\n",
+			expected, dTz.String())
+	}
+
+	_, err = TimeZoneDefinition{}.NewFromTimeComponents(
+		2021,
+		11,
+		8,
+		1,
+		30,
+		0,
+		0,
+		TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by TimeZoneDefinition{}.NewFromTimeComponents()\n"+
+			"Error='%v'\n", err.Error())
+	}
+
+	// TzDstPolicy.None() is NOT a valid DST Policy.
+	_, err = DateTzDto{}.NewDateTimeComponentsWithDstPolicy(
+		2021,
+		3,
+		14,
+		3,
+		30,
+		0,
+		0,
+		0,
+		0,
+		TZones.America.Chicago(),
+		TzDstPolicy.None(),
+		fmtStr)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewDateTimeComponentsWithDstPolicy()\n" +
+			"because the DST Policy is TzDstPolicy.None().\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = TimeZoneDefinition{}.NewFromTimeComponentsWithDstPolicy(
+		2021,
+		11,
+		8,
+		1,
+		30,
+		0,
+		0,
+		TZones.America.Chicago(),
+		TzDstPolicy.None())
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewFromTimeComponentsWithDstPolicy()\n" +
+			"because the DST Policy is TzDstPolicy.None().\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
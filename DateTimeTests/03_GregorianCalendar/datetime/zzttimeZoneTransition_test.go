@@ -0,0 +1,275 @@
+package datetime
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeZoneTransitionsBetween01(t *testing.T) {
+
+	ePrefix := "TestTimeZoneTransitionsBetween01() "
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	testPeriods := []struct {
+		timeZoneName  string
+		startDateTime time.Time
+		endDateTime   time.Time
+		expected      []string
+	}{
+		{
+			TZones.America.Chicago(),
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{
+				"2021-03-14 03:00:00 -0500 CDT",
+				"2021-11-07 01:00:00 -0600 CST",
+			},
+		},
+		{
+			// The search period begins at a transition and
+			// ends at the following transition.
+			TZones.America.Chicago(),
+			time.Date(2021, 3, 14, 8, 0, 0, 0, time.UTC),
+			time.Date(2021, 11, 7, 7, 0, 0, 0, time.UTC),
+			[]string{
+				"2021-03-14 03:00:00 -0500 CDT",
+			},
+		},
+		{
+			// Transitions following the last transition in the
+			// TZif file are computed from the footer TZ string.
+			TZones.Europe.London(),
+			time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2101, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{
+				"2100-03-28 02:00:00 +0100 BST",
+				"2100-10-31 01:00:00 +0000 GMT",
+			},
+		},
+		{
+			// A change in UTC offset without a change in abbreviation
+			TZones.Europe.Moscow(),
+			time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{
+				"2014-10-26 01:00:00 +0300 MSK",
+			},
+		},
+		{
+			TZones.Asia.Tokyo(),
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{},
+		},
+		{
+			TZones.UTC(),
+			time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+			[]string{},
+		},
+	}
+
+	for i := 0; i < len(testPeriods); i++ {
+
+		transitions, err := TimeZoneSpecification{}.NewTransitionsBetween(
+			testPeriods[i].timeZoneName,
+			testPeriods[i].startDateTime,
+			testPeriods[i].endDateTime,
+			ePrefix)
+
+		if err != nil {
+			t.Errorf("Error returned by TimeZoneSpecification{}.NewTransitionsBetween()\n"+
+				"Test Period #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			return
+		}
+
+		actual := make([]string, len(transitions))
+
+		for j := 0; j < len(transitions); j++ {
+			actual[j] = transitions[j].GetReferenceDateTime().Format(fmtStr)
+		}
+
+		if len(actual) != len(testPeriods[i].expected) {
+			t.Errorf("Error: Test Period #%v %v\n"+
+				"Expected transitions='%v'\n"+
+				"  Actual transitions='%v'\n",
+				i,
+				testPeriods[i].timeZoneName,
+				testPeriods[i].expected,
+				actual)
+			continue
+		}
+
+		for j := 0; j < len(actual); j++ {
+
+			if actual[j] != testPeriods[i].expected[j] {
+				t.Errorf("Error: Test Period #%v %v Transition #%v\n"+
+					"Expected transition='%v'\n"+
+					"  Actual transition='%v'\n",
+					i,
+					testPeriods[i].timeZoneName,
+					j,
+					testPeriods[i].expected[j],
+					actual[j])
+			}
+
+			if transitions[j].GetLocationName() != testPeriods[i].timeZoneName {
+				t.Errorf("Error: Test Period #%v Transition #%v\n"+
+					"Expected location name='%v'\n"+
+					"  Actual location name='%v'\n",
+					i,
+					j,
+					testPeriods[i].timeZoneName,
+					transitions[j].GetLocationName())
+			}
+		}
+	}
+
+	_, err := TimeZoneSpecification{}.NewTransitionsBetween(
+		TZones.America.Chicago(),
+		time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewTransitionsBetween()\n" +
+			"because 'endDateTime' occurs before 'startDateTime'.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+
+	_, err = TimeZoneSpecification{}.NewTransitionsBetween(
+		"America/Atlantis",
+		time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		ePrefix)
+
+	if err == nil {
+		t.Error("Error: Expected an error return from NewTransitionsBetween()\n" +
+			"because the time zone name is INVALID.\n" +
+			"However, NO ERROR WAS RETURNED!\n")
+	}
+}
+
+func TestDateTzDtoTransition01(t *testing.T) {
+
+	fmtStr := "2006-01-02 15:04:05 -0700 MST"
+
+	chicagoLoc, err := time.LoadLocation(TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	testDates := []struct {
+		dateTime     time.Time
+		hasNext      bool
+		expectedNext string
+		hasPrev      bool
+		expectedPrev string
+	}{
+		{
+			time.Date(2021, 6, 1, 12, 0, 0, 0, chicagoLoc),
+			true,
+			"2021-11-07 01:00:00 -0600 CST",
+			true,
+			"2021-03-14 03:00:00 -0500 CDT",
+		},
+		{
+			// The date time is a transition instant
+			time.Date(2021, 3, 14, 3, 0, 0, 0, chicagoLoc),
+			true,
+			"2021-11-07 01:00:00 -0600 CST",
+			true,
+			"2021-03-14 03:00:00 -0500 CDT",
+		},
+		{
+			// One nanosecond before a transition
+			time.Date(2021, 3, 14, 1, 59, 59, 999999999, chicagoLoc),
+			true,
+			"2021-03-14 03:00:00 -0500 CDT",
+			true,
+			"2020-11-01 01:00:00 -0600 CST",
+		},
+		{
+			// The last UTC day of a leap year
+			time.Date(2024, 12, 31, 12, 0, 0, 0, chicagoLoc),
+			true,
+			"2025-03-09 03:00:00 -0500 CDT",
+			true,
+			"2024-11-03 01:00:00 -0600 CST",
+		},
+		{
+			time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC),
+			false,
+			"",
+			false,
+			"",
+		},
+	}
+
+	for i := 0; i < len(testDates); i++ {
+
+		dTz, err := DateTzDto{}.NewDateTime(testDates[i].dateTime, fmtStr)
+
+		if err != nil {
+			t.Errorf("Error returned by DateTzDto{}.NewDateTime()\n"+
+				"Test Date #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			return
+		}
+
+		nextTransition, hasNext, err := dTz.NextTransition()
+
+		if err != nil {
+			t.Errorf("Error returned by dTz.NextTransition()\n"+
+				"Test Date #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			return
+		}
+
+		if hasNext != testDates[i].hasNext {
+			t.Errorf("Error: Test Date #%v\n"+
+				"Expected hasTransition='%v' from NextTransition().\n"+
+				"Instead, hasTransition='%v'\n",
+				i, testDates[i].hasNext, hasNext)
+
+		} else if hasNext &&
+			nextTransition.GetReferenceDateTime().Format(fmtStr) != testDates[i].expectedNext {
+			t.Errorf("Error: Test Date #%v\n"+
+				"Expected next transition='%v'\n"+
+				"  Actual next transition='%v'\n",
+				i,
+				testDates[i].expectedNext,
+				nextTransition.GetReferenceDateTime().Format(fmtStr))
+		}
+
+		prevTransition, hasPrev, err := dTz.PreviousTransition()
+
+		if err != nil {
+			t.Errorf("Error returned by dTz.PreviousTransition()\n"+
+				"Test Date #%v\n"+
+				"Error='%v'\n", i, err.Error())
+			return
+		}
+
+		if hasPrev != testDates[i].hasPrev {
+			t.Errorf("Error: Test Date #%v\n"+
+				"Expected hasTransition='%v' from PreviousTransition().\n"+
+				"Instead, hasTransition='%v'\n",
+				i, testDates[i].hasPrev, hasPrev)
+
+		} else if hasPrev &&
+			prevTransition.GetReferenceDateTime().Format(fmtStr) != testDates[i].expectedPrev {
+			t.Errorf("Error: Test Date #%v\n"+
+				"Expected previous transition='%v'\n"+
+				"  Actual previous transition='%v'\n",
+				i,
+				testDates[i].expectedPrev,
+				prevTransition.GetReferenceDateTime().Format(fmtStr))
+		}
+	}
+}
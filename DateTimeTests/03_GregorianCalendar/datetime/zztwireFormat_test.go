@@ -0,0 +1,584 @@
+package datetime
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+)
+
+// testCompareDateTzDtoWire - Compares a DateTzDto rebuilt from a
+// wire format with the original instance.
+func testCompareDateTzDtoWire(
+	t *testing.T,
+	testName string,
+	original *DateTzDto,
+	rebuilt *DateTzDto) {
+
+	if !rebuilt.IsValidInstance() {
+		t.Errorf("Error: %v\nThe rebuilt DateTzDto is INVALID!\n", testName)
+		return
+	}
+
+	if !rebuilt.GetDateTimeValue().Equal(original.GetDateTimeValue()) {
+		t.Errorf("Error: %v\nExpected dateTime='%v'\nInstead, dateTime='%v'\n",
+			testName,
+			original.GetDateTimeValue().Format(FmtDateTimeYrMDayFmtStr),
+			rebuilt.GetDateTimeValue().Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	if rebuilt.GetDateTimeValue().Format(FmtDateTimeYrMDayFmtStr) !=
+		original.GetDateTimeValue().Format(FmtDateTimeYrMDayFmtStr) {
+		t.Errorf("Error: %v\nExpected local dateTime='%v'\nInstead, local dateTime='%v'\n",
+			testName,
+			original.GetDateTimeValue().Format(FmtDateTimeYrMDayFmtStr),
+			rebuilt.GetDateTimeValue().Format(FmtDateTimeYrMDayFmtStr))
+	}
+
+	if rebuilt.GetDateTimeFmt() != original.GetDateTimeFmt() {
+		t.Errorf("Error: %v\nExpected dateTimeFmt='%v'\nInstead, dateTimeFmt='%v'\n",
+			testName, original.GetDateTimeFmt(), rebuilt.GetDateTimeFmt())
+	}
+
+	if rebuilt.GetOriginalTagDescription() != original.GetOriginalTagDescription() {
+		t.Errorf("Error: %v\nExpected tagDescription='%v'\nInstead, tagDescription='%v'\n",
+			testName, original.GetOriginalTagDescription(), rebuilt.GetOriginalTagDescription())
+	}
+
+	originalTz := original.GetTimeZoneDef()
+	rebuiltTz := rebuilt.GetTimeZoneDef()
+
+	if rebuiltTz.GetOriginalLocationName() != originalTz.GetOriginalLocationName() {
+		t.Errorf("Error: %v\nExpected original location='%v'\nInstead, original location='%v'\n",
+			testName, originalTz.GetOriginalLocationName(), rebuiltTz.GetOriginalLocationName())
+	}
+
+	if rebuiltTz.GetConvertibleLocationName() != originalTz.GetConvertibleLocationName() {
+		t.Errorf("Error: %v\nExpected convertible location='%v'\nInstead, convertible location='%v'\n",
+			testName, originalTz.GetConvertibleLocationName(), rebuiltTz.GetConvertibleLocationName())
+	}
+
+	if rebuiltTz.GetOriginalTimeZoneClass() != originalTz.GetOriginalTimeZoneClass() ||
+		rebuiltTz.GetConvertibleTimeZoneClass() != originalTz.GetConvertibleTimeZoneClass() {
+		t.Errorf("Error: %v\nThe rebuilt time zone classes do NOT match the original.\n",
+			testName)
+	}
+
+	originalTimeComponents := original.GetTimeComponents()
+	rebuiltTimeComponents := rebuilt.GetTimeComponents()
+
+	if !rebuiltTimeComponents.Equal(originalTimeComponents) {
+		t.Errorf("Error: %v\nThe rebuilt time components do NOT match the original.\n",
+			testName)
+	}
+}
+
+func TestWireFormat01(t *testing.T) {
+
+	chicagoPtr, err := time.LoadLocation(TZones.America.Chicago())
+
+	if err != nil {
+		t.Errorf("Error returned by time.LoadLocation()\nError='%v'\n", err.Error())
+		return
+	}
+
+	original, err := DateTzDto{}.NewDateTime(
+		time.Date(2021, 3, 13, 12, 0, 0, 123456789, chicagoPtr),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\nError='%v'\n", err.Error())
+		return
+	}
+
+	original.SetTagDescription("Project Start")
+
+	// JSON
+	data, err := json.Marshal(original)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Marshal(original)\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !strings.Contains(string(data), `"dateTime":"2021-03-13T12:00:00.123456789-06:00"`) {
+		t.Errorf("Error: Expected JSON wire format to contain the RFC 3339 date time.\n"+
+			"JSON='%v'\n", string(data))
+	}
+
+	rebuilt := DateTzDto{}
+
+	err = json.Unmarshal(data, &rebuilt)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Unmarshal()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareDateTzDtoWire(t, "JSON", &original, &rebuilt)
+
+	// Text
+	data, err = original.MarshalText()
+
+	if err != nil {
+		t.Errorf("Error returned by original.MarshalText()\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuilt = DateTzDto{}
+
+	err = rebuilt.UnmarshalText(data)
+
+	if err != nil {
+		t.Errorf("Error returned by rebuilt.UnmarshalText()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareDateTzDtoWire(t, "Text", &original, &rebuilt)
+
+	// Binary
+	data, err = original.MarshalBinary()
+
+	if err != nil {
+		t.Errorf("Error returned by original.MarshalBinary()\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuilt = DateTzDto{}
+
+	err = rebuilt.UnmarshalBinary(data)
+
+	if err != nil {
+		t.Errorf("Error returned by rebuilt.UnmarshalBinary()\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareDateTzDtoWire(t, "Binary", &original, &rebuilt)
+
+	// Embedded in an API payload.
+	type payload struct {
+		Name  string
+		Start DateTzDto
+	}
+
+	data, err = json.Marshal(payload{Name: "Project", Start: original})
+
+	if err != nil {
+		t.Errorf("Error returned by json.Marshal(payload)\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuiltPayload := payload{}
+
+	err = json.Unmarshal(data, &rebuiltPayload)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Unmarshal(payload)\nError='%v'\n", err.Error())
+		return
+	}
+
+	testCompareDateTzDtoWire(t, "Payload", &original, &rebuiltPayload.Start)
+}
+
+func TestWireFormat02(t *testing.T) {
+
+	// Time zone abbreviation '-05' is not a loadable location. The
+	// original time zone resolves to 'Etc/GMT+5' while the convertible
+	// time zone resolves the abbreviation to a different IANA location.
+	dateTime := time.Date(
+		2021,
+		7,
+		4,
+		9,
+		30,
+		0,
+		0,
+		time.FixedZone("-05", -5*60*60))
+
+	original, err := DateTzDto{}.NewDateTime(dateTime, FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !original.IsValidInstance() {
+		t.Error("Error: Expected 'original' to be a valid DateTzDto instance.\n" +
+			"Instead, original.IsValidInstance()='false'\n")
+		return
+	}
+
+	tzDef := original.GetTimeZoneDef()
+
+	if tzDef.GetOriginalLocationName() ==
+		tzDef.GetConvertibleLocationName() {
+		t.Errorf("Error: Expected the original time zone to differ from\n"+
+			"the convertible time zone. Instead, both time zones='%v'\n",
+			tzDef.GetOriginalLocationName())
+		return
+	}
+
+	data, err := original.MarshalBinary()
+
+	if err != nil {
+		t.Errorf("Error returned by original.MarshalBinary()\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuilt := DateTzDto{}
+
+	err = rebuilt.UnmarshalBinary(data)
+
+	if err != nil {
+		t.Errorf("Error returned by rebuilt.UnmarshalBinary()\n"+
+			"original location='%v'\nconvertible location='%v'\nError='%v'\n",
+			tzDef.GetOriginalLocationName(), tzDef.GetConvertibleLocationName(), err.Error())
+		return
+	}
+
+	testCompareDateTzDtoWire(t, "Abbreviation", &original, &rebuilt)
+
+	// Time Zone Definition and Specification.
+	data, err = json.Marshal(tzDef)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Marshal(tzDef)\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuiltTzDef := TimeZoneDefinition{}
+
+	err = json.Unmarshal(data, &rebuiltTzDef)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Unmarshal(tzDef)\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !rebuiltTzDef.IsValidInstance() ||
+		!rebuiltTzDef.Equal(tzDef) {
+		t.Errorf("Error: Expected rebuilt TimeZoneDefinition to equal the original.\n"+
+			"JSON='%v'\n", string(data))
+	}
+
+	tzSpec := tzDef.GetConvertibleTimeZone()
+
+	tzSpec.SetTagDescription("Convertible")
+
+	data, err = tzSpec.MarshalBinary()
+
+	if err != nil {
+		t.Errorf("Error returned by tzSpec.MarshalBinary()\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuiltTzSpec := TimeZoneSpecification{}
+
+	err = rebuiltTzSpec.UnmarshalBinary(data)
+
+	if err != nil {
+		t.Errorf("Error returned by rebuiltTzSpec.UnmarshalBinary()\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !rebuiltTzSpec.IsValidInstance() ||
+		!rebuiltTzSpec.Equal(tzSpec) {
+		t.Error("Error: Expected rebuilt TimeZoneSpecification to equal the original.")
+	}
+
+	if rebuiltTzSpec.GetTagDescription() != "Convertible" {
+		t.Errorf("Error: Expected tag description='Convertible'. Instead, tag='%v'\n",
+			rebuiltTzSpec.GetTagDescription())
+	}
+}
+
+func TestWireFormat03(t *testing.T) {
+
+	ePrefix := "TestWireFormat03() "
+
+	// TimeDto
+	tDto, err := TimeDto{}.NewTimeComponents(2021, 3, 1, 6, 14, 30, 15, 123, 456, 789)
+
+	if err != nil {
+		t.Errorf("Error returned by TimeDto{}.NewTimeComponents()\nError='%v'\n", err.Error())
+		return
+	}
+
+	for _, marshalType := range []string{"json", "text", "binary"} {
+
+		var data []byte
+		rebuiltTDto := TimeDto{}
+
+		switch marshalType {
+		case "json":
+			data, err = json.Marshal(tDto)
+			if err == nil {
+				err = json.Unmarshal(data, &rebuiltTDto)
+			}
+		case "text":
+			data, err = tDto.MarshalText()
+			if err == nil {
+				err = rebuiltTDto.UnmarshalText(data)
+			}
+		case "binary":
+			data, err = tDto.MarshalBinary()
+			if err == nil {
+				err = rebuiltTDto.UnmarshalBinary(data)
+			}
+		}
+
+		if err != nil {
+			t.Errorf("Error: TimeDto %v round trip failed.\nError='%v'\n",
+				marshalType, err.Error())
+			continue
+		}
+
+		if !rebuiltTDto.IsValidInstance() ||
+			!rebuiltTDto.Equal(tDto) {
+			t.Errorf("Error: TimeDto %v round trip does NOT equal the original.\n",
+				marshalType)
+		}
+	}
+
+	// JulianDayNoDto with a leap second.
+	jDNDto, err := JulianDayNoDto{}.NewFromJulianDayNoTime(
+		big.NewFloat(0.0).SetPrec(1024).SetFloat64(2457754.25),
+		true,
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by JulianDayNoDto{}.NewFromJulianDayNoTime()\n"+
+			"Error='%v'\n", err.Error())
+		return
+	}
+
+	data, err := json.Marshal(jDNDto)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Marshal(jDNDto)\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuiltJDN := JulianDayNoDto{}
+
+	err = json.Unmarshal(data, &rebuiltJDN)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Unmarshal(jDNDto)\nError='%v'\n", err.Error())
+		return
+	}
+
+	if !rebuiltJDN.IsValidInstance() {
+		t.Error("Error: The rebuilt JulianDayNoDto is INVALID!")
+	}
+
+	if rebuiltJDN.julianDayNoTime.Cmp(jDNDto.julianDayNoTime) != 0 ||
+		rebuiltJDN.julianDayNoNumericalSign != jDNDto.julianDayNoNumericalSign ||
+		rebuiltJDN.totalJulianNanoSeconds != jDNDto.totalJulianNanoSeconds ||
+		!rebuiltJDN.GetHasLeapSecond() {
+		t.Errorf("Error: The rebuilt JulianDayNoDto does NOT equal the original.\n"+
+			"JSON='%v'\n", string(data))
+	}
+
+	// ADateTimeDto with a BCE year, a leap second and a Julian Day
+	// Number.
+	aDateTime, err := ADateTimeDto{}.New(
+		CalSpec.Julian(),
+		44,
+		CalYearType.BCE(),
+		3,
+		15,
+		true,
+		11,
+		30,
+		60,
+		500,
+		TZones.UTC(),
+		FmtDateTimeYrMDayFmtStr,
+		"Ides of March",
+		ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by ADateTimeDto{}.New()\nError='%v'\n", err.Error())
+		return
+	}
+
+	aDateTime.SetDateTag("Date Tag")
+
+	err = aDateTime.SetJulianDayNumberDto(jDNDto, ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by aDateTime.SetJulianDayNumberDto()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	data, err = aDateTime.MarshalBinary()
+
+	if err != nil {
+		t.Errorf("Error returned by aDateTime.MarshalBinary()\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuiltADateTime := ADateTimeDto{}
+
+	err = rebuiltADateTime.UnmarshalBinary(data)
+
+	if err != nil {
+		t.Errorf("Error returned by rebuiltADateTime.UnmarshalBinary()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if !rebuiltADateTime.IsValidInstance() {
+		t.Error("Error: The rebuilt ADateTimeDto is INVALID!")
+	}
+
+	if rebuiltADateTime.GetYearAstronomical() != aDateTime.GetYearAstronomical() {
+		t.Errorf("Error: Expected astronomical year='%v'. Instead, year='%v'\n",
+			aDateTime.GetYearAstronomical(), rebuiltADateTime.GetYearAstronomical())
+	}
+
+	_, expectedYearType, err := aDateTime.GetYearWithType(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by aDateTime.GetYearWithType()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	_, yearType, err := rebuiltADateTime.GetYearWithType(ePrefix)
+
+	if err != nil {
+		t.Errorf("Error returned by rebuiltADateTime.GetYearWithType()\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if yearType != CalYearType.BCE() || yearType != expectedYearType {
+		t.Errorf("Error: Expected year type='%v'. Instead, year type='%v'\n",
+			expectedYearType.String(), yearType.String())
+	}
+
+	if !rebuiltADateTime.GetDateHasLeapSecond() ||
+		!rebuiltADateTime.GetTimeHasLeapSecond() ||
+		rebuiltADateTime.GetSecond() != 60 {
+		t.Error("Error: Expected the rebuilt ADateTimeDto to retain the leap second.")
+	}
+
+	if rebuiltADateTime.GetTag() != aDateTime.GetTag() ||
+		rebuiltADateTime.GetDateTag() != "Date Tag" ||
+		rebuiltADateTime.GetTimeTag() != aDateTime.GetTimeTag() {
+		t.Errorf("Error: Expected the rebuilt ADateTimeDto to retain its tags.\n"+
+			"tag='%v' dateTag='%v' timeTag='%v'\n",
+			rebuiltADateTime.GetTag(),
+			rebuiltADateTime.GetDateTag(),
+			rebuiltADateTime.GetTimeTag())
+	}
+
+	if !rebuiltADateTime.julianDayNumber.isThisInstanceValid ||
+		rebuiltADateTime.julianDayNumber.julianDayNoTime.Cmp(jDNDto.julianDayNoTime) != 0 {
+		t.Error("Error: Expected the rebuilt ADateTimeDto to retain its Julian Day Number.")
+	}
+
+	jsonData, err := json.Marshal(aDateTime)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Marshal(aDateTime)\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuiltADateTime = ADateTimeDto{}
+
+	err = json.Unmarshal(jsonData, &rebuiltADateTime)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Unmarshal(aDateTime)\nError='%v'\n", err.Error())
+		return
+	}
+
+	rebuiltJSON, err := json.Marshal(rebuiltADateTime)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Marshal(rebuiltADateTime)\nError='%v'\n",
+			err.Error())
+		return
+	}
+
+	if !bytes.Equal(jsonData, rebuiltJSON) {
+		t.Errorf("Error: Expected the ADateTimeDto JSON round trip to be stable.\n"+
+			"Original='%v'\n Rebuilt='%v'\n", string(jsonData), string(rebuiltJSON))
+	}
+}
+
+func TestWireFormat04(t *testing.T) {
+
+	// An empty instance cannot be marshaled.
+	_, err := DateTzDto{}.MarshalJSON()
+
+	if err == nil {
+		t.Error("Error: Expected an error from marshaling an empty DateTzDto. " +
+			"NO ERROR WAS RETURNED!")
+	}
+
+	original, err := DateTzDto{}.NewDateTime(
+		time.Date(2021, 3, 13, 12, 0, 0, 0, time.UTC),
+		FmtDateTimeYrMDayFmtStr)
+
+	if err != nil {
+		t.Errorf("Error returned by DateTzDto{}.NewDateTime()\nError='%v'\n", err.Error())
+		return
+	}
+
+	data, err := original.MarshalBinary()
+
+	if err != nil {
+		t.Errorf("Error returned by original.MarshalBinary()\nError='%v'\n", err.Error())
+		return
+	}
+
+	// The binary data encodes a DateTzDto, not a TimeDto.
+	tDto := TimeDto{}
+
+	err = tDto.UnmarshalBinary(data)
+
+	if err == nil {
+		t.Error("Error: Expected an error from TimeDto.UnmarshalBinary() with " +
+			"DateTzDto data. NO ERROR WAS RETURNED!")
+	}
+
+	// Truncated data leaves the target unchanged.
+	target := original.CopyOut()
+
+	err = target.UnmarshalBinary(data[:len(data)-3])
+
+	if err == nil {
+		t.Error("Error: Expected an error from UnmarshalBinary() with truncated " +
+			"data. NO ERROR WAS RETURNED!")
+	}
+
+	if !target.Equal(original) {
+		t.Error("Error: Expected a failed UnmarshalBinary() to leave the " +
+			"target unchanged.")
+	}
+
+	// An unknown time zone location is rejected.
+	jsonData, err := json.Marshal(original)
+
+	if err != nil {
+		t.Errorf("Error returned by json.Marshal(original)\nError='%v'\n", err.Error())
+		return
+	}
+
+	badJSON := strings.Replace(string(jsonData),
+		`"locationName":"UTC"`, `"locationName":"Mars/Olympus_Mons"`, 1)
+
+	err = json.Unmarshal([]byte(badJSON), &target)
+
+	if err == nil {
+		t.Error("Error: Expected an error from json.Unmarshal() with an " +
+			"unknown location. NO ERROR WAS RETURNED!")
+	}
+}